@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"shortvideo/internal/danmu/dao"
 	"shortvideo/internal/danmu/handler"
 	"shortvideo/internal/danmu/service"
+	liveDao "shortvideo/internal/live/dao"
+	socialDao "shortvideo/internal/social/dao"
+	userDao "shortvideo/internal/user/dao"
 	danmu "shortvideo/kitex_gen/danmu/danmuservice"
 	"shortvideo/pkg/config"
 	"shortvideo/pkg/database"
 	"shortvideo/pkg/logger"
+	"shortvideo/pkg/mq"
 
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
@@ -32,13 +37,24 @@ func main() {
 	//初始化弹幕相关dao
 	danmuRepo := dao.NewDanmuRepository(db)
 	danmuFilterRepo := dao.NewDanmuFilterRepository(db)
+	liveRoomRepo := liveDao.NewLiveRoomRepository(db)
+	roomAdminRepo := liveDao.NewRoomAdminRepository(db)
+	blockRepo := socialDao.NewBlockRepository(db)
+	userRepo := userDao.NewUserRepository(db)
 
 	//初始化弹幕服务
 	danmuService := service.NewDanmuService(
 		danmuRepo,
 		danmuFilterRepo,
+		liveRoomRepo,
+		roomAdminRepo,
+		blockRepo,
+		userRepo,
 	)
 
+	//消费账号注销事件，删除注销用户的弹幕
+	go mq.ConsumeUserDeleted(context.Background(), "danmu-user-deleted", danmuService.PurgeUserData)
+
 	//初始化处理器
 	danmuHandler := handler.NewDanmuService(danmuService)
 
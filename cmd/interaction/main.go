@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
 	"shortvideo/internal/interaction/dao"
 	"shortvideo/internal/interaction/handler"
 	"shortvideo/internal/interaction/service"
+	messageDao "shortvideo/internal/message/dao"
+	socialDao "shortvideo/internal/social/dao"
+	socialService "shortvideo/internal/social/service"
+	userDao "shortvideo/internal/user/dao"
+	userService "shortvideo/internal/user/service"
+	"shortvideo/internal/user/settings"
 	videoDao "shortvideo/internal/video/dao"
 	videoService "shortvideo/internal/video/service"
 	"shortvideo/kitex_gen/interaction/interactionservice"
@@ -14,6 +21,7 @@ import (
 	"shortvideo/pkg/config"
 	"shortvideo/pkg/database"
 	"shortvideo/pkg/es"
+	"shortvideo/pkg/jwt"
 	"shortvideo/pkg/mq"
 	"shortvideo/pkg/storage"
 
@@ -50,30 +58,63 @@ func main() {
 		log.Fatalf("初始化MinIO失败: %v", err)
 	}
 
+	//初始化JWT管理器
+	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.AccessExpireMinutes, cfg.JWT.RefreshExpireHours)
+
 	//初始化Elasticsearch
 	esClient, err := es.NewESManager()
 	if err != nil {
 		log.Printf("初始化Elasticsearch客户端失败: %v，服务将继续运行", err)
 	}
 
+	//初始化用户DAO
+	userRepo := userDao.NewUserRepository(db)
+
+	//初始化用户服务
+	userService := userService.NewUserService(userRepo, userDao.NewSessionRepository(db), userDao.NewTwoFactorRepository(db),
+		userDao.NewIdentityRepository(db), userDao.NewRoleRepository(db), userDao.NewExperienceRepository(db), userDao.NewSettingsRepository(db), userDao.NewUsernameHistoryRepository(db), userDao.NewAccountDeletionRepository(db),
+		userDao.NewDataExportRepository(db), userDao.NewPersonalDataRepository(db), userDao.NewAuditLogRepository(db), messageDao.NewNotificationRepository(db), jwtManager, minioClient, kafkaProducer, redisClient, esClient)
+
+	//初始化用户设置客户端
+	settingsClient := settings.NewClient(userDao.NewSettingsRepository(db), redisClient)
+
 	//初始化视频DAO
 	videoRepo := videoDao.NewVideoRepository(db)
+	historyRepo := videoDao.NewWatchHistoryRepository(db)
+
+	//初始化社交DAO
+	followRepo := socialDao.NewFollowRepository(db)
+	blockRepo := socialDao.NewBlockRepository(db)
+	followRequestRepo := socialDao.NewFollowRequestRepository(db)
+	audienceRepo := socialDao.NewAudienceListRepository(db)
+	notificationRepo := messageDao.NewNotificationRepository(db)
 
 	//初始化视频服务
-	videoService := videoService.NewVideoService(videoRepo, minioClient, kafkaProducer, redisClient, esClient)
+	videoService := videoService.NewVideoService(videoRepo, historyRepo, blockRepo, settingsClient, minioClient, kafkaProducer, redisClient, esClient)
+
+	//初始化社交服务
+	socialService := socialService.NewSocialService(followRepo, blockRepo, followRequestRepo, audienceRepo, notificationRepo, userService, settingsClient, kafkaProducer, redisClient)
 
 	//初始化互动DAO
 	likeRepo := dao.NewLikeRepository(db)
 	starRepo := dao.NewStarRepository(db)
+	starFolderRepo := dao.NewStarFolderRepository(db)
 	commentRepo := dao.NewCommentRepository(db)
+	commentLikeRepo := dao.NewCommentLikeRepository(db)
+	commentSettingRepo := dao.NewCommentSettingRepository(db)
+	commentKeywordRepo := dao.NewCommentKeywordRepository(db)
 	shareRepo := dao.NewShareRepository(db)
+	shareLinkRepo := dao.NewShareLinkRepository(db)
 	statsRepo := dao.NewVideoInteractionStatsRepository(db)
 
 	//初始化互动服务
-	interactionService := service.NewInteractionService(likeRepo, starRepo, commentRepo, shareRepo, statsRepo, videoService, kafkaProducer)
+	interactionService := service.NewInteractionService(likeRepo, starRepo, starFolderRepo, commentRepo, commentLikeRepo, commentSettingRepo, commentKeywordRepo, shareRepo, shareLinkRepo, statsRepo, videoService, socialService, settingsClient, kafkaProducer, redisClient)
+
+	//消费账号注销事件，清理注销用户的点赞、收藏和评论
+	go mq.ConsumeUserDeleted(context.Background(), "interaction-user-deleted", interactionService.PurgeUserData)
 
 	//初始化处理器
-	interactionHandler := handler.NewInteractionService(interactionService)
+	interactionHandler := handler.NewInteractionService(interactionService, userService)
 
 	//创建ETCD注册器
 	registry, err := registry_etcd.NewEtcdRegistry(cfg.Etcd.Endpoints)
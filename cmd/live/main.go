@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
 	"shortvideo/internal/live/dao"
 	"shortvideo/internal/live/handler"
 	"shortvideo/internal/live/service"
+	socialrpc "shortvideo/internal/social/rpcclient"
 	live "shortvideo/kitex_gen/live/liveservice"
+	"shortvideo/pkg/cache"
 	"shortvideo/pkg/config"
 	"shortvideo/pkg/database"
 	"shortvideo/pkg/es"
 	"shortvideo/pkg/logger"
+	"shortvideo/pkg/mq"
 	"shortvideo/pkg/prometheus"
 	"shortvideo/pkg/tracing"
 
@@ -33,6 +37,9 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
+	//初始化Redis
+	redisClient := cache.NewRedisCache()
+
 	//初始化直播相关dao
 	roomRepo := dao.NewLiveRoomRepository(db)
 	giftRepo := dao.NewGiftRepository(db)
@@ -74,10 +81,20 @@ func main() {
 		liveRecordRepo,
 		roomViewerRepo,
 		esClient,
+		redisClient,
 	)
 
+	//消费账号注销事件，删除注销用户的直播间和观看记录
+	go mq.ConsumeUserDeleted(context.Background(), "live-user-deleted", liveService.PurgeUserData)
+
+	//初始化社交服务客户端，用于检查直播间的可见名单
+	socialClient, err := socialrpc.New("live")
+	if err != nil {
+		log.Printf("初始化社交服务客户端失败: %v，限定名单的直播间将只对主播可见", err)
+	}
+
 	//初始化处理器
-	liveHandler := handler.NewLiveService(liveService)
+	liveHandler := handler.NewLiveService(liveService, socialClient)
 
 	//创建ETCD注册器
 	registry, err := registry_etcd.NewEtcdRegistry(cfg.Etcd.Endpoints)
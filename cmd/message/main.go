@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"shortvideo/internal/message/dao"
 	"shortvideo/internal/message/handler"
 	"shortvideo/internal/message/service"
+	socialDao "shortvideo/internal/social/dao"
 	userDao "shortvideo/internal/user/dao"
 	userService "shortvideo/internal/user/service"
+	"shortvideo/internal/user/settings"
 	"shortvideo/kitex_gen/message/messageservice"
 	"shortvideo/pkg/cache"
 	"shortvideo/pkg/config"
@@ -51,7 +54,7 @@ func main() {
 	}
 
 	//初始化JWT管理器
-	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.ExpireHours)
+	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.AccessExpireMinutes, cfg.JWT.RefreshExpireHours)
 
 	//初始化Elasticsearch
 	esClient, err := es.NewESManager()
@@ -63,14 +66,26 @@ func main() {
 	userRepo := userDao.NewUserRepository(db)
 
 	//初始化用户服务
-	userService := userService.NewUserService(userRepo, jwtManager, minioClient, kafkaProducer, redisClient, esClient)
+	userService := userService.NewUserService(userRepo, userDao.NewSessionRepository(db), userDao.NewTwoFactorRepository(db),
+		userDao.NewIdentityRepository(db), userDao.NewRoleRepository(db), userDao.NewExperienceRepository(db), userDao.NewSettingsRepository(db), userDao.NewUsernameHistoryRepository(db), userDao.NewAccountDeletionRepository(db),
+		userDao.NewDataExportRepository(db), userDao.NewPersonalDataRepository(db), userDao.NewAuditLogRepository(db), dao.NewNotificationRepository(db), jwtManager, minioClient, kafkaProducer, redisClient, esClient)
 
 	//初始化消息DAO
 	messageRepo := dao.NewMessageRepository(db)
 	notificationRepo := dao.NewNotificationRepository(db)
 
+	//初始化拉黑关系DAO，用于禁止拉黑双方互发消息
+	blockRepo := socialDao.NewBlockRepository(db)
+
+	//初始化关注关系DAO和用户设置客户端，用于检查接收者的私信权限
+	followRepo := socialDao.NewFollowRepository(db)
+	settingsClient := settings.NewClient(userDao.NewSettingsRepository(db), redisClient)
+
 	//初始化消息服务
-	messageService := service.NewMessageService(messageRepo, notificationRepo, userService, kafkaProducer)
+	messageService := service.NewMessageService(messageRepo, notificationRepo, userService, blockRepo, followRepo, settingsClient, kafkaProducer, redisClient)
+
+	//消费账号注销事件，删除注销用户的私信和系统通知
+	go mq.ConsumeUserDeleted(context.Background(), "message-user-deleted", messageService.PurgeUserData)
 
 	//初始化处理器
 	messageHandler := handler.NewMessageService(messageService)
@@ -1,16 +1,20 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
+	"shortvideo/internal/interaction/rpcclient"
 	"shortvideo/internal/recommend/dao"
 	"shortvideo/internal/recommend/handler"
 	"shortvideo/internal/recommend/service"
+	socialDao "shortvideo/internal/social/dao"
 	recommend "shortvideo/kitex_gen/recommend/recommendservice"
 	"shortvideo/pkg/config"
 	"shortvideo/pkg/database"
 	"shortvideo/pkg/logger"
+	"shortvideo/pkg/mq"
 
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
@@ -34,16 +38,31 @@ func main() {
 	actionRepo := dao.NewUserActionRepository(db)
 	videoTagRepo := dao.NewVideoTagRepository(db)
 	preferenceRepo := dao.NewUserPreferenceRepository(db)
+	feedbackRepo := dao.NewUserFeedbackRepository(db)
+	blockRepo := socialDao.NewBlockRepository(db)
+	followRepo := socialDao.NewFollowRepository(db)
 
 	//初始化推送服务
 	recommendService := service.NewRecommendService(
 		actionRepo,
 		videoTagRepo,
 		preferenceRepo,
+		feedbackRepo,
+		blockRepo,
+		followRepo,
 	)
 
+	//消费账号注销事件，清理用户的行为、偏好和负反馈
+	go mq.ConsumeUserDeleted(context.Background(), "recommend-user-deleted", recommendService.PurgeUserData)
+
+	//初始化交互服务客户端，用于填充点赞状态
+	interactionClient, err := rpcclient.New("recommend")
+	if err != nil {
+		log.Printf("初始化交互服务客户端失败: %v，将不返回点赞状态", err)
+	}
+
 	//初始化处理器
-	recommendHandler := handler.NewRecommendService(recommendService)
+	recommendHandler := handler.NewRecommendService(recommendService, interactionClient)
 
 	//创建ETCD注册器
 	registry, err := registry_etcd.NewEtcdRegistry(cfg.Etcd.Endpoints)
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"time"
 
+	messageDao "shortvideo/internal/message/dao"
 	"shortvideo/internal/social/dao"
 	"shortvideo/internal/social/handler"
 	"shortvideo/internal/social/service"
 	userDao "shortvideo/internal/user/dao"
 	userService "shortvideo/internal/user/service"
+	"shortvideo/internal/user/settings"
 	socialservice "shortvideo/kitex_gen/social/socialservice"
 	"shortvideo/pkg/cache"
 	"shortvideo/pkg/config"
@@ -52,7 +56,7 @@ func main() {
 	}
 
 	//初始化JWT管理器
-	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.ExpireHours)
+	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.AccessExpireMinutes, cfg.JWT.RefreshExpireHours)
 
 	//初始化Elasticsearch
 	esClient, err := es.NewESManager()
@@ -64,13 +68,32 @@ func main() {
 	userRepo := userDao.NewUserRepository(db)
 
 	//初始化用户服务
-	userService := userService.NewUserService(userRepo, jwtManager, minioClient, kafkaProducer, redisClient, esClient)
+	userService := userService.NewUserService(userRepo, userDao.NewSessionRepository(db), userDao.NewTwoFactorRepository(db),
+		userDao.NewIdentityRepository(db), userDao.NewRoleRepository(db), userDao.NewExperienceRepository(db), userDao.NewSettingsRepository(db), userDao.NewUsernameHistoryRepository(db), userDao.NewAccountDeletionRepository(db),
+		userDao.NewDataExportRepository(db), userDao.NewPersonalDataRepository(db), userDao.NewAuditLogRepository(db), messageDao.NewNotificationRepository(db), jwtManager, minioClient, kafkaProducer, redisClient, esClient)
 
 	//初始化社交DAO
 	followRepo := dao.NewFollowRepository(db)
+	blockRepo := dao.NewBlockRepository(db)
+	followRequestRepo := dao.NewFollowRequestRepository(db)
+	audienceRepo := dao.NewAudienceListRepository(db)
+
+	//初始化通知DAO，用于发送关注请求通知
+	notificationRepo := messageDao.NewNotificationRepository(db)
+
+	//初始化用户设置客户端，用于判断关注和粉丝列表是否可见
+	settingsClient := settings.NewClient(userDao.NewSettingsRepository(db), redisClient)
 
 	//初始化社交服务
-	socialService := service.NewSocialService(followRepo, userService, kafkaProducer)
+	socialService := service.NewSocialService(followRepo, blockRepo, followRequestRepo, audienceRepo, notificationRepo, userService, settingsClient, kafkaProducer, redisClient)
+
+	//消费账号注销事件，清理注销用户的关注、拉黑和可见名单
+	go mq.ConsumeUserDeleted(context.Background(), "social-user-deleted", socialService.PurgeUserData)
+
+	//启动时及之后定期根据关注表修复关注数和粉丝数
+	if cfg.Social.CounterRepairHours > 0 {
+		go repairFollowCounts(socialService, time.Duration(cfg.Social.CounterRepairHours)*time.Hour)
+	}
 
 	//初始化处理器
 	socialHandler := handler.NewSocialService(socialService, userService)
@@ -100,3 +123,15 @@ func main() {
 		log.Println(err.Error())
 	}
 }
+
+func repairFollowCounts(socialService service.SocialService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := socialService.RepairFollowCounts(context.Background()); err != nil {
+			log.Printf("修复关注计数失败: %v", err)
+		}
+		<-ticker.C
+	}
+}
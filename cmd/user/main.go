@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	messageDao "shortvideo/internal/message/dao"
 	"shortvideo/internal/user/dao"
 	"shortvideo/internal/user/handler"
 	"shortvideo/internal/user/service"
@@ -16,6 +18,7 @@ import (
 	"shortvideo/pkg/prometheus"
 	"shortvideo/pkg/storage"
 	"shortvideo/pkg/tracing"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
@@ -51,7 +54,7 @@ func main() {
 	}
 
 	//初始化JWT管理器
-	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.ExpireHours)
+	jwtManager := jwt.NewJWTManagerWithConfig(cfg.JWT.Secret, cfg.JWT.AccessExpireMinutes, cfg.JWT.RefreshExpireHours)
 
 	//初始化Elasticsearch
 	esClient, err := es.NewESManager()
@@ -79,9 +82,44 @@ func main() {
 
 	//初始化用户DAO
 	userRepo := dao.NewUserRepository(db)
+	sessionRepo := dao.NewSessionRepository(db)
+	twoFactorRepo := dao.NewTwoFactorRepository(db)
+	identityRepo := dao.NewIdentityRepository(db)
+	roleRepo := dao.NewRoleRepository(db)
+	experienceRepo := dao.NewExperienceRepository(db)
+	settingsRepo := dao.NewSettingsRepository(db)
+	usernameRepo := dao.NewUsernameHistoryRepository(db)
+	deletionRepo := dao.NewAccountDeletionRepository(db)
+	exportRepo := dao.NewDataExportRepository(db)
+	personalDataRepo := dao.NewPersonalDataRepository(db)
+	auditRepo := dao.NewAuditLogRepository(db)
+
+	//安全提醒写入消息服务的系统通知
+	notificationRepo := messageDao.NewNotificationRepository(db)
 
 	//初始化用户服务
-	userService := service.NewUserService(userRepo, jwtManager, minioClient, kafkaProducer, redisClient, esClient)
+	userService := service.NewUserService(userRepo, sessionRepo, twoFactorRepo, identityRepo, roleRepo, experienceRepo, settingsRepo, usernameRepo, deletionRepo, exportRepo,
+		personalDataRepo, auditRepo, notificationRepo, jwtManager, minioClient, kafkaProducer, redisClient, esClient)
+
+	//初始化管理员角色
+	if err := userService.EnsureAdmins(context.Background(), cfg.RBAC.AdminUsernames); err != nil {
+		log.Printf("初始化管理员角色失败: %v", err)
+	}
+
+	//导出文件包含个人数据，存放在私有桶中，只能通过预签名链接下载
+	if err := minioClient.CreatePrivateBucket(context.Background(), cfg.Account.ExportBucket); err != nil {
+		log.Printf("创建导出文件桶失败: %v", err)
+	}
+
+	//定时执行到期的账号注销和个人数据导出
+	if cfg.Account.JobIntervalMinutes > 0 {
+		go runAccountJobs(userService, time.Duration(cfg.Account.JobIntervalMinutes)*time.Minute)
+	}
+
+	//消费用户行为事件，发放经验值
+	go mq.ConsumeUserActivity(context.Background(), "user-activity-experience", func(ctx context.Context, event *mq.UserActivityEvent) error {
+		return userService.AwardExperience(ctx, event.UserID, event.Source, event.RefID, event.OccurredAt)
+	})
 
 	//初始化处理器
 	userHandler := handler.NewUserService(userService)
@@ -111,3 +149,18 @@ func main() {
 		log.Println(err.Error())
 	}
 }
+
+func runAccountJobs(userService service.UserService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := userService.ProcessDueDeletions(context.Background()); err != nil {
+			log.Printf("执行账号注销失败: %v", err)
+		}
+		if _, err := userService.ProcessDataExports(context.Background()); err != nil {
+			log.Printf("处理数据导出失败: %v", err)
+		}
+		<-ticker.C
+	}
+}
@@ -1,8 +1,15 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"shortvideo/internal/interaction/rpcclient"
+	recommendrpc "shortvideo/internal/recommend/rpcclient"
+	socialDao "shortvideo/internal/social/dao"
+	socialrpc "shortvideo/internal/social/rpcclient"
+	userDao "shortvideo/internal/user/dao"
+	"shortvideo/internal/user/settings"
 	"shortvideo/internal/video/dao"
 	"shortvideo/internal/video/handler"
 	"shortvideo/internal/video/service"
@@ -75,12 +82,40 @@ func main() {
 
 	//初始化视频DAO
 	videoRepo := dao.NewVideoRepository(db)
+	historyRepo := dao.NewWatchHistoryRepository(db)
+
+	//初始化用户设置客户端，用于读取作者的二次创作设置
+	settingsClient := settings.NewClient(userDao.NewSettingsRepository(db), redisClient)
+
+	//拉黑和静音的作者在视频流、搜索和作者主页中直接从查询中排除
+	blockRepo := socialDao.NewBlockRepository(db)
 
 	//初始化视频服务
-	videoService := service.NewVideoService(videoRepo, minioClient, kafkaProducer, redisClient, esClient)
+	videoService := service.NewVideoService(videoRepo, historyRepo, blockRepo, settingsClient, minioClient, kafkaProducer, redisClient, esClient)
+
+	//消费账号注销事件，删除注销用户的视频和观看历史
+	go mq.ConsumeUserDeleted(context.Background(), "video-user-deleted", videoService.PurgeUserData)
+
+	//初始化交互服务客户端，用于填充点赞状态
+	interactionClient, err := rpcclient.New("video")
+	if err != nil {
+		log.Printf("初始化交互服务客户端失败: %v，将不返回点赞状态", err)
+	}
+
+	//初始化推荐服务客户端，用于过滤用户屏蔽的内容
+	recommendClient, err := recommendrpc.New("video")
+	if err != nil {
+		log.Printf("初始化推荐服务客户端失败: %v，将不过滤屏蔽内容", err)
+	}
+
+	//初始化社交服务客户端，用于检查私密账号和可见名单的访问权限
+	socialClient, err := socialrpc.New("video")
+	if err != nil {
+		log.Printf("初始化社交服务客户端失败: %v，将不检查私密账号权限，限定名单的视频只对作者可见", err)
+	}
 
 	//初始化处理器
-	videoHandler := handler.NewVideoService(videoService)
+	videoHandler := handler.NewVideoService(videoService, interactionClient, recommendClient, socialClient)
 
 	//创建ETCD注册器
 	registry, err := registry_etcd.NewEtcdRegistry(cfg.Etcd.Endpoints)
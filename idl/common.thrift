@@ -14,6 +14,8 @@ struct User{
     6:i64 followCount
     7:i64 followerCount
     8:bool isFollow
+    9:optional bool isPrivate
+    10:i32 level
 }
 
 struct Video{
@@ -27,6 +29,10 @@ struct Video{
     8:string title
     9:i64 publishTime
     10:string description
+    11:optional string myReaction
+    12:optional i64 audienceListId
+    13:optional bool allowRemix
+    14:optional i64 remixOfVideoId
 }
 
 struct Comment{
@@ -36,6 +42,17 @@ struct Comment{
     4:string content
     5:string createTime
     6:i64 replyToId
+    7:i64 rootId
+    8:optional i64 replyToUserId
+    9:optional string replyToUsername
+    10:i64 replyCount
+    11:bool isDeleted
+    12:i64 likeCount
+    13:bool isLiked
+    14:bool isPinned
+    15:bool authorLiked
+    16:i32 status
+    17:i32 userLevel
 }
 
 struct Message{
@@ -45,6 +62,7 @@ struct Message{
     4:string content
     5:string createTime
     6:bool isRead
+    7:bool pending
 }
 
 struct LiveRoom{
@@ -57,6 +75,7 @@ struct LiveRoom{
     7:i64 viewerCount
     8:bool isLive
     9:string createTime
+    10:optional i64 audienceListId
 }
 
 struct Danmu{
@@ -66,4 +85,5 @@ struct Danmu{
     4:string content
     5:string color
     6:string createTime
+    7:i32 userLevel
 }
\ No newline at end of file
@@ -14,6 +14,8 @@ struct SendDanmuReq{
 struct SendDanmuResp{
     1:common.BaseResp BaseResp
     2:i64 danmuId
+    3:optional string content
+    4:optional i32 userLevel
 }
 
 struct GetDanmuHistoryReq{
@@ -21,6 +23,7 @@ struct GetDanmuHistoryReq{
     2:i64 startTime
     3:i64 endTime
     4:i32 limit
+    5:optional i64 currentUserId
 }
 
 struct GetDanmuHistoryResp{
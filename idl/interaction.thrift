@@ -17,18 +17,23 @@ struct LikeVideoListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct LikeVideoListResp{
     1:common.BaseResp BaseResp
     2:list<common.Video> videos
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct StarActionReq{
     1:i64 userId
     2:i64 videoId
     3:bool action
+    4:optional list<i64> folderIds
 }
 
 struct StarActionResp{
@@ -40,12 +45,111 @@ struct StarVideoListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional i64 folderId
+    6:optional string cursor
+    7:optional bool needTotal
 }
 
 struct StarVideoListResp{
     1:common.BaseResp BaseResp
     2:list<common.Video> videos
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
+}
+
+struct StarFolder{
+    1:i64 id
+    2:i64 userId
+    3:string name
+    4:bool isPublic
+    5:i64 videoCount
+    6:i64 followerCount
+    7:bool isFollowed
+    8:i64 createdAt
+}
+
+struct CreateStarFolderReq{
+    1:i64 userId
+    2:string name
+    3:bool isPublic
+}
+
+struct CreateStarFolderResp{
+    1:common.BaseResp BaseResp
+    2:StarFolder folder
+}
+
+struct UpdateStarFolderReq{
+    1:i64 userId
+    2:i64 folderId
+    3:optional string name
+    4:optional bool isPublic
+}
+
+struct UpdateStarFolderResp{
+    1:common.BaseResp BaseResp
+    2:StarFolder folder
+}
+
+struct DeleteStarFolderReq{
+    1:i64 userId
+    2:i64 folderId
+}
+
+struct DeleteStarFolderResp{
+    1:common.BaseResp BaseResp
+}
+
+struct StarFolderListReq{
+    1:i64 userId
+    2:i64 currentUserId
+}
+
+struct StarFolderListResp{
+    1:common.BaseResp BaseResp
+    2:list<StarFolder> folders
+}
+
+struct StarFolderItemActionReq{
+    1:i64 userId
+    2:i64 folderId
+    3:i64 videoId
+    4:bool action
+}
+
+struct StarFolderItemActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct MoveStarVideoReq{
+    1:i64 userId
+    2:i64 videoId
+    3:i64 fromFolderId
+    4:i64 toFolderId
+}
+
+struct MoveStarVideoResp{
+    1:common.BaseResp BaseResp
+}
+
+struct StarFolderFollowReq{
+    1:i64 userId
+    2:i64 folderId
+    3:bool action
+}
+
+struct StarFolderFollowResp{
+    1:common.BaseResp BaseResp
+}
+
+struct FollowedStarFolderListReq{
+    1:i64 userId
+}
+
+struct FollowedStarFolderListResp{
+    1:common.BaseResp BaseResp
+    2:list<StarFolder> folders
 }
 
 struct CommentActionReq{
@@ -65,12 +169,35 @@ struct CommentListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional string cursor
+    6:optional bool needTotal
+    7:optional string sort
 }
 
 struct CommentListResp{
     1:common.BaseResp BaseResp
     2:list<common.Comment> comments
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
+    6:map<i64, list<common.Comment>> replyPreviews
+    7:optional common.Comment pinnedComment
+}
+
+struct CommentRepliesReq{
+    1:i64 commentId
+    2:i64 currentUserId
+    3:i32 pageSize
+    4:optional string cursor
+    5:optional bool needTotal
+}
+
+struct CommentRepliesResp{
+    1:common.BaseResp BaseResp
+    2:list<common.Comment> comments
+    3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct DeleteCommentReq{
@@ -83,15 +210,163 @@ struct DeleteCommentResp{
     1:common.BaseResp BaseResp
 }
 
+struct CommentLikeActionReq{
+    1:i64 userId
+    2:i64 commentId
+    3:bool action
+}
+
+struct CommentLikeActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct PinCommentReq{
+    1:i64 userId
+    2:i64 videoId
+    3:i64 commentId
+    4:bool action
+}
+
+struct PinCommentResp{
+    1:common.BaseResp BaseResp
+}
+
+struct SetCommentPermissionReq{
+    1:i64 userId
+    2:i64 videoId
+    3:string permission
+}
+
+struct SetCommentPermissionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct GetCommentPermissionReq{
+    1:i64 videoId
+}
+
+struct GetCommentPermissionResp{
+    1:common.BaseResp BaseResp
+    2:string permission
+}
+
+struct CommentKeywordActionReq{
+    1:i64 userId
+    2:string keyword
+    3:bool action
+}
+
+struct CommentKeywordActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct CommentKeywordListReq{
+    1:i64 userId
+}
+
+struct CommentKeywordListResp{
+    1:common.BaseResp BaseResp
+    2:list<string> keywords
+}
+
+struct PendingCommentListReq{
+    1:i64 userId
+    2:i32 pageSize
+    3:optional string cursor
+    4:optional bool moderation
+}
+
+struct PendingCommentListResp{
+    1:common.BaseResp BaseResp
+    2:list<common.Comment> comments
+    3:optional string nextCursor
+    4:bool hasMore
+}
+
+struct ReviewCommentReq{
+    1:i64 userId
+    2:i64 commentId
+    3:bool approve
+}
+
+struct ReviewCommentResp{
+    1:common.BaseResp BaseResp
+}
+
 struct ShareActionReq{
     1:i64 userId
     2:i64 videoId
+    3:optional string channel
 }
 
 struct ShareActionResp{
     1:common.BaseResp BaseResp
 }
 
+struct CreateShareLinkReq{
+    1:i64 userId
+    2:i64 videoId
+    3:string channel
+}
+
+struct CreateShareLinkResp{
+    1:common.BaseResp BaseResp
+    2:string code
+    3:string channel
+}
+
+struct ResolveShareLinkReq{
+    1:string code
+    2:i64 visitorId
+}
+
+struct ResolveShareLinkResp{
+    1:common.BaseResp BaseResp
+    2:i64 videoId
+    3:i64 sharerId
+    4:string channel
+}
+
+struct ShareConversionReq{
+    1:string code
+    2:i64 visitorId
+    3:string event
+}
+
+struct ShareConversionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct ShareChannelStats{
+    1:string channel
+    2:i64 shareCount
+    3:i64 clickCount
+    4:i64 viewCount
+    5:i64 installCount
+}
+
+struct ShareStatsReq{
+    1:i64 userId
+    2:i64 videoId
+}
+
+struct ShareStatsResp{
+    1:common.BaseResp BaseResp
+    2:list<ShareChannelStats> channels
+}
+
+struct InvitedByReq{
+    1:i64 userId
+}
+
+struct InvitedByResp{
+    1:common.BaseResp BaseResp
+    2:optional common.User inviter
+    3:i64 videoId
+    4:string channel
+    5:i64 invitedAt
+}
+
 struct CountReq{
     1:i64 videoId
 }
@@ -102,6 +377,47 @@ struct CountResp{
     3:i64 commentCount
     4:i64 starCount
     5:i64 shareCount
+    6:map<string, i64> reactionCounts
+}
+
+struct ReactionActionReq{
+    1:i64 userId
+    2:i64 videoId
+    3:string reaction
+}
+
+struct ReactionActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct BatchGetReactionsReq{
+    1:i64 userId
+    2:list<i64> videoIds
+}
+
+struct BatchGetReactionsResp{
+    1:common.BaseResp BaseResp
+    2:map<i64, string> reactions
+}
+
+struct Reactor{
+    1:common.User user
+    2:string reaction
+    3:i64 reactedAt
+}
+
+struct ReactionUserListReq{
+    1:i64 videoId
+    2:optional string reaction
+    3:i32 pageSize
+    4:optional string cursor
+}
+
+struct ReactionUserListResp{
+    1:common.BaseResp BaseResp
+    2:list<Reactor> users
+    3:optional string nextCursor
+    4:bool hasMore
 }
 
 struct CheckLikeStatusReq{
@@ -114,6 +430,23 @@ struct CheckLikeStatusResp{
     2:bool isLiked
 }
 
+struct InteractionStatus{
+    1:bool isLiked
+    2:optional string reaction
+    3:bool isStarred
+    4:bool isFollowingAuthor
+}
+
+struct BatchCheckInteractionStatusReq{
+    1:i64 userId
+    2:list<i64> videoIds
+}
+
+struct BatchCheckInteractionStatusResp{
+    1:common.BaseResp BaseResp
+    2:map<i64, InteractionStatus> statuses
+}
+
 struct CheckStarStatusReq{
     1:i64 userId
     2:i64 videoId
@@ -129,11 +462,37 @@ service InteractionService{
     LikeVideoListResp GetLikeVideoList(1:LikeVideoListReq req)
     StarActionResp StarAction(1:StarActionReq req)
     StarVideoListResp GetStarVideoList(1:StarVideoListReq req)
+    CreateStarFolderResp CreateStarFolder(1:CreateStarFolderReq req)
+    UpdateStarFolderResp UpdateStarFolder(1:UpdateStarFolderReq req)
+    DeleteStarFolderResp DeleteStarFolder(1:DeleteStarFolderReq req)
+    StarFolderListResp GetStarFolders(1:StarFolderListReq req)
+    StarFolderItemActionResp StarFolderItemAction(1:StarFolderItemActionReq req)
+    MoveStarVideoResp MoveStarVideo(1:MoveStarVideoReq req)
+    StarFolderFollowResp StarFolderFollowAction(1:StarFolderFollowReq req)
+    FollowedStarFolderListResp GetFollowedStarFolders(1:FollowedStarFolderListReq req)
     CommentActionResp CommentAction(1:CommentActionReq req)
     CommentListResp GetCommentList(1:CommentListReq req)
+    CommentRepliesResp GetCommentReplies(1:CommentRepliesReq req)
     DeleteCommentResp DeleteComment(1:DeleteCommentReq req)
+    CommentLikeActionResp CommentLikeAction(1:CommentLikeActionReq req)
+    PinCommentResp PinComment(1:PinCommentReq req)
+    SetCommentPermissionResp SetCommentPermission(1:SetCommentPermissionReq req)
+    GetCommentPermissionResp GetCommentPermission(1:GetCommentPermissionReq req)
+    CommentKeywordActionResp CommentKeywordAction(1:CommentKeywordActionReq req)
+    CommentKeywordListResp GetCommentKeywords(1:CommentKeywordListReq req)
+    PendingCommentListResp GetPendingComments(1:PendingCommentListReq req)
+    ReviewCommentResp ReviewComment(1:ReviewCommentReq req)
     ShareActionResp ShareAction(1:ShareActionReq req)
+    CreateShareLinkResp CreateShareLink(1:CreateShareLinkReq req)
+    ResolveShareLinkResp ResolveShareLink(1:ResolveShareLinkReq req)
+    ShareConversionResp RecordShareConversion(1:ShareConversionReq req)
+    ShareStatsResp GetShareStats(1:ShareStatsReq req)
+    InvitedByResp GetInvitedBy(1:InvitedByReq req)
     CountResp GetCount(1:CountReq req)
     CheckLikeStatusResp CheckLikeStatus(1:CheckLikeStatusReq req)
+    ReactionActionResp ReactionAction(1:ReactionActionReq req)
+    BatchGetReactionsResp BatchGetReactions(1:BatchGetReactionsReq req)
+    ReactionUserListResp GetReactionUsers(1:ReactionUserListReq req)
     CheckStarStatusResp CheckStarStatus(1:CheckStarStatusReq req)
+    BatchCheckInteractionStatusResp BatchCheckInteractionStatus(1:BatchCheckInteractionStatusReq req)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ struct CreateLiveRoomReq{
     2:string title
     3:string coverUrl
     4:optional string description
+    5:optional i64 audienceListId
 }
 
 struct CreateLiveRoomResp{
@@ -38,12 +39,16 @@ struct GetLiveRoomsReq{
     2:i32 page
     3:i32 pageSize
     4:optional bool followingOnly
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct GetLiveRoomsResp{
     1:common.BaseResp BaseResp
     2:list<common.LiveRoom> rooms
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct GetLiveRoomDetailReq{
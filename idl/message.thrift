@@ -11,6 +11,8 @@ struct SendMessageReq{
 struct SendMessageResp{
     1:common.BaseResp BaseResp
     2:i64 messageId
+    3:optional string content
+    4:bool pending
 }
 
 struct GetChatHistoryReq{
@@ -18,12 +20,15 @@ struct GetChatHistoryReq{
     2:i64 userId2
     3:i64 lastMessageId
     4:i32 pageSize
+    5:optional string cursor
 }
 
 struct GetChatHistoryResp{
     1:common.BaseResp BaseResp
     2:list<common.Message> messages
     3:i64 nextMessageId
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct GetLatestMessagesReq{
@@ -85,12 +90,16 @@ struct GetNotificationsReq{
     2:i32 page
     3:i32 pageSize
     4:optional i32 type
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct GetNotificationsResp{
     1:common.BaseResp BaseResp
     2:list<SystemNotification> notifications
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct MarkNotificationReadReq{
@@ -102,6 +111,28 @@ struct MarkNotificationReadResp{
     1:common.BaseResp BaseResp
 }
 
+struct GetPendingMessagesReq{
+    1:i32 pageSize
+    2:optional string cursor
+}
+
+struct GetPendingMessagesResp{
+    1:common.BaseResp BaseResp
+    2:list<common.Message> messages
+    3:optional string nextCursor
+    4:bool hasMore
+}
+
+struct ReviewMessageReq{
+    1:i64 messageId
+    2:bool approve
+}
+
+struct ReviewMessageResp{
+    1:common.BaseResp BaseResp
+    2:optional common.Message message
+}
+
 service MessageService{
     SendMessageResp SendMessage(1:SendMessageReq req)
     GetChatHistoryResp GetChatHistory(1:GetChatHistoryReq req)
@@ -111,4 +142,6 @@ service MessageService{
     GetUnreadCountResp GetUnreadCount(1:GetUnreadCountReq req)
     GetNotificationsResp GetNotifications(1:GetNotificationsReq req)
     MarkNotificationReadResp MarkNotificationRead(1:MarkNotificationReadReq req)
+    GetPendingMessagesResp GetPendingMessages(1:GetPendingMessagesReq req)
+    ReviewMessageResp ReviewMessage(1:ReviewMessageReq req)
 }
\ No newline at end of file
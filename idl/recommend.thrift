@@ -76,6 +76,60 @@ struct GetPersonalizedFeedResp{
     3:i64 nextLastVideoId
 }
 
+struct FeedbackItem{
+    1:i64 id
+    2:string reason
+    3:string targetType
+    4:i64 targetId
+    5:string tagName
+    6:i64 createdAt
+}
+
+struct SubmitFeedbackReq{
+    1:i64 userId
+    2:string reason
+    3:optional i64 videoId
+    4:optional i64 authorId
+    5:optional string tag
+}
+
+struct SubmitFeedbackResp{
+    1:common.BaseResp BaseResp
+    2:i64 feedbackId
+}
+
+struct GetFeedbackListReq{
+    1:i64 userId
+    2:optional string targetType
+    3:i32 page
+    4:i32 pageSize
+}
+
+struct GetFeedbackListResp{
+    1:common.BaseResp BaseResp
+    2:list<FeedbackItem> feedbacks
+    3:i64 total
+}
+
+struct UndoFeedbackReq{
+    1:i64 userId
+    2:i64 feedbackId
+}
+
+struct UndoFeedbackResp{
+    1:common.BaseResp BaseResp
+}
+
+struct FilterHiddenVideosReq{
+    1:i64 userId
+    2:list<common.Video> videos
+}
+
+struct FilterHiddenVideosResp{
+    1:common.BaseResp BaseResp
+    2:list<i64> videoIds
+}
+
 service RecommendService{
     GetRecommendVideosResp GetRecommendVideos(1:GetRecommendVideosReq req)
     GetRecommendUsersResp GetRecommendUsers(1:GetRecommendUsersReq req)
@@ -83,4 +137,8 @@ service RecommendService{
     GetHotTagsResp GetHotTags(1:GetHotTagsReq req)
     GetTagVideosResp GetTagVideos(1:GetTagVideosReq req)
     GetPersonalizedFeedResp GetPersonalizedFeed(1:GetPersonalizedFeedReq req)
+    SubmitFeedbackResp SubmitFeedback(1:SubmitFeedbackReq req)
+    GetFeedbackListResp GetFeedbackList(1:GetFeedbackListReq req)
+    UndoFeedbackResp UndoFeedback(1:UndoFeedbackReq req)
+    FilterHiddenVideosResp FilterHiddenVideos(1:FilterHiddenVideosReq req)
 }
\ No newline at end of file
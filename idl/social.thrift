@@ -10,6 +10,7 @@ struct FollowActionReq{
 
 struct FollowActionResp{
     1:common.BaseResp BaseResp
+    2:bool isPending
 }
 
 struct FollowListReq{
@@ -17,12 +18,16 @@ struct FollowListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct FollowListResp{
     1:common.BaseResp BaseResp
     2:list<common.User> users
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct FollowerListReq{
@@ -30,24 +35,32 @@ struct FollowerListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct FollowerListResp{
     1:common.BaseResp BaseResp
     2:list<common.User> users
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct FriendListReq{
     1:i64 userId
     2:i32 page
     3:i32 pageSize
+    4:optional string cursor
+    5:optional bool needTotal
 }
 
 struct FriendListResp{
     1:common.BaseResp BaseResp
     2:list<common.User> users
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct CheckFollowReq{
@@ -96,6 +109,200 @@ struct BatchCheckFollowResp{
     2:map<i64,bool> followStatus
 }
 
+struct BlockActionReq{
+    1:i64 userId
+    2:i64 targetUserId
+    3:bool action
+}
+
+struct BlockActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct MuteActionReq{
+    1:i64 userId
+    2:i64 targetUserId
+    3:bool action
+}
+
+struct MuteActionResp{
+    1:common.BaseResp BaseResp
+}
+
+struct BlockListReq{
+    1:i64 userId
+    2:i8 blockType
+    3:i32 page
+    4:i32 pageSize
+}
+
+struct BlockListResp{
+    1:common.BaseResp BaseResp
+    2:list<common.User> users
+    3:i32 totalCount
+}
+
+struct CheckBlockReq{
+    1:i64 userId
+    2:i64 targetUserId
+}
+
+struct CheckBlockResp{
+    1:common.BaseResp BaseResp
+    2:bool isBlocked
+    3:bool isBlockedBy
+    4:bool isMuted
+}
+
+struct FollowRequestItem{
+    1:i64 id
+    2:i64 userId
+    3:i64 targetUserId
+    4:string createTime
+    5:optional common.User user
+}
+
+struct HandleFollowRequestReq{
+    1:i64 userId
+    2:i64 requesterId
+    3:bool approve
+}
+
+struct HandleFollowRequestResp{
+    1:common.BaseResp BaseResp
+}
+
+struct CancelFollowRequestReq{
+    1:i64 userId
+    2:i64 targetUserId
+}
+
+struct CancelFollowRequestResp{
+    1:common.BaseResp BaseResp
+}
+
+struct FollowRequestListReq{
+    1:i64 userId
+    2:bool incoming
+    3:i32 page
+    4:i32 pageSize
+}
+
+struct FollowRequestListResp{
+    1:common.BaseResp BaseResp
+    2:list<FollowRequestItem> requests
+    3:i32 totalCount
+}
+
+struct SetAccountPrivacyReq{
+    1:i64 userId
+    2:bool isPrivate
+}
+
+struct SetAccountPrivacyResp{
+    1:common.BaseResp BaseResp
+}
+
+struct CheckContentAccessReq{
+    1:i64 viewerId
+    2:i64 ownerId
+}
+
+struct CheckContentAccessResp{
+    1:common.BaseResp BaseResp
+    2:bool canView
+}
+
+struct AudienceList{
+    1:i64 id
+    2:string name
+    3:bool isCloseFriends
+    4:i64 memberCount
+    5:string createTime
+}
+
+struct CreateAudienceListReq{
+    1:i64 userId
+    2:string name
+}
+
+struct CreateAudienceListResp{
+    1:common.BaseResp BaseResp
+    2:AudienceList list
+}
+
+struct RenameAudienceListReq{
+    1:i64 userId
+    2:i64 listId
+    3:string name
+}
+
+struct RenameAudienceListResp{
+    1:common.BaseResp BaseResp
+}
+
+struct DeleteAudienceListReq{
+    1:i64 userId
+    2:i64 listId
+}
+
+struct DeleteAudienceListResp{
+    1:common.BaseResp BaseResp
+}
+
+struct AudienceListsReq{
+    1:i64 userId
+}
+
+struct AudienceListsResp{
+    1:common.BaseResp BaseResp
+    2:list<AudienceList> lists
+}
+
+struct UpdateAudienceMembersReq{
+    1:i64 userId
+    2:i64 listId
+    3:list<i64> memberIds
+    4:bool add
+}
+
+struct UpdateAudienceMembersResp{
+    1:common.BaseResp BaseResp
+    2:i64 changedCount
+}
+
+struct AudienceMembersReq{
+    1:i64 userId
+    2:i64 listId
+    3:i32 page
+    4:i32 pageSize
+}
+
+struct AudienceMembersResp{
+    1:common.BaseResp BaseResp
+    2:list<common.User> users
+    3:i32 totalCount
+}
+
+struct ValidateAudienceListReq{
+    1:i64 userId
+    2:i64 listId
+}
+
+struct ValidateAudienceListResp{
+    1:common.BaseResp BaseResp
+}
+
+struct BatchCheckAudienceReq{
+    1:i64 viewerId
+    2:list<i64> listIds
+}
+
+struct BatchCheckAudienceResp{
+    1:common.BaseResp BaseResp
+    2:list<i64> memberListIds
+}
+
 service SocialService{
     FollowActionResp FollowAction(1:FollowActionReq req)
     FollowListResp GetFollowList(1:FollowListReq req)
@@ -105,4 +312,21 @@ service SocialService{
     CheckMutualFollowResp CheckMutualFollow(1:CheckMutualFollowReq req)
     FollowStatsResp GetFollowStats(1:FollowStatsReq req)
     BatchCheckFollowResp BatchCheckFollow(1:BatchCheckFollowReq req)
+    BlockActionResp BlockAction(1:BlockActionReq req)
+    MuteActionResp MuteAction(1:MuteActionReq req)
+    BlockListResp GetBlockList(1:BlockListReq req)
+    CheckBlockResp CheckBlock(1:CheckBlockReq req)
+    HandleFollowRequestResp HandleFollowRequest(1:HandleFollowRequestReq req)
+    CancelFollowRequestResp CancelFollowRequest(1:CancelFollowRequestReq req)
+    FollowRequestListResp GetFollowRequests(1:FollowRequestListReq req)
+    SetAccountPrivacyResp SetAccountPrivacy(1:SetAccountPrivacyReq req)
+    CheckContentAccessResp CheckContentAccess(1:CheckContentAccessReq req)
+    CreateAudienceListResp CreateAudienceList(1:CreateAudienceListReq req)
+    RenameAudienceListResp RenameAudienceList(1:RenameAudienceListReq req)
+    DeleteAudienceListResp DeleteAudienceList(1:DeleteAudienceListReq req)
+    AudienceListsResp GetAudienceLists(1:AudienceListsReq req)
+    UpdateAudienceMembersResp UpdateAudienceMembers(1:UpdateAudienceMembersReq req)
+    AudienceMembersResp GetAudienceMembers(1:AudienceMembersReq req)
+    ValidateAudienceListResp ValidateAudienceList(1:ValidateAudienceListReq req)
+    BatchCheckAudienceResp BatchCheckAudience(1:BatchCheckAudienceReq req)
 }
\ No newline at end of file
@@ -2,22 +2,41 @@ namespace go user
 
 include "common.thrift"
 
+struct DeviceInfo{
+    1:string deviceId
+    2:string deviceName
+    3:string platform
+    4:string ip
+    5:string userAgent
+}
+
 struct RegisterReq{
     1:string username
     2:string password
     3:optional string avatar
     4:optional string about
+    5:optional DeviceInfo device
+    6:optional string captchaToken
 }
 
 struct LoginReq{
     1:string username
     2:string password
+    3:optional DeviceInfo device
+    4:optional string captchaToken
 }
 
 struct LoginRegisterResp{
     1:common.BaseResp BaseResp
     2:common.User user
     3:string token
+    4:string refreshToken
+    5:i64 expiresAt
+    6:bool twoFactorRequired
+    7:string challengeToken
+    8:i64 challengeExpiresAt
+    9:bool registered
+    10:bool identityLinked
 }
 
 struct UserInfoReq{
@@ -46,6 +65,7 @@ struct UpdateUserReq{
     3:optional string about
     4:optional string oldPassword
     5:optional string newPassword
+    6:optional string twoFactorCode
 }
 
 struct CheckUsernameReq{
@@ -96,12 +116,16 @@ struct SearchUsersReq{
     1:string keyword
     2:i32 page
     3:i32 pageSize
+    4:optional string cursor
+    5:optional bool needTotal
 }
 
 struct SearchUsersResp{
     1:common.BaseResp BaseResp
     2:list<common.User> users
     3:i64 total
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct UpdateFollowCountReq{
@@ -114,6 +138,272 @@ struct UpdateFollowerCountReq{
     2:i64 delta
 }
 
+struct VerifyTokenResp{
+    1:common.BaseResp BaseResp
+    2:i64 userId
+    3:string sessionId
+    4:list<string> roles
+}
+
+struct RefreshTokenReq{
+    1:string refreshToken
+}
+
+struct RefreshTokenResp{
+    1:common.BaseResp BaseResp
+    2:string token
+    3:string refreshToken
+    4:i64 expiresAt
+}
+
+struct LogoutReq{
+    1:string token
+}
+
+struct LogoutAllReq{
+    1:i64 userId
+}
+
+struct UserSession{
+    1:string sessionId
+    2:string deviceName
+    3:string platform
+    4:string ip
+    5:string userAgent
+    6:string createTime
+    7:string lastSeenTime
+    8:bool isCurrent
+}
+
+struct SessionListReq{
+    1:i64 userId
+    2:string currentSessionId
+}
+
+struct SessionListResp{
+    1:common.BaseResp BaseResp
+    2:list<UserSession> sessions
+}
+
+struct RevokeSessionReq{
+    1:i64 userId
+    2:string sessionId
+}
+
+struct TwoFactorLoginReq{
+    1:string challengeToken
+    2:string code
+}
+
+struct TwoFactorSetupReq{
+    1:i64 userId
+}
+
+struct TwoFactorSetupResp{
+    1:common.BaseResp BaseResp
+    2:string secret
+    3:string otpauthUri
+}
+
+struct TwoFactorCodeReq{
+    1:i64 userId
+    2:string code
+}
+
+struct RecoveryCodesResp{
+    1:common.BaseResp BaseResp
+    2:list<string> recoveryCodes
+}
+
+struct TwoFactorStatusReq{
+    1:i64 userId
+}
+
+struct TwoFactorStatusResp{
+    1:common.BaseResp BaseResp
+    2:bool enabled
+    3:i64 remainingRecoveryCodes
+}
+
+struct OAuthStartReq{
+    1:string provider
+    2:i64 userId
+    3:string browserBinding
+}
+
+struct OAuthStartResp{
+    1:common.BaseResp BaseResp
+    2:string authUrl
+}
+
+struct OAuthCallbackReq{
+    1:string provider
+    2:string state
+    3:string code
+    4:optional DeviceInfo device
+    5:string browserBinding
+}
+
+struct Identity{
+    1:string provider
+    2:string email
+    3:string name
+    4:string createTime
+}
+
+struct IdentityListReq{
+    1:i64 userId
+}
+
+struct IdentityListResp{
+    1:common.BaseResp BaseResp
+    2:list<Identity> identities
+}
+
+struct UnlinkIdentityReq{
+    1:i64 userId
+    2:string provider
+}
+
+struct AccountDeletionReq{
+    1:i64 userId
+    2:string password
+    3:optional string twoFactorCode
+}
+
+struct AccountDeletionStatusReq{
+    1:i64 userId
+}
+
+struct AccountDeletionResp{
+    1:common.BaseResp BaseResp
+    2:string status
+    3:string requestTime
+    4:string scheduledTime
+}
+
+struct CancelAccountDeletionReq{
+    1:i64 userId
+}
+
+struct DataExportReq{
+    1:i64 userId
+    2:optional string twoFactorCode
+}
+
+struct DataExportStatusReq{
+    1:i64 userId
+}
+
+struct DataExport{
+    1:i64 exportId
+    2:string status
+    3:string createTime
+    4:string completeTime
+    5:string expireTime
+    6:string downloadUrl
+}
+
+struct DataExportResp{
+    1:common.BaseResp BaseResp
+    2:DataExport export
+}
+
+struct UserRolesReq{
+    1:i64 userId
+}
+
+struct UserRolesResp{
+    1:common.BaseResp BaseResp
+    2:list<string> roles
+    3:list<string> permissions
+}
+
+struct RoleReq{
+    1:i64 operatorId
+    2:i64 userId
+    3:string role
+}
+
+struct BanUserReq{
+    1:i64 operatorId
+    2:i64 userId
+    3:bool banned
+}
+
+struct UserLevelReq{
+    1:i64 userId
+}
+
+struct UserLevelResp{
+    1:common.BaseResp BaseResp
+    2:i32 level
+    3:i64 experience
+    4:i64 nextLevelExperience
+    5:map<string,i64> todayExperience
+}
+
+struct UserSettings{
+    1:string dmPermission
+    2:string commentPermission
+    3:bool likedListPublic
+    4:bool starredListPublic
+    5:bool followListVisible
+    6:bool allowRemix
+    7:bool showOnlineStatus
+    8:i64 version
+}
+
+struct GetSettingsReq{
+    1:i64 userId
+}
+
+struct UpdateSettingsReq{
+    1:i64 userId
+    2:optional string dmPermission
+    3:optional string commentPermission
+    4:optional bool likedListPublic
+    5:optional bool starredListPublic
+    6:optional bool followListVisible
+    7:optional bool allowRemix
+    8:optional bool showOnlineStatus
+    9:optional i64 version
+}
+
+struct SettingsResp{
+    1:common.BaseResp BaseResp
+    2:UserSettings settings
+}
+
+struct ChangeUsernameReq{
+    1:i64 userId
+    2:string username
+    3:optional string twoFactorCode
+}
+
+struct ChangeUsernameResp{
+    1:common.BaseResp BaseResp
+    2:string username
+    3:i64 nextChangeTime
+}
+
+struct UsernameChange{
+    1:string oldUsername
+    2:string renamedTo
+    3:i64 changedAt
+    4:i64 redirectUntil
+}
+
+struct UsernameHistoryReq{
+    1:i64 operatorId
+    2:i64 userId
+}
+
+struct UsernameHistoryResp{
+    1:common.BaseResp BaseResp
+    2:list<UsernameChange> history
+}
+
 service UserService{
     LoginRegisterResp Register(1:RegisterReq req)
     LoginRegisterResp Login(1:LoginReq req)
@@ -128,5 +418,34 @@ service UserService{
     SearchUsersResp SearchUsers(1:SearchUsersReq req)
     common.BaseResp UpdateFollowCount(1:UpdateFollowCountReq req)
     common.BaseResp UpdateFollowerCount(1:UpdateFollowerCountReq req)
-    bool VerifyToken(1:string token)
+    VerifyTokenResp VerifyToken(1:string token)
+    RefreshTokenResp RefreshToken(1:RefreshTokenReq req)
+    common.BaseResp Logout(1:LogoutReq req)
+    common.BaseResp LogoutAll(1:LogoutAllReq req)
+    SessionListResp GetSessions(1:SessionListReq req)
+    common.BaseResp RevokeSession(1:RevokeSessionReq req)
+    LoginRegisterResp VerifyTwoFactorLogin(1:TwoFactorLoginReq req)
+    TwoFactorSetupResp SetupTwoFactor(1:TwoFactorSetupReq req)
+    RecoveryCodesResp EnableTwoFactor(1:TwoFactorCodeReq req)
+    common.BaseResp DisableTwoFactor(1:TwoFactorCodeReq req)
+    RecoveryCodesResp RegenerateRecoveryCodes(1:TwoFactorCodeReq req)
+    TwoFactorStatusResp GetTwoFactorStatus(1:TwoFactorStatusReq req)
+    OAuthStartResp StartOAuth(1:OAuthStartReq req)
+    LoginRegisterResp OAuthCallback(1:OAuthCallbackReq req)
+    IdentityListResp ListIdentities(1:IdentityListReq req)
+    common.BaseResp UnlinkIdentity(1:UnlinkIdentityReq req)
+    AccountDeletionResp RequestAccountDeletion(1:AccountDeletionReq req)
+    AccountDeletionResp GetAccountDeletion(1:AccountDeletionStatusReq req)
+    common.BaseResp CancelAccountDeletion(1:CancelAccountDeletionReq req)
+    DataExportResp RequestDataExport(1:DataExportReq req)
+    DataExportResp GetDataExport(1:DataExportStatusReq req)
+    UserRolesResp GetUserRoles(1:UserRolesReq req)
+    common.BaseResp AssignRole(1:RoleReq req)
+    common.BaseResp RevokeRole(1:RoleReq req)
+    common.BaseResp BanUser(1:BanUserReq req)
+    UserLevelResp GetUserLevel(1:UserLevelReq req)
+    SettingsResp GetSettings(1:GetSettingsReq req)
+    SettingsResp UpdateSettings(1:UpdateSettingsReq req)
+    ChangeUsernameResp ChangeUsername(1:ChangeUsernameReq req)
+    UsernameHistoryResp GetUsernameHistory(1:UsernameHistoryReq req)
 }
\ No newline at end of file
@@ -8,6 +8,8 @@ struct PublishVideoReq{
     3:string videoUrl
     4:string coverUrl
     5:string description
+    6:optional i64 audienceListId
+    7:optional i64 remixOfVideoId
 }
 
 struct PublishVideoResp{
@@ -20,12 +22,16 @@ struct UserVideoListReq{
     2:i64 currentUserId
     3:i32 page
     4:i32 pageSize
+    5:optional string cursor
+    6:optional bool needTotal
 }
 
 struct UserVideoListResp{
     1:common.BaseResp BaseResp
     2:list<common.Video> videos
     3:i32 totalCount
+    4:optional string nextCursor
+    5:bool hasMore
 }
 
 struct FeedReq{
@@ -87,6 +93,7 @@ struct UpdateVideoInfoReq{
     2:i64 userId
     3:optional string title
     4:optional string description
+    5:optional i64 audienceListId
 }
 
 struct UpdateVideoInfoResp{
@@ -151,6 +158,81 @@ struct GetTotalVideoCountResp{
     2:i64 count
 }
 
+struct WatchHistoryItem{
+    1:common.Video video
+    2:i32 position
+    3:i32 duration
+    4:double progress
+    5:i64 watchedAt
+}
+
+struct ReportWatchProgressReq{
+    1:i64 userId
+    2:i64 videoId
+    3:i32 position
+    4:i32 duration
+    5:optional bool finished
+}
+
+struct ReportWatchProgressResp{
+    1:common.BaseResp BaseResp
+}
+
+struct WatchHistoryReq{
+    1:i64 userId
+    2:optional i64 cursor
+    3:i32 pageSize
+}
+
+struct WatchHistoryResp{
+    1:common.BaseResp BaseResp
+    2:list<WatchHistoryItem> items
+    3:i64 nextCursor
+    4:bool hasMore
+    5:bool paused
+}
+
+struct WatchProgressReq{
+    1:i64 userId
+    2:i64 videoId
+}
+
+struct WatchProgressResp{
+    1:common.BaseResp BaseResp
+    2:bool exists
+    3:i32 position
+    4:i32 duration
+    5:double progress
+    6:i32 resumePosition
+    7:i64 watchedAt
+}
+
+struct DeleteWatchHistoryReq{
+    1:i64 userId
+    2:i64 videoId
+}
+
+struct DeleteWatchHistoryResp{
+    1:common.BaseResp BaseResp
+}
+
+struct ClearWatchHistoryReq{
+    1:i64 userId
+}
+
+struct ClearWatchHistoryResp{
+    1:common.BaseResp BaseResp
+}
+
+struct SetWatchHistoryPausedReq{
+    1:i64 userId
+    2:bool paused
+}
+
+struct SetWatchHistoryPausedResp{
+    1:common.BaseResp BaseResp
+}
+
 service VideoService{
     PublishVideoResp PublishVideo(1:PublishVideoReq req)
     UserVideoListResp GetUserVideoList(1:UserVideoListReq req)
@@ -165,4 +247,10 @@ service VideoService{
     UploadVideoResp UploadVideo(1:UploadVideoReq req)
     GetUserVideoCountResp GetUserVideoCount(1:GetUserVideoCountReq req)
     GetTotalVideoCountResp GetTotalVideoCount(1:GetTotalVideoCountReq req)
+    ReportWatchProgressResp ReportWatchProgress(1:ReportWatchProgressReq req)
+    WatchHistoryResp GetWatchHistory(1:WatchHistoryReq req)
+    WatchProgressResp GetWatchProgress(1:WatchProgressReq req)
+    DeleteWatchHistoryResp DeleteWatchHistory(1:DeleteWatchHistoryReq req)
+    ClearWatchHistoryResp ClearWatchHistory(1:ClearWatchHistoryReq req)
+    SetWatchHistoryPausedResp SetWatchHistoryPaused(1:SetWatchHistoryPausedReq req)
 }
\ No newline at end of file
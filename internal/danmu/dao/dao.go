@@ -17,6 +17,7 @@ type DanmuRepository interface {
 	CountByLiveID(ctx context.Context, liveID int64) (int64, error)
 	GetDanmuStats(ctx context.Context, liveID int64) (*model.DanmuStats, error)
 	GetActiveUsers(ctx context.Context, liveID int64, limit int) ([]int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo DanmuRepository) error) error
 }
 
@@ -25,6 +26,7 @@ type DanmuFilterRepository interface {
 	FindByUserAndLive(ctx context.Context, userID, liveID int64) (*model.DanmuFilter, error)
 	FindByLiveID(ctx context.Context, liveID int64) ([]*model.DanmuFilter, error)
 	Delete(ctx context.Context, userID, liveID int64) error
+	DeleteByUserID(ctx context.Context, userID int64) error
 	WithTransaction(ctx context.Context, fn func(txRepo DanmuFilterRepository) error) error
 }
 
@@ -131,6 +133,13 @@ func (r *danmuRepositoryImpl) GetActiveUsers(ctx context.Context, liveID int64,
 	return userIDs, err
 }
 
+func (r *danmuRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.Danmu{})
+	return result.RowsAffected, result.Error
+}
+
 func (r *danmuRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo DanmuRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &danmuRepositoryImpl{db: tx}
@@ -173,6 +182,12 @@ func (r *danmuFilterRepositoryImpl) Delete(ctx context.Context, userID, liveID i
 		Delete(&model.DanmuFilter{}).Error
 }
 
+func (r *danmuFilterRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.DanmuFilter{}).Error
+}
+
 func (r *danmuFilterRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo DanmuFilterRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &danmuFilterRepositoryImpl{db: tx}
@@ -36,7 +36,7 @@ func (s *DanmuServiceImpl) SendDanmu(ctx context.Context, req *danmu.SendDanmuRe
 		DanmuId: 0,
 	}
 
-	danmuID, err := s.danmuService.SendDanmu(ctx, req.UserId, req.LiveId, req.Content, req.GetColor())
+	sent, err := s.danmuService.SendDanmu(ctx, req.UserId, req.LiveId, req.Content, req.GetColor())
 	if err != nil {
 		logger.Error("SendDanmu failed", logger.ErrorField(err))
 		errorMsg := err.Error()
@@ -45,8 +45,10 @@ func (s *DanmuServiceImpl) SendDanmu(ctx context.Context, req *danmu.SendDanmuRe
 		return resp, nil
 	}
 
-	resp.DanmuId = danmuID
-	logger.Info("SendDanmu success", logger.Int64Field("danmu_id", danmuID))
+	resp.DanmuId = sent.ID
+	resp.Content = &sent.Content
+	resp.UserLevel = &sent.UserLevel
+	logger.Info("SendDanmu success", logger.Int64Field("danmu_id", sent.ID))
 	return resp, nil
 }
 
@@ -70,7 +72,7 @@ func (s *DanmuServiceImpl) GetDanmuHistory(ctx context.Context, req *danmu.GetDa
 	startTime := time.Unix(req.StartTime/1000, 0).Format("2006-01-02 15:04:05")
 	endTime := time.Unix(req.EndTime/1000, 0).Format("2006-01-02 15:04:05")
 
-	danmus, err := s.danmuService.GetDanmuHistory(ctx, req.LiveId, startTime, endTime, int(req.Limit))
+	danmus, err := s.danmuService.GetDanmuHistory(ctx, req.LiveId, req.GetCurrentUserId(), startTime, endTime, int(req.Limit))
 	if err != nil {
 		logger.Error("GetDanmuHistory failed", logger.ErrorField(err))
 		errorMsg := err.Error()
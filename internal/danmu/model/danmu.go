@@ -10,6 +10,7 @@ type Danmu struct {
 	LiveID     int64     `gorm:"index;not null;comment:直播间ID"`
 	Content    string    `gorm:"type:text;not null;comment:弹幕内容"`
 	Color      string    `gorm:"size:20;default:'#FFFFFF';comment:颜色"`
+	UserLevel  int32     `gorm:"default:1;comment:发送时的用户等级"`
 	CreateTime string    `gorm:"size:50;not null;comment:创建时间"`
 	CreatedAt  time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime;comment:更新时间"`
@@ -6,9 +6,15 @@ import (
 	"errors"
 	"shortvideo/internal/danmu/dao"
 	"shortvideo/internal/danmu/model"
+	liveDao "shortvideo/internal/live/dao"
+	socialDao "shortvideo/internal/social/dao"
+	userDao "shortvideo/internal/user/dao"
 	"shortvideo/kitex_gen/common"
 	"shortvideo/kitex_gen/danmu"
+	"shortvideo/pkg/config"
 	"shortvideo/pkg/logger"
+	"shortvideo/pkg/rbac"
+	"shortvideo/pkg/textfilter"
 	"time"
 )
 
@@ -19,12 +25,14 @@ var (
 	ErrInvalidParameter = errors.New("参数错误")
 	ErrLiveNotFound     = errors.New("直播间不存在")
 	ErrNotRoomAdmin     = errors.New("不是直播间管理员")
+	ErrSensitiveContent = errors.New("内容包含违规信息")
+	ErrUserBlocked      = errors.New("由于拉黑关系无法发送弹幕")
 )
 
 type DanmuService interface {
 	//弹幕相关
-	SendDanmu(ctx context.Context, userID, liveID int64, content, color string) (int64, error)
-	GetDanmuHistory(ctx context.Context, liveID int64, startTime, endTime string, limit int) ([]*model.Danmu, error)
+	SendDanmu(ctx context.Context, userID, liveID int64, content, color string) (*model.Danmu, error)
+	GetDanmuHistory(ctx context.Context, liveID, currentUserID int64, startTime, endTime string, limit int) ([]*model.Danmu, error)
 	ManageDanmu(ctx context.Context, managerID, liveID, danmuID int64, action int32) error
 	LikeDanmu(ctx context.Context, userID, danmuID int64) (int64, error)
 
@@ -35,71 +43,141 @@ type DanmuService interface {
 	//统计相关
 	GetDanmuStats(ctx context.Context, liveID int64) (*danmu.DanmuStats, error)
 
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
+
 	//事务相关
 	WithTransaction(ctx context.Context, fn func(txService DanmuService) error) error
 }
 
 type danmuServiceImpl struct {
-	danmuRepo  dao.DanmuRepository
-	filterRepo dao.DanmuFilterRepository
+	danmuRepo     dao.DanmuRepository
+	filterRepo    dao.DanmuFilterRepository
+	liveRoomRepo  liveDao.LiveRoomRepository
+	roomAdminRepo liveDao.RoomAdminRepository
+	blockRepo     socialDao.BlockRepository
+	userRepo      userDao.UserRepository
 }
 
 func NewDanmuService(
 	danmuRepo dao.DanmuRepository,
 	filterRepo dao.DanmuFilterRepository,
+	liveRoomRepo liveDao.LiveRoomRepository,
+	roomAdminRepo liveDao.RoomAdminRepository,
+	blockRepo socialDao.BlockRepository,
+	userRepo userDao.UserRepository,
 ) DanmuService {
 	return &danmuServiceImpl{
-		danmuRepo:  danmuRepo,
-		filterRepo: filterRepo,
+		danmuRepo:     danmuRepo,
+		filterRepo:    filterRepo,
+		liveRoomRepo:  liveRoomRepo,
+		roomAdminRepo: roomAdminRepo,
+		blockRepo:     blockRepo,
+		userRepo:      userRepo,
 	}
 }
 
 func NewDanmuServiceWithRepo(
 	danmuRepo dao.DanmuRepository,
 	filterRepo dao.DanmuFilterRepository,
+	liveRoomRepo liveDao.LiveRoomRepository,
+	roomAdminRepo liveDao.RoomAdminRepository,
+	blockRepo socialDao.BlockRepository,
+	userRepo userDao.UserRepository,
 ) DanmuService {
 	return &danmuServiceImpl{
-		danmuRepo:  danmuRepo,
-		filterRepo: filterRepo,
+		danmuRepo:     danmuRepo,
+		filterRepo:    filterRepo,
+		liveRoomRepo:  liveRoomRepo,
+		roomAdminRepo: roomAdminRepo,
+		blockRepo:     blockRepo,
+		userRepo:      userRepo,
 	}
 }
 
 // 发送弹幕
-func (s *danmuServiceImpl) SendDanmu(ctx context.Context, userID, liveID int64, content, color string) (int64, error) {
+func (s *danmuServiceImpl) SendDanmu(ctx context.Context, userID, liveID int64, content, color string) (*model.Danmu, error) {
 	logger.Info("SendDanmu request",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("live_id", liveID),
 		logger.StringField("content", content))
 
 	if content == "" {
-		return 0, ErrInvalidParameter
+		return nil, ErrInvalidParameter
+	}
+
+	//弹幕实时展示无法等待审核，需要审核的内容同样拒绝
+	filtered := textfilter.Check(content)
+	if filtered.Action == textfilter.ActionReject || filtered.Action == textfilter.ActionReview {
+		logger.Warn("SendDanmu rejected by text filter",
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("live_id", liveID))
+		return nil, ErrSensitiveContent
+	}
+	content = filtered.Text
+
+	//与主播存在拉黑关系时不能发送弹幕
+	if s.liveRoomRepo != nil && s.blockRepo != nil {
+		room, err := s.liveRoomRepo.FindByID(ctx, liveID)
+		if err != nil {
+			logger.Error("SendDanmu find live room failed", logger.ErrorField(err))
+			return nil, ErrInternalServer
+		}
+		if room == nil {
+			return nil, ErrLiveNotFound
+		}
+		if room.HostID != userID {
+			blocked, err := s.blockRepo.ExistsBlockBetween(ctx, userID, room.HostID)
+			if err != nil {
+				logger.Error("SendDanmu check block failed", logger.ErrorField(err))
+				return nil, ErrInternalServer
+			}
+			if blocked {
+				return nil, ErrUserBlocked
+			}
+		}
 	}
 
 	if color == "" {
 		color = "#FFFFFF"
 	}
 
+	//记录发送时的等级，用于展示等级徽章和隐藏低等级用户弹幕
+	level := int32(1)
+	if s.userRepo != nil {
+		user, err := s.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			logger.Error("SendDanmu find user failed", logger.ErrorField(err))
+			return nil, ErrInternalServer
+		}
+		if user != nil {
+			level = user.Level
+		}
+	}
+
 	danmu := &model.Danmu{
 		UserID:     userID,
 		LiveID:     liveID,
 		Content:    content,
 		Color:      color,
+		UserLevel:  level,
 		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
 	}
 
 	if err := s.danmuRepo.Create(ctx, danmu); err != nil {
 		logger.Error("SendDanmu failed", logger.ErrorField(err))
-		return 0, ErrInternalServer
+		return nil, ErrInternalServer
 	}
 
 	logger.Info("SendDanmu success", logger.Int64Field("danmu_id", danmu.ID))
-	return danmu.ID, nil
+	return danmu, nil
 }
 
 // 获取弹幕历史
-func (s *danmuServiceImpl) GetDanmuHistory(ctx context.Context, liveID int64, startTime, endTime string, limit int) ([]*model.Danmu, error) {
+func (s *danmuServiceImpl) GetDanmuHistory(ctx context.Context, liveID, currentUserID int64, startTime, endTime string, limit int) ([]*model.Danmu, error) {
 	logger.Info("GetDanmuHistory request",
 		logger.Int64Field("live_id", liveID),
+		logger.Int64Field("current_user_id", currentUserID),
 		logger.StringField("start_time", startTime),
 		logger.StringField("end_time", endTime),
 		logger.IntField("limit", limit))
@@ -118,6 +196,43 @@ func (s *danmuServiceImpl) GetDanmuHistory(ctx context.Context, liveID int64, st
 		return nil, ErrInternalServer
 	}
 
+	//隐藏拉黑、屏蔽用户的弹幕
+	if currentUserID > 0 && s.blockRepo != nil {
+		hiddenIDs, err := s.blockRepo.FindHiddenUserIDs(ctx, currentUserID)
+		if err != nil {
+			logger.Warn("GetDanmuHistory find hidden users failed", logger.ErrorField(err))
+		} else if len(hiddenIDs) > 0 {
+			hidden := make(map[int64]bool, len(hiddenIDs))
+			for _, id := range hiddenIDs {
+				hidden[id] = true
+			}
+			filtered := make([]*model.Danmu, 0, len(danmus))
+			for _, d := range danmus {
+				if !hidden[d.UserID] {
+					filtered = append(filtered, d)
+				}
+			}
+			danmus = filtered
+		}
+	}
+
+	//开启隐藏低等级用户弹幕时，过滤掉低于配置等级的用户弹幕
+	if currentUserID > 0 && s.filterRepo != nil {
+		filter, err := s.filterRepo.FindByUserAndLive(ctx, currentUserID, liveID)
+		if err != nil {
+			logger.Warn("GetDanmuHistory find filter failed", logger.ErrorField(err))
+		} else if filter != nil && filter.HideLowLevel {
+			lowLevel := config.Get().Experience.LowLevel
+			filtered := make([]*model.Danmu, 0, len(danmus))
+			for _, d := range danmus {
+				if d.UserLevel >= lowLevel || d.UserID == currentUserID {
+					filtered = append(filtered, d)
+				}
+			}
+			danmus = filtered
+		}
+	}
+
 	logger.Info("GetDanmuHistory success", logger.IntField("danmu_count", len(danmus)))
 	return danmus, nil
 }
@@ -153,6 +268,13 @@ func (s *danmuServiceImpl) ManageDanmu(ctx context.Context, managerID, liveID, d
 		return ErrInvalidParameter
 	}
 
+	//主播和房管可以管理本直播间的弹幕，版主和管理员可以管理所有弹幕
+	if !rbac.Allowed(ctx, rbac.PermDanmuManageAny) {
+		if err := s.checkRoomManager(ctx, managerID, liveID); err != nil {
+			return err
+		}
+	}
+
 	switch action {
 	case 1:
 		if err := s.danmuRepo.Delete(ctx, danmuID); err != nil {
@@ -311,12 +433,53 @@ func (s *danmuServiceImpl) GetDanmuStats(ctx context.Context, liveID int64) (*da
 	return danmuStats, nil
 }
 
+// 判断用户是否为直播间主播或房管
+func (s *danmuServiceImpl) checkRoomManager(ctx context.Context, userID, liveID int64) error {
+	if s.liveRoomRepo == nil {
+		return ErrNotRoomAdmin
+	}
+
+	room, err := s.liveRoomRepo.FindByID(ctx, liveID)
+	if err != nil {
+		logger.Error("FindLiveRoom failed", logger.ErrorField(err), logger.Int64Field("live_id", liveID))
+		return ErrInternalServer
+	}
+	if room == nil {
+		return ErrLiveNotFound
+	}
+	if room.HostID == userID {
+		return nil
+	}
+
+	if s.roomAdminRepo == nil {
+		return ErrNotRoomAdmin
+	}
+	isAdmin, err := s.roomAdminRepo.IsAdmin(ctx, liveID, userID)
+	if err != nil {
+		logger.Error("IsRoomAdmin failed", logger.ErrorField(err),
+			logger.Int64Field("live_id", liveID),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if !isAdmin {
+		logger.Warn("ManageDanmu permission denied",
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("live_id", liveID))
+		return ErrNotRoomAdmin
+	}
+	return nil
+}
+
 // 事务相关
 func (s *danmuServiceImpl) WithTransaction(ctx context.Context, fn func(txService DanmuService) error) error {
 	return s.danmuRepo.WithTransaction(ctx, func(txDanmuRepo dao.DanmuRepository) error {
 		txService := &danmuServiceImpl{
-			danmuRepo:  txDanmuRepo,
-			filterRepo: s.filterRepo,
+			danmuRepo:     txDanmuRepo,
+			filterRepo:    s.filterRepo,
+			liveRoomRepo:  s.liveRoomRepo,
+			roomAdminRepo: s.roomAdminRepo,
+			blockRepo:     s.blockRepo,
+			userRepo:      s.userRepo,
 		}
 		return fn(txService)
 	})
@@ -335,6 +498,7 @@ func ConvertToCommonDanmu(danmu *model.Danmu) *common.Danmu {
 		Content:    danmu.Content,
 		Color:      danmu.Color,
 		CreateTime: danmu.CreateTime,
+		UserLevel:  danmu.UserLevel,
 	}
 }
 
@@ -375,3 +539,28 @@ func ConvertToDanmuStats(stats *model.DanmuStats) *danmu.DanmuStats {
 		WordCloud:          make(map[string]int64),
 	}
 }
+
+// 账号注销后删除用户发送的弹幕和弹幕过滤设置，可重复执行
+func (s *danmuServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的弹幕数据", logger.Int64Field("user_id", userID))
+
+	count, err := s.danmuRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("删除用户弹幕失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if err := s.filterRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除用户弹幕过滤设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	logger.Info("清理注销用户的弹幕数据完成",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("danmu_count", count))
+	return nil
+}
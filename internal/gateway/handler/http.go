@@ -2,9 +2,14 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
+	"shortvideo/internal/user/oauth"
+	"shortvideo/kitex_gen/common"
 	"shortvideo/kitex_gen/danmu"
 	"shortvideo/kitex_gen/interaction"
 	"shortvideo/kitex_gen/live"
@@ -13,8 +18,10 @@ import (
 	"shortvideo/kitex_gen/social"
 	"shortvideo/kitex_gen/user"
 	"shortvideo/kitex_gen/video"
+	"shortvideo/pkg/config"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
 )
 
 // 处理HTTP请求
@@ -60,13 +67,28 @@ func (h *HTTPHandler) writeResponse(ctx *app.RequestContext, resp Response) {
 	ctx.JSON(resp.Code, resp)
 }
 
+// 客户端上报的设备信息加上请求的IP和User-Agent，用于登录会话记录
+func deviceInfo(ctx *app.RequestContext, deviceID, deviceName, platform string) *user.DeviceInfo {
+	return &user.DeviceInfo{
+		DeviceId:   deviceID,
+		DeviceName: deviceName,
+		Platform:   platform,
+		Ip:         ctx.ClientIP(),
+		UserAgent:  string(ctx.UserAgent()),
+	}
+}
+
 // 注册
 func (h *HTTPHandler) Register(c context.Context, ctx *app.RequestContext) {
 	var req struct {
-		Username string `json:"username"`
-		Password string `json:"password"`
-		Avatar   string `json:"avatar"`
-		About    string `json:"about"`
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Avatar       string `json:"avatar"`
+		About        string `json:"about"`
+		DeviceID     string `json:"device_id"`
+		DeviceName   string `json:"device_name"`
+		Platform     string `json:"platform"`
+		CaptchaToken string `json:"captcha_token"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
@@ -81,6 +103,7 @@ func (h *HTTPHandler) Register(c context.Context, ctx *app.RequestContext) {
 	registerReq := &user.RegisterReq{
 		Username: req.Username,
 		Password: req.Password,
+		Device:   deviceInfo(ctx, req.DeviceID, req.DeviceName, req.Platform),
 	}
 
 	if req.Avatar != "" {
@@ -89,6 +112,9 @@ func (h *HTTPHandler) Register(c context.Context, ctx *app.RequestContext) {
 	if req.About != "" {
 		registerReq.About = &req.About
 	}
+	if req.CaptchaToken != "" {
+		registerReq.CaptchaToken = &req.CaptchaToken
+	}
 
 	resp, err := h.clients.UserClient.Register(c, registerReq)
 	if err != nil {
@@ -106,16 +132,22 @@ func (h *HTTPHandler) Register(c context.Context, ctx *app.RequestContext) {
 	}
 
 	h.success(ctx, map[string]interface{}{
-		"user":  resp.User,
-		"token": resp.Token,
+		"user":          resp.User,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
 	})
 }
 
 // 登录
 func (h *HTTPHandler) Login(c context.Context, ctx *app.RequestContext) {
 	var req struct {
-		Username string `json:"username"`
-		Password string `json:"password"`
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		DeviceID     string `json:"device_id"`
+		DeviceName   string `json:"device_name"`
+		Platform     string `json:"platform"`
+		CaptchaToken string `json:"captcha_token"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
@@ -130,6 +162,10 @@ func (h *HTTPHandler) Login(c context.Context, ctx *app.RequestContext) {
 	loginReq := &user.LoginReq{
 		Username: req.Username,
 		Password: req.Password,
+		Device:   deviceInfo(ctx, req.DeviceID, req.DeviceName, req.Platform),
+	}
+	if req.CaptchaToken != "" {
+		loginReq.CaptchaToken = &req.CaptchaToken
 	}
 
 	resp, err := h.clients.UserClient.Login(c, loginReq)
@@ -147,165 +183,180 @@ func (h *HTTPHandler) Login(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
+	//开启两步验证的账号需要再提交验证码
+	if resp.TwoFactorRequired {
+		h.success(ctx, map[string]interface{}{
+			"two_factor_required":  true,
+			"challenge_token":      resp.ChallengeToken,
+			"challenge_expires_at": resp.ChallengeExpiresAt,
+		})
+		return
+	}
+
 	h.success(ctx, map[string]interface{}{
-		"user":  resp.User,
-		"token": resp.Token,
+		"user":          resp.User,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
 	})
 }
 
-// 获取视频流
-func (h *HTTPHandler) GetVideoFeed(c context.Context, ctx *app.RequestContext) {
-	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
-	if pageSize <= 0 {
-		pageSize = 10
+// 两步验证登录，提交登录返回的挑战令牌和验证码（或恢复码）
+func (h *HTTPHandler) VerifyTwoFactorLogin(c context.Context, ctx *app.RequestContext) {
+	var req struct {
+		ChallengeToken string `json:"challenge_token"`
+		Code           string `json:"code"`
 	}
-
-	latestTime, _ := strconv.ParseInt(ctx.Query("latest_time"), 10, 64)
-
-	userID, _ := c.Value("user_id").(int64)
-
-	if h.clients.VideoClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	feedReq := &video.FeedReq{
-		UserId:     userID,
-		LatestTime: latestTime,
-		PageSize:   int32(pageSize),
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	resp, err := h.clients.VideoClient.GetFeed(c, feedReq)
+	resp, err := h.clients.UserClient.VerifyTwoFactorLogin(c, &user.TwoFactorLoginReq{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取视频流失败"
+		errMsg := "两步验证失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
-		h.error(ctx, http.StatusBadRequest, errMsg)
+		h.error(ctx, http.StatusUnauthorized, errMsg)
 		return
 	}
 
 	h.success(ctx, map[string]interface{}{
-		"videos":    resp.Videos,
-		"next_time": resp.NextTime,
+		"user":          resp.User,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
 	})
 }
 
-// 获取视频详情
-func (h *HTTPHandler) GetVideoByID(c context.Context, ctx *app.RequestContext) {
-	videoID, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
-	if err != nil {
-		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
-		return
+// 使用刷新令牌换取新的令牌对，旧的刷新令牌随即失效
+func (h *HTTPHandler) RefreshToken(c context.Context, ctx *app.RequestContext) {
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
 	}
-
-	userID, _ := c.Value("user_id").(int64)
-
-	if h.clients.VideoClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	detailReq := &video.VideoDetailReq{
-		VideoId:       videoID,
-		CurrentUserId: userID,
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	resp, err := h.clients.VideoClient.GetVideoDetail(c, detailReq)
+	resp, err := h.clients.UserClient.RefreshToken(c, &user.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取视频详情失败"
+		errMsg := "刷新令牌失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
-		h.error(ctx, http.StatusBadRequest, errMsg)
+		h.error(ctx, http.StatusUnauthorized, errMsg)
 		return
 	}
 
-	h.success(ctx, resp.Video)
+	h.success(ctx, map[string]interface{}{
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
+	})
 }
 
-// 搜索视频
-func (h *HTTPHandler) Search(c context.Context, ctx *app.RequestContext) {
-	keyword := ctx.Query("keyword")
-	page, _ := strconv.Atoi(ctx.Query("page"))
-	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+// 退出当前设备
+func (h *HTTPHandler) Logout(c context.Context, ctx *app.RequestContext) {
+	token, _ := c.Value("token").(string)
 
-	if page <= 0 {
-		page = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	userID, _ := c.Value("user_id").(int64)
+	resp, err := h.clients.UserClient.Logout(c, &user.LogoutReq{Token: token})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
 
-	if h.clients.VideoClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+	if resp.StatusCode != 0 {
+		errMsg := "退出登录失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
 		return
 	}
 
-	searchReq := &video.SearchVideoReq{
-		Keyword:       keyword,
-		CurrentUserId: userID,
-		Page:          int32(page),
-		PageSize:      int32(pageSize),
+	h.success(ctx, nil)
+}
+
+// 退出所有设备
+func (h *HTTPHandler) LogoutAll(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	resp, err := h.clients.VideoClient.SearchVideo(c, searchReq)
+	resp, err := h.clients.UserClient.LogoutAll(c, &user.LogoutAllReq{UserId: userID})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "搜索视频失败"
-		if resp.BaseResp.Msg != nil {
-			errMsg = *resp.BaseResp.Msg
+	if resp.StatusCode != 0 {
+		errMsg := "退出所有设备失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
 		}
 		h.error(ctx, http.StatusBadRequest, errMsg)
 		return
 	}
 
-	h.success(ctx, map[string]interface{}{
-		"videos": resp.Videos,
-		"total":  resp.TotalCount,
-		"page":   page,
-		"size":   pageSize,
-	})
+	h.success(ctx, nil)
 }
 
-// 获取用户信息
-func (h *HTTPHandler) GetUserProfile(c context.Context, ctx *app.RequestContext) {
+// 获取当前登录的设备列表
+func (h *HTTPHandler) GetSessions(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
+	sessionID, _ := c.Value("session_id").(string)
 
 	if h.clients.UserClient == nil {
 		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
 		return
 	}
 
-	infoReq := &user.UserInfoReq{
-		UserId:        userID,
-		CurrentUserId: userID,
-	}
-
-	resp, err := h.clients.UserClient.GetUserInfo(c, infoReq)
+	resp, err := h.clients.UserClient.GetSessions(c, &user.SessionListReq{
+		UserId:           userID,
+		CurrentSessionId: sessionID,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取用户信息失败"
+		errMsg := "获取登录设备失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -313,20 +364,19 @@ func (h *HTTPHandler) GetUserProfile(c context.Context, ctx *app.RequestContext)
 		return
 	}
 
-	h.success(ctx, resp.User)
+	h.success(ctx, map[string]interface{}{
+		"sessions": resp.Sessions,
+	})
 }
 
-// 更新用户信息
-func (h *HTTPHandler) UpdateUser(c context.Context, ctx *app.RequestContext) {
+// 远程退出指定设备
+func (h *HTTPHandler) RevokeSession(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		Avatar      string `json:"avatar"`
-		About       string `json:"about"`
-		OldPassword string `json:"old_password"`
-		NewPassword string `json:"new_password"`
+		SessionID string `json:"session_id"`
 	}
-	if err := ctx.Bind(&req); err != nil {
+	if err := ctx.Bind(&req); err != nil || req.SessionID == "" {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
@@ -336,31 +386,17 @@ func (h *HTTPHandler) UpdateUser(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	updateReq := &user.UpdateUserReq{
-		UserId: userID,
-	}
-
-	if req.Avatar != "" {
-		updateReq.Avatar = &req.Avatar
-	}
-	if req.About != "" {
-		updateReq.About = &req.About
-	}
-	if req.OldPassword != "" {
-		updateReq.OldPassword = &req.OldPassword
-	}
-	if req.NewPassword != "" {
-		updateReq.NewPassword_ = &req.NewPassword
-	}
-
-	resp, err := h.clients.UserClient.UpdateUser(c, updateReq)
+	resp, err := h.clients.UserClient.RevokeSession(c, &user.RevokeSessionReq{
+		UserId:    userID,
+		SessionId: req.SessionID,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.StatusCode != 0 {
-		errMsg := "更新用户信息失败"
+		errMsg := "退出设备失败"
 		if resp.Msg != nil {
 			errMsg = *resp.Msg
 		}
@@ -371,37 +407,23 @@ func (h *HTTPHandler) UpdateUser(c context.Context, ctx *app.RequestContext) {
 	h.success(ctx, nil)
 }
 
-// 关注用户
-func (h *HTTPHandler) FollowUser(c context.Context, ctx *app.RequestContext) {
+// 获取两步验证状态
+func (h *HTTPHandler) GetTwoFactorStatus(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
-	var req struct {
-		TargetUserId int64 `json:"target_user_id"`
-	}
-	if err := ctx.Bind(&req); err != nil {
-		h.error(ctx, http.StatusBadRequest, "请求体无效")
-		return
-	}
-
-	if h.clients.SocialClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
 		return
 	}
 
-	followReq := &social.FollowActionReq{
-		UserId:       userID,
-		TargetUserId: req.TargetUserId,
-		Action:       true,
-	}
-
-	resp, err := h.clients.SocialClient.FollowAction(c, followReq)
+	resp, err := h.clients.UserClient.GetTwoFactorStatus(c, &user.TwoFactorStatusReq{UserId: userID})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "关注用户失败"
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取两步验证状态失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -409,40 +431,29 @@ func (h *HTTPHandler) FollowUser(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, nil)
+	h.success(ctx, map[string]interface{}{
+		"enabled":                  resp.Enabled,
+		"remaining_recovery_codes": resp.RemainingRecoveryCodes,
+	})
 }
 
-// 取消关注
-func (h *HTTPHandler) UnfollowUser(c context.Context, ctx *app.RequestContext) {
+// 获取两步验证密钥
+func (h *HTTPHandler) SetupTwoFactor(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
-	var req struct {
-		TargetUserId int64 `json:"target_user_id"`
-	}
-	if err := ctx.Bind(&req); err != nil {
-		h.error(ctx, http.StatusBadRequest, "请求体无效")
-		return
-	}
-
-	if h.clients.SocialClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
 		return
 	}
 
-	followReq := &social.FollowActionReq{
-		UserId:       userID,
-		TargetUserId: req.TargetUserId,
-		Action:       false,
-	}
-
-	resp, err := h.clients.SocialClient.FollowAction(c, followReq)
+	resp, err := h.clients.UserClient.SetupTwoFactor(c, &user.TwoFactorSetupReq{UserId: userID})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "取消关注失败"
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取两步验证密钥失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -450,41 +461,37 @@ func (h *HTTPHandler) UnfollowUser(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, nil)
+	h.success(ctx, map[string]interface{}{
+		"secret":      resp.Secret,
+		"otpauth_uri": resp.OtpauthUri,
+	})
 }
 
-// 获取关注列表
-func (h *HTTPHandler) GetFollowingList(c context.Context, ctx *app.RequestContext) {
+// 提交首个验证码开启两步验证，返回恢复码
+func (h *HTTPHandler) EnableTwoFactor(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
-	page, _ := strconv.Atoi(ctx.Query("page"))
-	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if page <= 0 {
-		page = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10
+	var req struct {
+		Code string `json:"code"`
 	}
-
-	if h.clients.SocialClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	followingReq := &social.FollowListReq{
-		UserId:   userID,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	resp, err := h.clients.SocialClient.GetFollowList(c, followingReq)
+	resp, err := h.clients.UserClient.EnableTwoFactor(c, &user.TwoFactorCodeReq{UserId: userID, Code: req.Code})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取关注列表失败"
+		errMsg := "开启两步验证失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -492,41 +499,36 @@ func (h *HTTPHandler) GetFollowingList(c context.Context, ctx *app.RequestContex
 		return
 	}
 
-	h.success(ctx, resp.Users)
+	h.success(ctx, map[string]interface{}{
+		"recovery_codes": resp.RecoveryCodes,
+	})
 }
 
-// 获取粉丝列表
-func (h *HTTPHandler) GetFollowerList(c context.Context, ctx *app.RequestContext) {
+// 重新生成恢复码，旧恢复码随即失效
+func (h *HTTPHandler) RegenerateRecoveryCodes(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
-	page, _ := strconv.Atoi(ctx.Query("page"))
-	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if page <= 0 {
-		page = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10
+	var req struct {
+		Code string `json:"code"`
 	}
-
-	if h.clients.SocialClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	followerReq := &social.FollowerListReq{
-		UserId:   userID,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
 	}
 
-	resp, err := h.clients.SocialClient.GetFollowerList(c, followerReq)
+	resp, err := h.clients.UserClient.RegenerateRecoveryCodes(c, &user.TwoFactorCodeReq{UserId: userID, Code: req.Code})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取粉丝列表失败"
+		errMsg := "重新生成恢复码失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -534,7 +536,1994 @@ func (h *HTTPHandler) GetFollowerList(c context.Context, ctx *app.RequestContext
 		return
 	}
 
-	h.success(ctx, resp.Users)
+	h.success(ctx, map[string]interface{}{
+		"recovery_codes": resp.RecoveryCodes,
+	})
+}
+
+// 关闭两步验证
+func (h *HTTPHandler) DisableTwoFactor(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Code string `json:"code"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.DisableTwoFactor(c, &user.TwoFactorCodeReq{UserId: userID, Code: req.Code})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		errMsg := "关闭两步验证失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 发起第三方登录，返回跳转到提供方的授权地址
+func (h *HTTPHandler) StartOAuth(c context.Context, ctx *app.RequestContext) {
+	h.startOAuth(c, ctx, ctx.Param("provider"), 0)
+}
+
+// 第三方授权回调，登录成功返回令牌；账号开启两步验证时返回挑战令牌；绑定流程返回绑定结果
+func (h *HTTPHandler) OAuthCallback(c context.Context, ctx *app.RequestContext) {
+	if errCode := ctx.Query("error"); errCode != "" {
+		h.error(ctx, http.StatusBadRequest, "第三方授权被拒绝: "+errCode)
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	//绑定值只使用一次，回调后立即清除
+	binding := string(ctx.Cookie(oauthBindingCookie))
+	h.setOAuthBindingCookie(ctx, "", -1)
+
+	resp, err := h.clients.UserClient.OAuthCallback(c, &user.OAuthCallbackReq{
+		Provider:       ctx.Param("provider"),
+		State:          ctx.Query("state"),
+		Code:           ctx.Query("code"),
+		Device:         deviceInfo(ctx, ctx.Query("device_id"), ctx.Query("device_name"), ctx.Query("platform")),
+		BrowserBinding: binding,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "第三方登录失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if resp.IdentityLinked {
+		h.success(ctx, map[string]interface{}{
+			"identity_linked": true,
+		})
+		return
+	}
+
+	if resp.TwoFactorRequired {
+		h.success(ctx, map[string]interface{}{
+			"two_factor_required":  true,
+			"challenge_token":      resp.ChallengeToken,
+			"challenge_expires_at": resp.ChallengeExpiresAt,
+			"registered":           resp.Registered,
+		})
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"user":          resp.User,
+		"token":         resp.Token,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
+		"registered":    resp.Registered,
+	})
+}
+
+// 本地模拟OIDC提供方的授权页，直接携带授权码跳回回调地址，仅在开启模拟提供方时可用
+func (h *HTTPHandler) MockOAuthAuthorize(c context.Context, ctx *app.RequestContext) {
+	oauthConfig := config.Get().OAuth
+	if !oauthConfig.Mock.Enable {
+		h.error(ctx, http.StatusNotFound, "模拟登录未开启")
+		return
+	}
+
+	params, err := url.ParseQuery(string(ctx.QueryArgs().QueryString()))
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "授权请求参数无效")
+		return
+	}
+
+	target, err := oauth.NewMockProvider(oauthConfig).Authorize(params)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ctx.Redirect(http.StatusFound, []byte(target))
+}
+
+// 绑定第三方账号，返回授权地址，授权完成后回调时绑定到当前用户
+func (h *HTTPHandler) LinkIdentity(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Provider string `json:"provider"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.Provider == "" {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	h.startOAuth(c, ctx, req.Provider, userID)
+}
+
+// 发起授权的浏览器持有的绑定值，用户服务只保存其哈希，回调时比对
+const oauthBindingCookie = "oauth_binding"
+
+func (h *HTTPHandler) setOAuthBindingCookie(ctx *app.RequestContext, value string, maxAge int) {
+	secure := string(ctx.URI().Scheme()) == "https"
+	ctx.SetCookie(oauthBindingCookie, value, maxAge, "/api/oauth", "", protocol.CookieSameSiteLaxMode, secure, true)
+}
+
+func (h *HTTPHandler) startOAuth(c context.Context, ctx *app.RequestContext, provider string, linkUserID int64) {
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	binding, err := oauth.RandomString(24)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, "发起第三方授权失败")
+		return
+	}
+
+	resp, err := h.clients.UserClient.StartOAuth(c, &user.OAuthStartReq{
+		Provider:       provider,
+		UserId:         linkUserID,
+		BrowserBinding: binding,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "发起第三方授权失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.setOAuthBindingCookie(ctx, binding, config.Get().OAuth.StateMinutes*60)
+	h.success(ctx, map[string]interface{}{
+		"auth_url": resp.AuthUrl,
+	})
+}
+
+// 已绑定的第三方账号
+func (h *HTTPHandler) ListIdentities(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.ListIdentities(c, &user.IdentityListReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取第三方账号失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"identities": resp.Identities,
+	})
+}
+
+// 解除第三方账号绑定
+func (h *HTTPHandler) UnlinkIdentity(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Provider string `json:"provider"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.Provider == "" {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.UnlinkIdentity(c, &user.UnlinkIdentityReq{
+		UserId:   userID,
+		Provider: req.Provider,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		errMsg := "解除绑定失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 申请注销账号，冷静期结束后执行注销
+func (h *HTTPHandler) RequestAccountDeletion(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Password      string `json:"password"`
+		TwoFactorCode string `json:"two_factor_code"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	deletionReq := &user.AccountDeletionReq{
+		UserId:   userID,
+		Password: req.Password,
+	}
+	if req.TwoFactorCode != "" {
+		deletionReq.TwoFactorCode = &req.TwoFactorCode
+	}
+
+	resp, err := h.clients.UserClient.RequestAccountDeletion(c, deletionReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "申请注销账号失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"status":         resp.Status,
+		"request_time":   resp.RequestTime,
+		"scheduled_time": resp.ScheduledTime,
+	})
+}
+
+// 注销申请状态
+func (h *HTTPHandler) GetAccountDeletion(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetAccountDeletion(c, &user.AccountDeletionStatusReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取注销申请失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"status":         resp.Status,
+		"request_time":   resp.RequestTime,
+		"scheduled_time": resp.ScheduledTime,
+	})
+}
+
+// 冷静期内撤销注销申请
+func (h *HTTPHandler) CancelAccountDeletion(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.CancelAccountDeletion(c, &user.CancelAccountDeletionReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		errMsg := "撤销注销申请失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 申请导出个人数据，生成后通过系统通知发送下载链接
+func (h *HTTPHandler) RequestDataExport(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TwoFactorCode string `json:"two_factor_code"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	exportReq := &user.DataExportReq{UserId: userID}
+	if req.TwoFactorCode != "" {
+		exportReq.TwoFactorCode = &req.TwoFactorCode
+	}
+
+	resp, err := h.clients.UserClient.RequestDataExport(c, exportReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "申请导出个人数据失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"export": resp.Export,
+	})
+}
+
+// 最近一次数据导出的状态，完成且未过期时包含下载链接
+func (h *HTTPHandler) GetDataExport(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetDataExport(c, &user.DataExportStatusReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取数据导出失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"export": resp.Export,
+	})
+}
+
+// 获取当前用户的角色和权限
+func (h *HTTPHandler) GetMyRoles(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	h.getUserRoles(c, ctx, userID)
+}
+
+// 管理员查询指定用户的角色和权限
+func (h *HTTPHandler) GetUserRoles(c context.Context, ctx *app.RequestContext) {
+	userID, err := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		h.error(ctx, http.StatusBadRequest, "无效的用户ID")
+		return
+	}
+	h.getUserRoles(c, ctx, userID)
+}
+
+func (h *HTTPHandler) getUserRoles(c context.Context, ctx *app.RequestContext, userID int64) {
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetUserRoles(c, &user.UserRolesReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取用户角色失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"user_id":     userID,
+		"roles":       resp.Roles,
+		"permissions": resp.Permissions,
+	})
+}
+
+// 授予角色
+func (h *HTTPHandler) AssignRole(c context.Context, ctx *app.RequestContext) {
+	h.changeRole(c, ctx, true)
+}
+
+// 收回角色
+func (h *HTTPHandler) RevokeRole(c context.Context, ctx *app.RequestContext) {
+	h.changeRole(c, ctx, false)
+}
+
+func (h *HTTPHandler) changeRole(c context.Context, ctx *app.RequestContext, assign bool) {
+	operatorID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		UserID int64  `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.UserID <= 0 || req.Role == "" {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	roleReq := &user.RoleReq{
+		OperatorId: operatorID,
+		UserId:     req.UserID,
+		Role:       req.Role,
+	}
+	var (
+		resp   *common.BaseResp
+		err    error
+		errMsg string
+	)
+	if assign {
+		resp, err = h.clients.UserClient.AssignRole(c, roleReq)
+		errMsg = "授予角色失败"
+	} else {
+		resp, err = h.clients.UserClient.RevokeRole(c, roleReq)
+		errMsg = "收回角色失败"
+	}
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 封禁或解封用户
+func (h *HTTPHandler) BanUser(c context.Context, ctx *app.RequestContext) {
+	operatorID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		UserID int64 `json:"user_id"`
+		Banned bool  `json:"banned"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.UserID <= 0 {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.BanUser(c, &user.BanUserReq{
+		OperatorId: operatorID,
+		UserId:     req.UserID,
+		Banned:     req.Banned,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		errMsg := "封禁用户失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取当前用户的等级和经验值
+func (h *HTTPHandler) GetUserLevel(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetUserLevel(c, &user.UserLevelReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取等级失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"level":                 resp.Level,
+		"experience":            resp.Experience,
+		"next_level_experience": resp.NextLevelExperience,
+		"today_experience":      resp.TodayExperience,
+	})
+}
+
+// 获取当前用户的隐私和偏好设置
+func (h *HTTPHandler) GetUserSettings(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetSettings(c, &user.GetSettingsReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取设置失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, settingsResponse(resp.Settings))
+}
+
+// 更新当前用户的隐私和偏好设置，只修改请求中出现的字段，
+// 携带version时用于乐观锁校验，版本不一致时更新失败
+func (h *HTTPHandler) UpdateUserSettings(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		DMPermission      *string `json:"dm_permission"`
+		CommentPermission *string `json:"comment_permission"`
+		LikedListPublic   *bool   `json:"liked_list_public"`
+		StarredListPublic *bool   `json:"starred_list_public"`
+		FollowListVisible *bool   `json:"follow_list_visible"`
+		AllowRemix        *bool   `json:"allow_remix"`
+		ShowOnlineStatus  *bool   `json:"show_online_status"`
+		Version           *int64  `json:"version"`
+	}
+	if err := ctx.BindJSON(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.UpdateSettings(c, &user.UpdateSettingsReq{
+		UserId:            userID,
+		DmPermission:      req.DMPermission,
+		CommentPermission: req.CommentPermission,
+		LikedListPublic:   req.LikedListPublic,
+		StarredListPublic: req.StarredListPublic,
+		FollowListVisible: req.FollowListVisible,
+		AllowRemix:        req.AllowRemix,
+		ShowOnlineStatus:  req.ShowOnlineStatus,
+		Version:           req.Version,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "更新设置失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, settingsResponse(resp.Settings))
+}
+
+func settingsResponse(settings *user.UserSettings) map[string]interface{} {
+	if settings == nil {
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		"dm_permission":       settings.DmPermission,
+		"comment_permission":  settings.CommentPermission,
+		"liked_list_public":   settings.LikedListPublic,
+		"starred_list_public": settings.StarredListPublic,
+		"follow_list_visible": settings.FollowListVisible,
+		"allow_remix":         settings.AllowRemix,
+		"show_online_status":  settings.ShowOnlineStatus,
+		"version":             settings.Version,
+	}
+}
+
+// 修改当前用户的用户名
+func (h *HTTPHandler) ChangeUsername(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Username      string `json:"username"`
+		TwoFactorCode string `json:"two_factor_code"`
+	}
+	if err := ctx.BindJSON(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	changeReq := &user.ChangeUsernameReq{
+		UserId:   userID,
+		Username: req.Username,
+	}
+	if req.TwoFactorCode != "" {
+		changeReq.TwoFactorCode = &req.TwoFactorCode
+	}
+
+	resp, err := h.clients.UserClient.ChangeUsername(c, changeReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "修改用户名失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"username":         resp.Username,
+		"next_change_time": resp.NextChangeTime,
+	})
+}
+
+// 获取当前用户的用户名修改记录
+func (h *HTTPHandler) GetMyUsernameHistory(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	h.getUsernameHistory(c, ctx, userID, userID)
+}
+
+// 查询指定用户的用户名修改记录
+func (h *HTTPHandler) GetUsernameHistory(c context.Context, ctx *app.RequestContext) {
+	operatorID, _ := c.Value("user_id").(int64)
+
+	userID, err := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		h.error(ctx, http.StatusBadRequest, "无效的用户ID")
+		return
+	}
+	h.getUsernameHistory(c, ctx, operatorID, userID)
+}
+
+func (h *HTTPHandler) getUsernameHistory(c context.Context, ctx *app.RequestContext, operatorID, userID int64) {
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetUsernameHistory(c, &user.UsernameHistoryReq{
+		OperatorId: operatorID,
+		UserId:     userID,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取用户名修改记录失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	history := make([]map[string]interface{}, 0, len(resp.History))
+	for _, change := range resp.History {
+		history = append(history, map[string]interface{}{
+			"old_username":   change.OldUsername,
+			"renamed_to":     change.RenamedTo,
+			"changed_at":     change.ChangedAt,
+			"redirect_until": change.RedirectUntil,
+		})
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"user_id": userID,
+		"history": history,
+	})
+}
+
+// 根据用户名获取用户主页，保留期内的旧用户名跳转到新用户名的主页
+func (h *HTTPHandler) GetUserByUsername(c context.Context, ctx *app.RequestContext) {
+	username := ctx.Param("username")
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	resp, err := h.clients.UserClient.GetUserInfoByUsername(c, &user.UserInfoByUsernameReq{Username: username})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "用户不存在"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusNotFound, errMsg)
+		return
+	}
+
+	//旧用户名在保留期后可能被他人使用，因此使用临时跳转
+	if resp.User != nil && resp.User.Username != username {
+		ctx.Redirect(http.StatusFound, []byte("/api/users/"+url.PathEscape(resp.User.Username)))
+		return
+	}
+
+	h.success(ctx, resp.User)
+}
+
+// 获取视频流
+func (h *HTTPHandler) GetVideoFeed(c context.Context, ctx *app.RequestContext) {
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	latestTime, _ := strconv.ParseInt(ctx.Query("latest_time"), 10, 64)
+
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
+	}
+
+	feedReq := &video.FeedReq{
+		UserId:     userID,
+		LatestTime: latestTime,
+		PageSize:   int32(pageSize),
+	}
+
+	resp, err := h.clients.VideoClient.GetFeed(c, feedReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取视频流失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"videos":    resp.Videos,
+		"next_time": resp.NextTime,
+	})
+}
+
+// 获取视频详情
+func (h *HTTPHandler) GetVideoByID(c context.Context, ctx *app.RequestContext) {
+	videoID, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
+	}
+
+	detailReq := &video.VideoDetailReq{
+		VideoId:       videoID,
+		CurrentUserId: userID,
+	}
+
+	resp, err := h.clients.VideoClient.GetVideoDetail(c, detailReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取视频详情失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Video)
+}
+
+// 搜索视频
+func (h *HTTPHandler) Search(c context.Context, ctx *app.RequestContext) {
+	keyword := ctx.Query("keyword")
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
+	}
+
+	searchReq := &video.SearchVideoReq{
+		Keyword:       keyword,
+		CurrentUserId: userID,
+		Page:          int32(page),
+		PageSize:      int32(pageSize),
+	}
+
+	resp, err := h.clients.VideoClient.SearchVideo(c, searchReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "搜索视频失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"videos": resp.Videos,
+		"total":  resp.TotalCount,
+		"page":   page,
+		"size":   pageSize,
+	})
+}
+
+// 获取用户信息
+func (h *HTTPHandler) GetUserProfile(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	infoReq := &user.UserInfoReq{
+		UserId:        userID,
+		CurrentUserId: userID,
+	}
+
+	resp, err := h.clients.UserClient.GetUserInfo(c, infoReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取用户信息失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.User)
+}
+
+// 更新用户信息
+func (h *HTTPHandler) UpdateUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Avatar        string `json:"avatar"`
+		About         string `json:"about"`
+		OldPassword   string `json:"old_password"`
+		NewPassword   string `json:"new_password"`
+		TwoFactorCode string `json:"two_factor_code"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	updateReq := &user.UpdateUserReq{
+		UserId: userID,
+	}
+
+	if req.Avatar != "" {
+		updateReq.Avatar = &req.Avatar
+	}
+	if req.About != "" {
+		updateReq.About = &req.About
+	}
+	if req.OldPassword != "" {
+		updateReq.OldPassword = &req.OldPassword
+	}
+	if req.NewPassword != "" {
+		updateReq.NewPassword_ = &req.NewPassword
+	}
+	if req.TwoFactorCode != "" {
+		updateReq.TwoFactorCode = &req.TwoFactorCode
+	}
+
+	resp, err := h.clients.UserClient.UpdateUser(c, updateReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.StatusCode != 0 {
+		errMsg := "更新用户信息失败"
+		if resp.Msg != nil {
+			errMsg = *resp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 关注用户
+func (h *HTTPHandler) FollowUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	followReq := &social.FollowActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       true,
+	}
+
+	resp, err := h.clients.SocialClient.FollowAction(c, followReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "关注用户失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	//关注私密账号时返回is_pending，表示已发送关注请求
+	h.success(ctx, map[string]interface{}{
+		"is_pending": resp.IsPending,
+	})
+}
+
+// 取消关注
+func (h *HTTPHandler) UnfollowUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	followReq := &social.FollowActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       false,
+	}
+
+	resp, err := h.clients.SocialClient.FollowAction(c, followReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "取消关注失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取关注列表，不传user_id时查看自己的列表
+func (h *HTTPHandler) GetFollowingList(c context.Context, ctx *app.RequestContext) {
+	currentUserID, _ := c.Value("user_id").(int64)
+	userID, _ := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+
+	if userID <= 0 {
+		userID = currentUserID
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	followingReq := &social.FollowListReq{
+		UserId:        userID,
+		CurrentUserId: currentUserID,
+		PageSize:      int32(pageSize),
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+	}
+
+	resp, err := h.clients.SocialClient.GetFollowList(c, followingReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取关注列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"users":       resp.Users,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+		"total":       resp.TotalCount,
+	})
+}
+
+// 获取粉丝列表，不传user_id时查看自己的列表
+func (h *HTTPHandler) GetFollowerList(c context.Context, ctx *app.RequestContext) {
+	currentUserID, _ := c.Value("user_id").(int64)
+	userID, _ := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+
+	if userID <= 0 {
+		userID = currentUserID
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	followerReq := &social.FollowerListReq{
+		UserId:        userID,
+		CurrentUserId: currentUserID,
+		PageSize:      int32(pageSize),
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+	}
+
+	resp, err := h.clients.SocialClient.GetFollowerList(c, followerReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取粉丝列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"users":       resp.Users,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+		"total":       resp.TotalCount,
+	})
+}
+
+// 通过关注请求
+func (h *HTTPHandler) ApproveFollowRequest(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		RequesterId int64 `json:"requester_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	handleReq := &social.HandleFollowRequestReq{
+		UserId:      userID,
+		RequesterId: req.RequesterId,
+		Approve:     true,
+	}
+
+	resp, err := h.clients.SocialClient.HandleFollowRequest(c, handleReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "通过关注请求失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 拒绝关注请求
+func (h *HTTPHandler) DeclineFollowRequest(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		RequesterId int64 `json:"requester_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	handleReq := &social.HandleFollowRequestReq{
+		UserId:      userID,
+		RequesterId: req.RequesterId,
+		Approve:     false,
+	}
+
+	resp, err := h.clients.SocialClient.HandleFollowRequest(c, handleReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "拒绝关注请求失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 撤回关注请求
+func (h *HTTPHandler) CancelFollowRequest(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	cancelReq := &social.CancelFollowRequestReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+	}
+
+	resp, err := h.clients.SocialClient.CancelFollowRequest(c, cancelReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "撤回关注请求失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取关注请求列表，direction=incoming为收到的请求，outgoing为发出的请求
+func (h *HTTPHandler) GetFollowRequests(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	var incoming bool
+	switch ctx.Query("direction") {
+	case "", "incoming":
+		incoming = true
+	case "outgoing":
+		incoming = false
+	default:
+		h.error(ctx, http.StatusBadRequest, "无效的请求方向")
+		return
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	listReq := &social.FollowRequestListReq{
+		UserId:   userID,
+		Incoming: incoming,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+
+	resp, err := h.clients.SocialClient.GetFollowRequests(c, listReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取关注请求失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"requests": resp.Requests,
+		"total":    resp.TotalCount,
+	})
+}
+
+// 设置私密账号，转为公开账号时自动通过待处理的关注请求
+func (h *HTTPHandler) SetAccountPrivacy(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		IsPrivate bool `json:"is_private"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	privacyReq := &social.SetAccountPrivacyReq{
+		UserId:    userID,
+		IsPrivate: req.IsPrivate,
+	}
+
+	resp, err := h.clients.SocialClient.SetAccountPrivacy(c, privacyReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "设置私密账号失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 拉黑用户
+func (h *HTTPHandler) BlockUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	blockReq := &social.BlockActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       true,
+	}
+
+	resp, err := h.clients.SocialClient.BlockAction(c, blockReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "拉黑用户失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 取消拉黑
+func (h *HTTPHandler) UnblockUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	blockReq := &social.BlockActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       false,
+	}
+
+	resp, err := h.clients.SocialClient.BlockAction(c, blockReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "取消拉黑失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 静音用户
+func (h *HTTPHandler) MuteUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	muteReq := &social.MuteActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       true,
+	}
+
+	resp, err := h.clients.SocialClient.MuteAction(c, muteReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "静音用户失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 取消静音
+func (h *HTTPHandler) UnmuteUser(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		TargetUserId int64 `json:"target_user_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	muteReq := &social.MuteActionReq{
+		UserId:       userID,
+		TargetUserId: req.TargetUserId,
+		Action:       false,
+	}
+
+	resp, err := h.clients.SocialClient.MuteAction(c, muteReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "取消静音失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取拉黑或静音列表
+func (h *HTTPHandler) GetBlockList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	var blockType int8
+	switch ctx.Query("type") {
+	case "", "block":
+		blockType = 1
+	case "mute":
+		blockType = 2
+	default:
+		h.error(ctx, http.StatusBadRequest, "无效的列表类型")
+		return
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	listReq := &social.BlockListReq{
+		UserId:    userID,
+		BlockType: blockType,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}
+
+	resp, err := h.clients.SocialClient.GetBlockList(c, listReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取拉黑列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"users": resp.Users,
+		"total": resp.TotalCount,
+	})
+}
+
+// 查询与目标用户的拉黑关系
+func (h *HTTPHandler) GetBlockStatus(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	targetUserID, err := strconv.ParseInt(ctx.Query("target_user_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的用户ID")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	checkReq := &social.CheckBlockReq{
+		UserId:       userID,
+		TargetUserId: targetUserID,
+	}
+
+	resp, err := h.clients.SocialClient.CheckBlock(c, checkReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "查询拉黑关系失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"is_blocked":    resp.IsBlocked,
+		"is_blocked_by": resp.IsBlockedBy,
+		"is_muted":      resp.IsMuted,
+	})
+}
+
+// 获取自己的可见名单，亲密好友名单始终排在第一位
+func (h *HTTPHandler) GetAudienceLists(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	resp, err := h.clients.SocialClient.GetAudienceLists(c, &social.AudienceListsReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取可见名单失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"lists": resp.Lists,
+	})
+}
+
+// 创建可见名单
+func (h *HTTPHandler) CreateAudienceList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	createReq := &social.CreateAudienceListReq{
+		UserId: userID,
+		Name:   req.Name,
+	}
+
+	resp, err := h.clients.SocialClient.CreateAudienceList(c, createReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "创建可见名单失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.List)
+}
+
+// 重命名可见名单
+func (h *HTTPHandler) RenameAudienceList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		ListId int64  `json:"list_id"`
+		Name   string `json:"name"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	renameReq := &social.RenameAudienceListReq{
+		UserId: userID,
+		ListId: req.ListId,
+		Name:   req.Name,
+	}
+
+	resp, err := h.clients.SocialClient.RenameAudienceList(c, renameReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "重命名可见名单失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 删除可见名单，引用该名单的内容之后只对作者可见
+func (h *HTTPHandler) DeleteAudienceList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		ListId int64 `json:"list_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	deleteReq := &social.DeleteAudienceListReq{
+		UserId: userID,
+		ListId: req.ListId,
+	}
+
+	resp, err := h.clients.SocialClient.DeleteAudienceList(c, deleteReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "删除可见名单失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 向可见名单添加成员
+func (h *HTTPHandler) AddAudienceMembers(c context.Context, ctx *app.RequestContext) {
+	h.updateAudienceMembers(c, ctx, true)
+}
+
+// 从可见名单移除成员
+func (h *HTTPHandler) RemoveAudienceMembers(c context.Context, ctx *app.RequestContext) {
+	h.updateAudienceMembers(c, ctx, false)
+}
+
+func (h *HTTPHandler) updateAudienceMembers(c context.Context, ctx *app.RequestContext, add bool) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		ListId    int64   `json:"list_id"`
+		MemberIds []int64 `json:"member_ids"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	updateReq := &social.UpdateAudienceMembersReq{
+		UserId:    userID,
+		ListId:    req.ListId,
+		MemberIds: req.MemberIds,
+		Add:       add,
+	}
+
+	resp, err := h.clients.SocialClient.UpdateAudienceMembers(c, updateReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "更新名单成员失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"changed_count": resp.ChangedCount,
+	})
+}
+
+// 获取可见名单成员
+func (h *HTTPHandler) GetAudienceMembers(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	listID, err := strconv.ParseInt(ctx.Query("list_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的名单ID")
+		return
+	}
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	if h.clients.SocialClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "社交服务不可用")
+		return
+	}
+
+	membersReq := &social.AudienceMembersReq{
+		UserId:   userID,
+		ListId:   listID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+
+	resp, err := h.clients.SocialClient.GetAudienceMembers(c, membersReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取名单成员失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"users": resp.Users,
+		"total": resp.TotalCount,
+	})
 }
 
 // 点赞视频
@@ -542,32 +2531,1602 @@ func (h *HTTPHandler) LikeVideo(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		VideoId int64 `json:"video_id"`
+		VideoId int64 `json:"video_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	likeReq := &interaction.LikeActionReq{
+		UserId:  userID,
+		VideoId: req.VideoId,
+		Action:  true,
+	}
+
+	resp, err := h.clients.InteractionClient.LikeAction(c, likeReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "点赞失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 表情回应，reaction为空表示取消回应
+func (h *HTTPHandler) ReactVideo(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId  int64  `json:"video_id"`
+		Reaction string `json:"reaction"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	reactReq := &interaction.ReactionActionReq{
+		UserId:   userID,
+		VideoId:  req.VideoId,
+		Reaction: req.Reaction,
+	}
+
+	resp, err := h.clients.InteractionClient.ReactionAction(c, reactReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "回应失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取回应视频的用户列表
+func (h *HTTPHandler) GetReactionUsers(c context.Context, ctx *app.RequestContext) {
+	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	reaction := ctx.Query("reaction")
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	usersReq := &interaction.ReactionUserListReq{
+		VideoId:  videoID,
+		Reaction: &reaction,
+		PageSize: int32(pageSize),
+		Cursor:   &cursor,
+	}
+
+	resp, err := h.clients.InteractionClient.GetReactionUsers(c, usersReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取回应用户失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"users":       resp.Users,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+	})
+}
+
+// 获取视频互动统计
+func (h *HTTPHandler) GetInteractionCount(c context.Context, ctx *app.RequestContext) {
+	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.GetCount(c, &interaction.CountReq{VideoId: videoID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取互动统计失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"like_count":      resp.LikeCount,
+		"comment_count":   resp.CommentCount,
+		"star_count":      resp.StarCount,
+		"share_count":     resp.ShareCount,
+		"reaction_counts": resp.ReactionCounts,
+	})
+}
+
+// 收藏/取消收藏视频，收藏时可同时放入多个收藏夹
+func (h *HTTPHandler) StarVideo(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId   int64   `json:"video_id"`
+		Action    bool    `json:"action"`
+		FolderIds []int64 `json:"folder_ids"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	starReq := &interaction.StarActionReq{
+		UserId:    userID,
+		VideoId:   req.VideoId,
+		Action:    req.Action,
+		FolderIds: req.FolderIds,
+	}
+
+	resp, err := h.clients.InteractionClient.StarAction(c, starReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "收藏操作失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取收藏视频列表，不传user_id时查看自己的收藏，传folder_id时只返回该收藏夹中的视频
+func (h *HTTPHandler) GetStarVideos(c context.Context, ctx *app.RequestContext) {
+	currentUserID, _ := c.Value("user_id").(int64)
+	userID, _ := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+	folderID, _ := strconv.ParseInt(ctx.Query("folder_id"), 10, 64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+
+	if userID <= 0 {
+		userID = currentUserID
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	listReq := &interaction.StarVideoListReq{
+		UserId:        userID,
+		CurrentUserId: currentUserID,
+		PageSize:      int32(pageSize),
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+	}
+	if folderID > 0 {
+		listReq.FolderId = &folderID
+	}
+
+	resp, err := h.clients.InteractionClient.GetStarVideoList(c, listReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取收藏列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"videos":      resp.Videos,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+		"total":       resp.TotalCount,
+	})
+}
+
+// 创建收藏夹
+func (h *HTTPHandler) CreateStarFolder(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Name     string `json:"name"`
+		IsPublic bool   `json:"is_public"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	createReq := &interaction.CreateStarFolderReq{
+		UserId:   userID,
+		Name:     req.Name,
+		IsPublic: req.IsPublic,
+	}
+
+	resp, err := h.clients.InteractionClient.CreateStarFolder(c, createReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "创建收藏夹失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Folder)
+}
+
+// 重命名收藏夹或修改公开设置
+func (h *HTTPHandler) UpdateStarFolder(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		FolderId int64   `json:"folder_id"`
+		Name     *string `json:"name"`
+		IsPublic *bool   `json:"is_public"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	updateReq := &interaction.UpdateStarFolderReq{
+		UserId:   userID,
+		FolderId: req.FolderId,
+		Name:     req.Name,
+		IsPublic: req.IsPublic,
+	}
+
+	resp, err := h.clients.InteractionClient.UpdateStarFolder(c, updateReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "更新收藏夹失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Folder)
+}
+
+// 删除收藏夹
+func (h *HTTPHandler) DeleteStarFolder(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		FolderId int64 `json:"folder_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	deleteReq := &interaction.DeleteStarFolderReq{
+		UserId:   userID,
+		FolderId: req.FolderId,
+	}
+
+	resp, err := h.clients.InteractionClient.DeleteStarFolder(c, deleteReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "删除收藏夹失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取收藏夹列表，不传user_id时查看自己的收藏夹，查看他人时只返回公开收藏夹
+func (h *HTTPHandler) GetStarFolders(c context.Context, ctx *app.RequestContext) {
+	currentUserID, _ := c.Value("user_id").(int64)
+	userID, _ := strconv.ParseInt(ctx.Query("user_id"), 10, 64)
+
+	if userID <= 0 {
+		userID = currentUserID
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	listReq := &interaction.StarFolderListReq{
+		UserId:        userID,
+		CurrentUserId: currentUserID,
+	}
+
+	resp, err := h.clients.InteractionClient.GetStarFolders(c, listReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取收藏夹列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Folders)
+}
+
+// 将已收藏的视频加入或移出收藏夹
+func (h *HTTPHandler) StarFolderItem(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		FolderId int64 `json:"folder_id"`
+		VideoId  int64 `json:"video_id"`
+		Action   bool  `json:"action"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	itemReq := &interaction.StarFolderItemActionReq{
+		UserId:   userID,
+		FolderId: req.FolderId,
+		VideoId:  req.VideoId,
+		Action:   req.Action,
+	}
+
+	resp, err := h.clients.InteractionClient.StarFolderItemAction(c, itemReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "收藏夹操作失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 将视频移动到另一个收藏夹
+func (h *HTTPHandler) MoveStarVideo(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId      int64 `json:"video_id"`
+		FromFolderId int64 `json:"from_folder_id"`
+		ToFolderId   int64 `json:"to_folder_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	moveReq := &interaction.MoveStarVideoReq{
+		UserId:       userID,
+		VideoId:      req.VideoId,
+		FromFolderId: req.FromFolderId,
+		ToFolderId:   req.ToFolderId,
+	}
+
+	resp, err := h.clients.InteractionClient.MoveStarVideo(c, moveReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "移动收藏视频失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 关注/取消关注公开收藏夹
+func (h *HTTPHandler) FollowStarFolder(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		FolderId int64 `json:"folder_id"`
+		Action   bool  `json:"action"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	followReq := &interaction.StarFolderFollowReq{
+		UserId:   userID,
+		FolderId: req.FolderId,
+		Action:   req.Action,
+	}
+
+	resp, err := h.clients.InteractionClient.StarFolderFollowAction(c, followReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "收藏夹关注操作失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取关注的收藏夹列表
+func (h *HTTPHandler) GetFollowedStarFolders(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.GetFollowedStarFolders(c, &interaction.FollowedStarFolderListReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取关注的收藏夹失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Folders)
+}
+
+// 生成分享短链
+func (h *HTTPHandler) CreateShareLink(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId int64  `json:"video_id"`
+		Channel string `json:"channel"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	linkReq := &interaction.CreateShareLinkReq{
+		UserId:  userID,
+		VideoId: req.VideoId,
+		Channel: req.Channel,
+	}
+
+	resp, err := h.clients.InteractionClient.CreateShareLink(c, linkReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "生成分享链接失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"code":    resp.Code,
+		"channel": resp.Channel,
+		"url":     config.Get().Share.BaseURL + resp.Code,
+	})
+}
+
+// 解析分享短链，记录点击后跳转到视频落地页
+func (h *HTTPHandler) ResolveShareLink(c context.Context, ctx *app.RequestContext) {
+	code := ctx.Param("code")
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.ResolveShareLink(c, &interaction.ResolveShareLinkReq{Code: code})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "分享链接不存在"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusNotFound, errMsg)
+		return
+	}
+
+	target := strings.NewReplacer(
+		"{video_id}", strconv.FormatInt(resp.VideoId, 10),
+		"{code}", url.QueryEscape(code),
+	).Replace(config.Get().Share.LandingURL)
+
+	ctx.Redirect(http.StatusFound, []byte(target))
+}
+
+// 上报分享链接带来的观看
+func (h *HTTPHandler) RecordShareView(c context.Context, ctx *app.RequestContext) {
+	h.recordShareConversion(c, ctx, 0, "view")
+}
+
+// 上报通过分享链接注册安装，记录邀请归因
+func (h *HTTPHandler) RecordShareInstall(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	h.recordShareConversion(c, ctx, userID, "install")
+}
+
+func (h *HTTPHandler) recordShareConversion(c context.Context, ctx *app.RequestContext, visitorID int64, event string) {
+	var req struct {
+		Code string `json:"code"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	conversionReq := &interaction.ShareConversionReq{
+		Code:      req.Code,
+		VisitorId: visitorID,
+		Event:     event,
+	}
+
+	resp, err := h.clients.InteractionClient.RecordShareConversion(c, conversionReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "记录分享转化失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取视频按渠道的分享数据（视频作者）
+func (h *HTTPHandler) GetShareStats(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	statsReq := &interaction.ShareStatsReq{
+		UserId:  userID,
+		VideoId: videoID,
+	}
+
+	resp, err := h.clients.InteractionClient.GetShareStats(c, statsReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取分享统计失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Channels)
+}
+
+// 获取邀请自己的用户
+func (h *HTTPHandler) GetInvitedBy(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.GetInvitedBy(c, &interaction.InvitedByReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取邀请信息失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if resp.Inviter == nil {
+		h.success(ctx, nil)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"inviter":    resp.Inviter,
+		"video_id":   resp.VideoId,
+		"channel":    resp.Channel,
+		"invited_at": resp.InvitedAt,
+	})
+}
+
+// 取消点赞
+func (h *HTTPHandler) UnlikeVideo(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId int64 `json:"video_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	likeReq := &interaction.LikeActionReq{
+		UserId:  userID,
+		VideoId: req.VideoId,
+		Action:  false,
+	}
+
+	resp, err := h.clients.InteractionClient.LikeAction(c, likeReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp.StatusCode != 0 {
+		errMsg := "取消点赞失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 评论视频
+func (h *HTTPHandler) CommentVideo(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId   int64  `json:"video_id"`
+		Content   string `json:"content"`
+		ReplyToId int64  `json:"reply_to_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	commentReq := &interaction.CommentActionReq{
+		UserId:  userID,
+		VideoId: req.VideoId,
+		Content: req.Content,
+	}
+	if req.ReplyToId > 0 {
+		commentReq.ReplyToId = &req.ReplyToId
+	}
+
+	resp, err := h.clients.InteractionClient.CommentAction(c, commentReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "评论失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Comment)
+}
+
+// 获取评论列表
+func (h *HTTPHandler) GetComments(c context.Context, ctx *app.RequestContext) {
+	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	userID, _ := c.Value("user_id").(int64)
+	sortType := ctx.Query("sort")
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	commentsReq := &interaction.CommentListReq{
+		VideoId:       videoID,
+		CurrentUserId: userID,
+		PageSize:      int32(pageSize),
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+		Sort:          &sortType,
+	}
+
+	resp, err := h.clients.InteractionClient.GetCommentList(c, commentsReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取评论列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"comments":       resp.Comments,
+		"pinned_comment": resp.PinnedComment,
+		"reply_previews": resp.ReplyPreviews,
+		"next_cursor":    resp.GetNextCursor(),
+		"has_more":       resp.HasMore,
+		"total":          resp.TotalCount,
+	})
+}
+
+// 获取评论回复列表
+func (h *HTTPHandler) GetCommentReplies(c context.Context, ctx *app.RequestContext) {
+	commentID, err := strconv.ParseInt(ctx.Query("comment_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的评论ID")
+		return
+	}
+
+	userID, _ := c.Value("user_id").(int64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	repliesReq := &interaction.CommentRepliesReq{
+		CommentId:     commentID,
+		CurrentUserId: userID,
+		PageSize:      int32(pageSize),
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+	}
+
+	resp, err := h.clients.InteractionClient.GetCommentReplies(c, repliesReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取评论回复失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"comments":    resp.Comments,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+		"total":       resp.TotalCount,
+	})
+}
+
+// 删除评论
+func (h *HTTPHandler) DeleteComment(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId   int64 `json:"video_id"`
+		CommentId int64 `json:"comment_id"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	deleteReq := &interaction.DeleteCommentReq{
+		UserId:    userID,
+		VideoId:   req.VideoId,
+		CommentId: req.CommentId,
+	}
+
+	resp, err := h.clients.InteractionClient.DeleteComment(c, deleteReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "删除评论失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 评论点赞/取消点赞
+func (h *HTTPHandler) LikeComment(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		CommentId int64 `json:"comment_id"`
+		Action    bool  `json:"action"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	likeReq := &interaction.CommentLikeActionReq{
+		UserId:    userID,
+		CommentId: req.CommentId,
+		Action:    req.Action,
+	}
+
+	resp, err := h.clients.InteractionClient.CommentLikeAction(c, likeReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "评论点赞失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 置顶/取消置顶评论
+func (h *HTTPHandler) PinComment(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId   int64 `json:"video_id"`
+		CommentId int64 `json:"comment_id"`
+		Action    bool  `json:"action"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	pinReq := &interaction.PinCommentReq{
+		UserId:    userID,
+		VideoId:   req.VideoId,
+		CommentId: req.CommentId,
+		Action:    req.Action,
+	}
+
+	resp, err := h.clients.InteractionClient.PinComment(c, pinReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "置顶评论失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 设置视频评论权限
+func (h *HTTPHandler) SetCommentPermission(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		VideoId    int64  `json:"video_id"`
+		Permission string `json:"permission"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	permissionReq := &interaction.SetCommentPermissionReq{
+		UserId:     userID,
+		VideoId:    req.VideoId,
+		Permission: req.Permission,
+	}
+
+	resp, err := h.clients.InteractionClient.SetCommentPermission(c, permissionReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "设置评论权限失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取视频评论权限
+func (h *HTTPHandler) GetCommentPermission(c context.Context, ctx *app.RequestContext) {
+	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.GetCommentPermission(c, &interaction.GetCommentPermissionReq{VideoId: videoID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取评论权限失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"permission": resp.Permission,
+	})
+}
+
+// 添加/删除评论关键词
+func (h *HTTPHandler) CommentKeywordAction(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		Keyword string `json:"keyword"`
+		Action  bool   `json:"action"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	keywordReq := &interaction.CommentKeywordActionReq{
+		UserId:  userID,
+		Keyword: req.Keyword,
+		Action:  req.Action,
+	}
+
+	resp, err := h.clients.InteractionClient.CommentKeywordAction(c, keywordReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "评论关键词操作失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取评论关键词列表
+func (h *HTTPHandler) GetCommentKeywords(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	resp, err := h.clients.InteractionClient.GetCommentKeywords(c, &interaction.CommentKeywordListReq{UserId: userID})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取评论关键词失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, resp.Keywords)
+}
+
+// 获取待审核评论列表
+func (h *HTTPHandler) GetPendingComments(c context.Context, ctx *app.RequestContext) {
+	h.getPendingComments(c, ctx, false)
+}
+
+// 获取命中平台敏感词、等待版主审核的评论列表
+func (h *HTTPHandler) GetModerationComments(c context.Context, ctx *app.RequestContext) {
+	h.getPendingComments(c, ctx, true)
+}
+
+func (h *HTTPHandler) getPendingComments(c context.Context, ctx *app.RequestContext, moderation bool) {
+	userID, _ := c.Value("user_id").(int64)
+
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	pendingReq := &interaction.PendingCommentListReq{
+		UserId:     userID,
+		PageSize:   int32(pageSize),
+		Cursor:     &cursor,
+		Moderation: &moderation,
+	}
+
+	resp, err := h.clients.InteractionClient.GetPendingComments(c, pendingReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取待审核评论失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"comments":    resp.Comments,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+	})
+}
+
+// 审核评论
+func (h *HTTPHandler) ReviewComment(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		CommentId int64 `json:"comment_id"`
+		Approve   bool  `json:"approve"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.InteractionClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+		return
+	}
+
+	reviewReq := &interaction.ReviewCommentReq{
+		UserId:    userID,
+		CommentId: req.CommentId,
+		Approve:   req.Approve,
+	}
+
+	resp, err := h.clients.InteractionClient.ReviewComment(c, reviewReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "审核评论失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 发送消息
+func (h *HTTPHandler) SendMessage(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		ReceiverId int64  `json:"receiver_id"`
+		Content    string `json:"content"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.MessageClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+		return
+	}
+
+	sendReq := &message.SendMessageReq{
+		SenderId:   userID,
+		ReceiverId: req.ReceiverId,
+		Content:    req.Content,
+	}
+
+	resp, err := h.clients.MessageClient.SendMessage(c, sendReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "发送消息失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"message_id": resp.MessageId,
+		"content":    resp.GetContent(),
+		"pending":    resp.Pending,
+	})
+}
+
+// 单次查询在线状态的最大用户数
+const maxOnlineStatusUsers = 20
+
+// 批量查询用户在线状态，以是否有WebSocket连接为准；关闭了在线状态展示的用户始终显示为离线
+func (h *HTTPHandler) GetOnlineStatus(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var targetIDs []int64
+	for _, value := range strings.Split(ctx.Query("user_ids"), ",") {
+		targetID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || targetID <= 0 {
+			continue
+		}
+		targetIDs = append(targetIDs, targetID)
+	}
+	if len(targetIDs) == 0 || len(targetIDs) > maxOnlineStatusUsers {
+		h.error(ctx, http.StatusBadRequest, "无效的用户ID列表")
+		return
+	}
+
+	if h.clients.UserClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "用户服务不可用")
+		return
+	}
+
+	online := make(map[int64]bool, len(targetIDs))
+	for _, targetID := range targetIDs {
+		if wsManager == nil || !wsManager.IsOnline(targetID) {
+			online[targetID] = false
+			continue
+		}
+		if targetID == userID {
+			online[targetID] = true
+			continue
+		}
+		//读取设置失败时按隐藏处理
+		resp, err := h.clients.UserClient.GetSettings(c, &user.GetSettingsReq{UserId: targetID})
+		online[targetID] = err == nil && resp.BaseResp != nil && resp.BaseResp.StatusCode == 0 &&
+			resp.Settings != nil && resp.Settings.ShowOnlineStatus
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"online": online,
+	})
+}
+
+// 待审核私信列表
+func (h *HTTPHandler) GetPendingMessages(c context.Context, ctx *app.RequestContext) {
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if h.clients.MessageClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+		return
+	}
+
+	resp, err := h.clients.MessageClient.GetPendingMessages(c, &message.GetPendingMessagesReq{
+		PageSize: int32(pageSize),
+		Cursor:   &cursor,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取待审核私信失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"messages":    resp.Messages,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+	})
+}
+
+// 审核私信，通过后推送给在线的接收者
+func (h *HTTPHandler) ReviewMessage(c context.Context, ctx *app.RequestContext) {
+	var req struct {
+		MessageID int64 `json:"message_id"`
+		Approve   bool  `json:"approve"`
+	}
+	if err := ctx.Bind(&req); err != nil || req.MessageID <= 0 {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
+		return
+	}
+
+	if h.clients.MessageClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+		return
+	}
+
+	resp, err := h.clients.MessageClient.ReviewMessage(c, &message.ReviewMessageReq{
+		MessageId: req.MessageID,
+		Approve:   req.Approve,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "审核私信失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	if req.Approve && resp.Message != nil && wsManager != nil {
+		content, _ := json.Marshal(map[string]interface{}{
+			"receiver_id": resp.Message.ReceiveId,
+			"content":     resp.Message.Content,
+		})
+		responseData, _ := json.Marshal(WSMessage{Type: MessageTypeChat, Content: content})
+		wsManager.BroadcastToUser(resp.Message.ReceiveId, responseData)
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取消息列表
+func (h *HTTPHandler) GetMessageList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	otherUserID, _ := strconv.ParseInt(ctx.Query("other_user_id"), 10, 64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+
+	if h.clients.MessageClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+		return
+	}
+
+	messageReq := &message.GetChatHistoryReq{
+		UserId1:  userID,
+		UserId2:  otherUserID,
+		PageSize: int32(pageSize),
+		Cursor:   &cursor,
+	}
+
+	resp, err := h.clients.MessageClient.GetChatHistory(c, messageReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取消息列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"messages":    resp.Messages,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+	})
+}
+
+// 开始直播
+func (h *HTTPHandler) StartLive(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	var req struct {
+		RoomId  int64  `json:"room_id"`
+		RtmpUrl string `json:"rtmp_url"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.InteractionClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+	if h.clients.LiveClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
 		return
 	}
 
-	likeReq := &interaction.LikeActionReq{
-		UserId:  userID,
-		VideoId: req.VideoId,
-		Action:  true,
+	startReq := &live.StartLiveReq{
+		HostId:  userID,
+		RoomId:  req.RoomId,
+		RtmpUrl: req.RtmpUrl,
 	}
 
-	resp, err := h.clients.InteractionClient.LikeAction(c, likeReq)
+	resp, err := h.clients.LiveClient.StartLive(c, startReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "点赞失败"
+		errMsg := "开始直播失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -578,37 +4137,36 @@ func (h *HTTPHandler) LikeVideo(c context.Context, ctx *app.RequestContext) {
 	h.success(ctx, nil)
 }
 
-// 取消点赞
-func (h *HTTPHandler) UnlikeVideo(c context.Context, ctx *app.RequestContext) {
+// 停止直播
+func (h *HTTPHandler) StopLive(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		VideoId int64 `json:"video_id"`
+		RoomId int64 `json:"room_id"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.InteractionClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+	if h.clients.LiveClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
 		return
 	}
 
-	likeReq := &interaction.LikeActionReq{
-		UserId:  userID,
-		VideoId: req.VideoId,
-		Action:  false,
+	stopReq := &live.StopLiveReq{
+		HostId: userID,
+		RoomId: req.RoomId,
 	}
 
-	resp, err := h.clients.InteractionClient.LikeAction(c, likeReq)
+	resp, err := h.clients.LiveClient.StopLive(c, stopReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "取消点赞失败"
+		errMsg := "停止直播失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -619,38 +4177,137 @@ func (h *HTTPHandler) UnlikeVideo(c context.Context, ctx *app.RequestContext) {
 	h.success(ctx, nil)
 }
 
-// 评论视频
-func (h *HTTPHandler) CommentVideo(c context.Context, ctx *app.RequestContext) {
+// 获取直播列表
+func (h *HTTPHandler) GetLiveList(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	cursor := ctx.Query("cursor")
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	needTotal, _ := strconv.ParseBool(ctx.Query("need_total"))
+	followingOnly, _ := strconv.ParseBool(ctx.Query("following_only"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if h.clients.LiveClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
+		return
+	}
+
+	liveReq := &live.GetLiveRoomsReq{
+		UserId:        userID,
+		PageSize:      int32(pageSize),
+		FollowingOnly: &followingOnly,
+		Cursor:        &cursor,
+		NeedTotal:     &needTotal,
+	}
+
+	resp, err := h.clients.LiveClient.GetLiveRooms(c, liveReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取直播列表失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"rooms":       resp.Rooms,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+		"total":       resp.TotalCount,
+	})
+}
+
+// 发送弹幕
+func (h *HTTPHandler) SendDanmu(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		VideoId int64  `json:"video_id"`
-		Content string `json:"content"`
+		LiveId   int64  `json:"live_id"`
+		Content  string `json:"content"`
+		Color    string `json:"color"`
+		Position int32  `json:"position"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.InteractionClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+	if h.clients.DanmuClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "弹幕服务不可用")
+		return
+	}
+
+	danmuReq := &danmu.SendDanmuReq{
+		UserId:  userID,
+		LiveId:  req.LiveId,
+		Content: req.Content,
+	}
+
+	if req.Color != "" {
+		danmuReq.Color = &req.Color
+	}
+	if req.Position > 0 {
+		danmuReq.Position = &req.Position
+	}
+
+	resp, err := h.clients.DanmuClient.SendDanmu(c, danmuReq)
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	commentReq := &interaction.CommentActionReq{
-		UserId:  userID,
-		VideoId: req.VideoId,
-		Content: req.Content,
+
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "发送弹幕失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, map[string]interface{}{
+		"danmu_id": resp.DanmuId,
+		"content":  resp.GetContent(),
+	})
+}
+
+// 获取弹幕列表
+func (h *HTTPHandler) GetDanmuList(c context.Context, ctx *app.RequestContext) {
+	liveID, err := strconv.ParseInt(ctx.Query("live_id"), 10, 64)
+	if err != nil {
+		h.error(ctx, http.StatusBadRequest, "无效的直播ID")
+		return
+	}
+
+	if h.clients.DanmuClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "弹幕服务不可用")
+		return
+	}
+
+	userID, _ := c.Value("user_id").(int64)
+	danmuReq := &danmu.GetDanmuHistoryReq{
+		LiveId: liveID,
+	}
+	if userID > 0 {
+		danmuReq.CurrentUserId = &userID
 	}
 
-	resp, err := h.clients.InteractionClient.CommentAction(c, commentReq)
+	resp, err := h.clients.DanmuClient.GetDanmuHistory(c, danmuReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "评论失败"
+		errMsg := "获取弹幕列表失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -658,46 +4315,36 @@ func (h *HTTPHandler) CommentVideo(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, resp.Comment)
+	h.success(ctx, resp.Danmus)
 }
 
-// 获取评论列表
-func (h *HTTPHandler) GetComments(c context.Context, ctx *app.RequestContext) {
-	videoID, err := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
-	if err != nil {
-		h.error(ctx, http.StatusBadRequest, "无效的视频ID")
-		return
-	}
-
-	page, _ := strconv.Atoi(ctx.Query("page"))
+// 获取推荐视频
+func (h *HTTPHandler) GetRecommendedVideos(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
 	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if page <= 0 {
-		page = 1
-	}
 	if pageSize <= 0 {
 		pageSize = 10
 	}
 
-	if h.clients.InteractionClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "交互服务不可用")
+	if h.clients.RecommendClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "推荐服务不可用")
 		return
 	}
 
-	commentsReq := &interaction.CommentListReq{
-		VideoId:  videoID,
-		Page:     int32(page),
+	recommendReq := &recommend.GetRecommendVideosReq{
+		UserId:   userID,
 		PageSize: int32(pageSize),
 	}
 
-	resp, err := h.clients.InteractionClient.GetCommentList(c, commentsReq)
+	resp, err := h.clients.RecommendClient.GetRecommendVideos(c, recommendReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取评论列表失败"
+		errMsg := "获取推荐视频失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -705,41 +4352,51 @@ func (h *HTTPHandler) GetComments(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, resp.Comments)
+	h.success(ctx, resp.Videos)
 }
 
-// 发送消息
-func (h *HTTPHandler) SendMessage(c context.Context, ctx *app.RequestContext) {
+// 提交不感兴趣/低质量反馈
+func (h *HTTPHandler) SubmitRecommendFeedback(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		ReceiverId int64  `json:"receiver_id"`
-		Content    string `json:"content"`
+		Reason   string `json:"reason"`
+		VideoId  int64  `json:"video_id"`
+		AuthorId int64  `json:"author_id"`
+		Tag      string `json:"tag"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.MessageClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+	if h.clients.RecommendClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "推荐服务不可用")
 		return
 	}
 
-	sendReq := &message.SendMessageReq{
-		SenderId:   userID,
-		ReceiverId: req.ReceiverId,
-		Content:    req.Content,
+	feedbackReq := &recommend.SubmitFeedbackReq{
+		UserId: userID,
+		Reason: req.Reason,
+	}
+	if req.VideoId > 0 {
+		feedbackReq.VideoId = &req.VideoId
+	}
+	if req.AuthorId > 0 {
+		feedbackReq.AuthorId = &req.AuthorId
+	}
+	if req.Tag != "" {
+		feedbackReq.Tag = &req.Tag
 	}
 
-	resp, err := h.clients.MessageClient.SendMessage(c, sendReq)
+	resp, err := h.clients.RecommendClient.SubmitFeedback(c, feedbackReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "发送消息失败"
+		errMsg := "提交反馈失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -748,33 +4405,46 @@ func (h *HTTPHandler) SendMessage(c context.Context, ctx *app.RequestContext) {
 	}
 
 	h.success(ctx, map[string]interface{}{
-		"message_id": resp.MessageId,
+		"feedback_id": resp.FeedbackId,
 	})
 }
 
-// 获取消息列表
-func (h *HTTPHandler) GetMessageList(c context.Context, ctx *app.RequestContext) {
+// 获取已屏蔽内容列表
+func (h *HTTPHandler) GetRecommendFeedbackList(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
-	otherUserID, _ := strconv.ParseInt(ctx.Query("other_user_id"), 10, 64)
+	targetType := ctx.Query("target_type")
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if h.clients.MessageClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "消息服务不可用")
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	if h.clients.RecommendClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "推荐服务不可用")
 		return
 	}
 
-	messageReq := &message.GetChatHistoryReq{
-		UserId1: userID,
-		UserId2: otherUserID,
+	listReq := &recommend.GetFeedbackListReq{
+		UserId:   userID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+	if targetType != "" {
+		listReq.TargetType = &targetType
 	}
 
-	resp, err := h.clients.MessageClient.GetChatHistory(c, messageReq)
+	resp, err := h.clients.RecommendClient.GetFeedbackList(c, listReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取消息列表失败"
+		errMsg := "获取反馈列表失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -782,41 +4452,40 @@ func (h *HTTPHandler) GetMessageList(c context.Context, ctx *app.RequestContext)
 		return
 	}
 
-	h.success(ctx, resp.Messages)
+	h.success(ctx, map[string]interface{}{
+		"feedbacks": resp.Feedbacks,
+		"total":     resp.Total,
+	})
 }
 
-// 开始直播
-func (h *HTTPHandler) StartLive(c context.Context, ctx *app.RequestContext) {
+// 撤销反馈
+func (h *HTTPHandler) UndoRecommendFeedback(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		RoomId  int64  `json:"room_id"`
-		RtmpUrl string `json:"rtmp_url"`
+		FeedbackId int64 `json:"feedback_id"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.LiveClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
+	if h.clients.RecommendClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "推荐服务不可用")
 		return
 	}
 
-	startReq := &live.StartLiveReq{
-		HostId:  userID,
-		RoomId:  req.RoomId,
-		RtmpUrl: req.RtmpUrl,
-	}
-
-	resp, err := h.clients.LiveClient.StartLive(c, startReq)
+	resp, err := h.clients.RecommendClient.UndoFeedback(c, &recommend.UndoFeedbackReq{
+		UserId:     userID,
+		FeedbackId: req.FeedbackId,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "开始直播失败"
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "撤销反馈失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -827,36 +4496,71 @@ func (h *HTTPHandler) StartLive(c context.Context, ctx *app.RequestContext) {
 	h.success(ctx, nil)
 }
 
-// 停止直播
-func (h *HTTPHandler) StopLive(c context.Context, ctx *app.RequestContext) {
+// 上报播放进度（播放心跳）
+func (h *HTTPHandler) ReportWatchProgress(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		RoomId int64 `json:"room_id"`
+		VideoId  int64 `json:"video_id"`
+		Position int32 `json:"position"`
+		Duration int32 `json:"duration"`
+		Finished bool  `json:"finished"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.LiveClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
 		return
 	}
 
-	stopReq := &live.StopLiveReq{
-		HostId: userID,
-		RoomId: req.RoomId,
+	resp, err := h.clients.VideoClient.ReportWatchProgress(c, &video.ReportWatchProgressReq{
+		UserId:   userID,
+		VideoId:  req.VideoId,
+		Position: req.Position,
+		Duration: req.Duration,
+		Finished: &req.Finished,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
 	}
 
-	resp, err := h.clients.LiveClient.StopLive(c, stopReq)
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "上报播放进度失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	h.success(ctx, nil)
+}
+
+// 获取续播进度
+func (h *HTTPHandler) GetWatchProgress(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+	videoID, _ := strconv.ParseInt(ctx.Query("video_id"), 10, 64)
+
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
+	}
+
+	resp, err := h.clients.VideoClient.GetWatchProgress(c, &video.WatchProgressReq{
+		UserId:  userID,
+		VideoId: videoID,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if resp.BaseResp.StatusCode != 0 {
-		errMsg := "停止直播失败"
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "获取播放进度失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -864,41 +4568,47 @@ func (h *HTTPHandler) StopLive(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, nil)
+	h.success(ctx, map[string]interface{}{
+		"exists":          resp.Exists,
+		"position":        resp.Position,
+		"duration":        resp.Duration,
+		"progress":        resp.Progress,
+		"resume_position": resp.ResumePosition,
+		"watched_at":      resp.WatchedAt,
+	})
 }
 
-// 获取直播列表
-func (h *HTTPHandler) GetLiveList(c context.Context, ctx *app.RequestContext) {
+// 获取观看历史
+func (h *HTTPHandler) GetWatchHistory(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
-	page, _ := strconv.Atoi(ctx.Query("page"))
+	cursor, _ := strconv.ParseInt(ctx.Query("cursor"), 10, 64)
 	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if page <= 0 {
-		page = 1
-	}
 	if pageSize <= 0 {
-		pageSize = 10
+		pageSize = 20
 	}
 
-	if h.clients.LiveClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "直播服务不可用")
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
 		return
 	}
 
-	liveReq := &live.GetLiveRoomsReq{
+	historyReq := &video.WatchHistoryReq{
 		UserId:   userID,
-		Page:     int32(page),
 		PageSize: int32(pageSize),
 	}
+	if cursor > 0 {
+		historyReq.Cursor = &cursor
+	}
 
-	resp, err := h.clients.LiveClient.GetLiveRooms(c, liveReq)
+	resp, err := h.clients.VideoClient.GetWatchHistory(c, historyReq)
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取直播列表失败"
+		errMsg := "获取观看历史失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -906,50 +4616,71 @@ func (h *HTTPHandler) GetLiveList(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, resp.Rooms)
+	h.success(ctx, map[string]interface{}{
+		"items":       resp.Items,
+		"next_cursor": resp.NextCursor,
+		"has_more":    resp.HasMore,
+		"paused":      resp.Paused,
+	})
 }
 
-// 发送弹幕
-func (h *HTTPHandler) SendDanmu(c context.Context, ctx *app.RequestContext) {
+// 删除单条观看记录
+func (h *HTTPHandler) DeleteWatchHistory(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
 
 	var req struct {
-		LiveId   int64  `json:"live_id"`
-		Content  string `json:"content"`
-		Color    string `json:"color"`
-		Position int32  `json:"position"`
+		VideoId int64 `json:"video_id"`
 	}
 	if err := ctx.Bind(&req); err != nil {
 		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	if h.clients.DanmuClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "弹幕服务不可用")
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
 		return
 	}
 
-	danmuReq := &danmu.SendDanmuReq{
+	resp, err := h.clients.VideoClient.DeleteWatchHistory(c, &video.DeleteWatchHistoryReq{
 		UserId:  userID,
-		LiveId:  req.LiveId,
-		Content: req.Content,
+		VideoId: req.VideoId,
+	})
+	if err != nil {
+		h.error(ctx, http.StatusInternalServerError, err.Error())
+		return
 	}
 
-	if req.Color != "" {
-		danmuReq.Color = &req.Color
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		errMsg := "删除观看记录失败"
+		if resp.BaseResp.Msg != nil {
+			errMsg = *resp.BaseResp.Msg
+		}
+		h.error(ctx, http.StatusBadRequest, errMsg)
+		return
 	}
-	if req.Position > 0 {
-		danmuReq.Position = &req.Position
+
+	h.success(ctx, nil)
+}
+
+// 清空观看历史
+func (h *HTTPHandler) ClearWatchHistory(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
+
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
 	}
 
-	resp, err := h.clients.DanmuClient.SendDanmu(c, danmuReq)
+	resp, err := h.clients.VideoClient.ClearWatchHistory(c, &video.ClearWatchHistoryReq{
+		UserId: userID,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "发送弹幕失败"
+		errMsg := "清空观看历史失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -957,36 +4688,37 @@ func (h *HTTPHandler) SendDanmu(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, map[string]interface{}{
-		"danmu_id": resp.DanmuId,
-	})
+	h.success(ctx, nil)
 }
 
-// 获取弹幕列表
-func (h *HTTPHandler) GetDanmuList(c context.Context, ctx *app.RequestContext) {
-	liveID, err := strconv.ParseInt(ctx.Query("live_id"), 10, 64)
-	if err != nil {
-		h.error(ctx, http.StatusBadRequest, "无效的直播ID")
-		return
-	}
+// 暂停或恢复记录观看历史
+func (h *HTTPHandler) SetWatchHistoryPaused(c context.Context, ctx *app.RequestContext) {
+	userID, _ := c.Value("user_id").(int64)
 
-	if h.clients.DanmuClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "弹幕服务不可用")
+	var req struct {
+		Paused bool `json:"paused"`
+	}
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	danmuReq := &danmu.GetDanmuHistoryReq{
-		LiveId: liveID,
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
 	}
 
-	resp, err := h.clients.DanmuClient.GetDanmuHistory(c, danmuReq)
+	resp, err := h.clients.VideoClient.SetWatchHistoryPaused(c, &video.SetWatchHistoryPausedReq{
+		UserId: userID,
+		Paused: req.Paused,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取弹幕列表失败"
+		errMsg := "设置观看历史失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -994,36 +4726,39 @@ func (h *HTTPHandler) GetDanmuList(c context.Context, ctx *app.RequestContext) {
 		return
 	}
 
-	h.success(ctx, resp.Danmus)
+	h.success(ctx, nil)
 }
 
-// 获取推荐视频
-func (h *HTTPHandler) GetRecommendedVideos(c context.Context, ctx *app.RequestContext) {
+// 设置视频的可见范围，audience_list_id为0表示公开
+func (h *HTTPHandler) SetVideoVisibility(c context.Context, ctx *app.RequestContext) {
 	userID, _ := c.Value("user_id").(int64)
-	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
 
-	if pageSize <= 0 {
-		pageSize = 10
+	var req struct {
+		VideoId        int64 `json:"video_id"`
+		AudienceListId int64 `json:"audience_list_id"`
 	}
-
-	if h.clients.RecommendClient == nil {
-		h.error(ctx, http.StatusServiceUnavailable, "推荐服务不可用")
+	if err := ctx.Bind(&req); err != nil {
+		h.error(ctx, http.StatusBadRequest, "请求体无效")
 		return
 	}
 
-	recommendReq := &recommend.GetRecommendVideosReq{
-		UserId:   userID,
-		PageSize: int32(pageSize),
+	if h.clients.VideoClient == nil {
+		h.error(ctx, http.StatusServiceUnavailable, "视频服务不可用")
+		return
 	}
 
-	resp, err := h.clients.RecommendClient.GetRecommendVideos(c, recommendReq)
+	resp, err := h.clients.VideoClient.UpdateVideoInfo(c, &video.UpdateVideoInfoReq{
+		VideoId:        req.VideoId,
+		UserId:         userID,
+		AudienceListId: &req.AudienceListId,
+	})
 	if err != nil {
 		h.error(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
-		errMsg := "获取推荐视频失败"
+		errMsg := "设置视频可见范围失败"
 		if resp.BaseResp.Msg != nil {
 			errMsg = *resp.BaseResp.Msg
 		}
@@ -1031,5 +4766,5 @@ func (h *HTTPHandler) GetRecommendedVideos(c context.Context, ctx *app.RequestCo
 		return
 	}
 
-	h.success(ctx, resp.Videos)
+	h.success(ctx, nil)
 }
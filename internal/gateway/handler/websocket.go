@@ -135,6 +135,19 @@ func (manager *WSManager) BroadcastToUser(userID int64, message []byte) {
 	}
 }
 
+// 用户是否有在线的WebSocket连接
+func (manager *WSManager) IsOnline(userID int64) bool {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	for client := range manager.clients {
+		if client.userID == userID {
+			return true
+		}
+	}
+	return false
+}
+
 // 处理WebSocket连接
 func (h *HTTPHandler) HandleWebSocket(c context.Context, ctx *app.RequestContext) {
 	userID := int64(0)
@@ -232,11 +245,25 @@ func (h *HTTPHandler) handleChatMessage(client *WSClient, content json.RawMessag
 			Content:    chatMsg.Content,
 		}
 
-		_, err := h.clients.MessageClient.SendMessage(context.Background(), sendReq)
+		resp, err := h.clients.MessageClient.SendMessage(context.Background(), sendReq)
 		if err != nil {
 			log.Printf("发送消息失败: %v", err)
 			return
 		}
+		if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+			log.Printf("发送消息失败: %s", resp.BaseResp.GetMsg())
+			return
+		}
+		//待审核的私信审核通过后再推送给接收者
+		if resp.Pending {
+			return
+		}
+
+		//广播经过敏感词过滤后的内容
+		if resp.Content != nil {
+			chatMsg.Content = *resp.Content
+			content, _ = json.Marshal(chatMsg)
+		}
 	}
 
 	//广播消息给接收者
@@ -253,10 +280,12 @@ func (h *HTTPHandler) handleChatMessage(client *WSClient, content json.RawMessag
 func (h *HTTPHandler) handleDanmuMessage(client *WSClient, content json.RawMessage) {
 	//解析弹幕消息
 	var danmuMsg struct {
-		LiveID   int64  `json:"live_id"`
-		Content  string `json:"content"`
-		Color    string `json:"color"`
-		Position int32  `json:"position"`
+		LiveID    int64  `json:"live_id"`
+		Content   string `json:"content"`
+		Color     string `json:"color"`
+		Position  int32  `json:"position"`
+		UserID    int64  `json:"user_id"`
+		UserLevel int32  `json:"user_level"`
 	}
 
 	if err := json.Unmarshal(content, &danmuMsg); err != nil {
@@ -279,11 +308,23 @@ func (h *HTTPHandler) handleDanmuMessage(client *WSClient, content json.RawMessa
 			danmuReq.Position = &danmuMsg.Position
 		}
 
-		_, err := h.clients.DanmuClient.SendDanmu(context.Background(), danmuReq)
+		resp, err := h.clients.DanmuClient.SendDanmu(context.Background(), danmuReq)
 		if err != nil {
 			log.Printf("发送弹幕失败: %v", err)
 			return
 		}
+		if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+			log.Printf("发送弹幕失败: %s", resp.BaseResp.GetMsg())
+			return
+		}
+
+		//广播经过敏感词过滤后的内容和发送者等级，客户端据此展示等级徽章、隐藏低等级用户弹幕
+		if resp.Content != nil {
+			danmuMsg.Content = *resp.Content
+		}
+		danmuMsg.UserID = client.userID
+		danmuMsg.UserLevel = resp.GetUserLevel()
+		content, _ = json.Marshal(danmuMsg)
 	}
 
 	//广播弹幕给直播间所有用户
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"shortvideo/kitex_gen/user/userservice"
+	"shortvideo/pkg/rbac"
 
 	"github.com/cloudwego/hertz/pkg/app"
 )
@@ -39,16 +40,59 @@ func (m *AuthMiddleware) Handle() app.HandlerFunc {
 			return
 		}
 
-		token := parts[1]
-
-		userID, err := m.userClient.VerifyToken(c, token)
-		if err != nil {
+		newCtx, ok := m.authenticate(c, parts[1])
+		if !ok {
 			ctx.JSON(http.StatusUnauthorized, map[string]string{"message": "无效或过期的token"})
 			ctx.Abort()
 			return
 		}
-
-		newCtx := context.WithValue(c, "user_id", userID)
 		ctx.Next(newCtx)
 	}
 }
+
+// 可选认证，用于游客也能访问的公开路由：携带有效token时写入当前用户，缺失或无效时按游客处理
+func (m *AuthMiddleware) OptionalAuth() app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		parts := strings.SplitN(string(ctx.GetHeader("Authorization")), " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" || m.userClient == nil {
+			ctx.Next(c)
+			return
+		}
+
+		if newCtx, ok := m.authenticate(c, parts[1]); ok {
+			ctx.Next(newCtx)
+			return
+		}
+		ctx.Next(c)
+	}
+}
+
+// 校验token并把当前用户信息写入上下文
+func (m *AuthMiddleware) authenticate(c context.Context, token string) (context.Context, bool) {
+	resp, err := m.userClient.VerifyToken(c, token)
+	if err != nil || resp.BaseResp == nil || resp.BaseResp.StatusCode != 0 {
+		return c, false
+	}
+
+	//处理器通过user_id获取当前用户，退出登录时需要原始token
+	newCtx := context.WithValue(c, "user_id", resp.UserId)
+	newCtx = context.WithValue(newCtx, "session_id", resp.SessionId)
+	newCtx = context.WithValue(newCtx, "token", token)
+	//角色写入上下文，并通过RPC元信息传递给下游服务做权限判断
+	newCtx = context.WithValue(newCtx, "roles", resp.Roles)
+	newCtx = rbac.WithRoles(newCtx, resp.Roles)
+	return newCtx, true
+}
+
+// 权限校验，需要放在认证中间件之后
+func RequirePermission(permission string) app.HandlerFunc {
+	return func(c context.Context, ctx *app.RequestContext) {
+		roles, _ := c.Value("roles").([]string)
+		if !rbac.Has(roles, permission) {
+			ctx.JSON(http.StatusForbidden, map[string]string{"message": "没有操作权限"})
+			ctx.Abort()
+			return
+		}
+		ctx.Next(c)
+	}
+}
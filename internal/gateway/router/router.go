@@ -6,6 +6,7 @@ import (
 
 	"shortvideo/internal/gateway/handler"
 	"shortvideo/internal/gateway/middleware"
+	"shortvideo/pkg/rbac"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
@@ -33,6 +34,9 @@ func RegisterRoutes(
 		ctx.JSON(http.StatusOK, map[string]string{"status": "OK"})
 	})
 
+	//分享短链
+	srv.GET("/s/:code", httpHandler.ResolveShareLink)
+
 	//API路由组
 	api := srv.Group("/api")
 
@@ -42,20 +46,31 @@ func RegisterRoutes(
 		//用户相关
 		public.POST("/user/register", httpHandler.Register)
 		public.POST("/user/login", httpHandler.Login)
-
-		//视频相关
-		public.GET("/video/feed", httpHandler.GetVideoFeed)
-		public.GET("/video/detail", httpHandler.GetVideoByID)
-		public.GET("/search", httpHandler.Search)
+		public.POST("/user/login/2fa", httpHandler.VerifyTwoFactorLogin)
+		public.POST("/user/token/refresh", httpHandler.RefreshToken)
+		public.GET("/oauth/mock/authorize", httpHandler.MockOAuthAuthorize)
+		public.GET("/oauth/:provider/login", httpHandler.StartOAuth)
+		public.GET("/oauth/:provider/callback", httpHandler.OAuthCallback)
+		public.GET("/users/:username", httpHandler.GetUserByUsername)
+
+		//视频相关，登录用户可选携带token以获得个性化结果
+		public.GET("/video/feed", authMiddleware.OptionalAuth(), httpHandler.GetVideoFeed)
+		public.GET("/video/detail", authMiddleware.OptionalAuth(), httpHandler.GetVideoByID)
+		public.GET("/search", authMiddleware.OptionalAuth(), httpHandler.Search)
 
 		//交互相关
-		public.GET("/interaction/comments", httpHandler.GetComments)
+		public.GET("/interaction/count", httpHandler.GetInteractionCount)
+		public.GET("/interaction/reactions", httpHandler.GetReactionUsers)
+		public.GET("/interaction/comments", authMiddleware.OptionalAuth(), httpHandler.GetComments)
+		public.GET("/interaction/comment/replies", authMiddleware.OptionalAuth(), httpHandler.GetCommentReplies)
+		public.GET("/interaction/comment/permission", httpHandler.GetCommentPermission)
+		public.POST("/interaction/share/view", httpHandler.RecordShareView)
 
 		//弹幕相关
-		public.GET("/danmu/list", httpHandler.GetDanmuList)
+		public.GET("/danmu/list", authMiddleware.OptionalAuth(), httpHandler.GetDanmuList)
 
 		//直播相关
-		public.GET("/live/list", httpHandler.GetLiveList)
+		public.GET("/live/list", authMiddleware.OptionalAuth(), httpHandler.GetLiveList)
 	}
 
 	//需要认证的路由
@@ -65,21 +80,104 @@ func RegisterRoutes(
 		//用户相关
 		protected.GET("/user/profile", httpHandler.GetUserProfile)
 		protected.PUT("/user/update", httpHandler.UpdateUser)
+		protected.GET("/user/invited_by", httpHandler.GetInvitedBy)
+		protected.POST("/user/logout", httpHandler.Logout)
+		protected.POST("/user/logout/all", httpHandler.LogoutAll)
+		protected.GET("/user/sessions", httpHandler.GetSessions)
+		protected.POST("/user/session/revoke", httpHandler.RevokeSession)
+		protected.GET("/user/2fa", httpHandler.GetTwoFactorStatus)
+		protected.POST("/user/2fa/setup", httpHandler.SetupTwoFactor)
+		protected.POST("/user/2fa/enable", httpHandler.EnableTwoFactor)
+		protected.POST("/user/2fa/disable", httpHandler.DisableTwoFactor)
+		protected.POST("/user/2fa/recovery-codes", httpHandler.RegenerateRecoveryCodes)
+		protected.GET("/user/identities", httpHandler.ListIdentities)
+		protected.POST("/user/identities/link", httpHandler.LinkIdentity)
+		protected.POST("/user/identities/unlink", httpHandler.UnlinkIdentity)
+		protected.POST("/user/deletion", httpHandler.RequestAccountDeletion)
+		protected.GET("/user/deletion", httpHandler.GetAccountDeletion)
+		protected.POST("/user/deletion/cancel", httpHandler.CancelAccountDeletion)
+		protected.POST("/user/export", httpHandler.RequestDataExport)
+		protected.GET("/user/export", httpHandler.GetDataExport)
+		protected.GET("/user/roles", httpHandler.GetMyRoles)
+		protected.GET("/user/level", httpHandler.GetUserLevel)
+		protected.GET("/users/online", httpHandler.GetOnlineStatus)
+		protected.GET("/user/settings", httpHandler.GetUserSettings)
+		protected.PUT("/user/settings", httpHandler.UpdateUserSettings)
+		protected.PUT("/user/username", httpHandler.ChangeUsername)
+		protected.GET("/user/username/history", httpHandler.GetMyUsernameHistory)
+		protected.GET("/admin/roles", middleware.RequirePermission(rbac.PermUserBan), httpHandler.GetUserRoles)
+		protected.POST("/admin/roles/assign", middleware.RequirePermission(rbac.PermRoleAssign), httpHandler.AssignRole)
+		protected.POST("/admin/roles/revoke", middleware.RequirePermission(rbac.PermRoleAssign), httpHandler.RevokeRole)
+		protected.POST("/admin/users/ban", middleware.RequirePermission(rbac.PermUserBan), httpHandler.BanUser)
+		protected.GET("/admin/users/username-history", middleware.RequirePermission(rbac.PermUsernameHistory), httpHandler.GetUsernameHistory)
+
+		//观看历史相关
+		protected.POST("/video/progress", httpHandler.ReportWatchProgress)
+		protected.GET("/video/progress", httpHandler.GetWatchProgress)
+		protected.GET("/video/history", httpHandler.GetWatchHistory)
+		protected.POST("/video/history/delete", httpHandler.DeleteWatchHistory)
+		protected.POST("/video/history/clear", httpHandler.ClearWatchHistory)
+		protected.POST("/video/history/pause", httpHandler.SetWatchHistoryPaused)
+		protected.POST("/video/visibility", httpHandler.SetVideoVisibility)
 
 		//社交相关
 		protected.POST("/social/follow", httpHandler.FollowUser)
 		protected.POST("/social/unfollow", httpHandler.UnfollowUser)
 		protected.GET("/social/following", httpHandler.GetFollowingList)
 		protected.GET("/social/follower", httpHandler.GetFollowerList)
+		protected.POST("/social/privacy", httpHandler.SetAccountPrivacy)
+		protected.GET("/social/follow/requests", httpHandler.GetFollowRequests)
+		protected.POST("/social/follow/request/approve", httpHandler.ApproveFollowRequest)
+		protected.POST("/social/follow/request/decline", httpHandler.DeclineFollowRequest)
+		protected.POST("/social/follow/request/cancel", httpHandler.CancelFollowRequest)
+		protected.POST("/social/block", httpHandler.BlockUser)
+		protected.POST("/social/unblock", httpHandler.UnblockUser)
+		protected.POST("/social/mute", httpHandler.MuteUser)
+		protected.POST("/social/unmute", httpHandler.UnmuteUser)
+		protected.GET("/social/blocklist", httpHandler.GetBlockList)
+		protected.GET("/social/block/status", httpHandler.GetBlockStatus)
+		protected.GET("/social/audience/lists", httpHandler.GetAudienceLists)
+		protected.POST("/social/audience/list/create", httpHandler.CreateAudienceList)
+		protected.POST("/social/audience/list/rename", httpHandler.RenameAudienceList)
+		protected.POST("/social/audience/list/delete", httpHandler.DeleteAudienceList)
+		protected.GET("/social/audience/members", httpHandler.GetAudienceMembers)
+		protected.POST("/social/audience/members/add", httpHandler.AddAudienceMembers)
+		protected.POST("/social/audience/members/remove", httpHandler.RemoveAudienceMembers)
 
 		//交互相关
 		protected.POST("/interaction/like", httpHandler.LikeVideo)
 		protected.POST("/interaction/unlike", httpHandler.UnlikeVideo)
+		protected.POST("/interaction/react", httpHandler.ReactVideo)
+		protected.POST("/interaction/share/link", httpHandler.CreateShareLink)
+		protected.POST("/interaction/share/install", httpHandler.RecordShareInstall)
+		protected.GET("/interaction/share/stats", httpHandler.GetShareStats)
+		protected.POST("/interaction/star", httpHandler.StarVideo)
+		protected.GET("/interaction/star/list", httpHandler.GetStarVideos)
+		protected.GET("/interaction/star/folders", httpHandler.GetStarFolders)
+		protected.GET("/interaction/star/folders/followed", httpHandler.GetFollowedStarFolders)
+		protected.POST("/interaction/star/folder", httpHandler.CreateStarFolder)
+		protected.POST("/interaction/star/folder/update", httpHandler.UpdateStarFolder)
+		protected.POST("/interaction/star/folder/delete", httpHandler.DeleteStarFolder)
+		protected.POST("/interaction/star/folder/item", httpHandler.StarFolderItem)
+		protected.POST("/interaction/star/folder/move", httpHandler.MoveStarVideo)
+		protected.POST("/interaction/star/folder/follow", httpHandler.FollowStarFolder)
 		protected.POST("/interaction/comment", httpHandler.CommentVideo)
+		protected.POST("/interaction/comment/delete", httpHandler.DeleteComment)
+		protected.POST("/interaction/comment/like", httpHandler.LikeComment)
+		protected.POST("/interaction/comment/pin", httpHandler.PinComment)
+		protected.POST("/interaction/comment/permission", httpHandler.SetCommentPermission)
+		protected.POST("/interaction/comment/keyword", httpHandler.CommentKeywordAction)
+		protected.GET("/interaction/comment/keywords", httpHandler.GetCommentKeywords)
+		protected.GET("/interaction/comment/pending", httpHandler.GetPendingComments)
+		protected.POST("/interaction/comment/review", httpHandler.ReviewComment)
 
 		//消息相关
 		protected.POST("/message/send", httpHandler.SendMessage)
 		protected.GET("/message/list", httpHandler.GetMessageList)
+		protected.GET("/admin/comments/pending", middleware.RequirePermission(rbac.PermCommentReview), httpHandler.GetModerationComments)
+		protected.POST("/admin/comments/review", middleware.RequirePermission(rbac.PermCommentReview), httpHandler.ReviewComment)
+		protected.GET("/admin/messages/pending", middleware.RequirePermission(rbac.PermMessageReview), httpHandler.GetPendingMessages)
+		protected.POST("/admin/messages/review", middleware.RequirePermission(rbac.PermMessageReview), httpHandler.ReviewMessage)
 
 		//直播相关
 		protected.POST("/live/start", httpHandler.StartLive)
@@ -90,6 +188,9 @@ func RegisterRoutes(
 
 		//推荐相关
 		protected.GET("/recommend/videos", httpHandler.GetRecommendedVideos)
+		protected.POST("/recommend/feedback", httpHandler.SubmitRecommendFeedback)
+		protected.GET("/recommend/feedback", httpHandler.GetRecommendFeedbackList)
+		protected.POST("/recommend/feedback/undo", httpHandler.UndoRecommendFeedback)
 	}
 
 	//WebSocket路由
@@ -4,28 +4,76 @@ import (
 	"context"
 	"errors"
 	"shortvideo/internal/interaction/model"
+	"shortvideo/pkg/pagination"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CommentRepository interface {
 	Create(ctx context.Context, comment *model.Comment) error
-	Delete(ctx context.Context, commentID, userID, videoID int64) error
+	Delete(ctx context.Context, commentID, userID, videoID int64) (bool, error)
 	FindByID(ctx context.Context, id int64) (*model.Comment, error)
-	ListByVideoID(ctx context.Context, videoID int64, page, pageSize int) ([]*model.Comment, int64, error)
+	ListByVideoID(ctx context.Context, videoID, viewerID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error)
 	CountByVideoID(ctx context.Context, videoID int64) (int64, error)
-	ListReplies(ctx context.Context, commentID int64, page, pageSize int) ([]*model.Comment, int64, error)
+	CountRootByVideoID(ctx context.Context, videoID int64) (int64, error)
+	ListReplies(ctx context.Context, rootID, viewerID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error)
+	ListReplyPreviews(ctx context.Context, rootIDs []int64, viewerID int64, limit int) (map[int64][]*model.Comment, error)
+	UpdateReplyCount(ctx context.Context, commentID int64, delta int64) error
+	MarkDeleted(ctx context.Context, commentID int64) (bool, error)
+	BatchGetByIDs(ctx context.Context, ids []int64) (map[int64]*model.Comment, error)
+	ListHotCandidates(ctx context.Context, videoID int64, limit int) ([]*model.Comment, error)
+	ListHotTail(ctx context.Context, videoID int64, excludeIDs []int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error)
+	UpdateLikeCount(ctx context.Context, commentID int64, delta int64) error
+	UpdateAuthorLiked(ctx context.Context, commentID int64, liked bool) error
+	FindPinnedByVideoID(ctx context.Context, videoID int64) (*model.Comment, error)
+	UpdatePinned(ctx context.Context, videoID, commentID int64, pinned bool) error
+	ListPendingByAuthor(ctx context.Context, authorID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error)
+	ListModeration(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*model.Comment, error)
+	UpdateStatus(ctx context.Context, commentID int64, from, to int32) (bool, error)
+	DeleteByStatus(ctx context.Context, commentID int64, status int32) (bool, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*model.Comment, error)
 	WithTransaction(ctx context.Context, fn func(txRepo CommentRepository) error) error
 }
 
+type CommentSettingRepository interface {
+	FindByVideoID(ctx context.Context, videoID int64) (*model.CommentSetting, error)
+	Upsert(ctx context.Context, setting *model.CommentSetting) error
+	WithTransaction(ctx context.Context, fn func(txRepo CommentSettingRepository) error) error
+}
+
+type CommentKeywordRepository interface {
+	Create(ctx context.Context, keyword *model.CommentKeyword) error
+	Delete(ctx context.Context, userID int64, keyword string) error
+	Exists(ctx context.Context, userID int64, keyword string) (bool, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*model.CommentKeyword, error)
+	WithTransaction(ctx context.Context, fn func(txRepo CommentKeywordRepository) error) error
+}
+
+type CommentLikeRepository interface {
+	Create(ctx context.Context, like *model.CommentLike) error
+	Delete(ctx context.Context, userID, commentID int64) error
+	Exists(ctx context.Context, userID, commentID int64) (bool, error)
+	BatchCheckLiked(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error)
+	ListCommentIDsByUserID(ctx context.Context, userID int64) ([]int64, error)
+	WithTransaction(ctx context.Context, fn func(txRepo CommentLikeRepository) error) error
+}
+
 type LikeRepository interface {
 	Create(ctx context.Context, like *model.Like) error
-	Delete(ctx context.Context, userID, videoID int64) error
+	Delete(ctx context.Context, userID, videoID int64) (bool, error)
 	Find(ctx context.Context, userID, videoID int64) (*model.Like, error)
 	Exists(ctx context.Context, userID, videoID int64) (bool, error)
 	CountByVideoID(ctx context.Context, videoID int64) (int64, error)
-	ListByUserID(ctx context.Context, userID int64, page, pageSize int) ([]*model.Like, int64, error)
+	ListByUserID(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Like, error)
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
 	ListByVideoIDs(ctx context.Context, videoIDs []int64) ([]*model.Like, error)
+	UpdateReaction(ctx context.Context, userID, videoID int64, reaction string) error
+	CountReactionsByVideoID(ctx context.Context, videoID int64) (map[string]int64, error)
+	BatchGetReactions(ctx context.Context, userID int64, videoIDs []int64) (map[int64]string, error)
+	ListByVideoIDAndReaction(ctx context.Context, videoID int64, reaction string, cursor *pagination.Cursor, limit int) ([]*model.Like, error)
+	ListVideoIDsByUserID(ctx context.Context, userID int64) ([]int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo LikeRepository) error) error
 }
 
@@ -35,17 +83,56 @@ type StarRepository interface {
 	Find(ctx context.Context, userID, videoID int64) (*model.Star, error)
 	Exists(ctx context.Context, userID, videoID int64) (bool, error)
 	CountByVideoID(ctx context.Context, videoID int64) (int64, error)
-	ListByUserID(ctx context.Context, userID int64, page, pageSize int) ([]*model.Star, int64, error)
+	ListByUserID(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Star, error)
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	ListVideoIDsByUserID(ctx context.Context, userID int64) ([]int64, error)
+	BatchExists(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error)
 	WithTransaction(ctx context.Context, fn func(txRepo StarRepository) error) error
 }
 
+type StarFolderRepository interface {
+	Create(ctx context.Context, folder *model.StarFolder) error
+	FindByID(ctx context.Context, id int64) (*model.StarFolder, error)
+	ExistsByName(ctx context.Context, userID int64, name string) (bool, error)
+	Update(ctx context.Context, id int64, updates map[string]interface{}) error
+	Delete(ctx context.Context, id int64) error
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	ListByUserID(ctx context.Context, userID int64, publicOnly bool) ([]*model.StarFolder, error)
+	ListByIDs(ctx context.Context, userID int64, ids []int64) ([]*model.StarFolder, error)
+	AddItem(ctx context.Context, item *model.StarFolderItem) (bool, error)
+	RemoveItem(ctx context.Context, folderID, videoID int64) (bool, error)
+	RemoveVideoFromUserFolders(ctx context.Context, userID, videoID int64) error
+	ListItems(ctx context.Context, folderID int64, cursor *pagination.Cursor, limit int) ([]*model.StarFolderItem, error)
+	CountItems(ctx context.Context, folderID int64) (int64, error)
+	Follow(ctx context.Context, userID, folderID int64) (bool, error)
+	Unfollow(ctx context.Context, userID, folderID int64) (bool, error)
+	BatchCheckFollowed(ctx context.Context, userID int64, folderIDs []int64) (map[int64]bool, error)
+	ListFollowed(ctx context.Context, userID int64) ([]*model.StarFolder, error)
+	WithTransaction(ctx context.Context, fn func(txRepo StarFolderRepository) error) error
+}
+
 type ShareRepository interface {
 	Create(ctx context.Context, share *model.Share) error
 	CountByVideoID(ctx context.Context, videoID int64) (int64, error)
 	ListByUserID(ctx context.Context, userID int64, page, pageSize int) ([]*model.Share, int64, error)
+	CountByChannel(ctx context.Context, videoID int64) (map[string]int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
 	WithTransaction(ctx context.Context, fn func(txRepo ShareRepository) error) error
 }
 
+type ShareLinkRepository interface {
+	Create(ctx context.Context, link *model.ShareLink) error
+	FindByCode(ctx context.Context, code string) (*model.ShareLink, error)
+	FindByOwner(ctx context.Context, userID, videoID int64, channel string) (*model.ShareLink, error)
+	RecordEvent(ctx context.Context, link *model.ShareLink, visitorID int64, event string) error
+	ExistsEvent(ctx context.Context, linkID, visitorID int64, event string) (bool, error)
+	SumByChannel(ctx context.Context, videoID int64) ([]*model.ShareChannelStats, error)
+	CreateAttribution(ctx context.Context, attribution *model.ShareAttribution) (bool, error)
+	FindAttribution(ctx context.Context, userID int64) (*model.ShareAttribution, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+	WithTransaction(ctx context.Context, fn func(txRepo ShareLinkRepository) error) error
+}
+
 type VideoInteractionStatsRepository interface {
 	CreateOrUpdate(ctx context.Context, stats *model.VideoInteractionStats) error
 	FindByVideoID(ctx context.Context, videoID int64) (*model.VideoInteractionStats, error)
@@ -53,6 +140,7 @@ type VideoInteractionStatsRepository interface {
 	IncrementLikeCount(ctx context.Context, videoID int64) error
 	DecrementLikeCount(ctx context.Context, videoID int64) error
 	IncrementCommentCount(ctx context.Context, videoID int64) error
+	DecrementCommentCount(ctx context.Context, videoID int64) error
 	IncrementStarCount(ctx context.Context, videoID int64) error
 	DecrementStarCount(ctx context.Context, videoID int64) error
 	IncrementShareCount(ctx context.Context, videoID int64) error
@@ -71,7 +159,8 @@ func (r *commentRepositoryImpl) Create(ctx context.Context, comment *model.Comme
 	return r.db.WithContext(ctx).Create(comment).Error
 }
 
-func (r *commentRepositoryImpl) Delete(ctx context.Context, commentID, userID, videoID int64) error {
+// 删除评论，返回是否删除了记录，并发删除时只有一次返回true
+func (r *commentRepositoryImpl) Delete(ctx context.Context, commentID, userID, videoID int64) (bool, error) {
 	query := r.db.WithContext(ctx).Where("id = ?", commentID)
 
 	if userID > 0 {
@@ -81,7 +170,8 @@ func (r *commentRepositoryImpl) Delete(ctx context.Context, commentID, userID, v
 		query = query.Where("video_id = ?", videoID)
 	}
 
-	return query.Delete(&model.Comment{}).Error
+	result := query.Delete(&model.Comment{})
+	return result.RowsAffected > 0, result.Error
 }
 
 func (r *commentRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Comment, error) {
@@ -93,23 +183,25 @@ func (r *commentRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.
 	return &comment, err
 }
 
-func (r *commentRepositoryImpl) ListByVideoID(ctx context.Context, videoID int64, page, pageSize int) ([]*model.Comment, int64, error) {
-	var comments []*model.Comment
-	var total int64
-	offset := (page - 1) * pageSize
+// 待作者审核的评论只对评论者和视频作者可见，待平台审核的评论只对评论者可见
+func visibleTo(query *gorm.DB, viewerID int64) *gorm.DB {
+	return query.Where("(status = ? OR user_id = ? OR (status = ? AND video_author_id = ?))",
+		model.CommentStatusPublished, viewerID, model.CommentStatusPending, viewerID)
+}
 
-	if err := r.db.WithContext(ctx).Model(&model.Comment{}).
-		Where("video_id = ? AND reply_to_id = 0", videoID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+func (r *commentRepositoryImpl) ListByVideoID(ctx context.Context, videoID, viewerID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	query := r.db.WithContext(ctx).Where("video_id = ? AND reply_to_id = 0 AND is_pinned = ?", videoID, false)
+	query = visibleTo(query, viewerID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("video_id = ? AND reply_to_id = 0", videoID).
-		Offset(offset).Limit(pageSize).
-		Order("create_time DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&comments).Error
 
-	return comments, total, err
+	return comments, err
 }
 
 func (r *commentRepositoryImpl) CountByVideoID(ctx context.Context, videoID int64) (int64, error) {
@@ -120,23 +212,206 @@ func (r *commentRepositoryImpl) CountByVideoID(ctx context.Context, videoID int6
 	return count, err
 }
 
-func (r *commentRepositoryImpl) ListReplies(ctx context.Context, commentID int64, page, pageSize int) ([]*model.Comment, int64, error) {
-	var replies []*model.Comment
-	var total int64
-	offset := (page - 1) * pageSize
+func (r *commentRepositoryImpl) CountRootByVideoID(ctx context.Context, videoID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("video_id = ? AND reply_to_id = 0 AND status = ?", videoID, model.CommentStatusPublished).
+		Count(&count).Error
+	return count, err
+}
 
-	if err := r.db.WithContext(ctx).Model(&model.Comment{}).
-		Where("reply_to_id = ?", commentID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+func (r *commentRepositoryImpl) ListReplies(ctx context.Context, rootID, viewerID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error) {
+	var replies []*model.Comment
+	query := visibleTo(r.db.WithContext(ctx).Where("root_id = ?", rootID), viewerID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) > (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("reply_to_id = ?", commentID).
-		Offset(offset).Limit(pageSize).
-		Order("create_time ASC").
+	err := query.Order("created_at ASC, id ASC").
+		Limit(limit).
 		Find(&replies).Error
 
-	return replies, total, err
+	return replies, err
+}
+
+func (r *commentRepositoryImpl) ListReplyPreviews(ctx context.Context, rootIDs []int64, viewerID int64, limit int) (map[int64][]*model.Comment, error) {
+	result := make(map[int64][]*model.Comment)
+	if len(rootIDs) == 0 {
+		return result, nil
+	}
+
+	var replies []*model.Comment
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT * FROM (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY root_id ORDER BY created_at ASC, id ASC) AS rn
+			FROM comments
+			WHERE root_id IN ? AND (status = ? OR user_id = ? OR (status = ? AND video_author_id = ?))
+		) t WHERE rn <= ?
+		ORDER BY root_id, created_at ASC, id ASC`, rootIDs, model.CommentStatusPublished, viewerID, model.CommentStatusPending, viewerID, limit).
+		Scan(&replies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reply := range replies {
+		result[reply.RootID] = append(result[reply.RootID], reply)
+	}
+	return result, nil
+}
+
+func (r *commentRepositoryImpl) UpdateReplyCount(ctx context.Context, commentID int64, delta int64) error {
+	return r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ?", commentID).
+		UpdateColumn("reply_count", gorm.Expr("GREATEST(reply_count + ?, 0)", delta)).Error
+}
+
+// 标记为墓碑，评论已经是墓碑时返回false
+func (r *commentRepositoryImpl) MarkDeleted(ctx context.Context, commentID int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ? AND is_deleted = ?", commentID, false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"content":    "",
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *commentRepositoryImpl) BatchGetByIDs(ctx context.Context, ids []int64) (map[int64]*model.Comment, error) {
+	var comments []*model.Comment
+	result := make(map[int64]*model.Comment)
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		result[comment.ID] = comment
+	}
+	return result, nil
+}
+
+func (r *commentRepositoryImpl) ListHotCandidates(ctx context.Context, videoID int64, limit int) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	err := r.db.WithContext(ctx).
+		Where("video_id = ? AND reply_to_id = 0 AND is_pinned = ? AND status = ?", videoID, false, model.CommentStatusPublished).
+		Order("like_count DESC, created_at DESC").
+		Limit(limit).
+		Find(&comments).Error
+	return comments, err
+}
+
+// 热门候选之外的评论，按时间倒序，接在热门排序之后
+func (r *commentRepositoryImpl) ListHotTail(ctx context.Context, videoID int64, excludeIDs []int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	query := r.db.WithContext(ctx).
+		Where("video_id = ? AND reply_to_id = 0 AND is_pinned = ? AND status = ?", videoID, false, model.CommentStatusPublished)
+	if len(excludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludeIDs)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&comments).Error
+	return comments, err
+}
+
+func (r *commentRepositoryImpl) UpdateLikeCount(ctx context.Context, commentID int64, delta int64) error {
+	return r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ?", commentID).
+		UpdateColumn("like_count", gorm.Expr("GREATEST(like_count + ?, 0)", delta)).Error
+}
+
+func (r *commentRepositoryImpl) UpdateAuthorLiked(ctx context.Context, commentID int64, liked bool) error {
+	return r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ?", commentID).
+		UpdateColumn("author_liked", liked).Error
+}
+
+func (r *commentRepositoryImpl) FindPinnedByVideoID(ctx context.Context, videoID int64) (*model.Comment, error) {
+	var comment model.Comment
+	err := r.db.WithContext(ctx).
+		Where("video_id = ? AND is_pinned = ?", videoID, true).
+		First(&comment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &comment, err
+}
+
+func (r *commentRepositoryImpl) UpdatePinned(ctx context.Context, videoID, commentID int64, pinned bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		//每个视频只能有一条置顶评论
+		if pinned {
+			if err := tx.Model(&model.Comment{}).
+				Where("video_id = ? AND is_pinned = ?", videoID, true).
+				UpdateColumn("is_pinned", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&model.Comment{}).
+			Where("id = ? AND video_id = ?", commentID, videoID).
+			UpdateColumn("is_pinned", pinned).Error
+	})
+}
+
+func (r *commentRepositoryImpl) ListPendingByAuthor(ctx context.Context, authorID int64, cursor *pagination.Cursor, limit int) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	query := r.db.WithContext(ctx).Where("video_author_id = ? AND status = ?", authorID, model.CommentStatusPending)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&comments).Error
+
+	return comments, err
+}
+
+// 待平台审核的评论，按提交时间倒序
+func (r *commentRepositoryImpl) ListModeration(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	query := r.db.WithContext(ctx).Where("status = ?", model.CommentStatusModeration)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&comments).Error
+
+	return comments, err
+}
+
+// 评论状态仍为from时改为to，并发审核时只有一次返回true
+func (r *commentRepositoryImpl) UpdateStatus(ctx context.Context, commentID int64, from, to int32) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ? AND status = ?", commentID, from).
+		UpdateColumn("status", to)
+	return result.RowsAffected > 0, result.Error
+}
+
+// 评论状态仍为status时删除，用于拒绝待审核评论
+func (r *commentRepositoryImpl) DeleteByStatus(ctx context.Context, commentID int64, status int32) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND status = ?", commentID, status).
+		Delete(&model.Comment{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// 用户未删除的评论，只查询删除评论所需的字段
+func (r *commentRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]*model.Comment, error) {
+	var comments []*model.Comment
+	err := r.db.WithContext(ctx).
+		Select("id", "video_id").
+		Where("user_id = ? AND is_deleted = ?", userID, false).
+		Find(&comments).Error
+	return comments, err
 }
 
 func (r *commentRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo CommentRepository) error) error {
@@ -146,6 +421,142 @@ func (r *commentRepositoryImpl) WithTransaction(ctx context.Context, fn func(txR
 	})
 }
 
+type commentLikeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCommentLikeRepository(db *gorm.DB) CommentLikeRepository {
+	return &commentLikeRepositoryImpl{db: db}
+}
+
+func (r *commentLikeRepositoryImpl) Create(ctx context.Context, like *model.CommentLike) error {
+	return r.db.WithContext(ctx).Create(like).Error
+}
+
+func (r *commentLikeRepositoryImpl) Delete(ctx context.Context, userID, commentID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		Delete(&model.CommentLike{}).Error
+}
+
+func (r *commentLikeRepositoryImpl) Exists(ctx context.Context, userID, commentID int64) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.CommentLike{}).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *commentLikeRepositoryImpl) BatchCheckLiked(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error) {
+	var likes []model.CommentLike
+	result := make(map[int64]bool)
+
+	for _, commentID := range commentIDs {
+		result[commentID] = false
+	}
+
+	err := r.db.WithContext(ctx).Select("comment_id").
+		Where("user_id = ? AND comment_id IN ?", userID, commentIDs).
+		Find(&likes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, like := range likes {
+		result[like.CommentID] = true
+	}
+
+	return result, nil
+}
+
+func (r *commentLikeRepositoryImpl) ListCommentIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	var commentIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.CommentLike{}).
+		Where("user_id = ?", userID).
+		Pluck("comment_id", &commentIDs).Error
+	return commentIDs, err
+}
+
+func (r *commentLikeRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo CommentLikeRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &commentLikeRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
+type commentSettingRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCommentSettingRepository(db *gorm.DB) CommentSettingRepository {
+	return &commentSettingRepositoryImpl{db: db}
+}
+
+func (r *commentSettingRepositoryImpl) FindByVideoID(ctx context.Context, videoID int64) (*model.CommentSetting, error) {
+	var setting model.CommentSetting
+	err := r.db.WithContext(ctx).Where("video_id = ?", videoID).First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &setting, err
+}
+
+func (r *commentSettingRepositoryImpl) Upsert(ctx context.Context, setting *model.CommentSetting) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"permission", "updated_at"}),
+	}).Create(setting).Error
+}
+
+func (r *commentSettingRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo CommentSettingRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &commentSettingRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
+type commentKeywordRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCommentKeywordRepository(db *gorm.DB) CommentKeywordRepository {
+	return &commentKeywordRepositoryImpl{db: db}
+}
+
+func (r *commentKeywordRepositoryImpl) Create(ctx context.Context, keyword *model.CommentKeyword) error {
+	return r.db.WithContext(ctx).Create(keyword).Error
+}
+
+func (r *commentKeywordRepositoryImpl) Delete(ctx context.Context, userID int64, keyword string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND keyword = ?", userID, keyword).
+		Delete(&model.CommentKeyword{}).Error
+}
+
+func (r *commentKeywordRepositoryImpl) Exists(ctx context.Context, userID int64, keyword string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.CommentKeyword{}).
+		Where("user_id = ? AND keyword = ?", userID, keyword).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *commentKeywordRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]*model.CommentKeyword, error) {
+	var keywords []*model.CommentKeyword
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&keywords).Error
+	return keywords, err
+}
+
+func (r *commentKeywordRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo CommentKeywordRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &commentKeywordRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
 type likeRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -158,10 +569,24 @@ func (r *likeRepositoryImpl) Create(ctx context.Context, like *model.Like) error
 	return r.db.WithContext(ctx).Create(like).Error
 }
 
-func (r *likeRepositoryImpl) Delete(ctx context.Context, userID, videoID int64) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ? AND video_id = ?", userID, videoID).
-		Delete(&model.Like{}).Error
+// 删除回应记录并在同一事务中减少视频点赞数，记录已不存在时返回false
+func (r *likeRepositoryImpl) Delete(ctx context.Context, userID, videoID int64) (bool, error) {
+	deleted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND video_id = ?", userID, videoID).
+			Delete(&model.Like{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		return tx.Model(&model.VideoInteractionStats{}).
+			Where("video_id = ?", videoID).
+			UpdateColumn("like_count", gorm.Expr("GREATEST(like_count - 1, 0)")).Error
+	})
+	return deleted, err
 }
 
 func (r *likeRepositoryImpl) Find(ctx context.Context, userID, videoID int64) (*model.Like, error) {
@@ -191,23 +616,26 @@ func (r *likeRepositoryImpl) CountByVideoID(ctx context.Context, videoID int64)
 	return count, err
 }
 
-func (r *likeRepositoryImpl) ListByUserID(ctx context.Context, userID int64, page, pageSize int) ([]*model.Like, int64, error) {
+func (r *likeRepositoryImpl) ListByUserID(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Like, error) {
 	var likes []*model.Like
-	var total int64
-	offset := (page - 1) * pageSize
-
-	if err := r.db.WithContext(ctx).Model(&model.Like{}).
-		Where("user_id = ?", userID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
-		Offset(offset).Limit(pageSize).
-		Order("created_at DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&likes).Error
 
-	return likes, total, err
+	return likes, err
+}
+
+func (r *likeRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.Like{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
 }
 
 func (r *likeRepositoryImpl) ListByVideoIDs(ctx context.Context, videoIDs []int64) ([]*model.Like, error) {
@@ -216,6 +644,79 @@ func (r *likeRepositoryImpl) ListByVideoIDs(ctx context.Context, videoIDs []int6
 	return likes, err
 }
 
+func (r *likeRepositoryImpl) UpdateReaction(ctx context.Context, userID, videoID int64, reaction string) error {
+	return r.db.WithContext(ctx).Model(&model.Like{}).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Update("reaction", reaction).Error
+}
+
+func (r *likeRepositoryImpl) CountReactionsByVideoID(ctx context.Context, videoID int64) (map[string]int64, error) {
+	var rows []struct {
+		Reaction string
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).Model(&model.Like{}).
+		Select("reaction, COUNT(*) AS count").
+		Where("video_id = ?", videoID).
+		Group("reaction").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		result[row.Reaction] = row.Count
+	}
+	return result, nil
+}
+
+func (r *likeRepositoryImpl) BatchGetReactions(ctx context.Context, userID int64, videoIDs []int64) (map[int64]string, error) {
+	result := make(map[int64]string)
+	if len(videoIDs) == 0 {
+		return result, nil
+	}
+
+	var likes []model.Like
+	err := r.db.WithContext(ctx).
+		Select("video_id, reaction").
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Find(&likes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, like := range likes {
+		result[like.VideoID] = like.Reaction
+	}
+	return result, nil
+}
+
+func (r *likeRepositoryImpl) ListByVideoIDAndReaction(ctx context.Context, videoID int64, reaction string, cursor *pagination.Cursor, limit int) ([]*model.Like, error) {
+	var likes []*model.Like
+	query := r.db.WithContext(ctx).Where("video_id = ?", videoID)
+	if reaction != "" {
+		query = query.Where("reaction = ?", reaction)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&likes).Error
+
+	return likes, err
+}
+
+func (r *likeRepositoryImpl) ListVideoIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	var videoIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.Like{}).
+		Where("user_id = ?", userID).
+		Pluck("video_id", &videoIDs).Error
+	return videoIDs, err
+}
+
 func (r *likeRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo LikeRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &likeRepositoryImpl{db: tx}
@@ -268,23 +769,54 @@ func (r *starRepositoryImpl) CountByVideoID(ctx context.Context, videoID int64)
 	return count, err
 }
 
-func (r *starRepositoryImpl) ListByUserID(ctx context.Context, userID int64, page, pageSize int) ([]*model.Star, int64, error) {
+func (r *starRepositoryImpl) ListByUserID(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Star, error) {
 	var stars []*model.Star
-	var total int64
-	offset := (page - 1) * pageSize
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&stars).Error
+
+	return stars, err
+}
 
-	if err := r.db.WithContext(ctx).Model(&model.Star{}).
+func (r *starRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.Star{}).
 		Where("user_id = ?", userID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+		Count(&count).Error
+	return count, err
+}
+
+func (r *starRepositoryImpl) ListVideoIDsByUserID(ctx context.Context, userID int64) ([]int64, error) {
+	var videoIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.Star{}).
+		Where("user_id = ?", userID).
+		Pluck("video_id", &videoIDs).Error
+	return videoIDs, err
+}
+
+func (r *starRepositoryImpl) BatchExists(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool)
+	if len(videoIDs) == 0 {
+		return result, nil
 	}
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
-		Offset(offset).Limit(pageSize).
-		Order("created_at DESC").
-		Find(&stars).Error
+	var starredIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.Star{}).
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Pluck("video_id", &starredIDs).Error
+	if err != nil {
+		return nil, err
+	}
 
-	return stars, total, err
+	for _, id := range starredIDs {
+		result[id] = true
+	}
+	return result, nil
 }
 
 func (r *starRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo StarRepository) error) error {
@@ -294,6 +826,242 @@ func (r *starRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo
 	})
 }
 
+type starFolderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStarFolderRepository(db *gorm.DB) StarFolderRepository {
+	return &starFolderRepositoryImpl{db: db}
+}
+
+func (r *starFolderRepositoryImpl) Create(ctx context.Context, folder *model.StarFolder) error {
+	return r.db.WithContext(ctx).Create(folder).Error
+}
+
+func (r *starFolderRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.StarFolder, error) {
+	var folder model.StarFolder
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&folder).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &folder, err
+}
+
+func (r *starFolderRepositoryImpl) ExistsByName(ctx context.Context, userID int64, name string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.StarFolder{}).
+		Where("user_id = ? AND name = ?", userID, name).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *starFolderRepositoryImpl) Update(ctx context.Context, id int64, updates map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&model.StarFolder{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// 删除收藏夹及其中的视频和关注记录，视频本身仍保留在收藏中
+func (r *starFolderRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("folder_id = ?", id).Delete(&model.StarFolderItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("folder_id = ?", id).Delete(&model.StarFolderFollow{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.StarFolder{}).Error
+	})
+}
+
+func (r *starFolderRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.StarFolder{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *starFolderRepositoryImpl) ListByUserID(ctx context.Context, userID int64, publicOnly bool) ([]*model.StarFolder, error) {
+	var folders []*model.StarFolder
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if publicOnly {
+		query = query.Where("is_public = ?", true)
+	}
+	err := query.Order("created_at ASC, id ASC").Find(&folders).Error
+	return folders, err
+}
+
+func (r *starFolderRepositoryImpl) ListByIDs(ctx context.Context, userID int64, ids []int64) ([]*model.StarFolder, error) {
+	var folders []*model.StarFolder
+	if len(ids) == 0 {
+		return folders, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND id IN ?", userID, ids).
+		Find(&folders).Error
+	return folders, err
+}
+
+// 添加视频到收藏夹，已存在时返回false
+func (r *starFolderRepositoryImpl) AddItem(ctx context.Context, item *model.StarFolderItem) (bool, error) {
+	added := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(item)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+		return tx.Model(&model.StarFolder{}).
+			Where("id = ?", item.FolderID).
+			UpdateColumn("video_count", gorm.Expr("video_count + 1")).Error
+	})
+	return added, err
+}
+
+// 从收藏夹移除视频，不存在时返回false
+func (r *starFolderRepositoryImpl) RemoveItem(ctx context.Context, folderID, videoID int64) (bool, error) {
+	removed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("folder_id = ? AND video_id = ?", folderID, videoID).
+			Delete(&model.StarFolderItem{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		removed = true
+		return tx.Model(&model.StarFolder{}).
+			Where("id = ?", folderID).
+			UpdateColumn("video_count", gorm.Expr("GREATEST(video_count - 1, 0)")).Error
+	})
+	return removed, err
+}
+
+// 取消收藏时将视频从该用户的所有收藏夹中移除
+func (r *starFolderRepositoryImpl) RemoveVideoFromUserFolders(ctx context.Context, userID, videoID int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var folderIDs []int64
+		if err := tx.Model(&model.StarFolderItem{}).
+			Where("user_id = ? AND video_id = ?", userID, videoID).
+			Pluck("folder_id", &folderIDs).Error; err != nil {
+			return err
+		}
+		if len(folderIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("user_id = ? AND video_id = ?", userID, videoID).
+			Delete(&model.StarFolderItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.StarFolder{}).
+			Where("id IN ?", folderIDs).
+			UpdateColumn("video_count", gorm.Expr("GREATEST(video_count - 1, 0)")).Error
+	})
+}
+
+func (r *starFolderRepositoryImpl) ListItems(ctx context.Context, folderID int64, cursor *pagination.Cursor, limit int) ([]*model.StarFolderItem, error) {
+	var items []*model.StarFolderItem
+	query := r.db.WithContext(ctx).Where("folder_id = ?", folderID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&items).Error
+
+	return items, err
+}
+
+func (r *starFolderRepositoryImpl) CountItems(ctx context.Context, folderID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.StarFolderItem{}).
+		Where("folder_id = ?", folderID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *starFolderRepositoryImpl) Follow(ctx context.Context, userID, folderID int64) (bool, error) {
+	followed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.StarFolderFollow{UserID: userID, FolderID: folderID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		followed = true
+		return tx.Model(&model.StarFolder{}).
+			Where("id = ?", folderID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count + 1")).Error
+	})
+	return followed, err
+}
+
+func (r *starFolderRepositoryImpl) Unfollow(ctx context.Context, userID, folderID int64) (bool, error) {
+	unfollowed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND folder_id = ?", userID, folderID).
+			Delete(&model.StarFolderFollow{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		unfollowed = true
+		return tx.Model(&model.StarFolder{}).
+			Where("id = ?", folderID).
+			UpdateColumn("follower_count", gorm.Expr("GREATEST(follower_count - 1, 0)")).Error
+	})
+	return unfollowed, err
+}
+
+func (r *starFolderRepositoryImpl) BatchCheckFollowed(ctx context.Context, userID int64, folderIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool)
+	if userID <= 0 || len(folderIDs) == 0 {
+		return result, nil
+	}
+
+	var followedIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.StarFolderFollow{}).
+		Where("user_id = ? AND folder_id IN ?", userID, folderIDs).
+		Pluck("folder_id", &followedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range followedIDs {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// 获取用户关注的收藏夹，已转为私密的不返回
+func (r *starFolderRepositoryImpl) ListFollowed(ctx context.Context, userID int64) ([]*model.StarFolder, error) {
+	var folders []*model.StarFolder
+	err := r.db.WithContext(ctx).
+		Joins("JOIN star_folder_follows ON star_folder_follows.folder_id = star_folders.id").
+		Where("star_folder_follows.user_id = ? AND star_folders.is_public = ?", userID, true).
+		Order("star_folder_follows.created_at DESC").
+		Find(&folders).Error
+	return folders, err
+}
+
+func (r *starFolderRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo StarFolderRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &starFolderRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
 type shareRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -333,6 +1101,31 @@ func (r *shareRepositoryImpl) ListByUserID(ctx context.Context, userID int64, pa
 	return shares, total, err
 }
 
+func (r *shareRepositoryImpl) CountByChannel(ctx context.Context, videoID int64) (map[string]int64, error) {
+	var rows []struct {
+		Channel string
+		Count   int64
+	}
+	err := r.db.WithContext(ctx).Model(&model.Share{}).
+		Select("channel, COUNT(*) AS count").
+		Where("video_id = ?", videoID).
+		Group("channel").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Channel] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *shareRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.Share{}).Error
+}
+
 func (r *shareRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo ShareRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &shareRepositoryImpl{db: tx}
@@ -340,6 +1133,120 @@ func (r *shareRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRep
 	})
 }
 
+type shareLinkRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewShareLinkRepository(db *gorm.DB) ShareLinkRepository {
+	return &shareLinkRepositoryImpl{db: db}
+}
+
+func (r *shareLinkRepositoryImpl) Create(ctx context.Context, link *model.ShareLink) error {
+	return r.db.WithContext(ctx).Create(link).Error
+}
+
+func (r *shareLinkRepositoryImpl) FindByCode(ctx context.Context, code string) (*model.ShareLink, error) {
+	var link model.ShareLink
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&link).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &link, err
+}
+
+func (r *shareLinkRepositoryImpl) FindByOwner(ctx context.Context, userID, videoID int64, channel string) (*model.ShareLink, error) {
+	var link model.ShareLink
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND video_id = ? AND channel = ?", userID, videoID, channel).
+		First(&link).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &link, err
+}
+
+// 记录转化事件并增加短链对应的计数
+func (r *shareLinkRepositoryImpl) RecordEvent(ctx context.Context, link *model.ShareLink, visitorID int64, event string) error {
+	column := event + "_count"
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shareEvent := &model.ShareEvent{
+			LinkID:    link.ID,
+			VideoID:   link.VideoID,
+			SharerID:  link.UserID,
+			VisitorID: visitorID,
+			Event:     event,
+		}
+		if err := tx.Create(shareEvent).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.ShareLink{}).
+			Where("id = ?", link.ID).
+			UpdateColumn(column, gorm.Expr(column+" + 1")).Error
+	})
+}
+
+func (r *shareLinkRepositoryImpl) ExistsEvent(ctx context.Context, linkID, visitorID int64, event string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.ShareEvent{}).
+		Where("link_id = ? AND visitor_id = ? AND event = ?", linkID, visitorID, event).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *shareLinkRepositoryImpl) SumByChannel(ctx context.Context, videoID int64) ([]*model.ShareChannelStats, error) {
+	var stats []*model.ShareChannelStats
+	err := r.db.WithContext(ctx).Model(&model.ShareLink{}).
+		Select("channel, SUM(click_count) AS click_count, SUM(view_count) AS view_count, SUM(install_count) AS install_count").
+		Where("video_id = ?", videoID).
+		Group("channel").
+		Scan(&stats).Error
+	return stats, err
+}
+
+// 创建邀请归因，用户已有归因时返回false
+func (r *shareLinkRepositoryImpl) CreateAttribution(ctx context.Context, attribution *model.ShareAttribution) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(attribution)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *shareLinkRepositoryImpl) FindAttribution(ctx context.Context, userID int64) (*model.ShareAttribution, error) {
+	var attribution model.ShareAttribution
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&attribution).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &attribution, err
+}
+
+// 删除用户的分享短链及其访问事件和用户自己的邀请归因；用户作为访问者的事件和作为邀请者的归因
+// 属于其他用户的分享和邀请数据，只匿名化用户ID
+func (r *shareLinkRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("sharer_id = ?", userID).Delete(&model.ShareEvent{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.ShareLink{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.ShareEvent{}).Where("visitor_id = ?", userID).
+			Update("visitor_id", 0).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.ShareAttribution{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.ShareAttribution{}).Where("inviter_id = ?", userID).
+			Update("inviter_id", 0).Error
+	})
+}
+
+func (r *shareLinkRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo ShareLinkRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &shareLinkRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
 type videoInteractionStatsRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -385,6 +1292,12 @@ func (r *videoInteractionStatsRepositoryImpl) IncrementCommentCount(ctx context.
 		UpdateColumn("comment_count", gorm.Expr("comment_count + 1")).Error
 }
 
+func (r *videoInteractionStatsRepositoryImpl) DecrementCommentCount(ctx context.Context, videoID int64) error {
+	return r.db.WithContext(ctx).Model(&model.VideoInteractionStats{}).
+		Where("video_id = ?", videoID).
+		UpdateColumn("comment_count", gorm.Expr("GREATEST(comment_count - 1, 0)")).Error
+}
+
 func (r *videoInteractionStatsRepositoryImpl) IncrementStarCount(ctx context.Context, videoID int64) error {
 	return r.db.WithContext(ctx).Model(&model.VideoInteractionStats{}).
 		Where("video_id = ?", videoID).
@@ -2,7 +2,10 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/interaction/model"
 	"shortvideo/internal/interaction/service"
+	userModel "shortvideo/internal/user/model"
+	userService "shortvideo/internal/user/service"
 	"shortvideo/kitex_gen/common"
 	interaction "shortvideo/kitex_gen/interaction"
 )
@@ -10,11 +13,13 @@ import (
 // InteractionServiceImpl implements the last service interface defined in the IDL.
 type InteractionServiceImpl struct {
 	interactionService service.InteractionService
+	userService        userService.UserService
 }
 
-func NewInteractionService(interactionService service.InteractionService) *InteractionServiceImpl {
+func NewInteractionService(interactionService service.InteractionService, userService userService.UserService) *InteractionServiceImpl {
 	return &InteractionServiceImpl{
 		interactionService: interactionService,
+		userService:        userService,
 	}
 }
 
@@ -51,7 +56,7 @@ func (s *InteractionServiceImpl) GetLikeVideoList(ctx context.Context, req *inte
 		TotalCount: 0,
 	}
 
-	videos, total, err := s.interactionService.GetLikeVideoList(ctx, req.UserId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	videos, nextCursor, total, err := s.interactionService.GetLikeVideoList(ctx, req.UserId, req.CurrentUserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -62,20 +67,25 @@ func (s *InteractionServiceImpl) GetLikeVideoList(ctx context.Context, req *inte
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
 	resp.Videos = commonVideos
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -89,7 +99,7 @@ func (s *InteractionServiceImpl) StarAction(ctx context.Context, req *interactio
 		},
 	}
 
-	err = s.interactionService.StarAction(ctx, req.UserId, req.VideoId, req.Action)
+	err = s.interactionService.StarAction(ctx, req.UserId, req.VideoId, req.Action, req.FolderIds)
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -112,7 +122,7 @@ func (s *InteractionServiceImpl) GetStarVideoList(ctx context.Context, req *inte
 		TotalCount: 0,
 	}
 
-	videos, total, err := s.interactionService.GetStarVideoList(ctx, req.UserId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	videos, nextCursor, total, err := s.interactionService.GetStarVideoList(ctx, req.UserId, req.CurrentUserId, req.GetFolderId(), req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -123,23 +133,219 @@ func (s *InteractionServiceImpl) GetStarVideoList(ctx context.Context, req *inte
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
 	resp.Videos = commonVideos
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// CreateStarFolder implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) CreateStarFolder(ctx context.Context, req *interaction.CreateStarFolderReq) (resp *interaction.CreateStarFolderResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CreateStarFolderResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	folder, err := s.interactionService.CreateStarFolder(ctx, req.UserId, req.Name, req.IsPublic)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Folder = convertStarFolder(folder, false)
+	return resp, nil
+}
+
+// UpdateStarFolder implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) UpdateStarFolder(ctx context.Context, req *interaction.UpdateStarFolderReq) (resp *interaction.UpdateStarFolderResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.UpdateStarFolderResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	folder, err := s.interactionService.UpdateStarFolder(ctx, req.UserId, req.FolderId, req.Name, req.IsPublic)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Folder = convertStarFolder(folder, false)
+	return resp, nil
+}
+
+// DeleteStarFolder implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) DeleteStarFolder(ctx context.Context, req *interaction.DeleteStarFolderReq) (resp *interaction.DeleteStarFolderResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.DeleteStarFolderResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.DeleteStarFolder(ctx, req.UserId, req.FolderId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetStarFolders implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetStarFolders(ctx context.Context, req *interaction.StarFolderListReq) (resp *interaction.StarFolderListResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.StarFolderListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Folders: []*interaction.StarFolder{},
+	}
+
+	folders, followed, err := s.interactionService.GetStarFolders(ctx, req.UserId, req.CurrentUserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, folder := range folders {
+		resp.Folders = append(resp.Folders, convertStarFolder(folder, followed[folder.ID]))
+	}
+	return resp, nil
+}
+
+// StarFolderItemAction implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) StarFolderItemAction(ctx context.Context, req *interaction.StarFolderItemActionReq) (resp *interaction.StarFolderItemActionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.StarFolderItemActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.StarFolderItemAction(ctx, req.UserId, req.FolderId, req.VideoId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// MoveStarVideo implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) MoveStarVideo(ctx context.Context, req *interaction.MoveStarVideoReq) (resp *interaction.MoveStarVideoResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.MoveStarVideoResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.MoveStarVideo(ctx, req.UserId, req.VideoId, req.FromFolderId, req.ToFolderId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// StarFolderFollowAction implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) StarFolderFollowAction(ctx context.Context, req *interaction.StarFolderFollowReq) (resp *interaction.StarFolderFollowResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.StarFolderFollowResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.StarFolderFollowAction(ctx, req.UserId, req.FolderId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
 	return resp, nil
 }
 
+// GetFollowedStarFolders implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetFollowedStarFolders(ctx context.Context, req *interaction.FollowedStarFolderListReq) (resp *interaction.FollowedStarFolderListResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.FollowedStarFolderListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Folders: []*interaction.StarFolder{},
+	}
+
+	folders, err := s.interactionService.GetFollowedStarFolders(ctx, req.UserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, folder := range folders {
+		resp.Folders = append(resp.Folders, convertStarFolder(folder, true))
+	}
+	return resp, nil
+}
+
+func convertStarFolder(folder *model.StarFolder, isFollowed bool) *interaction.StarFolder {
+	return &interaction.StarFolder{
+		Id:            folder.ID,
+		UserId:        folder.UserID,
+		Name:          folder.Name,
+		IsPublic:      folder.IsPublic,
+		VideoCount:    folder.VideoCount,
+		FollowerCount: folder.FollowerCount,
+		IsFollowed:    isFollowed,
+		CreatedAt:     folder.CreatedAt.Unix(),
+	}
+}
+
 // CommentAction implements the InteractionServiceImpl interface.
 func (s *InteractionServiceImpl) CommentAction(ctx context.Context, req *interaction.CommentActionReq) (resp *interaction.CommentActionResp, err error) {
 	successMsg := "成功"
@@ -164,14 +370,7 @@ func (s *InteractionServiceImpl) CommentAction(ctx context.Context, req *interac
 		return resp, nil
 	}
 
-	resp.Comment = &common.Comment{
-		Id:         comment.ID,
-		UserId:     comment.UserID,
-		VideoId:    comment.VideoID,
-		Content:    comment.Content,
-		CreateTime: comment.CreateTime,
-		ReplyToId:  comment.ReplyToID,
-	}
+	resp.Comment = s.convertComments(ctx, req.UserId, []*model.Comment{comment})[0]
 
 	return resp, nil
 }
@@ -188,7 +387,7 @@ func (s *InteractionServiceImpl) GetCommentList(ctx context.Context, req *intera
 		TotalCount: 0,
 	}
 
-	comments, total, err := s.interactionService.GetCommentList(ctx, req.VideoId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	comments, nextCursor, total, err := s.interactionService.GetCommentList(ctx, req.VideoId, req.CurrentUserId, req.GetSort(), req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -196,20 +395,65 @@ func (s *InteractionServiceImpl) GetCommentList(ctx context.Context, req *intera
 		return resp, nil
 	}
 
-	commonComments := make([]*common.Comment, len(comments))
-	for i, c := range comments {
-		commonComments[i] = &common.Comment{
-			Id:         c.ID,
-			UserId:     c.UserID,
-			VideoId:    c.VideoID,
-			Content:    c.Content,
-			CreateTime: c.CreateTime,
-			ReplyToId:  c.ReplyToID,
+	resp.Comments = s.convertComments(ctx, req.CurrentUserId, comments)
+	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+
+	//置顶评论只在第一页返回
+	if req.GetCursor() == "" {
+		pinned, err := s.interactionService.GetPinnedComment(ctx, req.VideoId)
+		if err == nil && pinned != nil {
+			comments = append(comments, pinned)
+			resp.PinnedComment = s.convertComments(ctx, req.CurrentUserId, []*model.Comment{pinned})[0]
+		}
+	}
+
+	rootIDs := make([]int64, 0, len(comments))
+	for _, c := range comments {
+		if c.ReplyCount > 0 {
+			rootIDs = append(rootIDs, c.ID)
+		}
+	}
+	previews, err := s.interactionService.GetReplyPreviews(ctx, rootIDs, req.CurrentUserId)
+	if err == nil {
+		replyPreviews := make(map[int64][]*common.Comment, len(previews))
+		for rootID, replies := range previews {
+			replyPreviews[rootID] = s.convertComments(ctx, req.CurrentUserId, replies)
 		}
+		resp.ReplyPreviews = replyPreviews
+	}
+	return resp, nil
+}
+
+// GetCommentReplies implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetCommentReplies(ctx context.Context, req *interaction.CommentRepliesReq) (resp *interaction.CommentRepliesResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CommentRepliesResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Comments:   []*common.Comment{},
+		TotalCount: 0,
+	}
+
+	replies, nextCursor, total, err := s.interactionService.GetCommentReplies(ctx, req.CommentId, req.CurrentUserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
 	}
 
-	resp.Comments = commonComments
+	resp.Comments = s.convertComments(ctx, req.CurrentUserId, replies)
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -234,6 +478,190 @@ func (s *InteractionServiceImpl) DeleteComment(ctx context.Context, req *interac
 	return resp, nil
 }
 
+// CommentLikeAction implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) CommentLikeAction(ctx context.Context, req *interaction.CommentLikeActionReq) (resp *interaction.CommentLikeActionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CommentLikeActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.CommentLikeAction(ctx, req.UserId, req.CommentId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// PinComment implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) PinComment(ctx context.Context, req *interaction.PinCommentReq) (resp *interaction.PinCommentResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.PinCommentResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.PinComment(ctx, req.UserId, req.VideoId, req.CommentId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// SetCommentPermission implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) SetCommentPermission(ctx context.Context, req *interaction.SetCommentPermissionReq) (resp *interaction.SetCommentPermissionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.SetCommentPermissionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.SetCommentPermission(ctx, req.UserId, req.VideoId, req.Permission)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetCommentPermission implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetCommentPermission(ctx context.Context, req *interaction.GetCommentPermissionReq) (resp *interaction.GetCommentPermissionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.GetCommentPermissionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	permission, err := s.interactionService.GetCommentPermission(ctx, req.VideoId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Permission = permission
+	return resp, nil
+}
+
+// CommentKeywordAction implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) CommentKeywordAction(ctx context.Context, req *interaction.CommentKeywordActionReq) (resp *interaction.CommentKeywordActionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CommentKeywordActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.CommentKeywordAction(ctx, req.UserId, req.Keyword, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetCommentKeywords implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetCommentKeywords(ctx context.Context, req *interaction.CommentKeywordListReq) (resp *interaction.CommentKeywordListResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CommentKeywordListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Keywords: []string{},
+	}
+
+	keywords, err := s.interactionService.GetCommentKeywords(ctx, req.UserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Keywords = keywords
+	return resp, nil
+}
+
+// GetPendingComments implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetPendingComments(ctx context.Context, req *interaction.PendingCommentListReq) (resp *interaction.PendingCommentListResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.PendingCommentListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Comments: []*common.Comment{},
+	}
+
+	//moderation为true时获取等待版主审核的评论，否则获取作者视频下命中关键词的评论
+	var comments []*model.Comment
+	var nextCursor string
+	if req.GetModeration() {
+		comments, nextCursor, err = s.interactionService.GetModerationComments(ctx, req.GetCursor(), int(req.PageSize))
+	} else {
+		comments, nextCursor, err = s.interactionService.GetPendingComments(ctx, req.UserId, req.GetCursor(), int(req.PageSize))
+	}
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Comments = s.convertComments(ctx, req.UserId, comments)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// ReviewComment implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) ReviewComment(ctx context.Context, req *interaction.ReviewCommentReq) (resp *interaction.ReviewCommentResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ReviewCommentResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.ReviewComment(ctx, req.UserId, req.CommentId, req.Approve)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
 // ShareAction implements the InteractionServiceImpl interface.
 func (s *InteractionServiceImpl) ShareAction(ctx context.Context, req *interaction.ShareActionReq) (resp *interaction.ShareActionResp, err error) {
 	successMsg := "成功"
@@ -244,7 +672,97 @@ func (s *InteractionServiceImpl) ShareAction(ctx context.Context, req *interacti
 		},
 	}
 
-	err = s.interactionService.ShareAction(ctx, req.UserId, req.VideoId)
+	err = s.interactionService.ShareAction(ctx, req.UserId, req.VideoId, req.GetChannel())
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// CreateShareLink implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) CreateShareLink(ctx context.Context, req *interaction.CreateShareLinkReq) (resp *interaction.CreateShareLinkResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.CreateShareLinkResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	link, err := s.interactionService.CreateShareLink(ctx, req.UserId, req.VideoId, req.Channel)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Code = link.Code
+	resp.Channel = link.Channel
+	return resp, nil
+}
+
+// ResolveShareLink implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) ResolveShareLink(ctx context.Context, req *interaction.ResolveShareLinkReq) (resp *interaction.ResolveShareLinkResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ResolveShareLinkResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	link, err := s.interactionService.ResolveShareLink(ctx, req.Code, req.VisitorId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.VideoId = link.VideoID
+	resp.SharerId = link.UserID
+	resp.Channel = link.Channel
+	return resp, nil
+}
+
+// RecordShareConversion implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) RecordShareConversion(ctx context.Context, req *interaction.ShareConversionReq) (resp *interaction.ShareConversionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ShareConversionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.RecordShareConversion(ctx, req.Code, req.VisitorId, req.Event)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetShareStats implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetShareStats(ctx context.Context, req *interaction.ShareStatsReq) (resp *interaction.ShareStatsResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ShareStatsResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Channels: []*interaction.ShareChannelStats{},
+	}
+
+	stats, err := s.interactionService.GetShareStats(ctx, req.UserId, req.VideoId)
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -252,6 +770,56 @@ func (s *InteractionServiceImpl) ShareAction(ctx context.Context, req *interacti
 		return resp, nil
 	}
 
+	for _, stat := range stats {
+		resp.Channels = append(resp.Channels, &interaction.ShareChannelStats{
+			Channel:      stat.Channel,
+			ShareCount:   stat.ShareCount,
+			ClickCount:   stat.ClickCount,
+			ViewCount:    stat.ViewCount,
+			InstallCount: stat.InstallCount,
+		})
+	}
+	return resp, nil
+}
+
+// GetInvitedBy implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetInvitedBy(ctx context.Context, req *interaction.InvitedByReq) (resp *interaction.InvitedByResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.InvitedByResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	attribution, err := s.interactionService.GetInvitedBy(ctx, req.UserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+	if attribution == nil {
+		return resp, nil
+	}
+
+	inviter := &common.User{Id: attribution.InviterID}
+	if s.userService != nil {
+		if user, err := s.userService.GetUserByID(ctx, attribution.InviterID); err == nil && user != nil {
+			inviter.Username = user.Username
+			inviter.FollowCount = user.FollowCount
+			inviter.FollowerCount = user.FollowerCount
+			if user.Avatar != "" {
+				avatar := user.Avatar
+				inviter.Avatar = &avatar
+			}
+		}
+	}
+
+	resp.Inviter = inviter
+	resp.VideoId = attribution.VideoID
+	resp.Channel = attribution.Channel
+	resp.InvitedAt = attribution.CreatedAt.Unix()
 	return resp, nil
 }
 
@@ -281,6 +849,15 @@ func (s *InteractionServiceImpl) GetCount(ctx context.Context, req *interaction.
 	resp.CommentCount = commentCount
 	resp.StarCount = starCount
 	resp.ShareCount = shareCount
+
+	reactionCounts, err := s.interactionService.GetReactionCounts(ctx, req.VideoId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+	resp.ReactionCounts = reactionCounts
 	return resp, nil
 }
 
@@ -329,3 +906,207 @@ func (s *InteractionServiceImpl) CheckStarStatus(ctx context.Context, req *inter
 	resp.IsStarred = isStarred
 	return resp, nil
 }
+
+// BatchCheckInteractionStatus implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) BatchCheckInteractionStatus(ctx context.Context, req *interaction.BatchCheckInteractionStatusReq) (resp *interaction.BatchCheckInteractionStatusResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.BatchCheckInteractionStatusResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Statuses: map[int64]*interaction.InteractionStatus{},
+	}
+
+	statuses, err := s.interactionService.BatchCheckInteractionStatus(ctx, req.UserId, req.VideoIds)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for videoID, status := range statuses {
+		item := &interaction.InteractionStatus{
+			IsLiked:           status.IsLiked,
+			IsStarred:         status.IsStarred,
+			IsFollowingAuthor: status.IsFollowingAuthor,
+		}
+		if status.Reaction != "" {
+			reaction := status.Reaction
+			item.Reaction = &reaction
+		}
+		resp.Statuses[videoID] = item
+	}
+	return resp, nil
+}
+
+// ReactionAction implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) ReactionAction(ctx context.Context, req *interaction.ReactionActionReq) (resp *interaction.ReactionActionResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ReactionActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.interactionService.ReactAction(ctx, req.UserId, req.VideoId, req.Reaction)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// BatchGetReactions implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) BatchGetReactions(ctx context.Context, req *interaction.BatchGetReactionsReq) (resp *interaction.BatchGetReactionsResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.BatchGetReactionsResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Reactions: map[int64]string{},
+	}
+
+	reactions, err := s.interactionService.BatchGetMyReactions(ctx, req.UserId, req.VideoIds)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Reactions = reactions
+	return resp, nil
+}
+
+// GetReactionUsers implements the InteractionServiceImpl interface.
+func (s *InteractionServiceImpl) GetReactionUsers(ctx context.Context, req *interaction.ReactionUserListReq) (resp *interaction.ReactionUserListResp, err error) {
+	successMsg := "成功"
+	resp = &interaction.ReactionUserListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Users: []*interaction.Reactor{},
+	}
+
+	likes, nextCursor, err := s.interactionService.GetReactionUsers(ctx, req.VideoId, req.GetReaction(), req.GetCursor(), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	userIDs := make([]int64, len(likes))
+	for i, like := range likes {
+		userIDs[i] = like.UserID
+	}
+
+	var users map[int64]*userModel.User
+	if s.userService != nil && len(userIDs) > 0 {
+		users, _ = s.userService.BatchGetUsersByIDs(ctx, userIDs)
+	}
+
+	reactors := make([]*interaction.Reactor, len(likes))
+	for i, like := range likes {
+		commonUser := &common.User{Id: like.UserID}
+		if user, ok := users[like.UserID]; ok {
+			commonUser.Username = user.Username
+			commonUser.FollowCount = user.FollowCount
+			commonUser.FollowerCount = user.FollowerCount
+			commonUser.Level = user.Level
+			if user.Avatar != "" {
+				avatar := user.Avatar
+				commonUser.Avatar = &avatar
+			}
+		}
+		reactors[i] = &interaction.Reactor{
+			User:      commonUser,
+			Reaction:  like.Reaction,
+			ReactedAt: like.CreatedAt.Unix(),
+		}
+	}
+
+	resp.Users = reactors
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// 转换评论，补充评论者等级、被回复用户的用户名和当前用户的点赞状态
+func (s *InteractionServiceImpl) convertComments(ctx context.Context, currentUserID int64, comments []*model.Comment) []*common.Comment {
+	commentIDs := make([]int64, 0, len(comments))
+	userIDs := make([]int64, 0, len(comments)*2)
+	for _, c := range comments {
+		commentIDs = append(commentIDs, c.ID)
+		userIDs = append(userIDs, c.UserID)
+		if c.ReplyToUserID > 0 {
+			userIDs = append(userIDs, c.ReplyToUserID)
+		}
+	}
+
+	var usernames map[int64]string
+	var levels map[int64]int32
+	if s.userService != nil && len(userIDs) > 0 {
+		users, err := s.userService.BatchGetUsersByIDs(ctx, userIDs)
+		if err == nil {
+			usernames = make(map[int64]string, len(users))
+			levels = make(map[int64]int32, len(users))
+			for id, user := range users {
+				usernames[id] = user.Username
+				levels[id] = user.Level
+			}
+		}
+	}
+
+	liked, err := s.interactionService.BatchCheckCommentLiked(ctx, currentUserID, commentIDs)
+	if err != nil {
+		liked = make(map[int64]bool)
+	}
+
+	commonComments := make([]*common.Comment, len(comments))
+	for i, c := range comments {
+		commonComments[i] = &common.Comment{
+			Id:          c.ID,
+			UserId:      c.UserID,
+			VideoId:     c.VideoID,
+			Content:     c.Content,
+			CreateTime:  c.CreateTime,
+			ReplyToId:   c.ReplyToID,
+			RootId:      c.RootID,
+			ReplyCount:  c.ReplyCount,
+			IsDeleted:   c.IsDeleted,
+			LikeCount:   c.LikeCount,
+			IsLiked:     liked[c.ID],
+			IsPinned:    c.IsPinned,
+			AuthorLiked: c.AuthorLiked,
+			Status:      c.Status,
+			UserLevel:   levels[c.UserID],
+		}
+		if c.ReplyToUserID > 0 {
+			replyToUserID := c.ReplyToUserID
+			commonComments[i].ReplyToUserId = &replyToUserID
+			if username, ok := usernames[c.ReplyToUserID]; ok {
+				commonComments[i].ReplyToUsername = &username
+			}
+		}
+	}
+	return commonComments
+}
+
+// 可见范围名单ID为0表示公开，不返回该字段
+func audienceListID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -4,25 +4,112 @@ import (
 	"time"
 )
 
+// 评论状态
+const (
+	CommentStatusPublished int32 = 0
+	//命中视频作者设置的关键词，由视频作者审核
+	CommentStatusPending int32 = 1
+	//命中平台敏感词需要审核，只有版主可以审核，视频作者不可见
+	CommentStatusModeration int32 = 2
+)
+
+// 视频评论权限
+const (
+	CommentPermissionEveryone  = "everyone"
+	CommentPermissionFollowers = "followers"
+	CommentPermissionFriends   = "friends"
+	CommentPermissionOff       = "off"
+)
+
 type Comment struct {
-	ID         int64     `gorm:"primaryKey;autoIncrement;comment:评论ID"`
-	UserID     int64     `gorm:"index;not null;comment:用户ID"`
-	VideoID    int64     `gorm:"index;not null;comment:视频ID"`
-	Content    string    `gorm:"type:text;not null;comment:评论内容"`
-	CreateTime string    `gorm:"size:50;not null;comment:创建时间"`
-	ReplyToID  int64     `gorm:"index;default:0;comment:回复的评论ID"`
-	CreatedAt  time.Time `gorm:"autoCreateTime;comment:创建时间"`
-	UpdatedAt  time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+	ID            int64     `gorm:"primaryKey;autoIncrement;comment:评论ID"`
+	UserID        int64     `gorm:"index;not null;comment:用户ID"`
+	VideoID       int64     `gorm:"index;not null;comment:视频ID"`
+	Content       string    `gorm:"type:text;not null;comment:评论内容"`
+	CreateTime    string    `gorm:"size:50;not null;comment:创建时间"`
+	ReplyToID     int64     `gorm:"index;default:0;comment:回复的评论ID"`
+	RootID        int64     `gorm:"index;default:0;comment:所属顶层评论ID"`
+	ReplyToUserID int64     `gorm:"default:0;comment:被回复的用户ID"`
+	ReplyCount    int64     `gorm:"default:0;comment:回复数"`
+	IsDeleted     bool      `gorm:"default:false;comment:是否已删除"`
+	LikeCount     int64     `gorm:"default:0;comment:点赞数"`
+	IsPinned      bool      `gorm:"default:false;comment:是否置顶"`
+	AuthorLiked   bool      `gorm:"default:false;comment:视频作者是否点赞"`
+	Status        int32     `gorm:"index;default:0;comment:状态(0已发布/1待作者审核/2待平台审核)"`
+	VideoAuthorID int64     `gorm:"index;default:0;comment:视频作者ID"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
 
 func (Comment) TableName() string {
 	return "comments"
 }
 
+type CommentLike struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:评论点赞ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_comment_like_user;not null;comment:用户ID"`
+	CommentID int64     `gorm:"uniqueIndex:idx_comment_like_user;index;not null;comment:评论ID"`
+	VideoID   int64     `gorm:"index;not null;comment:视频ID"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (CommentLike) TableName() string {
+	return "comment_likes"
+}
+
+type CommentSetting struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement;comment:设置ID"`
+	VideoID    int64     `gorm:"uniqueIndex;not null;comment:视频ID"`
+	AuthorID   int64     `gorm:"index;not null;comment:视频作者ID"`
+	Permission string    `gorm:"size:20;not null;default:everyone;comment:评论权限(everyone/followers/friends/off)"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (CommentSetting) TableName() string {
+	return "video_comment_settings"
+}
+
+type CommentKeyword struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:关键词ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_comment_keyword_user;not null;comment:作者ID"`
+	Keyword   string    `gorm:"uniqueIndex:idx_comment_keyword_user;size:100;not null;comment:关键词"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (CommentKeyword) TableName() string {
+	return "comment_keywords"
+}
+
+// 视频表情回应类型，LikeCount 统计任意回应
+const (
+	ReactionLike  = "like"
+	ReactionLove  = "love"
+	ReactionHaha  = "haha"
+	ReactionWow   = "wow"
+	ReactionSad   = "sad"
+	ReactionAngry = "angry"
+)
+
+var Reactions = []string{ReactionLike, ReactionLove, ReactionHaha, ReactionWow, ReactionSad, ReactionAngry}
+
+func IsValidReaction(reaction string) bool {
+	for _, r := range Reactions {
+		if r == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// 每个用户对每个视频只保留一个回应，Reaction 为回应类型
 type Like struct {
 	ID        int64     `gorm:"primaryKey;autoIncrement;comment:点赞ID"`
-	UserID    int64     `gorm:"index;not null;comment:用户ID"`
-	VideoID   int64     `gorm:"index;not null;comment:视频ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_like_user_video;index;not null;comment:用户ID"`
+	VideoID   int64     `gorm:"uniqueIndex:idx_like_user_video;index;not null;comment:视频ID"`
+	Reaction  string    `gorm:"size:20;not null;default:like;index;comment:回应类型(like/love/haha/wow/sad/angry)"`
 	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
@@ -31,6 +118,14 @@ func (Like) TableName() string {
 	return "likes"
 }
 
+// 当前用户与视频的互动状态
+type InteractionStatus struct {
+	IsLiked           bool
+	Reaction          string
+	IsStarred         bool
+	IsFollowingAuthor bool
+}
+
 type Star struct {
 	ID        int64     `gorm:"primaryKey;autoIncrement;comment:收藏ID"`
 	UserID    int64     `gorm:"index;not null;comment:用户ID"`
@@ -43,10 +138,77 @@ func (Star) TableName() string {
 	return "stars"
 }
 
+// 收藏夹，收藏的视频可同时放入多个收藏夹
+type StarFolder struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement;comment:收藏夹ID"`
+	UserID        int64     `gorm:"uniqueIndex:idx_star_folder_user_name;not null;comment:用户ID"`
+	Name          string    `gorm:"uniqueIndex:idx_star_folder_user_name;size:50;not null;comment:收藏夹名称"`
+	IsPublic      bool      `gorm:"default:false;comment:是否公开"`
+	VideoCount    int64     `gorm:"default:0;comment:视频数"`
+	FollowerCount int64     `gorm:"default:0;comment:关注数"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (StarFolder) TableName() string {
+	return "star_folders"
+}
+
+type StarFolderItem struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:收藏夹视频ID"`
+	FolderID  int64     `gorm:"uniqueIndex:idx_star_folder_item;not null;comment:收藏夹ID"`
+	VideoID   int64     `gorm:"uniqueIndex:idx_star_folder_item;index;not null;comment:视频ID"`
+	UserID    int64     `gorm:"index;not null;comment:用户ID"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (StarFolderItem) TableName() string {
+	return "star_folder_items"
+}
+
+type StarFolderFollow struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:关注ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_star_folder_follow;not null;comment:用户ID"`
+	FolderID  int64     `gorm:"uniqueIndex:idx_star_folder_follow;index;not null;comment:收藏夹ID"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (StarFolderFollow) TableName() string {
+	return "star_folder_follows"
+}
+
+// 分享渠道
+const (
+	ShareChannelWechat   = "wechat"
+	ShareChannelCopyLink = "copy_link"
+	ShareChannelMessage  = "message"
+)
+
+var ShareChannels = []string{ShareChannelWechat, ShareChannelCopyLink, ShareChannelMessage}
+
+func IsValidShareChannel(channel string) bool {
+	for _, c := range ShareChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// 分享链接转化事件
+const (
+	ShareEventClick   = "click"
+	ShareEventView    = "view"
+	ShareEventInstall = "install"
+)
+
 type Share struct {
 	ID        int64     `gorm:"primaryKey;autoIncrement;comment:分享ID"`
 	UserID    int64     `gorm:"index;not null;comment:用户ID"`
 	VideoID   int64     `gorm:"index;not null;comment:视频ID"`
+	Channel   string    `gorm:"size:20;default:'';comment:分享渠道"`
 	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
@@ -55,6 +217,62 @@ func (Share) TableName() string {
 	return "shares"
 }
 
+// 分享短链，同一用户对同一视频在同一渠道复用一个短码
+type ShareLink struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement;comment:短链ID"`
+	Code         string    `gorm:"uniqueIndex;size:16;not null;comment:短码"`
+	UserID       int64     `gorm:"uniqueIndex:idx_share_link_owner;not null;comment:分享者ID"`
+	VideoID      int64     `gorm:"uniqueIndex:idx_share_link_owner;index;not null;comment:视频ID"`
+	Channel      string    `gorm:"uniqueIndex:idx_share_link_owner;size:20;not null;comment:分享渠道"`
+	ClickCount   int64     `gorm:"default:0;comment:点击数"`
+	ViewCount    int64     `gorm:"default:0;comment:观看数"`
+	InstallCount int64     `gorm:"default:0;comment:安装数"`
+	CreatedAt    time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (ShareLink) TableName() string {
+	return "share_links"
+}
+
+type ShareEvent struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:事件ID"`
+	LinkID    int64     `gorm:"index;not null;comment:短链ID"`
+	VideoID   int64     `gorm:"index;not null;comment:视频ID"`
+	SharerID  int64     `gorm:"not null;comment:分享者ID"`
+	VisitorID int64     `gorm:"index;default:0;comment:访问者ID(未登录为0)"`
+	Event     string    `gorm:"size:20;not null;comment:事件类型(click/view/install)"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (ShareEvent) TableName() string {
+	return "share_events"
+}
+
+// 邀请归因，每个用户只记录第一个带来安装的分享
+type ShareAttribution struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:归因ID"`
+	UserID    int64     `gorm:"uniqueIndex;not null;comment:被邀请用户ID"`
+	InviterID int64     `gorm:"index;not null;comment:邀请者ID"`
+	LinkID    int64     `gorm:"not null;comment:短链ID"`
+	VideoID   int64     `gorm:"not null;comment:视频ID"`
+	Channel   string    `gorm:"size:20;not null;comment:分享渠道"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (ShareAttribution) TableName() string {
+	return "share_attributions"
+}
+
+// 按渠道汇总的分享数据
+type ShareChannelStats struct {
+	Channel      string
+	ShareCount   int64
+	ClickCount   int64
+	ViewCount    int64
+	InstallCount int64
+}
+
 type VideoInteractionStats struct {
 	ID           int64     `gorm:"primaryKey;autoIncrement;comment:统计ID"`
 	VideoID      int64     `gorm:"uniqueIndex;not null;comment:视频ID"`
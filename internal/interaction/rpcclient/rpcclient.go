@@ -0,0 +1,73 @@
+package rpcclient
+
+import (
+	"context"
+
+	"shortvideo/kitex_gen/common"
+	"shortvideo/kitex_gen/interaction"
+	"shortvideo/kitex_gen/interaction/interactionservice"
+	"shortvideo/pkg/config"
+	"shortvideo/pkg/logger"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/transport"
+	registry_etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+// 创建交互服务客户端，caller为调用方服务名
+func New(caller string) (interactionservice.Client, error) {
+	cfg := config.Get()
+
+	resolver, err := registry_etcd.NewEtcdResolver(cfg.Etcd.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return interactionservice.NewClient(
+		"interaction",
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithResolver(resolver),
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: caller,
+		}),
+	)
+}
+
+// 批量填充当前用户对视频的点赞状态和回应类型，失败时不影响主流程
+func FillVideoStatus(ctx context.Context, cli interactionservice.Client, userID int64, videos []*common.Video) {
+	if cli == nil || userID <= 0 || len(videos) == 0 {
+		return
+	}
+
+	videoIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		if v != nil {
+			videoIDs = append(videoIDs, v.Id)
+		}
+	}
+
+	resp, err := cli.BatchCheckInteractionStatus(ctx, &interaction.BatchCheckInteractionStatusReq{
+		UserId:   userID,
+		VideoIds: videoIDs,
+	})
+	if err != nil {
+		logger.Warn("批量获取互动状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return
+	}
+	if resp.BaseResp == nil || resp.BaseResp.StatusCode != 0 {
+		return
+	}
+
+	for _, v := range videos {
+		if v == nil {
+			continue
+		}
+		if status, ok := resp.Statuses[v.Id]; ok && status != nil {
+			v.IsLike = status.IsLiked
+			v.MyReaction = status.Reaction
+		}
+	}
+}
@@ -2,16 +2,28 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"shortvideo/internal/interaction/dao"
 	"shortvideo/internal/interaction/model"
+	socialService "shortvideo/internal/social/service"
+	userModel "shortvideo/internal/user/model"
+	"shortvideo/internal/user/settings"
 	videoModel "shortvideo/internal/video/model"
 	"shortvideo/internal/video/service"
+	"shortvideo/pkg/cache"
 	"shortvideo/pkg/logger"
 	"shortvideo/pkg/mq"
+	"shortvideo/pkg/pagination"
+	"shortvideo/pkg/rbac"
+	"shortvideo/pkg/textfilter"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
@@ -25,66 +37,196 @@ var (
 	ErrAlreadyStarred        = errors.New("已经收藏过")
 	ErrNotLiked              = errors.New("未点赞")
 	ErrNotStarred            = errors.New("未收藏")
+	ErrCommentDeleted        = errors.New("评论已删除")
+	ErrNotVideoAuthor        = errors.New("不是视频作者")
+	ErrInvalidSortType       = errors.New("无效的排序方式")
+	ErrCommentsDisabled      = errors.New("该视频已关闭评论")
+	ErrCommentNotAllowed     = errors.New("没有评论该视频的权限")
+	ErrCommentBlocked        = errors.New("由于拉黑关系无法评论")
+	ErrInvalidPermission     = errors.New("无效的评论权限设置")
+	ErrInvalidKeyword        = errors.New("无效的关键词")
+	ErrKeywordExists         = errors.New("关键词已存在")
+	ErrKeywordNotFound       = errors.New("关键词不存在")
+	ErrTooManyKeywords       = errors.New("关键词数量已达上限")
+	ErrCommentNotPending     = errors.New("评论不在待审核状态")
+	ErrSensitiveContent      = errors.New("内容包含违规信息")
+	ErrInvalidReaction       = errors.New("无效的回应类型")
+	ErrAlreadyReacted        = errors.New("已经使用该回应")
+	ErrInvalidFolderName     = errors.New("无效的收藏夹名称")
+	ErrStarFolderExists      = errors.New("收藏夹名称已存在")
+	ErrStarFolderNotFound    = errors.New("收藏夹不存在")
+	ErrTooManyStarFolders    = errors.New("收藏夹数量已达上限")
+	ErrAlreadyInFolder       = errors.New("视频已在收藏夹中")
+	ErrNotInFolder           = errors.New("视频不在收藏夹中")
+	ErrCannotFollowOwnFolder = errors.New("不能关注自己的收藏夹")
+	ErrAlreadyFollowedFolder = errors.New("已经关注该收藏夹")
+	ErrNotFollowedFolder     = errors.New("未关注该收藏夹")
+	ErrInvalidShareChannel   = errors.New("无效的分享渠道")
+	ErrShareLinkNotFound     = errors.New("分享链接不存在")
+	ErrInvalidShareEvent     = errors.New("无效的分享转化事件")
+	ErrSelfAttribution       = errors.New("不能通过自己的分享链接归因")
+	ErrAlreadyAttributed     = errors.New("已有邀请归因")
+	ErrLikeListHidden        = errors.New("该用户未公开点赞列表")
+	ErrStarListHidden        = errors.New("该用户未公开收藏列表")
 )
 
+// 评论排序方式
+const (
+	CommentSortNew = "new"
+	CommentSortHot = "hot"
+)
+
+const (
+	//评论列表中每条顶层评论预览的回复数
+	replyPreviewSize = 3
+	//参与热门排序的候选评论数
+	hotCandidateLimit = 500
+	//热门评论排序缓存时间
+	hotCommentsTTL = 10 * time.Minute
+	//热门评论排序快照保留时间，翻页期间排序不随缓存刷新而变化
+	hotSnapshotTTL = time.Hour
+	//每个作者的评论关键词上限
+	maxCommentKeywords = 200
+	//单个关键词最大长度
+	maxKeywordLength = 50
+	//每个用户的收藏夹上限
+	maxStarFolders = 100
+	//收藏夹名称最大长度
+	maxFolderNameLength = 50
+	//分享短码长度
+	shareCodeLength = 8
+	//生成短码冲突时的重试次数
+	shareCodeRetries = 3
+	//用户点赞/收藏集合缓存时间
+	statusCacheTTL = 30 * time.Minute
+	//集合中标记已预热的占位成员，视频ID不会为0
+	statusCacheSentinel = "0"
+)
+
+const shareCodeAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
 type InteractionService interface {
 	//点赞
 	LikeAction(ctx context.Context, userID, videoID int64, action bool) error
-	GetLikeVideoList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*videoModel.Video, int64, error)
+	GetLikeVideoList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*videoModel.Video, string, int64, error)
 	CheckLikeStatus(ctx context.Context, userID, videoID int64) (bool, error)
+	BatchCheckInteractionStatus(ctx context.Context, userID int64, videoIDs []int64) (map[int64]*model.InteractionStatus, error)
+	//表情回应
+	ReactAction(ctx context.Context, userID, videoID int64, reaction string) error
+	GetReactionCounts(ctx context.Context, videoID int64) (map[string]int64, error)
+	BatchGetMyReactions(ctx context.Context, userID int64, videoIDs []int64) (map[int64]string, error)
+	GetReactionUsers(ctx context.Context, videoID int64, reaction, cursor string, pageSize int) ([]*model.Like, string, error)
 	//收藏
-	StarAction(ctx context.Context, userID, videoID int64, action bool) error
-	GetStarVideoList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*videoModel.Video, int64, error)
+	StarAction(ctx context.Context, userID, videoID int64, action bool, folderIDs []int64) error
+	GetStarVideoList(ctx context.Context, userID, currentUserID, folderID int64, cursor string, pageSize int, needTotal bool) ([]*videoModel.Video, string, int64, error)
 	CheckStarStatus(ctx context.Context, userID, videoID int64) (bool, error)
+	//收藏夹
+	CreateStarFolder(ctx context.Context, userID int64, name string, isPublic bool) (*model.StarFolder, error)
+	UpdateStarFolder(ctx context.Context, userID, folderID int64, name *string, isPublic *bool) (*model.StarFolder, error)
+	DeleteStarFolder(ctx context.Context, userID, folderID int64) error
+	GetStarFolders(ctx context.Context, userID, currentUserID int64) ([]*model.StarFolder, map[int64]bool, error)
+	StarFolderItemAction(ctx context.Context, userID, folderID, videoID int64, action bool) error
+	MoveStarVideo(ctx context.Context, userID, videoID, fromFolderID, toFolderID int64) error
+	StarFolderFollowAction(ctx context.Context, userID, folderID int64, action bool) error
+	GetFollowedStarFolders(ctx context.Context, userID int64) ([]*model.StarFolder, error)
 	//评论
 	CommentAction(ctx context.Context, userID, videoID int64, content string, replyToID int64) (*model.Comment, error)
-	GetCommentList(ctx context.Context, videoID, currentUserID int64, page, pageSize int) ([]*model.Comment, int64, error)
+	GetCommentList(ctx context.Context, videoID, currentUserID int64, sortType, cursor string, pageSize int, needTotal bool) ([]*model.Comment, string, int64, error)
+	GetPinnedComment(ctx context.Context, videoID int64) (*model.Comment, error)
+	GetReplyPreviews(ctx context.Context, rootIDs []int64, currentUserID int64) (map[int64][]*model.Comment, error)
+	GetCommentReplies(ctx context.Context, commentID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*model.Comment, string, int64, error)
 	DeleteComment(ctx context.Context, userID, videoID, commentID int64) error
+	CommentLikeAction(ctx context.Context, userID, commentID int64, action bool) error
+	BatchCheckCommentLiked(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error)
+	PinComment(ctx context.Context, userID, videoID, commentID int64, action bool) error
+	//评论管理
+	SetCommentPermission(ctx context.Context, userID, videoID int64, permission string) error
+	GetCommentPermission(ctx context.Context, videoID int64) (string, error)
+	CommentKeywordAction(ctx context.Context, userID int64, keyword string, action bool) error
+	GetCommentKeywords(ctx context.Context, userID int64) ([]string, error)
+	GetPendingComments(ctx context.Context, userID int64, cursor string, pageSize int) ([]*model.Comment, string, error)
+	GetModerationComments(ctx context.Context, cursor string, pageSize int) ([]*model.Comment, string, error)
+	ReviewComment(ctx context.Context, userID, commentID int64, approve bool) error
 	//分享操作
-	ShareAction(ctx context.Context, userID, videoID int64) error
+	ShareAction(ctx context.Context, userID, videoID int64, channel string) error
+	CreateShareLink(ctx context.Context, userID, videoID int64, channel string) (*model.ShareLink, error)
+	ResolveShareLink(ctx context.Context, code string, visitorID int64) (*model.ShareLink, error)
+	RecordShareConversion(ctx context.Context, code string, visitorID int64, event string) error
+	GetShareStats(ctx context.Context, userID, videoID int64) ([]*model.ShareChannelStats, error)
+	GetInvitedBy(ctx context.Context, userID int64) (*model.ShareAttribution, error)
 	//获取互动统计
 	GetCount(ctx context.Context, videoID int64) (int64, int64, int64, int64, error)
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
 	//事务支持
 	WithTransaction(ctx context.Context, fn func(txService InteractionService) error) error
 }
 
 type interactionServiceImpl struct {
-	likeRepo      dao.LikeRepository
-	starRepo      dao.StarRepository
-	commentRepo   dao.CommentRepository
-	shareRepo     dao.ShareRepository
-	statsRepo     dao.VideoInteractionStatsRepository
-	videoService  service.VideoService
-	kafkaProducer *mq.Producer
+	likeRepo           dao.LikeRepository
+	starRepo           dao.StarRepository
+	starFolderRepo     dao.StarFolderRepository
+	commentRepo        dao.CommentRepository
+	commentLikeRepo    dao.CommentLikeRepository
+	commentSettingRepo dao.CommentSettingRepository
+	commentKeywordRepo dao.CommentKeywordRepository
+	shareRepo          dao.ShareRepository
+	shareLinkRepo      dao.ShareLinkRepository
+	statsRepo          dao.VideoInteractionStatsRepository
+	videoService       service.VideoService
+	socialService      socialService.SocialService
+	settings           settings.Client
+	kafkaProducer      *mq.Producer
+	cache              cache.Cache
 }
 
 func NewInteractionService(
 	likeRepo dao.LikeRepository,
 	starRepo dao.StarRepository,
+	starFolderRepo dao.StarFolderRepository,
 	commentRepo dao.CommentRepository,
+	commentLikeRepo dao.CommentLikeRepository,
+	commentSettingRepo dao.CommentSettingRepository,
+	commentKeywordRepo dao.CommentKeywordRepository,
 	shareRepo dao.ShareRepository,
+	shareLinkRepo dao.ShareLinkRepository,
 	statsRepo dao.VideoInteractionStatsRepository,
 	videoService service.VideoService,
+	socialService socialService.SocialService,
+	settings settings.Client,
 	kafkaProducer *mq.Producer,
+	cache cache.Cache,
 ) InteractionService {
 	return &interactionServiceImpl{
-		likeRepo:      likeRepo,
-		starRepo:      starRepo,
-		commentRepo:   commentRepo,
-		shareRepo:     shareRepo,
-		statsRepo:     statsRepo,
-		videoService:  videoService,
-		kafkaProducer: kafkaProducer,
+		likeRepo:           likeRepo,
+		starRepo:           starRepo,
+		starFolderRepo:     starFolderRepo,
+		commentRepo:        commentRepo,
+		commentLikeRepo:    commentLikeRepo,
+		commentSettingRepo: commentSettingRepo,
+		commentKeywordRepo: commentKeywordRepo,
+		shareRepo:          shareRepo,
+		shareLinkRepo:      shareLinkRepo,
+		statsRepo:          statsRepo,
+		videoService:       videoService,
+		socialService:      socialService,
+		settings:           settings,
+		kafkaProducer:      kafkaProducer,
+		cache:              cache,
 	}
 }
 
-// 点赞操作
+// 点赞操作，点赞即使用like回应
 func (s *interactionServiceImpl) LikeAction(ctx context.Context, userID, videoID int64, action bool) error {
 	logger.Info("点赞操作请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID),
 		logger.BoolField("action", action))
 
+	if !action {
+		return s.ReactAction(ctx, userID, videoID, "")
+	}
+
 	exists, err := s.likeRepo.Exists(ctx, userID, videoID)
 	if err != nil {
 		logger.Error("检查点赞状态失败",
@@ -93,19 +235,62 @@ func (s *interactionServiceImpl) LikeAction(ctx context.Context, userID, videoID
 			logger.Int64Field("video_id", videoID))
 		return ErrInternalServer
 	}
+	if exists {
+		return ErrAlreadyLiked
+	}
 
-	if action {
-		if exists {
-			return ErrAlreadyLiked
+	return s.ReactAction(ctx, userID, videoID, model.ReactionLike)
+}
+
+// 表情回应操作，每个用户对每个视频只有一个回应，reaction为空表示取消回应
+func (s *interactionServiceImpl) ReactAction(ctx context.Context, userID, videoID int64, reaction string) error {
+	logger.Info("表情回应请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("reaction", reaction))
+
+	if reaction != "" && !model.IsValidReaction(reaction) {
+		return ErrInvalidReaction
+	}
+
+	existing, err := s.likeRepo.Find(ctx, userID, videoID)
+	if err != nil {
+		logger.Error("查询回应记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return ErrInternalServer
+	}
+
+	switch {
+	case reaction == "":
+		if existing == nil {
+			return ErrNotLiked
+		}
+
+		//并发取消时只有真正删除记录的请求减少点赞数
+		deleted, err := s.likeRepo.Delete(ctx, userID, videoID)
+		if err != nil {
+			logger.Error("删除回应记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+			return ErrInteractionFailed
+		}
+		if !deleted {
+			return ErrNotLiked
 		}
 
+		s.updateStatusSet(ctx, cache.GenerateUserLikedKey(userID), videoID, false)
+	case existing == nil:
 		like := &model.Like{
-			UserID:  userID,
-			VideoID: videoID,
+			UserID:   userID,
+			VideoID:  videoID,
+			Reaction: reaction,
 		}
 
 		if err := s.likeRepo.Create(ctx, like); err != nil {
-			logger.Error("创建点赞记录失败",
+			logger.Error("创建回应记录失败",
 				logger.ErrorField(err),
 				logger.Int64Field("user_id", userID),
 				logger.Int64Field("video_id", videoID))
@@ -117,63 +302,163 @@ func (s *interactionServiceImpl) LikeAction(ctx context.Context, userID, videoID
 				logger.ErrorField(err),
 				logger.Int64Field("video_id", videoID))
 		}
-	} else {
-		if !exists {
-			return ErrNotLiked
-		}
 
-		if err := s.likeRepo.Delete(ctx, userID, videoID); err != nil {
-			logger.Error("删除点赞记录失败",
+		s.updateStatusSet(ctx, cache.GenerateUserLikedKey(userID), videoID, true)
+		s.publishLikeReceived(ctx, userID, videoID)
+	case existing.Reaction == reaction:
+		return ErrAlreadyReacted
+	default:
+		//切换回应类型，点赞总数不变
+		if err := s.likeRepo.UpdateReaction(ctx, userID, videoID, reaction); err != nil {
+			logger.Error("切换回应类型失败",
 				logger.ErrorField(err),
 				logger.Int64Field("user_id", userID),
 				logger.Int64Field("video_id", videoID))
 			return ErrInteractionFailed
 		}
-
-		if err := s.statsRepo.DecrementLikeCount(ctx, videoID); err != nil {
-			logger.Error("减少视频点赞数失败",
-				logger.ErrorField(err),
-				logger.Int64Field("video_id", videoID))
-		}
 	}
 
 	if s.kafkaProducer != nil {
 		eventData := map[string]interface{}{
 			"user_id":    userID,
 			"video_id":   videoID,
-			"action":     action,
+			"action":     reaction != "",
+			"reaction":   reaction,
 			"created_at": time.Now(),
 		}
 		data, _ := json.Marshal(eventData)
 		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", userID), data)
 	}
 
-	logger.Info("点赞操作成功",
+	logger.Info("表情回应成功",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID),
-		logger.BoolField("action", action))
+		logger.StringField("reaction", reaction))
 
 	return nil
 }
 
+// 获取视频各类回应的数量
+func (s *interactionServiceImpl) GetReactionCounts(ctx context.Context, videoID int64) (map[string]int64, error) {
+	counts, err := s.likeRepo.CountReactionsByVideoID(ctx, videoID)
+	if err != nil {
+		logger.Error("统计视频回应失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
+	}
+
+	result := make(map[string]int64, len(model.Reactions))
+	for _, reaction := range model.Reactions {
+		result[reaction] = counts[reaction]
+	}
+	return result, nil
+}
+
+// 批量获取用户对视频的回应
+func (s *interactionServiceImpl) BatchGetMyReactions(ctx context.Context, userID int64, videoIDs []int64) (map[int64]string, error) {
+	if userID <= 0 || len(videoIDs) == 0 {
+		return make(map[int64]string), nil
+	}
+
+	reactions, err := s.likeRepo.BatchGetReactions(ctx, userID, videoIDs)
+	if err != nil {
+		logger.Error("批量获取回应失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return reactions, nil
+}
+
+// 获取回应视频的用户，reaction为空时返回所有回应
+func (s *interactionServiceImpl) GetReactionUsers(ctx context.Context, videoID int64, reaction, cursor string, pageSize int) ([]*model.Like, string, error) {
+	logger.Info("获取回应用户列表请求",
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("reaction", reaction),
+		logger.StringField("cursor", cursor),
+		logger.IntField("page_size", pageSize))
+
+	if reaction != "" && !model.IsValidReaction(reaction) {
+		return nil, "", ErrInvalidReaction
+	}
+
+	pageScope := pagination.Scope{List: "reaction_users:" + reaction, Owner: videoID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	likes, err := s.likeRepo.ListByVideoIDAndReaction(ctx, videoID, reaction, pageCursor, pageSize+1)
+	if err != nil {
+		logger.Error("获取回应用户列表失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return nil, "", ErrInternalServer
+	}
+
+	likes, nextCursor := pagination.Paginate(likes, pageSize, pageScope, func(l *model.Like) pagination.Cursor {
+		return pagination.Cursor{SortKey: l.CreatedAt.UnixMicro(), ID: l.ID}
+	})
+	return likes, nextCursor, nil
+}
+
 // 获取用户点赞视频列表
-func (s *interactionServiceImpl) GetLikeVideoList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*videoModel.Video, int64, error) {
+func (s *interactionServiceImpl) GetLikeVideoList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*videoModel.Video, string, int64, error) {
 	logger.Info("获取用户点赞视频列表请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	likes, total, err := s.likeRepo.ListByUserID(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "liked_videos", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	if err := s.checkContentAccess(ctx, currentUserID, userID); err != nil {
+		return nil, "", 0, err
+	}
+	ownerSettings, err := s.ownerSettings(ctx, currentUserID, userID)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if ownerSettings != nil && !ownerSettings.LikedListPublic {
+		return nil, "", 0, ErrLikeListHidden
+	}
+
+	likes, err := s.likeRepo.ListByUserID(ctx, userID, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("获取用户点赞记录失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	likes, nextCursor := pagination.Paginate(likes, pageSize, pageScope, func(like *model.Like) pagination.Cursor {
+		return pagination.Cursor{SortKey: like.CreatedAt.UnixMicro(), ID: like.ID}
+	})
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("user_likes", userID), func() (int64, error) {
+			return s.likeRepo.CountByUserID(ctx, userID)
+		})
+		if err != nil {
+			logger.Error("统计用户点赞数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	if len(likes) == 0 {
-		return []*videoModel.Video{}, total, nil
+		return []*videoModel.Video{}, nextCursor, total, nil
 	}
 
 	videoIDs := make([]int64, len(likes))
@@ -186,7 +471,7 @@ func (s *interactionServiceImpl) GetLikeVideoList(ctx context.Context, userID, c
 		logger.Error("批量获取视频信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
 	}
 
 	videos := make([]*videoModel.Video, 0, len(likes))
@@ -195,21 +480,24 @@ func (s *interactionServiceImpl) GetLikeVideoList(ctx context.Context, userID, c
 			videos = append(videos, video)
 		}
 	}
+	videos = s.filterAudienceVideos(ctx, currentUserID, videos)
 
 	logger.Info("获取用户点赞视频列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("video_count", len(videos)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return videos, total, nil
+	return videos, nextCursor, total, nil
 }
 
 // 收藏操作
-func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID int64, action bool) error {
+// 收藏时可同时放入多个收藏夹，取消收藏时从所有收藏夹中移除
+func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID int64, action bool, folderIDs []int64) error {
 	logger.Info("收藏操作请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID),
-		logger.BoolField("action", action))
+		logger.BoolField("action", action),
+		logger.IntField("folder_count", len(folderIDs)))
 
 	exists, err := s.starRepo.Exists(ctx, userID, videoID)
 	if err != nil {
@@ -225,6 +513,11 @@ func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID
 			return ErrAlreadyStarred
 		}
 
+		folders, err := s.getOwnFolders(ctx, userID, folderIDs)
+		if err != nil {
+			return err
+		}
+
 		star := &model.Star{
 			UserID:  userID,
 			VideoID: videoID,
@@ -243,6 +536,22 @@ func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID
 				logger.ErrorField(err),
 				logger.Int64Field("video_id", videoID))
 		}
+
+		s.updateStatusSet(ctx, cache.GenerateUserStarredKey(userID), videoID, true)
+
+		for _, folder := range folders {
+			item := &model.StarFolderItem{
+				FolderID: folder.ID,
+				VideoID:  videoID,
+				UserID:   userID,
+			}
+			if _, err := s.starFolderRepo.AddItem(ctx, item); err != nil {
+				logger.Error("添加视频到收藏夹失败",
+					logger.ErrorField(err),
+					logger.Int64Field("folder_id", folder.ID),
+					logger.Int64Field("video_id", videoID))
+			}
+		}
 	} else {
 		if !exists {
 			return ErrNotStarred
@@ -261,6 +570,15 @@ func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID
 				logger.ErrorField(err),
 				logger.Int64Field("video_id", videoID))
 		}
+
+		s.updateStatusSet(ctx, cache.GenerateUserStarredKey(userID), videoID, false)
+
+		if err := s.starFolderRepo.RemoveVideoFromUserFolders(ctx, userID, videoID); err != nil {
+			logger.Error("从收藏夹移除视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+		}
 	}
 
 	if s.kafkaProducer != nil {
@@ -282,29 +600,169 @@ func (s *interactionServiceImpl) StarAction(ctx context.Context, userID, videoID
 	return nil
 }
 
-// 获取用户收藏视频列表
-func (s *interactionServiceImpl) GetStarVideoList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*videoModel.Video, int64, error) {
+// 私密账号的点赞和收藏只对本人和关注者可见
+func (s *interactionServiceImpl) checkContentAccess(ctx context.Context, viewerID, ownerID int64) error {
+	if s.socialService == nil {
+		return nil
+	}
+
+	canView, err := s.socialService.CanViewContent(ctx, viewerID, ownerID)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return socialService.ErrPrivateAccount
+	}
+	return nil
+}
+
+// 获取列表所有者的用户设置，本人查看自己的列表时返回nil
+func (s *interactionServiceImpl) ownerSettings(ctx context.Context, viewerID, ownerID int64) (*userModel.UserSettings, error) {
+	if s.settings == nil || viewerID == ownerID {
+		return nil, nil
+	}
+
+	ownerSettings, err := s.settings.Get(ctx, ownerID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	return ownerSettings, nil
+}
+
+// 过滤当前用户不在作者指定可见名单中的视频，查询失败时按不可见处理
+func (s *interactionServiceImpl) filterAudienceVideos(ctx context.Context, viewerID int64, videos []*videoModel.Video) []*videoModel.Video {
+	if s.socialService == nil {
+		return videos
+	}
+
+	listIDs := make([]int64, 0)
+	for _, video := range videos {
+		if video.AudienceListID != 0 && video.AuthorID != viewerID {
+			listIDs = append(listIDs, video.AudienceListID)
+		}
+	}
+	if len(listIDs) == 0 {
+		return videos
+	}
+
+	allowed, err := s.socialService.BatchCheckAudience(ctx, viewerID, listIDs)
+	if err != nil {
+		logger.Warn("批量检查可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("viewer_id", viewerID))
+		allowed = map[int64]bool{}
+	}
+
+	filtered := make([]*videoModel.Video, 0, len(videos))
+	for _, video := range videos {
+		if video.AudienceListID != 0 && video.AuthorID != viewerID && !allowed[video.AudienceListID] {
+			continue
+		}
+		filtered = append(filtered, video)
+	}
+	return filtered
+}
+
+// 获取用户收藏视频列表，folderID大于0时只返回该收藏夹中的视频
+func (s *interactionServiceImpl) GetStarVideoList(ctx context.Context, userID, currentUserID, folderID int64, cursor string, pageSize int, needTotal bool) ([]*videoModel.Video, string, int64, error) {
 	logger.Info("获取用户收藏视频列表请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
+		logger.Int64Field("folder_id", folderID),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	stars, total, err := s.starRepo.ListByUserID(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "starred_videos", Owner: userID}
+	if folderID > 0 {
+		pageScope = pagination.Scope{List: "star_folder_items", Owner: folderID}
+	}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
 	if err != nil {
-		logger.Error("获取用户收藏记录失败",
-			logger.ErrorField(err),
-			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	if err := s.checkContentAccess(ctx, currentUserID, userID); err != nil {
+		return nil, "", 0, err
 	}
 
-	if len(stars) == 0 {
-		return []*videoModel.Video{}, total, nil
+	var videoIDs []int64
+	var nextCursor string
+	var total int64
+	if folderID > 0 {
+		folder, err := s.getVisibleFolder(ctx, folderID, currentUserID)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		if folder.UserID != userID {
+			return nil, "", 0, ErrStarFolderNotFound
+		}
+
+		items, err := s.starFolderRepo.ListItems(ctx, folderID, pageCursor, pageSize+1)
+		if err != nil {
+			logger.Error("获取收藏夹视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("folder_id", folderID))
+			return nil, "", 0, ErrInternalServer
+		}
+		items, nextCursor = pagination.Paginate(items, pageSize, pageScope, func(item *model.StarFolderItem) pagination.Cursor {
+			return pagination.Cursor{SortKey: item.CreatedAt.UnixMicro(), ID: item.ID}
+		})
+		for _, item := range items {
+			videoIDs = append(videoIDs, item.VideoID)
+		}
+
+		if needTotal {
+			total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("star_folder_items", folderID), func() (int64, error) {
+				return s.starFolderRepo.CountItems(ctx, folderID)
+			})
+			if err != nil {
+				logger.Error("统计收藏夹视频数失败",
+					logger.ErrorField(err),
+					logger.Int64Field("folder_id", folderID))
+				return nil, "", 0, ErrInternalServer
+			}
+		}
+	} else {
+		//收藏夹有各自的可见性，设置只控制全部收藏列表
+		ownerSettings, err := s.ownerSettings(ctx, currentUserID, userID)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		if ownerSettings != nil && !ownerSettings.StarredListPublic {
+			return nil, "", 0, ErrStarListHidden
+		}
+
+		stars, err := s.starRepo.ListByUserID(ctx, userID, pageCursor, pageSize+1)
+		if err != nil {
+			logger.Error("获取用户收藏记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
+		stars, nextCursor = pagination.Paginate(stars, pageSize, pageScope, func(star *model.Star) pagination.Cursor {
+			return pagination.Cursor{SortKey: star.CreatedAt.UnixMicro(), ID: star.ID}
+		})
+		for _, star := range stars {
+			videoIDs = append(videoIDs, star.VideoID)
+		}
+
+		if needTotal {
+			total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("user_stars", userID), func() (int64, error) {
+				return s.starRepo.CountByUserID(ctx, userID)
+			})
+			if err != nil {
+				logger.Error("统计用户收藏数失败",
+					logger.ErrorField(err),
+					logger.Int64Field("user_id", userID))
+				return nil, "", 0, ErrInternalServer
+			}
+		}
 	}
 
-	videoIDs := make([]int64, len(stars))
-	for i, star := range stars {
-		videoIDs[i] = star.VideoID
+	if len(videoIDs) == 0 {
+		return []*videoModel.Video{}, nextCursor, total, nil
 	}
 
 	videoMap, err := s.videoService.BatchGetVideosByIDs(ctx, videoIDs, currentUserID)
@@ -312,196 +770,1947 @@ func (s *interactionServiceImpl) GetStarVideoList(ctx context.Context, userID, c
 		logger.Error("批量获取视频信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
 	}
 
-	videos := make([]*videoModel.Video, 0, len(stars))
-	for _, star := range stars {
-		if video, ok := videoMap[star.VideoID]; ok {
+	videos := make([]*videoModel.Video, 0, len(videoIDs))
+	for _, videoID := range videoIDs {
+		if video, ok := videoMap[videoID]; ok {
 			videos = append(videos, video)
 		}
 	}
+	videos = s.filterAudienceVideos(ctx, currentUserID, videos)
 
 	logger.Info("获取用户收藏视频列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("video_count", len(videos)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return videos, total, nil
+	return videos, nextCursor, total, nil
 }
 
-// 评论操作
-func (s *interactionServiceImpl) CommentAction(ctx context.Context, userID, videoID int64, content string, replyToID int64) (*model.Comment, error) {
-	logger.Info("评论操作请求",
+// 创建收藏夹
+func (s *interactionServiceImpl) CreateStarFolder(ctx context.Context, userID int64, name string, isPublic bool) (*model.StarFolder, error) {
+	logger.Info("创建收藏夹请求",
 		logger.Int64Field("user_id", userID),
-		logger.Int64Field("video_id", videoID),
-		logger.StringField("content", content),
-		logger.Int64Field("reply_to_id", replyToID))
+		logger.StringField("name", name),
+		logger.BoolField("is_public", isPublic))
 
-	if content == "" {
-		return nil, ErrInvalidCommentContent
+	name, err := validateFolderName(name)
+	if err != nil {
+		return nil, err
 	}
 
-	comment := &model.Comment{
-		UserID:     userID,
-		VideoID:    videoID,
-		Content:    content,
-		ReplyToID:  replyToID,
-		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
+	count, err := s.starFolderRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("统计收藏夹数量失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if count >= maxStarFolders {
+		return nil, ErrTooManyStarFolders
 	}
 
-	if err := s.commentRepo.Create(ctx, comment); err != nil {
-		logger.Error("创建评论记录失败",
+	exists, err := s.starFolderRepo.ExistsByName(ctx, userID, name)
+	if err != nil {
+		logger.Error("检查收藏夹名称失败",
 			logger.ErrorField(err),
-			logger.Int64Field("user_id", userID),
-			logger.Int64Field("video_id", videoID))
-		return nil, ErrInteractionFailed
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if exists {
+		return nil, ErrStarFolderExists
 	}
 
-	if err := s.statsRepo.IncrementCommentCount(ctx, videoID); err != nil {
-		logger.Error("增加视频评论数失败",
+	folder := &model.StarFolder{
+		UserID:   userID,
+		Name:     name,
+		IsPublic: isPublic,
+	}
+	if err := s.starFolderRepo.Create(ctx, folder); err != nil {
+		logger.Error("创建收藏夹失败",
 			logger.ErrorField(err),
-			logger.Int64Field("video_id", videoID))
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInteractionFailed
 	}
 
-	if s.kafkaProducer != nil {
-		eventData := map[string]interface{}{
-			"comment_id":  comment.ID,
-			"user_id":     userID,
-			"video_id":    videoID,
-			"content":     content,
-			"reply_to_id": replyToID,
-			"created_at":  time.Now(),
+	logger.Info("创建收藏夹成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folder.ID))
+
+	return folder, nil
+}
+
+// 重命名收藏夹或修改公开设置，name和isPublic为nil时不修改
+func (s *interactionServiceImpl) UpdateStarFolder(ctx context.Context, userID, folderID int64, name *string, isPublic *bool) (*model.StarFolder, error) {
+	logger.Info("更新收藏夹请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID))
+
+	folder, err := s.getOwnFolder(ctx, userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if name != nil {
+		newName, err := validateFolderName(*name)
+		if err != nil {
+			return nil, err
 		}
-		data, _ := json.Marshal(eventData)
-		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", comment.ID), data)
+		if newName != folder.Name {
+			exists, err := s.starFolderRepo.ExistsByName(ctx, userID, newName)
+			if err != nil {
+				logger.Error("检查收藏夹名称失败",
+					logger.ErrorField(err),
+					logger.Int64Field("user_id", userID))
+				return nil, ErrInternalServer
+			}
+			if exists {
+				return nil, ErrStarFolderExists
+			}
+			updates["name"] = newName
+			folder.Name = newName
+		}
+	}
+	if isPublic != nil && *isPublic != folder.IsPublic {
+		updates["is_public"] = *isPublic
+		folder.IsPublic = *isPublic
 	}
 
-	logger.Info("评论操作成功",
+	if len(updates) == 0 {
+		return folder, nil
+	}
+
+	if err := s.starFolderRepo.Update(ctx, folderID, updates); err != nil {
+		logger.Error("更新收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("folder_id", folderID))
+		return nil, ErrInteractionFailed
+	}
+
+	logger.Info("更新收藏夹成功",
 		logger.Int64Field("user_id", userID),
-		logger.Int64Field("video_id", videoID),
-		logger.Int64Field("comment_id", comment.ID))
+		logger.Int64Field("folder_id", folderID))
 
-	return comment, nil
+	return folder, nil
 }
 
-// 获取评论列表
-func (s *interactionServiceImpl) GetCommentList(ctx context.Context, videoID, currentUserID int64, page, pageSize int) ([]*model.Comment, int64, error) {
-	logger.Info("获取评论列表请求",
-		logger.Int64Field("video_id", videoID),
-		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
-		logger.IntField("page_size", pageSize))
+// 删除收藏夹，其中的视频仍保留在收藏中
+func (s *interactionServiceImpl) DeleteStarFolder(ctx context.Context, userID, folderID int64) error {
+	logger.Info("删除收藏夹请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID))
 
-	comments, total, err := s.commentRepo.ListByVideoID(ctx, videoID, page, pageSize)
-	if err != nil {
-		logger.Error("获取评论列表失败",
+	if _, err := s.getOwnFolder(ctx, userID, folderID); err != nil {
+		return err
+	}
+
+	if err := s.starFolderRepo.Delete(ctx, folderID); err != nil {
+		logger.Error("删除收藏夹失败",
 			logger.ErrorField(err),
-			logger.Int64Field("video_id", videoID))
-		return nil, 0, ErrInternalServer
+			logger.Int64Field("folder_id", folderID))
+		return ErrInteractionFailed
 	}
 
-	logger.Info("获取评论列表成功",
-		logger.Int64Field("video_id", videoID),
-		logger.IntField("comment_count", len(comments)),
-		logger.Int64Field("total_count", total))
+	logger.Info("删除收藏夹成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID))
 
-	return comments, total, nil
+	return nil
 }
 
-// 删除评论
-func (s *interactionServiceImpl) DeleteComment(ctx context.Context, userID, videoID, commentID int64) error {
-	logger.Info("删除评论请求",
+// 获取用户的收藏夹列表，查看他人时只返回公开收藏夹，同时返回当前用户的关注状态
+func (s *interactionServiceImpl) GetStarFolders(ctx context.Context, userID, currentUserID int64) ([]*model.StarFolder, map[int64]bool, error) {
+	logger.Info("获取收藏夹列表请求",
 		logger.Int64Field("user_id", userID),
-		logger.Int64Field("video_id", videoID),
-		logger.Int64Field("comment_id", commentID))
+		logger.Int64Field("current_user_id", currentUserID))
 
-	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	folders, err := s.starFolderRepo.ListByUserID(ctx, userID, userID != currentUserID)
 	if err != nil {
-		logger.Error("查询评论失败",
+		logger.Error("获取收藏夹列表失败",
 			logger.ErrorField(err),
-			logger.Int64Field("comment_id", commentID))
-		return ErrInternalServer
+			logger.Int64Field("user_id", userID))
+		return nil, nil, ErrInternalServer
 	}
 
-	if comment == nil {
-		return ErrCommentNotFound
+	followed := make(map[int64]bool)
+	if currentUserID > 0 && currentUserID != userID && len(folders) > 0 {
+		folderIDs := make([]int64, len(folders))
+		for i, folder := range folders {
+			folderIDs[i] = folder.ID
+		}
+		followed, err = s.starFolderRepo.BatchCheckFollowed(ctx, currentUserID, folderIDs)
+		if err != nil {
+			logger.Error("批量检查收藏夹关注状态失败",
+				logger.ErrorField(err),
+				logger.Int64Field("current_user_id", currentUserID))
+			followed = make(map[int64]bool)
+		}
 	}
 
-	if comment.UserID != userID {
+	logger.Info("获取收藏夹列表成功",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("folder_count", len(folders)))
+
+	return folders, followed, nil
+}
+
+// 将已收藏的视频加入或移出收藏夹
+func (s *interactionServiceImpl) StarFolderItemAction(ctx context.Context, userID, folderID, videoID int64, action bool) error {
+	logger.Info("收藏夹视频操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID),
+		logger.Int64Field("video_id", videoID),
+		logger.BoolField("action", action))
+
+	if _, err := s.getOwnFolder(ctx, userID, folderID); err != nil {
+		return err
+	}
+
+	if action {
+		starred, err := s.starRepo.Exists(ctx, userID, videoID)
+		if err != nil {
+			logger.Error("检查收藏状态失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+			return ErrInternalServer
+		}
+		if !starred {
+			return ErrNotStarred
+		}
+
+		added, err := s.starFolderRepo.AddItem(ctx, &model.StarFolderItem{
+			FolderID: folderID,
+			VideoID:  videoID,
+			UserID:   userID,
+		})
+		if err != nil {
+			logger.Error("添加视频到收藏夹失败",
+				logger.ErrorField(err),
+				logger.Int64Field("folder_id", folderID),
+				logger.Int64Field("video_id", videoID))
+			return ErrInteractionFailed
+		}
+		if !added {
+			return ErrAlreadyInFolder
+		}
+	} else {
+		removed, err := s.starFolderRepo.RemoveItem(ctx, folderID, videoID)
+		if err != nil {
+			logger.Error("从收藏夹移除视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("folder_id", folderID),
+				logger.Int64Field("video_id", videoID))
+			return ErrInteractionFailed
+		}
+		if !removed {
+			return ErrNotInFolder
+		}
+	}
+
+	logger.Info("收藏夹视频操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID),
+		logger.Int64Field("video_id", videoID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 将视频从一个收藏夹移动到另一个收藏夹
+func (s *interactionServiceImpl) MoveStarVideo(ctx context.Context, userID, videoID, fromFolderID, toFolderID int64) error {
+	logger.Info("移动收藏视频请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("from_folder_id", fromFolderID),
+		logger.Int64Field("to_folder_id", toFolderID))
+
+	if fromFolderID == toFolderID {
+		return nil
+	}
+	if _, err := s.getOwnFolder(ctx, userID, fromFolderID); err != nil {
+		return err
+	}
+	if _, err := s.getOwnFolder(ctx, userID, toFolderID); err != nil {
+		return err
+	}
+
+	err := s.starFolderRepo.WithTransaction(ctx, func(txRepo dao.StarFolderRepository) error {
+		removed, err := txRepo.RemoveItem(ctx, fromFolderID, videoID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return ErrNotInFolder
+		}
+		_, err = txRepo.AddItem(ctx, &model.StarFolderItem{
+			FolderID: toFolderID,
+			VideoID:  videoID,
+			UserID:   userID,
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotInFolder) {
+			return err
+		}
+		logger.Error("移动收藏视频失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return ErrInteractionFailed
+	}
+
+	logger.Info("移动收藏视频成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("to_folder_id", toFolderID))
+
+	return nil
+}
+
+// 关注或取消关注他人的公开收藏夹
+func (s *interactionServiceImpl) StarFolderFollowAction(ctx context.Context, userID, folderID int64, action bool) error {
+	logger.Info("收藏夹关注操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID),
+		logger.BoolField("action", action))
+
+	if action {
+		folder, err := s.getVisibleFolder(ctx, folderID, userID)
+		if err != nil {
+			return err
+		}
+		if folder.UserID == userID {
+			return ErrCannotFollowOwnFolder
+		}
+
+		followed, err := s.starFolderRepo.Follow(ctx, userID, folderID)
+		if err != nil {
+			logger.Error("关注收藏夹失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("folder_id", folderID))
+			return ErrInteractionFailed
+		}
+		if !followed {
+			return ErrAlreadyFollowedFolder
+		}
+	} else {
+		//收藏夹转为私密后仍允许取消关注
+		unfollowed, err := s.starFolderRepo.Unfollow(ctx, userID, folderID)
+		if err != nil {
+			logger.Error("取消关注收藏夹失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("folder_id", folderID))
+			return ErrInteractionFailed
+		}
+		if !unfollowed {
+			return ErrNotFollowedFolder
+		}
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"type":       "star_folder_follow",
+			"user_id":    userID,
+			"folder_id":  folderID,
+			"action":     action,
+			"created_at": time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", userID), data)
+	}
+
+	logger.Info("收藏夹关注操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("folder_id", folderID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 获取用户关注的收藏夹列表
+func (s *interactionServiceImpl) GetFollowedStarFolders(ctx context.Context, userID int64) ([]*model.StarFolder, error) {
+	logger.Info("获取关注的收藏夹请求",
+		logger.Int64Field("user_id", userID))
+
+	folders, err := s.starFolderRepo.ListFollowed(ctx, userID)
+	if err != nil {
+		logger.Error("获取关注的收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	logger.Info("获取关注的收藏夹成功",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("folder_count", len(folders)))
+
+	return folders, nil
+}
+
+// 获取当前用户自己的收藏夹
+func (s *interactionServiceImpl) getOwnFolder(ctx context.Context, userID, folderID int64) (*model.StarFolder, error) {
+	folder, err := s.starFolderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		logger.Error("查询收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("folder_id", folderID))
+		return nil, ErrInternalServer
+	}
+	if folder == nil || folder.UserID != userID {
+		return nil, ErrStarFolderNotFound
+	}
+	return folder, nil
+}
+
+// 批量获取当前用户自己的收藏夹，任意一个不存在时返回错误
+func (s *interactionServiceImpl) getOwnFolders(ctx context.Context, userID int64, folderIDs []int64) ([]*model.StarFolder, error) {
+	if len(folderIDs) == 0 {
+		return nil, nil
+	}
+
+	unique := make(map[int64]struct{}, len(folderIDs))
+	ids := make([]int64, 0, len(folderIDs))
+	for _, id := range folderIDs {
+		if _, ok := unique[id]; ok {
+			continue
+		}
+		unique[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	folders, err := s.starFolderRepo.ListByIDs(ctx, userID, ids)
+	if err != nil {
+		logger.Error("批量查询收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if len(folders) != len(ids) {
+		return nil, ErrStarFolderNotFound
+	}
+	return folders, nil
+}
+
+// 获取对当前用户可见的收藏夹，私密收藏夹仅所有者可见
+func (s *interactionServiceImpl) getVisibleFolder(ctx context.Context, folderID, currentUserID int64) (*model.StarFolder, error) {
+	folder, err := s.starFolderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		logger.Error("查询收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("folder_id", folderID))
+		return nil, ErrInternalServer
+	}
+	if folder == nil || (!folder.IsPublic && folder.UserID != currentUserID) {
+		return nil, ErrStarFolderNotFound
+	}
+	return folder, nil
+}
+
+// 校验收藏夹名称，返回去除首尾空白后的名称
+func validateFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxFolderNameLength {
+		return "", ErrInvalidFolderName
+	}
+	if textfilter.Check(name).Action != textfilter.ActionPass {
+		return "", ErrSensitiveContent
+	}
+	return name, nil
+}
+
+// 评论操作
+func (s *interactionServiceImpl) CommentAction(ctx context.Context, userID, videoID int64, content string, replyToID int64) (*model.Comment, error) {
+	logger.Info("评论操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("content", content),
+		logger.Int64Field("reply_to_id", replyToID))
+
+	if content == "" {
+		return nil, ErrInvalidCommentContent
+	}
+
+	//敏感词过滤：拒绝的直接返回，打码的保存打码后的内容，需要审核的交给版主审核
+	filtered := textfilter.Check(content)
+	if filtered.Action == textfilter.ActionReject {
+		logger.Warn("评论包含违规信息",
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrSensitiveContent
+	}
+	content = filtered.Text
+
+	authorID, err := s.getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCommentPermission(ctx, userID, videoID, authorID); err != nil {
+		return nil, err
+	}
+
+	//回复只保留两层，回复的回复归入同一个顶层评论
+	var rootID, replyToUserID int64
+	if replyToID > 0 {
+		parent, err := s.commentRepo.FindByID(ctx, replyToID)
+		if err != nil {
+			logger.Error("查询被回复评论失败",
+				logger.ErrorField(err),
+				logger.Int64Field("reply_to_id", replyToID))
+			return nil, ErrInternalServer
+		}
+		if parent == nil || parent.VideoID != videoID || parent.Status != model.CommentStatusPublished {
+			return nil, ErrCommentNotFound
+		}
+		if parent.IsDeleted {
+			return nil, ErrCommentDeleted
+		}
+
+		rootID = parent.ID
+		if parent.RootID > 0 {
+			rootID = parent.RootID
+		}
+		replyToUserID = parent.UserID
+
+		blocked, err := s.socialService.IsBlockedBetween(ctx, userID, replyToUserID)
+		if err != nil {
+			return nil, ErrInternalServer
+		}
+		if blocked {
+			return nil, ErrCommentBlocked
+		}
+	}
+
+	//命中平台敏感词的评论由版主审核；命中作者关键词的评论由作者审核，作者本人的评论不受关键词限制
+	status := model.CommentStatusPublished
+	if filtered.Action == textfilter.ActionReview {
+		status = model.CommentStatusModeration
+	} else if userID != authorID && s.matchCommentKeywords(ctx, authorID, content) {
+		status = model.CommentStatusPending
+	}
+
+	comment := &model.Comment{
+		UserID:        userID,
+		VideoID:       videoID,
+		Content:       content,
+		ReplyToID:     replyToID,
+		RootID:        rootID,
+		ReplyToUserID: replyToUserID,
+		Status:        status,
+		VideoAuthorID: authorID,
+		CreateTime:    time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		logger.Error("创建评论记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInteractionFailed
+	}
+
+	if status == model.CommentStatusPublished {
+		s.onCommentPublished(ctx, comment)
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"comment_id":  comment.ID,
+			"user_id":     userID,
+			"video_id":    videoID,
+			"content":     content,
+			"reply_to_id": replyToID,
+			"root_id":     rootID,
+			"status":      status,
+			"created_at":  time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", comment.ID), data)
+	}
+
+	logger.Info("评论操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("comment_id", comment.ID),
+		logger.BoolField("pending", status != model.CommentStatusPublished))
+
+	return comment, nil
+}
+
+// 获取评论列表
+func (s *interactionServiceImpl) GetCommentList(ctx context.Context, videoID, currentUserID int64, sortType, cursor string, pageSize int, needTotal bool) ([]*model.Comment, string, int64, error) {
+	logger.Info("获取评论列表请求",
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("current_user_id", currentUserID),
+		logger.StringField("sort", sortType),
+		logger.StringField("cursor", cursor),
+		logger.IntField("page_size", pageSize))
+
+	if sortType == "" {
+		sortType = CommentSortNew
+	}
+	if sortType != CommentSortNew && sortType != CommentSortHot {
+		return nil, "", 0, ErrInvalidSortType
+	}
+
+	pageScope := pagination.Scope{List: "video_comments:" + sortType, Owner: videoID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	var comments []*model.Comment
+	var nextCursor string
+	if sortType == CommentSortHot {
+		comments, nextCursor, err = s.listHotComments(ctx, videoID, pageScope, pageCursor, pageSize)
+	} else {
+		comments, err = s.commentRepo.ListByVideoID(ctx, videoID, currentUserID, pageCursor, pageSize+1)
+		if err == nil {
+			comments, nextCursor = pagination.Paginate(comments, pageSize, pageScope, func(c *model.Comment) pagination.Cursor {
+				return pagination.Cursor{SortKey: c.CreatedAt.UnixMicro(), ID: c.ID}
+			})
+		}
+	}
+	if errors.Is(err, pagination.ErrCursorExpired) {
+		return nil, "", 0, err
+	}
+	if err != nil {
+		logger.Error("获取评论列表失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return nil, "", 0, ErrInternalServer
+	}
+
+	comments = s.filterHiddenComments(ctx, currentUserID, comments)
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("video_comments", videoID), func() (int64, error) {
+			return s.commentRepo.CountRootByVideoID(ctx, videoID)
+		})
+		if err != nil {
+			logger.Error("统计评论数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("video_id", videoID))
+			return nil, "", 0, ErrInternalServer
+		}
+	}
+
+	logger.Info("获取评论列表成功",
+		logger.Int64Field("video_id", videoID),
+		logger.IntField("comment_count", len(comments)),
+		logger.BoolField("has_more", nextCursor != ""))
+
+	return comments, nextCursor, total, nil
+}
+
+// 热门评论排序快照，翻页时游标记录快照版本，缓存刷新后已发出的游标仍按原排序继续
+type hotCommentSnapshot struct {
+	Version int64   `json:"version"`
+	IDs     []int64 `json:"ids"`
+}
+
+// 按热门排序获取一页评论。先按快照排序返回候选评论，快照达到候选上限时
+// 其余评论按时间倒序接在后面；游标ID在快照中时继续快照排序，否则为时间顺序的位置
+func (s *interactionServiceImpl) listHotComments(ctx context.Context, videoID int64, pageScope pagination.Scope, cursor *pagination.Cursor, pageSize int) ([]*model.Comment, string, error) {
+	snapshot, err := s.getHotCommentSnapshot(ctx, videoID, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	keyFn := func(c *model.Comment) pagination.Cursor {
+		return pagination.Cursor{SortKey: c.CreatedAt.UnixMicro(), ID: c.ID, Version: snapshot.Version}
+	}
+
+	start := 0
+	inSnapshot := true
+	if cursor != nil {
+		inSnapshot = false
+		for i, id := range snapshot.IDs {
+			if id == cursor.ID {
+				start = i + 1
+				inSnapshot = true
+				break
+			}
+		}
+	}
+	//快照未满说明候选已包含全部评论，没有后续的时间顺序部分
+	hasTail := len(snapshot.IDs) >= hotCandidateLimit
+
+	if !inSnapshot {
+		tail, err := s.commentRepo.ListHotTail(ctx, videoID, snapshot.IDs, cursor, pageSize+1)
+		if err != nil {
+			return nil, "", err
+		}
+		tail, nextCursor := pagination.Paginate(tail, pageSize, pageScope, keyFn)
+		return tail, nextCursor, nil
+	}
+
+	end := start + pageSize
+	if end > len(snapshot.IDs) {
+		end = len(snapshot.IDs)
+	}
+	comments := []*model.Comment{}
+	if start < end {
+		pageIDs := snapshot.IDs[start:end]
+		commentMap, err := s.commentRepo.BatchGetByIDs(ctx, pageIDs)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, id := range pageIDs {
+			if comment, ok := commentMap[id]; ok {
+				comments = append(comments, comment)
+			}
+		}
+	}
+
+	//快照中还有剩余，或快照已翻完且没有后续部分
+	if end < len(snapshot.IDs) {
+		return comments, pagination.Encode(&pagination.Cursor{ID: snapshot.IDs[end-1], Version: snapshot.Version}, pageScope), nil
+	}
+	if !hasTail {
+		return comments, "", nil
+	}
+
+	//快照在本页翻完，用时间顺序的评论补满本页
+	remaining := pageSize - len(comments)
+	tail, err := s.commentRepo.ListHotTail(ctx, videoID, snapshot.IDs, nil, remaining+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if remaining == 0 {
+		if len(tail) == 0 {
+			return comments, "", nil
+		}
+		return comments, pagination.Encode(&pagination.Cursor{ID: snapshot.IDs[end-1], Version: snapshot.Version}, pageScope), nil
+	}
+	tail, nextCursor := pagination.Paginate(tail, remaining, pageScope, keyFn)
+	return append(comments, tail...), nextCursor, nil
+}
+
+// 获取热门评论排序快照。首页读取当前快照，翻页时读取游标记录的版本，
+// 该版本已过期时返回ErrCursorExpired；未启用缓存时每次重新计算
+func (s *interactionServiceImpl) getHotCommentSnapshot(ctx context.Context, videoID int64, cursor *pagination.Cursor) (*hotCommentSnapshot, error) {
+	if s.cache != nil {
+		key := cache.GenerateHotCommentsKey(videoID)
+		if cursor != nil {
+			key = cache.GenerateHotCommentsSnapshotKey(videoID, cursor.Version)
+		}
+		cached, err := s.cache.Get(ctx, key)
+		if err == nil && cached != "" {
+			var snapshot hotCommentSnapshot
+			if err := json.Unmarshal([]byte(cached), &snapshot); err == nil {
+				return &snapshot, nil
+			}
+		}
+		if cursor != nil {
+			return nil, pagination.ErrCursorExpired
+		}
+	}
+
+	candidates, err := s.commentRepo.ListHotCandidates(ctx, videoID, hotCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scores := make(map[int64]float64, len(candidates))
+	for _, c := range candidates {
+		scores[c.ID] = hotScore(c, now)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].ID] > scores[candidates[j].ID]
+	})
+
+	snapshot := &hotCommentSnapshot{
+		Version: now.UnixNano(),
+		IDs:     make([]int64, len(candidates)),
+	}
+	for i, c := range candidates {
+		snapshot.IDs[i] = c.ID
+	}
+
+	if s.cache != nil {
+		if data, err := json.Marshal(snapshot); err == nil {
+			s.cache.Set(ctx, cache.GenerateHotCommentsKey(videoID), string(data), hotCommentsTTL)
+			s.cache.Set(ctx, cache.GenerateHotCommentsSnapshotKey(videoID, snapshot.Version), string(data), hotSnapshotTTL)
+		}
+	}
+	return snapshot, nil
+}
+
+// 清除视频的热门评论排序缓存
+func (s *interactionServiceImpl) invalidateHotComments(ctx context.Context, videoID int64) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Delete(ctx, cache.GenerateHotCommentsKey(videoID)); err != nil {
+		logger.Warn("清除热门评论缓存失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+	}
+}
+
+// 热门分数：以点赞和回复作为互动量计算Wilson下界，再按发布时间衰减
+func hotScore(c *model.Comment, now time.Time) float64 {
+	positive := float64(c.LikeCount)
+	if c.AuthorLiked {
+		positive += 5
+	}
+	total := positive + float64(c.ReplyCount) + 1
+
+	const z = 1.96
+	phat := positive / total
+	wilson := (phat + z*z/(2*total) - z*math.Sqrt((phat*(1-phat)+z*z/(4*total))/total)) / (1 + z*z/total)
+
+	ageHours := now.Sub(c.CreatedAt).Hours()
+	if ageHours < 0 {
+		ageHours = 0
+	}
+	engagement := math.Log10(total + 1)
+	return (wilson + engagement) / math.Pow(ageHours+2, 0.8)
+}
+
+// 获取视频的置顶评论
+func (s *interactionServiceImpl) GetPinnedComment(ctx context.Context, videoID int64) (*model.Comment, error) {
+	comment, err := s.commentRepo.FindPinnedByVideoID(ctx, videoID)
+	if err != nil {
+		logger.Error("获取置顶评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
+	}
+	return comment, nil
+}
+
+// 批量获取顶层评论的回复预览
+func (s *interactionServiceImpl) GetReplyPreviews(ctx context.Context, rootIDs []int64, currentUserID int64) (map[int64][]*model.Comment, error) {
+	previews, err := s.commentRepo.ListReplyPreviews(ctx, rootIDs, currentUserID, replyPreviewSize)
+	if err != nil {
+		logger.Error("获取评论回复预览失败",
+			logger.ErrorField(err),
+			logger.IntField("root_count", len(rootIDs)))
+		return nil, ErrInternalServer
+	}
+
+	hidden := s.hiddenUserSet(ctx, currentUserID)
+	if len(hidden) > 0 {
+		for rootID, replies := range previews {
+			previews[rootID] = filterCommentsByUser(replies, hidden)
+		}
+	}
+	return previews, nil
+}
+
+// 对当前用户隐藏拉黑、静音用户的评论
+func (s *interactionServiceImpl) filterHiddenComments(ctx context.Context, currentUserID int64, comments []*model.Comment) []*model.Comment {
+	hidden := s.hiddenUserSet(ctx, currentUserID)
+	if len(hidden) == 0 {
+		return comments
+	}
+	return filterCommentsByUser(comments, hidden)
+}
+
+// 需要对当前用户隐藏内容的用户，查询失败时不隐藏
+func (s *interactionServiceImpl) hiddenUserSet(ctx context.Context, currentUserID int64) map[int64]bool {
+	if currentUserID <= 0 || s.socialService == nil {
+		return nil
+	}
+
+	userIDs, err := s.socialService.GetHiddenUserIDs(ctx, currentUserID)
+	if err != nil {
+		return nil
+	}
+
+	hidden := make(map[int64]bool, len(userIDs))
+	for _, id := range userIDs {
+		hidden[id] = true
+	}
+	return hidden
+}
+
+func filterCommentsByUser(comments []*model.Comment, hidden map[int64]bool) []*model.Comment {
+	visible := make([]*model.Comment, 0, len(comments))
+	for _, c := range comments {
+		if !hidden[c.UserID] {
+			visible = append(visible, c)
+		}
+	}
+	return visible
+}
+
+// 获取评论回复列表
+func (s *interactionServiceImpl) GetCommentReplies(ctx context.Context, commentID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*model.Comment, string, int64, error) {
+	logger.Info("获取评论回复列表请求",
+		logger.Int64Field("comment_id", commentID),
+		logger.Int64Field("current_user_id", currentUserID),
+		logger.StringField("cursor", cursor),
+		logger.IntField("page_size", pageSize))
+
+	pageScope := pagination.Scope{List: "comment_replies", Owner: commentID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	root, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return nil, "", 0, ErrInternalServer
+	}
+	if root == nil {
+		return nil, "", 0, ErrCommentNotFound
+	}
+	if root.RootID > 0 {
+		root, err = s.commentRepo.FindByID(ctx, root.RootID)
+		if err != nil {
+			logger.Error("查询顶层评论失败",
+				logger.ErrorField(err),
+				logger.Int64Field("comment_id", commentID))
+			return nil, "", 0, ErrInternalServer
+		}
+		if root == nil {
+			return nil, "", 0, ErrCommentNotFound
+		}
+	}
+
+	replies, err := s.commentRepo.ListReplies(ctx, root.ID, currentUserID, pageCursor, pageSize+1)
+	if err != nil {
+		logger.Error("获取评论回复列表失败",
+			logger.ErrorField(err),
+			logger.Int64Field("root_id", root.ID))
+		return nil, "", 0, ErrInternalServer
+	}
+
+	replies, nextCursor := pagination.Paginate(replies, pageSize, pageScope, func(c *model.Comment) pagination.Cursor {
+		return pagination.Cursor{SortKey: c.CreatedAt.UnixMicro(), ID: c.ID}
+	})
+
+	replies = s.filterHiddenComments(ctx, currentUserID, replies)
+
+	var total int64
+	if needTotal {
+		total = root.ReplyCount
+	}
+
+	logger.Info("获取评论回复列表成功",
+		logger.Int64Field("root_id", root.ID),
+		logger.IntField("reply_count", len(replies)),
+		logger.BoolField("has_more", nextCursor != ""))
+
+	return replies, nextCursor, total, nil
+}
+
+// 回复被删除后更新顶层评论，墓碑评论没有回复时一并清理
+func (s *interactionServiceImpl) releaseReply(ctx context.Context, rootID int64) {
+	if err := s.commentRepo.UpdateReplyCount(ctx, rootID, -1); err != nil {
+		logger.Error("减少评论回复数失败",
+			logger.ErrorField(err),
+			logger.Int64Field("root_id", rootID))
+		return
+	}
+
+	root, err := s.commentRepo.FindByID(ctx, rootID)
+	if err != nil || root == nil {
+		return
+	}
+	if root.IsDeleted && root.ReplyCount == 0 {
+		if _, err := s.commentRepo.Delete(ctx, rootID, 0, 0); err != nil {
+			logger.Error("清理墓碑评论失败",
+				logger.ErrorField(err),
+				logger.Int64Field("root_id", rootID))
+		}
+	}
+}
+
+// 删除评论
+func (s *interactionServiceImpl) DeleteComment(ctx context.Context, userID, videoID, commentID int64) error {
+	logger.Info("删除评论请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("comment_id", commentID))
+
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInternalServer
+	}
+
+	if comment == nil {
+		return ErrCommentNotFound
+	}
+
+	//评论不属于请求中的视频时按不存在处理
+	if comment.IsDeleted || (videoID > 0 && comment.VideoID != videoID) {
+		return ErrCommentNotFound
+	}
+
+	//版主和管理员可以删除任何评论
+	if comment.UserID != userID && !rbac.Allowed(ctx, rbac.PermCommentDeleteAny) {
 		return ErrNotCommentOwner
 	}
 
-	if err := s.commentRepo.Delete(ctx, commentID, userID, videoID); err != nil {
-		logger.Error("删除评论失败",
+	//有回复的顶层评论只做墓碑标记，避免回复失去归属
+	tombstone := comment.RootID == 0 && comment.ReplyCount > 0
+	var deleted bool
+	if tombstone {
+		deleted, err = s.commentRepo.MarkDeleted(ctx, commentID)
+	} else {
+		deleted, err = s.commentRepo.Delete(ctx, commentID, comment.UserID, comment.VideoID)
+	}
+	if err != nil {
+		logger.Error("删除评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", comment.VideoID))
+		return ErrInteractionFailed
+	}
+	//并发的另一次删除已经完成，计数只调整一次
+	if !deleted {
+		return ErrCommentNotFound
+	}
+
+	//待审核评论未计入统计
+	if comment.Status == model.CommentStatusPublished {
+		if err := s.statsRepo.DecrementCommentCount(ctx, comment.VideoID); err != nil {
+			logger.Error("减少视频评论数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("video_id", comment.VideoID))
+		}
+
+		if comment.RootID > 0 {
+			s.releaseReply(ctx, comment.RootID)
+		}
+		s.invalidateHotComments(ctx, comment.VideoID)
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"comment_id": commentID,
+			"user_id":    comment.UserID,
+			"deleted_by": userID,
+			"video_id":   comment.VideoID,
+			"tombstone":  tombstone,
+			"deleted_at": time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", commentID), data)
+	}
+
+	logger.Info("删除评论成功",
+		logger.Int64Field("comment_id", commentID),
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", comment.VideoID))
+
+	return nil
+}
+
+// 评论点赞操作
+func (s *interactionServiceImpl) CommentLikeAction(ctx context.Context, userID, commentID int64, action bool) error {
+	logger.Info("评论点赞操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("action", action))
+
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInternalServer
+	}
+	if comment == nil || comment.IsDeleted || comment.Status != model.CommentStatusPublished {
+		return ErrCommentNotFound
+	}
+
+	exists, err := s.commentLikeRepo.Exists(ctx, userID, commentID)
+	if err != nil {
+		logger.Error("检查评论点赞状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInternalServer
+	}
+
+	var delta int64 = 1
+	if action {
+		if exists {
+			return ErrAlreadyLiked
+		}
+
+		like := &model.CommentLike{
+			UserID:    userID,
+			CommentID: commentID,
+			VideoID:   comment.VideoID,
+		}
+		if err := s.commentLikeRepo.Create(ctx, like); err != nil {
+			logger.Error("创建评论点赞记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("comment_id", commentID))
+			return ErrInteractionFailed
+		}
+	} else {
+		if !exists {
+			return ErrNotLiked
+		}
+
+		if err := s.commentLikeRepo.Delete(ctx, userID, commentID); err != nil {
+			logger.Error("删除评论点赞记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("comment_id", commentID))
+			return ErrInteractionFailed
+		}
+		delta = -1
+	}
+
+	if err := s.commentRepo.UpdateLikeCount(ctx, commentID, delta); err != nil {
+		logger.Error("更新评论点赞数失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+	}
+
+	//视频作者点赞的评论带有作者赞过标记
+	authorID, err := s.getVideoAuthorID(ctx, comment.VideoID)
+	if err == nil && authorID == userID {
+		if err := s.commentRepo.UpdateAuthorLiked(ctx, commentID, action); err != nil {
+			logger.Error("更新作者点赞标记失败",
+				logger.ErrorField(err),
+				logger.Int64Field("comment_id", commentID))
+		}
+	}
+
+	if comment.RootID == 0 {
+		s.invalidateHotComments(ctx, comment.VideoID)
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"user_id":    userID,
+			"comment_id": commentID,
+			"video_id":   comment.VideoID,
+			"action":     action,
+			"created_at": time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", commentID), data)
+	}
+
+	logger.Info("评论点赞操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 批量检查评论点赞状态
+func (s *interactionServiceImpl) BatchCheckCommentLiked(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error) {
+	if userID <= 0 || len(commentIDs) == 0 {
+		return make(map[int64]bool), nil
+	}
+
+	liked, err := s.commentLikeRepo.BatchCheckLiked(ctx, userID, commentIDs)
+	if err != nil {
+		logger.Error("批量检查评论点赞状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return liked, nil
+}
+
+// 置顶评论，每个视频只能由作者置顶一条顶层评论
+func (s *interactionServiceImpl) PinComment(ctx context.Context, userID, videoID, commentID int64, action bool) error {
+	logger.Info("置顶评论请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("action", action))
+
+	authorID, err := s.getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if authorID != userID {
+		return ErrNotVideoAuthor
+	}
+
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInternalServer
+	}
+	if comment == nil || comment.VideoID != videoID || comment.RootID != 0 || comment.IsDeleted || comment.Status != model.CommentStatusPublished {
+		return ErrCommentNotFound
+	}
+
+	if err := s.commentRepo.UpdatePinned(ctx, videoID, commentID, action); err != nil {
+		logger.Error("更新置顶评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInteractionFailed
+	}
+
+	s.invalidateHotComments(ctx, videoID)
+
+	logger.Info("置顶评论成功",
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 获取视频作者ID
+func (s *interactionServiceImpl) getVideoAuthorID(ctx context.Context, videoID int64) (int64, error) {
+	videos, err := s.videoService.BatchGetVideosByIDs(ctx, []int64{videoID}, 0)
+	if err != nil {
+		return 0, ErrInternalServer
+	}
+	video, ok := videos[videoID]
+	if !ok {
+		return 0, ErrVideoNotFound
+	}
+	return video.AuthorID, nil
+}
+
+// 评论发布后更新视频评论数和顶层评论回复数
+func (s *interactionServiceImpl) onCommentPublished(ctx context.Context, comment *model.Comment) {
+	if err := s.statsRepo.IncrementCommentCount(ctx, comment.VideoID); err != nil {
+		logger.Error("增加视频评论数失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", comment.VideoID))
+	}
+
+	if comment.RootID > 0 {
+		if err := s.commentRepo.UpdateReplyCount(ctx, comment.RootID, 1); err != nil {
+			logger.Error("增加评论回复数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("root_id", comment.RootID))
+		}
+	}
+	s.invalidateHotComments(ctx, comment.VideoID)
+	s.publishActivity(ctx, comment.UserID, mq.ActivityComment, fmt.Sprintf("%d", comment.ID))
+}
+
+// 发送可获得经验值的用户行为事件，发送失败只记录日志
+func (s *interactionServiceImpl) publishActivity(ctx context.Context, userID int64, source, refID string) {
+	if s.kafkaProducer == nil {
+		return
+	}
+	if err := s.kafkaProducer.SendUserActivityEvent(ctx, userID, source, refID); err != nil {
+		logger.Warn("发送用户行为事件失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("source", source))
+	}
+}
+
+// 视频作者收到新的点赞或回应时获得经验值，同一用户对同一视频每天只计算一次，给自己点赞不计算
+func (s *interactionServiceImpl) publishLikeReceived(ctx context.Context, userID, videoID int64) {
+	if s.kafkaProducer == nil || s.videoService == nil {
+		return
+	}
+	videos, err := s.videoService.BatchGetVideosByIDs(ctx, []int64{videoID}, userID)
+	if err != nil {
+		logger.Warn("查询视频作者失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return
+	}
+	video, ok := videos[videoID]
+	if !ok || video.AuthorID == userID {
+		return
+	}
+	s.publishActivity(ctx, video.AuthorID, mq.ActivityLikeReceived, fmt.Sprintf("%d:%d", videoID, userID))
+}
+
+// 检查用户是否有权限评论视频，视频作者始终可以评论
+func (s *interactionServiceImpl) checkCommentPermission(ctx context.Context, userID, videoID, authorID int64) error {
+	if userID == authorID {
+		return nil
+	}
+
+	blocked, err := s.socialService.IsBlockedBetween(ctx, userID, authorID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if blocked {
+		return ErrCommentBlocked
+	}
+
+	permission, err := s.GetCommentPermission(ctx, videoID)
+	if err != nil {
+		return err
+	}
+
+	switch permission {
+	case model.CommentPermissionOff:
+		return ErrCommentsDisabled
+	case model.CommentPermissionFollowers:
+		following, err := s.socialService.CheckFollow(ctx, userID, authorID)
+		if err != nil {
+			return ErrInternalServer
+		}
+		if !following {
+			return ErrCommentNotAllowed
+		}
+	case model.CommentPermissionFriends:
+		mutual, err := s.socialService.CheckMutualFollow(ctx, userID, authorID)
+		if err != nil {
+			return ErrInternalServer
+		}
+		if !mutual {
+			return ErrCommentNotAllowed
+		}
+	}
+	return nil
+}
+
+// 检查评论内容是否命中作者设置的关键词
+func (s *interactionServiceImpl) matchCommentKeywords(ctx context.Context, authorID int64, content string) bool {
+	keywords, err := s.commentKeywordRepo.ListByUserID(ctx, authorID)
+	if err != nil {
+		logger.Error("获取评论关键词失败",
+			logger.ErrorField(err),
+			logger.Int64Field("author_id", authorID))
+		return false
+	}
+
+	lowerContent := strings.ToLower(content)
+	for _, k := range keywords {
+		if strings.Contains(lowerContent, k.Keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidCommentPermission(permission string) bool {
+	switch permission {
+	case model.CommentPermissionEveryone, model.CommentPermissionFollowers,
+		model.CommentPermissionFriends, model.CommentPermissionOff:
+		return true
+	}
+	return false
+}
+
+// 设置视频评论权限
+func (s *interactionServiceImpl) SetCommentPermission(ctx context.Context, userID, videoID int64, permission string) error {
+	logger.Info("设置评论权限请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("permission", permission))
+
+	if !isValidCommentPermission(permission) {
+		return ErrInvalidPermission
+	}
+
+	authorID, err := s.getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if authorID != userID {
+		return ErrNotVideoAuthor
+	}
+
+	setting := &model.CommentSetting{
+		VideoID:    videoID,
+		AuthorID:   authorID,
+		Permission: permission,
+	}
+	if err := s.commentSettingRepo.Upsert(ctx, setting); err != nil {
+		logger.Error("保存评论权限失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return ErrInteractionFailed
+	}
+
+	logger.Info("设置评论权限成功",
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("permission", permission))
+
+	return nil
+}
+
+// 获取视频评论权限，视频未单独设置时使用作者在用户设置中的默认评论权限
+func (s *interactionServiceImpl) GetCommentPermission(ctx context.Context, videoID int64) (string, error) {
+	setting, err := s.commentSettingRepo.FindByVideoID(ctx, videoID)
+	if err != nil {
+		logger.Error("获取评论权限失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return "", ErrInternalServer
+	}
+	if setting != nil {
+		return setting.Permission, nil
+	}
+
+	if s.settings == nil {
+		return model.CommentPermissionEveryone, nil
+	}
+	authorID, err := s.getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return "", err
+	}
+	authorSettings, err := s.settings.Get(ctx, authorID)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+	//用户设置中的nobody对应视频评论权限的关闭
+	if authorSettings.CommentPermission == userModel.SettingAudienceNobody {
+		return model.CommentPermissionOff, nil
+	}
+	return authorSettings.CommentPermission, nil
+}
+
+// 添加或删除评论关键词
+func (s *interactionServiceImpl) CommentKeywordAction(ctx context.Context, userID int64, keyword string, action bool) error {
+	logger.Info("评论关键词操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("keyword", keyword),
+		logger.BoolField("action", action))
+
+	//关键词统一小写存储，匹配时不区分大小写
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	if keyword == "" || utf8.RuneCountInString(keyword) > maxKeywordLength {
+		return ErrInvalidKeyword
+	}
+
+	exists, err := s.commentKeywordRepo.Exists(ctx, userID, keyword)
+	if err != nil {
+		logger.Error("检查评论关键词失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if action {
+		if exists {
+			return ErrKeywordExists
+		}
+
+		keywords, err := s.commentKeywordRepo.ListByUserID(ctx, userID)
+		if err != nil {
+			logger.Error("获取评论关键词失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(keywords) >= maxCommentKeywords {
+			return ErrTooManyKeywords
+		}
+
+		if err := s.commentKeywordRepo.Create(ctx, &model.CommentKeyword{UserID: userID, Keyword: keyword}); err != nil {
+			logger.Error("添加评论关键词失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInteractionFailed
+		}
+	} else {
+		if !exists {
+			return ErrKeywordNotFound
+		}
+
+		if err := s.commentKeywordRepo.Delete(ctx, userID, keyword); err != nil {
+			logger.Error("删除评论关键词失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInteractionFailed
+		}
+	}
+
+	logger.Info("评论关键词操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 获取作者的评论关键词列表
+func (s *interactionServiceImpl) GetCommentKeywords(ctx context.Context, userID int64) ([]string, error) {
+	keywords, err := s.commentKeywordRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("获取评论关键词失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	result := make([]string, len(keywords))
+	for i, k := range keywords {
+		result[i] = k.Keyword
+	}
+	return result, nil
+}
+
+// 获取作者视频下待审核的评论
+func (s *interactionServiceImpl) GetPendingComments(ctx context.Context, userID int64, cursor string, pageSize int) ([]*model.Comment, string, error) {
+	logger.Info("获取待审核评论请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("cursor", cursor),
+		logger.IntField("page_size", pageSize))
+
+	pageScope := pagination.Scope{List: "pending_comments", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	comments, err := s.commentRepo.ListPendingByAuthor(ctx, userID, pageCursor, pageSize+1)
+	if err != nil {
+		logger.Error("获取待审核评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, "", ErrInternalServer
+	}
+
+	comments, nextCursor := pagination.Paginate(comments, pageSize, pageScope, func(c *model.Comment) pagination.Cursor {
+		return pagination.Cursor{SortKey: c.CreatedAt.UnixMicro(), ID: c.ID}
+	})
+
+	return comments, nextCursor, nil
+}
+
+// 获取命中平台敏感词、等待版主审核的评论，需要comment.review权限
+func (s *interactionServiceImpl) GetModerationComments(ctx context.Context, cursor string, pageSize int) ([]*model.Comment, string, error) {
+	if err := rbac.Require(ctx, rbac.PermCommentReview); err != nil {
+		return nil, "", err
+	}
+
+	pageScope := pagination.Scope{List: "moderation_comments"}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	comments, err := s.commentRepo.ListModeration(ctx, pageCursor, pageSize+1)
+	if err != nil {
+		logger.Error("获取待平台审核评论失败",
+			logger.ErrorField(err))
+		return nil, "", ErrInternalServer
+	}
+
+	comments, nextCursor := pagination.Paginate(comments, pageSize, pageScope, func(c *model.Comment) pagination.Cursor {
+		return pagination.Cursor{SortKey: c.CreatedAt.UnixMicro(), ID: c.ID}
+	})
+
+	return comments, nextCursor, nil
+}
+
+// 审核待审核评论，通过后正常展示，拒绝则删除。命中作者关键词的评论由视频作者或拥有comment.delete.any权限的版主审核，
+// 命中平台敏感词的评论只能由拥有comment.review权限的版主审核
+func (s *interactionServiceImpl) ReviewComment(ctx context.Context, userID, commentID int64, approve bool) error {
+	logger.Info("审核评论请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("approve", approve))
+
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInternalServer
+	}
+	if comment == nil {
+		return ErrCommentNotFound
+	}
+	switch comment.Status {
+	case model.CommentStatusPending:
+		if comment.VideoAuthorID != userID && !rbac.Allowed(ctx, rbac.PermCommentDeleteAny) {
+			return ErrNotVideoAuthor
+		}
+	case model.CommentStatusModeration:
+		if err := rbac.Require(ctx, rbac.PermCommentReview); err != nil {
+			return err
+		}
+	default:
+		return ErrCommentNotPending
+	}
+
+	var reviewed bool
+	if approve {
+		reviewed, err = s.commentRepo.UpdateStatus(ctx, commentID, comment.Status, model.CommentStatusPublished)
+	} else {
+		reviewed, err = s.commentRepo.DeleteByStatus(ctx, commentID, comment.Status)
+	}
+	if err != nil {
+		logger.Error("审核评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("comment_id", commentID))
+		return ErrInteractionFailed
+	}
+	//并发的另一次审核已经处理了这条评论
+	if !reviewed {
+		return ErrCommentNotPending
+	}
+
+	if approve {
+		s.onCommentPublished(ctx, comment)
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"comment_id":  commentID,
+			"user_id":     comment.UserID,
+			"video_id":    comment.VideoID,
+			"approve":     approve,
+			"reviewed_at": time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", commentID), data)
+	}
+
+	logger.Info("审核评论成功",
+		logger.Int64Field("comment_id", commentID),
+		logger.BoolField("approve", approve))
+
+	return nil
+}
+
+// 分享操作
+func (s *interactionServiceImpl) ShareAction(ctx context.Context, userID, videoID int64, channel string) error {
+	logger.Info("分享操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("channel", channel))
+
+	if channel != "" && !model.IsValidShareChannel(channel) {
+		return ErrInvalidShareChannel
+	}
+
+	share := &model.Share{
+		UserID:  userID,
+		VideoID: videoID,
+		Channel: channel,
+	}
+
+	if err := s.shareRepo.Create(ctx, share); err != nil {
+		logger.Error("创建分享记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return ErrInteractionFailed
+	}
+
+	if err := s.statsRepo.IncrementShareCount(ctx, videoID); err != nil {
+		logger.Error("增加视频分享数失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"share_id":  share.ID,
+			"user_id":   userID,
+			"video_id":  videoID,
+			"channel":   channel,
+			"shared_at": time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", share.ID), data)
+	}
+
+	logger.Info("分享操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("share_id", share.ID))
+
+	return nil
+}
+
+// 生成分享短链，同一用户对同一视频在同一渠道复用已有短码，每次生成都计一次分享
+func (s *interactionServiceImpl) CreateShareLink(ctx context.Context, userID, videoID int64, channel string) (*model.ShareLink, error) {
+	logger.Info("生成分享链接请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("channel", channel))
+
+	if !model.IsValidShareChannel(channel) {
+		return nil, ErrInvalidShareChannel
+	}
+
+	if _, err := s.getVideoAuthorID(ctx, videoID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.shareLinkRepo.FindByOwner(ctx, userID, videoID, channel)
+	if err != nil {
+		logger.Error("查询分享链接失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
+	}
+
+	if link == nil {
+		link, err = s.createShareLink(ctx, userID, videoID, channel)
+		if err != nil {
+			logger.Error("创建分享链接失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+			return nil, ErrInteractionFailed
+		}
+	}
+
+	if err := s.ShareAction(ctx, userID, videoID, channel); err != nil {
+		return nil, err
+	}
+
+	logger.Info("生成分享链接成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID),
+		logger.StringField("code", link.Code))
+
+	return link, nil
+}
+
+// 解析短码并记录一次点击
+func (s *interactionServiceImpl) ResolveShareLink(ctx context.Context, code string, visitorID int64) (*model.ShareLink, error) {
+	link, err := s.findShareLink(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.shareLinkRepo.RecordEvent(ctx, link, visitorID, model.ShareEventClick); err != nil {
+		logger.Error("记录分享点击失败",
+			logger.ErrorField(err),
+			logger.StringField("code", code))
+	}
+
+	return link, nil
+}
+
+// 记录分享带来的观看或安装，登录用户对同一链接只计一次，安装同时记录邀请归因
+func (s *interactionServiceImpl) RecordShareConversion(ctx context.Context, code string, visitorID int64, event string) error {
+	logger.Info("记录分享转化请求",
+		logger.StringField("code", code),
+		logger.Int64Field("visitor_id", visitorID),
+		logger.StringField("event", event))
+
+	if event != model.ShareEventView && event != model.ShareEventInstall {
+		return ErrInvalidShareEvent
+	}
+	if event == model.ShareEventInstall && visitorID <= 0 {
+		return ErrInvalidShareEvent
+	}
+
+	link, err := s.findShareLink(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if visitorID > 0 {
+		if visitorID == link.UserID {
+			if event == model.ShareEventInstall {
+				return ErrSelfAttribution
+			}
+			return nil
+		}
+
+		exists, err := s.shareLinkRepo.ExistsEvent(ctx, link.ID, visitorID, event)
+		if err != nil {
+			logger.Error("检查分享转化记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("link_id", link.ID))
+			return ErrInternalServer
+		}
+		if exists {
+			return nil
+		}
+	}
+
+	if event == model.ShareEventInstall {
+		created, err := s.shareLinkRepo.CreateAttribution(ctx, &model.ShareAttribution{
+			UserID:    visitorID,
+			InviterID: link.UserID,
+			LinkID:    link.ID,
+			VideoID:   link.VideoID,
+			Channel:   link.Channel,
+		})
+		if err != nil {
+			logger.Error("创建邀请归因失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", visitorID))
+			return ErrInteractionFailed
+		}
+		if !created {
+			return ErrAlreadyAttributed
+		}
+	}
+
+	if err := s.shareLinkRepo.RecordEvent(ctx, link, visitorID, event); err != nil {
+		logger.Error("记录分享转化失败",
 			logger.ErrorField(err),
-			logger.Int64Field("comment_id", commentID),
-			logger.Int64Field("user_id", userID),
-			logger.Int64Field("video_id", videoID))
+			logger.Int64Field("link_id", link.ID))
 		return ErrInteractionFailed
 	}
 
 	if s.kafkaProducer != nil {
 		eventData := map[string]interface{}{
-			"comment_id": commentID,
-			"user_id":    userID,
-			"video_id":   videoID,
-			"deleted_at": time.Now(),
+			"type":       "share_conversion",
+			"event":      event,
+			"link_id":    link.ID,
+			"sharer_id":  link.UserID,
+			"visitor_id": visitorID,
+			"video_id":   link.VideoID,
+			"channel":    link.Channel,
+			"created_at": time.Now(),
 		}
 		data, _ := json.Marshal(eventData)
-		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", commentID), data)
+		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", link.ID), data)
 	}
 
-	logger.Info("删除评论成功",
-		logger.Int64Field("comment_id", commentID),
-		logger.Int64Field("user_id", userID),
-		logger.Int64Field("video_id", videoID))
+	logger.Info("记录分享转化成功",
+		logger.Int64Field("link_id", link.ID),
+		logger.Int64Field("visitor_id", visitorID),
+		logger.StringField("event", event))
 
 	return nil
 }
 
-// 分享操作
-func (s *interactionServiceImpl) ShareAction(ctx context.Context, userID, videoID int64) error {
-	logger.Info("分享操作请求",
+// 获取视频按渠道的分享数据，仅视频作者可查看
+func (s *interactionServiceImpl) GetShareStats(ctx context.Context, userID, videoID int64) ([]*model.ShareChannelStats, error) {
+	logger.Info("获取分享统计请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID))
 
-	share := &model.Share{
-		UserID:  userID,
-		VideoID: videoID,
+	authorID, err := s.getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if authorID != userID {
+		return nil, ErrNotVideoAuthor
 	}
 
-	if err := s.shareRepo.Create(ctx, share); err != nil {
-		logger.Error("创建分享记录失败",
+	shareCounts, err := s.shareRepo.CountByChannel(ctx, videoID)
+	if err != nil {
+		logger.Error("统计渠道分享数失败",
 			logger.ErrorField(err),
-			logger.Int64Field("user_id", userID),
 			logger.Int64Field("video_id", videoID))
-		return ErrInteractionFailed
+		return nil, ErrInternalServer
 	}
 
-	if err := s.statsRepo.IncrementShareCount(ctx, videoID); err != nil {
-		logger.Error("增加视频分享数失败",
+	linkStats, err := s.shareLinkRepo.SumByChannel(ctx, videoID)
+	if err != nil {
+		logger.Error("统计渠道转化数据失败",
 			logger.ErrorField(err),
 			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
 	}
 
-	if s.kafkaProducer != nil {
-		eventData := map[string]interface{}{
-			"share_id":  share.ID,
-			"user_id":   userID,
-			"video_id":  videoID,
-			"shared_at": time.Now(),
+	statsMap := make(map[string]*model.ShareChannelStats, len(model.ShareChannels))
+	for _, stat := range linkStats {
+		statsMap[stat.Channel] = stat
+	}
+
+	//固定返回所有渠道，未经短链的历史分享不计入
+	stats := make([]*model.ShareChannelStats, 0, len(model.ShareChannels))
+	for _, channel := range model.ShareChannels {
+		stat, ok := statsMap[channel]
+		if !ok {
+			stat = &model.ShareChannelStats{Channel: channel}
 		}
-		data, _ := json.Marshal(eventData)
-		s.kafkaProducer.SendInteractionEvent(ctx, fmt.Sprintf("%d", share.ID), data)
+		stat.ShareCount = shareCounts[channel]
+		stats = append(stats, stat)
 	}
 
-	logger.Info("分享操作成功",
-		logger.Int64Field("user_id", userID),
-		logger.Int64Field("video_id", videoID),
-		logger.Int64Field("share_id", share.ID))
+	return stats, nil
+}
 
-	return nil
+// 获取用户的邀请归因，没有时返回nil
+func (s *interactionServiceImpl) GetInvitedBy(ctx context.Context, userID int64) (*model.ShareAttribution, error) {
+	attribution, err := s.shareLinkRepo.FindAttribution(ctx, userID)
+	if err != nil {
+		logger.Error("查询邀请归因失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return attribution, nil
+}
+
+func (s *interactionServiceImpl) findShareLink(ctx context.Context, code string) (*model.ShareLink, error) {
+	if code == "" || len(code) > shareCodeLength {
+		return nil, ErrShareLinkNotFound
+	}
+
+	link, err := s.shareLinkRepo.FindByCode(ctx, code)
+	if err != nil {
+		logger.Error("查询分享链接失败",
+			logger.ErrorField(err),
+			logger.StringField("code", code))
+		return nil, ErrInternalServer
+	}
+	if link == nil {
+		return nil, ErrShareLinkNotFound
+	}
+	return link, nil
+}
+
+// 创建短链，短码冲突时重新生成
+func (s *interactionServiceImpl) createShareLink(ctx context.Context, userID, videoID int64, channel string) (*model.ShareLink, error) {
+	var err error
+	for i := 0; i < shareCodeRetries; i++ {
+		var code string
+		code, err = generateShareCode()
+		if err != nil {
+			return nil, err
+		}
+
+		link := &model.ShareLink{
+			Code:    code,
+			UserID:  userID,
+			VideoID: videoID,
+			Channel: channel,
+		}
+		if err = s.shareLinkRepo.Create(ctx, link); err == nil {
+			return link, nil
+		}
+
+		//并发生成时可能已由其他请求创建
+		existing, findErr := s.shareLinkRepo.FindByOwner(ctx, userID, videoID, channel)
+		if findErr == nil && existing != nil {
+			return existing, nil
+		}
+	}
+	return nil, err
+}
+
+func generateShareCode() (string, error) {
+	buf := make([]byte, shareCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = shareCodeAlphabet[int(b)%len(shareCodeAlphabet)]
+	}
+	return string(buf), nil
 }
 
 // 获取互动统计
@@ -537,7 +2746,7 @@ func (s *interactionServiceImpl) CheckLikeStatus(ctx context.Context, userID, vi
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID))
 
-	exists, err := s.likeRepo.Exists(ctx, userID, videoID)
+	liked, err := s.checkLiked(ctx, userID, []int64{videoID})
 	if err != nil {
 		logger.Error("检查点赞状态失败",
 			logger.ErrorField(err),
@@ -545,6 +2754,7 @@ func (s *interactionServiceImpl) CheckLikeStatus(ctx context.Context, userID, vi
 			logger.Int64Field("video_id", videoID))
 		return false, ErrInternalServer
 	}
+	exists := liked[videoID]
 
 	logger.Info("检查点赞状态成功",
 		logger.Int64Field("user_id", userID),
@@ -554,13 +2764,224 @@ func (s *interactionServiceImpl) CheckLikeStatus(ctx context.Context, userID, vi
 	return exists, nil
 }
 
+// 批量获取当前用户对视频的点赞、收藏和关注作者状态，点赞和收藏优先从Redis集合读取
+func (s *interactionServiceImpl) BatchCheckInteractionStatus(ctx context.Context, userID int64, videoIDs []int64) (map[int64]*model.InteractionStatus, error) {
+	result := make(map[int64]*model.InteractionStatus, len(videoIDs))
+	for _, videoID := range videoIDs {
+		result[videoID] = &model.InteractionStatus{}
+	}
+	if userID <= 0 || len(videoIDs) == 0 {
+		return result, nil
+	}
+
+	liked, err := s.checkLiked(ctx, userID, videoIDs)
+	if err != nil {
+		logger.Error("批量检查点赞状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	starred, err := s.checkStarred(ctx, userID, videoIDs)
+	if err != nil {
+		logger.Error("批量检查收藏状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	//只为已点赞的视频查询回应类型
+	likedIDs := make([]int64, 0, len(liked))
+	for videoID, ok := range liked {
+		if ok {
+			likedIDs = append(likedIDs, videoID)
+		}
+	}
+	reactions := make(map[int64]string)
+	if len(likedIDs) > 0 {
+		reactions, err = s.likeRepo.BatchGetReactions(ctx, userID, likedIDs)
+		if err != nil {
+			logger.Error("批量获取回应失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			reactions = make(map[int64]string)
+		}
+	}
+
+	for videoID, status := range result {
+		status.IsLiked = liked[videoID]
+		status.IsStarred = starred[videoID]
+		if status.IsLiked {
+			status.Reaction = reactions[videoID]
+			if status.Reaction == "" {
+				status.Reaction = model.ReactionLike
+			}
+		}
+	}
+
+	s.fillFollowingAuthor(ctx, userID, videoIDs, result)
+
+	return result, nil
+}
+
+// 填充是否关注视频作者，失败时保持默认值
+func (s *interactionServiceImpl) fillFollowingAuthor(ctx context.Context, userID int64, videoIDs []int64, result map[int64]*model.InteractionStatus) {
+	if s.videoService == nil || s.socialService == nil {
+		return
+	}
+
+	videos, err := s.videoService.BatchGetVideosByIDs(ctx, videoIDs, 0)
+	if err != nil {
+		logger.Error("批量获取视频作者失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return
+	}
+
+	authorIDs := make([]int64, 0, len(videos))
+	seen := make(map[int64]bool, len(videos))
+	for _, video := range videos {
+		if video.AuthorID != userID && !seen[video.AuthorID] {
+			seen[video.AuthorID] = true
+			authorIDs = append(authorIDs, video.AuthorID)
+		}
+	}
+	if len(authorIDs) == 0 {
+		return
+	}
+
+	following, err := s.socialService.BatchCheckFollow(ctx, userID, authorIDs)
+	if err != nil {
+		logger.Error("批量检查关注状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return
+	}
+
+	for videoID, video := range videos {
+		if status, ok := result[videoID]; ok {
+			status.IsFollowingAuthor = following[video.AuthorID]
+		}
+	}
+}
+
+func (s *interactionServiceImpl) checkLiked(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	return s.checkStatusSet(ctx, cache.GenerateUserLikedKey(userID), videoIDs,
+		func() ([]int64, error) {
+			return s.likeRepo.ListVideoIDsByUserID(ctx, userID)
+		},
+		func() (map[int64]bool, error) {
+			reactions, err := s.likeRepo.BatchGetReactions(ctx, userID, videoIDs)
+			if err != nil {
+				return nil, err
+			}
+			liked := make(map[int64]bool, len(reactions))
+			for videoID := range reactions {
+				liked[videoID] = true
+			}
+			return liked, nil
+		})
+}
+
+func (s *interactionServiceImpl) checkStarred(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	return s.checkStatusSet(ctx, cache.GenerateUserStarredKey(userID), videoIDs,
+		func() ([]int64, error) {
+			return s.starRepo.ListVideoIDsByUserID(ctx, userID)
+		},
+		func() (map[int64]bool, error) {
+			return s.starRepo.BatchExists(ctx, userID, videoIDs)
+		})
+}
+
+// 从用户的视频集合中检查成员，集合未预热时用load从数据库加载全部视频ID写入，缓存不可用时用query直接查询
+func (s *interactionServiceImpl) checkStatusSet(ctx context.Context, key string, videoIDs []int64, load func() ([]int64, error), query func() (map[int64]bool, error)) (map[int64]bool, error) {
+	if s.cache == nil {
+		return query()
+	}
+
+	members := make([]interface{}, 0, len(videoIDs)+1)
+	members = append(members, statusCacheSentinel)
+	for _, videoID := range videoIDs {
+		members = append(members, videoID)
+	}
+
+	flags, err := s.cache.SMIsMember(ctx, key, members...)
+	if err != nil {
+		logger.Warn("读取互动状态缓存失败",
+			logger.ErrorField(err),
+			logger.StringField("key", key))
+		return query()
+	}
+
+	result := make(map[int64]bool, len(videoIDs))
+	if len(flags) == len(members) && flags[0] {
+		for i, videoID := range videoIDs {
+			result[videoID] = flags[i+1]
+		}
+		return result, nil
+	}
+
+	//集合未预热，从数据库加载
+	allIDs, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	warm := make([]interface{}, 0, len(allIDs)+1)
+	warm = append(warm, statusCacheSentinel)
+	all := make(map[int64]bool, len(allIDs))
+	for _, videoID := range allIDs {
+		warm = append(warm, videoID)
+		all[videoID] = true
+	}
+	if err := s.cache.SAdd(ctx, key, warm...); err != nil {
+		logger.Warn("预热互动状态缓存失败",
+			logger.ErrorField(err),
+			logger.StringField("key", key))
+	} else if err := s.cache.Expire(ctx, key, statusCacheTTL); err != nil {
+		logger.Warn("设置互动状态缓存过期时间失败",
+			logger.ErrorField(err),
+			logger.StringField("key", key))
+	}
+
+	for _, videoID := range videoIDs {
+		result[videoID] = all[videoID]
+	}
+	return result, nil
+}
+
+// 点赞或收藏变化后同步已预热的集合，未预热的集合等下次读取时从数据库加载
+func (s *interactionServiceImpl) updateStatusSet(ctx context.Context, key string, videoID int64, add bool) {
+	if s.cache == nil {
+		return
+	}
+
+	warmed, err := s.cache.SIsMember(ctx, key, statusCacheSentinel)
+	if err != nil || !warmed {
+		return
+	}
+
+	if add {
+		err = s.cache.SAdd(ctx, key, videoID)
+	} else {
+		err = s.cache.SRem(ctx, key, videoID)
+	}
+	if err != nil {
+		//写入失败时删除集合，避免返回过期状态
+		logger.Warn("更新互动状态缓存失败",
+			logger.ErrorField(err),
+			logger.StringField("key", key))
+		s.cache.Delete(ctx, key)
+	}
+}
+
 // 检查收藏状态
 func (s *interactionServiceImpl) CheckStarStatus(ctx context.Context, userID, videoID int64) (bool, error) {
 	logger.Info("检查收藏状态请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("video_id", videoID))
 
-	exists, err := s.starRepo.Exists(ctx, userID, videoID)
+	starred, err := s.checkStarred(ctx, userID, []int64{videoID})
 	if err != nil {
 		logger.Error("检查收藏状态失败",
 			logger.ErrorField(err),
@@ -568,6 +2989,7 @@ func (s *interactionServiceImpl) CheckStarStatus(ctx context.Context, userID, vi
 			logger.Int64Field("video_id", videoID))
 		return false, ErrInternalServer
 	}
+	exists := starred[videoID]
 
 	logger.Info("检查收藏状态成功",
 		logger.Int64Field("user_id", userID),
@@ -582,6 +3004,7 @@ func (s *interactionServiceImpl) WithTransaction(ctx context.Context, fn func(tx
 	return s.likeRepo.WithTransaction(ctx, func(txLikeRepo dao.LikeRepository) error {
 		var txStarRepo dao.StarRepository
 		var txCommentRepo dao.CommentRepository
+		var txCommentLikeRepo dao.CommentLikeRepository
 		var txShareRepo dao.ShareRepository
 		var txStatsRepo dao.VideoInteractionStatsRepository
 
@@ -601,6 +3024,14 @@ func (s *interactionServiceImpl) WithTransaction(ctx context.Context, fn func(tx
 			return err
 		}
 
+		err = s.commentLikeRepo.WithTransaction(ctx, func(repo dao.CommentLikeRepository) error {
+			txCommentLikeRepo = repo
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
 		err = s.shareRepo.WithTransaction(ctx, func(repo dao.ShareRepository) error {
 			txShareRepo = repo
 			return nil
@@ -618,15 +3049,137 @@ func (s *interactionServiceImpl) WithTransaction(ctx context.Context, fn func(tx
 		}
 
 		txService := &interactionServiceImpl{
-			likeRepo:      txLikeRepo,
-			starRepo:      txStarRepo,
-			commentRepo:   txCommentRepo,
-			shareRepo:     txShareRepo,
-			statsRepo:     txStatsRepo,
-			videoService:  s.videoService,
-			kafkaProducer: s.kafkaProducer,
+			likeRepo:           txLikeRepo,
+			starRepo:           txStarRepo,
+			starFolderRepo:     s.starFolderRepo,
+			commentRepo:        txCommentRepo,
+			commentLikeRepo:    txCommentLikeRepo,
+			commentSettingRepo: s.commentSettingRepo,
+			commentKeywordRepo: s.commentKeywordRepo,
+			shareRepo:          txShareRepo,
+			shareLinkRepo:      s.shareLinkRepo,
+			statsRepo:          txStatsRepo,
+			videoService:       s.videoService,
+			socialService:      s.socialService,
+			settings:           s.settings,
+			kafkaProducer:      s.kafkaProducer,
+			cache:              s.cache,
 		}
 
 		return fn(txService)
 	})
 }
+
+// 账号注销后清理用户的互动数据：取消点赞、收藏和评论点赞，删除评论、收藏夹和评论关键词，
+// 复用各操作的逻辑以同步更新计数和缓存；已处理的记录会被跳过，可重复执行
+func (s *interactionServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的互动数据", logger.Int64Field("user_id", userID))
+
+	likedVideoIDs, err := s.likeRepo.ListVideoIDsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询点赞记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, videoID := range likedVideoIDs {
+		if err := s.ReactAction(ctx, userID, videoID, ""); err != nil && err != ErrNotLiked {
+			return err
+		}
+	}
+
+	starredVideoIDs, err := s.starRepo.ListVideoIDsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询收藏记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, videoID := range starredVideoIDs {
+		if err := s.StarAction(ctx, userID, videoID, false, nil); err != nil && err != ErrNotStarred {
+			return err
+		}
+	}
+
+	folders, err := s.starFolderRepo.ListByUserID(ctx, userID, false)
+	if err != nil {
+		logger.Error("查询收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, folder := range folders {
+		if err := s.DeleteStarFolder(ctx, userID, folder.ID); err != nil && err != ErrStarFolderNotFound {
+			return err
+		}
+	}
+
+	followedFolders, err := s.starFolderRepo.ListFollowed(ctx, userID)
+	if err != nil {
+		logger.Error("查询关注的收藏夹失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, folder := range followedFolders {
+		if err := s.StarFolderFollowAction(ctx, userID, folder.ID, false); err != nil && err != ErrNotFollowedFolder && err != ErrStarFolderNotFound {
+			return err
+		}
+	}
+
+	likedCommentIDs, err := s.commentLikeRepo.ListCommentIDsByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询评论点赞记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, commentID := range likedCommentIDs {
+		if err := s.CommentLikeAction(ctx, userID, commentID, false); err != nil && err != ErrNotLiked && err != ErrCommentNotFound {
+			return err
+		}
+	}
+
+	//有回复的评论保留为墓碑，内容被清空
+	comments, err := s.commentRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询评论失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, comment := range comments {
+		if err := s.DeleteComment(ctx, userID, comment.VideoID, comment.ID); err != nil && err != ErrCommentNotFound {
+			return err
+		}
+	}
+
+	keywords, err := s.commentKeywordRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询评论关键词失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, keyword := range keywords {
+		if err := s.CommentKeywordAction(ctx, userID, keyword.Keyword, false); err != nil && err != ErrKeywordNotFound {
+			return err
+		}
+	}
+
+	//分享记录直接删除，视频的分享数作为历史统计保留
+	if err := s.shareRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除分享记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if err := s.shareLinkRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除分享短链和邀请归因失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	return nil
+}
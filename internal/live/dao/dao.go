@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"shortvideo/internal/live/model"
+	"shortvideo/pkg/pagination"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -14,7 +16,8 @@ type LiveRoomRepository interface {
 	FindByID(ctx context.Context, id int64) (*model.LiveRoom, error)
 	FindByHostID(ctx context.Context, hostID int64) (*model.LiveRoom, error)
 	Delete(ctx context.Context, id int64, hostID int64) error
-	ListLiveRooms(ctx context.Context, page, pageSize int, followingOnly bool, userID int64) ([]*model.LiveRoom, int64, error)
+	ListLiveRooms(ctx context.Context, cursor *pagination.Cursor, limit int, followingOnly bool, userID int64) ([]*model.LiveRoom, error)
+	CountLiveRooms(ctx context.Context, followingOnly bool, userID int64) (int64, error)
 	UpdateViewerCount(ctx context.Context, roomID int64, delta int64) error
 	UpdateLiveStatus(ctx context.Context, roomID int64, isLive bool) error
 	UpdateStreamURLs(ctx context.Context, roomID int64, rtmpURL, hlsURL string) error
@@ -37,6 +40,7 @@ type GiftRecordRepository interface {
 	ListBySenderID(ctx context.Context, senderID int64, page, pageSize int) ([]*model.GiftRecord, int64, error)
 	GetTotalGiftValueByRoom(ctx context.Context, roomID int64) (int64, error)
 	GetTotalGiftValueBySender(ctx context.Context, senderID int64) (int64, error)
+	AnonymizeSender(ctx context.Context, senderID int64) (int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo GiftRecordRepository) error) error
 }
 
@@ -66,6 +70,7 @@ type RoomViewerRepository interface {
 	CountByRoomID(ctx context.Context, roomID int64) (int64, error)
 	UpdateLeaveTime(ctx context.Context, roomID, userID int64, leaveTime string) error
 	Delete(ctx context.Context, roomID, userID int64) error
+	DeleteByUserID(ctx context.Context, userID int64) error
 	WithTransaction(ctx context.Context, fn func(txRepo RoomViewerRepository) error) error
 }
 
@@ -109,27 +114,42 @@ func (r *liveRoomRepositoryImpl) Delete(ctx context.Context, id int64, hostID in
 		Delete(&model.LiveRoom{}).Error
 }
 
-func (r *liveRoomRepositoryImpl) ListLiveRooms(ctx context.Context, page, pageSize int, followingOnly bool, userID int64) ([]*model.LiveRoom, int64, error) {
+func (r *liveRoomRepositoryImpl) ListLiveRooms(ctx context.Context, cursor *pagination.Cursor, limit int, followingOnly bool, userID int64) ([]*model.LiveRoom, error) {
 	var rooms []*model.LiveRoom
-	var total int64
-	offset := (page - 1) * pageSize
 
 	query := r.db.WithContext(ctx).Model(&model.LiveRoom{})
 
-	if followingOnly && userID > 0 {
+	//只看关注的主播，游客没有关注所以结果为空
+	if followingOnly {
+		query = query.Where("host_id IN (SELECT target_user_id FROM follows WHERE user_id = ?)", userID)
 	}
 
 	query = query.Where("is_live = ?", true)
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	//按开播时间翻页，观众数随时变化不能作为游标
+	if cursor != nil {
+		query = query.Where("(started_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := query.Offset(offset).Limit(pageSize).
-		Order("viewer_count DESC").
+	err := query.Order("started_at DESC, id DESC").
+		Limit(limit).
 		Find(&rooms).Error
 
-	return rooms, total, err
+	return rooms, err
+}
+
+func (r *liveRoomRepositoryImpl) CountLiveRooms(ctx context.Context, followingOnly bool, userID int64) (int64, error) {
+	var count int64
+
+	query := r.db.WithContext(ctx).Model(&model.LiveRoom{})
+
+	//只看关注的主播，游客没有关注所以结果为空
+	if followingOnly {
+		query = query.Where("host_id IN (SELECT target_user_id FROM follows WHERE user_id = ?)", userID)
+	}
+
+	err := query.Where("is_live = ?", true).Count(&count).Error
+	return count, err
 }
 
 func (r *liveRoomRepositoryImpl) UpdateViewerCount(ctx context.Context, roomID int64, delta int64) error {
@@ -138,10 +158,16 @@ func (r *liveRoomRepositoryImpl) UpdateViewerCount(ctx context.Context, roomID i
 		UpdateColumn("viewer_count", gorm.Expr("viewer_count + ?", delta)).Error
 }
 
+// 开播时同时记录开播时间
 func (r *liveRoomRepositoryImpl) UpdateLiveStatus(ctx context.Context, roomID int64, isLive bool) error {
+	updates := map[string]interface{}{"is_live": isLive}
+	if isLive {
+		updates["started_at"] = time.Now()
+	}
+
 	return r.db.WithContext(ctx).Model(&model.LiveRoom{}).
 		Where("id = ?", roomID).
-		Update("is_live", isLive).Error
+		Updates(updates).Error
 }
 
 func (r *liveRoomRepositoryImpl) UpdateStreamURLs(ctx context.Context, roomID int64, rtmpURL, hlsURL string) error {
@@ -284,6 +310,14 @@ func (r *giftRecordRepositoryImpl) GetTotalGiftValueBySender(ctx context.Context
 	return totalValue, err
 }
 
+// 送礼记录涉及直播间的收入统计，不删除，只把发送者置为0
+func (r *giftRecordRepositoryImpl) AnonymizeSender(ctx context.Context, senderID int64) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&model.GiftRecord{}).
+		Where("sender_id = ?", senderID).
+		Update("sender_id", 0)
+	return result.RowsAffected, result.Error
+}
+
 func (r *giftRecordRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo GiftRecordRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &giftRecordRepositoryImpl{db: tx}
@@ -453,6 +487,12 @@ func (r *roomViewerRepositoryImpl) Delete(ctx context.Context, roomID, userID in
 		Delete(&model.RoomViewer{}).Error
 }
 
+func (r *roomViewerRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.RoomViewer{}).Error
+}
+
 func (r *roomViewerRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo RoomViewerRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &roomViewerRepositoryImpl{db: tx}
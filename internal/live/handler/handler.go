@@ -3,19 +3,26 @@ package handler
 import (
 	"context"
 	"shortvideo/internal/live/service"
+	socialrpc "shortvideo/internal/social/rpcclient"
 	"shortvideo/kitex_gen/common"
 	live "shortvideo/kitex_gen/live"
+	"shortvideo/kitex_gen/social/socialservice"
 	"shortvideo/pkg/logger"
 )
 
+const errAudienceRestricted = "该直播间仅对主播指定的名单可见"
+
 // LiveServiceImpl implements the last service interface defined in the IDL.
 type LiveServiceImpl struct {
-	liveService service.LiveService
+	liveService  service.LiveService
+	socialClient socialservice.Client
 }
 
-func NewLiveService(liveService service.LiveService) *LiveServiceImpl {
+// socialClient 用于校验和检查直播间的可见名单
+func NewLiveService(liveService service.LiveService, socialClient socialservice.Client) *LiveServiceImpl {
 	return &LiveServiceImpl{
-		liveService: liveService,
+		liveService:  liveService,
+		socialClient: socialClient,
 	}
 }
 
@@ -34,7 +41,14 @@ func (s *LiveServiceImpl) CreateLiveRoom(ctx context.Context, req *live.CreateLi
 		Room: nil,
 	}
 
-	room, err := s.liveService.CreateLiveRoom(ctx, req.HostId, req.Title, req.CoverUrl)
+	if err := socialrpc.ValidateAudienceList(ctx, s.socialClient, req.HostId, req.GetAudienceListId()); err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	room, err := s.liveService.CreateLiveRoom(ctx, req.HostId, req.Title, req.CoverUrl, req.GetAudienceListId())
 	if err != nil {
 		logger.Error("CreateLiveRoom failed", logger.ErrorField(err))
 		errorMsg := err.Error()
@@ -108,7 +122,7 @@ func (s *LiveServiceImpl) StopLive(ctx context.Context, req *live.StopLiveReq) (
 func (s *LiveServiceImpl) GetLiveRooms(ctx context.Context, req *live.GetLiveRoomsReq) (resp *live.GetLiveRoomsResp, err error) {
 	logger.Info("GetLiveRooms request",
 		logger.Int64Field("user_id", req.UserId),
-		logger.IntField("page", int(req.Page)),
+		logger.StringField("cursor", req.GetCursor()),
 		logger.IntField("page_size", int(req.PageSize)))
 
 	successMsg := "成功"
@@ -121,7 +135,7 @@ func (s *LiveServiceImpl) GetLiveRooms(ctx context.Context, req *live.GetLiveRoo
 		TotalCount: 0,
 	}
 
-	rooms, total, err := s.liveService.GetLiveRooms(ctx, req.UserId, int(req.Page), int(req.PageSize), req.GetFollowingOnly())
+	rooms, nextCursor, total, err := s.liveService.GetLiveRooms(ctx, req.UserId, req.GetCursor(), int(req.PageSize), req.GetFollowingOnly(), req.GetNeedTotal())
 	if err != nil {
 		logger.Error("GetLiveRooms failed", logger.ErrorField(err))
 		errorMsg := err.Error()
@@ -135,10 +149,14 @@ func (s *LiveServiceImpl) GetLiveRooms(ctx context.Context, req *live.GetLiveRoo
 		commonRooms[i] = service.ConvertToCommonLiveRoom(room)
 	}
 
-	resp.Rooms = commonRooms
+	resp.Rooms = socialrpc.FilterAudienceRooms(ctx, s.socialClient, req.UserId, commonRooms)
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 
-	logger.Info("GetLiveRooms success", logger.IntField("room_count", len(rooms)), logger.Int64Field("total", total))
+	logger.Info("GetLiveRooms success", logger.IntField("room_count", len(rooms)), logger.BoolField("has_more", resp.HasMore))
 	return resp, nil
 }
 
@@ -167,6 +185,13 @@ func (s *LiveServiceImpl) GetLiveRoomDetail(ctx context.Context, req *live.GetLi
 		return resp, nil
 	}
 
+	if !socialrpc.CanViewAudience(ctx, s.socialClient, req.UserId, room.HostID, room.AudienceListID) {
+		errorMsg := errAudienceRestricted
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
 	commonRoom := service.ConvertToCommonLiveRoom(room)
 	resp.Room = commonRoom
 	resp.OnlineCount = onlineCount
@@ -191,6 +216,21 @@ func (s *LiveServiceImpl) JoinLiveRoom(ctx context.Context, req *live.JoinLiveRo
 		ChatHistory: []string{},
 	}
 
+	room, _, err := s.liveService.GetLiveRoomDetail(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		logger.Error("JoinLiveRoom failed", logger.ErrorField(err))
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+	if !socialrpc.CanViewAudience(ctx, s.socialClient, req.UserId, room.HostID, room.AudienceListID) {
+		errorMsg := errAudienceRestricted
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
 	hlsURL, chatHistory, err := s.liveService.JoinLiveRoom(ctx, req.RoomId, req.UserId)
 	if err != nil {
 		logger.Error("JoinLiveRoom failed", logger.ErrorField(err))
@@ -5,17 +5,19 @@ import (
 )
 
 type LiveRoom struct {
-	ID          int64     `gorm:"primaryKey;autoIncrement;comment:直播间ID"`
-	HostID      int64     `gorm:"index;not null;comment:主播ID"`
-	Title       string    `gorm:"size:200;not null;comment:标题"`
-	CoverURL    string    `gorm:"size:500;comment:封面地址"`
-	RtmpURL     string    `gorm:"size:500;comment:RTMP地址"`
-	HlsURL      string    `gorm:"size:500;comment:HLS地址"`
-	ViewerCount int64     `gorm:"default:0;comment:观众数"`
-	IsLive      bool      `gorm:"default:false;comment:是否在直播"`
-	CreateTime  string    `gorm:"size:50;not null;comment:创建时间"`
-	CreatedAt   time.Time `gorm:"autoCreateTime;comment:创建时间"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+	ID             int64     `gorm:"primaryKey;autoIncrement;comment:直播间ID"`
+	HostID         int64     `gorm:"index;not null;comment:主播ID"`
+	Title          string    `gorm:"size:200;not null;comment:标题"`
+	CoverURL       string    `gorm:"size:500;comment:封面地址"`
+	RtmpURL        string    `gorm:"size:500;comment:RTMP地址"`
+	HlsURL         string    `gorm:"size:500;comment:HLS地址"`
+	ViewerCount    int64     `gorm:"default:0;comment:观众数"`
+	IsLive         bool      `gorm:"default:false;comment:是否在直播"`
+	StartedAt      time.Time `gorm:"index;comment:最近一次开播时间"`
+	AudienceListID int64     `gorm:"index;default:0;comment:可见范围名单ID(0为公开)"`
+	CreateTime     string    `gorm:"size:50;not null;comment:创建时间"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
 
 func (LiveRoom) TableName() string {
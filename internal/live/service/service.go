@@ -8,8 +8,11 @@ import (
 	"shortvideo/internal/live/model"
 	"shortvideo/kitex_gen/common"
 	"shortvideo/kitex_gen/live"
+	"shortvideo/pkg/cache"
 	"shortvideo/pkg/es"
 	"shortvideo/pkg/logger"
+	"shortvideo/pkg/pagination"
+	"shortvideo/pkg/rbac"
 	"time"
 )
 
@@ -26,10 +29,10 @@ var (
 
 type LiveService interface {
 	// 直播间相关
-	CreateLiveRoom(ctx context.Context, hostID int64, title, coverURL string) (*model.LiveRoom, error)
+	CreateLiveRoom(ctx context.Context, hostID int64, title, coverURL string, audienceListID int64) (*model.LiveRoom, error)
 	StartLive(ctx context.Context, hostID, roomID int64, rtmpURL string) error
 	StopLive(ctx context.Context, hostID, roomID int64) error
-	GetLiveRooms(ctx context.Context, userID int64, page, pageSize int, followingOnly bool) ([]*model.LiveRoom, int64, error)
+	GetLiveRooms(ctx context.Context, userID int64, cursor string, pageSize int, followingOnly, needTotal bool) ([]*model.LiveRoom, string, int64, error)
 	GetLiveRoomDetail(ctx context.Context, roomID, userID int64) (*model.LiveRoom, int64, error)
 	JoinLiveRoom(ctx context.Context, roomID, userID int64) (string, []string, error)
 	LeaveLiveRoom(ctx context.Context, roomID, userID int64) error
@@ -40,6 +43,8 @@ type LiveService interface {
 	SetRoomAdmin(ctx context.Context, hostID, roomID, targetUserID int64, action bool) error
 	//录制相关
 	RecordLive(ctx context.Context, hostID, roomID int64, action bool) (string, error)
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
 	//事务相关
 	WithTransaction(ctx context.Context, fn func(txService LiveService) error) error
 }
@@ -52,6 +57,7 @@ type liveServiceImpl struct {
 	liveRecordRepo dao.LiveRecordRepository
 	roomViewerRepo dao.RoomViewerRepository
 	es             *es.ESManager
+	cache          cache.Cache
 }
 
 func NewLiveService(
@@ -62,6 +68,7 @@ func NewLiveService(
 	liveRecordRepo dao.LiveRecordRepository,
 	roomViewerRepo dao.RoomViewerRepository,
 	es *es.ESManager,
+	cache cache.Cache,
 ) LiveService {
 	return &liveServiceImpl{
 		roomRepo:       roomRepo,
@@ -71,6 +78,7 @@ func NewLiveService(
 		liveRecordRepo: liveRecordRepo,
 		roomViewerRepo: roomViewerRepo,
 		es:             es,
+		cache:          cache,
 	}
 }
 
@@ -94,7 +102,7 @@ func NewLiveServiceWithRepo(
 }
 
 // 创建直播间
-func (s *liveServiceImpl) CreateLiveRoom(ctx context.Context, hostID int64, title, coverURL string) (*model.LiveRoom, error) {
+func (s *liveServiceImpl) CreateLiveRoom(ctx context.Context, hostID int64, title, coverURL string, audienceListID int64) (*model.LiveRoom, error) {
 	logger.Info("创建直播间请求",
 		logger.Int64Field("host_id", hostID),
 		logger.StringField("title", title))
@@ -119,12 +127,13 @@ func (s *liveServiceImpl) CreateLiveRoom(ctx context.Context, hostID int64, titl
 	}
 
 	room := &model.LiveRoom{
-		HostID:      hostID,
-		Title:       title,
-		CoverURL:    coverURL,
-		ViewerCount: 0,
-		IsLive:      false,
-		CreateTime:  time.Now().Format("2006-01-02 15:04:05"),
+		HostID:         hostID,
+		Title:          title,
+		CoverURL:       coverURL,
+		ViewerCount:    0,
+		IsLive:         false,
+		AudienceListID: audienceListID,
+		CreateTime:     time.Now().Format("2006-01-02 15:04:05"),
 	}
 
 	if err := s.roomRepo.Create(ctx, room); err != nil {
@@ -261,7 +270,8 @@ func (s *liveServiceImpl) StopLive(ctx context.Context, hostID, roomID int64) er
 		return ErrRoomNotFound
 	}
 
-	if room.HostID != hostID {
+	//版主和管理员可以关闭任何直播
+	if room.HostID != hostID && !rbac.Allowed(ctx, rbac.PermLiveStopAny) {
 		logger.Warn("不是直播间房主",
 			logger.Int64Field("host_id", hostID),
 			logger.Int64Field("room_id", roomID),
@@ -318,35 +328,61 @@ func (s *liveServiceImpl) StopLive(ctx context.Context, hostID, roomID int64) er
 }
 
 // 获取直播列表
-func (s *liveServiceImpl) GetLiveRooms(ctx context.Context, userID int64, page, pageSize int, followingOnly bool) ([]*model.LiveRoom, int64, error) {
+func (s *liveServiceImpl) GetLiveRooms(ctx context.Context, userID int64, cursor string, pageSize int, followingOnly, needTotal bool) ([]*model.LiveRoom, string, int64, error) {
 	logger.Info("获取直播列表请求",
 		logger.Int64Field("user_id", userID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize),
 		logger.BoolField("following_only", followingOnly))
 
-	if page < 1 {
-		page = 1
+	pageScope := pagination.Scope{List: "live_rooms"}
+	if followingOnly {
+		pageScope = pagination.Scope{List: "live_rooms:following", Owner: userID}
+	}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
 	}
 	if pageSize < 1 || pageSize > 50 {
 		pageSize = 10
 	}
 
-	rooms, total, err := s.roomRepo.ListLiveRooms(ctx, page, pageSize, followingOnly, userID)
+	rooms, err := s.roomRepo.ListLiveRooms(ctx, pageCursor, pageSize+1, followingOnly, userID)
 	if err != nil {
 		logger.Error("获取直播列表失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	rooms, nextCursor := pagination.Paginate(rooms, pageSize, pageScope, func(room *model.LiveRoom) pagination.Cursor {
+		return pagination.Cursor{SortKey: room.StartedAt.UnixMicro(), ID: room.ID}
+	})
+
+	var total int64
+	if needTotal {
+		scope := "live_rooms"
+		if followingOnly {
+			scope = "live_rooms:following"
+		}
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey(scope, userID), func() (int64, error) {
+			return s.roomRepo.CountLiveRooms(ctx, followingOnly, userID)
+		})
+		if err != nil {
+			logger.Error("统计直播间数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	logger.Info("获取直播列表成功",
 		logger.Int64Field("user_id", userID),
-		logger.IntField("page", page),
-		logger.IntField("page_size", pageSize),
-		logger.Int64Field("total", total))
+		logger.IntField("room_count", len(rooms)),
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return rooms, total, nil
+	return rooms, nextCursor, total, nil
 }
 
 // 获取直播间详情
@@ -697,6 +733,8 @@ func (s *liveServiceImpl) WithTransaction(ctx context.Context, fn func(txService
 			roomAdminRepo:  s.roomAdminRepo,
 			liveRecordRepo: s.liveRecordRepo,
 			roomViewerRepo: s.roomViewerRepo,
+			es:             s.es,
+			cache:          s.cache,
 		}
 		return fn(txService)
 	})
@@ -707,7 +745,7 @@ func ConvertToCommonLiveRoom(room *model.LiveRoom) *common.LiveRoom {
 		return nil
 	}
 
-	return &common.LiveRoom{
+	commonRoom := &common.LiveRoom{
 		Id:          room.ID,
 		HostId:      room.HostID,
 		Title:       room.Title,
@@ -718,6 +756,10 @@ func ConvertToCommonLiveRoom(room *model.LiveRoom) *common.LiveRoom {
 		IsLive:      room.IsLive,
 		CreateTime:  room.CreateTime,
 	}
+	if room.AudienceListID != 0 {
+		commonRoom.AudienceListId = &room.AudienceListID
+	}
+	return commonRoom
 }
 
 func ConvertToLiveGift(gift *model.Gift) *live.Gift {
@@ -733,3 +775,123 @@ func ConvertToLiveGift(gift *model.Gift) *live.Gift {
 		AnimationUrl: gift.AnimationURL,
 	}
 }
+
+// 账号注销后删除用户的直播间及其录制和管理员、用户的管理员身份和观看记录，
+// 送礼记录保留用于直播间收入统计，但不再关联到该用户；可重复执行
+func (s *liveServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的直播数据", logger.Int64Field("user_id", userID))
+
+	for {
+		room, err := s.roomRepo.FindByHostID(ctx, userID)
+		if err != nil {
+			logger.Error("查询用户直播间失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if room == nil {
+			break
+		}
+		if err := s.deleteRoom(ctx, room); err != nil {
+			return err
+		}
+	}
+
+	admins, err := s.roomAdminRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户管理的直播间失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, admin := range admins {
+		if err := s.roomAdminRepo.Delete(ctx, admin.RoomID, userID); err != nil {
+			logger.Error("删除直播间管理员失败",
+				logger.ErrorField(err),
+				logger.Int64Field("room_id", admin.RoomID),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+	}
+
+	if err := s.roomViewerRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除用户观看记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	giftCount, err := s.giftRecordRepo.AnonymizeSender(ctx, userID)
+	if err != nil {
+		logger.Error("匿名化送礼记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	logger.Info("清理注销用户的直播数据完成",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("gift_record_count", giftCount))
+	return nil
+}
+
+// 删除直播间，正在直播时先停止直播
+func (s *liveServiceImpl) deleteRoom(ctx context.Context, room *model.LiveRoom) error {
+	if room.IsLive {
+		if err := s.StopLive(ctx, room.HostID, room.ID); err != nil && err != ErrRoomNotLive {
+			return err
+		}
+	}
+
+	records, err := s.liveRecordRepo.FindByRoomID(ctx, room.ID)
+	if err != nil {
+		logger.Error("查询直播录制失败",
+			logger.ErrorField(err),
+			logger.Int64Field("room_id", room.ID))
+		return ErrInternalServer
+	}
+	for _, record := range records {
+		if err := s.liveRecordRepo.Delete(ctx, record.ID); err != nil {
+			logger.Error("删除直播录制失败",
+				logger.ErrorField(err),
+				logger.Int64Field("record_id", record.ID))
+			return ErrInternalServer
+		}
+	}
+
+	admins, err := s.roomAdminRepo.ListByRoomID(ctx, room.ID)
+	if err != nil {
+		logger.Error("查询直播间管理员失败",
+			logger.ErrorField(err),
+			logger.Int64Field("room_id", room.ID))
+		return ErrInternalServer
+	}
+	for _, admin := range admins {
+		if err := s.roomAdminRepo.Delete(ctx, room.ID, admin.UserID); err != nil {
+			logger.Error("删除直播间管理员失败",
+				logger.ErrorField(err),
+				logger.Int64Field("room_id", room.ID))
+			return ErrInternalServer
+		}
+	}
+
+	if err := s.roomRepo.Delete(ctx, room.ID, room.HostID); err != nil {
+		logger.Error("删除直播间失败",
+			logger.ErrorField(err),
+			logger.Int64Field("room_id", room.ID))
+		return ErrInternalServer
+	}
+
+	if s.es != nil {
+		if err := s.es.DeleteDocument("lives", fmt.Sprintf("%d", room.ID)); err != nil {
+			logger.Error("从ES删除直播间失败",
+				logger.ErrorField(err),
+				logger.Int64Field("room_id", room.ID))
+		}
+	}
+
+	logger.Info("删除直播间成功",
+		logger.Int64Field("room_id", room.ID),
+		logger.Int64Field("host_id", room.HostID))
+	return nil
+}
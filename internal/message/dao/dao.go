@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"shortvideo/internal/message/model"
+	"shortvideo/pkg/pagination"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -13,23 +15,28 @@ type MessageRepository interface {
 	Create(ctx context.Context, message *model.Message) error
 	FindByID(ctx context.Context, id int64) (*model.Message, error)
 	Delete(ctx context.Context, messageID, userID int64) error
-	GetChatHistory(ctx context.Context, userID1, userID2 int64, lastMessageID int64, pageSize int) ([]*model.Message, int64, error)
+	GetChatHistory(ctx context.Context, userID1, userID2 int64, cursor *pagination.Cursor, limit int) ([]*model.Message, error)
 	GetLatestMessages(ctx context.Context, userID int64, limit int) ([]*model.Message, error)
 	MarkMessageRead(ctx context.Context, userID, messageID int64) error
 	MarkMessagesRead(ctx context.Context, userID, sendID int64) error
 	GetUnreadCount(ctx context.Context, userID int64) (int64, error)
 	GetUnreadCountBySender(ctx context.Context, userID, sendID int64) (int64, error)
+	GetPendingMessages(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*model.Message, error)
+	UpdateStatus(ctx context.Context, messageID int64, from, to int32) (bool, error)
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo MessageRepository) error) error
 }
 
 type NotificationRepository interface {
 	Create(ctx context.Context, notification *model.SystemNotification) error
 	FindByID(ctx context.Context, id int64) (*model.SystemNotification, error)
-	GetNotifications(ctx context.Context, userID int64, page, pageSize int, notificationType *int32) ([]*model.SystemNotification, int64, error)
+	GetNotifications(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int, notificationType *int32) ([]*model.SystemNotification, error)
+	CountNotifications(ctx context.Context, userID int64, notificationType *int32) (int64, error)
 	MarkNotificationRead(ctx context.Context, userID, notificationID int64) error
 	MarkAllNotificationsRead(ctx context.Context, userID int64) error
 	GetUnreadNotificationCount(ctx context.Context, userID int64) (int64, error)
 	Delete(ctx context.Context, notificationID, userID int64) error
+	DeleteByUserID(ctx context.Context, userID int64) (int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo NotificationRepository) error) error
 }
 
@@ -60,33 +67,46 @@ func (r *messageRepositoryImpl) Delete(ctx context.Context, messageID, userID in
 		Delete(&model.Message{}).Error
 }
 
-func (r *messageRepositoryImpl) GetChatHistory(ctx context.Context, userID1, userID2 int64, lastMessageID int64, pageSize int) ([]*model.Message, int64, error) {
+// 待审核的私信只对发送者可见
+func visibleTo(userID int64) (string, []interface{}) {
+	return "(status = ? OR send_id = ?)", []interface{}{model.MessageStatusDelivered, userID}
+}
+
+// 删除用户发出和收到的全部消息，返回删除的条数
+func (r *messageRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("send_id = ? OR receive_id = ?", userID, userID).
+		Delete(&model.Message{})
+	return result.RowsAffected, result.Error
+}
+
+// 两个用户之间的消息，按ID倒序
+func (r *messageRepositoryImpl) GetChatHistory(ctx context.Context, userID1, userID2 int64, cursor *pagination.Cursor, limit int) ([]*model.Message, error) {
 	var messages []*model.Message
-	var total int64
 
 	query := r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("(send_id = ? AND receive_id = ?) OR (send_id = ? AND receive_id = ?)",
 			userID1, userID2, userID2, userID1)
+	visible, args := visibleTo(userID1)
+	query = query.Where(visible, args...)
 
-	if lastMessageID > 0 {
-		query = query.Where("id < ?", lastMessageID)
+	if cursor != nil {
+		query = query.Where("id < ?", cursor.ID)
 	}
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	err := query.Order("id DESC").Limit(limit).Find(&messages).Error
 
-	err := query.Order("id DESC").Limit(pageSize).Find(&messages).Error
-
-	return messages, total, err
+	return messages, err
 }
 
 func (r *messageRepositoryImpl) GetLatestMessages(ctx context.Context, userID int64, limit int) ([]*model.Message, error) {
 	var messages []*model.Message
 
+	visible, args := visibleTo(userID)
 	subQuery := r.db.WithContext(ctx).Model(&model.Message{}).
 		Select("MAX(id) as max_id").
 		Where("receive_id = ? OR send_id = ?", userID, userID).
+		Where(visible, args...).
 		Group(fmt.Sprintf("CASE WHEN send_id = %d THEN receive_id ELSE send_id END", userID))
 
 	err := r.db.WithContext(ctx).Where("id IN (?)", subQuery).
@@ -99,20 +119,21 @@ func (r *messageRepositoryImpl) GetLatestMessages(ctx context.Context, userID in
 
 func (r *messageRepositoryImpl) MarkMessageRead(ctx context.Context, userID, messageID int64) error {
 	return r.db.WithContext(ctx).Model(&model.Message{}).
-		Where("id = ? AND receive_id = ?", messageID, userID).
+		Where("id = ? AND receive_id = ? AND status = ?", messageID, userID, model.MessageStatusDelivered).
 		Update("is_read", true).Error
 }
 
 func (r *messageRepositoryImpl) MarkMessagesRead(ctx context.Context, userID, sendID int64) error {
 	return r.db.WithContext(ctx).Model(&model.Message{}).
-		Where("receive_id = ? AND send_id = ? AND is_read = ?", userID, sendID, false).
+		Where("receive_id = ? AND send_id = ? AND is_read = ? AND status = ?",
+			userID, sendID, false, model.MessageStatusDelivered).
 		Update("is_read", true).Error
 }
 
 func (r *messageRepositoryImpl) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&model.Message{}).
-		Where("receive_id = ? AND is_read = ?", userID, false).
+		Where("receive_id = ? AND is_read = ? AND status = ?", userID, false, model.MessageStatusDelivered).
 		Count(&count).Error
 	return count, err
 }
@@ -120,11 +141,39 @@ func (r *messageRepositoryImpl) GetUnreadCount(ctx context.Context, userID int64
 func (r *messageRepositoryImpl) GetUnreadCountBySender(ctx context.Context, userID, sendID int64) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&model.Message{}).
-		Where("receive_id = ? AND send_id = ? AND is_read = ?", userID, sendID, false).
+		Where("receive_id = ? AND send_id = ? AND is_read = ? AND status = ?",
+			userID, sendID, false, model.MessageStatusDelivered).
 		Count(&count).Error
 	return count, err
 }
 
+// 待审核私信按发送先后排列，lastMessageID为上一页最后一条的ID
+// 待审核私信，先送审的排在前面
+func (r *messageRepositoryImpl) GetPendingMessages(ctx context.Context, cursor *pagination.Cursor, limit int) ([]*model.Message, error) {
+	var messages []*model.Message
+	query := r.db.WithContext(ctx).Where("status = ?", model.MessageStatusPending)
+	if cursor != nil {
+		query = query.Where("id > ?", cursor.ID)
+	}
+
+	err := query.
+		Order("id ASC").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+// 状态为from时更新为to，状态已变化时返回false
+func (r *messageRepositoryImpl) UpdateStatus(ctx context.Context, messageID int64, from, to int32) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.Message{}).
+		Where("id = ? AND status = ?", messageID, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *messageRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo MessageRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &messageRepositoryImpl{db: tx}
@@ -153,27 +202,38 @@ func (r *notificationRepositoryImpl) FindByID(ctx context.Context, id int64) (*m
 	return &notification, err
 }
 
-func (r *notificationRepositoryImpl) GetNotifications(ctx context.Context, userID int64, page, pageSize int, notificationType *int32) ([]*model.SystemNotification, int64, error) {
+func (r *notificationRepositoryImpl) GetNotifications(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int, notificationType *int32) ([]*model.SystemNotification, error) {
 	var notifications []*model.SystemNotification
-	var total int64
-	offset := (page - 1) * pageSize
 
-	query := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
-		Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
 
 	if notificationType != nil {
 		query = query.Where("type = ?", *notificationType)
 	}
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := query.Offset(offset).Limit(pageSize).
-		Order("create_time DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&notifications).Error
 
-	return notifications, total, err
+	return notifications, err
+}
+
+func (r *notificationRepositoryImpl) CountNotifications(ctx context.Context, userID int64, notificationType *int32) (int64, error) {
+	var count int64
+
+	query := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
+		Where("user_id = ?", userID)
+
+	if notificationType != nil {
+		query = query.Where("type = ?", *notificationType)
+	}
+
+	err := query.Count(&count).Error
+	return count, err
 }
 
 func (r *notificationRepositoryImpl) MarkNotificationRead(ctx context.Context, userID, notificationID int64) error {
@@ -202,6 +262,13 @@ func (r *notificationRepositoryImpl) Delete(ctx context.Context, notificationID,
 		Delete(&model.SystemNotification{}).Error
 }
 
+func (r *notificationRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.SystemNotification{})
+	return result.RowsAffected, result.Error
+}
+
 func (r *notificationRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo NotificationRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &notificationRepositoryImpl{db: tx}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/message/model"
 	"shortvideo/internal/message/service"
 	"shortvideo/kitex_gen/common"
 	message "shortvideo/kitex_gen/message"
@@ -29,7 +30,7 @@ func (s *MessageServiceImpl) SendMessage(ctx context.Context, req *message.SendM
 		MessageId: 0,
 	}
 
-	messageID, err := s.messageService.SendMessage(ctx, req.SenderId, req.ReceiverId, req.Content)
+	sent, err := s.messageService.SendMessage(ctx, req.SenderId, req.ReceiverId, req.Content)
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -37,7 +38,9 @@ func (s *MessageServiceImpl) SendMessage(ctx context.Context, req *message.SendM
 		return resp, nil
 	}
 
-	resp.MessageId = messageID
+	resp.MessageId = sent.ID
+	resp.Content = &sent.Content
+	resp.Pending = sent.Status == model.MessageStatusPending
 	return resp, nil
 }
 
@@ -53,7 +56,7 @@ func (s *MessageServiceImpl) GetChatHistory(ctx context.Context, req *message.Ge
 		NextMessageId: 0,
 	}
 
-	messages, nextMessageID, err := s.messageService.GetChatHistory(ctx, req.UserId1, req.UserId2, req.LastMessageId, int(req.PageSize))
+	messages, nextCursor, err := s.messageService.GetChatHistory(ctx, req.UserId1, req.UserId2, req.GetCursor(), int(req.PageSize))
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -61,20 +64,11 @@ func (s *MessageServiceImpl) GetChatHistory(ctx context.Context, req *message.Ge
 		return resp, nil
 	}
 
-	commonMessages := make([]*common.Message, len(messages))
-	for i, msg := range messages {
-		commonMessages[i] = &common.Message{
-			Id:         msg.ID,
-			SendId:     msg.SendID,
-			ReceiveId:  msg.ReceiveID,
-			Content:    msg.Content,
-			CreateTime: msg.CreateTime,
-			IsRead:     msg.IsRead,
-		}
+	resp.Messages = convertMessages(messages)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
 	}
-
-	resp.Messages = commonMessages
-	resp.NextMessageId = nextMessageID
 	return resp, nil
 }
 
@@ -109,15 +103,8 @@ func (s *MessageServiceImpl) GetLatestMessages(ctx context.Context, req *message
 		}
 
 		latestMessages[i] = &message.LatestMessage{
-			User: user,
-			LastMessage: &common.Message{
-				Id:         msg.ID,
-				SendId:     msg.SendID,
-				ReceiveId:  msg.ReceiveID,
-				Content:    msg.Content,
-				CreateTime: msg.CreateTime,
-				IsRead:     msg.IsRead,
-			},
+			User:        user,
+			LastMessage: convertMessage(msg),
 			UnreadCount: 0,
 		}
 	}
@@ -203,7 +190,7 @@ func (s *MessageServiceImpl) GetNotifications(ctx context.Context, req *message.
 		TotalCount:    0,
 	}
 
-	notifications, total, err := s.messageService.GetNotifications(ctx, req.UserId, int(req.Page), int(req.PageSize), req.Type)
+	notifications, nextCursor, total, err := s.messageService.GetNotifications(ctx, req.UserId, req.GetCursor(), int(req.PageSize), req.Type, req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -227,6 +214,10 @@ func (s *MessageServiceImpl) GetNotifications(ctx context.Context, req *message.
 
 	resp.Notifications = systemNotifications
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -250,3 +241,72 @@ func (s *MessageServiceImpl) MarkNotificationRead(ctx context.Context, req *mess
 
 	return resp, nil
 }
+
+// GetPendingMessages implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) GetPendingMessages(ctx context.Context, req *message.GetPendingMessagesReq) (resp *message.GetPendingMessagesResp, err error) {
+	successMsg := "成功"
+	resp = &message.GetPendingMessagesResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Messages: []*common.Message{},
+	}
+
+	messages, nextCursor, err := s.messageService.GetPendingMessages(ctx, req.GetCursor(), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Messages = convertMessages(messages)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// ReviewMessage implements the MessageServiceImpl interface.
+func (s *MessageServiceImpl) ReviewMessage(ctx context.Context, req *message.ReviewMessageReq) (resp *message.ReviewMessageResp, err error) {
+	successMsg := "成功"
+	resp = &message.ReviewMessageResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	reviewed, err := s.messageService.ReviewMessage(ctx, req.MessageId, req.Approve)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Message = convertMessage(reviewed)
+	return resp, nil
+}
+
+func convertMessage(msg *model.Message) *common.Message {
+	return &common.Message{
+		Id:         msg.ID,
+		SendId:     msg.SendID,
+		ReceiveId:  msg.ReceiveID,
+		Content:    msg.Content,
+		CreateTime: msg.CreateTime,
+		IsRead:     msg.IsRead,
+		Pending:    msg.Status == model.MessageStatusPending,
+	}
+}
+
+func convertMessages(messages []*model.Message) []*common.Message {
+	result := make([]*common.Message, len(messages))
+	for i, msg := range messages {
+		result[i] = convertMessage(msg)
+	}
+	return result
+}
@@ -4,6 +4,12 @@ import (
 	"time"
 )
 
+// 私信状态，命中送审敏感词的私信在审核通过前只有发送者可见
+const (
+	MessageStatusDelivered int32 = 0
+	MessageStatusPending   int32 = 1
+)
+
 type Message struct {
 	ID         int64     `gorm:"primaryKey;autoIncrement;comment:消息ID"`
 	ReceiveID  int64     `gorm:"index;not null;comment:接收者ID"`
@@ -11,6 +17,7 @@ type Message struct {
 	Content    string    `gorm:"type:text;not null;comment:消息内容"`
 	CreateTime string    `gorm:"size:50;not null;comment:创建时间"`
 	IsRead     bool      `gorm:"default:false;comment:是否已读"`
+	Status     int32     `gorm:"index;default:0;comment:状态 0已送达 1待审核"`
 	CreatedAt  time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
@@ -35,3 +42,21 @@ type SystemNotification struct {
 func (SystemNotification) TableName() string {
 	return "system_notifications"
 }
+
+// 社交类系统通知类型
+const (
+	NotificationTypeFollowRequest  int32 = 101
+	NotificationTypeFollowApproved int32 = 102
+)
+
+// 账号安全类系统通知类型
+const (
+	NotificationTypeNewDeviceLogin    int32 = 201
+	NotificationTypeDeletionScheduled int32 = 202
+	NotificationTypeDataExportReady   int32 = 203
+)
+
+// 成长类系统通知类型
+const (
+	NotificationTypeLevelUp int32 = 301
+)
@@ -7,9 +7,15 @@ import (
 	"fmt"
 	"shortvideo/internal/message/dao"
 	"shortvideo/internal/message/model"
+	socialDao "shortvideo/internal/social/dao"
 	userService "shortvideo/internal/user/service"
+	"shortvideo/internal/user/settings"
+	"shortvideo/pkg/cache"
 	"shortvideo/pkg/logger"
 	"shortvideo/pkg/mq"
+	"shortvideo/pkg/pagination"
+	"shortvideo/pkg/rbac"
+	"shortvideo/pkg/textfilter"
 	"time"
 )
 
@@ -22,13 +28,17 @@ var (
 	ErrNotNotificationOwner  = errors.New("不是通知所有者")
 	ErrInternalServer        = errors.New("服务器内部错误")
 	ErrUserNotFound          = errors.New("用户不存在")
+	ErrSensitiveContent      = errors.New("内容包含违规信息")
+	ErrUserBlocked           = errors.New("由于拉黑关系无法发送消息")
+	ErrDMNotAllowed          = errors.New("对方设置了私信权限，无法发送消息")
+	ErrMessageNotPending     = errors.New("消息不在待审核状态")
 )
 
 type MessageService interface {
 	//发送消息
-	SendMessage(ctx context.Context, senderID, receiverID int64, content string) (int64, error)
+	SendMessage(ctx context.Context, senderID, receiverID int64, content string) (*model.Message, error)
 	//获取聊天历史
-	GetChatHistory(ctx context.Context, userID1, userID2, lastMessageID int64, pageSize int) ([]*model.Message, int64, error)
+	GetChatHistory(ctx context.Context, userID1, userID2 int64, cursor string, pageSize int) ([]*model.Message, string, error)
 	//获取最新消息
 	GetLatestMessages(ctx context.Context, userID int64, limit int) ([]*model.Message, error)
 	//标记消息已读
@@ -37,12 +47,18 @@ type MessageService interface {
 	DeleteMessage(ctx context.Context, userID, messageID int64) error
 	//获取未读消息数
 	GetUnreadCount(ctx context.Context, userID int64) (int64, error)
+	//获取待审核私信
+	GetPendingMessages(ctx context.Context, cursor string, pageSize int) ([]*model.Message, string, error)
+	//审核私信
+	ReviewMessage(ctx context.Context, messageID int64, approve bool) (*model.Message, error)
 	//获取通知列表
-	GetNotifications(ctx context.Context, userID int64, page, pageSize int, notificationType *int32) ([]*model.SystemNotification, int64, error)
+	GetNotifications(ctx context.Context, userID int64, cursor string, pageSize int, notificationType *int32, needTotal bool) ([]*model.SystemNotification, string, int64, error)
 	//标记通知已读
 	MarkNotificationRead(ctx context.Context, userID, notificationID int64) error
 	//创建系统通知
 	CreateNotification(ctx context.Context, userID int64, title, content string, notificationType int32, relatedID int64) (int64, error)
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
 	//事务支持
 	WithTransaction(ctx context.Context, fn func(txService MessageService) error) error
 }
@@ -51,41 +67,63 @@ type messageServiceImpl struct {
 	messageRepo      dao.MessageRepository
 	notificationRepo dao.NotificationRepository
 	userService      userService.UserService
+	blockRepo        socialDao.BlockRepository
+	followRepo       socialDao.FollowRepository
+	settings         settings.Client
 	kafkaProducer    *mq.Producer
+	cache            cache.Cache
 }
 
 func NewMessageService(
 	messageRepo dao.MessageRepository,
 	notificationRepo dao.NotificationRepository,
 	userService userService.UserService,
+	blockRepo socialDao.BlockRepository,
+	followRepo socialDao.FollowRepository,
+	settings settings.Client,
 	kafkaProducer *mq.Producer,
+	cache cache.Cache,
 ) MessageService {
 	return &messageServiceImpl{
 		messageRepo:      messageRepo,
 		notificationRepo: notificationRepo,
 		userService:      userService,
+		blockRepo:        blockRepo,
+		followRepo:       followRepo,
+		settings:         settings,
 		kafkaProducer:    kafkaProducer,
+		cache:            cache,
 	}
 }
 
 // 发送消息
-func (s *messageServiceImpl) SendMessage(ctx context.Context, senderID, receiverID int64, content string) (int64, error) {
+func (s *messageServiceImpl) SendMessage(ctx context.Context, senderID, receiverID int64, content string) (*model.Message, error) {
 	logger.Info("发送消息请求",
 		logger.Int64Field("sender_id", senderID),
 		logger.Int64Field("receiver_id", receiverID),
 		logger.StringField("content", content))
 
 	if content == "" {
-		return 0, ErrInvalidMessageContent
+		return nil, ErrInvalidMessageContent
 	}
 
+	//敏感词过滤：拒绝的直接返回，其余保存打码后的内容，需要审核的暂不投递，审核通过后接收者才能看到
+	filtered := textfilter.Check(content)
+	if filtered.Action == textfilter.ActionReject {
+		logger.Warn("消息包含违规信息",
+			logger.Int64Field("sender_id", senderID),
+			logger.Int64Field("receiver_id", receiverID))
+		return nil, ErrSensitiveContent
+	}
+	content = filtered.Text
+
 	var err error
 	_, err = s.userService.GetUserByID(ctx, senderID)
 	if err != nil {
 		logger.Error("获取发送者信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("sender_id", senderID))
-		return 0, ErrUserNotFound
+		return nil, ErrUserNotFound
 	}
 
 	_, err = s.userService.GetUserByID(ctx, receiverID)
@@ -93,7 +131,51 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, senderID, receiver
 		logger.Error("获取接收者信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("receiver_id", receiverID))
-		return 0, ErrUserNotFound
+		return nil, ErrUserNotFound
+	}
+
+	if s.blockRepo != nil {
+		blocked, err := s.blockRepo.ExistsBlockBetween(ctx, senderID, receiverID)
+		if err != nil {
+			logger.Error("检查拉黑关系失败",
+				logger.ErrorField(err),
+				logger.Int64Field("sender_id", senderID),
+				logger.Int64Field("receiver_id", receiverID))
+			return nil, ErrInternalServer
+		}
+		if blocked {
+			logger.Warn("拉黑关系禁止发送消息",
+				logger.Int64Field("sender_id", senderID),
+				logger.Int64Field("receiver_id", receiverID))
+			return nil, ErrUserBlocked
+		}
+	}
+
+	//检查发送者是否在接收者设置的私信范围内
+	if s.settings != nil && s.followRepo != nil {
+		receiverSettings, err := s.settings.Get(ctx, receiverID)
+		if err != nil {
+			return nil, ErrInternalServer
+		}
+		allowed, err := settings.AudienceAllows(ctx, receiverSettings.DMPermission, receiverID, senderID, s.followRepo.Exists)
+		if err != nil {
+			logger.Error("检查私信权限失败",
+				logger.ErrorField(err),
+				logger.Int64Field("sender_id", senderID),
+				logger.Int64Field("receiver_id", receiverID))
+			return nil, ErrInternalServer
+		}
+		if !allowed {
+			logger.Warn("接收者的私信设置禁止发送消息",
+				logger.Int64Field("sender_id", senderID),
+				logger.Int64Field("receiver_id", receiverID))
+			return nil, ErrDMNotAllowed
+		}
+	}
+
+	status := model.MessageStatusDelivered
+	if filtered.Action == textfilter.ActionReview {
+		status = model.MessageStatusPending
 	}
 
 	message := &model.Message{
@@ -102,6 +184,7 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, senderID, receiver
 		Content:    content,
 		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
 		IsRead:     false,
+		Status:     status,
 	}
 
 	if err := s.messageRepo.Create(ctx, message); err != nil {
@@ -109,46 +192,159 @@ func (s *messageServiceImpl) SendMessage(ctx context.Context, senderID, receiver
 			logger.ErrorField(err),
 			logger.Int64Field("sender_id", senderID),
 			logger.Int64Field("receiver_id", receiverID))
-		return 0, ErrMessageSendFailed
+		return nil, ErrMessageSendFailed
 	}
 
-	if s.kafkaProducer != nil {
-		eventData := map[string]interface{}{
-			"message_id":  message.ID,
-			"sender_id":   senderID,
-			"receiver_id": receiverID,
-			"content":     content,
-			"created_at":  time.Now(),
-		}
-		data, _ := json.Marshal(eventData)
-		s.kafkaProducer.SendMessageEvent(ctx, fmt.Sprintf("%d", message.ID), data)
+	if status == model.MessageStatusDelivered {
+		s.publishMessageEvent(ctx, message)
+	} else if s.kafkaProducer != nil {
+		reviewData, _ := json.Marshal(map[string]interface{}{
+			"type":       "content_review",
+			"message_id": message.ID,
+			"sender_id":  senderID,
+			"content":    content,
+			"matches":    filtered.Matches,
+			"created_at": time.Now(),
+		})
+		s.kafkaProducer.SendMessageEvent(ctx, fmt.Sprintf("%d", message.ID), reviewData)
 	}
 
 	logger.Info("发送消息成功",
 		logger.Int64Field("message_id", message.ID),
 		logger.Int64Field("sender_id", senderID),
-		logger.Int64Field("receiver_id", receiverID))
+		logger.Int64Field("receiver_id", receiverID),
+		logger.BoolField("pending", status == model.MessageStatusPending))
+
+	return message, nil
+}
+
+// 私信送达接收者后发出消息事件
+func (s *messageServiceImpl) publishMessageEvent(ctx context.Context, message *model.Message) {
+	if s.kafkaProducer == nil {
+		return
+	}
+	eventData := map[string]interface{}{
+		"message_id":  message.ID,
+		"sender_id":   message.SendID,
+		"receiver_id": message.ReceiveID,
+		"content":     message.Content,
+		"created_at":  time.Now(),
+	}
+	data, _ := json.Marshal(eventData)
+	s.kafkaProducer.SendMessageEvent(ctx, fmt.Sprintf("%d", message.ID), data)
+}
+
+// 获取待审核私信，需要message.review权限
+func (s *messageServiceImpl) GetPendingMessages(ctx context.Context, cursor string, pageSize int) ([]*model.Message, string, error) {
+	if err := rbac.Require(ctx, rbac.PermMessageReview); err != nil {
+		return nil, "", err
+	}
+
+	pageScope := pagination.Scope{List: "pending_messages"}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	messages, err := s.messageRepo.GetPendingMessages(ctx, pageCursor, pageSize+1)
+	if err != nil {
+		logger.Error("获取待审核私信失败",
+			logger.ErrorField(err))
+		return nil, "", ErrInternalServer
+	}
+
+	messages, nextCursor := pagination.Paginate(messages, pageSize, pageScope, func(m *model.Message) pagination.Cursor {
+		return pagination.Cursor{SortKey: m.ID, ID: m.ID}
+	})
+	return messages, nextCursor, nil
+}
+
+// 审核私信：通过后投递给接收者，拒绝则删除，需要message.review权限
+func (s *messageServiceImpl) ReviewMessage(ctx context.Context, messageID int64, approve bool) (*model.Message, error) {
+	logger.Info("审核私信请求",
+		logger.Int64Field("message_id", messageID),
+		logger.BoolField("approve", approve))
+
+	if err := rbac.Require(ctx, rbac.PermMessageReview); err != nil {
+		return nil, err
+	}
+
+	message, err := s.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		logger.Error("查询消息失败",
+			logger.ErrorField(err),
+			logger.Int64Field("message_id", messageID))
+		return nil, ErrInternalServer
+	}
+	if message == nil {
+		return nil, ErrMessageNotFound
+	}
+	if message.Status != model.MessageStatusPending {
+		return nil, ErrMessageNotPending
+	}
+
+	if !approve {
+		if err := s.messageRepo.Delete(ctx, messageID, message.SendID); err != nil {
+			logger.Error("删除待审核私信失败",
+				logger.ErrorField(err),
+				logger.Int64Field("message_id", messageID))
+			return nil, ErrInternalServer
+		}
+		logger.Info("私信审核未通过",
+			logger.Int64Field("message_id", messageID))
+		return message, nil
+	}
+
+	updated, err := s.messageRepo.UpdateStatus(ctx, messageID, model.MessageStatusPending, model.MessageStatusDelivered)
+	if err != nil {
+		logger.Error("更新私信状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("message_id", messageID))
+		return nil, ErrInternalServer
+	}
+	if !updated {
+		return nil, ErrMessageNotPending
+	}
+	message.Status = model.MessageStatusDelivered
+	s.publishMessageEvent(ctx, message)
 
-	return message.ID, nil
+	logger.Info("私信审核通过",
+		logger.Int64Field("message_id", messageID))
+	return message, nil
 }
 
 // 获取聊天历史
-func (s *messageServiceImpl) GetChatHistory(ctx context.Context, userID1, userID2, lastMessageID int64, pageSize int) ([]*model.Message, int64, error) {
+func (s *messageServiceImpl) GetChatHistory(ctx context.Context, userID1, userID2 int64, cursor string, pageSize int) ([]*model.Message, string, error) {
 	logger.Info("获取聊天历史请求",
 		logger.Int64Field("user_id1", userID1),
 		logger.Int64Field("user_id2", userID2),
-		logger.Int64Field("last_message_id", lastMessageID),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	messages, total, err := s.messageRepo.GetChatHistory(ctx, userID1, userID2, lastMessageID, pageSize)
+	//游标绑定到查看者和对方，不能用于其他会话
+	pageScope := pagination.Scope{List: fmt.Sprintf("chat_history:%d", userID2), Owner: userID1}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	messages, err := s.messageRepo.GetChatHistory(ctx, userID1, userID2, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("获取聊天历史失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id1", userID1),
 			logger.Int64Field("user_id2", userID2))
-		return nil, 0, ErrInternalServer
+		return nil, "", ErrInternalServer
 	}
 
+	messages, nextCursor := pagination.Paginate(messages, pageSize, pageScope, func(m *model.Message) pagination.Cursor {
+		return pagination.Cursor{SortKey: m.ID, ID: m.ID}
+	})
+
 	if len(messages) > 0 {
 		go func() {
 			if err := s.messageRepo.MarkMessagesRead(context.Background(), userID1, userID2); err != nil {
@@ -160,18 +356,13 @@ func (s *messageServiceImpl) GetChatHistory(ctx context.Context, userID1, userID
 		}()
 	}
 
-	var nextMessageID int64
-	if len(messages) > 0 {
-		nextMessageID = messages[len(messages)-1].ID
-	}
-
 	logger.Info("获取聊天历史成功",
 		logger.Int64Field("user_id1", userID1),
 		logger.Int64Field("user_id2", userID2),
 		logger.IntField("message_count", len(messages)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return messages, nextMessageID, nil
+	return messages, nextCursor, nil
 }
 
 // 获取最新消息
@@ -290,26 +481,58 @@ func (s *messageServiceImpl) GetUnreadCount(ctx context.Context, userID int64) (
 }
 
 // 获取通知列表
-func (s *messageServiceImpl) GetNotifications(ctx context.Context, userID int64, page, pageSize int, notificationType *int32) ([]*model.SystemNotification, int64, error) {
+func (s *messageServiceImpl) GetNotifications(ctx context.Context, userID int64, cursor string, pageSize int, notificationType *int32, needTotal bool) ([]*model.SystemNotification, string, int64, error) {
 	logger.Info("获取通知列表请求",
 		logger.Int64Field("user_id", userID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	notifications, total, err := s.notificationRepo.GetNotifications(ctx, userID, page, pageSize, notificationType)
+	pageScope := pagination.Scope{List: "notifications", Owner: userID}
+	if notificationType != nil {
+		pageScope.List = fmt.Sprintf("notifications:%d", *notificationType)
+	}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	notifications, err := s.notificationRepo.GetNotifications(ctx, userID, pageCursor, pageSize+1, notificationType)
 	if err != nil {
 		logger.Error("获取通知列表失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	notifications, nextCursor := pagination.Paginate(notifications, pageSize, pageScope, func(n *model.SystemNotification) pagination.Cursor {
+		return pagination.Cursor{SortKey: n.CreatedAt.UnixMicro(), ID: n.ID}
+	})
+
+	var total int64
+	if needTotal {
+		scope := "notifications"
+		if notificationType != nil {
+			scope = fmt.Sprintf("notifications:%d", *notificationType)
+		}
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey(scope, userID), func() (int64, error) {
+			return s.notificationRepo.CountNotifications(ctx, userID, notificationType)
+		})
+		if err != nil {
+			logger.Error("统计通知数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	logger.Info("获取通知列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("notification_count", len(notifications)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return notifications, total, nil
+	return notifications, nextCursor, total, nil
 }
 
 // 标记通知已读
@@ -403,9 +626,40 @@ func (s *messageServiceImpl) WithTransaction(ctx context.Context, fn func(txServ
 			messageRepo:      txMessageRepo,
 			notificationRepo: txNotificationRepo,
 			userService:      s.userService,
+			blockRepo:        s.blockRepo,
+			followRepo:       s.followRepo,
+			settings:         s.settings,
 			kafkaProducer:    s.kafkaProducer,
+			cache:            s.cache,
 		}
 
 		return fn(txService)
 	})
 }
+
+// 账号注销后删除用户发出和收到的私信以及系统通知，可重复执行
+func (s *messageServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的消息数据", logger.Int64Field("user_id", userID))
+
+	messageCount, err := s.messageRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("删除用户私信失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	notificationCount, err := s.notificationRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("删除用户系统通知失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	logger.Info("清理注销用户的消息数据完成",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("message_count", messageCount),
+		logger.Int64Field("notification_count", notificationCount))
+	return nil
+}
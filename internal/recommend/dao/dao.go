@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserActionRepository interface {
@@ -16,6 +17,8 @@ type UserActionRepository interface {
 	FindByItemID(ctx context.Context, itemID int64, itemType string, limit int) ([]*model.UserAction, error)
 	FindRecentActions(ctx context.Context, userID int64, actionTypes []string, limit int) ([]*model.UserAction, error)
 	CountByUserAndItem(ctx context.Context, userID, itemID int64, itemType, actionType string) (int64, error)
+	DeleteByUserAndItem(ctx context.Context, userID, itemID int64, itemType, actionType string) error
+	DeleteByUserID(ctx context.Context, userID int64) error
 	GetActionStats(ctx context.Context, userID int64, startTime, endTime time.Time) (*UserActionStats, error)
 	GetPopularItems(ctx context.Context, itemType string, days int, limit int) ([]*PopularItem, error)
 	GetSimilarUsers(ctx context.Context, userID int64, limit int) ([]int64, error)
@@ -41,9 +44,21 @@ type UserPreferenceRepository interface {
 	UpdateUserTagWeight(ctx context.Context, userID int64, tag string, delta float64) error
 	BatchGetUserPreferences(ctx context.Context, userIDs []int64, limit int) (map[int64][]string, error)
 	GetTopKPreferences(ctx context.Context, userID int64, k int) ([]*model.UserPreference, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
 	WithTransaction(ctx context.Context, fn func(txRepo UserPreferenceRepository) error) error
 }
 
+type UserFeedbackRepository interface {
+	Create(ctx context.Context, feedback *model.UserFeedback) (bool, error)
+	FindByID(ctx context.Context, id int64) (*model.UserFeedback, error)
+	FindByTarget(ctx context.Context, userID int64, targetType string, targetID int64, tagName string) (*model.UserFeedback, error)
+	Delete(ctx context.Context, id int64) error
+	DeleteByUserID(ctx context.Context, userID int64) error
+	ListByUserID(ctx context.Context, userID int64, targetType string, page, pageSize int) ([]*model.UserFeedback, int64, error)
+	FindHiddenTargets(ctx context.Context, userID int64) (*HiddenTargets, error)
+	WithTransaction(ctx context.Context, fn func(txRepo UserFeedbackRepository) error) error
+}
+
 type UserActionStats struct {
 	UserID       int64
 	TotalActions int64
@@ -56,6 +71,17 @@ type UserActionStats struct {
 	EndTime      time.Time
 }
 
+// 用户通过负反馈屏蔽的视频、作者和标签
+type HiddenTargets struct {
+	VideoIDs  map[int64]bool
+	AuthorIDs map[int64]bool
+	Tags      map[string]bool
+}
+
+func (h *HiddenTargets) IsEmpty() bool {
+	return h == nil || (len(h.VideoIDs) == 0 && len(h.AuthorIDs) == 0 && len(h.Tags) == 0)
+}
+
 type PopularItem struct {
 	ItemID      int64
 	ItemType    string
@@ -122,6 +148,17 @@ func (r *userActionRepositoryImpl) CountByUserAndItem(ctx context.Context, userI
 	return count, err
 }
 
+func (r *userActionRepositoryImpl) DeleteByUserAndItem(ctx context.Context, userID, itemID int64, itemType, actionType string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND item_id = ? AND item_type = ? AND action_type = ?",
+			userID, itemID, itemType, actionType).
+		Delete(&model.UserAction{}).Error
+}
+
+func (r *userActionRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserAction{}).Error
+}
+
 func (r *userActionRepositoryImpl) GetActionStats(ctx context.Context, userID int64, startTime, endTime time.Time) (*UserActionStats, error) {
 	var stats UserActionStats
 	stats.UserID = userID
@@ -167,6 +204,7 @@ func (r *userActionRepositoryImpl) GetPopularItems(ctx context.Context, itemType
 			"WHEN action_type = 'share' THEN 3 "+
 			"ELSE 1 END) as score").
 		Where("item_type = ? AND created_at >= ?", itemType, startTime).
+		Where("action_type NOT IN ?", []string{model.ActionNotInterested, model.ActionLowQuality}).
 		Group("item_id, item_type").
 		Order("score DESC").
 		Limit(limit).
@@ -356,9 +394,122 @@ func (r *userPreferenceRepositoryImpl) GetTopKPreferences(ctx context.Context, u
 	return preferences, err
 }
 
+func (r *userPreferenceRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserPreference{}).Error
+}
+
 func (r *userPreferenceRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo UserPreferenceRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &userPreferenceRepositoryImpl{db: tx}
 		return fn(txRepo)
 	})
 }
+
+type userFeedbackRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUserFeedbackRepository(db *gorm.DB) UserFeedbackRepository {
+	return &userFeedbackRepositoryImpl{db: db}
+}
+
+// 同一对象已存在反馈时不重复创建，返回false
+func (r *userFeedbackRepositoryImpl) Create(ctx context.Context, feedback *model.UserFeedback) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(feedback)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *userFeedbackRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.UserFeedback, error) {
+	var feedback model.UserFeedback
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&feedback).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &feedback, nil
+}
+
+func (r *userFeedbackRepositoryImpl) FindByTarget(ctx context.Context, userID int64, targetType string, targetID int64, tagName string) (*model.UserFeedback, error) {
+	var feedback model.UserFeedback
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id = ? AND tag_name = ?", userID, targetType, targetID, tagName).
+		First(&feedback).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &feedback, nil
+}
+
+func (r *userFeedbackRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.UserFeedback{}).Error
+}
+
+func (r *userFeedbackRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserFeedback{}).Error
+}
+
+func (r *userFeedbackRepositoryImpl) ListByUserID(ctx context.Context, userID int64, targetType string, page, pageSize int) ([]*model.UserFeedback, int64, error) {
+	var feedbacks []*model.UserFeedback
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.UserFeedback{}).Where("user_id = ?", userID)
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&feedbacks).Error
+	return feedbacks, total, err
+}
+
+func (r *userFeedbackRepositoryImpl) FindHiddenTargets(ctx context.Context, userID int64) (*HiddenTargets, error) {
+	var feedbacks []*model.UserFeedback
+	err := r.db.WithContext(ctx).
+		Select("target_type, target_id, tag_name").
+		Where("user_id = ?", userID).
+		Find(&feedbacks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hidden := &HiddenTargets{
+		VideoIDs:  make(map[int64]bool),
+		AuthorIDs: make(map[int64]bool),
+		Tags:      make(map[string]bool),
+	}
+	for _, feedback := range feedbacks {
+		switch feedback.TargetType {
+		case model.FeedbackTargetVideo:
+			hidden.VideoIDs[feedback.TargetID] = true
+		case model.FeedbackTargetAuthor:
+			hidden.AuthorIDs[feedback.TargetID] = true
+		case model.FeedbackTargetTag:
+			hidden.Tags[feedback.TagName] = true
+		}
+	}
+	return hidden, nil
+}
+
+func (r *userFeedbackRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo UserFeedbackRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &userFeedbackRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
@@ -2,20 +2,25 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/interaction/rpcclient"
 	"shortvideo/internal/recommend/service"
 	"shortvideo/kitex_gen/common"
+	"shortvideo/kitex_gen/interaction/interactionservice"
 	recommend "shortvideo/kitex_gen/recommend"
 	"shortvideo/pkg/logger"
 )
 
 // RecommendServiceImpl implements the last service interface defined in the IDL.
 type RecommendServiceImpl struct {
-	recommendService service.RecommendService
+	recommendService  service.RecommendService
+	interactionClient interactionservice.Client
 }
 
-func NewRecommendService(recommendService service.RecommendService) *RecommendServiceImpl {
+// interactionClient 用于填充当前用户的点赞状态，为nil时不填充
+func NewRecommendService(recommendService service.RecommendService, interactionClient interactionservice.Client) *RecommendServiceImpl {
 	return &RecommendServiceImpl{
-		recommendService: recommendService,
+		recommendService:  recommendService,
+		interactionClient: interactionClient,
 	}
 }
 
@@ -46,6 +51,7 @@ func (s *RecommendServiceImpl) GetRecommendVideos(ctx context.Context, req *reco
 	}
 
 	resp.Videos = videos
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, resp.Videos)
 	resp.NextOffset = nextOffset
 	logger.Info("GetRecommendVideos success",
 		logger.Int64Field("user_id", req.UserId),
@@ -170,6 +176,7 @@ func (s *RecommendServiceImpl) GetTagVideos(ctx context.Context, req *recommend.
 	}
 
 	resp.Videos = videos
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, resp.Videos)
 	logger.Info("GetTagVideos success",
 		logger.StringField("tag", req.Tag),
 		logger.IntField("video_count", len(videos)))
@@ -203,6 +210,7 @@ func (s *RecommendServiceImpl) GetPersonalizedFeed(ctx context.Context, req *rec
 	}
 
 	resp.Videos = videos
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, resp.Videos)
 	resp.NextLastVideoId = nextLastVideoId
 	logger.Info("GetPersonalizedFeed success",
 		logger.Int64Field("user_id", req.UserId),
@@ -210,3 +218,130 @@ func (s *RecommendServiceImpl) GetPersonalizedFeed(ctx context.Context, req *rec
 		logger.Int64Field("next_last_video_id", nextLastVideoId))
 	return resp, nil
 }
+
+// SubmitFeedback implements the RecommendServiceImpl interface.
+func (s *RecommendServiceImpl) SubmitFeedback(ctx context.Context, req *recommend.SubmitFeedbackReq) (resp *recommend.SubmitFeedbackResp, err error) {
+	logger.Info("SubmitFeedback request",
+		logger.Int64Field("user_id", req.UserId),
+		logger.StringField("reason", req.Reason))
+
+	successMsg := "成功"
+	resp = &recommend.SubmitFeedbackResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	feedbackID, err := s.recommendService.SubmitFeedback(ctx, req.UserId, req.Reason, req.GetVideoId(), req.GetAuthorId(), req.GetTag())
+	if err != nil {
+		logger.Error("SubmitFeedback failed", logger.ErrorField(err))
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.FeedbackId = feedbackID
+	logger.Info("SubmitFeedback success",
+		logger.Int64Field("user_id", req.UserId),
+		logger.Int64Field("feedback_id", feedbackID))
+	return resp, nil
+}
+
+// GetFeedbackList implements the RecommendServiceImpl interface.
+func (s *RecommendServiceImpl) GetFeedbackList(ctx context.Context, req *recommend.GetFeedbackListReq) (resp *recommend.GetFeedbackListResp, err error) {
+	logger.Info("GetFeedbackList request",
+		logger.Int64Field("user_id", req.UserId),
+		logger.AnyField("page", req.Page),
+		logger.AnyField("page_size", req.PageSize))
+
+	successMsg := "成功"
+	resp = &recommend.GetFeedbackListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Feedbacks: []*recommend.FeedbackItem{},
+	}
+
+	feedbacks, total, err := s.recommendService.GetFeedbackList(ctx, req.UserId, req.GetTargetType(), int(req.Page), int(req.PageSize))
+	if err != nil {
+		logger.Error("GetFeedbackList failed", logger.ErrorField(err))
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, feedback := range feedbacks {
+		resp.Feedbacks = append(resp.Feedbacks, &recommend.FeedbackItem{
+			Id:         feedback.ID,
+			Reason:     feedback.Reason,
+			TargetType: feedback.TargetType,
+			TargetId:   feedback.TargetID,
+			TagName:    feedback.TagName,
+			CreatedAt:  feedback.CreatedAt.Unix(),
+		})
+	}
+	resp.Total = total
+	logger.Info("GetFeedbackList success",
+		logger.Int64Field("user_id", req.UserId),
+		logger.Int64Field("total", total))
+	return resp, nil
+}
+
+// UndoFeedback implements the RecommendServiceImpl interface.
+func (s *RecommendServiceImpl) UndoFeedback(ctx context.Context, req *recommend.UndoFeedbackReq) (resp *recommend.UndoFeedbackResp, err error) {
+	logger.Info("UndoFeedback request",
+		logger.Int64Field("user_id", req.UserId),
+		logger.Int64Field("feedback_id", req.FeedbackId))
+
+	successMsg := "成功"
+	resp = &recommend.UndoFeedbackResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.recommendService.UndoFeedback(ctx, req.UserId, req.FeedbackId)
+	if err != nil {
+		logger.Error("UndoFeedback failed", logger.ErrorField(err))
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	logger.Info("UndoFeedback success",
+		logger.Int64Field("user_id", req.UserId),
+		logger.Int64Field("feedback_id", req.FeedbackId))
+	return resp, nil
+}
+
+// FilterHiddenVideos implements the RecommendServiceImpl interface.
+func (s *RecommendServiceImpl) FilterHiddenVideos(ctx context.Context, req *recommend.FilterHiddenVideosReq) (resp *recommend.FilterHiddenVideosResp, err error) {
+	successMsg := "成功"
+	resp = &recommend.FilterHiddenVideosResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		VideoIds: []int64{},
+	}
+
+	videos, err := s.recommendService.FilterHiddenVideos(ctx, req.UserId, req.Videos)
+	if err != nil {
+		logger.Error("FilterHiddenVideos failed", logger.ErrorField(err))
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, v := range videos {
+		resp.VideoIds = append(resp.VideoIds, v.Id)
+	}
+	return resp, nil
+}
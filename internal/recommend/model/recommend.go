@@ -45,3 +45,41 @@ type UserPreference struct {
 func (UserPreference) TableName() string {
 	return "user_preferences"
 }
+
+// 负反馈原因
+const (
+	FeedbackNotInterestedVideo  = "not_interested_video"
+	FeedbackNotInterestedAuthor = "not_interested_author"
+	FeedbackNotInterestedTag    = "not_interested_tag"
+	FeedbackLowQuality          = "low_quality"
+)
+
+// 负反馈屏蔽对象类型
+const (
+	FeedbackTargetVideo  = "video"
+	FeedbackTargetAuthor = "author"
+	FeedbackTargetTag    = "tag"
+)
+
+// 负反馈对应的用户行为类型，score为负值
+const (
+	ActionNotInterested = "not_interested"
+	ActionLowQuality    = "low_quality"
+)
+
+// 用户负反馈，同一对象只保留一条，撤销时删除
+type UserFeedback struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement;comment:反馈ID"`
+	UserID      int64     `gorm:"uniqueIndex:idx_user_feedback_target;not null;comment:用户ID"`
+	TargetType  string    `gorm:"size:20;uniqueIndex:idx_user_feedback_target;not null;comment:屏蔽对象类型(video/author/tag)"`
+	TargetID    int64     `gorm:"uniqueIndex:idx_user_feedback_target;default:0;comment:屏蔽对象ID"`
+	TagName     string    `gorm:"size:50;uniqueIndex:idx_user_feedback_target;default:'';comment:屏蔽标签名"`
+	Reason      string    `gorm:"size:30;not null;comment:反馈原因"`
+	WeightDelta float64   `gorm:"default:0.0;comment:对标签偏好施加的权重变化"`
+	AppliedTags string    `gorm:"size:500;default:'';comment:被调整权重的标签，逗号分隔"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (UserFeedback) TableName() string {
+	return "user_feedbacks"
+}
@@ -0,0 +1,69 @@
+package rpcclient
+
+import (
+	"context"
+
+	"shortvideo/kitex_gen/common"
+	"shortvideo/kitex_gen/recommend"
+	"shortvideo/kitex_gen/recommend/recommendservice"
+	"shortvideo/pkg/config"
+	"shortvideo/pkg/logger"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/transport"
+	registry_etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+// 创建推荐服务客户端，caller为调用方服务名
+func New(caller string) (recommendservice.Client, error) {
+	cfg := config.Get()
+
+	resolver, err := registry_etcd.NewEtcdResolver(cfg.Etcd.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return recommendservice.NewClient(
+		"recommend",
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithResolver(resolver),
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: caller,
+		}),
+	)
+}
+
+// 过滤掉当前用户屏蔽的视频以及无权查看的私密账号视频，未登录时userID传0，失败时返回原列表，不影响主流程
+func FilterHiddenVideos(ctx context.Context, cli recommendservice.Client, userID int64, videos []*common.Video) []*common.Video {
+	if cli == nil || len(videos) == 0 {
+		return videos
+	}
+
+	resp, err := cli.FilterHiddenVideos(ctx, &recommend.FilterHiddenVideosReq{
+		UserId: userID,
+		Videos: videos,
+	})
+	if err != nil {
+		logger.Warn("过滤屏蔽视频失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return videos
+	}
+	if resp.BaseResp == nil || resp.BaseResp.StatusCode != 0 {
+		return videos
+	}
+
+	visible := make(map[int64]bool, len(resp.VideoIds))
+	for _, id := range resp.VideoIds {
+		visible[id] = true
+	}
+
+	filtered := make([]*common.Video, 0, len(resp.VideoIds))
+	for _, v := range videos {
+		if v != nil && visible[v.Id] {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
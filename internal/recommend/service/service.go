@@ -5,10 +5,13 @@ import (
 	"errors"
 	"shortvideo/internal/recommend/dao"
 	"shortvideo/internal/recommend/model"
+	socialDao "shortvideo/internal/social/dao"
 	"shortvideo/kitex_gen/common"
 	"shortvideo/kitex_gen/recommend"
 	"shortvideo/pkg/logger"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
@@ -16,8 +19,22 @@ var (
 	ErrInvalidParameter = errors.New("参数错误")
 	ErrItemNotFound     = errors.New("项目不存在")
 	ErrActionFailed     = errors.New("操作失败")
+
+	ErrInvalidFeedbackReason = errors.New("无效的反馈原因")
+	ErrFeedbackExists        = errors.New("已提交过该反馈")
+	ErrFeedbackNotFound      = errors.New("反馈不存在")
 )
 
+const maxFeedbackTagLength = 50
+
+// 各类负反馈对应的行为评分，同时作为对相关标签偏好施加的权重变化
+var feedbackWeights = map[string]float64{
+	model.FeedbackNotInterestedVideo:  -1.0,
+	model.FeedbackLowQuality:          -2.0,
+	model.FeedbackNotInterestedAuthor: -3.0,
+	model.FeedbackNotInterestedTag:    -5.0,
+}
+
 type RecommendService interface {
 	//推荐相关
 	GetRecommendVideos(ctx context.Context, userID int64, pageSize int32, offset *int64) ([]*common.Video, int64, error)
@@ -28,6 +45,13 @@ type RecommendService interface {
 	//标签相关
 	GetHotTags(ctx context.Context, count int32) ([]*recommend.TagInfo, error)
 	GetTagVideos(ctx context.Context, tag string, userID int64, pageSize int32) ([]*common.Video, error)
+	//负反馈相关
+	SubmitFeedback(ctx context.Context, userID int64, reason string, videoID, authorID int64, tag string) (int64, error)
+	GetFeedbackList(ctx context.Context, userID int64, targetType string, page, pageSize int) ([]*model.UserFeedback, int64, error)
+	UndoFeedback(ctx context.Context, userID, feedbackID int64) error
+	FilterHiddenVideos(ctx context.Context, userID int64, videos []*common.Video) ([]*common.Video, error)
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
 	//事务相关
 	WithTransaction(ctx context.Context, fn func(txService RecommendService) error) error
 }
@@ -36,17 +60,26 @@ type recommendServiceImpl struct {
 	actionRepo     dao.UserActionRepository
 	videoTagRepo   dao.VideoTagRepository
 	preferenceRepo dao.UserPreferenceRepository
+	feedbackRepo   dao.UserFeedbackRepository
+	blockRepo      socialDao.BlockRepository
+	followRepo     socialDao.FollowRepository
 }
 
 func NewRecommendService(
 	actionRepo dao.UserActionRepository,
 	videoTagRepo dao.VideoTagRepository,
 	preferenceRepo dao.UserPreferenceRepository,
+	feedbackRepo dao.UserFeedbackRepository,
+	blockRepo socialDao.BlockRepository,
+	followRepo socialDao.FollowRepository,
 ) RecommendService {
 	return &recommendServiceImpl{
 		actionRepo:     actionRepo,
 		videoTagRepo:   videoTagRepo,
 		preferenceRepo: preferenceRepo,
+		feedbackRepo:   feedbackRepo,
+		blockRepo:      blockRepo,
+		followRepo:     followRepo,
 	}
 }
 
@@ -54,11 +87,17 @@ func NewRecommendServiceWithRepo(
 	actionRepo dao.UserActionRepository,
 	videoTagRepo dao.VideoTagRepository,
 	preferenceRepo dao.UserPreferenceRepository,
+	feedbackRepo dao.UserFeedbackRepository,
+	blockRepo socialDao.BlockRepository,
+	followRepo socialDao.FollowRepository,
 ) RecommendService {
 	return &recommendServiceImpl{
 		actionRepo:     actionRepo,
 		videoTagRepo:   videoTagRepo,
 		preferenceRepo: preferenceRepo,
+		feedbackRepo:   feedbackRepo,
+		blockRepo:      blockRepo,
+		followRepo:     followRepo,
 	}
 }
 
@@ -103,6 +142,8 @@ func (s *recommendServiceImpl) GetRecommendVideos(ctx context.Context, userID in
 		nextOffset = int64(len(videos))
 	}
 
+	videos = s.filterHidden(ctx, userID, videos)
+
 	logger.Info("GetRecommendVideos success",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("video_count", len(videos)),
@@ -162,6 +203,18 @@ func (s *recommendServiceImpl) GetRecommendUsers(ctx context.Context, userID int
 		}
 	}
 
+	if hidden, err := s.findHiddenTargets(ctx, userID); err != nil {
+		logger.Error("FindHiddenTargets failed", logger.ErrorField(err))
+	} else if len(hidden.AuthorIDs) > 0 {
+		visible := make([]*common.User, 0, len(users))
+		for _, user := range users {
+			if !hidden.AuthorIDs[user.Id] {
+				visible = append(visible, user)
+			}
+		}
+		users = visible
+	}
+
 	logger.Info("GetRecommendUsers success",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("user_count", len(users)))
@@ -318,6 +371,8 @@ func (s *recommendServiceImpl) GetTagVideos(ctx context.Context, tag string, use
 		videos = append(videos, video)
 	}
 
+	videos = s.filterHidden(ctx, userID, videos)
+
 	logger.Info("GetTagVideos success",
 		logger.StringField("tag", tag),
 		logger.IntField("video_count", len(videos)))
@@ -372,6 +427,8 @@ func (s *recommendServiceImpl) GetPersonalizedFeed(ctx context.Context, userID i
 		nextLastVideoID = item.ItemID
 	}
 
+	videos = s.filterHidden(ctx, userID, videos)
+
 	logger.Info("GetPersonalizedFeed success",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("video_count", len(videos)),
@@ -380,13 +437,369 @@ func (s *recommendServiceImpl) GetPersonalizedFeed(ctx context.Context, userID i
 	return videos, nextLastVideoID, nil
 }
 
+// 提交负反馈：不感兴趣的视频、作者、标签或低质量举报
+func (s *recommendServiceImpl) SubmitFeedback(ctx context.Context, userID int64, reason string, videoID, authorID int64, tag string) (int64, error) {
+	logger.Info("SubmitFeedback request",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("reason", reason),
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("author_id", authorID),
+		logger.StringField("tag", tag))
+
+	if userID <= 0 {
+		return 0, ErrInvalidParameter
+	}
+
+	weight, ok := feedbackWeights[reason]
+	if !ok {
+		return 0, ErrInvalidFeedbackReason
+	}
+
+	feedback := &model.UserFeedback{
+		UserID:      userID,
+		Reason:      reason,
+		WeightDelta: weight,
+	}
+	actionType := model.ActionNotInterested
+	var affectedTags []string
+
+	switch reason {
+	case model.FeedbackNotInterestedVideo, model.FeedbackLowQuality:
+		if videoID <= 0 {
+			return 0, ErrInvalidParameter
+		}
+		feedback.TargetType = model.FeedbackTargetVideo
+		feedback.TargetID = videoID
+		if reason == model.FeedbackLowQuality {
+			actionType = model.ActionLowQuality
+		}
+
+		videoTags, err := s.videoTagRepo.FindByVideoID(ctx, videoID)
+		if err != nil {
+			logger.Error("FindByVideoID failed", logger.ErrorField(err))
+		}
+		for _, videoTag := range videoTags {
+			affectedTags = append(affectedTags, videoTag.TagName)
+		}
+	case model.FeedbackNotInterestedAuthor:
+		if authorID <= 0 || authorID == userID {
+			return 0, ErrInvalidParameter
+		}
+		feedback.TargetType = model.FeedbackTargetAuthor
+		feedback.TargetID = authorID
+	case model.FeedbackNotInterestedTag:
+		tag = strings.TrimSpace(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxFeedbackTagLength {
+			return 0, ErrInvalidParameter
+		}
+		feedback.TargetType = model.FeedbackTargetTag
+		feedback.TagName = tag
+		affectedTags = []string{tag}
+	}
+	feedback.AppliedTags = strings.Join(affectedTags, ",")
+
+	created, err := s.feedbackRepo.Create(ctx, feedback)
+	if err != nil {
+		logger.Error("CreateUserFeedback failed", logger.ErrorField(err))
+		return 0, ErrInternalServer
+	}
+	if !created {
+		return 0, ErrFeedbackExists
+	}
+
+	itemType, itemID := feedbackActionItem(feedback)
+	action := &model.UserAction{
+		UserID:     userID,
+		ItemID:     itemID,
+		ItemType:   itemType,
+		ActionType: actionType,
+		Score:      weight,
+		Timestamp:  time.Now().Format(time.RFC3339),
+	}
+	if err := s.actionRepo.Create(ctx, action); err != nil {
+		logger.Error("CreateUserAction failed", logger.ErrorField(err))
+	}
+
+	for _, tagName := range affectedTags {
+		s.adjustTagWeight(ctx, userID, tagName, weight)
+	}
+
+	logger.Info("SubmitFeedback success",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("feedback_id", feedback.ID))
+
+	return feedback.ID, nil
+}
+
+// 获取用户的负反馈列表，targetType为空时返回全部
+func (s *recommendServiceImpl) GetFeedbackList(ctx context.Context, userID int64, targetType string, page, pageSize int) ([]*model.UserFeedback, int64, error) {
+	logger.Info("GetFeedbackList request",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("target_type", targetType),
+		logger.IntField("page", page),
+		logger.IntField("page_size", pageSize))
+
+	if userID <= 0 {
+		return nil, 0, ErrInvalidParameter
+	}
+
+	switch targetType {
+	case "", model.FeedbackTargetVideo, model.FeedbackTargetAuthor, model.FeedbackTargetTag:
+	default:
+		return nil, 0, ErrInvalidParameter
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 20
+	}
+
+	feedbacks, total, err := s.feedbackRepo.ListByUserID(ctx, userID, targetType, page, pageSize)
+	if err != nil {
+		logger.Error("ListUserFeedback failed", logger.ErrorField(err))
+		return nil, 0, ErrInternalServer
+	}
+
+	logger.Info("GetFeedbackList success",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("feedback_count", len(feedbacks)),
+		logger.Int64Field("total", total))
+
+	return feedbacks, total, nil
+}
+
+// 撤销负反馈，恢复被屏蔽的内容并回退标签权重
+func (s *recommendServiceImpl) UndoFeedback(ctx context.Context, userID, feedbackID int64) error {
+	logger.Info("UndoFeedback request",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("feedback_id", feedbackID))
+
+	if userID <= 0 || feedbackID <= 0 {
+		return ErrInvalidParameter
+	}
+
+	feedback, err := s.feedbackRepo.FindByID(ctx, feedbackID)
+	if err != nil {
+		logger.Error("FindUserFeedback failed", logger.ErrorField(err))
+		return ErrInternalServer
+	}
+	if feedback == nil || feedback.UserID != userID {
+		return ErrFeedbackNotFound
+	}
+
+	if err := s.feedbackRepo.Delete(ctx, feedbackID); err != nil {
+		logger.Error("DeleteUserFeedback failed", logger.ErrorField(err))
+		return ErrInternalServer
+	}
+
+	actionType := model.ActionNotInterested
+	if feedback.Reason == model.FeedbackLowQuality {
+		actionType = model.ActionLowQuality
+	}
+	// 标签行为没有项目ID，无法区分具体标签，只回退权重
+	if feedback.TargetType != model.FeedbackTargetTag {
+		itemType, itemID := feedbackActionItem(feedback)
+		if err := s.actionRepo.DeleteByUserAndItem(ctx, userID, itemID, itemType, actionType); err != nil {
+			logger.Error("DeleteUserAction failed", logger.ErrorField(err))
+		}
+	}
+
+	if feedback.AppliedTags != "" {
+		for _, tagName := range strings.Split(feedback.AppliedTags, ",") {
+			s.adjustTagWeight(ctx, userID, tagName, -feedback.WeightDelta)
+		}
+	}
+
+	logger.Info("UndoFeedback success",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("feedback_id", feedbackID))
+
+	return nil
+}
+
+// 过滤掉用户屏蔽的视频、作者和标签、拉黑关系用户的视频以及未关注的私密账号的视频，供其他服务的信息流调用
+func (s *recommendServiceImpl) FilterHiddenVideos(ctx context.Context, userID int64, videos []*common.Video) ([]*common.Video, error) {
+	if len(videos) == 0 {
+		return videos, nil
+	}
+
+	hidden := &dao.HiddenTargets{
+		VideoIDs:  make(map[int64]bool),
+		AuthorIDs: make(map[int64]bool),
+		Tags:      make(map[string]bool),
+	}
+	if userID > 0 {
+		var err error
+		hidden, err = s.findHiddenTargets(ctx, userID)
+		if err != nil {
+			logger.Error("FindHiddenTargets failed", logger.ErrorField(err))
+			return nil, ErrInternalServer
+		}
+	}
+
+	if s.followRepo != nil {
+		authorIDs := make([]int64, 0, len(videos))
+		for _, video := range videos {
+			if video != nil {
+				authorIDs = append(authorIDs, video.AuthorId)
+			}
+		}
+		privateIDs, err := s.followRepo.FindInaccessibleUserIDs(ctx, userID, authorIDs)
+		if err != nil {
+			logger.Error("FindInaccessibleUserIDs failed", logger.ErrorField(err))
+			return nil, ErrInternalServer
+		}
+		for _, privateID := range privateIDs {
+			hidden.AuthorIDs[privateID] = true
+		}
+	}
+
+	if hidden.IsEmpty() {
+		return videos, nil
+	}
+
+	var videoTags map[int64][]string
+	if len(hidden.Tags) > 0 {
+		videoIDs := make([]int64, 0, len(videos))
+		for _, video := range videos {
+			if video != nil {
+				videoIDs = append(videoIDs, video.Id)
+			}
+		}
+		var err error
+		videoTags, err = s.videoTagRepo.BatchGetVideoTags(ctx, videoIDs)
+		if err != nil {
+			logger.Error("BatchGetVideoTags failed", logger.ErrorField(err))
+			return nil, ErrInternalServer
+		}
+	}
+
+	filtered := make([]*common.Video, 0, len(videos))
+	for _, video := range videos {
+		if video == nil || hidden.VideoIDs[video.Id] || hidden.AuthorIDs[video.AuthorId] {
+			continue
+		}
+		tagHidden := false
+		for _, tagName := range videoTags[video.Id] {
+			if hidden.Tags[tagName] {
+				tagHidden = true
+				break
+			}
+		}
+		if !tagHidden {
+			filtered = append(filtered, video)
+		}
+	}
+
+	return filtered, nil
+}
+
+// 汇总负反馈屏蔽的内容，拉黑、静音的用户以及拉黑了自己的用户一并视为屏蔽作者
+func (s *recommendServiceImpl) findHiddenTargets(ctx context.Context, userID int64) (*dao.HiddenTargets, error) {
+	hidden, err := s.feedbackRepo.FindHiddenTargets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if s.blockRepo == nil {
+		return hidden, nil
+	}
+
+	hiddenUserIDs, err := s.blockRepo.FindHiddenUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hiddenUserID := range hiddenUserIDs {
+		hidden.AuthorIDs[hiddenUserID] = true
+	}
+	return hidden, nil
+}
+
+// 推荐结果过滤屏蔽内容，失败时返回原结果，不影响推荐
+func (s *recommendServiceImpl) filterHidden(ctx context.Context, userID int64, videos []*common.Video) []*common.Video {
+	filtered, err := s.FilterHiddenVideos(ctx, userID, videos)
+	if err != nil {
+		return videos
+	}
+	return filtered
+}
+
+// 调整用户对标签的偏好权重，偏好不存在时创建
+func (s *recommendServiceImpl) adjustTagWeight(ctx context.Context, userID int64, tagName string, delta float64) {
+	weight, err := s.preferenceRepo.GetUserTagWeight(ctx, userID, tagName)
+	if err != nil {
+		logger.Error("GetUserTagWeight failed", logger.ErrorField(err))
+		return
+	}
+
+	if weight == 0.0 {
+		preference := &model.UserPreference{
+			UserID:  userID,
+			TagName: tagName,
+			Weight:  delta,
+		}
+		if err := s.preferenceRepo.CreateOrUpdate(ctx, preference); err != nil {
+			logger.Error("CreateOrUpdatePreference failed", logger.ErrorField(err))
+		}
+		return
+	}
+
+	if err := s.preferenceRepo.UpdateUserTagWeight(ctx, userID, tagName, delta); err != nil {
+		logger.Error("UpdateUserTagWeight failed", logger.ErrorField(err))
+	}
+}
+
+// 负反馈对应的用户行为项目
+func feedbackActionItem(feedback *model.UserFeedback) (string, int64) {
+	switch feedback.TargetType {
+	case model.FeedbackTargetAuthor:
+		return "user", feedback.TargetID
+	case model.FeedbackTargetTag:
+		return "tag", 0
+	default:
+		return "video", feedback.TargetID
+	}
+}
+
 // 事务相关
+func (s *recommendServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的推荐数据", logger.Int64Field("user_id", userID))
+
+	if err := s.actionRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除用户行为记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if err := s.preferenceRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除用户偏好失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if err := s.feedbackRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("删除用户负反馈失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	logger.Info("清理注销用户的推荐数据完成", logger.Int64Field("user_id", userID))
+	return nil
+}
+
 func (s *recommendServiceImpl) WithTransaction(ctx context.Context, fn func(txService RecommendService) error) error {
 	return s.actionRepo.WithTransaction(ctx, func(txActionRepo dao.UserActionRepository) error {
 		txService := &recommendServiceImpl{
 			actionRepo:     txActionRepo,
 			videoTagRepo:   s.videoTagRepo,
 			preferenceRepo: s.preferenceRepo,
+			feedbackRepo:   s.feedbackRepo,
+			blockRepo:      s.blockRepo,
+			followRepo:     s.followRepo,
 		}
 		return fn(txService)
 	})
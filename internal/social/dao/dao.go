@@ -4,25 +4,67 @@ import (
 	"context"
 	"errors"
 	"shortvideo/internal/social/model"
+	"shortvideo/pkg/pagination"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FollowRepository interface {
-	Create(ctx context.Context, follow *model.Follow) error
-	Delete(ctx context.Context, userID, targetUserID int64) error
+	Create(ctx context.Context, follow *model.Follow) (bool, error)
+	Delete(ctx context.Context, userID, targetUserID int64) (bool, error)
 	Find(ctx context.Context, userID, targetUserID int64) (*model.Follow, error)
 	Exists(ctx context.Context, userID, targetUserID int64) (bool, error)
-	FindFollowing(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, int64, error)
-	FindFollowers(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, int64, error)
-	FindFriends(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, error)
+	FindFollowing(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error)
+	FindFollowers(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error)
+	FindFriends(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error)
 	CountFollowing(ctx context.Context, userID int64) (int64, error)
 	CountFollowers(ctx context.Context, userID int64) (int64, error)
 	CountFriends(ctx context.Context, userID int64) (int64, error)
 	BatchCheckFollow(ctx context.Context, userID int64, targetUserIDs []int64) (map[int64]bool, error)
+	FindInaccessibleUserIDs(ctx context.Context, viewerID int64, ownerIDs []int64) ([]int64, error)
+	RepairFollowCounts(ctx context.Context) (int64, error)
 	WithTransaction(ctx context.Context, fn func(txRepo FollowRepository) error) error
 }
 
+type BlockRepository interface {
+	Block(ctx context.Context, userID, targetUserID int64) error
+	Mute(ctx context.Context, userID, targetUserID int64) (bool, error)
+	Delete(ctx context.Context, userID, targetUserID int64, blockType int8) (bool, error)
+	Find(ctx context.Context, userID, targetUserID int64) (*model.Block, error)
+	ExistsBlockBetween(ctx context.Context, userID1, userID2 int64) (bool, error)
+	FindHiddenUserIDs(ctx context.Context, userID int64) ([]int64, error)
+	ListByUserID(ctx context.Context, userID int64, blockType int8, page, pageSize int) ([]*model.Block, int64, error)
+	WithTransaction(ctx context.Context, fn func(txRepo BlockRepository) error) error
+}
+
+type AudienceListRepository interface {
+	Create(ctx context.Context, list *model.AudienceList) error
+	FindByID(ctx context.Context, id int64) (*model.AudienceList, error)
+	FindCloseFriends(ctx context.Context, userID int64) (*model.AudienceList, error)
+	UpdateName(ctx context.Context, id int64, name string) error
+	Delete(ctx context.Context, id int64) ([]int64, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*model.AudienceList, error)
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	AddMembers(ctx context.Context, listID int64, memberIDs []int64) (int64, error)
+	RemoveMembers(ctx context.Context, listID int64, memberIDs []int64) (int64, error)
+	ListMembers(ctx context.Context, listID int64, page, pageSize int) ([]*model.AudienceListMember, int64, error)
+	FindListIDsByMember(ctx context.Context, memberID int64) ([]int64, error)
+	WithTransaction(ctx context.Context, fn func(txRepo AudienceListRepository) error) error
+}
+
+type FollowRequestRepository interface {
+	Create(ctx context.Context, request *model.FollowRequest) (bool, error)
+	Find(ctx context.Context, userID, targetUserID int64) (*model.FollowRequest, error)
+	Delete(ctx context.Context, userID, targetUserID int64) (bool, error)
+	Approve(ctx context.Context, userID, targetUserID int64) (bool, error)
+	ApproveAll(ctx context.Context, targetUserID int64) ([]int64, error)
+	ListIncoming(ctx context.Context, targetUserID int64, page, pageSize int) ([]*model.FollowRequest, int64, error)
+	ListOutgoing(ctx context.Context, userID int64, page, pageSize int) ([]*model.FollowRequest, int64, error)
+	WithTransaction(ctx context.Context, fn func(txRepo FollowRequestRepository) error) error
+}
+
 type followRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -31,14 +73,61 @@ func NewFollowRepository(db *gorm.DB) FollowRepository {
 	return &followRepositoryImpl{db: db}
 }
 
-func (r *followRepositoryImpl) Create(ctx context.Context, follow *model.Follow) error {
-	return r.db.WithContext(ctx).Create(follow).Error
+// 创建关注记录并在同一事务中更新双方的关注数和粉丝数，已关注时返回false
+func (r *followRepositoryImpl) Create(ctx context.Context, follow *model.Follow) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = createFollow(tx, follow)
+		return err
+	})
+	return created, err
+}
+
+// 删除关注记录并在同一事务中更新双方的关注数和粉丝数，未关注时返回false
+func (r *followRepositoryImpl) Delete(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	deleted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		deleted, err = deleteFollow(tx, userID, targetUserID)
+		return err
+	})
+	return deleted, err
 }
 
-func (r *followRepositoryImpl) Delete(ctx context.Context, userID, targetUserID int64) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
-		Delete(&model.Follow{}).Error
+// 关注记录依赖唯一索引去重，只有真正插入时才更新计数
+func createFollow(tx *gorm.DB, follow *model.Follow) (bool, error) {
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	return true, updateFollowCounts(tx, follow.UserID, follow.TargetUserID, 1)
+}
+
+func deleteFollow(tx *gorm.DB, userID, targetUserID int64) (bool, error) {
+	result := tx.Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+		Delete(&model.Follow{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	return true, updateFollowCounts(tx, userID, targetUserID, -1)
+}
+
+// 关注数和粉丝数保存在users表，与关注记录在同一事务中更新
+func updateFollowCounts(tx *gorm.DB, userID, targetUserID, delta int64) error {
+	err := tx.Table("users").Where("id = ?", userID).
+		UpdateColumn("follow_count", gorm.Expr("GREATEST(follow_count + ?, 0)", delta)).Error
+	if err != nil {
+		return err
+	}
+	return tx.Table("users").Where("id = ?", targetUserID).
+		UpdateColumn("follower_count", gorm.Expr("GREATEST(follower_count + ?, 0)", delta)).Error
 }
 
 func (r *followRepositoryImpl) Find(ctx context.Context, userID, targetUserID int64) (*model.Follow, error) {
@@ -60,65 +149,59 @@ func (r *followRepositoryImpl) Exists(ctx context.Context, userID, targetUserID
 	return count > 0, err
 }
 
-func (r *followRepositoryImpl) FindFollowing(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, int64, error) {
+func (r *followRepositoryImpl) FindFollowing(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error) {
 	var follows []*model.Follow
-	var total int64
-	offset := (page - 1) * pageSize
-
-	if err := r.db.WithContext(ctx).Model(&model.Follow{}).
-		Where("user_id = ?", userID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
-		Offset(offset).Limit(pageSize).
-		Order("created_at DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&follows).Error
 
-	return follows, total, err
+	return follows, err
 }
 
-func (r *followRepositoryImpl) FindFollowers(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, int64, error) {
+func (r *followRepositoryImpl) FindFollowers(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error) {
 	var follows []*model.Follow
-	var total int64
-	offset := (page - 1) * pageSize
-
-	if err := r.db.WithContext(ctx).Model(&model.Follow{}).
-		Where("target_user_id = ?", userID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	query := r.db.WithContext(ctx).Where("target_user_id = ?", userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("target_user_id = ?", userID).
-		Offset(offset).Limit(pageSize).
-		Order("created_at DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&follows).Error
 
-	return follows, total, err
+	return follows, err
 }
 
-func (r *followRepositoryImpl) FindFriends(ctx context.Context, userID int64, page, pageSize int) ([]*model.Follow, error) {
+// 互相关注的好友，按当前用户关注对方的时间倒序
+func (r *followRepositoryImpl) FindFriends(ctx context.Context, userID int64, cursor *pagination.Cursor, limit int) ([]*model.Follow, error) {
 	var follows []*model.Follow
-	offset := (page - 1) * pageSize
-
-	subQuery := r.db.WithContext(ctx).Model(&model.Follow{}).
-		Select("target_user_id").
-		Where("user_id = ?", userID).
-		Where("target_user_id IN (?)",
-			r.db.Model(&model.Follow{}).
-				Select("user_id").
-				Where("target_user_id = ?", userID))
+	query := r.friendsQuery(ctx, userID)
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", time.UnixMicro(cursor.SortKey), cursor.ID)
+	}
 
-	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND target_user_id IN (?)", userID, subQuery).
-		Offset(offset).Limit(pageSize).
-		Order("created_at DESC").
+	err := query.Order("created_at DESC, id DESC").
+		Limit(limit).
 		Find(&follows).Error
 
 	return follows, err
 }
 
+// 互相关注：当前用户关注的用户中同时关注了当前用户的记录
+func (r *followRepositoryImpl) friendsQuery(ctx context.Context, userID int64) *gorm.DB {
+	followerIDs := r.db.Model(&model.Follow{}).
+		Select("user_id").
+		Where("target_user_id = ?", userID)
+
+	return r.db.WithContext(ctx).Model(&model.Follow{}).
+		Where("user_id = ? AND target_user_id IN (?)", userID, followerIDs)
+}
+
 func (r *followRepositoryImpl) CountFollowing(ctx context.Context, userID int64) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&model.Follow{}).
@@ -137,19 +220,7 @@ func (r *followRepositoryImpl) CountFollowers(ctx context.Context, userID int64)
 
 func (r *followRepositoryImpl) CountFriends(ctx context.Context, userID int64) (int64, error) {
 	var count int64
-
-	subQuery := r.db.WithContext(ctx).Model(&model.Follow{}).
-		Select("target_user_id").
-		Where("user_id = ?", userID).
-		Where("target_user_id IN (?)",
-			r.db.Model(&model.Follow{}).
-				Select("user_id").
-				Where("target_user_id = ?", userID))
-
-	err := r.db.WithContext(ctx).Model(&model.Follow{}).
-		Where("user_id = ? AND target_user_id IN (?)", userID, subQuery).
-		Count(&count).Error
-
+	err := r.friendsQuery(ctx, userID).Count(&count).Error
 	return count, err
 }
 
@@ -181,3 +252,472 @@ func (r *followRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRe
 		return fn(txRepo)
 	})
 }
+
+// 私密账号中当前用户未关注的账号，本人的账号始终可见
+func (r *followRepositoryImpl) FindInaccessibleUserIDs(ctx context.Context, viewerID int64, ownerIDs []int64) ([]int64, error) {
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+
+	var userIDs []int64
+	err := r.db.WithContext(ctx).Table("users").
+		Where("id IN ? AND is_private = ? AND id <> ?", ownerIDs, true, viewerID).
+		Where("id NOT IN (?)", r.db.Model(&model.Follow{}).Select("target_user_id").Where("user_id = ?", viewerID)).
+		Pluck("id", &userIDs).Error
+	return userIDs, err
+}
+
+// 根据关注表重新计算所有用户的关注数和粉丝数，返回被修正的记录数
+func (r *followRepositoryImpl) RepairFollowCounts(ctx context.Context) (int64, error) {
+	var repaired int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`UPDATE users u SET follow_count = c.cnt
+			FROM (SELECT users.id, COUNT(follows.id) AS cnt FROM users
+				LEFT JOIN follows ON follows.user_id = users.id GROUP BY users.id) c
+			WHERE u.id = c.id AND u.follow_count <> c.cnt`)
+		if result.Error != nil {
+			return result.Error
+		}
+		repaired += result.RowsAffected
+
+		result = tx.Exec(`UPDATE users u SET follower_count = c.cnt
+			FROM (SELECT users.id, COUNT(follows.id) AS cnt FROM users
+				LEFT JOIN follows ON follows.target_user_id = users.id GROUP BY users.id) c
+			WHERE u.id = c.id AND u.follower_count <> c.cnt`)
+		if result.Error != nil {
+			return result.Error
+		}
+		repaired += result.RowsAffected
+		return nil
+	})
+	return repaired, err
+}
+
+type blockRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBlockRepository(db *gorm.DB) BlockRepository {
+	return &blockRepositoryImpl{db: db}
+}
+
+// 拉黑用户，已静音时升级为拉黑，同时解除双方的关注关系
+func (r *blockRepositoryImpl) Block(ctx context.Context, userID, targetUserID int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		block := &model.Block{
+			UserID:       userID,
+			TargetUserID: targetUserID,
+			Type:         model.BlockTypeBlock,
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"type", "updated_at"}),
+		}).Create(block).Error
+		if err != nil {
+			return err
+		}
+
+		if _, err := deleteFollow(tx, userID, targetUserID); err != nil {
+			return err
+		}
+		if _, err := deleteFollow(tx, targetUserID, userID); err != nil {
+			return err
+		}
+
+		return tx.Where("(user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?)",
+			userID, targetUserID, targetUserID, userID).
+			Delete(&model.FollowRequest{}).Error
+	})
+}
+
+// 静音用户，已存在屏蔽关系时返回false
+func (r *blockRepositoryImpl) Mute(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.Block{
+			UserID:       userID,
+			TargetUserID: targetUserID,
+			Type:         model.BlockTypeMute,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *blockRepositoryImpl) Delete(ctx context.Context, userID, targetUserID int64, blockType int8) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ? AND type = ?", userID, targetUserID, blockType).
+		Delete(&model.Block{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *blockRepositoryImpl) Find(ctx context.Context, userID, targetUserID int64) (*model.Block, error) {
+	var block model.Block
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+		First(&block).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// 任意一方拉黑了另一方
+func (r *blockRepositoryImpl) ExistsBlockBetween(ctx context.Context, userID1, userID2 int64) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.Block{}).
+		Where("type = ? AND ((user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?))",
+			model.BlockTypeBlock, userID1, userID2, userID2, userID1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// 对该用户隐藏内容的用户：自己拉黑或静音的用户，以及拉黑了自己的用户
+func (r *blockRepositoryImpl) FindHiddenUserIDs(ctx context.Context, userID int64) ([]int64, error) {
+	var blocks []*model.Block
+	err := r.db.WithContext(ctx).
+		Select("user_id, target_user_id").
+		Where("user_id = ? OR (target_user_id = ? AND type = ?)", userID, userID, model.BlockTypeBlock).
+		Find(&blocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(blocks))
+	for _, block := range blocks {
+		if block.UserID == userID {
+			userIDs = append(userIDs, block.TargetUserID)
+		} else {
+			userIDs = append(userIDs, block.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+func (r *blockRepositoryImpl) ListByUserID(ctx context.Context, userID int64, blockType int8, page, pageSize int) ([]*model.Block, int64, error) {
+	var blocks []*model.Block
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Block{}).
+		Where("user_id = ? AND type = ?", userID, blockType)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&blocks).Error
+	return blocks, total, err
+}
+
+func (r *blockRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo BlockRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &blockRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
+type followRequestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFollowRequestRepository(db *gorm.DB) FollowRequestRepository {
+	return &followRequestRepositoryImpl{db: db}
+}
+
+// 创建关注请求，已存在时返回false
+func (r *followRequestRepositoryImpl) Create(ctx context.Context, request *model.FollowRequest) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(request)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *followRequestRepositoryImpl) Find(ctx context.Context, userID, targetUserID int64) (*model.FollowRequest, error) {
+	var request model.FollowRequest
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+		First(&request).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *followRequestRepositoryImpl) Delete(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+		Delete(&model.FollowRequest{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 通过关注请求：删除请求并创建关注关系，请求不存在时返回false
+func (r *followRequestRepositoryImpl) Approve(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	approved := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+			Delete(&model.FollowRequest{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		approved = true
+		_, err := createFollow(tx, &model.Follow{
+			UserID:       userID,
+			TargetUserID: targetUserID,
+		})
+		return err
+	})
+	return approved, err
+}
+
+// 通过该用户收到的全部关注请求，返回新建了关注关系的申请人ID
+func (r *followRequestRepositoryImpl) ApproveAll(ctx context.Context, targetUserID int64) ([]int64, error) {
+	var approved []int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var requests []*model.FollowRequest
+		if err := tx.Where("target_user_id = ?", targetUserID).Find(&requests).Error; err != nil {
+			return err
+		}
+		if len(requests) == 0 {
+			return nil
+		}
+
+		for _, request := range requests {
+			created, err := createFollow(tx, &model.Follow{
+				UserID:       request.UserID,
+				TargetUserID: request.TargetUserID,
+			})
+			if err != nil {
+				return err
+			}
+			if created {
+				approved = append(approved, request.UserID)
+			}
+		}
+
+		return tx.Where("target_user_id = ?", targetUserID).Delete(&model.FollowRequest{}).Error
+	})
+	return approved, err
+}
+
+func (r *followRequestRepositoryImpl) ListIncoming(ctx context.Context, targetUserID int64, page, pageSize int) ([]*model.FollowRequest, int64, error) {
+	return r.list(ctx, "target_user_id = ?", targetUserID, page, pageSize)
+}
+
+func (r *followRequestRepositoryImpl) ListOutgoing(ctx context.Context, userID int64, page, pageSize int) ([]*model.FollowRequest, int64, error) {
+	return r.list(ctx, "user_id = ?", userID, page, pageSize)
+}
+
+func (r *followRequestRepositoryImpl) list(ctx context.Context, condition string, userID int64, page, pageSize int) ([]*model.FollowRequest, int64, error) {
+	var requests []*model.FollowRequest
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.FollowRequest{}).Where(condition, userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&requests).Error
+	return requests, total, err
+}
+
+func (r *followRequestRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo FollowRequestRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &followRequestRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
+
+type audienceListRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAudienceListRepository(db *gorm.DB) AudienceListRepository {
+	return &audienceListRepositoryImpl{db: db}
+}
+
+func (r *audienceListRepositoryImpl) Create(ctx context.Context, list *model.AudienceList) error {
+	return r.db.WithContext(ctx).Create(list).Error
+}
+
+func (r *audienceListRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.AudienceList, error) {
+	var list model.AudienceList
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&list).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (r *audienceListRepositoryImpl) FindCloseFriends(ctx context.Context, userID int64) (*model.AudienceList, error) {
+	var list model.AudienceList
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_close_friends = ?", userID, true).
+		First(&list).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (r *audienceListRepositoryImpl) UpdateName(ctx context.Context, id int64, name string) error {
+	return r.db.WithContext(ctx).Model(&model.AudienceList{}).
+		Where("id = ?", id).
+		Update("name", name).Error
+}
+
+// 删除名单及其成员，返回被移除的成员ID
+func (r *audienceListRepositoryImpl) Delete(ctx context.Context, id int64) ([]int64, error) {
+	var memberIDs []int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.AudienceListMember{}).
+			Where("list_id = ?", id).
+			Pluck("member_id", &memberIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("list_id = ?", id).Delete(&model.AudienceListMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.AudienceList{}).Error
+	})
+	return memberIDs, err
+}
+
+func (r *audienceListRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]*model.AudienceList, error) {
+	var lists []*model.AudienceList
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("is_close_friends DESC, created_at ASC").
+		Find(&lists).Error
+	return lists, err
+}
+
+func (r *audienceListRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.AudienceList{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// 添加成员，已在名单中的忽略，返回实际添加的数量
+func (r *audienceListRepositoryImpl) AddMembers(ctx context.Context, listID int64, memberIDs []int64) (int64, error) {
+	if len(memberIDs) == 0 {
+		return 0, nil
+	}
+
+	var added int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		members := make([]*model.AudienceListMember, len(memberIDs))
+		for i, memberID := range memberIDs {
+			members[i] = &model.AudienceListMember{
+				ListID:   listID,
+				MemberID: memberID,
+			}
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&members)
+		if result.Error != nil {
+			return result.Error
+		}
+		added = result.RowsAffected
+		if added == 0 {
+			return nil
+		}
+
+		return tx.Model(&model.AudienceList{}).
+			Where("id = ?", listID).
+			UpdateColumn("member_count", gorm.Expr("member_count + ?", added)).Error
+	})
+	return added, err
+}
+
+// 移除成员，返回实际移除的数量
+func (r *audienceListRepositoryImpl) RemoveMembers(ctx context.Context, listID int64, memberIDs []int64) (int64, error) {
+	if len(memberIDs) == 0 {
+		return 0, nil
+	}
+
+	var removed int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("list_id = ? AND member_id IN ?", listID, memberIDs).
+			Delete(&model.AudienceListMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		removed = result.RowsAffected
+		if removed == 0 {
+			return nil
+		}
+
+		return tx.Model(&model.AudienceList{}).
+			Where("id = ?", listID).
+			UpdateColumn("member_count", gorm.Expr("member_count - ?", removed)).Error
+	})
+	return removed, err
+}
+
+func (r *audienceListRepositoryImpl) ListMembers(ctx context.Context, listID int64, page, pageSize int) ([]*model.AudienceListMember, int64, error) {
+	var members []*model.AudienceListMember
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.AudienceListMember{}).Where("list_id = ?", listID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&members).Error
+	return members, total, err
+}
+
+// 用户所在的全部名单
+func (r *audienceListRepositoryImpl) FindListIDsByMember(ctx context.Context, memberID int64) ([]int64, error) {
+	var listIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.AudienceListMember{}).
+		Where("member_id = ?", memberID).
+		Pluck("list_id", &listIDs).Error
+	return listIDs, err
+}
+
+func (r *audienceListRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo AudienceListRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &audienceListRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/social/model"
 	"shortvideo/internal/social/service"
 	userService "shortvideo/internal/user/service"
 	"shortvideo/kitex_gen/common"
@@ -31,7 +32,7 @@ func (s *SocialServiceImpl) FollowAction(ctx context.Context, req *social.Follow
 		},
 	}
 
-	err = s.socialService.FollowAction(ctx, req.UserId, req.TargetUserId, req.Action)
+	pending, err := s.socialService.FollowAction(ctx, req.UserId, req.TargetUserId, req.Action)
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -39,6 +40,7 @@ func (s *SocialServiceImpl) FollowAction(ctx context.Context, req *social.Follow
 		return resp, nil
 	}
 
+	resp.IsPending = pending
 	return resp, nil
 }
 
@@ -54,7 +56,7 @@ func (s *SocialServiceImpl) GetFollowList(ctx context.Context, req *social.Follo
 		TotalCount: 0,
 	}
 
-	targetUserIDs, total, err := s.socialService.GetFollowList(ctx, req.UserId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	targetUserIDs, nextCursor, total, err := s.socialService.GetFollowList(ctx, req.UserId, req.CurrentUserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -95,6 +97,7 @@ func (s *SocialServiceImpl) GetFollowList(ctx context.Context, req *social.Follo
 				Username:      user.Username,
 				FollowCount:   user.FollowCount,
 				FollowerCount: user.FollowerCount,
+				Level:         user.Level,
 				Avatar:        avatar,
 				About:         about,
 				IsFollow:      followStatus[userID],
@@ -104,6 +107,10 @@ func (s *SocialServiceImpl) GetFollowList(ctx context.Context, req *social.Follo
 
 	resp.Users = users
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -119,7 +126,7 @@ func (s *SocialServiceImpl) GetFollowerList(ctx context.Context, req *social.Fol
 		TotalCount: 0,
 	}
 
-	followerIDs, total, err := s.socialService.GetFollowerList(ctx, req.UserId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	followerIDs, nextCursor, total, err := s.socialService.GetFollowerList(ctx, req.UserId, req.CurrentUserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -160,6 +167,7 @@ func (s *SocialServiceImpl) GetFollowerList(ctx context.Context, req *social.Fol
 				Username:      user.Username,
 				FollowCount:   user.FollowCount,
 				FollowerCount: user.FollowerCount,
+				Level:         user.Level,
 				Avatar:        avatar,
 				About:         about,
 				IsFollow:      followStatus[userID],
@@ -169,6 +177,10 @@ func (s *SocialServiceImpl) GetFollowerList(ctx context.Context, req *social.Fol
 
 	resp.Users = users
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -184,7 +196,7 @@ func (s *SocialServiceImpl) GetFriendList(ctx context.Context, req *social.Frien
 		TotalCount: 0,
 	}
 
-	friendIDs, err := s.socialService.GetFriendList(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	friendIDs, nextCursor, total, err := s.socialService.GetFriendList(ctx, req.UserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -214,6 +226,7 @@ func (s *SocialServiceImpl) GetFriendList(ctx context.Context, req *social.Frien
 				Username:      user.Username,
 				FollowCount:   user.FollowCount,
 				FollowerCount: user.FollowerCount,
+				Level:         user.Level,
 				Avatar:        avatar,
 				About:         about,
 				IsFollow:      true,
@@ -222,7 +235,11 @@ func (s *SocialServiceImpl) GetFriendList(ctx context.Context, req *social.Frien
 	}
 
 	resp.Users = users
-	resp.TotalCount = int32(len(users))
+	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
 	return resp, nil
 }
 
@@ -324,3 +341,494 @@ func (s *SocialServiceImpl) BatchCheckFollow(ctx context.Context, req *social.Ba
 	resp.FollowStatus = followStatus
 	return resp, nil
 }
+
+// BlockAction implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) BlockAction(ctx context.Context, req *social.BlockActionReq) (resp *social.BlockActionResp, err error) {
+	successMsg := "成功"
+	resp = &social.BlockActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.BlockAction(ctx, req.UserId, req.TargetUserId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// MuteAction implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) MuteAction(ctx context.Context, req *social.MuteActionReq) (resp *social.MuteActionResp, err error) {
+	successMsg := "成功"
+	resp = &social.MuteActionResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.MuteAction(ctx, req.UserId, req.TargetUserId, req.Action)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetBlockList implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) GetBlockList(ctx context.Context, req *social.BlockListReq) (resp *social.BlockListResp, err error) {
+	successMsg := "成功"
+	resp = &social.BlockListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Users:      []*common.User{},
+		TotalCount: 0,
+	}
+
+	targetUserIDs, total, err := s.socialService.GetBlockList(ctx, req.UserId, req.BlockType, int(req.Page), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	users := make([]*common.User, len(targetUserIDs))
+	for i, userID := range targetUserIDs {
+		user, err := s.userService.GetUserByID(ctx, userID)
+		if err != nil {
+			users[i] = &common.User{
+				Id: userID,
+			}
+		} else {
+			var avatar *string
+			if user.Avatar != "" {
+				avatar = &user.Avatar
+			}
+			var about *string
+			if user.About != "" {
+				about = &user.About
+			}
+
+			users[i] = &common.User{
+				Id:            user.ID,
+				Username:      user.Username,
+				FollowCount:   user.FollowCount,
+				FollowerCount: user.FollowerCount,
+				Level:         user.Level,
+				Avatar:        avatar,
+				About:         about,
+			}
+		}
+	}
+
+	resp.Users = users
+	resp.TotalCount = int32(total)
+	return resp, nil
+}
+
+// CheckBlock implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) CheckBlock(ctx context.Context, req *social.CheckBlockReq) (resp *social.CheckBlockResp, err error) {
+	successMsg := "成功"
+	resp = &social.CheckBlockResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	blocked, blockedBy, muted, err := s.socialService.CheckBlock(ctx, req.UserId, req.TargetUserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.IsBlocked = blocked
+	resp.IsBlockedBy = blockedBy
+	resp.IsMuted = muted
+	return resp, nil
+}
+
+// HandleFollowRequest implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) HandleFollowRequest(ctx context.Context, req *social.HandleFollowRequestReq) (resp *social.HandleFollowRequestResp, err error) {
+	successMsg := "成功"
+	resp = &social.HandleFollowRequestResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.HandleFollowRequest(ctx, req.UserId, req.RequesterId, req.Approve)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// CancelFollowRequest implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) CancelFollowRequest(ctx context.Context, req *social.CancelFollowRequestReq) (resp *social.CancelFollowRequestResp, err error) {
+	successMsg := "成功"
+	resp = &social.CancelFollowRequestResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.CancelFollowRequest(ctx, req.UserId, req.TargetUserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetFollowRequests implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) GetFollowRequests(ctx context.Context, req *social.FollowRequestListReq) (resp *social.FollowRequestListResp, err error) {
+	successMsg := "成功"
+	resp = &social.FollowRequestListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Requests:   []*social.FollowRequestItem{},
+		TotalCount: 0,
+	}
+
+	requests, total, err := s.socialService.GetFollowRequests(ctx, req.UserId, req.Incoming, int(req.Page), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	items := make([]*social.FollowRequestItem, len(requests))
+	for i, request := range requests {
+		item := &social.FollowRequestItem{
+			Id:           request.ID,
+			UserId:       request.UserID,
+			TargetUserId: request.TargetUserID,
+			CreateTime:   request.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+
+		//收到的请求展示申请人，发出的请求展示对方
+		otherUserID := request.TargetUserID
+		if req.Incoming {
+			otherUserID = request.UserID
+		}
+		if user, err := s.userService.GetUserByID(ctx, otherUserID); err == nil {
+			var avatar *string
+			if user.Avatar != "" {
+				avatar = &user.Avatar
+			}
+			var about *string
+			if user.About != "" {
+				about = &user.About
+			}
+
+			item.User = &common.User{
+				Id:            user.ID,
+				Username:      user.Username,
+				FollowCount:   user.FollowCount,
+				FollowerCount: user.FollowerCount,
+				Level:         user.Level,
+				Avatar:        avatar,
+				About:         about,
+			}
+		}
+		items[i] = item
+	}
+
+	resp.Requests = items
+	resp.TotalCount = int32(total)
+	return resp, nil
+}
+
+// SetAccountPrivacy implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) SetAccountPrivacy(ctx context.Context, req *social.SetAccountPrivacyReq) (resp *social.SetAccountPrivacyResp, err error) {
+	successMsg := "成功"
+	resp = &social.SetAccountPrivacyResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.SetAccountPrivacy(ctx, req.UserId, req.IsPrivate)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// CheckContentAccess implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) CheckContentAccess(ctx context.Context, req *social.CheckContentAccessReq) (resp *social.CheckContentAccessResp, err error) {
+	successMsg := "成功"
+	resp = &social.CheckContentAccessResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	canView, err := s.socialService.CanViewContent(ctx, req.ViewerId, req.OwnerId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.CanView = canView
+	return resp, nil
+}
+
+// CreateAudienceList implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) CreateAudienceList(ctx context.Context, req *social.CreateAudienceListReq) (resp *social.CreateAudienceListResp, err error) {
+	successMsg := "成功"
+	resp = &social.CreateAudienceListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	list, err := s.socialService.CreateAudienceList(ctx, req.UserId, req.Name)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.List = convertAudienceList(list)
+	return resp, nil
+}
+
+// RenameAudienceList implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) RenameAudienceList(ctx context.Context, req *social.RenameAudienceListReq) (resp *social.RenameAudienceListResp, err error) {
+	successMsg := "成功"
+	resp = &social.RenameAudienceListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.RenameAudienceList(ctx, req.UserId, req.ListId, req.Name)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// DeleteAudienceList implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) DeleteAudienceList(ctx context.Context, req *social.DeleteAudienceListReq) (resp *social.DeleteAudienceListResp, err error) {
+	successMsg := "成功"
+	resp = &social.DeleteAudienceListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.DeleteAudienceList(ctx, req.UserId, req.ListId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetAudienceLists implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) GetAudienceLists(ctx context.Context, req *social.AudienceListsReq) (resp *social.AudienceListsResp, err error) {
+	successMsg := "成功"
+	resp = &social.AudienceListsResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Lists: []*social.AudienceList{},
+	}
+
+	lists, err := s.socialService.GetAudienceLists(ctx, req.UserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, list := range lists {
+		resp.Lists = append(resp.Lists, convertAudienceList(list))
+	}
+	return resp, nil
+}
+
+// UpdateAudienceMembers implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) UpdateAudienceMembers(ctx context.Context, req *social.UpdateAudienceMembersReq) (resp *social.UpdateAudienceMembersResp, err error) {
+	successMsg := "成功"
+	resp = &social.UpdateAudienceMembersResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	changed, err := s.socialService.UpdateAudienceMembers(ctx, req.UserId, req.ListId, req.MemberIds, req.Add)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.ChangedCount = changed
+	return resp, nil
+}
+
+// GetAudienceMembers implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) GetAudienceMembers(ctx context.Context, req *social.AudienceMembersReq) (resp *social.AudienceMembersResp, err error) {
+	successMsg := "成功"
+	resp = &social.AudienceMembersResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Users:      []*common.User{},
+		TotalCount: 0,
+	}
+
+	memberIDs, total, err := s.socialService.GetAudienceMembers(ctx, req.UserId, req.ListId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	users := make([]*common.User, len(memberIDs))
+	for i, memberID := range memberIDs {
+		user, err := s.userService.GetUserByID(ctx, memberID)
+		if err != nil {
+			users[i] = &common.User{
+				Id: memberID,
+			}
+			continue
+		}
+
+		var avatar *string
+		if user.Avatar != "" {
+			avatar = &user.Avatar
+		}
+		var about *string
+		if user.About != "" {
+			about = &user.About
+		}
+
+		users[i] = &common.User{
+			Id:            user.ID,
+			Username:      user.Username,
+			FollowCount:   user.FollowCount,
+			FollowerCount: user.FollowerCount,
+			Level:         user.Level,
+			Avatar:        avatar,
+			About:         about,
+		}
+	}
+
+	resp.Users = users
+	resp.TotalCount = int32(total)
+	return resp, nil
+}
+
+// ValidateAudienceList implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) ValidateAudienceList(ctx context.Context, req *social.ValidateAudienceListReq) (resp *social.ValidateAudienceListResp, err error) {
+	successMsg := "成功"
+	resp = &social.ValidateAudienceListResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.socialService.ValidateAudienceList(ctx, req.UserId, req.ListId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// BatchCheckAudience implements the SocialServiceImpl interface.
+func (s *SocialServiceImpl) BatchCheckAudience(ctx context.Context, req *social.BatchCheckAudienceReq) (resp *social.BatchCheckAudienceResp, err error) {
+	successMsg := "成功"
+	resp = &social.BatchCheckAudienceResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		MemberListIds: []int64{},
+	}
+
+	result, err := s.socialService.BatchCheckAudience(ctx, req.ViewerId, req.ListIds)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	for _, listID := range req.ListIds {
+		if result[listID] {
+			resp.MemberListIds = append(resp.MemberListIds, listID)
+		}
+	}
+	return resp, nil
+}
+
+func convertAudienceList(list *model.AudienceList) *social.AudienceList {
+	return &social.AudienceList{
+		Id:             list.ID,
+		Name:           list.Name,
+		IsCloseFriends: list.IsCloseFriends,
+		MemberCount:    list.MemberCount,
+		CreateTime:     list.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -6,8 +6,8 @@ import (
 
 type Follow struct {
 	ID           int64     `gorm:"primaryKey;autoIncrement;comment:关注ID"`
-	UserID       int64     `gorm:"index;not null;comment:用户ID"`
-	TargetUserID int64     `gorm:"index;not null;comment:被关注用户ID"`
+	UserID       int64     `gorm:"uniqueIndex:idx_follow_user_target;index;not null;comment:用户ID"`
+	TargetUserID int64     `gorm:"uniqueIndex:idx_follow_user_target;index;not null;comment:被关注用户ID"`
 	CreatedAt    time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt    time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
@@ -15,3 +15,64 @@ type Follow struct {
 func (Follow) TableName() string {
 	return "follows"
 }
+
+// 屏蔽类型
+const (
+	//拉黑：双向禁止关注、私信、评论、弹幕，互相隐藏内容
+	BlockTypeBlock int8 = 1
+	//静音：只对自己隐藏对方的内容，对方无感知
+	BlockTypeMute int8 = 2
+)
+
+// 用户屏蔽关系，同一对用户只保留一条，拉黑优先于静音
+type Block struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement;comment:屏蔽ID"`
+	UserID       int64     `gorm:"uniqueIndex:idx_block_user_target;not null;comment:用户ID"`
+	TargetUserID int64     `gorm:"uniqueIndex:idx_block_user_target;index;not null;comment:被屏蔽用户ID"`
+	Type         int8      `gorm:"not null;comment:屏蔽类型(1拉黑/2静音)"`
+	CreatedAt    time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (Block) TableName() string {
+	return "blocks"
+}
+
+// 关注私密账号时的待处理请求，通过后转为关注关系并删除请求
+type FollowRequest struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement;comment:关注请求ID"`
+	UserID       int64     `gorm:"uniqueIndex:idx_follow_request_user_target;not null;comment:申请用户ID"`
+	TargetUserID int64     `gorm:"uniqueIndex:idx_follow_request_user_target;index;not null;comment:被申请用户ID"`
+	CreatedAt    time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (FollowRequest) TableName() string {
+	return "follow_requests"
+}
+
+// 用户自定义的可见名单，每个用户另有一个系统创建的亲密好友名单
+type AudienceList struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement;comment:名单ID"`
+	UserID         int64     `gorm:"index;not null;comment:创建者ID"`
+	Name           string    `gorm:"size:50;not null;comment:名单名称"`
+	IsCloseFriends bool      `gorm:"default:false;comment:是否亲密好友名单"`
+	MemberCount    int64     `gorm:"default:0;comment:成员数"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (AudienceList) TableName() string {
+	return "audience_lists"
+}
+
+type AudienceListMember struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	ListID    int64     `gorm:"uniqueIndex:idx_audience_member_list_user;not null;comment:名单ID"`
+	MemberID  int64     `gorm:"uniqueIndex:idx_audience_member_list_user;index;not null;comment:成员用户ID"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (AudienceListMember) TableName() string {
+	return "audience_list_members"
+}
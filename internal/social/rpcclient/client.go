@@ -0,0 +1,171 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+
+	"shortvideo/kitex_gen/common"
+	"shortvideo/kitex_gen/social"
+	"shortvideo/kitex_gen/social/socialservice"
+	"shortvideo/pkg/config"
+	"shortvideo/pkg/logger"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/transport"
+	registry_etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+// 创建社交服务客户端，caller为调用方服务名
+func New(caller string) (socialservice.Client, error) {
+	cfg := config.Get()
+
+	resolver, err := registry_etcd.NewEtcdResolver(cfg.Etcd.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return socialservice.NewClient(
+		"social",
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithResolver(resolver),
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: caller,
+		}),
+	)
+}
+
+// 检查当前用户能否查看私密账号的内容，查看自己或未配置客户端时直接放行，调用失败时按不可见处理
+func CanViewContent(ctx context.Context, cli socialservice.Client, viewerID, ownerID int64) bool {
+	if cli == nil || viewerID == ownerID {
+		return true
+	}
+
+	resp, err := cli.CheckContentAccess(ctx, &social.CheckContentAccessReq{
+		ViewerId: viewerID,
+		OwnerId:  ownerID,
+	})
+	if err != nil {
+		logger.Warn("检查内容访问权限失败",
+			logger.ErrorField(err),
+			logger.Int64Field("viewer_id", viewerID),
+			logger.Int64Field("owner_id", ownerID))
+		return false
+	}
+	if resp.BaseResp == nil || resp.BaseResp.StatusCode != 0 {
+		return false
+	}
+	return resp.CanView
+}
+
+// 校验发布者是否可以使用该名单作为可见范围，listID为0表示公开
+func ValidateAudienceList(ctx context.Context, cli socialservice.Client, userID, listID int64) error {
+	if listID == 0 {
+		return nil
+	}
+	if cli == nil {
+		return errors.New("社交服务不可用")
+	}
+
+	resp, err := cli.ValidateAudienceList(ctx, &social.ValidateAudienceListReq{
+		UserId: userID,
+		ListId: listID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp != nil && resp.BaseResp.StatusCode != 0 {
+		return errors.New(resp.BaseResp.GetMsg())
+	}
+	return nil
+}
+
+// 批量查询当前用户所在的名单，调用失败时按不在任何名单处理
+func checkAudience(ctx context.Context, cli socialservice.Client, viewerID int64, listIDs []int64) map[int64]bool {
+	result := make(map[int64]bool, len(listIDs))
+	if cli == nil || viewerID <= 0 || len(listIDs) == 0 {
+		return result
+	}
+
+	resp, err := cli.BatchCheckAudience(ctx, &social.BatchCheckAudienceReq{
+		ViewerId: viewerID,
+		ListIds:  listIDs,
+	})
+	if err != nil {
+		logger.Warn("批量检查可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("viewer_id", viewerID))
+		return result
+	}
+	if resp.BaseResp == nil || resp.BaseResp.StatusCode != 0 {
+		return result
+	}
+	for _, listID := range resp.MemberListIds {
+		result[listID] = true
+	}
+	return result
+}
+
+// 检查当前用户能否查看限定名单可见的内容
+func CanViewAudience(ctx context.Context, cli socialservice.Client, viewerID, ownerID, listID int64) bool {
+	if listID == 0 || viewerID == ownerID {
+		return true
+	}
+	return checkAudience(ctx, cli, viewerID, []int64{listID})[listID]
+}
+
+// 过滤当前用户不在可见名单中的视频，一页视频只发起一次批量检查
+func FilterAudienceVideos(ctx context.Context, cli socialservice.Client, viewerID int64, videos []*common.Video) []*common.Video {
+	listIDs := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, video := range videos {
+		listID := video.GetAudienceListId()
+		if listID == 0 || video.AuthorId == viewerID || seen[listID] {
+			continue
+		}
+		seen[listID] = true
+		listIDs = append(listIDs, listID)
+	}
+	if len(listIDs) == 0 {
+		return videos
+	}
+
+	allowed := checkAudience(ctx, cli, viewerID, listIDs)
+	filtered := make([]*common.Video, 0, len(videos))
+	for _, video := range videos {
+		listID := video.GetAudienceListId()
+		if listID != 0 && video.AuthorId != viewerID && !allowed[listID] {
+			continue
+		}
+		filtered = append(filtered, video)
+	}
+	return filtered
+}
+
+// 过滤当前用户不在可见名单中的直播间
+func FilterAudienceRooms(ctx context.Context, cli socialservice.Client, viewerID int64, rooms []*common.LiveRoom) []*common.LiveRoom {
+	listIDs := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, room := range rooms {
+		listID := room.GetAudienceListId()
+		if listID == 0 || room.HostId == viewerID || seen[listID] {
+			continue
+		}
+		seen[listID] = true
+		listIDs = append(listIDs, listID)
+	}
+	if len(listIDs) == 0 {
+		return rooms
+	}
+
+	allowed := checkAudience(ctx, cli, viewerID, listIDs)
+	filtered := make([]*common.LiveRoom, 0, len(rooms))
+	for _, room := range rooms {
+		listID := room.GetAudienceListId()
+		if listID != 0 && room.HostId != viewerID && !allowed[listID] {
+			continue
+		}
+		filtered = append(filtered, room)
+	}
+	return filtered
+}
@@ -5,12 +5,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	messageDao "shortvideo/internal/message/dao"
+	messageModel "shortvideo/internal/message/model"
 	"shortvideo/internal/social/dao"
 	"shortvideo/internal/social/model"
 	userService "shortvideo/internal/user/service"
+	"shortvideo/internal/user/settings"
+	"shortvideo/pkg/cache"
 	"shortvideo/pkg/logger"
 	"shortvideo/pkg/mq"
+	"shortvideo/pkg/pagination"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
@@ -21,17 +28,48 @@ var (
 	ErrAlreadyFollowing     = errors.New("已经关注过了")
 	ErrNotFollowing         = errors.New("没有关注过")
 	ErrInternalServer       = errors.New("服务器内部错误")
+	ErrCannotBlockYourself  = errors.New("不能拉黑或静音自己")
+	ErrAlreadyBlocked       = errors.New("已经拉黑过了")
+	ErrNotBlocked           = errors.New("没有拉黑过")
+	ErrAlreadyMuted         = errors.New("已经静音过了")
+	ErrNotMuted             = errors.New("没有静音过")
+	ErrUserBlocked          = errors.New("由于拉黑关系无法进行此操作")
+	ErrInvalidBlockType     = errors.New("无效的屏蔽类型")
+	ErrFollowRequestExists  = errors.New("已经发送过关注请求")
+	ErrFollowRequestMissing = errors.New("关注请求不存在")
+	ErrPrivateAccount       = errors.New("私密账号的内容仅对关注者可见")
+	ErrFollowListHidden     = errors.New("该用户隐藏了关注和粉丝列表")
+	ErrAudienceListNotFound = errors.New("可见名单不存在")
+	ErrAudienceListLimit    = errors.New("可见名单数量已达上限")
+	ErrAudienceMemberLimit  = errors.New("名单成员数量已达上限")
+	ErrInvalidAudienceName  = errors.New("名单名称无效")
+	ErrCloseFriendsReadonly = errors.New("亲密好友名单不能重命名或删除")
+)
+
+const (
+	//每个用户最多创建的可见名单数，包含亲密好友名单
+	maxAudienceLists = 20
+	//每个名单最多的成员数
+	maxAudienceListMembers = 5000
+	maxAudienceNameLength  = 50
+	closeFriendsListName   = "亲密好友"
+	//用户所在名单集合缓存时间
+	audienceCacheTTL = 30 * time.Minute
+	//集合中标记已预热的占位成员，名单ID不会为0
+	audienceCacheSentinel = "0"
+	//注销清理时每批处理的关系数量
+	purgeBatchSize = 100
 )
 
 type SocialService interface {
-	//关注操作
-	FollowAction(ctx context.Context, userID, targetUserID int64, action bool) error
+	//关注操作，关注私密账号时返回true表示已发送关注请求
+	FollowAction(ctx context.Context, userID, targetUserID int64, action bool) (bool, error)
 	//获取关注列表
-	GetFollowList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]int64, int64, error)
+	GetFollowList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error)
 	//获取粉丝列表
-	GetFollowerList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]int64, int64, error)
+	GetFollowerList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error)
 	//获取好友列表
-	GetFriendList(ctx context.Context, userID int64, page, pageSize int) ([]int64, error)
+	GetFriendList(ctx context.Context, userID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error)
 	//检查关注状态
 	CheckFollow(ctx context.Context, userID, targetUserID int64) (bool, error)
 	//检查互相关注状态
@@ -40,37 +78,92 @@ type SocialService interface {
 	GetFollowStats(ctx context.Context, userID int64) (int64, int64, int64, error)
 	//批量检查关注状态
 	BatchCheckFollow(ctx context.Context, userID int64, targetUserIDs []int64) (map[int64]bool, error)
+	//拉黑/取消拉黑
+	BlockAction(ctx context.Context, userID, targetUserID int64, action bool) error
+	//静音/取消静音
+	MuteAction(ctx context.Context, userID, targetUserID int64, action bool) error
+	//获取拉黑或静音列表
+	GetBlockList(ctx context.Context, userID int64, blockType int8, page, pageSize int) ([]int64, int64, error)
+	//检查屏蔽状态：是否拉黑对方、是否被对方拉黑、是否静音对方
+	CheckBlock(ctx context.Context, userID, targetUserID int64) (bool, bool, bool, error)
+	//任意一方拉黑了另一方
+	IsBlockedBetween(ctx context.Context, userID1, userID2 int64) (bool, error)
+	//获取需要对该用户隐藏内容的用户
+	GetHiddenUserIDs(ctx context.Context, userID int64) ([]int64, error)
+	//通过或拒绝关注请求
+	HandleFollowRequest(ctx context.Context, userID, requesterID int64, approve bool) error
+	//撤回自己发出的关注请求
+	CancelFollowRequest(ctx context.Context, userID, targetUserID int64) error
+	//获取收到或发出的关注请求
+	GetFollowRequests(ctx context.Context, userID int64, incoming bool, page, pageSize int) ([]*model.FollowRequest, int64, error)
+	//设置私密账号，转为公开时自动通过待处理的关注请求
+	SetAccountPrivacy(ctx context.Context, userID int64, isPrivate bool) error
+	//检查是否可以查看用户的视频、点赞和关注列表
+	CanViewContent(ctx context.Context, viewerID, ownerID int64) (bool, error)
+	//可见名单管理
+	CreateAudienceList(ctx context.Context, userID int64, name string) (*model.AudienceList, error)
+	RenameAudienceList(ctx context.Context, userID, listID int64, name string) error
+	DeleteAudienceList(ctx context.Context, userID, listID int64) error
+	GetAudienceLists(ctx context.Context, userID int64) ([]*model.AudienceList, error)
+	UpdateAudienceMembers(ctx context.Context, userID, listID int64, memberIDs []int64, add bool) (int64, error)
+	GetAudienceMembers(ctx context.Context, userID, listID int64, page, pageSize int) ([]int64, int64, error)
+	//检查名单是否属于该用户，用于设置视频和直播间的可见范围
+	ValidateAudienceList(ctx context.Context, userID, listID int64) error
+	//批量检查用户是否在名单中
+	BatchCheckAudience(ctx context.Context, viewerID int64, listIDs []int64) (map[int64]bool, error)
+	//根据关注表修复关注数和粉丝数
+	RepairFollowCounts(ctx context.Context) (int64, error)
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
 	//事务支持
 	WithTransaction(ctx context.Context, fn func(txService SocialService) error) error
 }
 
 type socialServiceImpl struct {
-	followRepo    dao.FollowRepository
-	userService   userService.UserService
-	kafkaProducer *mq.Producer
+	followRepo        dao.FollowRepository
+	blockRepo         dao.BlockRepository
+	followRequestRepo dao.FollowRequestRepository
+	audienceRepo      dao.AudienceListRepository
+	notificationRepo  messageDao.NotificationRepository
+	userService       userService.UserService
+	settings          settings.Client
+	kafkaProducer     *mq.Producer
+	cache             cache.Cache
 }
 
 func NewSocialService(
 	followRepo dao.FollowRepository,
+	blockRepo dao.BlockRepository,
+	followRequestRepo dao.FollowRequestRepository,
+	audienceRepo dao.AudienceListRepository,
+	notificationRepo messageDao.NotificationRepository,
 	userService userService.UserService,
+	settings settings.Client,
 	kafkaProducer *mq.Producer,
+	cache cache.Cache,
 ) SocialService {
 	return &socialServiceImpl{
-		followRepo:    followRepo,
-		userService:   userService,
-		kafkaProducer: kafkaProducer,
+		followRepo:        followRepo,
+		blockRepo:         blockRepo,
+		followRequestRepo: followRequestRepo,
+		audienceRepo:      audienceRepo,
+		notificationRepo:  notificationRepo,
+		userService:       userService,
+		settings:          settings,
+		kafkaProducer:     kafkaProducer,
+		cache:             cache,
 	}
 }
 
 // 关注操作
-func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUserID int64, action bool) error {
+func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUserID int64, action bool) (bool, error) {
 	logger.Info("关注操作请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("target_user_id", targetUserID),
 		logger.BoolField("action", action))
 
 	if userID == targetUserID {
-		return ErrCannotFollowYourself
+		return false, ErrCannotFollowYourself
 	}
 
 	_, err := s.userService.GetUserByID(ctx, userID)
@@ -78,15 +171,15 @@ func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUser
 		logger.Error("获取用户信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return ErrUserNotFound
+		return false, ErrUserNotFound
 	}
 
-	_, err = s.userService.GetUserByID(ctx, targetUserID)
+	targetUser, err := s.userService.GetUserByID(ctx, targetUserID)
 	if err != nil {
 		logger.Error("获取目标用户信息失败",
 			logger.ErrorField(err),
 			logger.Int64Field("target_user_id", targetUserID))
-		return ErrUserNotFound
+		return false, ErrUserNotFound
 	}
 
 	exists, err := s.followRepo.Exists(ctx, userID, targetUserID)
@@ -95,12 +188,25 @@ func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUser
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID),
 			logger.Int64Field("target_user_id", targetUserID))
-		return ErrInternalServer
+		return false, ErrInternalServer
 	}
 
 	if action {
 		if exists {
-			return ErrAlreadyFollowing
+			return false, ErrAlreadyFollowing
+		}
+
+		blocked, err := s.IsBlockedBetween(ctx, userID, targetUserID)
+		if err != nil {
+			return false, err
+		}
+		if blocked {
+			return false, ErrUserBlocked
+		}
+
+		//私密账号需要对方同意后才建立关注关系
+		if targetUser.IsPrivate {
+			return true, s.createFollowRequest(ctx, userID, targetUserID)
 		}
 
 		follow := &model.Follow{
@@ -108,27 +214,38 @@ func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUser
 			TargetUserID: targetUserID,
 		}
 
-		if err := s.followRepo.Create(ctx, follow); err != nil {
+		created, err := s.followRepo.Create(ctx, follow)
+		if err != nil {
 			logger.Error("创建关注记录失败",
 				logger.ErrorField(err),
 				logger.Int64Field("user_id", userID),
 				logger.Int64Field("target_user_id", targetUserID))
-			return ErrFollowFailed
+			return false, ErrFollowFailed
+		}
+		//并发的重复关注由唯一索引拦截
+		if !created {
+			return false, ErrAlreadyFollowing
 		}
 	} else {
 		if !exists {
-			return ErrNotFollowing
+			return false, ErrNotFollowing
 		}
 
-		if err := s.followRepo.Delete(ctx, userID, targetUserID); err != nil {
+		deleted, err := s.followRepo.Delete(ctx, userID, targetUserID)
+		if err != nil {
 			logger.Error("删除关注记录失败",
 				logger.ErrorField(err),
 				logger.Int64Field("user_id", userID),
 				logger.Int64Field("target_user_id", targetUserID))
-			return ErrUnfollowFailed
+			return false, ErrUnfollowFailed
+		}
+		if !deleted {
+			return false, ErrNotFollowing
 		}
 	}
 
+	s.invalidateFollowCache(ctx, userID, targetUserID)
+
 	if s.kafkaProducer != nil {
 		eventData := map[string]interface{}{
 			"user_id":        userID,
@@ -145,23 +262,84 @@ func (s *socialServiceImpl) FollowAction(ctx context.Context, userID, targetUser
 		logger.Int64Field("target_user_id", targetUserID),
 		logger.BoolField("action", action))
 
-	return nil
+	return false, nil
+}
+
+// 关注关系变化后清理双方的用户信息缓存和列表总数缓存，关注数和粉丝数随用户信息一起缓存
+func (s *socialServiceImpl) invalidateFollowCache(ctx context.Context, userID, targetUserID int64) {
+	if s.cache == nil {
+		return
+	}
+	s.cache.MDelete(ctx, []string{
+		cache.GenerateUserKey(userID),
+		cache.GenerateUserKey(targetUserID),
+		pagination.TotalKey("following", userID),
+		pagination.TotalKey("followers", targetUserID),
+	})
+}
+
+// 根据关注表修复所有用户的关注数和粉丝数
+func (s *socialServiceImpl) RepairFollowCounts(ctx context.Context) (int64, error) {
+	repaired, err := s.followRepo.RepairFollowCounts(ctx)
+	if err != nil {
+		logger.Error("修复关注计数失败", logger.ErrorField(err))
+		return 0, ErrInternalServer
+	}
+
+	logger.Info("修复关注计数完成", logger.Int64Field("repaired", repaired))
+	return repaired, nil
 }
 
 // 获取关注列表
-func (s *socialServiceImpl) GetFollowList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]int64, int64, error) {
+func (s *socialServiceImpl) GetFollowList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error) {
 	logger.Info("获取关注列表请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	follows, total, err := s.followRepo.FindFollowing(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "following", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	canView, err := s.CanViewContent(ctx, currentUserID, userID)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if !canView {
+		return nil, "", 0, ErrPrivateAccount
+	}
+	if err := s.checkFollowListVisible(ctx, currentUserID, userID); err != nil {
+		return nil, "", 0, err
+	}
+
+	follows, err := s.followRepo.FindFollowing(ctx, userID, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("获取关注列表失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	follows, nextCursor := pagination.Paginate(follows, pageSize, pageScope, func(follow *model.Follow) pagination.Cursor {
+		return pagination.Cursor{SortKey: follow.CreatedAt.UnixMicro(), ID: follow.ID}
+	})
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("following", userID), func() (int64, error) {
+			return s.followRepo.CountFollowing(ctx, userID)
+		})
+		if err != nil {
+			logger.Error("统计关注数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	targetUserIDs := make([]int64, len(follows))
@@ -172,25 +350,61 @@ func (s *socialServiceImpl) GetFollowList(ctx context.Context, userID, currentUs
 	logger.Info("获取关注列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("follow_count", len(targetUserIDs)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return targetUserIDs, total, nil
+	return targetUserIDs, nextCursor, total, nil
 }
 
 // 获取粉丝列表
-func (s *socialServiceImpl) GetFollowerList(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]int64, int64, error) {
+func (s *socialServiceImpl) GetFollowerList(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error) {
 	logger.Info("获取粉丝列表请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	follows, total, err := s.followRepo.FindFollowers(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "followers", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	canView, err := s.CanViewContent(ctx, currentUserID, userID)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if !canView {
+		return nil, "", 0, ErrPrivateAccount
+	}
+	if err := s.checkFollowListVisible(ctx, currentUserID, userID); err != nil {
+		return nil, "", 0, err
+	}
+
+	follows, err := s.followRepo.FindFollowers(ctx, userID, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("获取粉丝列表失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	follows, nextCursor := pagination.Paginate(follows, pageSize, pageScope, func(follow *model.Follow) pagination.Cursor {
+		return pagination.Cursor{SortKey: follow.CreatedAt.UnixMicro(), ID: follow.ID}
+	})
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("followers", userID), func() (int64, error) {
+			return s.followRepo.CountFollowers(ctx, userID)
+		})
+		if err != nil {
+			logger.Error("统计粉丝数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	followerIDs := make([]int64, len(follows))
@@ -201,36 +415,78 @@ func (s *socialServiceImpl) GetFollowerList(ctx context.Context, userID, current
 	logger.Info("获取粉丝列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("follower_count", len(followerIDs)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
+
+	return followerIDs, nextCursor, total, nil
+}
 
-	return followerIDs, total, nil
+// 用户可以在设置中对他人隐藏关注和粉丝列表，本人始终可见
+func (s *socialServiceImpl) checkFollowListVisible(ctx context.Context, viewerID, ownerID int64) error {
+	if s.settings == nil || viewerID == ownerID {
+		return nil
+	}
+
+	ownerSettings, err := s.settings.Get(ctx, ownerID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if !ownerSettings.FollowListVisible {
+		return ErrFollowListHidden
+	}
+	return nil
 }
 
 // 获取好友列表
-func (s *socialServiceImpl) GetFriendList(ctx context.Context, userID int64, page, pageSize int) ([]int64, error) {
+func (s *socialServiceImpl) GetFriendList(ctx context.Context, userID int64, cursor string, pageSize int, needTotal bool) ([]int64, string, int64, error) {
 	logger.Info("获取好友列表请求",
 		logger.Int64Field("user_id", userID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	follows, err := s.followRepo.FindFriends(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "friends", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	follows, err := s.followRepo.FindFriends(ctx, userID, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("获取好友列表失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID))
-		return nil, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
 	}
 
+	follows, nextCursor := pagination.Paginate(follows, pageSize, pageScope, func(follow *model.Follow) pagination.Cursor {
+		return pagination.Cursor{SortKey: follow.CreatedAt.UnixMicro(), ID: follow.ID}
+	})
+
 	friendIDs := make([]int64, len(follows))
 	for i, follow := range follows {
 		friendIDs[i] = follow.TargetUserID
 	}
 
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("friends", userID), func() (int64, error) {
+			return s.followRepo.CountFriends(ctx, userID)
+		})
+		if err != nil {
+			logger.Error("统计好友数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
+	}
+
 	logger.Info("获取好友列表成功",
 		logger.Int64Field("user_id", userID),
-		logger.IntField("friend_count", len(friendIDs)))
+		logger.IntField("friend_count", len(friendIDs)),
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return friendIDs, nil
+	return friendIDs, nextCursor, total, nil
 }
 
 // 检查关注状态
@@ -349,15 +605,961 @@ func (s *socialServiceImpl) BatchCheckFollow(ctx context.Context, userID int64,
 	return result, nil
 }
 
-// 事务支持
-func (s *socialServiceImpl) WithTransaction(ctx context.Context, fn func(txService SocialService) error) error {
-	return s.followRepo.WithTransaction(ctx, func(txFollowRepo dao.FollowRepository) error {
-		txService := &socialServiceImpl{
-			followRepo:    txFollowRepo,
-			userService:   s.userService,
-			kafkaProducer: s.kafkaProducer,
+// 拉黑/取消拉黑，拉黑时解除双方的关注关系
+func (s *socialServiceImpl) BlockAction(ctx context.Context, userID, targetUserID int64, action bool) error {
+	logger.Info("拉黑操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID),
+		logger.BoolField("action", action))
+
+	if userID == targetUserID {
+		return ErrCannotBlockYourself
+	}
+
+	if action {
+		if _, err := s.userService.GetUserByID(ctx, targetUserID); err != nil {
+			logger.Error("获取目标用户信息失败",
+				logger.ErrorField(err),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrUserNotFound
 		}
 
-		return fn(txService)
+		existing, err := s.blockRepo.Find(ctx, userID, targetUserID)
+		if err != nil {
+			logger.Error("查询屏蔽关系失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+		if existing != nil && existing.Type == model.BlockTypeBlock {
+			return ErrAlreadyBlocked
+		}
+
+		if err := s.blockRepo.Block(ctx, userID, targetUserID); err != nil {
+			logger.Error("拉黑用户失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+		//拉黑会解除双方的关注关系
+		s.invalidateFollowCache(ctx, userID, targetUserID)
+		s.invalidateFollowCache(ctx, targetUserID, userID)
+	} else {
+		deleted, err := s.blockRepo.Delete(ctx, userID, targetUserID, model.BlockTypeBlock)
+		if err != nil {
+			logger.Error("取消拉黑失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+		if !deleted {
+			return ErrNotBlocked
+		}
+	}
+
+	if s.kafkaProducer != nil {
+		eventData := map[string]interface{}{
+			"type":           "block",
+			"user_id":        userID,
+			"target_user_id": targetUserID,
+			"action":         action,
+			"created_at":     time.Now(),
+		}
+		data, _ := json.Marshal(eventData)
+		s.kafkaProducer.SendSocialEvent(ctx, fmt.Sprintf("%d", userID), data)
+	}
+
+	logger.Info("拉黑操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 静音/取消静音，只对自己隐藏对方内容，不通知对方
+func (s *socialServiceImpl) MuteAction(ctx context.Context, userID, targetUserID int64, action bool) error {
+	logger.Info("静音操作请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID),
+		logger.BoolField("action", action))
+
+	if userID == targetUserID {
+		return ErrCannotBlockYourself
+	}
+
+	if action {
+		if _, err := s.userService.GetUserByID(ctx, targetUserID); err != nil {
+			logger.Error("获取目标用户信息失败",
+				logger.ErrorField(err),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrUserNotFound
+		}
+
+		existing, err := s.blockRepo.Find(ctx, userID, targetUserID)
+		if err != nil {
+			logger.Error("查询屏蔽关系失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+		if existing != nil {
+			if existing.Type == model.BlockTypeBlock {
+				return ErrAlreadyBlocked
+			}
+			return ErrAlreadyMuted
+		}
+
+		if _, err := s.blockRepo.Mute(ctx, userID, targetUserID); err != nil {
+			logger.Error("静音用户失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+	} else {
+		deleted, err := s.blockRepo.Delete(ctx, userID, targetUserID, model.BlockTypeMute)
+		if err != nil {
+			logger.Error("取消静音失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("target_user_id", targetUserID))
+			return ErrInternalServer
+		}
+		if !deleted {
+			return ErrNotMuted
+		}
+	}
+
+	logger.Info("静音操作成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID),
+		logger.BoolField("action", action))
+
+	return nil
+}
+
+// 获取拉黑或静音列表
+func (s *socialServiceImpl) GetBlockList(ctx context.Context, userID int64, blockType int8, page, pageSize int) ([]int64, int64, error) {
+	logger.Info("获取屏蔽列表请求",
+		logger.Int64Field("user_id", userID),
+		logger.AnyField("block_type", blockType))
+
+	if blockType != model.BlockTypeBlock && blockType != model.BlockTypeMute {
+		return nil, 0, ErrInvalidBlockType
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	blocks, total, err := s.blockRepo.ListByUserID(ctx, userID, blockType, page, pageSize)
+	if err != nil {
+		logger.Error("获取屏蔽列表失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, 0, ErrInternalServer
+	}
+
+	userIDs := make([]int64, 0, len(blocks))
+	for _, block := range blocks {
+		userIDs = append(userIDs, block.TargetUserID)
+	}
+
+	logger.Info("获取屏蔽列表成功",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("count", len(userIDs)),
+		logger.Int64Field("total", total))
+
+	return userIDs, total, nil
+}
+
+// 检查屏蔽状态
+func (s *socialServiceImpl) CheckBlock(ctx context.Context, userID, targetUserID int64) (bool, bool, bool, error) {
+	mine, err := s.blockRepo.Find(ctx, userID, targetUserID)
+	if err != nil {
+		logger.Error("查询屏蔽关系失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("target_user_id", targetUserID))
+		return false, false, false, ErrInternalServer
+	}
+
+	theirs, err := s.blockRepo.Find(ctx, targetUserID, userID)
+	if err != nil {
+		logger.Error("查询屏蔽关系失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", targetUserID),
+			logger.Int64Field("target_user_id", userID))
+		return false, false, false, ErrInternalServer
+	}
+
+	blocked := mine != nil && mine.Type == model.BlockTypeBlock
+	muted := mine != nil && mine.Type == model.BlockTypeMute
+	//静音对被静音方不可见
+	blockedBy := theirs != nil && theirs.Type == model.BlockTypeBlock
+
+	return blocked, blockedBy, muted, nil
+}
+
+// 任意一方拉黑了另一方
+func (s *socialServiceImpl) IsBlockedBetween(ctx context.Context, userID1, userID2 int64) (bool, error) {
+	if userID1 <= 0 || userID2 <= 0 || userID1 == userID2 {
+		return false, nil
+	}
+
+	blocked, err := s.blockRepo.ExistsBlockBetween(ctx, userID1, userID2)
+	if err != nil {
+		logger.Error("检查拉黑关系失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id1", userID1),
+			logger.Int64Field("user_id2", userID2))
+		return false, ErrInternalServer
+	}
+	return blocked, nil
+}
+
+// 获取需要对该用户隐藏内容的用户
+func (s *socialServiceImpl) GetHiddenUserIDs(ctx context.Context, userID int64) ([]int64, error) {
+	if userID <= 0 {
+		return nil, nil
+	}
+
+	userIDs, err := s.blockRepo.FindHiddenUserIDs(ctx, userID)
+	if err != nil {
+		logger.Error("查询屏蔽用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return userIDs, nil
+}
+
+// 创建关注私密账号的请求并通知对方
+func (s *socialServiceImpl) createFollowRequest(ctx context.Context, userID, targetUserID int64) error {
+	created, err := s.followRequestRepo.Create(ctx, &model.FollowRequest{
+		UserID:       userID,
+		TargetUserID: targetUserID,
 	})
+	if err != nil {
+		logger.Error("创建关注请求失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("target_user_id", targetUserID))
+		return ErrFollowFailed
+	}
+	if !created {
+		return ErrFollowRequestExists
+	}
+
+	requester, err := s.userService.GetUserByID(ctx, userID)
+	if err == nil {
+		s.notify(ctx, targetUserID, "新的关注请求",
+			fmt.Sprintf("%s 请求关注你", requester.Username),
+			messageModel.NotificationTypeFollowRequest, userID)
+	}
+
+	logger.Info("发送关注请求成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID))
+
+	return nil
+}
+
+// 通过或拒绝关注请求，拒绝时不通知申请人
+func (s *socialServiceImpl) HandleFollowRequest(ctx context.Context, userID, requesterID int64, approve bool) error {
+	logger.Info("处理关注请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("requester_id", requesterID),
+		logger.BoolField("approve", approve))
+
+	var (
+		handled bool
+		err     error
+	)
+	if approve {
+		handled, err = s.followRequestRepo.Approve(ctx, requesterID, userID)
+	} else {
+		handled, err = s.followRequestRepo.Delete(ctx, requesterID, userID)
+	}
+	if err != nil {
+		logger.Error("处理关注请求失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("requester_id", requesterID))
+		return ErrInternalServer
+	}
+	if !handled {
+		return ErrFollowRequestMissing
+	}
+
+	if approve {
+		s.invalidateFollowCache(ctx, requesterID, userID)
+
+		user, err := s.userService.GetUserByID(ctx, userID)
+		if err == nil {
+			s.notify(ctx, requesterID, "关注请求已通过",
+				fmt.Sprintf("%s 通过了你的关注请求", user.Username),
+				messageModel.NotificationTypeFollowApproved, userID)
+		}
+
+		if s.kafkaProducer != nil {
+			eventData := map[string]interface{}{
+				"user_id":        requesterID,
+				"target_user_id": userID,
+				"action":         true,
+				"created_at":     time.Now(),
+			}
+			data, _ := json.Marshal(eventData)
+			s.kafkaProducer.SendSocialEvent(ctx, fmt.Sprintf("%d", requesterID), data)
+		}
+	}
+
+	logger.Info("处理关注请求成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("requester_id", requesterID),
+		logger.BoolField("approve", approve))
+
+	return nil
+}
+
+// 撤回自己发出的关注请求
+func (s *socialServiceImpl) CancelFollowRequest(ctx context.Context, userID, targetUserID int64) error {
+	logger.Info("撤回关注请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("target_user_id", targetUserID))
+
+	deleted, err := s.followRequestRepo.Delete(ctx, userID, targetUserID)
+	if err != nil {
+		logger.Error("撤回关注请求失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("target_user_id", targetUserID))
+		return ErrInternalServer
+	}
+	if !deleted {
+		return ErrFollowRequestMissing
+	}
+
+	return nil
+}
+
+// 获取收到或发出的关注请求
+func (s *socialServiceImpl) GetFollowRequests(ctx context.Context, userID int64, incoming bool, page, pageSize int) ([]*model.FollowRequest, int64, error) {
+	logger.Info("获取关注请求列表",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("incoming", incoming),
+		logger.IntField("page", page),
+		logger.IntField("page_size", pageSize))
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	var (
+		requests []*model.FollowRequest
+		total    int64
+		err      error
+	)
+	if incoming {
+		requests, total, err = s.followRequestRepo.ListIncoming(ctx, userID, page, pageSize)
+	} else {
+		requests, total, err = s.followRequestRepo.ListOutgoing(ctx, userID, page, pageSize)
+	}
+	if err != nil {
+		logger.Error("获取关注请求列表失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, 0, ErrInternalServer
+	}
+
+	return requests, total, nil
+}
+
+// 设置私密账号，转为公开账号时自动通过所有待处理的关注请求
+func (s *socialServiceImpl) SetAccountPrivacy(ctx context.Context, userID int64, isPrivate bool) error {
+	if err := s.userService.SetAccountPrivacy(ctx, userID, isPrivate); err != nil {
+		return err
+	}
+	if isPrivate {
+		return nil
+	}
+
+	approved, err := s.followRequestRepo.ApproveAll(ctx, userID)
+	if err != nil {
+		logger.Error("自动通过关注请求失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, requesterID := range approved {
+		s.invalidateFollowCache(ctx, requesterID, userID)
+	}
+
+	logger.Info("转为公开账号",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("approved_requests", len(approved)))
+
+	return nil
+}
+
+// 公开账号对所有人可见，私密账号只对本人和已通过的关注者可见
+func (s *socialServiceImpl) CanViewContent(ctx context.Context, viewerID, ownerID int64) (bool, error) {
+	if viewerID == ownerID {
+		return true, nil
+	}
+
+	owner, err := s.userService.GetUserByID(ctx, ownerID)
+	if err != nil {
+		return false, ErrUserNotFound
+	}
+	if !owner.IsPrivate {
+		return true, nil
+	}
+	if viewerID <= 0 {
+		return false, nil
+	}
+
+	following, err := s.followRepo.Exists(ctx, viewerID, ownerID)
+	if err != nil {
+		logger.Error("检查关注状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("viewer_id", viewerID),
+			logger.Int64Field("owner_id", ownerID))
+		return false, ErrInternalServer
+	}
+	return following, nil
+}
+
+// 创建可见名单
+func (s *socialServiceImpl) CreateAudienceList(ctx context.Context, userID int64, name string) (*model.AudienceList, error) {
+	logger.Info("创建可见名单请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("name", name))
+
+	name, err := normalizeAudienceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	//先确保亲密好友名单存在，避免其占用的名额被自定义名单用完
+	if _, err := s.ensureCloseFriendsList(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.audienceRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("统计可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if count >= maxAudienceLists {
+		return nil, ErrAudienceListLimit
+	}
+
+	list := &model.AudienceList{
+		UserID: userID,
+		Name:   name,
+	}
+	if err := s.audienceRepo.Create(ctx, list); err != nil {
+		logger.Error("创建可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	logger.Info("创建可见名单成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("list_id", list.ID))
+
+	return list, nil
+}
+
+// 重命名可见名单，亲密好友名单不能重命名
+func (s *socialServiceImpl) RenameAudienceList(ctx context.Context, userID, listID int64, name string) error {
+	logger.Info("重命名可见名单请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("list_id", listID),
+		logger.StringField("name", name))
+
+	name, err := normalizeAudienceName(name)
+	if err != nil {
+		return err
+	}
+
+	list, err := s.getOwnedAudienceList(ctx, userID, listID)
+	if err != nil {
+		return err
+	}
+	if list.IsCloseFriends {
+		return ErrCloseFriendsReadonly
+	}
+
+	if err := s.audienceRepo.UpdateName(ctx, listID, name); err != nil {
+		logger.Error("重命名可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("list_id", listID))
+		return ErrInternalServer
+	}
+
+	return nil
+}
+
+// 删除可见名单，已指定该名单的视频和直播间此后只对作者本人可见
+func (s *socialServiceImpl) DeleteAudienceList(ctx context.Context, userID, listID int64) error {
+	logger.Info("删除可见名单请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("list_id", listID))
+
+	list, err := s.getOwnedAudienceList(ctx, userID, listID)
+	if err != nil {
+		return err
+	}
+	if list.IsCloseFriends {
+		return ErrCloseFriendsReadonly
+	}
+
+	memberIDs, err := s.audienceRepo.Delete(ctx, listID)
+	if err != nil {
+		logger.Error("删除可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("list_id", listID))
+		return ErrInternalServer
+	}
+	s.invalidateAudienceMembership(ctx, memberIDs)
+
+	logger.Info("删除可见名单成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("list_id", listID),
+		logger.IntField("member_count", len(memberIDs)))
+
+	return nil
+}
+
+// 获取用户的可见名单，亲密好友名单始终排在第一个
+func (s *socialServiceImpl) GetAudienceLists(ctx context.Context, userID int64) ([]*model.AudienceList, error) {
+	if _, err := s.ensureCloseFriendsList(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	lists, err := s.audienceRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("获取可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return lists, nil
+}
+
+// 添加或移除名单成员，返回实际变化的成员数
+func (s *socialServiceImpl) UpdateAudienceMembers(ctx context.Context, userID, listID int64, memberIDs []int64, add bool) (int64, error) {
+	logger.Info("更新可见名单成员请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("list_id", listID),
+		logger.IntField("member_count", len(memberIDs)),
+		logger.BoolField("add", add))
+
+	list, err := s.getOwnedAudienceList(ctx, userID, listID)
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[int64]bool, len(memberIDs))
+	uniqueIDs := make([]int64, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID <= 0 || memberID == userID || seen[memberID] {
+			continue
+		}
+		seen[memberID] = true
+		uniqueIDs = append(uniqueIDs, memberID)
+	}
+	if len(uniqueIDs) == 0 {
+		return 0, nil
+	}
+
+	var changed int64
+	if add {
+		if list.MemberCount+int64(len(uniqueIDs)) > maxAudienceListMembers {
+			return 0, ErrAudienceMemberLimit
+		}
+
+		users, err := s.userService.BatchGetUsersByIDs(ctx, uniqueIDs)
+		if err != nil {
+			return 0, ErrInternalServer
+		}
+		existing := make([]int64, 0, len(users))
+		for _, memberID := range uniqueIDs {
+			if users[memberID] != nil {
+				existing = append(existing, memberID)
+			}
+		}
+		uniqueIDs = existing
+
+		changed, err = s.audienceRepo.AddMembers(ctx, listID, uniqueIDs)
+	} else {
+		changed, err = s.audienceRepo.RemoveMembers(ctx, listID, uniqueIDs)
+	}
+	if err != nil {
+		logger.Error("更新可见名单成员失败",
+			logger.ErrorField(err),
+			logger.Int64Field("list_id", listID))
+		return 0, ErrInternalServer
+	}
+	if changed > 0 {
+		s.invalidateAudienceMembership(ctx, uniqueIDs)
+	}
+
+	logger.Info("更新可见名单成员成功",
+		logger.Int64Field("list_id", listID),
+		logger.Int64Field("changed", changed))
+
+	return changed, nil
+}
+
+// 获取名单成员，只有创建者可以查看
+func (s *socialServiceImpl) GetAudienceMembers(ctx context.Context, userID, listID int64, page, pageSize int) ([]int64, int64, error) {
+	if _, err := s.getOwnedAudienceList(ctx, userID, listID); err != nil {
+		return nil, 0, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	members, total, err := s.audienceRepo.ListMembers(ctx, listID, page, pageSize)
+	if err != nil {
+		logger.Error("获取可见名单成员失败",
+			logger.ErrorField(err),
+			logger.Int64Field("list_id", listID))
+		return nil, 0, ErrInternalServer
+	}
+
+	memberIDs := make([]int64, len(members))
+	for i, member := range members {
+		memberIDs[i] = member.MemberID
+	}
+	return memberIDs, total, nil
+}
+
+// 检查名单是否属于该用户
+func (s *socialServiceImpl) ValidateAudienceList(ctx context.Context, userID, listID int64) error {
+	_, err := s.getOwnedAudienceList(ctx, userID, listID)
+	return err
+}
+
+// 批量检查用户是否在名单中，用户所在的名单缓存为Redis集合，一次调用完成整页检查
+func (s *socialServiceImpl) BatchCheckAudience(ctx context.Context, viewerID int64, listIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(listIDs))
+	if viewerID <= 0 || len(listIDs) == 0 {
+		return result, nil
+	}
+
+	if s.cache != nil {
+		key := cache.GenerateAudienceMembershipKey(viewerID)
+		members := make([]interface{}, 0, len(listIDs)+1)
+		members = append(members, audienceCacheSentinel)
+		for _, listID := range listIDs {
+			members = append(members, listID)
+		}
+
+		flags, err := s.cache.SMIsMember(ctx, key, members...)
+		if err == nil && len(flags) == len(members) && flags[0] {
+			for i, listID := range listIDs {
+				result[listID] = flags[i+1]
+			}
+			return result, nil
+		}
+	}
+
+	memberOf, err := s.audienceRepo.FindListIDsByMember(ctx, viewerID)
+	if err != nil {
+		logger.Error("查询用户所在名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("viewer_id", viewerID))
+		return nil, ErrInternalServer
+	}
+
+	all := make(map[int64]bool, len(memberOf))
+	warm := make([]interface{}, 0, len(memberOf)+1)
+	warm = append(warm, audienceCacheSentinel)
+	for _, listID := range memberOf {
+		all[listID] = true
+		warm = append(warm, listID)
+	}
+
+	if s.cache != nil {
+		key := cache.GenerateAudienceMembershipKey(viewerID)
+		if err := s.cache.SAdd(ctx, key, warm...); err != nil {
+			logger.Warn("预热可见名单缓存失败",
+				logger.ErrorField(err),
+				logger.Int64Field("viewer_id", viewerID))
+		} else {
+			s.cache.Expire(ctx, key, audienceCacheTTL)
+		}
+	}
+
+	for _, listID := range listIDs {
+		result[listID] = all[listID]
+	}
+	return result, nil
+}
+
+// 获取并校验名单归属
+func (s *socialServiceImpl) getOwnedAudienceList(ctx context.Context, userID, listID int64) (*model.AudienceList, error) {
+	list, err := s.audienceRepo.FindByID(ctx, listID)
+	if err != nil {
+		logger.Error("查询可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("list_id", listID))
+		return nil, ErrInternalServer
+	}
+	if list == nil || list.UserID != userID {
+		return nil, ErrAudienceListNotFound
+	}
+	return list, nil
+}
+
+// 亲密好友名单在首次使用时创建
+func (s *socialServiceImpl) ensureCloseFriendsList(ctx context.Context, userID int64) (*model.AudienceList, error) {
+	list, err := s.audienceRepo.FindCloseFriends(ctx, userID)
+	if err != nil {
+		logger.Error("查询亲密好友名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if list != nil {
+		return list, nil
+	}
+
+	list = &model.AudienceList{
+		UserID:         userID,
+		Name:           closeFriendsListName,
+		IsCloseFriends: true,
+	}
+	if err := s.audienceRepo.Create(ctx, list); err != nil {
+		logger.Error("创建亲密好友名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return list, nil
+}
+
+// 成员变化后删除其名单集合缓存，下次检查时重新加载
+func (s *socialServiceImpl) invalidateAudienceMembership(ctx context.Context, memberIDs []int64) {
+	if s.cache == nil || len(memberIDs) == 0 {
+		return
+	}
+
+	keys := make([]string, len(memberIDs))
+	for i, memberID := range memberIDs {
+		keys[i] = cache.GenerateAudienceMembershipKey(memberID)
+	}
+	if err := s.cache.MDelete(ctx, keys); err != nil {
+		logger.Warn("删除可见名单缓存失败", logger.ErrorField(err))
+	}
+}
+
+func normalizeAudienceName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxAudienceNameLength || name == closeFriendsListName {
+		return "", ErrInvalidAudienceName
+	}
+	return name, nil
+}
+
+// 写入系统通知，失败不影响主流程
+func (s *socialServiceImpl) notify(ctx context.Context, userID int64, title, content string, notificationType int32, relatedID int64) {
+	if s.notificationRepo == nil {
+		return
+	}
+
+	notification := &messageModel.SystemNotification{
+		UserID:     userID,
+		Title:      title,
+		Content:    content,
+		Type:       notificationType,
+		RelatedID:  relatedID,
+		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		logger.Warn("创建系统通知失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+	}
+}
+
+// 事务支持
+func (s *socialServiceImpl) WithTransaction(ctx context.Context, fn func(txService SocialService) error) error {
+	return s.followRepo.WithTransaction(ctx, func(txFollowRepo dao.FollowRepository) error {
+		txService := &socialServiceImpl{
+			followRepo:        txFollowRepo,
+			blockRepo:         s.blockRepo,
+			followRequestRepo: s.followRequestRepo,
+			audienceRepo:      s.audienceRepo,
+			notificationRepo:  s.notificationRepo,
+			userService:       s.userService,
+			settings:          s.settings,
+			kafkaProducer:     s.kafkaProducer,
+			cache:             s.cache,
+		}
+
+		return fn(txService)
+	})
+}
+
+// 账号注销后清理用户的社交关系：双向取消关注，撤回和拒绝关注请求，解除拉黑和静音，
+// 删除用户的可见名单并从他人名单中移除；取消关注复用关注操作以同步双方的计数，可重复执行
+func (s *socialServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的社交数据", logger.Int64Field("user_id", userID))
+
+	for {
+		following, err := s.followRepo.FindFollowing(ctx, userID, nil, purgeBatchSize)
+		if err != nil {
+			logger.Error("查询关注列表失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(following) == 0 {
+			break
+		}
+		for _, follow := range following {
+			if _, err := s.FollowAction(ctx, userID, follow.TargetUserID, false); err != nil && err != ErrNotFollowing {
+				return err
+			}
+		}
+	}
+
+	for {
+		followers, err := s.followRepo.FindFollowers(ctx, userID, nil, purgeBatchSize)
+		if err != nil {
+			logger.Error("查询粉丝列表失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(followers) == 0 {
+			break
+		}
+		for _, follow := range followers {
+			if _, err := s.FollowAction(ctx, follow.UserID, userID, false); err != nil && err != ErrNotFollowing {
+				return err
+			}
+		}
+	}
+
+	for {
+		requests, _, err := s.followRequestRepo.ListOutgoing(ctx, userID, 1, purgeBatchSize)
+		if err != nil {
+			logger.Error("查询发出的关注请求失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(requests) == 0 {
+			break
+		}
+		for _, request := range requests {
+			if err := s.CancelFollowRequest(ctx, userID, request.TargetUserID); err != nil && err != ErrFollowRequestMissing {
+				return err
+			}
+		}
+	}
+
+	for {
+		requests, _, err := s.followRequestRepo.ListIncoming(ctx, userID, 1, purgeBatchSize)
+		if err != nil {
+			logger.Error("查询收到的关注请求失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(requests) == 0 {
+			break
+		}
+		for _, request := range requests {
+			if err := s.HandleFollowRequest(ctx, userID, request.UserID, false); err != nil && err != ErrFollowRequestMissing {
+				return err
+			}
+		}
+	}
+
+	for _, blockType := range []int8{model.BlockTypeBlock, model.BlockTypeMute} {
+		for {
+			blocks, _, err := s.blockRepo.ListByUserID(ctx, userID, blockType, 1, purgeBatchSize)
+			if err != nil {
+				logger.Error("查询屏蔽列表失败",
+					logger.ErrorField(err),
+					logger.Int64Field("user_id", userID))
+				return ErrInternalServer
+			}
+			if len(blocks) == 0 {
+				break
+			}
+			for _, block := range blocks {
+				if blockType == model.BlockTypeBlock {
+					err = s.BlockAction(ctx, userID, block.TargetUserID, false)
+				} else {
+					err = s.MuteAction(ctx, userID, block.TargetUserID, false)
+				}
+				if err != nil && err != ErrNotBlocked && err != ErrNotMuted {
+					return err
+				}
+			}
+		}
+	}
+
+	//亲密好友名单不能通过接口删除，这里直接删除用户的全部名单
+	lists, err := s.audienceRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, list := range lists {
+		memberIDs, err := s.audienceRepo.Delete(ctx, list.ID)
+		if err != nil {
+			logger.Error("删除可见名单失败",
+				logger.ErrorField(err),
+				logger.Int64Field("list_id", list.ID))
+			return ErrInternalServer
+		}
+		s.invalidateAudienceMembership(ctx, memberIDs)
+	}
+
+	listIDs, err := s.audienceRepo.FindListIDsByMember(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户所在的可见名单失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	for _, listID := range listIDs {
+		if _, err := s.audienceRepo.RemoveMembers(ctx, listID, []int64{userID}); err != nil {
+			logger.Error("从可见名单移除成员失败",
+				logger.ErrorField(err),
+				logger.Int64Field("list_id", listID))
+			return ErrInternalServer
+		}
+	}
+	s.invalidateAudienceMembership(ctx, []int64{userID})
+
+	return nil
 }
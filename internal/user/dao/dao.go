@@ -3,11 +3,35 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
+	danmuModel "shortvideo/internal/danmu/model"
+	interactionModel "shortvideo/internal/interaction/model"
+	liveModel "shortvideo/internal/live/model"
+	messageModel "shortvideo/internal/message/model"
+	recommendModel "shortvideo/internal/recommend/model"
+	socialModel "shortvideo/internal/social/model"
 	"shortvideo/internal/user/model"
+	videoModel "shortvideo/internal/video/model"
+	"shortvideo/pkg/pagination"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// 新用户名已被其他用户占用，并发注册或改名时由唯一索引检测
+var ErrUsernameTaken = errors.New("用户名已被占用")
+
+// PostgreSQL唯一约束冲突的错误码
+const uniqueViolationCode = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	FindByID(ctx context.Context, id int64) (*model.User, error)
@@ -15,15 +39,112 @@ type UserRepository interface {
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int64) error
 	ListByIDs(ctx context.Context, ids []int64) ([]*model.User, error)
-	Search(ctx context.Context, keyword string, page, pageSize int) ([]*model.User, int64, error)
+	Search(ctx context.Context, keyword string, cursor *pagination.Cursor, limit int) ([]*model.User, error)
+	CountSearch(ctx context.Context, keyword string) (int64, error)
 	Count(ctx context.Context) (int64, error)
 	BatchCheckUsername(ctx context.Context, usernames []string) (map[string]bool, error)
 	BatchGetByIDs(ctx context.Context, ids []int64) (map[int64]*model.User, error)
 	UpdateFollowCount(ctx context.Context, userID int64, delta int64) error
 	UpdateFollowerCount(ctx context.Context, userID int64, delta int64) error
+	UpdatePrivacy(ctx context.Context, userID int64, isPrivate bool) error
+	UpdateBanned(ctx context.Context, userID int64, isBanned bool) error
+	UpdateLevel(ctx context.Context, userID int64, level int32) (bool, error)
 	WithTransaction(ctx context.Context, fn func(txRepo UserRepository) error) error
 }
 
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.UserSession) error
+	FindBySessionID(ctx context.Context, sessionID string) (*model.UserSession, error)
+	ExistsDevice(ctx context.Context, userID int64, deviceID string) (bool, error)
+	ListActive(ctx context.Context, userID int64) ([]*model.UserSession, error)
+	Touch(ctx context.Context, sessionID string, lastSeenAt time.Time) error
+	Extend(ctx context.Context, sessionID string, expiresAt time.Time) error
+	Revoke(ctx context.Context, sessionID string) (bool, error)
+	RevokeAll(ctx context.Context, userID int64) ([]string, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type TwoFactorRepository interface {
+	FindByUserID(ctx context.Context, userID int64) (*model.UserTwoFactor, error)
+	SavePending(ctx context.Context, userID int64, secret string) error
+	Enable(ctx context.Context, userID int64, step int64, codeHashes []string) (bool, error)
+	Disable(ctx context.Context, userID int64) error
+	UseStep(ctx context.Context, userID int64, step int64) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID int64) (int64, error)
+}
+
+type IdentityRepository interface {
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*model.UserIdentity, error)
+	Create(ctx context.Context, identity *model.UserIdentity) (bool, error)
+	CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+	Delete(ctx context.Context, userID int64, provider string) (bool, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *model.SecurityAuditLog) error
+}
+
+type ExperienceRepository interface {
+	Award(ctx context.Context, entry *model.ExperienceLog, dailyCap int64) (int64, int64, error)
+	SumByDay(ctx context.Context, userID int64, day string) (map[string]int64, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type SettingsRepository interface {
+	FindByUserID(ctx context.Context, userID int64) (*model.UserSettings, error)
+	Save(ctx context.Context, settings *model.UserSettings, expectedVersion int64) (bool, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type UsernameHistoryRepository interface {
+	Rename(ctx context.Context, history *model.UsernameHistory) (bool, error)
+	FindLatestByUserID(ctx context.Context, userID int64) (*model.UsernameHistory, error)
+	FindActiveByUsername(ctx context.Context, username string, now time.Time) (*model.UsernameHistory, error)
+	ListActiveByUsernames(ctx context.Context, usernames []string, now time.Time) (map[string]int64, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*model.UsernameHistory, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
+}
+
+type RoleRepository interface {
+	ListByUserID(ctx context.Context, userID int64) ([]string, error)
+	Grant(ctx context.Context, userID int64, role string, grantedBy int64) (bool, error)
+	Revoke(ctx context.Context, userID int64, role string) (bool, error)
+	LockUserIDsByRole(ctx context.Context, role string) ([]int64, error)
+	WithTransaction(ctx context.Context, fn func(txRepo RoleRepository) error) error
+}
+
+type AccountDeletionRepository interface {
+	FindByUserID(ctx context.Context, userID int64) (*model.AccountDeletion, error)
+	Schedule(ctx context.Context, userID int64, scheduledAt time.Time) error
+	Cancel(ctx context.Context, userID int64, now time.Time) (bool, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.AccountDeletion, error)
+	Complete(ctx context.Context, userID int64, completedAt time.Time) (bool, error)
+}
+
+type DataExportRepository interface {
+	Create(ctx context.Context, export *model.DataExport) error
+	FindLatest(ctx context.Context, userID int64) (*model.DataExport, error)
+	ListPending(ctx context.Context, staleBefore time.Time, limit int) ([]*model.DataExport, error)
+	Claim(ctx context.Context, id int64, staleBefore time.Time) (bool, error)
+	Complete(ctx context.Context, id int64, objectName string, completedAt, expiresAt time.Time) error
+	Fail(ctx context.Context, id int64) error
+	DeleteByUserID(ctx context.Context, userID int64) ([]string, error)
+}
+
+// 个人数据的一个分类，导出时每个分类写入一个JSON文件
+type PersonalDataSection struct {
+	Name string
+	Rows []map[string]interface{}
+}
+
+type PersonalDataRepository interface {
+	Collect(ctx context.Context, userID int64) ([]*PersonalDataSection, error)
+}
+
 type userRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -68,22 +189,31 @@ func (r *userRepositoryImpl) ListByIDs(ctx context.Context, ids []int64) ([]*mod
 	return users, err
 }
 
-func (r *userRepositoryImpl) Search(ctx context.Context, keyword string, page, pageSize int) ([]*model.User, int64, error) {
+// 按粉丝数倒序搜索用户，与ES的排序一致，游标可在两者之间通用
+func (r *userRepositoryImpl) Search(ctx context.Context, keyword string, cursor *pagination.Cursor, limit int) ([]*model.User, error) {
 	var users []*model.User
-	var total int64
-	offset := (page - 1) * pageSize
 
-	db := r.db.WithContext(ctx)
-	if keyword != "" {
-		db = db.Where("username LIKE ?", "%"+keyword+"%")
+	query := r.searchQuery(ctx, keyword)
+	if cursor != nil {
+		query = query.Where("(follower_count, id) < (?, ?)", cursor.SortKey, cursor.ID)
 	}
 
-	if err := db.Model(&model.User{}).Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	err := query.Order("follower_count DESC, id DESC").Limit(limit).Find(&users).Error
+	return users, err
+}
 
-	err := db.Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&users).Error
-	return users, total, err
+func (r *userRepositoryImpl) CountSearch(ctx context.Context, keyword string) (int64, error) {
+	var total int64
+	err := r.searchQuery(ctx, keyword).Count(&total).Error
+	return total, err
+}
+
+func (r *userRepositoryImpl) searchQuery(ctx context.Context, keyword string) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&model.User{})
+	if keyword != "" {
+		query = query.Where("username LIKE ?", "%"+keyword+"%")
+	}
+	return query
 }
 
 func (r *userRepositoryImpl) Count(ctx context.Context) (int64, error) {
@@ -140,9 +270,834 @@ func (r *userRepositoryImpl) UpdateFollowerCount(ctx context.Context, userID int
 		UpdateColumn("follower_count", gorm.Expr("follower_count + ?", delta)).Error
 }
 
+func (r *userRepositoryImpl) UpdatePrivacy(ctx context.Context, userID int64, isPrivate bool) error {
+	return r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("is_private", isPrivate).Error
+}
+
+func (r *userRepositoryImpl) UpdateBanned(ctx context.Context, userID int64, isBanned bool) error {
+	return r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("is_banned", isBanned).Error
+}
+
+// 更新等级，只在等级提升时更新，返回是否更新
+func (r *userRepositoryImpl) UpdateLevel(ctx context.Context, userID int64, level int32) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND level < ?", userID, level).
+		Update("level", level)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *userRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo UserRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &userRepositoryImpl{db: tx}
 		return fn(txRepo)
 	})
 }
+
+type sessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+	return &sessionRepositoryImpl{db: db}
+}
+
+func (r *sessionRepositoryImpl) Create(ctx context.Context, session *model.UserSession) error {
+	return r.db.WithContext(ctx).Create(session).Error
+}
+
+func (r *sessionRepositoryImpl) FindBySessionID(ctx context.Context, sessionID string) (*model.UserSession, error) {
+	var session model.UserSession
+	err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).First(&session).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &session, err
+}
+
+// 用户是否曾在该设备上登录过，包括已退出的会话
+func (r *sessionRepositoryImpl) ExistsDevice(ctx context.Context, userID int64, deviceID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// 未吊销且未过期的会话，最近活跃的在前
+func (r *sessionRepositoryImpl) ListActive(ctx context.Context, userID int64) ([]*model.UserSession, error) {
+	var sessions []*model.UserSession
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *sessionRepositoryImpl) Touch(ctx context.Context, sessionID string, lastSeenAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("session_id = ?", sessionID).
+		UpdateColumn("last_seen_at", lastSeenAt).Error
+}
+
+// 刷新令牌轮换后延长会话的过期时间
+func (r *sessionRepositoryImpl) Extend(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("session_id = ?", sessionID).
+		UpdateColumns(map[string]interface{}{
+			"expires_at":   expiresAt,
+			"last_seen_at": now,
+		}).Error
+}
+
+// 吊销会话，已吊销时返回false
+func (r *sessionRepositoryImpl) Revoke(ctx context.Context, sessionID string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("session_id = ? AND revoked_at IS NULL", sessionID).
+		UpdateColumn("revoked_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 吊销用户所有未吊销的会话，返回被吊销的会话ID
+func (r *sessionRepositoryImpl) RevokeAll(ctx context.Context, userID int64) ([]string, error) {
+	var sessionIDs []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.UserSession{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Pluck("session_id", &sessionIDs).Error
+		if err != nil || len(sessionIDs) == 0 {
+			return err
+		}
+
+		return tx.Model(&model.UserSession{}).
+			Where("session_id IN ?", sessionIDs).
+			UpdateColumn("revoked_at", time.Now()).Error
+	})
+	return sessionIDs, err
+}
+
+func (r *sessionRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserSession{}).Error
+}
+
+type auditLogRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepositoryImpl{db: db}
+}
+
+func (r *auditLogRepositoryImpl) Create(ctx context.Context, log *model.SecurityAuditLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+type twoFactorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorRepository(db *gorm.DB) TwoFactorRepository {
+	return &twoFactorRepositoryImpl{db: db}
+}
+
+func (r *twoFactorRepositoryImpl) FindByUserID(ctx context.Context, userID int64) (*model.UserTwoFactor, error) {
+	var twoFactor model.UserTwoFactor
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&twoFactor).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &twoFactor, err
+}
+
+// 保存待验证的密钥，已开启的不会被覆盖
+func (r *twoFactorRepositoryImpl) SavePending(ctx context.Context, userID int64, secret string) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"secret": secret, "last_used_step": 0, "updated_at": time.Now()}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "user_two_factors.enabled = ?", Vars: []interface{}{false}}}},
+	}).Create(&model.UserTwoFactor{UserID: userID, Secret: secret}).Error
+}
+
+// 开启两步验证并写入恢复码，已开启时返回false
+func (r *twoFactorRepositoryImpl) Enable(ctx context.Context, userID int64, step int64, codeHashes []string) (bool, error) {
+	enabled := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&model.UserTwoFactor{}).
+			Where("user_id = ? AND enabled = ?", userID, false).
+			UpdateColumns(map[string]interface{}{
+				"enabled":        true,
+				"enabled_at":     now,
+				"last_used_step": step,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		enabled = true
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+	return enabled, err
+}
+
+// 关闭两步验证，同时删除密钥和恢复码
+func (r *twoFactorRepositoryImpl) Disable(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&model.UserTwoFactor{}).Error
+	})
+}
+
+// 记录使用的时间步，时间步不大于上次使用的值时返回false
+func (r *twoFactorRepositoryImpl) UseStep(ctx context.Context, userID int64, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.UserTwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		UpdateColumn("last_used_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *twoFactorRepositoryImpl) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]*model.UserRecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &model.UserRecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return tx.Create(&codes).Error
+}
+
+// 使用恢复码，不存在或已使用时返回false
+func (r *twoFactorRepositoryImpl) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		UpdateColumn("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 剩余可用的恢复码数量
+func (r *twoFactorRepositoryImpl) CountRecoveryCodes(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+type identityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewIdentityRepository(db *gorm.DB) IdentityRepository {
+	return &identityRepositoryImpl{db: db}
+}
+
+func (r *identityRepositoryImpl) FindByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &identity, err
+}
+
+func (r *identityRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]*model.UserIdentity, error) {
+	var identities []*model.UserIdentity
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error
+	return identities, err
+}
+
+// 绑定第三方账号，第三方账号已被绑定或用户已绑定该提供方时返回false
+func (r *identityRepositoryImpl) Create(ctx context.Context, identity *model.UserIdentity) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(identity)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 第三方登录自动注册，用户和绑定关系在同一事务中创建
+func (r *identityRepositoryImpl) CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
+
+// 解除绑定，未绑定时返回false
+func (r *identityRepositoryImpl) Delete(ctx context.Context, userID int64, provider string) (bool, error) {
+	result := r.db.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider).Delete(&model.UserIdentity{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *identityRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserIdentity{}).Error
+}
+
+type settingsRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSettingsRepository(db *gorm.DB) SettingsRepository {
+	return &settingsRepositoryImpl{db: db}
+}
+
+func (r *settingsRepositoryImpl) FindByUserID(ctx context.Context, userID int64) (*model.UserSettings, error) {
+	var settings model.UserSettings
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// 保存设置，只有当前版本等于expectedVersion时才写入，写入后版本号为expectedVersion+1；
+// expectedVersion为0表示还没有记录。版本不一致时返回false
+func (r *settingsRepositoryImpl) Save(ctx context.Context, settings *model.UserSettings, expectedVersion int64) (bool, error) {
+	settings.Version = expectedVersion + 1
+	if expectedVersion == 0 {
+		result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Select("*").Create(settings)
+		if result.Error != nil {
+			return false, result.Error
+		}
+		return result.RowsAffected > 0, nil
+	}
+
+	result := r.db.WithContext(ctx).Model(&model.UserSettings{}).
+		Where("user_id = ? AND version = ?", settings.UserID, expectedVersion).
+		Updates(map[string]interface{}{
+			"dm_permission":       settings.DMPermission,
+			"comment_permission":  settings.CommentPermission,
+			"liked_list_public":   settings.LikedListPublic,
+			"starred_list_public": settings.StarredListPublic,
+			"follow_list_visible": settings.FollowListVisible,
+			"allow_remix":         settings.AllowRemix,
+			"show_online_status":  settings.ShowOnlineStatus,
+			"version":             settings.Version,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *settingsRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UserSettings{}).Error
+}
+
+type usernameHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUsernameHistoryRepository(db *gorm.DB) UsernameHistoryRepository {
+	return &usernameHistoryRepositoryImpl{db: db}
+}
+
+// 修改用户名并记录修改历史，用户当前的用户名不是OldUsername时返回false，
+// 新用户名同时被其他用户占用时返回ErrUsernameTaken；
+// 用户改回保留期内自己的旧用户名时，该旧用户名的跳转随之结束
+func (r *usernameHistoryRepositoryImpl) Rename(ctx context.Context, history *model.UsernameHistory) (bool, error) {
+	renamed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.User{}).
+			Where("id = ? AND username = ?", history.UserID, history.OldUsername).
+			Update("username", history.NewUsername)
+		if isUniqueViolation(result.Error) {
+			return ErrUsernameTaken
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&model.UsernameHistory{}).
+			Where("user_id = ? AND old_username = ? AND redirect_until > ?", history.UserID, history.NewUsername, time.Now()).
+			Update("redirect_until", time.Now()).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+		renamed = true
+		return nil
+	})
+	return renamed, err
+}
+
+func (r *usernameHistoryRepositoryImpl) FindLatestByUserID(ctx context.Context, userID int64) (*model.UsernameHistory, error) {
+	var history model.UsernameHistory
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id DESC").First(&history).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// 查询仍在保留期内的旧用户名记录
+func (r *usernameHistoryRepositoryImpl) FindActiveByUsername(ctx context.Context, username string, now time.Time) (*model.UsernameHistory, error) {
+	var history model.UsernameHistory
+	err := r.db.WithContext(ctx).
+		Where("old_username = ? AND redirect_until > ?", username, now).
+		Order("id DESC").
+		First(&history).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// 批量查询仍在保留期内的旧用户名，返回旧用户名到用户ID的映射
+func (r *usernameHistoryRepositoryImpl) ListActiveByUsernames(ctx context.Context, usernames []string, now time.Time) (map[string]int64, error) {
+	result := make(map[string]int64)
+	if len(usernames) == 0 {
+		return result, nil
+	}
+
+	var histories []*model.UsernameHistory
+	err := r.db.WithContext(ctx).
+		Select("user_id", "old_username").
+		Where("old_username IN ? AND redirect_until > ?", usernames, now).
+		Find(&histories).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, history := range histories {
+		result[history.OldUsername] = history.UserID
+	}
+	return result, nil
+}
+
+func (r *usernameHistoryRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]*model.UsernameHistory, error) {
+	var histories []*model.UsernameHistory
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id DESC").Find(&histories).Error
+	return histories, err
+}
+
+func (r *usernameHistoryRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.UsernameHistory{}).Error
+}
+
+type roleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) RoleRepository {
+	return &roleRepositoryImpl{db: db}
+}
+
+func (r *roleRepositoryImpl) ListByUserID(ctx context.Context, userID int64) ([]string, error) {
+	var roles []string
+	err := r.db.WithContext(ctx).Model(&model.UserRole{}).
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Pluck("role", &roles).Error
+	return roles, err
+}
+
+// 授予角色，已拥有该角色时返回false
+func (r *roleRepositoryImpl) Grant(ctx context.Context, userID int64, role string, grantedBy int64) (bool, error) {
+	userRole := &model.UserRole{
+		UserID:    userID,
+		Role:      role,
+		GrantedBy: grantedBy,
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(userRole)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 收回角色，未拥有该角色时返回false
+func (r *roleRepositoryImpl) Revoke(ctx context.Context, userID int64, role string) (bool, error) {
+	result := r.db.WithContext(ctx).Where("user_id = ? AND role = ?", userID, role).Delete(&model.UserRole{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 锁定拥有该角色的全部记录并返回用户ID，需要在事务中调用，用于收回角色前判断是否为最后一名持有者
+func (r *roleRepositoryImpl) LockUserIDsByRole(ctx context.Context, role string) ([]int64, error) {
+	var userIDs []int64
+	err := r.db.WithContext(ctx).Model(&model.UserRole{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role = ?", role).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *roleRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo RoleRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&roleRepositoryImpl{db: tx})
+	})
+}
+
+type experienceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewExperienceRepository(db *gorm.DB) ExperienceRepository {
+	return &experienceRepositoryImpl{db: db}
+}
+
+// 发放经验值，超出当天该来源上限的部分不发放，dailyCap不大于0表示不限制；
+// 同一来源同一对象当天已发放过时不重复发放。返回实际发放的经验值和发放后的累计经验值
+func (r *experienceRepositoryImpl) Award(ctx context.Context, entry *model.ExperienceLog, dailyCap int64) (int64, int64, error) {
+	var awarded, experience int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		//锁定用户行，同一用户的发放串行执行，保证每日上限准确
+		var user model.User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "experience").
+			Where("id = ? AND is_deleted = ?", entry.UserID, false).
+			First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		experience = user.Experience
+
+		points := entry.Points
+		if dailyCap > 0 {
+			var earned int64
+			if err := tx.Model(&model.ExperienceLog{}).
+				Where("user_id = ? AND source = ? AND day = ?", entry.UserID, entry.Source, entry.Day).
+				Select("COALESCE(SUM(points), 0)").
+				Scan(&earned).Error; err != nil {
+				return err
+			}
+			if earned+points > dailyCap {
+				points = dailyCap - earned
+			}
+		}
+		if points <= 0 {
+			return nil
+		}
+
+		entry.Points = points
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&model.User{}).Where("id = ?", entry.UserID).
+			UpdateColumn("experience", gorm.Expr("experience + ?", points)).Error; err != nil {
+			return err
+		}
+		awarded = points
+		experience += points
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return awarded, experience, nil
+}
+
+// 统计用户某天各来源获得的经验值
+func (r *experienceRepositoryImpl) SumByDay(ctx context.Context, userID int64, day string) (map[string]int64, error) {
+	var rows []struct {
+		Source string
+		Points int64
+	}
+	err := r.db.WithContext(ctx).Model(&model.ExperienceLog{}).
+		Select("source, SUM(points) AS points").
+		Where("user_id = ? AND day = ?", userID, day).
+		Group("source").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		sums[row.Source] = row.Points
+	}
+	return sums, nil
+}
+
+func (r *experienceRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.ExperienceLog{}).Error
+}
+
+type accountDeletionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionRepository(db *gorm.DB) AccountDeletionRepository {
+	return &accountDeletionRepositoryImpl{db: db}
+}
+
+func (r *accountDeletionRepositoryImpl) FindByUserID(ctx context.Context, userID int64) (*model.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&deletion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &deletion, err
+}
+
+// 创建注销申请，已有撤销过的申请时覆盖为新的申请
+func (r *accountDeletionRepositoryImpl) Schedule(ctx context.Context, userID int64, scheduledAt time.Time) error {
+	deletion := &model.AccountDeletion{
+		UserID:      userID,
+		Status:      model.DeletionStatusPending,
+		ScheduledAt: scheduledAt,
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":       model.DeletionStatusPending,
+			"scheduled_at": scheduledAt,
+			"completed_at": nil,
+			"created_at":   time.Now(),
+			"updated_at":   time.Now(),
+		}),
+	}).Create(deletion).Error
+}
+
+// 撤销注销申请，只有冷静期内的申请可以撤销，已到期等待执行的申请不能撤销
+func (r *accountDeletionRepositoryImpl) Cancel(ctx context.Context, userID int64, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("user_id = ? AND status = ? AND scheduled_at > ?", userID, model.DeletionStatusPending, now).
+		Update("status", model.DeletionStatusCancelled)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *accountDeletionRepositoryImpl) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.AccountDeletion, error) {
+	var deletions []*model.AccountDeletion
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND scheduled_at <= ?", model.DeletionStatusPending, now).
+		Order("scheduled_at ASC").
+		Limit(limit).
+		Find(&deletions).Error
+	return deletions, err
+}
+
+// 标记注销完成，申请已被撤销时返回false
+func (r *accountDeletionRepositoryImpl) Complete(ctx context.Context, userID int64, completedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("user_id = ? AND status = ?", userID, model.DeletionStatusPending).
+		Updates(map[string]interface{}{
+			"status":       model.DeletionStatusCompleted,
+			"completed_at": completedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+type dataExportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDataExportRepository(db *gorm.DB) DataExportRepository {
+	return &dataExportRepositoryImpl{db: db}
+}
+
+func (r *dataExportRepositoryImpl) Create(ctx context.Context, export *model.DataExport) error {
+	return r.db.WithContext(ctx).Create(export).Error
+}
+
+func (r *dataExportRepositoryImpl) FindLatest(ctx context.Context, userID int64) (*model.DataExport, error) {
+	var export model.DataExport
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("id DESC").First(&export).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &export, err
+}
+
+// 待处理的导出任务，处理中但超过staleBefore未更新的任务视为中断，重新处理
+func (r *dataExportRepositoryImpl) ListPending(ctx context.Context, staleBefore time.Time, limit int) ([]*model.DataExport, error) {
+	var exports []*model.DataExport
+	err := r.db.WithContext(ctx).
+		Where("status = ? OR (status = ? AND updated_at < ?)",
+			model.ExportStatusPending, model.ExportStatusProcessing, staleBefore).
+		Order("id ASC").
+		Limit(limit).
+		Find(&exports).Error
+	return exports, err
+}
+
+// 领取导出任务，多个实例同时处理时只有一个能领取成功
+func (r *dataExportRepositoryImpl) Claim(ctx context.Context, id int64, staleBefore time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.DataExport{}).
+		Where("id = ? AND (status = ? OR (status = ? AND updated_at < ?))",
+			id, model.ExportStatusPending, model.ExportStatusProcessing, staleBefore).
+		Updates(map[string]interface{}{
+			"status":     model.ExportStatusProcessing,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *dataExportRepositoryImpl) Complete(ctx context.Context, id int64, objectName string, completedAt, expiresAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.DataExport{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       model.ExportStatusCompleted,
+			"object_name":  objectName,
+			"completed_at": completedAt,
+			"expires_at":   expiresAt,
+		}).Error
+}
+
+func (r *dataExportRepositoryImpl) Fail(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Model(&model.DataExport{}).
+		Where("id = ?", id).
+		Update("status", model.ExportStatusFailed).Error
+}
+
+// 删除用户的全部导出任务，返回已生成的导出文件对象名
+func (r *dataExportRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) ([]string, error) {
+	var objectNames []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.DataExport{}).
+			Where("user_id = ? AND object_name <> ''", userID).
+			Pluck("object_name", &objectNames).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&model.DataExport{}).Error
+	})
+	return objectNames, err
+}
+
+// 个人数据来源，query为按用户过滤的条件，参数都是用户ID；order为空时按id排序
+type personalDataSource struct {
+	name  string
+	model interface{}
+	query string
+	order string
+}
+
+// 导出的个人数据来源，两步验证密钥和恢复码不导出
+var personalDataSources = []personalDataSource{
+	{name: "sessions", model: &model.UserSession{}, query: "user_id = ?"},
+	{name: "identities", model: &model.UserIdentity{}, query: "user_id = ?"},
+	{name: "security_audit_logs", model: &model.SecurityAuditLog{}, query: "user_id = ?"},
+	{name: "experience_logs", model: &model.ExperienceLog{}, query: "user_id = ?"},
+	{name: "username_history", model: &model.UsernameHistory{}, query: "user_id = ?"},
+	{name: "settings", model: &model.UserSettings{}, query: "user_id = ?", order: "user_id ASC"},
+	{name: "videos", model: &videoModel.Video{}, query: "author_id = ?"},
+	{name: "watch_history", model: &videoModel.WatchHistory{}, query: "user_id = ?"},
+	{name: "watch_history_settings", model: &videoModel.WatchHistorySetting{}, query: "user_id = ?", order: "user_id ASC"},
+	{name: "video_comment_settings", model: &interactionModel.CommentSetting{}, query: "author_id = ?"},
+	{name: "comments", model: &interactionModel.Comment{}, query: "user_id = ?"},
+	{name: "comment_likes", model: &interactionModel.CommentLike{}, query: "user_id = ?"},
+	{name: "comment_keywords", model: &interactionModel.CommentKeyword{}, query: "user_id = ?"},
+	{name: "likes", model: &interactionModel.Like{}, query: "user_id = ?"},
+	{name: "stars", model: &interactionModel.Star{}, query: "user_id = ?"},
+	{name: "star_folders", model: &interactionModel.StarFolder{}, query: "user_id = ?"},
+	{name: "star_folder_follows", model: &interactionModel.StarFolderFollow{}, query: "user_id = ?"},
+	{name: "shares", model: &interactionModel.Share{}, query: "user_id = ?"},
+	{name: "share_links", model: &interactionModel.ShareLink{}, query: "user_id = ?"},
+	{name: "share_events", model: &interactionModel.ShareEvent{}, query: "sharer_id = ? OR visitor_id = ?"},
+	{name: "share_attributions", model: &interactionModel.ShareAttribution{}, query: "user_id = ? OR inviter_id = ?"},
+	{name: "following", model: &socialModel.Follow{}, query: "user_id = ?"},
+	{name: "followers", model: &socialModel.Follow{}, query: "target_user_id = ?"},
+	{name: "follow_requests", model: &socialModel.FollowRequest{}, query: "user_id = ? OR target_user_id = ?"},
+	{name: "blocks", model: &socialModel.Block{}, query: fmt.Sprintf("user_id = ? AND type = %d", socialModel.BlockTypeBlock)},
+	{name: "mutes", model: &socialModel.Block{}, query: fmt.Sprintf("user_id = ? AND type = %d", socialModel.BlockTypeMute)},
+	{name: "audience_lists", model: &socialModel.AudienceList{}, query: "user_id = ?"},
+	{name: "audience_list_members", model: &socialModel.AudienceListMember{}, query: "list_id IN (SELECT id FROM audience_lists WHERE user_id = ?)"},
+	{name: "audience_list_memberships", model: &socialModel.AudienceListMember{}, query: "member_id = ?"},
+	{name: "messages", model: &messageModel.Message{}, query: "send_id = ? OR receive_id = ?"},
+	{name: "notifications", model: &messageModel.SystemNotification{}, query: "user_id = ?"},
+	{name: "danmus", model: &danmuModel.Danmu{}, query: "user_id = ?"},
+	{name: "danmu_filters", model: &danmuModel.DanmuFilter{}, query: "user_id = ?"},
+	{name: "live_rooms", model: &liveModel.LiveRoom{}, query: "host_id = ?"},
+	{name: "gift_records", model: &liveModel.GiftRecord{}, query: "sender_id = ?"},
+	{name: "live_views", model: &liveModel.RoomViewer{}, query: "user_id = ?"},
+	{name: "actions", model: &recommendModel.UserAction{}, query: "user_id = ?"},
+	{name: "preferences", model: &recommendModel.UserPreference{}, query: "user_id = ?"},
+	{name: "feedbacks", model: &recommendModel.UserFeedback{}, query: "user_id = ?"},
+}
+
+type personalDataRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPersonalDataRepository(db *gorm.DB) PersonalDataRepository {
+	return &personalDataRepositoryImpl{db: db}
+}
+
+// 按来源依次查询用户的全部数据，各服务共用数据库，直接读取各服务的表
+func (r *personalDataRepositoryImpl) Collect(ctx context.Context, userID int64) ([]*PersonalDataSection, error) {
+	sections := make([]*PersonalDataSection, 0, len(personalDataSources))
+	for _, source := range personalDataSources {
+		args := make([]interface{}, strings.Count(source.query, "?"))
+		for i := range args {
+			args[i] = userID
+		}
+
+		order := source.order
+		if order == "" {
+			order = "id ASC"
+		}
+
+		var rows []map[string]interface{}
+		err := r.db.WithContext(ctx).Model(source.model).Where(source.query, args...).Order(order).Find(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, &PersonalDataSection{Name: source.name, Rows: rows})
+	}
+	return sections, nil
+}
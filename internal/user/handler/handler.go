@@ -2,9 +2,12 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/user/model"
 	"shortvideo/internal/user/service"
 	"shortvideo/kitex_gen/common"
 	user "shortvideo/kitex_gen/user"
+	"shortvideo/pkg/jwt"
+	"shortvideo/pkg/rbac"
 )
 
 // UserServiceImpl implements the last service interface defined in the IDL.
@@ -29,7 +32,7 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.RegisterReq) (
 		about = *req.About
 	}
 
-	user, token, err := s.userService.Register(ctx, req.Username, req.Password, avatar, about)
+	user, tokens, err := s.userService.Register(ctx, req.Username, req.Password, avatar, about, toDeviceInfo(req.Device), req.GetCaptchaToken())
 	if err != nil {
 		errMsg := err.Error()
 		resp.BaseResp = &common.BaseResp{
@@ -55,8 +58,12 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.RegisterReq) (
 		About:         aboutPtr,
 		FollowCount:   user.FollowCount,
 		FollowerCount: user.FollowerCount,
+		IsPrivate:     &user.IsPrivate,
+		Level:         user.Level,
 	}
-	resp.Token = token
+	resp.Token = tokens.AccessToken
+	resp.RefreshToken = tokens.RefreshToken
+	resp.ExpiresAt = tokens.AccessClaims.ExpiresAt.Unix()
 	successMsg := "注册成功"
 	resp.BaseResp = &common.BaseResp{
 		StatusCode: 0,
@@ -69,7 +76,7 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *user.RegisterReq) (
 func (s *UserServiceImpl) Login(ctx context.Context, req *user.LoginReq) (resp *user.LoginRegisterResp, err error) {
 	resp = &user.LoginRegisterResp{}
 
-	user, token, err := s.userService.Login(ctx, req.Username, req.Password)
+	result, err := s.userService.Login(ctx, req.Username, req.Password, toDeviceInfo(req.Device), req.GetCaptchaToken())
 	if err != nil {
 		errMsg := err.Error()
 		resp.BaseResp = &common.BaseResp{
@@ -79,6 +86,52 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.LoginReq) (resp *
 		return resp, nil
 	}
 
+	//需要两步验证时只返回挑战令牌，不返回用户信息
+	if result.ChallengeToken != "" {
+		resp.TwoFactorRequired = true
+		resp.ChallengeToken = result.ChallengeToken
+		resp.ChallengeExpiresAt = result.ChallengeExpiresAt.Unix()
+		successMsg := "请输入两步验证码"
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		}
+		return resp, nil
+	}
+
+	fillLoginResp(resp, result.User, result.Tokens)
+	successMsg := "登录成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// VerifyTwoFactorLogin implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyTwoFactorLogin(ctx context.Context, req *user.TwoFactorLoginReq) (resp *user.LoginRegisterResp, err error) {
+	resp = &user.LoginRegisterResp{}
+
+	user, tokens, err := s.userService.VerifyTwoFactorLogin(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	fillLoginResp(resp, user, tokens)
+	successMsg := "登录成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+func fillLoginResp(resp *user.LoginRegisterResp, user *model.User, tokens *jwt.TokenPair) {
 	var avatarPtr *string
 	if user.Avatar != "" {
 		avatarPtr = &user.Avatar
@@ -95,14 +148,12 @@ func (s *UserServiceImpl) Login(ctx context.Context, req *user.LoginReq) (resp *
 		About:         aboutPtr,
 		FollowCount:   user.FollowCount,
 		FollowerCount: user.FollowerCount,
+		IsPrivate:     &user.IsPrivate,
+		Level:         user.Level,
 	}
-	resp.Token = token
-	successMsg := "登录成功"
-	resp.BaseResp = &common.BaseResp{
-		StatusCode: 0,
-		Msg:        &successMsg,
-	}
-	return resp, nil
+	resp.Token = tokens.AccessToken
+	resp.RefreshToken = tokens.RefreshToken
+	resp.ExpiresAt = tokens.AccessClaims.ExpiresAt.Unix()
 }
 
 // GetUserInfo implements the UserServiceImpl interface.
@@ -135,6 +186,8 @@ func (s *UserServiceImpl) GetUserInfo(ctx context.Context, req *user.UserInfoReq
 		About:         aboutPtr,
 		FollowCount:   user.FollowCount,
 		FollowerCount: user.FollowerCount,
+		IsPrivate:     &user.IsPrivate,
+		Level:         user.Level,
 	}
 	successMsg := "获取用户信息成功"
 	resp.BaseResp = &common.BaseResp{
@@ -176,6 +229,8 @@ func (s *UserServiceImpl) BatchGetUserInfo(ctx context.Context, req *user.BatchU
 			About:         aboutPtr,
 			FollowCount:   user.FollowCount,
 			FollowerCount: user.FollowerCount,
+			IsPrivate:     &user.IsPrivate,
+			Level:         user.Level,
 		}
 	}
 	resp.Users = userMap
@@ -208,7 +263,7 @@ func (s *UserServiceImpl) UpdateUser(ctx context.Context, req *user.UpdateUserRe
 		newPassword = *req.NewPassword_
 	}
 
-	err = s.userService.UpdateUser(ctx, req.UserId, avatar, about, oldPassword, newPassword)
+	err = s.userService.UpdateUser(ctx, req.UserId, avatar, about, oldPassword, newPassword, req.GetTwoFactorCode())
 	if err != nil {
 		errMsg := err.Error()
 		resp.StatusCode = -1
@@ -275,19 +330,35 @@ func (s *UserServiceImpl) GetUserStats(ctx context.Context, req *user.UserStatsR
 }
 
 // VerifyToken implements the UserServiceImpl interface.
-func (s *UserServiceImpl) VerifyToken(ctx context.Context, token string) (resp bool, err error) {
-	_, err = s.userService.VerifyToken(ctx, token)
+func (s *UserServiceImpl) VerifyToken(ctx context.Context, token string) (resp *user.VerifyTokenResp, err error) {
+	resp = &user.VerifyTokenResp{}
+
+	claims, err := s.userService.VerifyToken(ctx, token)
 	if err != nil {
-		return false, nil
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.UserId = claims.UserID
+	resp.SessionId = claims.SessionID
+	resp.Roles = claims.Roles
+	successMsg := "令牌有效"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
 	}
-	return true, nil
+	return resp, nil
 }
 
-// GetUserInfoByUsername implements the UserServiceImpl interface.
-func (s *UserServiceImpl) GetUserInfoByUsername(ctx context.Context, req *user.UserInfoByUsernameReq) (resp *user.UserInfoResp, err error) {
-	resp = &user.UserInfoResp{}
+// RefreshToken implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (resp *user.RefreshTokenResp, err error) {
+	resp = &user.RefreshTokenResp{}
 
-	user, err := s.userService.GetUserByUsername(ctx, req.Username)
+	tokens, err := s.userService.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
 		errMsg := err.Error()
 		resp.BaseResp = &common.BaseResp{
@@ -297,24 +368,81 @@ func (s *UserServiceImpl) GetUserInfoByUsername(ctx context.Context, req *user.U
 		return resp, nil
 	}
 
-	var avatarPtr *string
-	if user.Avatar != "" {
-		avatarPtr = &user.Avatar
+	resp.Token = tokens.AccessToken
+	resp.RefreshToken = tokens.RefreshToken
+	resp.ExpiresAt = tokens.AccessClaims.ExpiresAt.Unix()
+	successMsg := "刷新令牌成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
 	}
-	var aboutPtr *string
-	if user.About != "" {
-		aboutPtr = &user.About
+	return resp, nil
+}
+
+// Logout implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Logout(ctx context.Context, req *user.LogoutReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.Logout(ctx, req.Token)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
 	}
 
-	resp.User = &common.User{
-		Id:            user.ID,
-		Username:      user.Username,
-		Avatar:        avatarPtr,
-		About:         aboutPtr,
-		FollowCount:   user.FollowCount,
-		FollowerCount: user.FollowerCount,
+	successMsg := "退出登录成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// LogoutAll implements the UserServiceImpl interface.
+func (s *UserServiceImpl) LogoutAll(ctx context.Context, req *user.LogoutAllReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.LogoutAll(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
 	}
-	successMsg := "获取用户信息成功"
+
+	successMsg := "已退出所有设备"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// GetSessions implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetSessions(ctx context.Context, req *user.SessionListReq) (resp *user.SessionListResp, err error) {
+	resp = &user.SessionListResp{}
+
+	sessions, err := s.userService.GetSessions(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Sessions = make([]*user.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &user.UserSession{
+			SessionId:    session.SessionID,
+			DeviceName:   session.DeviceName,
+			Platform:     session.Platform,
+			Ip:           session.IP,
+			UserAgent:    session.UserAgent,
+			CreateTime:   session.CreatedAt.Format("2006-01-02 15:04:05"),
+			LastSeenTime: session.LastSeenAt.Format("2006-01-02 15:04:05"),
+			IsCurrent:    session.SessionID == req.CurrentSessionId,
+		})
+	}
+	successMsg := "获取登录设备成功"
 	resp.BaseResp = &common.BaseResp{
 		StatusCode: 0,
 		Msg:        &successMsg,
@@ -322,11 +450,11 @@ func (s *UserServiceImpl) GetUserInfoByUsername(ctx context.Context, req *user.U
 	return resp, nil
 }
 
-// UpdateAvatar implements the UserServiceImpl interface.
-func (s *UserServiceImpl) UpdateAvatar(ctx context.Context, req *user.UpdateAvatarReq) (resp *common.BaseResp, err error) {
+// RevokeSession implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeSession(ctx context.Context, req *user.RevokeSessionReq) (resp *common.BaseResp, err error) {
 	resp = &common.BaseResp{}
 
-	_, err = s.userService.UpdateAvatar(ctx, req.UserId, req.AvatarData)
+	err = s.userService.RevokeSession(ctx, req.UserId, req.SessionId)
 	if err != nil {
 		errMsg := err.Error()
 		resp.StatusCode = -1
@@ -334,17 +462,17 @@ func (s *UserServiceImpl) UpdateAvatar(ctx context.Context, req *user.UpdateAvat
 		return resp, nil
 	}
 
-	successMsg := "更新头像成功"
+	successMsg := "已退出该设备"
 	resp.StatusCode = 0
 	resp.Msg = &successMsg
 	return resp, nil
 }
 
-// BatchCheckUsernames implements the UserServiceImpl interface.
-func (s *UserServiceImpl) BatchCheckUsernames(ctx context.Context, req *user.BatchCheckUsernamesReq) (resp *user.BatchCheckUsernamesResp, err error) {
-	resp = &user.BatchCheckUsernamesResp{}
+// SetupTwoFactor implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SetupTwoFactor(ctx context.Context, req *user.TwoFactorSetupReq) (resp *user.TwoFactorSetupResp, err error) {
+	resp = &user.TwoFactorSetupResp{}
 
-	availableMap, err := s.userService.BatchCheckUsernames(ctx, req.Usernames)
+	secret, uri, err := s.userService.SetupTwoFactor(ctx, req.UserId)
 	if err != nil {
 		errMsg := err.Error()
 		resp.BaseResp = &common.BaseResp{
@@ -354,8 +482,9 @@ func (s *UserServiceImpl) BatchCheckUsernames(ctx context.Context, req *user.Bat
 		return resp, nil
 	}
 
-	resp.AvailableMap = availableMap
-	successMsg := "批量检查用户名成功"
+	resp.Secret = secret
+	resp.OtpauthUri = uri
+	successMsg := "请使用验证器扫码后提交验证码"
 	resp.BaseResp = &common.BaseResp{
 		StatusCode: 0,
 		Msg:        &successMsg,
@@ -363,11 +492,11 @@ func (s *UserServiceImpl) BatchCheckUsernames(ctx context.Context, req *user.Bat
 	return resp, nil
 }
 
-// SearchUsers implements the UserServiceImpl interface.
-func (s *UserServiceImpl) SearchUsers(ctx context.Context, req *user.SearchUsersReq) (resp *user.SearchUsersResp, err error) {
-	resp = &user.SearchUsersResp{}
+// EnableTwoFactor implements the UserServiceImpl interface.
+func (s *UserServiceImpl) EnableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq) (resp *user.RecoveryCodesResp, err error) {
+	resp = &user.RecoveryCodesResp{}
 
-	users, total, err := s.userService.SearchUsers(ctx, req.Keyword, int(req.Page), int(req.PageSize))
+	codes, err := s.userService.EnableTwoFactor(ctx, req.UserId, req.Code)
 	if err != nil {
 		errMsg := err.Error()
 		resp.BaseResp = &common.BaseResp{
@@ -377,30 +506,8 @@ func (s *UserServiceImpl) SearchUsers(ctx context.Context, req *user.SearchUsers
 		return resp, nil
 	}
 
-	userList := make([]*common.User, 0, len(users))
-	for _, user := range users {
-		var avatarPtr *string
-		if user.Avatar != "" {
-			avatarPtr = &user.Avatar
-		}
-		var aboutPtr *string
-		if user.About != "" {
-			aboutPtr = &user.About
-		}
-
-		userList = append(userList, &common.User{
-			Id:            user.ID,
-			Username:      user.Username,
-			Avatar:        avatarPtr,
-			About:         aboutPtr,
-			FollowCount:   user.FollowCount,
-			FollowerCount: user.FollowerCount,
-		})
-	}
-
-	resp.Users = userList
-	resp.Total = total
-	successMsg := "搜索用户成功"
+	resp.RecoveryCodes = codes
+	successMsg := "已开启两步验证，请妥善保存恢复码"
 	resp.BaseResp = &common.BaseResp{
 		StatusCode: 0,
 		Msg:        &successMsg,
@@ -408,11 +515,11 @@ func (s *UserServiceImpl) SearchUsers(ctx context.Context, req *user.SearchUsers
 	return resp, nil
 }
 
-// UpdateFollowCount implements the UserServiceImpl interface.
-func (s *UserServiceImpl) UpdateFollowCount(ctx context.Context, req *user.UpdateFollowCountReq) (resp *common.BaseResp, err error) {
+// DisableTwoFactor implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DisableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq) (resp *common.BaseResp, err error) {
 	resp = &common.BaseResp{}
 
-	err = s.userService.UpdateFollowCount(ctx, req.UserId, req.Delta)
+	err = s.userService.DisableTwoFactor(ctx, req.UserId, req.Code)
 	if err != nil {
 		errMsg := err.Error()
 		resp.StatusCode = -1
@@ -420,17 +527,165 @@ func (s *UserServiceImpl) UpdateFollowCount(ctx context.Context, req *user.Updat
 		return resp, nil
 	}
 
-	successMsg := "更新关注数成功"
+	successMsg := "已关闭两步验证"
 	resp.StatusCode = 0
 	resp.Msg = &successMsg
 	return resp, nil
 }
 
-// UpdateFollowerCount implements the UserServiceImpl interface.
-func (s *UserServiceImpl) UpdateFollowerCount(ctx context.Context, req *user.UpdateFollowerCountReq) (resp *common.BaseResp, err error) {
+// RegenerateRecoveryCodes implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RegenerateRecoveryCodes(ctx context.Context, req *user.TwoFactorCodeReq) (resp *user.RecoveryCodesResp, err error) {
+	resp = &user.RecoveryCodesResp{}
+
+	codes, err := s.userService.RegenerateRecoveryCodes(ctx, req.UserId, req.Code)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.RecoveryCodes = codes
+	successMsg := "已重新生成恢复码，旧恢复码已失效"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// GetTwoFactorStatus implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetTwoFactorStatus(ctx context.Context, req *user.TwoFactorStatusReq) (resp *user.TwoFactorStatusResp, err error) {
+	resp = &user.TwoFactorStatusResp{}
+
+	enabled, remaining, err := s.userService.GetTwoFactorStatus(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Enabled = enabled
+	resp.RemainingRecoveryCodes = remaining
+	successMsg := "获取两步验证状态成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// StartOAuth implements the UserServiceImpl interface.
+func (s *UserServiceImpl) StartOAuth(ctx context.Context, req *user.OAuthStartReq) (resp *user.OAuthStartResp, err error) {
+	resp = &user.OAuthStartResp{}
+
+	authURL, err := s.userService.StartOAuth(ctx, req.Provider, req.UserId, req.BrowserBinding)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.AuthUrl = authURL
+	successMsg := "获取授权地址成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// OAuthCallback implements the UserServiceImpl interface.
+func (s *UserServiceImpl) OAuthCallback(ctx context.Context, req *user.OAuthCallbackReq) (resp *user.LoginRegisterResp, err error) {
+	resp = &user.LoginRegisterResp{}
+
+	result, err := s.userService.OAuthCallback(ctx, req.Provider, req.State, req.Code, req.BrowserBinding, toDeviceInfo(req.Device))
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	//绑定流程不签发令牌
+	if result.Linked {
+		resp.IdentityLinked = true
+		successMsg := "绑定成功"
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Registered = result.Registered
+	if result.ChallengeToken != "" {
+		resp.TwoFactorRequired = true
+		resp.ChallengeToken = result.ChallengeToken
+		resp.ChallengeExpiresAt = result.ChallengeExpiresAt.Unix()
+		successMsg := "请输入两步验证码"
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		}
+		return resp, nil
+	}
+
+	fillLoginResp(resp, result.User, result.Tokens)
+	successMsg := "登录成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// ListIdentities implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListIdentities(ctx context.Context, req *user.IdentityListReq) (resp *user.IdentityListResp, err error) {
+	resp = &user.IdentityListResp{}
+
+	identities, err := s.userService.ListIdentities(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Identities = make([]*user.Identity, 0, len(identities))
+	for _, identity := range identities {
+		resp.Identities = append(resp.Identities, &user.Identity{
+			Provider:   identity.Provider,
+			Email:      identity.Email,
+			Name:       identity.Name,
+			CreateTime: identity.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	successMsg := "获取第三方账号成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// UnlinkIdentity implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityReq) (resp *common.BaseResp, err error) {
 	resp = &common.BaseResp{}
 
-	err = s.userService.UpdateFollowerCount(ctx, req.UserId, req.Delta)
+	err = s.userService.UnlinkIdentity(ctx, req.UserId, req.Provider)
 	if err != nil {
 		errMsg := err.Error()
 		resp.StatusCode = -1
@@ -438,8 +693,554 @@ func (s *UserServiceImpl) UpdateFollowerCount(ctx context.Context, req *user.Upd
 		return resp, nil
 	}
 
-	successMsg := "更新粉丝数成功"
+	successMsg := "解除绑定成功"
 	resp.StatusCode = 0
 	resp.Msg = &successMsg
 	return resp, nil
 }
+
+// RequestAccountDeletion implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestAccountDeletion(ctx context.Context, req *user.AccountDeletionReq) (resp *user.AccountDeletionResp, err error) {
+	resp = &user.AccountDeletionResp{}
+
+	deletion, err := s.userService.RequestAccountDeletion(ctx, req.UserId, req.Password, req.GetTwoFactorCode())
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	fillAccountDeletionResp(resp, deletion)
+	successMsg := "注销申请已提交"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// GetAccountDeletion implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetAccountDeletion(ctx context.Context, req *user.AccountDeletionStatusReq) (resp *user.AccountDeletionResp, err error) {
+	resp = &user.AccountDeletionResp{}
+
+	deletion, err := s.userService.GetAccountDeletion(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	fillAccountDeletionResp(resp, deletion)
+	successMsg := "获取注销申请成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// 没有注销申请时状态为none
+func fillAccountDeletionResp(resp *user.AccountDeletionResp, deletion *model.AccountDeletion) {
+	if deletion == nil {
+		resp.Status = "none"
+		return
+	}
+	resp.Status = deletion.Status
+	resp.RequestTime = deletion.CreatedAt.Format("2006-01-02 15:04:05")
+	resp.ScheduledTime = deletion.ScheduledAt.Format("2006-01-02 15:04:05")
+}
+
+// CancelAccountDeletion implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CancelAccountDeletion(ctx context.Context, req *user.CancelAccountDeletionReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.CancelAccountDeletion(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "已撤销注销申请"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// RequestDataExport implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestDataExport(ctx context.Context, req *user.DataExportReq) (resp *user.DataExportResp, err error) {
+	resp = &user.DataExportResp{}
+
+	export, err := s.userService.RequestDataExport(ctx, req.UserId, req.GetTwoFactorCode())
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Export = toDataExport(export, "")
+	successMsg := "数据导出申请已提交，完成后将通过系统通知发送下载链接"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// GetDataExport implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetDataExport(ctx context.Context, req *user.DataExportStatusReq) (resp *user.DataExportResp, err error) {
+	resp = &user.DataExportResp{}
+
+	export, downloadURL, err := s.userService.GetDataExport(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Export = toDataExport(export, downloadURL)
+	successMsg := "获取数据导出成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+func toDataExport(export *model.DataExport, downloadURL string) *user.DataExport {
+	result := &user.DataExport{
+		ExportId:    export.ID,
+		Status:      export.Status,
+		CreateTime:  export.CreatedAt.Format("2006-01-02 15:04:05"),
+		DownloadUrl: downloadURL,
+	}
+	if export.CompletedAt != nil {
+		result.CompleteTime = export.CompletedAt.Format("2006-01-02 15:04:05")
+	}
+	if export.ExpiresAt != nil {
+		result.ExpireTime = export.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	return result
+}
+
+// GetUserRoles implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetUserRoles(ctx context.Context, req *user.UserRolesReq) (resp *user.UserRolesResp, err error) {
+	resp = &user.UserRolesResp{}
+
+	roles, err := s.userService.GetUserRoles(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Roles = roles
+	resp.Permissions = rbac.Permissions(roles)
+	successMsg := "获取角色成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// AssignRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) AssignRole(ctx context.Context, req *user.RoleReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.AssignRole(ctx, req.OperatorId, req.UserId, req.Role)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "授予角色成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// RevokeRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeRole(ctx context.Context, req *user.RoleReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.RevokeRole(ctx, req.OperatorId, req.UserId, req.Role)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "收回角色成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// BanUser implements the UserServiceImpl interface.
+func (s *UserServiceImpl) BanUser(ctx context.Context, req *user.BanUserReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.BanUser(ctx, req.OperatorId, req.UserId, req.Banned)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "操作成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+func toDeviceInfo(device *user.DeviceInfo) *model.DeviceInfo {
+	if device == nil {
+		return nil
+	}
+	return &model.DeviceInfo{
+		DeviceID:   device.DeviceId,
+		DeviceName: device.DeviceName,
+		Platform:   device.Platform,
+		IP:         device.Ip,
+		UserAgent:  device.UserAgent,
+	}
+}
+
+// GetUserInfoByUsername implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetUserInfoByUsername(ctx context.Context, req *user.UserInfoByUsernameReq) (resp *user.UserInfoResp, err error) {
+	resp = &user.UserInfoResp{}
+
+	user, err := s.userService.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	var avatarPtr *string
+	if user.Avatar != "" {
+		avatarPtr = &user.Avatar
+	}
+	var aboutPtr *string
+	if user.About != "" {
+		aboutPtr = &user.About
+	}
+
+	resp.User = &common.User{
+		Id:            user.ID,
+		Username:      user.Username,
+		Avatar:        avatarPtr,
+		About:         aboutPtr,
+		FollowCount:   user.FollowCount,
+		FollowerCount: user.FollowerCount,
+		IsPrivate:     &user.IsPrivate,
+		Level:         user.Level,
+	}
+	successMsg := "获取用户信息成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// UpdateAvatar implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateAvatar(ctx context.Context, req *user.UpdateAvatarReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	_, err = s.userService.UpdateAvatar(ctx, req.UserId, req.AvatarData)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "更新头像成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// BatchCheckUsernames implements the UserServiceImpl interface.
+func (s *UserServiceImpl) BatchCheckUsernames(ctx context.Context, req *user.BatchCheckUsernamesReq) (resp *user.BatchCheckUsernamesResp, err error) {
+	resp = &user.BatchCheckUsernamesResp{}
+
+	availableMap, err := s.userService.BatchCheckUsernames(ctx, req.Usernames)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.AvailableMap = availableMap
+	successMsg := "批量检查用户名成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// SearchUsers implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SearchUsers(ctx context.Context, req *user.SearchUsersReq) (resp *user.SearchUsersResp, err error) {
+	resp = &user.SearchUsersResp{}
+
+	users, nextCursor, total, err := s.userService.SearchUsers(ctx, req.Keyword, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	userList := make([]*common.User, 0, len(users))
+	for _, user := range users {
+		var avatarPtr *string
+		if user.Avatar != "" {
+			avatarPtr = &user.Avatar
+		}
+		var aboutPtr *string
+		if user.About != "" {
+			aboutPtr = &user.About
+		}
+
+		userList = append(userList, &common.User{
+			Id:            user.ID,
+			Username:      user.Username,
+			Avatar:        avatarPtr,
+			About:         aboutPtr,
+			FollowCount:   user.FollowCount,
+			FollowerCount: user.FollowerCount,
+			IsPrivate:     &user.IsPrivate,
+			Level:         user.Level,
+		})
+	}
+
+	resp.Users = userList
+	resp.Total = total
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	successMsg := "搜索用户成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// UpdateFollowCount implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateFollowCount(ctx context.Context, req *user.UpdateFollowCountReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.UpdateFollowCount(ctx, req.UserId, req.Delta)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "更新关注数成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// UpdateFollowerCount implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateFollowerCount(ctx context.Context, req *user.UpdateFollowerCountReq) (resp *common.BaseResp, err error) {
+	resp = &common.BaseResp{}
+
+	err = s.userService.UpdateFollowerCount(ctx, req.UserId, req.Delta)
+	if err != nil {
+		errMsg := err.Error()
+		resp.StatusCode = -1
+		resp.Msg = &errMsg
+		return resp, nil
+	}
+
+	successMsg := "更新粉丝数成功"
+	resp.StatusCode = 0
+	resp.Msg = &successMsg
+	return resp, nil
+}
+
+// GetUserLevel implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetUserLevel(ctx context.Context, req *user.UserLevelReq) (resp *user.UserLevelResp, err error) {
+	resp = &user.UserLevelResp{}
+
+	info, err := s.userService.GetUserLevel(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Level = info.Level
+	resp.Experience = info.Experience
+	resp.NextLevelExperience = info.NextLevelExperience
+	resp.TodayExperience = info.TodayExperience
+	successMsg := "获取等级成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// GetSettings implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetSettings(ctx context.Context, req *user.GetSettingsReq) (resp *user.SettingsResp, err error) {
+	resp = &user.SettingsResp{}
+
+	userSettings, err := s.userService.GetSettings(ctx, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Settings = toUserSettings(userSettings)
+	successMsg := "获取设置成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// UpdateSettings implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateSettings(ctx context.Context, req *user.UpdateSettingsReq) (resp *user.SettingsResp, err error) {
+	resp = &user.SettingsResp{}
+
+	userSettings, err := s.userService.UpdateSettings(ctx, req.UserId, &service.SettingsUpdate{
+		DMPermission:      req.DmPermission,
+		CommentPermission: req.CommentPermission,
+		LikedListPublic:   req.LikedListPublic,
+		StarredListPublic: req.StarredListPublic,
+		FollowListVisible: req.FollowListVisible,
+		AllowRemix:        req.AllowRemix,
+		ShowOnlineStatus:  req.ShowOnlineStatus,
+		Version:           req.Version,
+	})
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Settings = toUserSettings(userSettings)
+	successMsg := "更新设置成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+func toUserSettings(userSettings *model.UserSettings) *user.UserSettings {
+	return &user.UserSettings{
+		DmPermission:      userSettings.DMPermission,
+		CommentPermission: userSettings.CommentPermission,
+		LikedListPublic:   userSettings.LikedListPublic,
+		StarredListPublic: userSettings.StarredListPublic,
+		FollowListVisible: userSettings.FollowListVisible,
+		AllowRemix:        userSettings.AllowRemix,
+		ShowOnlineStatus:  userSettings.ShowOnlineStatus,
+		Version:           userSettings.Version,
+	}
+}
+
+// ChangeUsername implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ChangeUsername(ctx context.Context, req *user.ChangeUsernameReq) (resp *user.ChangeUsernameResp, err error) {
+	resp = &user.ChangeUsernameResp{}
+
+	updatedUser, nextChangeTime, err := s.userService.ChangeUsername(ctx, req.UserId, req.Username, req.GetTwoFactorCode())
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.Username = updatedUser.Username
+	resp.NextChangeTime = nextChangeTime.Unix()
+	successMsg := "修改用户名成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
+
+// GetUsernameHistory implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetUsernameHistory(ctx context.Context, req *user.UsernameHistoryReq) (resp *user.UsernameHistoryResp, err error) {
+	resp = &user.UsernameHistoryResp{}
+
+	histories, err := s.userService.GetUsernameHistory(ctx, req.OperatorId, req.UserId)
+	if err != nil {
+		errMsg := err.Error()
+		resp.BaseResp = &common.BaseResp{
+			StatusCode: -1,
+			Msg:        &errMsg,
+		}
+		return resp, nil
+	}
+
+	resp.History = make([]*user.UsernameChange, 0, len(histories))
+	for _, history := range histories {
+		resp.History = append(resp.History, &user.UsernameChange{
+			OldUsername:   history.OldUsername,
+			RenamedTo:     history.NewUsername,
+			ChangedAt:     history.CreatedAt.Unix(),
+			RedirectUntil: history.RedirectUntil.Unix(),
+		})
+	}
+	successMsg := "获取用户名修改记录成功"
+	resp.BaseResp = &common.BaseResp{
+		StatusCode: 0,
+		Msg:        &successMsg,
+	}
+	return resp, nil
+}
@@ -12,6 +12,12 @@ type User struct {
 	About         string    `gorm:"type:text;default:'';comment:个人简介"`
 	FollowCount   int64     `gorm:"default:0;comment:关注数"`
 	FollowerCount int64     `gorm:"default:0;comment:粉丝数"`
+	IsPrivate     bool      `gorm:"default:false;comment:是否私密账号"`
+	PasswordUnset bool      `gorm:"default:false;comment:是否未设置密码，第三方登录自动注册的账号为true"`
+	IsDeleted     bool      `gorm:"default:false;comment:是否已注销，注销后资料被匿名化且不能再登录"`
+	IsBanned      bool      `gorm:"default:false;comment:是否被封禁，封禁后不能登录"`
+	Experience    int64     `gorm:"default:0;comment:累计经验值"`
+	Level         int32     `gorm:"default:1;comment:等级"`
 	CreatedAt     time.Time `gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt     time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
@@ -19,3 +25,255 @@ type User struct {
 func (User) TableName() string {
 	return "users"
 }
+
+// 登录会话，每次登录签发的令牌对应一条记录，刷新令牌轮换时沿用同一会话
+type UserSession struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	SessionID  string     `gorm:"size:64;uniqueIndex;not null;comment:会话ID"`
+	UserID     int64      `gorm:"index:idx_user_session_user_device,priority:1;not null;comment:用户ID"`
+	DeviceID   string     `gorm:"size:128;index:idx_user_session_user_device,priority:2;comment:设备标识"`
+	DeviceName string     `gorm:"size:100;comment:设备名称"`
+	Platform   string     `gorm:"size:50;comment:平台"`
+	IP         string     `gorm:"size:64;comment:登录IP"`
+	UserAgent  string     `gorm:"size:500;comment:User-Agent"`
+	LastSeenAt time.Time  `gorm:"not null;comment:最近活跃时间"`
+	ExpiresAt  time.Time  `gorm:"index;not null;comment:过期时间"`
+	RevokedAt  *time.Time `gorm:"index;comment:吊销时间"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
+
+// 登录设备信息，由网关从请求中收集
+type DeviceInfo struct {
+	DeviceID   string
+	DeviceName string
+	Platform   string
+	IP         string
+	UserAgent  string
+}
+
+// 安全审计事件类型
+const (
+	AuditEventLoginLockout     = "login_lockout"
+	AuditEventRegisterLockout  = "register_lockout"
+	AuditEventTwoFactorLockout = "two_factor_lockout"
+
+	AuditEventTwoFactorEnabled      = "two_factor_enabled"
+	AuditEventTwoFactorDisabled     = "two_factor_disabled"
+	AuditEventRecoveryCodesRenewed  = "recovery_codes_renewed"
+	AuditEventRecoveryCodeUsed      = "recovery_code_used"
+	AuditEventTwoFactorChallengeOut = "two_factor_challenge_exhausted"
+	AuditEventIdentityLinked        = "identity_linked"
+	AuditEventIdentityUnlinked      = "identity_unlinked"
+	AuditEventDeletionRequested     = "account_deletion_requested"
+	AuditEventDeletionCancelled     = "account_deletion_cancelled"
+	AuditEventDeletionCompleted     = "account_deletion_completed"
+	AuditEventDataExportRequested   = "data_export_requested"
+	AuditEventRoleGranted           = "role_granted"
+	AuditEventRoleRevoked           = "role_revoked"
+	AuditEventUserBanned            = "user_banned"
+	AuditEventUserUnbanned          = "user_unbanned"
+	AuditEventUsernameChanged       = "username_changed"
+)
+
+// 账号安全审计日志，UserID为0表示事件未关联到具体用户
+type SecurityAuditLog struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64     `gorm:"index;comment:用户ID"`
+	Event     string    `gorm:"size:50;index;not null;comment:事件类型"`
+	Subject   string    `gorm:"size:255;comment:事件对象"`
+	IP        string    `gorm:"size:64;comment:来源IP"`
+	Detail    string    `gorm:"size:500;comment:事件详情"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index;comment:创建时间"`
+}
+
+func (SecurityAuditLog) TableName() string {
+	return "security_audit_logs"
+}
+
+// 两步验证设置，获取密钥后Enabled为false，首次验证码校验通过后开启
+type UserTwoFactor struct {
+	ID           int64      `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID       int64      `gorm:"uniqueIndex;not null;comment:用户ID"`
+	Secret       string     `gorm:"size:64;not null;comment:TOTP密钥"`
+	Enabled      bool       `gorm:"default:false;comment:是否已开启"`
+	LastUsedStep int64      `gorm:"default:0;comment:最近使用的时间步，防止验证码重放"`
+	EnabledAt    *time.Time `gorm:"comment:开启时间"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (UserTwoFactor) TableName() string {
+	return "user_two_factors"
+}
+
+// 两步验证恢复码，只保存哈希，每个恢复码只能使用一次
+type UserRecoveryCode struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64      `gorm:"index;not null;comment:用户ID"`
+	CodeHash  string     `gorm:"size:64;not null;comment:恢复码哈希"`
+	UsedAt    *time.Time `gorm:"comment:使用时间"`
+	CreatedAt time.Time  `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
+
+// 第三方账号绑定，同一第三方账号只能绑定一个用户，同一用户在每个提供方只能绑定一个账号
+type UserIdentity struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_identity_user_provider,priority:1;not null;comment:用户ID"`
+	Provider  string    `gorm:"size:50;uniqueIndex:idx_identity_user_provider,priority:2;uniqueIndex:idx_identity_provider_subject,priority:1;not null;comment:提供方"`
+	Subject   string    `gorm:"size:255;uniqueIndex:idx_identity_provider_subject,priority:2;not null;comment:第三方账号ID"`
+	Email     string    `gorm:"size:255;comment:第三方账号邮箱"`
+	Name      string    `gorm:"size:100;comment:第三方账号昵称"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:绑定时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+// 账号注销申请状态
+const (
+	DeletionStatusPending   = "pending"
+	DeletionStatusCancelled = "cancelled"
+	DeletionStatusCompleted = "completed"
+)
+
+// 账号注销申请，冷静期内可以撤销，到期后由定时任务执行注销，每个用户只保留最近一次申请
+type AccountDeletion struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID      int64      `gorm:"uniqueIndex;not null;comment:用户ID"`
+	Status      string     `gorm:"size:20;index:idx_account_deletion_due,priority:1;not null;comment:状态"`
+	ScheduledAt time.Time  `gorm:"index:idx_account_deletion_due,priority:2;not null;comment:计划注销时间"`
+	CompletedAt *time.Time `gorm:"comment:注销完成时间"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;comment:申请时间"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+// 数据导出任务状态
+const (
+	ExportStatusPending    = "pending"
+	ExportStatusProcessing = "processing"
+	ExportStatusCompleted  = "completed"
+	ExportStatusFailed     = "failed"
+)
+
+// 个人数据导出任务，导出文件为ZIP格式，保存在私有桶中，通过有时效的预签名链接下载
+type DataExport struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement;comment:任务ID"`
+	UserID      int64      `gorm:"index;not null;comment:用户ID"`
+	Status      string     `gorm:"size:20;index;not null;comment:状态"`
+	ObjectName  string     `gorm:"size:255;comment:导出文件对象名"`
+	CompletedAt *time.Time `gorm:"comment:完成时间"`
+	ExpiresAt   *time.Time `gorm:"comment:下载链接过期时间"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;comment:申请时间"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+// 用户被授予的角色，所有用户默认拥有的user角色不单独保存
+type UserRole struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_user_role,priority:1;not null;comment:用户ID"`
+	Role      string    `gorm:"size:32;uniqueIndex:idx_user_role,priority:2;index;not null;comment:角色"`
+	GrantedBy int64     `gorm:"default:0;comment:授予者ID，0表示系统授予"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:授予时间"`
+}
+
+func (UserRole) TableName() string {
+	return "user_roles"
+}
+
+// 经验值发放记录，同一来源同一对象每天只记录一次，Day为行为发生的日期
+type ExperienceLog struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_experience_ref,priority:1;index:idx_experience_day,priority:1;not null;comment:用户ID"`
+	Source    string    `gorm:"size:32;uniqueIndex:idx_experience_ref,priority:2;index:idx_experience_day,priority:3;not null;comment:经验值来源"`
+	RefID     string    `gorm:"size:64;uniqueIndex:idx_experience_ref,priority:3;default:'';comment:关联对象"`
+	Day       string    `gorm:"size:10;uniqueIndex:idx_experience_ref,priority:4;index:idx_experience_day,priority:2;not null;comment:日期"`
+	Points    int64     `gorm:"not null;comment:获得的经验值"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+}
+
+func (ExperienceLog) TableName() string {
+	return "experience_logs"
+}
+
+// 用户名修改记录，RedirectUntil之前旧用户名仍可访问并跳转到用户当前的用户名，其他用户不能使用
+type UsernameHistory struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID        int64     `gorm:"index;not null;comment:用户ID"`
+	OldUsername   string    `gorm:"size:32;index:idx_username_history_old,priority:1;not null;comment:旧用户名"`
+	NewUsername   string    `gorm:"size:32;not null;comment:新用户名"`
+	RedirectUntil time.Time `gorm:"index:idx_username_history_old,priority:2;not null;comment:旧用户名跳转截止时间"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;comment:修改时间"`
+}
+
+func (UsernameHistory) TableName() string {
+	return "username_histories"
+}
+
+// 私信和评论权限的适用范围，followers为关注了我的用户，friends为互相关注的用户
+const (
+	SettingAudienceEveryone  = "everyone"
+	SettingAudienceFollowers = "followers"
+	SettingAudienceFriends   = "friends"
+	SettingAudienceNobody    = "nobody"
+)
+
+// 用户隐私和偏好设置，没有记录时使用DefaultUserSettings的默认值；
+// Version每次更新加一，用于并发更新时的冲突检测
+type UserSettings struct {
+	UserID            int64     `gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	DMPermission      string    `gorm:"size:16;not null;comment:谁可以私信我"`
+	CommentPermission string    `gorm:"size:16;not null;comment:谁可以评论我的视频"`
+	LikedListPublic   bool      `gorm:"not null;comment:点赞列表是否公开"`
+	StarredListPublic bool      `gorm:"not null;comment:收藏列表是否公开"`
+	FollowListVisible bool      `gorm:"not null;comment:关注和粉丝列表是否对他人可见"`
+	AllowRemix        bool      `gorm:"not null;comment:是否允许他人二次创作我的视频"`
+	ShowOnlineStatus  bool      `gorm:"not null;comment:是否展示在线状态"`
+	Version           int64     `gorm:"not null;default:0;comment:版本号"`
+	CreatedAt         time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (UserSettings) TableName() string {
+	return "user_settings"
+}
+
+// 默认设置与引入设置之前的行为一致：所有人可私信、可评论，各列表公开
+func DefaultUserSettings(userID int64) *UserSettings {
+	return &UserSettings{
+		UserID:            userID,
+		DMPermission:      SettingAudienceEveryone,
+		CommentPermission: SettingAudienceEveryone,
+		LikedListPublic:   true,
+		StarredListPublic: true,
+		FollowListVisible: true,
+		AllowRemix:        true,
+		ShowOnlineStatus:  true,
+	}
+}
+
+func IsValidSettingAudience(audience string) bool {
+	switch audience {
+	case SettingAudienceEveryone, SettingAudienceFollowers, SettingAudienceFriends, SettingAudienceNobody:
+		return true
+	}
+	return false
+}
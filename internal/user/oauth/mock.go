@@ -0,0 +1,169 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"shortvideo/pkg/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const MockProviderName = "mock"
+
+// 模拟授权码和ID令牌的有效期
+const (
+	mockCodeExpire    = 2 * time.Minute
+	mockIDTokenExpire = 5 * time.Minute
+)
+
+var (
+	ErrMockAuthorizeInvalid = errors.New("授权请求参数无效")
+)
+
+var mockLoginPattern = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// MockProvider 本地模拟的OIDC提供方，授权码和ID令牌都是用配置密钥签名的JWT，
+// 网关和用户服务各自持有一个实例也能完成整个流程，不需要访问外网。
+// 授权码只会跳转到配置的回调地址
+type MockProvider struct {
+	cfg         config.OAuthMockConfig
+	redirectURI string
+}
+
+func NewMockProvider(oauthConfig config.OAuthConfig) *MockProvider {
+	return &MockProvider{
+		cfg:         oauthConfig.Mock,
+		redirectURI: strings.ReplaceAll(oauthConfig.RedirectURL, "{provider}", url.PathEscape(MockProviderName)),
+	}
+}
+
+func (p *MockProvider) Name() string {
+	return MockProviderName
+}
+
+func (p *MockProvider) AuthCodeURL(req *AuthRequest) string {
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.cfg.ClientID)
+	params.Set("redirect_uri", req.RedirectURI)
+	params.Set("scope", "openid profile email")
+	params.Set("state", req.State)
+	params.Set("nonce", req.Nonce)
+	params.Set("code_challenge", req.CodeChallenge)
+	params.Set("code_challenge_method", "S256")
+	return p.cfg.Issuer + "/authorize?" + params.Encode()
+}
+
+// 授权码中保存的授权请求
+type mockCodeClaims struct {
+	Nonce         string `json:"nonce"`
+	CodeChallenge string `json:"code_challenge"`
+	RedirectURI   string `json:"redirect_uri"`
+	Login         string `json:"login"`
+	jwt.RegisteredClaims
+}
+
+// 模拟授权页：不展示登录界面，直接以login_hint作为登录的用户签发授权码，返回带code和state的回调地址
+func (p *MockProvider) Authorize(params url.Values) (string, error) {
+	redirectURI := params.Get("redirect_uri")
+	if params.Get("response_type") != "code" || params.Get("client_id") != p.cfg.ClientID ||
+		redirectURI != p.redirectURI || params.Get("code_challenge") == "" || params.Get("code_challenge_method") != "S256" {
+		return "", ErrMockAuthorizeInvalid
+	}
+
+	login := mockLoginPattern.ReplaceAllString(params.Get("login_hint"), "")
+	if login == "" {
+		login = "mock-user"
+	}
+
+	now := time.Now()
+	claims := &mockCodeClaims{
+		Nonce:         params.Get("nonce"),
+		CodeChallenge: params.Get("code_challenge"),
+		RedirectURI:   redirectURI,
+		Login:         login,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    p.cfg.Issuer,
+			Subject:   login,
+			Audience:  jwt.ClaimStrings{p.cfg.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(mockCodeExpire)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	code, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(p.codeKey())
+	if err != nil {
+		return "", err
+	}
+
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", ErrMockAuthorizeInvalid
+	}
+	query := target.Query()
+	query.Set("code", code)
+	query.Set("state", params.Get("state"))
+	target.RawQuery = query.Encode()
+	return target.String(), nil
+}
+
+func (p *MockProvider) Exchange(ctx context.Context, code, codeVerifier, redirectURI string) (string, error) {
+	claims := &mockCodeClaims{}
+	_, err := jwt.ParseWithClaims(code, claims, func(token *jwt.Token) (interface{}, error) {
+		return p.codeKey(), nil
+	},
+		jwt.WithValidMethods([]string{"HS256"}),
+		jwt.WithIssuer(p.cfg.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.RedirectURI != redirectURI || CodeChallenge(codeVerifier) != claims.CodeChallenge {
+		return "", ErrExchangeFailed
+	}
+
+	now := time.Now()
+	idClaims := &idTokenClaims{
+		Nonce:             claims.Nonce,
+		Email:             claims.Login + "@mock.local",
+		EmailVerified:     true,
+		Name:              claims.Login,
+		PreferredUsername: claims.Login,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    p.cfg.Issuer,
+			Subject:   claims.Subject,
+			Audience:  jwt.ClaimStrings{p.cfg.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(mockIDTokenExpire)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, idClaims).SignedString(p.idTokenKey())
+}
+
+func (p *MockProvider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return p.idTokenKey(), nil
+	},
+		jwt.WithValidMethods([]string{"HS256"}),
+		jwt.WithIssuer(p.cfg.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	return claims.identity(MockProviderName, nonce)
+}
+
+// 授权码和ID令牌使用不同的密钥，避免授权码被当作ID令牌使用
+func (p *MockProvider) codeKey() []byte {
+	return []byte(p.cfg.Secret + ":code")
+}
+
+func (p *MockProvider) idTokenKey() []byte {
+	return []byte(p.cfg.Secret + ":id_token")
+}
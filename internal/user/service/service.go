@@ -1,85 +1,271 @@
 package service
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	messageDao "shortvideo/internal/message/dao"
+	messageModel "shortvideo/internal/message/model"
 	"shortvideo/internal/user/dao"
 	"shortvideo/internal/user/model"
+	"shortvideo/internal/user/oauth"
+	"shortvideo/internal/user/settings"
 	"shortvideo/pkg/cache"
+	"shortvideo/pkg/captcha"
+	"shortvideo/pkg/config"
 	"shortvideo/pkg/es"
 	"shortvideo/pkg/jwt"
 	"shortvideo/pkg/logger"
 	"shortvideo/pkg/mq"
+	"shortvideo/pkg/pagination"
+	"shortvideo/pkg/ratelimit"
+	"shortvideo/pkg/rbac"
 	"shortvideo/pkg/storage"
+	"shortvideo/pkg/textfilter"
+	"shortvideo/pkg/totp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
+// 会话最近活跃时间的更新间隔
+const sessionTouchInterval = 5 * time.Minute
+
 var (
-	ErrUserNotFound     = errors.New("用户不存在")
-	ErrUsernameExists   = errors.New("用户名已存在")
-	ErrInvalidPassword  = errors.New("密码错误")
-	ErrOldPasswordWrong = errors.New("旧密码错误")
-	ErrTokenInvalid     = errors.New("令牌无效")
-	ErrInternalServer   = errors.New("服务器内部错误")
-	ErrFileUploadFailed = errors.New("文件上传失败")
-	ErrInvalidFile      = errors.New("无效的文件")
+	ErrUserNotFound          = errors.New("用户不存在")
+	ErrUsernameExists        = errors.New("用户名已存在")
+	ErrInvalidLogin          = errors.New("用户名或密码错误")
+	ErrTooManyAttempts       = errors.New("尝试次数过多，请稍后再试")
+	ErrCaptchaRequired       = errors.New("请先完成人机验证")
+	ErrCaptchaInvalid        = errors.New("人机验证未通过")
+	ErrOldPasswordWrong      = errors.New("旧密码错误")
+	ErrTokenInvalid          = errors.New("令牌无效")
+	ErrTokenRevoked          = errors.New("令牌已失效，请重新登录")
+	ErrRefreshReused         = errors.New("刷新令牌已被使用，请重新登录")
+	ErrSessionNotFound       = errors.New("会话不存在")
+	ErrInternalServer        = errors.New("服务器内部错误")
+	ErrFileUploadFailed      = errors.New("文件上传失败")
+	ErrInvalidFile           = errors.New("无效的文件")
+	ErrSensitiveContent      = errors.New("内容包含违规信息")
+	ErrTwoFactorEnabled      = errors.New("已开启两步验证")
+	ErrTwoFactorNotEnabled   = errors.New("未开启两步验证")
+	ErrTwoFactorNotSetup     = errors.New("请先获取两步验证密钥")
+	ErrTwoFactorRequired     = errors.New("请输入两步验证码")
+	ErrTwoFactorCodeInvalid  = errors.New("两步验证码错误")
+	ErrChallengeInvalid      = errors.New("验证已过期，请重新登录")
+	ErrOAuthStateInvalid     = errors.New("授权已过期，请重新发起")
+	ErrOAuthFailed           = errors.New("第三方登录失败")
+	ErrIdentityLinkedOther   = errors.New("该第三方账号已绑定其他用户")
+	ErrProviderLinked        = errors.New("已绑定该登录方式，请先解除绑定")
+	ErrIdentityNotLinked     = errors.New("未绑定该登录方式")
+	ErrLastLoginMethod       = errors.New("这是账号唯一的登录方式，请先设置密码或绑定其他登录方式")
+	ErrPasswordWrong         = errors.New("密码错误")
+	ErrDeletionPending       = errors.New("账号已申请注销，正在冷静期内")
+	ErrDeletionNotFound      = errors.New("没有可撤销的注销申请")
+	ErrExportInProgress      = errors.New("已有正在进行的数据导出")
+	ErrExportNotFound        = errors.New("没有数据导出记录")
+	ErrUserBanned            = errors.New("账号已被封禁")
+	ErrPermissionDenied      = rbac.ErrPermissionDenied
+	ErrInvalidRole           = errors.New("角色不存在")
+	ErrRoleGranted           = errors.New("用户已拥有该角色")
+	ErrRoleNotGranted        = errors.New("用户没有该角色")
+	ErrLastAdmin             = errors.New("不能收回最后一个管理员的角色")
+	ErrCannotBanSelf         = errors.New("不能封禁自己")
+	ErrRolesChanged          = errors.New("账号角色已变更，请刷新令牌")
+	ErrInvalidSetting        = errors.New("设置项无效")
+	ErrSettingsConflict      = errors.New("设置已被修改，请刷新后重试")
+	ErrInvalidUsername       = errors.New("用户名只能包含字母、数字和下划线，长度为3到32个字符")
+	ErrUsernameReserved      = errors.New("该用户名为保留用户名")
+	ErrUsernameUnchanged     = errors.New("新用户名与当前用户名相同")
+	ErrUsernameChangeTooSoon = errors.New("修改用户名过于频繁，请稍后再试")
 )
 
 type UserService interface {
 	//注册相关
-	Register(ctx context.Context, username, password, avatar, about string) (*model.User, string, error)
-	Login(ctx context.Context, username, password string) (*model.User, string, error)
+	Register(ctx context.Context, username, password, avatar, about string, device *model.DeviceInfo, captchaToken string) (*model.User, *jwt.TokenPair, error)
+	Login(ctx context.Context, username, password string, device *model.DeviceInfo, captchaToken string) (*LoginResult, error)
+	VerifyTwoFactorLogin(ctx context.Context, challengeToken, code string) (*model.User, *jwt.TokenPair, error)
 
 	//用户信息相关
 	GetUserByID(ctx context.Context, id int64) (*model.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
 	BatchGetUsersByIDs(ctx context.Context, ids []int64) (map[int64]*model.User, error)
-	UpdateUser(ctx context.Context, userID int64, avatar, about, oldPassword, newPassword string) error
+	UpdateUser(ctx context.Context, userID int64, avatar, about, oldPassword, newPassword, twoFactorCode string) error
 	UpdateAvatar(ctx context.Context, userID int64, avatarData []byte) (string, error)
+	SetAccountPrivacy(ctx context.Context, userID int64, isPrivate bool) error
 
 	//用户名检查
 	CheckUsernameAvailable(ctx context.Context, username string) (bool, error)
 	BatchCheckUsernames(ctx context.Context, usernames []string) (map[string]bool, error)
 
+	//用户名修改相关
+	ChangeUsername(ctx context.Context, userID int64, username, twoFactorCode string) (*model.User, time.Time, error)
+	GetUsernameHistory(ctx context.Context, operatorID, userID int64) ([]*model.UsernameHistory, error)
+
 	//统计相关
 	GetUserCount(ctx context.Context) (int64, error)
-	SearchUsers(ctx context.Context, keyword string, page, pageSize int) ([]*model.User, int64, error)
+	SearchUsers(ctx context.Context, keyword, cursor string, pageSize int, needTotal bool) ([]*model.User, string, int64, error)
 
 	//关注数相关
 	UpdateFollowCount(ctx context.Context, userID int64, delta int64) error
 	UpdateFollowerCount(ctx context.Context, userID int64, delta int64) error
 
 	//Token相关
-	VerifyToken(ctx context.Context, token string) (int64, error)
-	GenerateToken(userID int64) (string, error)
+	VerifyToken(ctx context.Context, token string) (*jwt.Claims, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*jwt.TokenPair, error)
+	Logout(ctx context.Context, token string) error
+	LogoutAll(ctx context.Context, userID int64) error
+
+	//登录会话相关
+	GetSessions(ctx context.Context, userID int64) ([]*model.UserSession, error)
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+
+	//两步验证相关
+	SetupTwoFactor(ctx context.Context, userID int64) (string, string, error)
+	EnableTwoFactor(ctx context.Context, userID int64, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, userID int64, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID int64, code string) ([]string, error)
+	GetTwoFactorStatus(ctx context.Context, userID int64) (bool, int64, error)
+
+	//第三方登录相关
+	StartOAuth(ctx context.Context, provider string, linkUserID int64, binding string) (string, error)
+	OAuthCallback(ctx context.Context, provider, state, code, binding string, device *model.DeviceInfo) (*LoginResult, error)
+	ListIdentities(ctx context.Context, userID int64) ([]*model.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID int64, provider string) error
+
+	//账号注销和数据导出相关
+	RequestAccountDeletion(ctx context.Context, userID int64, password, twoFactorCode string) (*model.AccountDeletion, error)
+	GetAccountDeletion(ctx context.Context, userID int64) (*model.AccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, userID int64) error
+	ProcessDueDeletions(ctx context.Context) (int, error)
+	RequestDataExport(ctx context.Context, userID int64, twoFactorCode string) (*model.DataExport, error)
+	GetDataExport(ctx context.Context, userID int64) (*model.DataExport, string, error)
+	ProcessDataExports(ctx context.Context) (int, error)
+
+	//角色权限相关
+	GetUserRoles(ctx context.Context, userID int64) ([]string, error)
+	AssignRole(ctx context.Context, operatorID, userID int64, role string) error
+	RevokeRole(ctx context.Context, operatorID, userID int64, role string) error
+	BanUser(ctx context.Context, operatorID, userID int64, banned bool) error
+	EnsureAdmins(ctx context.Context, usernames []string) error
+
+	//经验值和等级相关
+	AwardExperience(ctx context.Context, userID int64, source, refID string, occurredAt time.Time) error
+	GetUserLevel(ctx context.Context, userID int64) (*LevelInfo, error)
+
+	//隐私和偏好设置相关
+	GetSettings(ctx context.Context, userID int64) (*model.UserSettings, error)
+	UpdateSettings(ctx context.Context, userID int64, update *SettingsUpdate) (*model.UserSettings, error)
 
 	//事务相关
 	WithTransaction(ctx context.Context, fn func(txService UserService) error) error
 }
 
+// 登录结果，开启两步验证时只返回验证挑战，验证码通过后再签发令牌
+// 第三方授权回调时，Registered表示首次登录自动注册，Linked表示绑定流程已完成，此时不签发令牌
+type LoginResult struct {
+	User               *model.User
+	Tokens             *jwt.TokenPair
+	ChallengeToken     string
+	ChallengeExpiresAt time.Time
+	Registered         bool
+	Linked             bool
+}
+
+// 用户等级信息，NextLevelExperience为升到下一级所需的累计经验值，已满级时为0；
+// TodayExperience为当天各来源已获得的经验值
+type LevelInfo struct {
+	Level               int32
+	Experience          int64
+	NextLevelExperience int64
+	TodayExperience     map[string]int64
+}
+
+// 设置的部分更新，为nil的字段保持不变；Version不为nil时只在当前版本与之相同时更新
+type SettingsUpdate struct {
+	DMPermission      *string
+	CommentPermission *string
+	LikedListPublic   *bool
+	StarredListPublic *bool
+	FollowListVisible *bool
+	AllowRemix        *bool
+	ShowOnlineStatus  *bool
+	Version           *int64
+}
+
 type userServiceImpl struct {
-	repo          dao.UserRepository
-	jwtManager    *jwt.JWTManager
-	storage       storage.Storage
-	kafkaProducer *mq.Producer
-	cache         cache.Cache
-	es            *es.ESManager
+	repo             dao.UserRepository
+	sessionRepo      dao.SessionRepository
+	twoFactorRepo    dao.TwoFactorRepository
+	identityRepo     dao.IdentityRepository
+	roleRepo         dao.RoleRepository
+	experienceRepo   dao.ExperienceRepository
+	settingsRepo     dao.SettingsRepository
+	settings         settings.Client
+	usernameRepo     dao.UsernameHistoryRepository
+	deletionRepo     dao.AccountDeletionRepository
+	exportRepo       dao.DataExportRepository
+	personalDataRepo dao.PersonalDataRepository
+	auditRepo        dao.AuditLogRepository
+	notificationRepo messageDao.NotificationRepository
+	guard            *loginGuard
+	twoFactorConfig  config.TwoFactorConfig
+	providers        map[string]oauth.IdentityProvider
+	oauthConfig      config.OAuthConfig
+	accountConfig    config.AccountConfig
+	usernameConfig   config.UsernameConfig
+	experienceConfig config.ExperienceConfig
+	jwtManager       *jwt.JWTManager
+	storage          storage.Storage
+	kafkaProducer    *mq.Producer
+	cache            cache.Cache
+	es               *es.ESManager
 }
 
-func NewUserService(repo dao.UserRepository, jwtManager *jwt.JWTManager, storage storage.Storage,
-	kafkaProducer *mq.Producer, cache cache.Cache, es *es.ESManager) UserService {
+func NewUserService(repo dao.UserRepository, sessionRepo dao.SessionRepository, twoFactorRepo dao.TwoFactorRepository,
+	identityRepo dao.IdentityRepository, roleRepo dao.RoleRepository, experienceRepo dao.ExperienceRepository, settingsRepo dao.SettingsRepository,
+	usernameRepo dao.UsernameHistoryRepository, deletionRepo dao.AccountDeletionRepository,
+	exportRepo dao.DataExportRepository, personalDataRepo dao.PersonalDataRepository, auditRepo dao.AuditLogRepository, notificationRepo messageDao.NotificationRepository,
+	jwtManager *jwt.JWTManager, storage storage.Storage, kafkaProducer *mq.Producer, cache cache.Cache, es *es.ESManager) UserService {
 	return &userServiceImpl{
-		repo:          repo,
-		jwtManager:    jwtManager,
-		storage:       storage,
-		kafkaProducer: kafkaProducer,
-		cache:         cache,
-		es:            es,
+		repo:             repo,
+		sessionRepo:      sessionRepo,
+		twoFactorRepo:    twoFactorRepo,
+		identityRepo:     identityRepo,
+		roleRepo:         roleRepo,
+		experienceRepo:   experienceRepo,
+		settingsRepo:     settingsRepo,
+		settings:         settings.NewClient(settingsRepo, cache),
+		usernameRepo:     usernameRepo,
+		deletionRepo:     deletionRepo,
+		exportRepo:       exportRepo,
+		personalDataRepo: personalDataRepo,
+		auditRepo:        auditRepo,
+		notificationRepo: notificationRepo,
+		guard:            newLoginGuard(cache, auditRepo),
+		twoFactorConfig:  config.Get().TwoFactor,
+		providers:        oauth.NewProviders(),
+		oauthConfig:      config.Get().OAuth,
+		accountConfig:    config.Get().Account,
+		usernameConfig:   config.Get().Username,
+		experienceConfig: config.Get().Experience,
+		jwtManager:       jwtManager,
+		storage:          storage,
+		kafkaProducer:    kafkaProducer,
+		cache:            cache,
+		es:               es,
 	}
 }
 
@@ -95,22 +281,38 @@ func NewUserServiceWithRepo(repo dao.UserRepository, jwtManager *jwt.JWTManager)
 }
 
 // 注册新用户
-func (s *userServiceImpl) Register(ctx context.Context, username, password, avatar, about string) (*model.User, string, error) {
+func (s *userServiceImpl) Register(ctx context.Context, username, password, avatar, about string, device *model.DeviceInfo, captchaToken string) (*model.User, *jwt.TokenPair, error) {
 	logger.Info("用户注册请求",
 		logger.StringField("username", username),
 		logger.StringField("about", about))
 
-	existingUser, err := s.repo.FindByUsername(ctx, username)
+	if err := s.guard.checkRegister(ctx, device, captchaToken); err != nil {
+		return nil, nil, err
+	}
+
+	//用户名不做打码，命中任何敏感词都拒绝
+	if textfilter.Check(username).Action != textfilter.ActionPass {
+		return nil, nil, ErrSensitiveContent
+	}
+	about, err := s.filterProfileText(ctx, 0, about)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.isReservedUsername(username) {
+		return nil, nil, ErrUsernameReserved
+	}
+	unavailable, err := s.unavailableUsernames(ctx, 0, []string{username})
 	if err != nil {
 		logger.Error("查询用户失败",
 			logger.ErrorField(err),
 			logger.StringField("username", username))
-		return nil, "", ErrInternalServer
+		return nil, nil, ErrInternalServer
 	}
-	if existingUser != nil {
+	if unavailable[username] {
 		logger.Warn("用户名已存在",
 			logger.StringField("username", username))
-		return nil, "", ErrUsernameExists
+		return nil, nil, ErrUsernameExists
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -118,7 +320,7 @@ func (s *userServiceImpl) Register(ctx context.Context, username, password, avat
 		logger.Error("密码加密失败",
 			logger.ErrorField(err),
 			logger.StringField("username", username))
-		return nil, "", ErrInternalServer
+		return nil, nil, ErrInternalServer
 	}
 	user := &model.User{
 		Username: username,
@@ -131,17 +333,28 @@ func (s *userServiceImpl) Register(ctx context.Context, username, password, avat
 		logger.Error("创建用户失败",
 			logger.ErrorField(err),
 			logger.StringField("username", username))
-		return nil, "", ErrInternalServer
+		return nil, nil, ErrInternalServer
 	}
 
-	token, err := s.jwtManager.GenerateToken(user.ID)
+	tokens, err := s.issueTokens(ctx, user.ID, device)
 	if err != nil {
 		logger.Error("生成令牌失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", user.ID))
-		return nil, "", ErrInternalServer
+		return nil, nil, ErrInternalServer
 	}
 
+	s.publishRegistered(ctx, user)
+
+	logger.Info("用户注册成功",
+		logger.Int64Field("user_id", user.ID),
+		logger.StringField("username", username))
+
+	return user, tokens, nil
+}
+
+// 发送注册事件并将新用户同步到Elasticsearch
+func (s *userServiceImpl) publishRegistered(ctx context.Context, user *model.User) {
 	if s.kafkaProducer != nil {
 		eventData, _ := json.Marshal(map[string]interface{}{
 			"user_id":       user.ID,
@@ -166,52 +379,151 @@ func (s *userServiceImpl) Register(ctx context.Context, username, password, avat
 			"follower_count": user.FollowerCount,
 			"created_at":     user.CreatedAt.Format("2006-01-02 15:04:05"),
 		}
-		err = s.es.AddDocument("users", fmt.Sprintf("%d", user.ID), esUser)
+		err := s.es.AddDocument("users", fmt.Sprintf("%d", user.ID), esUser)
 		if err != nil {
 			logger.Error("同步用户到ES失败",
 				logger.ErrorField(err),
 				logger.Int64Field("user_id", user.ID))
 		}
 	}
-
-	logger.Info("用户注册成功",
-		logger.Int64Field("user_id", user.ID),
-		logger.StringField("username", username))
-
-	return user, token, nil
 }
 
 // 用户登录
-func (s *userServiceImpl) Login(ctx context.Context, username, password string) (*model.User, string, error) {
+func (s *userServiceImpl) Login(ctx context.Context, username, password string, device *model.DeviceInfo, captchaToken string) (*LoginResult, error) {
 	logger.Info("用户登录请求",
 		logger.StringField("username", username))
 
+	if err := s.guard.checkLogin(ctx, username, device, captchaToken); err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.FindByUsername(ctx, username)
 	if err != nil {
 		logger.Error("查询用户失败",
 			logger.ErrorField(err),
 			logger.StringField("username", username))
-		return nil, "", ErrInternalServer
+		return nil, ErrInternalServer
 	}
-	if user == nil {
+	//用户不存在和密码错误返回相同的错误，并同样做一次哈希比较，避免通过响应或耗时判断用户名是否存在，已注销的账号按不存在处理
+	if user == nil || user.IsDeleted {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
 		logger.Warn("用户不存在",
 			logger.StringField("username", username))
-		return nil, "", ErrUserNotFound
+		s.guard.recordLoginFailure(ctx, username, device, 0)
+		return nil, ErrInvalidLogin
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		logger.Warn("密码错误",
 			logger.StringField("username", username))
-		return nil, "", ErrInvalidPassword
+		s.guard.recordLoginFailure(ctx, username, device, user.ID)
+		return nil, ErrInvalidLogin
+	}
+
+	result, err := s.finishLogin(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+	//开启两步验证的账号在第二步通过后才清空失败次数，避免持有密码者无限签发挑战
+	if result.Tokens != nil {
+		s.guard.resetLoginFailures(ctx, username, device)
+	}
+	return result, nil
+}
+
+// 第一步验证通过后，开启两步验证的账号返回验证挑战，否则直接签发令牌
+func (s *userServiceImpl) finishLogin(ctx context.Context, user *model.User, device *model.DeviceInfo) (*LoginResult, error) {
+	if user.IsBanned {
+		logger.Warn("封禁账号尝试登录",
+			logger.Int64Field("user_id", user.ID))
+		return nil, ErrUserBanned
+	}
+
+	twoFactor, err := s.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	if twoFactor != nil && twoFactor.Enabled {
+		challengeToken, expiresAt, err := s.createLoginChallenge(ctx, user.ID, device)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("第一步验证通过，等待两步验证",
+			logger.Int64Field("user_id", user.ID))
+		return &LoginResult{User: user, ChallengeToken: challengeToken, ChallengeExpiresAt: expiresAt}, nil
+	}
+
+	tokens, err := s.completeLogin(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: user, Tokens: tokens}, nil
+}
+
+// 两步验证登录：提交密码验证后拿到的挑战令牌和验证码（或恢复码），通过后签发令牌
+func (s *userServiceImpl) VerifyTwoFactorLogin(ctx context.Context, challengeToken, code string) (*model.User, *jwt.TokenPair, error) {
+	challenge, err := s.loadLoginChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.repo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", challenge.UserID))
+		return nil, nil, ErrInternalServer
+	}
+	if user == nil {
+		return nil, nil, ErrChallengeInvalid
+	}
+
+	twoFactor, err := s.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, nil, ErrInternalServer
+	}
+	//挑战签发后关闭了两步验证，按正常登录处理
+	if twoFactor != nil && twoFactor.Enabled {
+		if err := s.verifyTwoFactorCode(ctx, twoFactor, code); err != nil {
+			if err == ErrTwoFactorCodeInvalid || err == ErrTwoFactorRequired {
+				s.recordChallengeFailure(ctx, challengeToken, user.ID)
+			}
+			return nil, nil, err
+		}
+	}
+	s.cache.Delete(ctx, cache.GenerateTwoFactorChallengeKey(challengeToken))
+	s.cache.Delete(ctx, cache.GenerateTwoFactorAttemptKey(challengeToken))
+	s.guard.resetLoginFailures(ctx, user.Username, challenge.Device)
+
+	tokens, err := s.completeLogin(ctx, user, challenge.Device)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, tokens, nil
+}
+
+// 身份验证通过后签发令牌，新设备登录时发送安全提醒
+func (s *userServiceImpl) completeLogin(ctx context.Context, user *model.User, device *model.DeviceInfo) (*jwt.TokenPair, error) {
+	//两步验证期间账号可能被封禁
+	if user.IsBanned {
+		return nil, ErrUserBanned
 	}
 
-	token, err := s.jwtManager.GenerateToken(user.ID)
+	//签发令牌前判断是否为新设备，新会话本身会记录该设备
+	newDevice := s.isNewDevice(ctx, user.ID, device)
+
+	tokens, err := s.issueTokens(ctx, user.ID, device)
 	if err != nil {
 		logger.Error("生成令牌失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", user.ID))
-		return nil, "", ErrInternalServer
+		return nil, ErrInternalServer
+	}
+
+	if newDevice {
+		s.notifyNewDevice(ctx, user.ID, device)
 	}
+	s.awardDailyLogin(ctx, user.ID)
 
 	if s.kafkaProducer != nil {
 		eventData, _ := json.Marshal(map[string]interface{}{
@@ -227,9 +539,9 @@ func (s *userServiceImpl) Login(ctx context.Context, username, password string)
 
 	logger.Info("用户登录成功",
 		logger.Int64Field("user_id", user.ID),
-		logger.StringField("username", username))
+		logger.StringField("username", user.Username))
 
-	return user, token, nil
+	return tokens, nil
 }
 
 // 根据ID获取用户
@@ -280,13 +592,35 @@ func (s *userServiceImpl) GetUserByID(ctx context.Context, id int64) (*model.Use
 	return user, nil
 }
 
-// 根据用户名获取用户
+// 根据用户名获取用户，保留期内的旧用户名返回改名后的用户，调用方比较用户名即可判断是否需要跳转
 func (s *userServiceImpl) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
 	user, err := s.repo.FindByUsername(ctx, username)
 	if err != nil {
 		return nil, ErrInternalServer
 	}
-	if user == nil {
+	if user != nil {
+		return user, nil
+	}
+	if s.usernameRepo == nil {
+		return nil, ErrUserNotFound
+	}
+
+	history, err := s.usernameRepo.FindActiveByUsername(ctx, username, time.Now())
+	if err != nil {
+		logger.Error("查询用户名修改记录失败",
+			logger.ErrorField(err),
+			logger.StringField("username", username))
+		return nil, ErrInternalServer
+	}
+	if history == nil {
+		return nil, ErrUserNotFound
+	}
+
+	user, err = s.repo.FindByID(ctx, history.UserID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
 		return nil, ErrUserNotFound
 	}
 	return user, nil
@@ -302,7 +636,7 @@ func (s *userServiceImpl) BatchGetUsersByIDs(ctx context.Context, ids []int64) (
 }
 
 // 更新用户信息
-func (s *userServiceImpl) UpdateUser(ctx context.Context, userID int64, avatar, about, oldPassword, newPassword string) error {
+func (s *userServiceImpl) UpdateUser(ctx context.Context, userID int64, avatar, about, oldPassword, newPassword, twoFactorCode string) error {
 	logger.Info("更新用户信息请求",
 		logger.Int64Field("user_id", userID))
 
@@ -323,14 +657,25 @@ func (s *userServiceImpl) UpdateUser(ctx context.Context, userID int64, avatar,
 		user.Avatar = avatar
 	}
 	if about != "" {
+		about, err = s.filterProfileText(ctx, userID, about)
+		if err != nil {
+			return err
+		}
 		user.About = about
 	}
 
-	if oldPassword != "" && newPassword != "" {
-		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPassword)); err != nil {
-			logger.Warn("旧密码错误",
-				logger.Int64Field("user_id", userID))
-			return ErrOldPasswordWrong
+	//第三方登录注册的账号首次设置密码时不需要旧密码
+	if newPassword != "" && (oldPassword != "" || user.PasswordUnset) {
+		if !user.PasswordUnset {
+			if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPassword)); err != nil {
+				logger.Warn("旧密码错误",
+					logger.Int64Field("user_id", userID))
+				return ErrOldPasswordWrong
+			}
+		}
+		//修改密码属于敏感操作，开启两步验证的账号需要再次验证
+		if err := s.requireStepUp(ctx, userID, twoFactorCode); err != nil {
+			return err
 		}
 
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
@@ -341,6 +686,7 @@ func (s *userServiceImpl) UpdateUser(ctx context.Context, userID int64, avatar,
 			return ErrInternalServer
 		}
 		user.Password = string(hashedPassword)
+		user.PasswordUnset = false
 	}
 
 	if err := s.repo.Update(ctx, user); err != nil {
@@ -385,18 +731,78 @@ func (s *userServiceImpl) UpdateUser(ctx context.Context, userID int64, avatar,
 	return nil
 }
 
+// 设置私密账号
+func (s *userServiceImpl) SetAccountPrivacy(ctx context.Context, userID int64, isPrivate bool) error {
+	logger.Info("设置私密账号请求",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("is_private", isPrivate))
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	if err := s.repo.UpdatePrivacy(ctx, userID, isPrivate); err != nil {
+		logger.Error("设置私密账号失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateUserKey(userID))
+	}
+
+	logger.Info("设置私密账号成功",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("is_private", isPrivate))
+
+	return nil
+}
+
+// 过滤个人资料文本，拒绝的直接返回错误，需要审核的发出审核事件后按打码内容保存
+func (s *userServiceImpl) filterProfileText(ctx context.Context, userID int64, text string) (string, error) {
+	filtered := textfilter.Check(text)
+	switch filtered.Action {
+	case textfilter.ActionReject:
+		logger.Warn("个人资料包含违规信息",
+			logger.Int64Field("user_id", userID))
+		return "", ErrSensitiveContent
+	case textfilter.ActionReview:
+		if s.kafkaProducer != nil {
+			eventData, _ := json.Marshal(map[string]interface{}{
+				"type":       "content_review",
+				"user_id":    userID,
+				"field":      "about",
+				"content":    filtered.Text,
+				"matches":    filtered.Matches,
+				"created_at": time.Now(),
+			})
+			s.kafkaProducer.SendUserEvent(ctx, fmt.Sprintf("%d", userID), eventData)
+		}
+		return filtered.MaskAll(), nil
+	}
+	return filtered.Text, nil
+}
+
 // 检查用户名是否可用
 func (s *userServiceImpl) CheckUsernameAvailable(ctx context.Context, username string) (bool, error) {
-	user, err := s.repo.FindByUsername(ctx, username)
+	unavailable, err := s.unavailableUsernames(ctx, 0, []string{username})
 	if err != nil {
 		return false, ErrInternalServer
 	}
-	return user == nil, nil
+	return !unavailable[username], nil
 }
 
 // 批量检查用户名
 func (s *userServiceImpl) BatchCheckUsernames(ctx context.Context, usernames []string) (map[string]bool, error) {
-	result, err := s.repo.BatchCheckUsername(ctx, usernames)
+	result, err := s.unavailableUsernames(ctx, 0, usernames)
 	if err != nil {
 		return nil, ErrInternalServer
 	}
@@ -419,7 +825,19 @@ func (s *userServiceImpl) GetUserCount(ctx context.Context) (int64, error) {
 }
 
 // 搜索用户
-func (s *userServiceImpl) SearchUsers(ctx context.Context, keyword string, page, pageSize int) ([]*model.User, int64, error) {
+func (s *userServiceImpl) SearchUsers(ctx context.Context, keyword, cursor string, pageSize int, needTotal bool) ([]*model.User, string, int64, error) {
+	pageScope := pagination.Scope{List: "user_search:" + keyword}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+	//ES和数据库都按(粉丝数, ID)倒序，游标记录上一页最后一个用户的粉丝数和ID
+	keyFn := func(u *model.User) pagination.Cursor {
+		return pagination.Cursor{SortKey: u.FollowerCount, ID: u.ID}
+	}
+
 	//优先使用Elasticsearch进行搜索
 	if s.es != nil {
 		//构建搜索查询
@@ -432,8 +850,7 @@ func (s *userServiceImpl) SearchUsers(ctx context.Context, keyword string, page,
 					"operator": "and",
 				},
 			},
-			From: (page - 1) * pageSize,
-			Size: pageSize,
+			Size: pageSize + 1,
 			Sort: []map[string]interface{}{
 				{
 					"follower_count": map[string]interface{}{
@@ -441,12 +858,15 @@ func (s *userServiceImpl) SearchUsers(ctx context.Context, keyword string, page,
 					},
 				},
 				{
-					"created_at": map[string]interface{}{
+					"id": map[string]interface{}{
 						"order": "desc",
 					},
 				},
 			},
 		}
+		if pageCursor != nil {
+			query.SearchAfter = []interface{}{pageCursor.SortKey, pageCursor.ID}
+		}
 
 		var searchResult es.SearchResult
 		err := s.es.Search("users", query, &searchResult)
@@ -487,16 +907,28 @@ func (s *userServiceImpl) SearchUsers(ctx context.Context, keyword string, page,
 					}
 				}
 			}
-			return users, searchResult.Hits.Total.Value, nil
+			users, nextCursor := pagination.Paginate(users, pageSize, pageScope, keyFn)
+			return users, nextCursor, searchResult.Hits.Total.Value, nil
 		}
 	}
 
 	//如果ES搜索失败，回退到数据库搜索
-	users, total, err := s.repo.Search(ctx, keyword, page, pageSize)
+	users, err := s.repo.Search(ctx, keyword, pageCursor, pageSize+1)
 	if err != nil {
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
 	}
-	return users, total, nil
+	users, nextCursor := pagination.Paginate(users, pageSize, pageScope, keyFn)
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("user_search:"+keyword, 0), func() (int64, error) {
+			return s.repo.CountSearch(ctx, keyword)
+		})
+		if err != nil {
+			return nil, "", 0, ErrInternalServer
+		}
+	}
+	return users, nextCursor, total, nil
 }
 
 // 更新关注数
@@ -515,72 +947,2688 @@ func (s *userServiceImpl) UpdateFollowerCount(ctx context.Context, userID int64,
 	return nil
 }
 
-// 验证token
-func (s *userServiceImpl) VerifyToken(ctx context.Context, token string) (int64, error) {
-	userID, err := s.jwtManager.GetUserIDFromToken(token)
+// 验证访问令牌，并检查令牌、会话和用户级别的吊销记录
+func (s *userServiceImpl) VerifyToken(ctx context.Context, token string) (*jwt.Claims, error) {
+	claims, err := s.jwtManager.ValidateAccessToken(token)
 	if err != nil {
-		return 0, ErrTokenInvalid
+		return nil, ErrTokenInvalid
+	}
+	if err := s.checkRevoked(ctx, claims); err != nil {
+		return nil, err
 	}
-	return userID, nil
+	s.touchSession(ctx, claims.SessionID)
+	return claims, nil
 }
 
-// 生成token
-func (s *userServiceImpl) GenerateToken(userID int64) (string, error) {
-	token, err := s.jwtManager.GenerateToken(userID)
+// 为新会话签发令牌对，记录会话的设备信息和当前有效的刷新令牌
+func (s *userServiceImpl) issueTokens(ctx context.Context, userID int64, device *model.DeviceInfo) (*jwt.TokenPair, error) {
+	roles, err := s.userRoles(ctx, userID)
 	if err != nil {
-		return "", ErrInternalServer
+		return nil, err
+	}
+	tokens, err := s.jwtManager.GenerateTokenPair(userID, jwt.NewSessionID(), roles)
+	if err != nil {
+		return nil, err
 	}
-	return token, nil
-}
 
-// 事务支持
-func (s *userServiceImpl) WithTransaction(ctx context.Context, fn func(txService UserService) error) error {
-	return s.repo.WithTransaction(ctx, func(txRepo dao.UserRepository) error {
-		txService := NewUserServiceWithRepo(txRepo, s.jwtManager)
-		return fn(txService)
-	})
+	if s.sessionRepo != nil {
+		if device == nil {
+			device = &model.DeviceInfo{}
+		}
+		now := time.Now()
+		session := &model.UserSession{
+			SessionID:  tokens.RefreshClaims.SessionID,
+			UserID:     userID,
+			DeviceID:   deviceKey(device),
+			DeviceName: device.DeviceName,
+			Platform:   device.Platform,
+			IP:         device.IP,
+			UserAgent:  device.UserAgent,
+			LastSeenAt: now,
+			ExpiresAt:  tokens.RefreshClaims.ExpiresAt.Time,
+		}
+		if err := s.sessionRepo.Create(ctx, session); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.cache != nil {
+		key := cache.GenerateSessionRefreshKey(tokens.RefreshClaims.SessionID)
+		if err := s.cache.Set(ctx, key, tokens.RefreshClaims.ID, s.jwtManager.RefreshExpire()); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
 }
 
-// 更新用户头像
-func (s *userServiceImpl) UpdateAvatar(ctx context.Context, userID int64, avatarData []byte) (string, error) {
-	user, err := s.repo.FindByID(ctx, userID)
+// 使用刷新令牌换取新的令牌对，旧的刷新令牌随即失效。已轮换过的刷新令牌再次出现说明令牌可能被盗，吊销整个会话
+func (s *userServiceImpl) RefreshToken(ctx context.Context, refreshToken string) (*jwt.TokenPair, error) {
+	claims, err := s.jwtManager.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		return "", ErrInternalServer
+		return nil, ErrTokenInvalid
 	}
-	if user == nil {
-		return "", ErrUserNotFound
+
+	//没有缓存时无法跟踪刷新令牌的轮换，拒绝刷新
+	if s.cache == nil {
+		logger.Warn("缓存不可用，无法刷新令牌",
+			logger.Int64Field("user_id", claims.UserID))
+		return nil, ErrInternalServer
 	}
 
-	if len(avatarData) == 0 {
-		return "", ErrInvalidFile
+	if err := s.checkRevoked(ctx, claims); err != nil {
+		return nil, err
 	}
 
-	objectName := fmt.Sprintf("avatars/%d_%d.jpg", userID, time.Now().Unix())
+	//每次刷新都重新读取用户状态和角色，封禁和角色变更在刷新后生效
+	user, err := s.repo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", claims.UserID))
+		return nil, ErrInternalServer
+	}
+	if user == nil || user.IsDeleted || user.IsBanned {
+		return nil, ErrTokenRevoked
+	}
+	roles, err := s.userRoles(ctx, claims.UserID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
 
-	if s.storage == nil {
-		return "", ErrFileUploadFailed
+	tokens, err := s.jwtManager.GenerateTokenPair(claims.UserID, claims.SessionID, roles)
+	if err != nil {
+		logger.Error("生成令牌失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", claims.UserID))
+		return nil, ErrInternalServer
 	}
 
-	reader := bytes.NewReader(avatarData)
-	avatarURL, err := s.storage.Upload(ctx, "", objectName, reader, int64(len(avatarData)), "image/jpeg")
+	key := cache.GenerateSessionRefreshKey(claims.SessionID)
+	current, err := s.cache.GetSet(ctx, key, tokens.RefreshClaims.ID, s.jwtManager.RefreshExpire())
 	if err != nil {
-		return "", ErrFileUploadFailed
+		logger.Error("轮换刷新令牌失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", claims.UserID))
+		return nil, ErrInternalServer
 	}
 
-	user.Avatar = avatarURL
-	err = s.repo.Update(ctx, user)
+	if current != claims.ID {
+		logger.Warn("检测到刷新令牌重复使用，吊销会话",
+			logger.Int64Field("user_id", claims.UserID),
+			logger.StringField("session_id", claims.SessionID))
+		s.revokeSessionTokens(ctx, claims.SessionID)
+		if s.sessionRepo != nil {
+			s.sessionRepo.Revoke(ctx, claims.SessionID)
+		}
+		return nil, ErrRefreshReused
+	}
+
+	if s.sessionRepo != nil {
+		if err := s.sessionRepo.Extend(ctx, claims.SessionID, tokens.RefreshClaims.ExpiresAt.Time); err != nil {
+			logger.Warn("更新会话过期时间失败",
+				logger.ErrorField(err),
+				logger.StringField("session_id", claims.SessionID))
+		}
+	}
+
+	//长期保持登录的用户通过刷新令牌获得每日登录经验
+	s.awardDailyLogin(ctx, claims.UserID)
+
+	logger.Info("刷新令牌成功",
+		logger.Int64Field("user_id", claims.UserID),
+		logger.StringField("session_id", claims.SessionID))
+
+	return tokens, nil
+}
+
+// 退出当前会话，该会话的访问令牌和刷新令牌全部失效
+func (s *userServiceImpl) Logout(ctx context.Context, token string) error {
+	claims, err := s.jwtManager.ValidateAccessToken(token)
 	if err != nil {
-		return "", ErrInternalServer
+		return ErrTokenInvalid
 	}
 
-	if s.kafkaProducer != nil {
-		eventData, _ := json.Marshal(map[string]interface{}{
-			"user_id":    userID,
-			"avatar_url": avatarURL,
-			"updated_at": time.Now(),
-		})
-		s.kafkaProducer.SendUserEvent(ctx, fmt.Sprintf("%d", userID), eventData)
+	if s.cache != nil {
+		ttl := time.Until(claims.ExpiresAt.Time)
+		if ttl > 0 {
+			if err := s.cache.Set(ctx, cache.GenerateRevokedTokenKey(claims.ID), "1", ttl); err != nil {
+				logger.Error("吊销令牌失败",
+					logger.ErrorField(err),
+					logger.Int64Field("user_id", claims.UserID))
+				return ErrInternalServer
+			}
+		}
+		if err := s.revokeSessionTokens(ctx, claims.SessionID); err != nil {
+			return ErrInternalServer
+		}
 	}
 
-	return avatarURL, nil
+	if s.sessionRepo != nil {
+		if _, err := s.sessionRepo.Revoke(ctx, claims.SessionID); err != nil {
+			logger.Warn("标记会话退出失败",
+				logger.ErrorField(err),
+				logger.StringField("session_id", claims.SessionID))
+		}
+	}
+
+	logger.Info("用户退出登录",
+		logger.Int64Field("user_id", claims.UserID),
+		logger.StringField("session_id", claims.SessionID))
+
+	return nil
+}
+
+// 退出所有设备，此前签发的令牌全部失效
+func (s *userServiceImpl) LogoutAll(ctx context.Context, userID int64) error {
+	if s.cache == nil {
+		return ErrInternalServer
+	}
+
+	key := cache.GenerateUserTokensRevokedKey(userID)
+	if err := s.cache.Set(ctx, key, time.Now().UnixMilli(), s.jwtManager.RefreshExpire()); err != nil {
+		logger.Error("吊销用户令牌失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if s.sessionRepo != nil {
+		sessionIDs, err := s.sessionRepo.RevokeAll(ctx, userID)
+		if err != nil {
+			logger.Warn("标记会话退出失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+		}
+		for _, sessionID := range sessionIDs {
+			s.revokeSessionTokens(ctx, sessionID)
+		}
+	}
+
+	logger.Info("用户退出所有设备",
+		logger.Int64Field("user_id", userID))
+
+	return nil
+}
+
+// 获取用户当前登录的会话
+func (s *userServiceImpl) GetSessions(ctx context.Context, userID int64) ([]*model.UserSession, error) {
+	if s.sessionRepo == nil {
+		return []*model.UserSession{}, nil
+	}
+
+	sessions, err := s.sessionRepo.ListActive(ctx, userID)
+	if err != nil {
+		logger.Error("获取登录会话失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return sessions, nil
+}
+
+// 远程退出指定会话，该会话的令牌立即失效
+func (s *userServiceImpl) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	logger.Info("吊销会话请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("session_id", sessionID))
+
+	if s.sessionRepo == nil {
+		return ErrSessionNotFound
+	}
+
+	session, err := s.sessionRepo.FindBySessionID(ctx, sessionID)
+	if err != nil {
+		logger.Error("查询会话失败",
+			logger.ErrorField(err),
+			logger.StringField("session_id", sessionID))
+		return ErrInternalServer
+	}
+	if session == nil || session.UserID != userID || session.RevokedAt != nil {
+		return ErrSessionNotFound
+	}
+
+	if err := s.revokeSessionTokens(ctx, sessionID); err != nil {
+		return ErrInternalServer
+	}
+	if _, err := s.sessionRepo.Revoke(ctx, sessionID); err != nil {
+		logger.Error("吊销会话失败",
+			logger.ErrorField(err),
+			logger.StringField("session_id", sessionID))
+		return ErrInternalServer
+	}
+
+	logger.Info("吊销会话成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("session_id", sessionID))
+	return nil
+}
+
+// 设备标识优先使用客户端上报的设备ID，没有时用User-Agent的摘要区分设备
+func deviceKey(device *model.DeviceInfo) string {
+	if device.DeviceID != "" {
+		return device.DeviceID
+	}
+	if device.UserAgent == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(device.UserAgent))
+	return "ua:" + hex.EncodeToString(sum[:])
+}
+
+// 用户从未在该设备上登录过时视为新设备，查询失败时不提醒
+func (s *userServiceImpl) isNewDevice(ctx context.Context, userID int64, device *model.DeviceInfo) bool {
+	if s.sessionRepo == nil || s.notificationRepo == nil {
+		return false
+	}
+	if device == nil {
+		device = &model.DeviceInfo{}
+	}
+
+	exists, err := s.sessionRepo.ExistsDevice(ctx, userID, deviceKey(device))
+	if err != nil {
+		logger.Warn("查询登录设备失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return false
+	}
+	return !exists
+}
+
+// 新设备登录时发送安全提醒
+func (s *userServiceImpl) notifyNewDevice(ctx context.Context, userID int64, device *model.DeviceInfo) {
+	if device == nil {
+		device = &model.DeviceInfo{}
+	}
+
+	deviceName := device.DeviceName
+	if deviceName == "" {
+		deviceName = "未知设备"
+	}
+	if device.Platform != "" {
+		deviceName = fmt.Sprintf("%s（%s）", deviceName, device.Platform)
+	}
+	content := fmt.Sprintf("你的账号于 %s 在新设备 %s 上登录", time.Now().Format("2006-01-02 15:04:05"), deviceName)
+	if device.IP != "" {
+		content += fmt.Sprintf("，IP：%s", device.IP)
+	}
+	content += "。如果不是你本人操作，请立即修改密码并退出该设备。"
+
+	notification := &messageModel.SystemNotification{
+		UserID:     userID,
+		Title:      "新设备登录提醒",
+		Content:    content,
+		Type:       messageModel.NotificationTypeNewDeviceLogin,
+		RelatedID:  userID,
+		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		logger.Warn("发送新设备登录提醒失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+	}
+}
+
+// 更新会话的最近活跃时间，同一会话在间隔内只写一次数据库
+func (s *userServiceImpl) touchSession(ctx context.Context, sessionID string) {
+	if s.sessionRepo == nil {
+		return
+	}
+
+	if s.cache != nil {
+		key := cache.GenerateSessionSeenKey(sessionID)
+		if seen, err := s.cache.Exists(ctx, key); err == nil && seen {
+			return
+		}
+		s.cache.Set(ctx, key, "1", sessionTouchInterval)
+	}
+
+	if err := s.sessionRepo.Touch(ctx, sessionID, time.Now()); err != nil {
+		logger.Warn("更新会话活跃时间失败",
+			logger.ErrorField(err),
+			logger.StringField("session_id", sessionID))
+	}
+}
+
+// 吊销会话，会话签发的令牌在过期前都会被拒绝
+func (s *userServiceImpl) revokeSessionTokens(ctx context.Context, sessionID string) error {
+	if s.cache == nil {
+		return nil
+	}
+
+	if err := s.cache.Set(ctx, cache.GenerateRevokedSessionKey(sessionID), "1", s.jwtManager.RefreshExpire()); err != nil {
+		logger.Error("吊销会话失败",
+			logger.ErrorField(err),
+			logger.StringField("session_id", sessionID))
+		return err
+	}
+	s.cache.Delete(ctx, cache.GenerateSessionRefreshKey(sessionID))
+	return nil
+}
+
+// 一次查询检查令牌、会话和用户级别的吊销记录，缓存查询失败时按已吊销处理
+func (s *userServiceImpl) checkRevoked(ctx context.Context, claims *jwt.Claims) error {
+	if s.cache == nil {
+		return nil
+	}
+
+	tokenKey := cache.GenerateRevokedTokenKey(claims.ID)
+	sessionKey := cache.GenerateRevokedSessionKey(claims.SessionID)
+	userKey := cache.GenerateUserTokensRevokedKey(claims.UserID)
+	rolesKey := cache.GenerateUserRolesChangedKey(claims.UserID)
+
+	values, err := s.cache.MGet(ctx, []string{tokenKey, sessionKey, userKey, rolesKey})
+	if err != nil {
+		logger.Error("查询令牌吊销记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", claims.UserID))
+		return ErrTokenRevoked
+	}
+
+	if values[tokenKey] != "" || values[sessionKey] != "" {
+		return ErrTokenRevoked
+	}
+	//与吊销在同一毫秒内签发的令牌也视为吊销前签发
+	if revokedBefore, ok := parseRevokedAt(values[userKey]); ok {
+		if claims.IssuedAt == nil || claims.IssuedAt.UnixMilli() <= revokedBefore {
+			return ErrTokenRevoked
+		}
+	}
+	//角色变更前签发的访问令牌拒绝使用，客户端用刷新令牌换取携带新角色的令牌
+	if changedAt, ok := parseRevokedAt(values[rolesKey]); ok && claims.TokenType == jwt.TokenTypeAccess {
+		if claims.IssuedAt == nil || claims.IssuedAt.UnixMilli() <= changedAt {
+			return ErrRolesChanged
+		}
+	}
+	return nil
+}
+
+// 解析吊销时间（毫秒），之前按秒保存的记录换算为毫秒
+func parseRevokedAt(value string) (int64, bool) {
+	at, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	if at < 1e12 {
+		at *= 1000
+	}
+	return at, true
+}
+
+// 事务支持
+func (s *userServiceImpl) WithTransaction(ctx context.Context, fn func(txService UserService) error) error {
+	return s.repo.WithTransaction(ctx, func(txRepo dao.UserRepository) error {
+		txService := NewUserServiceWithRepo(txRepo, s.jwtManager)
+		return fn(txService)
+	})
+}
+
+// 更新用户头像
+func (s *userServiceImpl) UpdateAvatar(ctx context.Context, userID int64, avatarData []byte) (string, error) {
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+	if user == nil {
+		return "", ErrUserNotFound
+	}
+
+	if len(avatarData) == 0 {
+		return "", ErrInvalidFile
+	}
+
+	objectName := fmt.Sprintf("avatars/%d_%d.jpg", userID, time.Now().Unix())
+
+	if s.storage == nil {
+		return "", ErrFileUploadFailed
+	}
+
+	reader := bytes.NewReader(avatarData)
+	avatarURL, err := s.storage.Upload(ctx, "", objectName, reader, int64(len(avatarData)), "image/jpeg")
+	if err != nil {
+		return "", ErrFileUploadFailed
+	}
+
+	user.Avatar = avatarURL
+	err = s.repo.Update(ctx, user)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+
+	if s.kafkaProducer != nil {
+		eventData, _ := json.Marshal(map[string]interface{}{
+			"user_id":    userID,
+			"avatar_url": avatarURL,
+			"updated_at": time.Now(),
+		})
+		s.kafkaProducer.SendUserEvent(ctx, fmt.Sprintf("%d", userID), eventData)
+	}
+
+	return avatarURL, nil
+}
+
+var (
+	dummyHash     []byte
+	dummyHashOnce sync.Once
+)
+
+// 用户不存在时用于比较的哈希，使两种失败的耗时一致
+func dummyPasswordHash() []byte {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("shortvideo-dummy-password"), bcrypt.DefaultCost)
+	})
+	return dummyHash
+}
+
+// 防护统计的维度
+const (
+	guardKindUsername  = "username"
+	guardKindIP        = "ip"
+	guardKindDevice    = "device"
+	guardKindTwoFactor = "two_factor"
+)
+
+// 参与统计的对象，max为该维度的上限，0表示不限制
+type guardSubject struct {
+	kind  string
+	value string
+	max   int
+}
+
+// 登录注册防护：滑动窗口统计失败次数，按次数要求人机验证、延迟响应和临时锁定
+// 缓存不可用时放行请求，避免Redis故障导致所有人无法登录
+type loginGuard struct {
+	cache     cache.Cache
+	auditRepo dao.AuditLogRepository
+	verifier  captcha.Verifier
+	failures  *ratelimit.SlidingWindow
+	registers *ratelimit.SlidingWindow
+	cfg       config.LoginGuardConfig
+}
+
+func newLoginGuard(c cache.Cache, auditRepo dao.AuditLogRepository) *loginGuard {
+	if c == nil {
+		return nil
+	}
+	cfg := config.Get().LoginGuard
+	if !cfg.Enable {
+		return nil
+	}
+
+	verifier, err := captcha.NewVerifier()
+	if err != nil {
+		logger.Warn("初始化人机验证失败，需要验证的请求将被拒绝",
+			logger.ErrorField(err))
+	}
+
+	return &loginGuard{
+		cache:     c,
+		auditRepo: auditRepo,
+		verifier:  verifier,
+		failures:  ratelimit.NewSlidingWindow(c, time.Duration(cfg.WindowMinutes)*time.Minute),
+		registers: ratelimit.NewSlidingWindow(c, time.Duration(cfg.RegisterWindowMinutes)*time.Minute),
+		cfg:       cfg,
+	}
+}
+
+func (g *loginGuard) loginSubjects(username string, device *model.DeviceInfo) []guardSubject {
+	subjects := []guardSubject{{kind: guardKindUsername, value: username, max: g.cfg.MaxUsernameFailures}}
+	return append(subjects, g.clientSubjects(device, g.cfg.MaxIPFailures, g.cfg.MaxDeviceFailures)...)
+}
+
+// 请求来源的IP和设备指纹，缺失的维度不参与统计
+func (g *loginGuard) clientSubjects(device *model.DeviceInfo, maxIP, maxDevice int) []guardSubject {
+	if device == nil {
+		return nil
+	}
+	var subjects []guardSubject
+	if device.IP != "" {
+		subjects = append(subjects, guardSubject{kind: guardKindIP, value: device.IP, max: maxIP})
+	}
+	if fingerprint := deviceKey(device); fingerprint != "" {
+		subjects = append(subjects, guardSubject{kind: guardKindDevice, value: fingerprint, max: maxDevice})
+	}
+	return subjects
+}
+
+// 登录前检查：已锁定的直接拒绝，失败次数较多时要求人机验证并延迟响应
+func (g *loginGuard) checkLogin(ctx context.Context, username string, device *model.DeviceInfo, captchaToken string) error {
+	if g == nil {
+		return nil
+	}
+	subjects := g.loginSubjects(username, device)
+
+	if g.locked(ctx, subjects) {
+		return ErrTooManyAttempts
+	}
+
+	var failures int64
+	for _, subject := range subjects {
+		count, err := g.failures.Count(ctx, cache.GenerateLoginFailureKey(subject.kind, subject.value))
+		if err != nil {
+			logger.Warn("查询登录失败次数失败",
+				logger.ErrorField(err),
+				logger.StringField("kind", subject.kind))
+			continue
+		}
+		if count > failures {
+			failures = count
+		}
+	}
+
+	if g.cfg.CaptchaAfter > 0 && failures >= int64(g.cfg.CaptchaAfter) {
+		if err := g.verifyCaptcha(ctx, captchaToken, device); err != nil {
+			return err
+		}
+	}
+
+	return g.delay(ctx, failures)
+}
+
+// 记录一次登录失败，任一维度达到上限时锁定该维度
+func (g *loginGuard) recordLoginFailure(ctx context.Context, username string, device *model.DeviceInfo, userID int64) {
+	if g == nil {
+		return
+	}
+
+	for _, subject := range g.loginSubjects(username, device) {
+		count, err := g.failures.Hit(ctx, cache.GenerateLoginFailureKey(subject.kind, subject.value))
+		if err != nil {
+			logger.Warn("记录登录失败次数失败",
+				logger.ErrorField(err),
+				logger.StringField("kind", subject.kind))
+			continue
+		}
+		if subject.max > 0 && count >= int64(subject.max) {
+			g.lock(ctx, model.AuditEventLoginLockout, subject, userID, device,
+				fmt.Sprintf("%d分钟内登录失败%d次", g.cfg.WindowMinutes, count))
+		}
+	}
+}
+
+// 登录成功后清空用户名和设备的失败次数，IP可能被多人共用，保留其计数
+func (g *loginGuard) resetLoginFailures(ctx context.Context, username string, device *model.DeviceInfo) {
+	if g == nil {
+		return
+	}
+
+	for _, subject := range g.loginSubjects(username, device) {
+		if subject.kind == guardKindIP {
+			continue
+		}
+		g.failures.Reset(ctx, cache.GenerateLoginFailureKey(subject.kind, subject.value))
+	}
+}
+
+func (g *loginGuard) twoFactorSubject(userID int64) guardSubject {
+	return guardSubject{kind: guardKindTwoFactor, value: strconv.FormatInt(userID, 10), max: g.cfg.MaxTwoFactorFailures}
+}
+
+// 校验两步验证码前检查用户是否因验证码错误次数过多被锁定
+func (g *loginGuard) checkTwoFactor(ctx context.Context, userID int64) error {
+	if g == nil {
+		return nil
+	}
+	if g.locked(ctx, []guardSubject{g.twoFactorSubject(userID)}) {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+// 记录一次两步验证码错误，达到上限时锁定该用户的两步验证
+func (g *loginGuard) recordTwoFactorFailure(ctx context.Context, userID int64) {
+	if g == nil {
+		return
+	}
+
+	subject := g.twoFactorSubject(userID)
+	count, err := g.failures.Hit(ctx, cache.GenerateLoginFailureKey(subject.kind, subject.value))
+	if err != nil {
+		logger.Warn("记录两步验证失败次数失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return
+	}
+	if subject.max > 0 && count >= int64(subject.max) {
+		g.lock(ctx, model.AuditEventTwoFactorLockout, subject, userID, nil,
+			fmt.Sprintf("%d分钟内两步验证码错误%d次", g.cfg.WindowMinutes, count))
+	}
+}
+
+func (g *loginGuard) resetTwoFactorFailures(ctx context.Context, userID int64) {
+	if g == nil {
+		return
+	}
+	subject := g.twoFactorSubject(userID)
+	g.failures.Reset(ctx, cache.GenerateLoginFailureKey(subject.kind, subject.value))
+}
+
+// 注册前检查：同一IP或设备的注册次数超过阈值后要求人机验证，达到上限后临时锁定
+func (g *loginGuard) checkRegister(ctx context.Context, device *model.DeviceInfo, captchaToken string) error {
+	if g == nil {
+		return nil
+	}
+	subjects := g.clientSubjects(device, g.cfg.MaxRegisterPerIP, g.cfg.MaxRegisterPerDevice)
+
+	if g.locked(ctx, subjects) {
+		return ErrTooManyAttempts
+	}
+
+	var attempts int64
+	for _, subject := range subjects {
+		count, err := g.registers.Hit(ctx, cache.GenerateRegisterAttemptKey(subject.kind, subject.value))
+		if err != nil {
+			logger.Warn("记录注册次数失败",
+				logger.ErrorField(err),
+				logger.StringField("kind", subject.kind))
+			continue
+		}
+		if subject.max > 0 && count > int64(subject.max) {
+			g.lock(ctx, model.AuditEventRegisterLockout, subject, 0, device,
+				fmt.Sprintf("%d分钟内注册%d次", g.cfg.RegisterWindowMinutes, count))
+			return ErrTooManyAttempts
+		}
+		if count > attempts {
+			attempts = count
+		}
+	}
+
+	if g.cfg.RegisterCaptchaAfter > 0 && attempts > int64(g.cfg.RegisterCaptchaAfter) {
+		return g.verifyCaptcha(ctx, captchaToken, device)
+	}
+	return nil
+}
+
+// 任一维度处于锁定期即视为锁定
+func (g *loginGuard) locked(ctx context.Context, subjects []guardSubject) bool {
+	if len(subjects) == 0 {
+		return false
+	}
+
+	keys := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		keys = append(keys, cache.GenerateLoginLockKey(subject.kind, subject.value))
+	}
+	values, err := g.cache.MGet(ctx, keys)
+	if err != nil {
+		logger.Warn("查询登录锁定状态失败",
+			logger.ErrorField(err))
+		return false
+	}
+	return len(values) > 0
+}
+
+// 锁定一个维度并记录审计日志
+func (g *loginGuard) lock(ctx context.Context, event string, subject guardSubject, userID int64, device *model.DeviceInfo, detail string) {
+	lockout := time.Duration(g.cfg.LockoutMinutes) * time.Minute
+	if lockout <= 0 {
+		return
+	}
+	if err := g.cache.Set(ctx, cache.GenerateLoginLockKey(subject.kind, subject.value), "1", lockout); err != nil {
+		logger.Warn("设置登录锁定失败",
+			logger.ErrorField(err),
+			logger.StringField("kind", subject.kind))
+		return
+	}
+
+	ip := ""
+	if device != nil {
+		ip = device.IP
+	}
+	detail = fmt.Sprintf("%s，锁定%d分钟", detail, g.cfg.LockoutMinutes)
+	logger.Warn("触发临时锁定",
+		logger.StringField("event", event),
+		logger.StringField("kind", subject.kind),
+		logger.StringField("subject", subject.value),
+		logger.StringField("ip", ip),
+		logger.StringField("detail", detail))
+
+	if g.auditRepo == nil {
+		return
+	}
+	auditLog := &model.SecurityAuditLog{
+		UserID:  userID,
+		Event:   event,
+		Subject: subject.kind + ":" + subject.value,
+		IP:      ip,
+		Detail:  detail,
+	}
+	if err := g.auditRepo.Create(ctx, auditLog); err != nil {
+		logger.Error("写入审计日志失败",
+			logger.ErrorField(err),
+			logger.StringField("event", event))
+	}
+}
+
+func (g *loginGuard) verifyCaptcha(ctx context.Context, token string, device *model.DeviceInfo) error {
+	if token == "" {
+		return ErrCaptchaRequired
+	}
+	if g.verifier == nil {
+		return ErrCaptchaInvalid
+	}
+
+	ip := ""
+	if device != nil {
+		ip = device.IP
+	}
+	ok, err := g.verifier.Verify(ctx, token, ip)
+	if err != nil {
+		logger.Warn("人机验证失败",
+			logger.ErrorField(err))
+		return ErrCaptchaInvalid
+	}
+	if !ok {
+		return ErrCaptchaInvalid
+	}
+	return nil
+}
+
+// 按失败次数翻倍延迟响应，上限为DelayMaxMillis
+func (g *loginGuard) delay(ctx context.Context, failures int64) error {
+	if failures <= 0 || g.cfg.DelayBaseMillis <= 0 {
+		return nil
+	}
+
+	wait := time.Duration(g.cfg.DelayBaseMillis) * time.Millisecond
+	maxWait := time.Duration(g.cfg.DelayMaxMillis) * time.Millisecond
+	for i := int64(1); i < failures && wait < maxWait; i++ {
+		wait *= 2
+	}
+	if maxWait > 0 && wait > maxWait {
+		wait = maxWait
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// 两步验证登录挑战，保存在缓存中直到验证通过或过期
+type loginChallenge struct {
+	UserID int64             `json:"user_id"`
+	Device *model.DeviceInfo `json:"device"`
+}
+
+// 获取两步验证密钥和otpauth URI，首次验证码校验通过后才会开启
+func (s *userServiceImpl) SetupTwoFactor(ctx context.Context, userID int64) (string, string, error) {
+	logger.Info("获取两步验证密钥请求",
+		logger.Int64Field("user_id", userID))
+
+	if s.twoFactorRepo == nil {
+		return "", "", ErrInternalServer
+	}
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return "", "", ErrInternalServer
+	}
+	if user == nil {
+		return "", "", ErrUserNotFound
+	}
+
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return "", "", ErrInternalServer
+	}
+	if twoFactor != nil && twoFactor.Enabled {
+		return "", "", ErrTwoFactorEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.Error("生成两步验证密钥失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return "", "", ErrInternalServer
+	}
+	if err := s.twoFactorRepo.SavePending(ctx, userID, secret); err != nil {
+		logger.Error("保存两步验证密钥失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return "", "", ErrInternalServer
+	}
+
+	return secret, totp.URI(s.twoFactorConfig.Issuer, user.Username, secret), nil
+}
+
+// 校验首个验证码后开启两步验证，返回一次性恢复码，恢复码只在此时返回明文
+func (s *userServiceImpl) EnableTwoFactor(ctx context.Context, userID int64, code string) ([]string, error) {
+	logger.Info("开启两步验证请求",
+		logger.Int64Field("user_id", userID))
+
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	if twoFactor == nil {
+		return nil, ErrTwoFactorNotSetup
+	}
+	if twoFactor.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	step, ok := totp.Validate(twoFactor.Secret, code, time.Now(), s.twoFactorConfig.Skew)
+	if !ok {
+		return nil, ErrTwoFactorCodeInvalid
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		logger.Error("生成恢复码失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	enabled, err := s.twoFactorRepo.Enable(ctx, userID, step, hashes)
+	if err != nil {
+		logger.Error("开启两步验证失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if !enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	s.audit(ctx, userID, model.AuditEventTwoFactorEnabled, "开启两步验证")
+	logger.Info("开启两步验证成功",
+		logger.Int64Field("user_id", userID))
+	return codes, nil
+}
+
+// 关闭两步验证，需要提交验证码或恢复码
+func (s *userServiceImpl) DisableTwoFactor(ctx context.Context, userID int64, code string) error {
+	logger.Info("关闭两步验证请求",
+		logger.Int64Field("user_id", userID))
+
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return ErrTwoFactorNotEnabled
+	}
+	if err := s.verifyTwoFactorCode(ctx, twoFactor, code); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepo.Disable(ctx, userID); err != nil {
+		logger.Error("关闭两步验证失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	s.audit(ctx, userID, model.AuditEventTwoFactorDisabled, "关闭两步验证")
+	logger.Info("关闭两步验证成功",
+		logger.Int64Field("user_id", userID))
+	return nil
+}
+
+// 重新生成恢复码，旧的恢复码全部失效
+func (s *userServiceImpl) RegenerateRecoveryCodes(ctx context.Context, userID int64, code string) ([]string, error) {
+	logger.Info("重新生成恢复码请求",
+		logger.Int64Field("user_id", userID))
+
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err := s.verifyTwoFactorCode(ctx, twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		logger.Error("生成恢复码失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if err := s.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		logger.Error("保存恢复码失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	s.audit(ctx, userID, model.AuditEventRecoveryCodesRenewed, "重新生成恢复码")
+	return codes, nil
+}
+
+// 两步验证状态和剩余可用的恢复码数量
+func (s *userServiceImpl) GetTwoFactorStatus(ctx context.Context, userID int64) (bool, int64, error) {
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return false, 0, ErrInternalServer
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return false, 0, nil
+	}
+
+	remaining, err := s.twoFactorRepo.CountRecoveryCodes(ctx, userID)
+	if err != nil {
+		logger.Error("查询恢复码数量失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return false, 0, ErrInternalServer
+	}
+	return true, remaining, nil
+}
+
+// 敏感操作的二次验证，未开启两步验证的账号直接通过
+func (s *userServiceImpl) requireStepUp(ctx context.Context, userID int64, code string) error {
+	twoFactor, err := s.findTwoFactor(ctx, userID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if twoFactor == nil || !twoFactor.Enabled {
+		return nil
+	}
+	return s.verifyTwoFactorCode(ctx, twoFactor, code)
+}
+
+func (s *userServiceImpl) findTwoFactor(ctx context.Context, userID int64) (*model.UserTwoFactor, error) {
+	if s.twoFactorRepo == nil {
+		return nil, nil
+	}
+
+	twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询两步验证设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, err
+	}
+	return twoFactor, nil
+}
+
+// 校验验证码，验证码不匹配时尝试作为恢复码使用；同一时间步的验证码只能使用一次。
+// 登录和敏感操作共用按用户统计的错误次数，达到上限后锁定，防止穷举验证码
+func (s *userServiceImpl) verifyTwoFactorCode(ctx context.Context, twoFactor *model.UserTwoFactor, code string) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ErrTwoFactorRequired
+	}
+	if err := s.guard.checkTwoFactor(ctx, twoFactor.UserID); err != nil {
+		return err
+	}
+
+	err := s.matchTwoFactorCode(ctx, twoFactor, code)
+	switch err {
+	case nil:
+		s.guard.resetTwoFactorFailures(ctx, twoFactor.UserID)
+	case ErrTwoFactorCodeInvalid:
+		s.guard.recordTwoFactorFailure(ctx, twoFactor.UserID)
+	}
+	return err
+}
+
+func (s *userServiceImpl) matchTwoFactorCode(ctx context.Context, twoFactor *model.UserTwoFactor, code string) error {
+
+	if step, ok := totp.Validate(twoFactor.Secret, code, time.Now(), s.twoFactorConfig.Skew); ok {
+		used, err := s.twoFactorRepo.UseStep(ctx, twoFactor.UserID, step)
+		if err != nil {
+			logger.Error("记录验证码使用失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", twoFactor.UserID))
+			return ErrInternalServer
+		}
+		if !used {
+			return ErrTwoFactorCodeInvalid
+		}
+		return nil
+	}
+
+	used, err := s.twoFactorRepo.UseRecoveryCode(ctx, twoFactor.UserID, hashRecoveryCode(code))
+	if err != nil {
+		logger.Error("使用恢复码失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", twoFactor.UserID))
+		return ErrInternalServer
+	}
+	if !used {
+		return ErrTwoFactorCodeInvalid
+	}
+	s.audit(ctx, twoFactor.UserID, model.AuditEventRecoveryCodeUsed, "使用恢复码完成两步验证")
+	return nil
+}
+
+// 生成恢复码，返回明文和对应的哈希
+func (s *userServiceImpl) generateRecoveryCodes() ([]string, []string, error) {
+	count := s.twoFactorConfig.RecoveryCodes
+	if count <= 0 {
+		count = 10
+	}
+
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		raw, err := randomToken(5)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// 恢复码忽略大小写和分隔符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// 密码验证通过后创建两步验证挑战，挑战令牌在配置的时限内有效
+func (s *userServiceImpl) createLoginChallenge(ctx context.Context, userID int64, device *model.DeviceInfo) (string, time.Time, error) {
+	if s.cache == nil {
+		logger.Error("缓存不可用，无法进行两步验证登录",
+			logger.Int64Field("user_id", userID))
+		return "", time.Time{}, ErrInternalServer
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return "", time.Time{}, ErrInternalServer
+	}
+	data, _ := json.Marshal(&loginChallenge{UserID: userID, Device: device})
+
+	ttl := time.Duration(s.twoFactorConfig.ChallengeMinutes) * time.Minute
+	if err := s.cache.Set(ctx, cache.GenerateTwoFactorChallengeKey(token), string(data), ttl); err != nil {
+		logger.Error("保存两步验证挑战失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return "", time.Time{}, ErrInternalServer
+	}
+	return token, time.Now().Add(ttl), nil
+}
+
+func (s *userServiceImpl) loadLoginChallenge(ctx context.Context, token string) (*loginChallenge, error) {
+	if s.cache == nil || token == "" {
+		return nil, ErrChallengeInvalid
+	}
+
+	data, err := s.cache.Get(ctx, cache.GenerateTwoFactorChallengeKey(token))
+	if err != nil || data == "" {
+		return nil, ErrChallengeInvalid
+	}
+	var challenge loginChallenge
+	if err := json.Unmarshal([]byte(data), &challenge); err != nil {
+		return nil, ErrChallengeInvalid
+	}
+	return &challenge, nil
+}
+
+// 记录挑战的错误次数，超过上限后作废挑战，需重新输入密码
+func (s *userServiceImpl) recordChallengeFailure(ctx context.Context, token string, userID int64) {
+	attemptKey := cache.GenerateTwoFactorAttemptKey(token)
+	attempts, err := s.cache.Incr(ctx, attemptKey)
+	if err != nil {
+		return
+	}
+	s.cache.Expire(ctx, attemptKey, time.Duration(s.twoFactorConfig.ChallengeMinutes)*time.Minute)
+
+	if attempts >= int64(s.twoFactorConfig.ChallengeAttempts) {
+		s.cache.Delete(ctx, cache.GenerateTwoFactorChallengeKey(token))
+		s.cache.Delete(ctx, attemptKey)
+		s.audit(ctx, userID, model.AuditEventTwoFactorChallengeOut,
+			fmt.Sprintf("两步验证码连续错误%d次，登录挑战已作废", attempts))
+	}
+}
+
+// 写入安全审计日志，失败时只记录错误
+func (s *userServiceImpl) audit(ctx context.Context, userID int64, event, detail string) {
+	if s.auditRepo == nil {
+		return
+	}
+	auditLog := &model.SecurityAuditLog{
+		UserID:  userID,
+		Event:   event,
+		Subject: fmt.Sprintf("user:%d", userID),
+		Detail:  detail,
+	}
+	if err := s.auditRepo.Create(ctx, auditLog); err != nil {
+		logger.Error("写入审计日志失败",
+			logger.ErrorField(err),
+			logger.StringField("event", event))
+	}
+}
+
+// 第三方授权请求，state作为键保存在缓存中，回调时取出并作废。
+// BindingHash为发起授权的浏览器所持绑定值的哈希，回调必须来自同一浏览器
+type oauthState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	RedirectURI  string `json:"redirect_uri"`
+	LinkUserID   int64  `json:"link_user_id"`
+	BindingHash  string `json:"binding_hash"`
+}
+
+func hashOAuthBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+// 自动注册时用户名只保留字母、数字和下划线
+var usernameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// 用户名基础部分的最大长度，为冲突时追加的后缀留出空间
+const usernameBaseMaxLength = 24
+
+// 发起第三方授权，返回跳转地址；linkUserID不为0时为已登录用户绑定第三方账号
+func (s *userServiceImpl) StartOAuth(ctx context.Context, provider string, linkUserID int64, binding string) (string, error) {
+	logger.Info("发起第三方授权请求",
+		logger.StringField("provider", provider),
+		logger.Int64Field("link_user_id", linkUserID))
+
+	identityProvider, ok := s.providers[provider]
+	if !ok {
+		return "", oauth.ErrProviderNotFound
+	}
+	if binding == "" {
+		return "", ErrOAuthStateInvalid
+	}
+	if s.cache == nil {
+		logger.Error("缓存不可用，无法发起第三方授权",
+			logger.StringField("provider", provider))
+		return "", ErrInternalServer
+	}
+
+	state, err := oauth.RandomString(24)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+	nonce, err := oauth.RandomString(24)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+	verifier, err := oauth.RandomString(48)
+	if err != nil {
+		return "", ErrInternalServer
+	}
+
+	pending := &oauthState{
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RedirectURI:  strings.ReplaceAll(s.oauthConfig.RedirectURL, "{provider}", url.PathEscape(provider)),
+		LinkUserID:   linkUserID,
+		BindingHash:  hashOAuthBinding(binding),
+	}
+	data, _ := json.Marshal(pending)
+	ttl := time.Duration(s.oauthConfig.StateMinutes) * time.Minute
+	if err := s.cache.Set(ctx, cache.GenerateOAuthStateKey(state), string(data), ttl); err != nil {
+		logger.Error("保存授权状态失败",
+			logger.ErrorField(err),
+			logger.StringField("provider", provider))
+		return "", ErrInternalServer
+	}
+
+	return identityProvider.AuthCodeURL(&oauth.AuthRequest{
+		State:         state,
+		Nonce:         nonce,
+		CodeChallenge: oauth.CodeChallenge(verifier),
+		RedirectURI:   pending.RedirectURI,
+	}), nil
+}
+
+// 第三方授权回调：换取并校验ID令牌后登录已绑定的用户，首次登录自动注册；绑定流程则把第三方账号绑定到发起的用户
+func (s *userServiceImpl) OAuthCallback(ctx context.Context, provider, state, code, binding string, device *model.DeviceInfo) (*LoginResult, error) {
+	logger.Info("第三方授权回调",
+		logger.StringField("provider", provider))
+
+	identityProvider, ok := s.providers[provider]
+	if !ok {
+		return nil, oauth.ErrProviderNotFound
+	}
+	if s.identityRepo == nil {
+		return nil, ErrInternalServer
+	}
+
+	pending, err := s.consumeOAuthState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if pending.Provider != provider {
+		return nil, ErrOAuthStateInvalid
+	}
+	//state只能由发起授权的浏览器使用，防止把攻击者的授权码和state发给受害者完成登录或绑定
+	if binding == "" || subtle.ConstantTimeCompare([]byte(hashOAuthBinding(binding)), []byte(pending.BindingHash)) != 1 {
+		logger.Warn("授权回调与发起授权的浏览器不一致",
+			logger.StringField("provider", provider))
+		return nil, ErrOAuthStateInvalid
+	}
+
+	rawIDToken, err := identityProvider.Exchange(ctx, code, pending.CodeVerifier, pending.RedirectURI)
+	if err != nil {
+		logger.Warn("授权码换取令牌失败",
+			logger.ErrorField(err),
+			logger.StringField("provider", provider))
+		return nil, ErrOAuthFailed
+	}
+	identity, err := identityProvider.VerifyIDToken(ctx, rawIDToken, pending.Nonce)
+	if err != nil {
+		return nil, ErrOAuthFailed
+	}
+
+	existing, err := s.identityRepo.FindByProviderSubject(ctx, provider, identity.Subject)
+	if err != nil {
+		logger.Error("查询第三方账号绑定失败",
+			logger.ErrorField(err),
+			logger.StringField("provider", provider))
+		return nil, ErrInternalServer
+	}
+
+	if pending.LinkUserID != 0 {
+		return s.linkIdentity(ctx, pending.LinkUserID, identity, existing)
+	}
+
+	var user *model.User
+	registered := false
+	if existing != nil {
+		user, err = s.repo.FindByID(ctx, existing.UserID)
+		if err != nil {
+			logger.Error("查询用户失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", existing.UserID))
+			return nil, ErrInternalServer
+		}
+		if user == nil {
+			return nil, ErrOAuthFailed
+		}
+	} else {
+		user, err = s.registerFromIdentity(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+		registered = true
+	}
+
+	result, err := s.finishLogin(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+	result.Registered = registered
+	return result, nil
+}
+
+// 已绑定的第三方账号列表
+func (s *userServiceImpl) ListIdentities(ctx context.Context, userID int64) ([]*model.UserIdentity, error) {
+	if s.identityRepo == nil {
+		return []*model.UserIdentity{}, nil
+	}
+
+	identities, err := s.identityRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询第三方账号绑定失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return identities, nil
+}
+
+// 解除第三方账号绑定，账号没有密码时不能解除唯一的绑定
+func (s *userServiceImpl) UnlinkIdentity(ctx context.Context, userID int64, provider string) error {
+	logger.Info("解除第三方账号绑定请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("provider", provider))
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	identities, err := s.ListIdentities(ctx, userID)
+	if err != nil {
+		return err
+	}
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return ErrIdentityNotLinked
+	}
+	if user.PasswordUnset && len(identities) <= 1 {
+		return ErrLastLoginMethod
+	}
+
+	deleted, err := s.identityRepo.Delete(ctx, userID, provider)
+	if err != nil {
+		logger.Error("解除第三方账号绑定失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if !deleted {
+		return ErrIdentityNotLinked
+	}
+
+	s.audit(ctx, userID, model.AuditEventIdentityUnlinked, fmt.Sprintf("解除绑定%s账号", provider))
+	return nil
+}
+
+// 取出并作废授权状态，同一个state只能回调一次
+func (s *userServiceImpl) consumeOAuthState(ctx context.Context, state string) (*oauthState, error) {
+	if s.cache == nil || state == "" {
+		return nil, ErrOAuthStateInvalid
+	}
+
+	key := cache.GenerateOAuthStateKey(state)
+	data, err := s.cache.GetSet(ctx, key, "", time.Second)
+	if err != nil || data == "" {
+		return nil, ErrOAuthStateInvalid
+	}
+	s.cache.Delete(ctx, key)
+
+	var pending oauthState
+	if err := json.Unmarshal([]byte(data), &pending); err != nil {
+		return nil, ErrOAuthStateInvalid
+	}
+	return &pending, nil
+}
+
+func (s *userServiceImpl) linkIdentity(ctx context.Context, userID int64, identity *oauth.Identity, existing *model.UserIdentity) (*LoginResult, error) {
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if existing != nil {
+		if existing.UserID != userID {
+			return nil, ErrIdentityLinkedOther
+		}
+		return &LoginResult{User: user, Linked: true}, nil
+	}
+
+	created, err := s.identityRepo.Create(ctx, &model.UserIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+		Name:     identity.Name,
+	})
+	if err != nil {
+		logger.Error("绑定第三方账号失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	//唯一索引冲突：该用户已绑定同一提供方的其他账号，或第三方账号刚被其他用户绑定
+	if !created {
+		return nil, ErrProviderLinked
+	}
+
+	s.audit(ctx, userID, model.AuditEventIdentityLinked, fmt.Sprintf("绑定%s账号", identity.Provider))
+	logger.Info("绑定第三方账号成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("provider", identity.Provider))
+	return &LoginResult{User: user, Linked: true}, nil
+}
+
+// 第三方账号首次登录时自动注册，账号没有可用的密码，之后可在资料中设置
+func (s *userServiceImpl) registerFromIdentity(ctx context.Context, identity *oauth.Identity) (*model.User, error) {
+	username, err := s.resolveUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	randomPassword, err := randomToken(32)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(randomPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+
+	user := &model.User{
+		Username:      username,
+		Password:      string(hashedPassword),
+		PasswordUnset: true,
+	}
+	if len(identity.Picture) <= 255 {
+		user.Avatar = identity.Picture
+	}
+	err = s.identityRepo.CreateWithUser(ctx, user, &model.UserIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+		Name:     identity.Name,
+	})
+	if err != nil {
+		logger.Error("第三方登录自动注册失败",
+			logger.ErrorField(err),
+			logger.StringField("provider", identity.Provider))
+		return nil, ErrInternalServer
+	}
+
+	s.publishRegistered(ctx, user)
+
+	logger.Info("第三方登录自动注册成功",
+		logger.Int64Field("user_id", user.ID),
+		logger.StringField("username", username),
+		logger.StringField("provider", identity.Provider))
+	return user, nil
+}
+
+// 依次取第三方账号的用户名、昵称和邮箱前缀作为用户名，被占用时追加数字后缀，仍冲突时追加随机后缀
+func (s *userServiceImpl) resolveUsername(ctx context.Context, identity *oauth.Identity) (string, error) {
+	emailLocal := identity.Email
+	if at := strings.Index(emailLocal, "@"); at >= 0 {
+		emailLocal = emailLocal[:at]
+	}
+
+	base := ""
+	for _, candidate := range []string{identity.PreferredUsername, identity.Name, emailLocal} {
+		cleaned := usernameInvalidChars.ReplaceAllString(candidate, "")
+		if len(cleaned) >= 3 && textfilter.Check(cleaned).Action == textfilter.ActionPass {
+			base = cleaned
+			break
+		}
+	}
+	if base == "" {
+		base = usernameInvalidChars.ReplaceAllString(identity.Provider, "") + "_user"
+	}
+	if len(base) > usernameBaseMaxLength {
+		base = base[:usernameBaseMaxLength]
+	}
+
+	candidates := []string{base}
+	for i := 2; i <= 9; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s_%d", base, i))
+	}
+	for i := 0; i < 3; i++ {
+		suffix, err := randomToken(3)
+		if err != nil {
+			return "", ErrInternalServer
+		}
+		candidates = append(candidates, base+"_"+suffix)
+	}
+
+	taken, err := s.unavailableUsernames(ctx, 0, candidates)
+	if err != nil {
+		logger.Error("检查用户名失败",
+			logger.ErrorField(err),
+			logger.StringField("username", base))
+		return "", ErrInternalServer
+	}
+	for _, candidate := range candidates {
+		if !taken[candidate] {
+			return candidate, nil
+		}
+	}
+	return "", ErrUsernameExists
+}
+
+// 定时任务每轮处理的注销申请和导出任务数量
+const (
+	deletionBatchSize = 100
+	exportBatchSize   = 10
+)
+
+// 导出任务处理中超过该时长未完成时视为中断，下一轮重新处理
+const exportStaleTimeout = 30 * time.Minute
+
+// 申请注销账号，需要验证密码，开启两步验证的账号还需要验证码；冷静期结束后由定时任务执行注销
+func (s *userServiceImpl) RequestAccountDeletion(ctx context.Context, userID int64, password, twoFactorCode string) (*model.AccountDeletion, error) {
+	logger.Info("申请注销账号请求",
+		logger.Int64Field("user_id", userID))
+
+	if s.deletionRepo == nil {
+		return nil, ErrInternalServer
+	}
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
+		return nil, ErrUserNotFound
+	}
+
+	existing, err := s.deletionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询注销申请失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if existing != nil && existing.Status == model.DeletionStatusPending {
+		return nil, ErrDeletionPending
+	}
+
+	//第三方登录注册且未设置密码的账号没有密码可以验证
+	if !user.PasswordUnset {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+			logger.Warn("注销账号密码错误",
+				logger.Int64Field("user_id", userID))
+			return nil, ErrPasswordWrong
+		}
+	}
+	if err := s.requireStepUp(ctx, userID, twoFactorCode); err != nil {
+		return nil, err
+	}
+
+	scheduledAt := time.Now().Add(time.Duration(s.accountConfig.DeletionCoolingDays) * 24 * time.Hour)
+	if err := s.deletionRepo.Schedule(ctx, userID, scheduledAt); err != nil {
+		logger.Error("保存注销申请失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	deletion, err := s.deletionRepo.FindByUserID(ctx, userID)
+	if err != nil || deletion == nil {
+		logger.Error("查询注销申请失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	s.notify(ctx, userID, "账号注销申请已提交",
+		fmt.Sprintf("你的账号将于 %s 注销，注销后资料、作品和互动记录将被删除且无法恢复。在此之前登录并撤销申请即可保留账号。",
+			scheduledAt.Format("2006-01-02 15:04:05")),
+		messageModel.NotificationTypeDeletionScheduled)
+	s.audit(ctx, userID, model.AuditEventDeletionRequested,
+		fmt.Sprintf("申请注销账号，计划于%s执行", scheduledAt.Format("2006-01-02 15:04:05")))
+
+	logger.Info("申请注销账号成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("scheduled_at", scheduledAt.Format("2006-01-02 15:04:05")))
+	return deletion, nil
+}
+
+// 获取最近一次注销申请，没有申请时返回nil
+func (s *userServiceImpl) GetAccountDeletion(ctx context.Context, userID int64) (*model.AccountDeletion, error) {
+	if s.deletionRepo == nil {
+		return nil, nil
+	}
+
+	deletion, err := s.deletionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询注销申请失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return deletion, nil
+}
+
+// 冷静期内撤销注销申请
+func (s *userServiceImpl) CancelAccountDeletion(ctx context.Context, userID int64) error {
+	logger.Info("撤销注销申请请求",
+		logger.Int64Field("user_id", userID))
+
+	if s.deletionRepo == nil {
+		return ErrDeletionNotFound
+	}
+
+	cancelled, err := s.deletionRepo.Cancel(ctx, userID, time.Now())
+	if err != nil {
+		logger.Error("撤销注销申请失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if !cancelled {
+		return ErrDeletionNotFound
+	}
+
+	s.audit(ctx, userID, model.AuditEventDeletionCancelled, "撤销注销申请")
+	logger.Info("撤销注销申请成功",
+		logger.Int64Field("user_id", userID))
+	return nil
+}
+
+// 执行冷静期已结束的注销申请，返回本轮完成注销的账号数
+func (s *userServiceImpl) ProcessDueDeletions(ctx context.Context) (int, error) {
+	if s.deletionRepo == nil {
+		return 0, nil
+	}
+
+	deletions, err := s.deletionRepo.ListDue(ctx, time.Now(), deletionBatchSize)
+	if err != nil {
+		logger.Error("查询到期注销申请失败",
+			logger.ErrorField(err))
+		return 0, ErrInternalServer
+	}
+
+	completed := 0
+	for _, deletion := range deletions {
+		//单个账号失败不影响其他账号，申请保持待执行状态，下一轮重试
+		if err := s.deleteAccount(ctx, deletion.UserID); err != nil {
+			logger.Error("执行账号注销失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", deletion.UserID))
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// 注销账号：匿名化用户资料，清除登录凭证和导出文件，再通知各服务清理该用户的数据；每一步都可以重复执行
+func (s *userServiceImpl) deleteAccount(ctx context.Context, userID int64) error {
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user != nil && !user.IsDeleted {
+		//用户名保留为占位名称，密码替换为无人知道的随机值
+		randomPassword, err := randomToken(32)
+		if err != nil {
+			return err
+		}
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(randomPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		user.Username = fmt.Sprintf("deleted_%d", userID)
+		user.Password = string(hashedPassword)
+		user.Avatar = ""
+		user.About = ""
+		user.IsPrivate = false
+		user.PasswordUnset = true
+		user.IsDeleted = true
+		if err := s.repo.Update(ctx, user); err != nil {
+			return err
+		}
+	}
+
+	if s.cache != nil {
+		if err := s.LogoutAll(ctx, userID); err != nil {
+			return err
+		}
+		s.cache.Delete(ctx, cache.GenerateUserKey(userID))
+	}
+	if s.sessionRepo != nil {
+		if err := s.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if s.identityRepo != nil {
+		if err := s.identityRepo.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if s.experienceRepo != nil {
+		if err := s.experienceRepo.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if s.settingsRepo != nil {
+		if err := s.settingsRepo.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+		s.settings.Invalidate(ctx, userID)
+	}
+	//删除修改记录，旧用户名不再跳转到注销后的账号
+	if s.usernameRepo != nil {
+		if err := s.usernameRepo.DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if s.twoFactorRepo != nil {
+		if err := s.twoFactorRepo.Disable(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if s.exportRepo != nil {
+		objectNames, err := s.exportRepo.DeleteByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if s.storage != nil {
+			for _, objectName := range objectNames {
+				if err := s.storage.Delete(ctx, s.accountConfig.ExportBucket, objectName); err != nil {
+					logger.Warn("删除导出文件失败",
+						logger.ErrorField(err),
+						logger.StringField("object", objectName))
+				}
+			}
+		}
+	}
+
+	if s.es != nil {
+		if err := s.es.DeleteDocument("users", fmt.Sprintf("%d", userID)); err != nil {
+			logger.Warn("从ES删除用户失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+		}
+	}
+
+	//各服务消费注销事件后删除或匿名化该用户的数据，事件发送成功后才标记注销完成
+	if s.kafkaProducer == nil {
+		return errors.New("kafka生产者不可用")
+	}
+	if err := s.kafkaProducer.SendUserDeletedEvent(ctx, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.deletionRepo.Complete(ctx, userID, time.Now()); err != nil {
+		return err
+	}
+
+	s.audit(ctx, userID, model.AuditEventDeletionCompleted, "账号已注销")
+	logger.Info("账号注销完成",
+		logger.Int64Field("user_id", userID))
+	return nil
+}
+
+// 申请导出个人数据，同一时间只能有一个进行中的导出任务，导出文件由定时任务生成
+func (s *userServiceImpl) RequestDataExport(ctx context.Context, userID int64, twoFactorCode string) (*model.DataExport, error) {
+	logger.Info("申请导出个人数据请求",
+		logger.Int64Field("user_id", userID))
+
+	if s.exportRepo == nil {
+		return nil, ErrInternalServer
+	}
+	if err := s.requireStepUp(ctx, userID, twoFactorCode); err != nil {
+		return nil, err
+	}
+
+	latest, err := s.exportRepo.FindLatest(ctx, userID)
+	if err != nil {
+		logger.Error("查询数据导出任务失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if latest != nil && (latest.Status == model.ExportStatusPending || latest.Status == model.ExportStatusProcessing) {
+		return nil, ErrExportInProgress
+	}
+
+	export := &model.DataExport{
+		UserID: userID,
+		Status: model.ExportStatusPending,
+	}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		logger.Error("创建数据导出任务失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+
+	s.audit(ctx, userID, model.AuditEventDataExportRequested, fmt.Sprintf("申请导出个人数据，任务ID:%d", export.ID))
+	logger.Info("申请导出个人数据成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("export_id", export.ID))
+	return export, nil
+}
+
+// 获取最近一次导出任务，导出完成且链接未过期时同时返回下载链接
+func (s *userServiceImpl) GetDataExport(ctx context.Context, userID int64) (*model.DataExport, string, error) {
+	if s.exportRepo == nil {
+		return nil, "", ErrExportNotFound
+	}
+
+	export, err := s.exportRepo.FindLatest(ctx, userID)
+	if err != nil {
+		logger.Error("查询数据导出任务失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, "", ErrInternalServer
+	}
+	if export == nil {
+		return nil, "", ErrExportNotFound
+	}
+
+	if export.Status != model.ExportStatusCompleted || export.ExpiresAt == nil || s.storage == nil {
+		return export, "", nil
+	}
+	remaining := time.Until(*export.ExpiresAt)
+	if remaining <= 0 {
+		return export, "", nil
+	}
+	downloadURL, err := s.storage.GetPresignedURL(ctx, s.accountConfig.ExportBucket, export.ObjectName, remaining)
+	if err != nil {
+		logger.Error("生成下载链接失败",
+			logger.ErrorField(err),
+			logger.Int64Field("export_id", export.ID))
+		return nil, "", ErrInternalServer
+	}
+	return export, downloadURL, nil
+}
+
+// 处理待执行的导出任务，返回本轮完成的任务数
+func (s *userServiceImpl) ProcessDataExports(ctx context.Context) (int, error) {
+	if s.exportRepo == nil || s.personalDataRepo == nil || s.storage == nil {
+		return 0, nil
+	}
+
+	staleBefore := time.Now().Add(-exportStaleTimeout)
+	exports, err := s.exportRepo.ListPending(ctx, staleBefore, exportBatchSize)
+	if err != nil {
+		logger.Error("查询待处理导出任务失败",
+			logger.ErrorField(err))
+		return 0, ErrInternalServer
+	}
+
+	completed := 0
+	for _, export := range exports {
+		claimed, err := s.exportRepo.Claim(ctx, export.ID, staleBefore)
+		if err != nil {
+			logger.Error("领取导出任务失败",
+				logger.ErrorField(err),
+				logger.Int64Field("export_id", export.ID))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.buildDataExport(ctx, export); err != nil {
+			logger.Error("生成数据导出失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", export.UserID),
+				logger.Int64Field("export_id", export.ID))
+			if err := s.exportRepo.Fail(ctx, export.ID); err != nil {
+				logger.Error("标记导出任务失败状态失败",
+					logger.ErrorField(err),
+					logger.Int64Field("export_id", export.ID))
+			}
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// 收集用户在各服务的数据，打包为ZIP上传到私有桶，并通过系统通知发送下载链接
+func (s *userServiceImpl) buildDataExport(ctx context.Context, export *model.DataExport) error {
+	user, err := s.repo.FindByID(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.IsDeleted {
+		return ErrUserNotFound
+	}
+
+	sections, err := s.personalDataRepo.Collect(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	//资料中不包含密码哈希
+	profile := map[string]interface{}{
+		"id":             user.ID,
+		"username":       user.Username,
+		"avatar":         user.Avatar,
+		"about":          user.About,
+		"follow_count":   user.FollowCount,
+		"follower_count": user.FollowerCount,
+		"is_private":     user.IsPrivate,
+		"created_at":     user.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := []*dao.PersonalDataSection{{Name: "profile", Rows: []map[string]interface{}{profile}}}
+	for _, section := range append(files, sections...) {
+		rows := section.Rows
+		if rows == nil {
+			rows = []map[string]interface{}{}
+		}
+		data, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return err
+		}
+		writer, err := archive.Create(section.Name + ".json")
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	//对象名带随机后缀，避免被猜测
+	suffix, err := randomToken(16)
+	if err != nil {
+		return err
+	}
+	objectName := fmt.Sprintf("exports/%d/%d_%s.zip", export.UserID, export.ID, suffix)
+	if _, err := s.storage.Upload(ctx, s.accountConfig.ExportBucket, objectName, bytes.NewReader(buf.Bytes()), int64(buf.Len()), "application/zip"); err != nil {
+		return err
+	}
+
+	expiry := time.Duration(s.accountConfig.ExportExpireHours) * time.Hour
+	downloadURL, err := s.storage.GetPresignedURL(ctx, s.accountConfig.ExportBucket, objectName, expiry)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := s.exportRepo.Complete(ctx, export.ID, objectName, now, now.Add(expiry)); err != nil {
+		return err
+	}
+
+	s.notify(ctx, export.UserID, "个人数据导出完成",
+		fmt.Sprintf("你申请导出的个人数据已生成，下载链接 %s 有效期至 %s，请勿转发给他人。",
+			downloadURL, now.Add(expiry).Format("2006-01-02 15:04:05")),
+		messageModel.NotificationTypeDataExportReady)
+
+	logger.Info("数据导出完成",
+		logger.Int64Field("user_id", export.UserID),
+		logger.Int64Field("export_id", export.ID),
+		logger.IntField("size", buf.Len()))
+	return nil
+}
+
+// 发送账号类系统通知，发送失败只记录日志
+func (s *userServiceImpl) notify(ctx context.Context, userID int64, title, content string, notificationType int32) {
+	if s.notificationRepo == nil {
+		return
+	}
+
+	notification := &messageModel.SystemNotification{
+		UserID:     userID,
+		Title:      title,
+		Content:    content,
+		Type:       notificationType,
+		RelatedID:  userID,
+		CreateTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		logger.Warn("发送系统通知失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("title", title))
+	}
+}
+
+// 用户当前拥有的角色，所有用户都拥有user角色
+func (s *userServiceImpl) userRoles(ctx context.Context, userID int64) ([]string, error) {
+	roles := []string{rbac.RoleUser}
+	if s.roleRepo == nil {
+		return roles, nil
+	}
+
+	granted, err := s.roleRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户角色失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, err
+	}
+	return append(roles, granted...), nil
+}
+
+// 操作者拥有指定权限时返回nil，权限以数据库中的角色为准，不依赖令牌中的角色
+func (s *userServiceImpl) requirePermission(ctx context.Context, operatorID int64, permission string) error {
+	roles, err := s.userRoles(ctx, operatorID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if !rbac.Has(roles, permission) {
+		logger.Warn("没有操作权限",
+			logger.Int64Field("operator_id", operatorID),
+			logger.StringField("permission", permission))
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// 记录角色变更时间，此前签发的访问令牌需要刷新后才能继续使用
+func (s *userServiceImpl) markRolesChanged(ctx context.Context, userID int64) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Set(ctx, cache.GenerateUserRolesChangedKey(userID), time.Now().UnixMilli(), s.jwtManager.RefreshExpire()); err != nil {
+		logger.Warn("记录角色变更时间失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+	}
+}
+
+// 获取用户的角色
+func (s *userServiceImpl) GetUserRoles(ctx context.Context, userID int64) ([]string, error) {
+	roles, err := s.userRoles(ctx, userID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	return roles, nil
+}
+
+// 授予角色，需要role.assign权限
+func (s *userServiceImpl) AssignRole(ctx context.Context, operatorID, userID int64, role string) error {
+	logger.Info("授予角色请求",
+		logger.Int64Field("operator_id", operatorID),
+		logger.Int64Field("user_id", userID),
+		logger.StringField("role", role))
+
+	if s.roleRepo == nil {
+		return ErrInternalServer
+	}
+	if !rbac.ValidRole(role) || role == rbac.RoleUser {
+		return ErrInvalidRole
+	}
+	if err := s.requirePermission(ctx, operatorID, rbac.PermRoleAssign); err != nil {
+		return err
+	}
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
+		return ErrUserNotFound
+	}
+
+	granted, err := s.roleRepo.Grant(ctx, userID, role, operatorID)
+	if err != nil {
+		logger.Error("授予角色失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("role", role))
+		return ErrInternalServer
+	}
+	if !granted {
+		return ErrRoleGranted
+	}
+
+	s.markRolesChanged(ctx, userID)
+	s.audit(ctx, userID, model.AuditEventRoleGranted, fmt.Sprintf("授予角色%s，操作者:%d", role, operatorID))
+	logger.Info("授予角色成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("role", role))
+	return nil
+}
+
+// 收回角色，需要role.assign权限，最后一个管理员的角色不能收回
+func (s *userServiceImpl) RevokeRole(ctx context.Context, operatorID, userID int64, role string) error {
+	logger.Info("收回角色请求",
+		logger.Int64Field("operator_id", operatorID),
+		logger.Int64Field("user_id", userID),
+		logger.StringField("role", role))
+
+	if s.roleRepo == nil {
+		return ErrInternalServer
+	}
+	if !rbac.ValidRole(role) || role == rbac.RoleUser {
+		return ErrInvalidRole
+	}
+	if err := s.requirePermission(ctx, operatorID, rbac.PermRoleAssign); err != nil {
+		return err
+	}
+
+	//锁定该角色的全部持有记录后再判断和删除，避免并发收回时删掉最后一名管理员
+	err := s.roleRepo.WithTransaction(ctx, func(txRepo dao.RoleRepository) error {
+		holders, err := txRepo.LockUserIDsByRole(ctx, role)
+		if err != nil {
+			return err
+		}
+		held := false
+		for _, holderID := range holders {
+			if holderID == userID {
+				held = true
+				break
+			}
+		}
+		if !held {
+			return ErrRoleNotGranted
+		}
+		if role == rbac.RoleAdmin && len(holders) <= 1 {
+			return ErrLastAdmin
+		}
+
+		revoked, err := txRepo.Revoke(ctx, userID, role)
+		if err != nil {
+			return err
+		}
+		if !revoked {
+			return ErrRoleNotGranted
+		}
+		return nil
+	})
+	if err == ErrRoleNotGranted || err == ErrLastAdmin {
+		return err
+	}
+	if err != nil {
+		logger.Error("收回角色失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("role", role))
+		return ErrInternalServer
+	}
+
+	s.markRolesChanged(ctx, userID)
+	s.audit(ctx, userID, model.AuditEventRoleRevoked, fmt.Sprintf("收回角色%s，操作者:%d", role, operatorID))
+	logger.Info("收回角色成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("role", role))
+	return nil
+}
+
+// 封禁或解封用户，需要user.ban权限；封禁同样拥有封禁权限的用户还需要role.assign权限，避免版主之间互相封禁
+func (s *userServiceImpl) BanUser(ctx context.Context, operatorID, userID int64, banned bool) error {
+	logger.Info("封禁用户请求",
+		logger.Int64Field("operator_id", operatorID),
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("banned", banned))
+
+	if operatorID == userID {
+		return ErrCannotBanSelf
+	}
+	if err := s.requirePermission(ctx, operatorID, rbac.PermUserBan); err != nil {
+		return err
+	}
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
+		return ErrUserNotFound
+	}
+
+	targetRoles, err := s.userRoles(ctx, userID)
+	if err != nil {
+		return ErrInternalServer
+	}
+	if rbac.Has(targetRoles, rbac.PermUserBan) {
+		if err := s.requirePermission(ctx, operatorID, rbac.PermRoleAssign); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.UpdateBanned(ctx, userID, banned); err != nil {
+		logger.Error("更新封禁状态失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateUserKey(userID))
+	}
+
+	event := model.AuditEventUserUnbanned
+	if banned {
+		//封禁后已登录的设备全部下线
+		if err := s.LogoutAll(ctx, userID); err != nil {
+			return err
+		}
+		event = model.AuditEventUserBanned
+	}
+	s.audit(ctx, userID, event, fmt.Sprintf("操作者:%d", operatorID))
+
+	logger.Info("封禁用户成功",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("banned", banned))
+	return nil
+}
+
+// 为配置中的用户授予管理员角色，用于初始化第一个管理员，用户不存在时跳过
+func (s *userServiceImpl) EnsureAdmins(ctx context.Context, usernames []string) error {
+	if s.roleRepo == nil {
+		return nil
+	}
+
+	for _, username := range usernames {
+		user, err := s.repo.FindByUsername(ctx, username)
+		if err != nil {
+			logger.Error("查询用户失败",
+				logger.ErrorField(err),
+				logger.StringField("username", username))
+			return ErrInternalServer
+		}
+		if user == nil || user.IsDeleted {
+			logger.Warn("初始管理员不存在",
+				logger.StringField("username", username))
+			continue
+		}
+
+		granted, err := s.roleRepo.Grant(ctx, user.ID, rbac.RoleAdmin, 0)
+		if err != nil {
+			logger.Error("授予管理员角色失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", user.ID))
+			return ErrInternalServer
+		}
+		if granted {
+			s.markRolesChanged(ctx, user.ID)
+			s.audit(ctx, user.ID, model.AuditEventRoleGranted, "按配置授予初始管理员角色")
+			logger.Info("授予初始管理员角色",
+				logger.Int64Field("user_id", user.ID),
+				logger.StringField("username", username))
+		}
+	}
+	return nil
+}
+
+// 发放每日登录经验，失败只记录日志，不影响登录
+func (s *userServiceImpl) awardDailyLogin(ctx context.Context, userID int64) {
+	if err := s.AwardExperience(ctx, userID, mq.ActivityLogin, "", time.Now()); err != nil {
+		logger.Warn("发放登录经验失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+	}
+}
+
+// 按累计经验值计算等级，初始为1级，每达到一个阈值升一级
+func (s *userServiceImpl) levelForExperience(experience int64) int32 {
+	level := int32(1)
+	for _, threshold := range s.experienceConfig.LevelThresholds {
+		if experience < threshold {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+// 升到下一级所需的累计经验值，已满级时返回0
+func (s *userServiceImpl) nextLevelExperience(level int32) int64 {
+	if level < 1 || int(level) > len(s.experienceConfig.LevelThresholds) {
+		return 0
+	}
+	return s.experienceConfig.LevelThresholds[level-1]
+}
+
+// 发放经验值，同一来源同一对象每天只发放一次，超过当天上限的部分不发放，
+// 未配置的来源直接忽略。等级提升时发送系统通知
+func (s *userServiceImpl) AwardExperience(ctx context.Context, userID int64, source, refID string, occurredAt time.Time) error {
+	if s.experienceRepo == nil {
+		return nil
+	}
+	sourceConfig, ok := s.experienceConfig.Sources[source]
+	if !ok || sourceConfig.Points <= 0 {
+		return nil
+	}
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	entry := &model.ExperienceLog{
+		UserID: userID,
+		Source: source,
+		RefID:  refID,
+		Day:    occurredAt.Format("2006-01-02"),
+		Points: sourceConfig.Points,
+	}
+	awarded, experience, err := s.experienceRepo.Award(ctx, entry, sourceConfig.DailyCap)
+	if err != nil {
+		logger.Error("发放经验值失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("source", source))
+		return ErrInternalServer
+	}
+	if awarded == 0 {
+		return nil
+	}
+
+	level := s.levelForExperience(experience)
+	if level <= 1 {
+		return nil
+	}
+	//只在等级提升时更新成功，重复处理同一事件不会重复通知
+	upgraded, err := s.repo.UpdateLevel(ctx, userID, level)
+	if err != nil {
+		logger.Error("更新用户等级失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if !upgraded {
+		return nil
+	}
+
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateUserKey(userID))
+	}
+	s.notify(ctx, userID, "等级提升",
+		fmt.Sprintf("恭喜你升到了 %d 级，当前经验值 %d", level, experience),
+		messageModel.NotificationTypeLevelUp)
+	logger.Info("用户等级提升",
+		logger.Int64Field("user_id", userID),
+		logger.AnyField("level", level),
+		logger.Int64Field("experience", experience))
+	return nil
+}
+
+// 查询用户的等级、经验值和当天各来源获得的经验值
+func (s *userServiceImpl) GetUserLevel(ctx context.Context, userID int64) (*LevelInfo, error) {
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
+		return nil, ErrUserNotFound
+	}
+
+	info := &LevelInfo{
+		Level:               user.Level,
+		Experience:          user.Experience,
+		NextLevelExperience: s.nextLevelExperience(user.Level),
+		TodayExperience:     map[string]int64{},
+	}
+	if s.experienceRepo != nil {
+		today, err := s.experienceRepo.SumByDay(ctx, userID, time.Now().Format("2006-01-02"))
+		if err != nil {
+			logger.Error("统计当天经验值失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, ErrInternalServer
+		}
+		info.TodayExperience = today
+	}
+	return info, nil
+}
+
+// 获取用户设置，没有设置记录时返回默认设置
+func (s *userServiceImpl) GetSettings(ctx context.Context, userID int64) (*model.UserSettings, error) {
+	if s.settingsRepo == nil {
+		return model.DefaultUserSettings(userID), nil
+	}
+
+	userSettings, err := s.settings.Get(ctx, userID)
+	if err != nil {
+		return nil, ErrInternalServer
+	}
+	return userSettings, nil
+}
+
+// 部分更新用户设置，更新前后都以数据库中的版本为准，版本不一致时返回ErrSettingsConflict
+func (s *userServiceImpl) UpdateSettings(ctx context.Context, userID int64, update *SettingsUpdate) (*model.UserSettings, error) {
+	logger.Info("更新用户设置请求",
+		logger.Int64Field("user_id", userID))
+
+	if s.settingsRepo == nil {
+		return nil, ErrInternalServer
+	}
+	if update == nil {
+		return nil, ErrInvalidSetting
+	}
+	if update.DMPermission != nil && !model.IsValidSettingAudience(*update.DMPermission) {
+		return nil, ErrInvalidSetting
+	}
+	if update.CommentPermission != nil && !model.IsValidSettingAudience(*update.CommentPermission) {
+		return nil, ErrInvalidSetting
+	}
+
+	current, err := s.settingsRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if current == nil {
+		current = model.DefaultUserSettings(userID)
+	}
+	if update.Version != nil && *update.Version != current.Version {
+		return nil, ErrSettingsConflict
+	}
+
+	next := *current
+	if update.DMPermission != nil {
+		next.DMPermission = *update.DMPermission
+	}
+	if update.CommentPermission != nil {
+		next.CommentPermission = *update.CommentPermission
+	}
+	if update.LikedListPublic != nil {
+		next.LikedListPublic = *update.LikedListPublic
+	}
+	if update.StarredListPublic != nil {
+		next.StarredListPublic = *update.StarredListPublic
+	}
+	if update.FollowListVisible != nil {
+		next.FollowListVisible = *update.FollowListVisible
+	}
+	if update.AllowRemix != nil {
+		next.AllowRemix = *update.AllowRemix
+	}
+	if update.ShowOnlineStatus != nil {
+		next.ShowOnlineStatus = *update.ShowOnlineStatus
+	}
+
+	saved, err := s.settingsRepo.Save(ctx, &next, current.Version)
+	if err != nil {
+		logger.Error("保存用户设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	if !saved {
+		return nil, ErrSettingsConflict
+	}
+	s.settings.Invalidate(ctx, userID)
+
+	logger.Info("更新用户设置成功",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("version", next.Version))
+	return &next, nil
+}
+
+// 用户名只能包含字母、数字和下划线
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_]{3,32}$`)
+
+// 是否为配置中的保留用户名，不区分大小写
+func (s *userServiceImpl) isReservedUsername(username string) bool {
+	for _, reserved := range s.usernameConfig.Reserved {
+		if strings.EqualFold(username, reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+// 返回各用户名是否不可用：已被使用、是保留用户名或是其他用户保留期内的旧用户名。
+// userID为0时所有保留期内的旧用户名都不可用，否则该用户可以改回自己的旧用户名
+func (s *userServiceImpl) unavailableUsernames(ctx context.Context, userID int64, usernames []string) (map[string]bool, error) {
+	unavailable, err := s.repo.BatchCheckUsername(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.usernameRepo != nil {
+		held, err := s.usernameRepo.ListActiveByUsernames(ctx, usernames, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		for username, holderID := range held {
+			if holderID != userID {
+				unavailable[username] = true
+			}
+		}
+	}
+
+	for _, username := range usernames {
+		if s.isReservedUsername(username) {
+			unavailable[username] = true
+		}
+	}
+	return unavailable, nil
+}
+
+// 修改用户名，两次修改之间需间隔配置的冷却期；旧用户名在保留期内跳转到新用户名，其他用户不能使用。
+// 返回修改后的用户和下次可以修改的时间
+func (s *userServiceImpl) ChangeUsername(ctx context.Context, userID int64, username, twoFactorCode string) (*model.User, time.Time, error) {
+	logger.Info("修改用户名请求",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("username", username))
+
+	if !usernamePattern.MatchString(username) {
+		return nil, time.Time{}, ErrInvalidUsername
+	}
+	//用户名不做打码，命中任何敏感词都拒绝
+	if textfilter.Check(username).Action != textfilter.ActionPass {
+		return nil, time.Time{}, ErrSensitiveContent
+	}
+	if s.isReservedUsername(username) {
+		return nil, time.Time{}, ErrUsernameReserved
+	}
+	if s.usernameRepo == nil {
+		return nil, time.Time{}, ErrInternalServer
+	}
+
+	user, err := s.repo.FindByID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, time.Time{}, ErrInternalServer
+	}
+	if user == nil || user.IsDeleted {
+		return nil, time.Time{}, ErrUserNotFound
+	}
+	if user.Username == username {
+		return nil, time.Time{}, ErrUsernameUnchanged
+	}
+
+	now := time.Now()
+	cooldown := time.Duration(s.usernameConfig.ChangeCooldownDays) * 24 * time.Hour
+	latest, err := s.usernameRepo.FindLatestByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户名修改记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, time.Time{}, ErrInternalServer
+	}
+	if latest != nil && now.Before(latest.CreatedAt.Add(cooldown)) {
+		return nil, time.Time{}, ErrUsernameChangeTooSoon
+	}
+
+	unavailable, err := s.unavailableUsernames(ctx, userID, []string{username})
+	if err != nil {
+		logger.Error("检查用户名失败",
+			logger.ErrorField(err),
+			logger.StringField("username", username))
+		return nil, time.Time{}, ErrInternalServer
+	}
+	if unavailable[username] {
+		return nil, time.Time{}, ErrUsernameExists
+	}
+	//用户名用于登录和@提及，开启两步验证的账号修改前需要验证码
+	if err := s.requireStepUp(ctx, userID, twoFactorCode); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	oldUsername := user.Username
+	history := &model.UsernameHistory{
+		UserID:        userID,
+		OldUsername:   oldUsername,
+		NewUsername:   username,
+		RedirectUntil: now.Add(time.Duration(s.usernameConfig.RedirectDays) * 24 * time.Hour),
+	}
+	renamed, err := s.usernameRepo.Rename(ctx, history)
+	if errors.Is(err, dao.ErrUsernameTaken) {
+		//检查之后新用户名被并发的注册或改名占用
+		return nil, time.Time{}, ErrUsernameExists
+	}
+	if err != nil {
+		logger.Error("修改用户名失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, time.Time{}, ErrInternalServer
+	}
+	if !renamed {
+		//用户名已被同时进行的另一次修改改掉
+		return nil, time.Time{}, ErrUsernameChangeTooSoon
+	}
+	user.Username = username
+
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateUserKey(userID))
+	}
+
+	//同步Elasticsearch中的用户名，搜索结果使用新用户名
+	if s.es != nil {
+		err := s.es.UpdateDocument("users", fmt.Sprintf("%d", userID), map[string]interface{}{
+			"username": username,
+		})
+		if err != nil {
+			logger.Error("更新用户到ES失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+		}
+	}
+
+	s.audit(ctx, userID, model.AuditEventUsernameChanged, fmt.Sprintf("%s -> %s", oldUsername, username))
+
+	logger.Info("修改用户名成功",
+		logger.Int64Field("user_id", userID),
+		logger.StringField("old_username", oldUsername),
+		logger.StringField("username", username))
+	return user, now.Add(cooldown), nil
+}
+
+// 获取用户名修改记录，查看他人的记录需要username.history权限
+func (s *userServiceImpl) GetUsernameHistory(ctx context.Context, operatorID, userID int64) ([]*model.UsernameHistory, error) {
+	if operatorID != userID {
+		if err := s.requirePermission(ctx, operatorID, rbac.PermUsernameHistory); err != nil {
+			return nil, err
+		}
+	}
+	if s.usernameRepo == nil {
+		return []*model.UsernameHistory{}, nil
+	}
+
+	histories, err := s.usernameRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户名修改记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, ErrInternalServer
+	}
+	return histories, nil
 }
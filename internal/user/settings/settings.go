@@ -0,0 +1,93 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"shortvideo/internal/user/dao"
+	"shortvideo/internal/user/model"
+	"shortvideo/pkg/cache"
+	"shortvideo/pkg/logger"
+)
+
+// 设置缓存的有效期，用户服务更新设置后会立即删除缓存
+const cacheExpire = 30 * time.Minute
+
+// Client 读取用户设置，各服务共用数据库，直接读取设置表并按用户缓存在Redis中，
+// 没有设置记录时返回默认设置
+type Client interface {
+	Get(ctx context.Context, userID int64) (*model.UserSettings, error)
+	Invalidate(ctx context.Context, userID int64)
+}
+
+type client struct {
+	repo  dao.SettingsRepository
+	cache cache.Cache
+}
+
+func NewClient(repo dao.SettingsRepository, cache cache.Cache) Client {
+	return &client{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+func (c *client) Get(ctx context.Context, userID int64) (*model.UserSettings, error) {
+	key := cache.GenerateUserSettingsKey(userID)
+	if c.cache != nil {
+		data, err := c.cache.Get(ctx, key)
+		if err == nil && data != "" {
+			var settings model.UserSettings
+			if err := json.Unmarshal([]byte(data), &settings); err == nil {
+				return &settings, nil
+			}
+		}
+	}
+
+	settings, err := c.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		logger.Error("查询用户设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, err
+	}
+	if settings == nil {
+		settings = model.DefaultUserSettings(userID)
+	}
+
+	if c.cache != nil {
+		if data, err := json.Marshal(settings); err == nil {
+			c.cache.Set(ctx, key, string(data), cacheExpire)
+		}
+	}
+	return settings, nil
+}
+
+func (c *client) Invalidate(ctx context.Context, userID int64) {
+	if c.cache != nil {
+		c.cache.Delete(ctx, cache.GenerateUserSettingsKey(userID))
+	}
+}
+
+// 判断userID是否在ownerID设置的范围内，本人始终允许。follows(a, b)返回a是否关注了b，
+// 只在范围为followers或friends时才查询关注关系
+func AudienceAllows(ctx context.Context, audience string, ownerID, userID int64, follows func(ctx context.Context, userID, targetUserID int64) (bool, error)) (bool, error) {
+	if userID == ownerID {
+		return true, nil
+	}
+
+	switch audience {
+	case model.SettingAudienceNobody:
+		return false, nil
+	case model.SettingAudienceFollowers:
+		return follows(ctx, userID, ownerID)
+	case model.SettingAudienceFriends:
+		following, err := follows(ctx, userID, ownerID)
+		if err != nil || !following {
+			return false, err
+		}
+		return follows(ctx, ownerID, userID)
+	}
+	return true, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"shortvideo/internal/video/model"
+	"shortvideo/pkg/pagination"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type VideoRepository interface {
@@ -13,11 +15,11 @@ type VideoRepository interface {
 	FindByID(ctx context.Context, id int64) (*model.Video, error)
 	Update(ctx context.Context, video *model.Video) error
 	Delete(ctx context.Context, id int64, userID int64) error
-	ListByAuthorID(ctx context.Context, authorID int64, page, pageSize int) ([]*model.Video, int64, error)
+	ListByAuthorID(ctx context.Context, authorID int64, cursor *pagination.Cursor, limit int) ([]*model.Video, error)
 	ListByIDs(ctx context.Context, ids []int64) ([]*model.Video, error)
 	BatchGetByIDs(ctx context.Context, ids []int64) (map[int64]*model.Video, error)
-	ListFeedVideos(ctx context.Context, latestTime int64, pageSize int) ([]*model.Video, error)
-	Search(ctx context.Context, keyword string, page, pageSize int) ([]*model.Video, int64, error)
+	ListFeedVideos(ctx context.Context, latestTime int64, pageSize int, excludeAuthorIDs []int64) ([]*model.Video, error)
+	Search(ctx context.Context, keyword string, page, pageSize int, excludeAuthorIDs []int64) ([]*model.Video, int64, error)
 	CountByAuthorID(ctx context.Context, authorID int64) (int64, error)
 	GetTotalVideoCount(ctx context.Context) (int64, error)
 	GetStats(ctx context.Context, videoID int64) (*model.VideoStats, error)
@@ -28,6 +30,19 @@ type VideoRepository interface {
 	WithTransaction(ctx context.Context, fn func(txRepo VideoRepository) error) error
 }
 
+type WatchHistoryRepository interface {
+	Upsert(ctx context.Context, history *model.WatchHistory) error
+	BatchUpsert(ctx context.Context, histories []*model.WatchHistory) error
+	FindByUserAndVideo(ctx context.Context, userID, videoID int64) (*model.WatchHistory, error)
+	ListByUserID(ctx context.Context, userID int64, beforeWatchedAt int64, limit int) ([]*model.WatchHistory, error)
+	Delete(ctx context.Context, userID, videoID int64) error
+	DeleteByUserID(ctx context.Context, userID int64) error
+	GetSetting(ctx context.Context, userID int64) (*model.WatchHistorySetting, error)
+	SaveSetting(ctx context.Context, setting *model.WatchHistorySetting) error
+	DeleteSetting(ctx context.Context, userID int64) error
+	WithTransaction(ctx context.Context, fn func(txRepo WatchHistoryRepository) error) error
+}
+
 type videoRepositoryImpl struct {
 	db *gorm.DB
 }
@@ -59,23 +74,18 @@ func (r *videoRepositoryImpl) Delete(ctx context.Context, id int64, userID int64
 		Delete(&model.Video{}).Error
 }
 
-func (r *videoRepositoryImpl) ListByAuthorID(ctx context.Context, authorID int64, page, pageSize int) ([]*model.Video, int64, error) {
+func (r *videoRepositoryImpl) ListByAuthorID(ctx context.Context, authorID int64, cursor *pagination.Cursor, limit int) ([]*model.Video, error) {
 	var videos []*model.Video
-	var total int64
-	offset := (page - 1) * pageSize
-
-	if err := r.db.WithContext(ctx).Model(&model.Video{}).
-		Where("author_id = ?", authorID).
-		Count(&total).Error; err != nil {
-		return nil, 0, err
+	query := r.db.WithContext(ctx).Where("author_id = ?", authorID)
+	if cursor != nil {
+		query = query.Where("(publish_time, id) < (?, ?)", cursor.SortKey, cursor.ID)
 	}
 
-	err := r.db.WithContext(ctx).Where("author_id = ?", authorID).
-		Offset(offset).Limit(pageSize).
-		Order("publish_time DESC").
+	err := query.Order("publish_time DESC, id DESC").
+		Limit(limit).
 		Find(&videos).Error
 
-	return videos, total, err
+	return videos, err
 }
 
 func (r *videoRepositoryImpl) ListByIDs(ctx context.Context, ids []int64) ([]*model.Video, error) {
@@ -102,13 +112,17 @@ func (r *videoRepositoryImpl) BatchGetByIDs(ctx context.Context, ids []int64) (m
 	return result, nil
 }
 
-func (r *videoRepositoryImpl) ListFeedVideos(ctx context.Context, latestTime int64, pageSize int) ([]*model.Video, error) {
+// excludeAuthorIDs中作者的视频不出现在结果中，用于隐藏拉黑和静音的用户
+func (r *videoRepositoryImpl) ListFeedVideos(ctx context.Context, latestTime int64, pageSize int, excludeAuthorIDs []int64) ([]*model.Video, error) {
 	var videos []*model.Video
 
 	query := r.db.WithContext(ctx)
 	if latestTime > 0 {
 		query = query.Where("publish_time < ?", latestTime)
 	}
+	if len(excludeAuthorIDs) > 0 {
+		query = query.Where("author_id NOT IN ?", excludeAuthorIDs)
+	}
 
 	err := query.Order("publish_time DESC").
 		Limit(pageSize).
@@ -117,7 +131,7 @@ func (r *videoRepositoryImpl) ListFeedVideos(ctx context.Context, latestTime int
 	return videos, err
 }
 
-func (r *videoRepositoryImpl) Search(ctx context.Context, keyword string, page, pageSize int) ([]*model.Video, int64, error) {
+func (r *videoRepositoryImpl) Search(ctx context.Context, keyword string, page, pageSize int, excludeAuthorIDs []int64) ([]*model.Video, int64, error) {
 	var videos []*model.Video
 	var total int64
 	offset := (page - 1) * pageSize
@@ -126,6 +140,9 @@ func (r *videoRepositoryImpl) Search(ctx context.Context, keyword string, page,
 	if keyword != "" {
 		db = db.Where("title LIKE ? OR description LIKE ?", "%"+keyword+"%", "%"+keyword+"%")
 	}
+	if len(excludeAuthorIDs) > 0 {
+		db = db.Where("author_id NOT IN ?", excludeAuthorIDs)
+	}
 
 	if err := db.Model(&model.Video{}).Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -197,3 +214,96 @@ func (r *videoRepositoryImpl) GetTotalVideoCount(ctx context.Context) (int64, er
 	err := r.db.WithContext(ctx).Model(&model.Video{}).Count(&count).Error
 	return count, err
 }
+
+type watchHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWatchHistoryRepository(db *gorm.DB) WatchHistoryRepository {
+	return &watchHistoryRepositoryImpl{db: db}
+}
+
+// 按用户和视频写入观看记录，已存在时覆盖进度
+func (r *watchHistoryRepositoryImpl) Upsert(ctx context.Context, history *model.WatchHistory) error {
+	return r.BatchUpsert(ctx, []*model.WatchHistory{history})
+}
+
+func (r *watchHistoryRepositoryImpl) BatchUpsert(ctx context.Context, histories []*model.WatchHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "video_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"position", "duration", "progress", "watched_at", "updated_at"}),
+		}).
+		Create(histories).Error
+}
+
+func (r *watchHistoryRepositoryImpl) FindByUserAndVideo(ctx context.Context, userID, videoID int64) (*model.WatchHistory, error) {
+	var history model.WatchHistory
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		First(&history).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// 按最后观看时间倒序查询，beforeWatchedAt为0时从最新开始
+func (r *watchHistoryRepositoryImpl) ListByUserID(ctx context.Context, userID int64, beforeWatchedAt int64, limit int) ([]*model.WatchHistory, error) {
+	var histories []*model.WatchHistory
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if beforeWatchedAt > 0 {
+		query = query.Where("watched_at < ?", beforeWatchedAt)
+	}
+	err := query.Order("watched_at DESC").
+		Limit(limit).
+		Find(&histories).Error
+	return histories, err
+}
+
+func (r *watchHistoryRepositoryImpl) Delete(ctx context.Context, userID, videoID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Delete(&model.WatchHistory{}).Error
+}
+
+func (r *watchHistoryRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.WatchHistory{}).Error
+}
+
+func (r *watchHistoryRepositoryImpl) GetSetting(ctx context.Context, userID int64) (*model.WatchHistorySetting, error) {
+	var setting model.WatchHistorySetting
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &setting, nil
+}
+
+func (r *watchHistoryRepositoryImpl) SaveSetting(ctx context.Context, setting *model.WatchHistorySetting) error {
+	return r.db.WithContext(ctx).Save(setting).Error
+}
+
+func (r *watchHistoryRepositoryImpl) DeleteSetting(ctx context.Context, userID int64) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&model.WatchHistorySetting{}).Error
+}
+
+func (r *watchHistoryRepositoryImpl) WithTransaction(ctx context.Context, fn func(txRepo WatchHistoryRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &watchHistoryRepositoryImpl{db: tx}
+		return fn(txRepo)
+	})
+}
@@ -2,19 +2,45 @@ package handler
 
 import (
 	"context"
+	"shortvideo/internal/interaction/rpcclient"
+	recommendrpc "shortvideo/internal/recommend/rpcclient"
+	socialrpc "shortvideo/internal/social/rpcclient"
 	"shortvideo/internal/video/service"
 	"shortvideo/kitex_gen/common"
+	"shortvideo/kitex_gen/interaction/interactionservice"
+	"shortvideo/kitex_gen/recommend/recommendservice"
+	"shortvideo/kitex_gen/social/socialservice"
 	video "shortvideo/kitex_gen/video"
 )
 
+const (
+	errPrivateAccount     = "私密账号的内容仅对关注者可见"
+	errAudienceRestricted = "该内容仅对作者指定的名单可见"
+)
+
+// 可见范围名单ID为0表示公开，不返回该字段
+func audienceListID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
 // VideoServiceImpl implements the last service interface defined in the IDL.
 type VideoServiceImpl struct {
-	videoService service.VideoService
+	videoService      service.VideoService
+	interactionClient interactionservice.Client
+	recommendClient   recommendservice.Client
+	socialClient      socialservice.Client
 }
 
-func NewVideoService(videoService service.VideoService) *VideoServiceImpl {
+// interactionClient 用于填充当前用户的点赞状态，recommendClient 用于过滤用户屏蔽的内容，socialClient 用于检查私密账号的访问权限，为nil时不处理
+func NewVideoService(videoService service.VideoService, interactionClient interactionservice.Client, recommendClient recommendservice.Client, socialClient socialservice.Client) *VideoServiceImpl {
 	return &VideoServiceImpl{
-		videoService: videoService,
+		videoService:      videoService,
+		interactionClient: interactionClient,
+		recommendClient:   recommendClient,
+		socialClient:      socialClient,
 	}
 }
 
@@ -28,7 +54,32 @@ func (s *VideoServiceImpl) PublishVideo(ctx context.Context, req *video.PublishV
 		},
 	}
 
-	videoID, err := s.videoService.PublishVideo(ctx, req.UserId, req.Title, req.VideoUrl, req.CoverUrl, req.Description)
+	if err := socialrpc.ValidateAudienceList(ctx, s.socialClient, req.UserId, req.GetAudienceListId()); err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	//二次创作需要原视频对发布者可见，且作者允许二次创作
+	if req.GetRemixOfVideoId() > 0 {
+		source, err := s.videoService.GetRemixSource(ctx, req.UserId, req.GetRemixOfVideoId())
+		if err != nil {
+			errorMsg := err.Error()
+			resp.BaseResp.StatusCode = 1
+			resp.BaseResp.Msg = &errorMsg
+			return resp, nil
+		}
+		if !socialrpc.CanViewContent(ctx, s.socialClient, req.UserId, source.AuthorID) ||
+			!socialrpc.CanViewAudience(ctx, s.socialClient, req.UserId, source.AuthorID, source.AudienceListID) {
+			errorMsg := service.ErrVideoNotFound.Error()
+			resp.BaseResp.StatusCode = 1
+			resp.BaseResp.Msg = &errorMsg
+			return resp, nil
+		}
+	}
+
+	videoID, err := s.videoService.PublishVideo(ctx, req.UserId, req.Title, req.VideoUrl, req.CoverUrl, req.Description, req.GetAudienceListId(), req.GetRemixOfVideoId())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -52,7 +103,14 @@ func (s *VideoServiceImpl) GetUserVideoList(ctx context.Context, req *video.User
 		TotalCount: 0,
 	}
 
-	videos, total, err := s.videoService.GetUserVideos(ctx, req.UserId, req.CurrentUserId, int(req.Page), int(req.PageSize))
+	if !socialrpc.CanViewContent(ctx, s.socialClient, req.CurrentUserId, req.UserId) {
+		errorMsg := errPrivateAccount
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	videos, nextCursor, total, err := s.videoService.GetUserVideos(ctx, req.UserId, req.CurrentUserId, req.GetCursor(), int(req.PageSize), req.GetNeedTotal())
 	if err != nil {
 		errorMsg := err.Error()
 		resp.BaseResp.StatusCode = 1
@@ -63,20 +121,30 @@ func (s *VideoServiceImpl) GetUserVideoList(ctx context.Context, req *video.User
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
 	resp.Videos = commonVideos
+	if req.CurrentUserId != req.UserId {
+		resp.Videos = recommendrpc.FilterHiddenVideos(ctx, s.recommendClient, req.CurrentUserId, commonVideos)
+		resp.Videos = socialrpc.FilterAudienceVideos(ctx, s.socialClient, req.CurrentUserId, resp.Videos)
+	}
 	resp.TotalCount = int32(total)
+	resp.HasMore = nextCursor != ""
+	if resp.HasMore {
+		resp.NextCursor = &nextCursor
+	}
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.CurrentUserId, resp.Videos)
 	return resp, nil
 }
 
@@ -103,20 +171,23 @@ func (s *VideoServiceImpl) GetFeed(ctx context.Context, req *video.FeedReq) (res
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
-	resp.Videos = commonVideos
+	resp.Videos = recommendrpc.FilterHiddenVideos(ctx, s.recommendClient, req.UserId, commonVideos)
+	resp.Videos = socialrpc.FilterAudienceVideos(ctx, s.socialClient, req.UserId, resp.Videos)
 	resp.NextTime = nextTime
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, resp.Videos)
 	return resp, nil
 }
 
@@ -143,20 +214,23 @@ func (s *VideoServiceImpl) SearchVideo(ctx context.Context, req *video.SearchVid
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
-	resp.Videos = commonVideos
+	resp.Videos = recommendrpc.FilterHiddenVideos(ctx, s.recommendClient, req.CurrentUserId, commonVideos)
+	resp.Videos = socialrpc.FilterAudienceVideos(ctx, s.socialClient, req.CurrentUserId, resp.Videos)
 	resp.TotalCount = int32(total)
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.CurrentUserId, resp.Videos)
 	return resp, nil
 }
 
@@ -178,18 +252,39 @@ func (s *VideoServiceImpl) GetVideoDetail(ctx context.Context, req *video.VideoD
 		return resp, nil
 	}
 
-	resp.Video = &common.Video{
-		Id:           v.ID,
-		AuthorId:     v.AuthorID,
-		Url:          v.URL,
-		CoverUrl:     v.CoverURL,
-		Title:        v.Title,
-		Description:  v.Description,
-		LikeCount:    v.LikeCount,
-		CommentCount: v.CommentCount,
-		PublishTime:  v.PublishTime,
+	if !socialrpc.CanViewContent(ctx, s.socialClient, req.CurrentUserId, v.AuthorID) {
+		errorMsg := errPrivateAccount
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
 	}
 
+	if !socialrpc.CanViewAudience(ctx, s.socialClient, req.CurrentUserId, v.AuthorID, v.AudienceListID) {
+		errorMsg := errAudienceRestricted
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	resp.Video = &common.Video{
+		Id:             v.ID,
+		AuthorId:       v.AuthorID,
+		Url:            v.URL,
+		CoverUrl:       v.CoverURL,
+		Title:          v.Title,
+		Description:    v.Description,
+		LikeCount:      v.LikeCount,
+		CommentCount:   v.CommentCount,
+		PublishTime:    v.PublishTime,
+		AudienceListId: audienceListID(v.AudienceListID),
+	}
+	allowRemix := s.videoService.AllowRemix(ctx, v.AuthorID)
+	resp.Video.AllowRemix = &allowRemix
+	if v.RemixOfID > 0 {
+		resp.Video.RemixOfVideoId = &v.RemixOfID
+	}
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.CurrentUserId, []*common.Video{resp.Video})
+
 	return resp, nil
 }
 
@@ -214,18 +309,25 @@ func (s *VideoServiceImpl) BatchGetVideoInfo(ctx context.Context, req *video.Bat
 
 	for id, v := range videos {
 		resp.Videos[id] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
+	commonVideos := make([]*common.Video, 0, len(resp.Videos))
+	for _, v := range resp.Videos {
+		commonVideos = append(commonVideos, v)
+	}
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.CurrentUserId, commonVideos)
+
 	return resp, nil
 }
 
@@ -260,6 +362,25 @@ func (s *VideoServiceImpl) UpdateVideoInfo(ctx context.Context, req *video.Updat
 		},
 	}
 
+	if req.AudienceListId != nil {
+		if err := socialrpc.ValidateAudienceList(ctx, s.socialClient, req.UserId, *req.AudienceListId); err != nil {
+			errorMsg := err.Error()
+			resp.BaseResp.StatusCode = 1
+			resp.BaseResp.Msg = &errorMsg
+			return resp, nil
+		}
+		if err := s.videoService.UpdateVideoAudience(ctx, req.VideoId, req.UserId, *req.AudienceListId); err != nil {
+			errorMsg := err.Error()
+			resp.BaseResp.StatusCode = 1
+			resp.BaseResp.Msg = &errorMsg
+			return resp, nil
+		}
+		// 只修改可见范围时不覆盖标题和描述
+		if req.Title == nil && req.Description == nil {
+			return resp, nil
+		}
+	}
+
 	title := ""
 	if req.Title != nil {
 		title = *req.Title
@@ -332,19 +453,22 @@ func (s *VideoServiceImpl) GetHotVideos(ctx context.Context, req *video.HotVideo
 	commonVideos := make([]*common.Video, len(videos))
 	for i, v := range videos {
 		commonVideos[i] = &common.Video{
-			Id:           v.ID,
-			AuthorId:     v.AuthorID,
-			Url:          v.URL,
-			CoverUrl:     v.CoverURL,
-			Title:        v.Title,
-			Description:  v.Description,
-			LikeCount:    v.LikeCount,
-			CommentCount: v.CommentCount,
-			PublishTime:  v.PublishTime,
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
 		}
 	}
 
-	resp.Videos = commonVideos
+	resp.Videos = recommendrpc.FilterHiddenVideos(ctx, s.recommendClient, req.UserId, commonVideos)
+	resp.Videos = socialrpc.FilterAudienceVideos(ctx, s.socialClient, req.UserId, resp.Videos)
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, resp.Videos)
 	return resp, nil
 }
 
@@ -416,3 +540,171 @@ func (s *VideoServiceImpl) GetTotalVideoCount(ctx context.Context, req *video.Ge
 	resp.Count = count
 	return resp, nil
 }
+
+// ReportWatchProgress implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ReportWatchProgress(ctx context.Context, req *video.ReportWatchProgressReq) (resp *video.ReportWatchProgressResp, err error) {
+	successMsg := "成功"
+	resp = &video.ReportWatchProgressResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.videoService.ReportWatchProgress(ctx, req.UserId, req.VideoId, req.Position, req.Duration, req.GetFinished())
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// GetWatchHistory implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) GetWatchHistory(ctx context.Context, req *video.WatchHistoryReq) (resp *video.WatchHistoryResp, err error) {
+	successMsg := "成功"
+	resp = &video.WatchHistoryResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+		Items: []*video.WatchHistoryItem{},
+	}
+
+	histories, videos, nextCursor, hasMore, err := s.videoService.GetWatchHistory(ctx, req.UserId, req.GetCursor(), int(req.PageSize))
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	commonVideos := make([]*common.Video, 0, len(histories))
+	for _, h := range histories {
+		v := videos[h.VideoID]
+		commonVideo := &common.Video{
+			Id:             v.ID,
+			AuthorId:       v.AuthorID,
+			Url:            v.URL,
+			CoverUrl:       v.CoverURL,
+			Title:          v.Title,
+			Description:    v.Description,
+			LikeCount:      v.LikeCount,
+			CommentCount:   v.CommentCount,
+			PublishTime:    v.PublishTime,
+			AudienceListId: audienceListID(v.AudienceListID),
+		}
+		commonVideos = append(commonVideos, commonVideo)
+		resp.Items = append(resp.Items, &video.WatchHistoryItem{
+			Video:     commonVideo,
+			Position:  h.Position,
+			Duration:  h.Duration,
+			Progress:  h.Progress,
+			WatchedAt: h.WatchedAt,
+		})
+	}
+	rpcclient.FillVideoStatus(ctx, s.interactionClient, req.UserId, commonVideos)
+
+	paused, err := s.videoService.IsWatchHistoryPaused(ctx, req.UserId)
+	if err == nil {
+		resp.Paused = paused
+	}
+
+	resp.NextCursor = nextCursor
+	resp.HasMore = hasMore
+	return resp, nil
+}
+
+// GetWatchProgress implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) GetWatchProgress(ctx context.Context, req *video.WatchProgressReq) (resp *video.WatchProgressResp, err error) {
+	successMsg := "成功"
+	resp = &video.WatchProgressResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	history, err := s.videoService.GetWatchProgress(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	if history != nil {
+		resp.Exists = true
+		resp.Position = history.Position
+		resp.Duration = history.Duration
+		resp.Progress = history.Progress
+		resp.ResumePosition = history.ResumePosition()
+		resp.WatchedAt = history.WatchedAt
+	}
+	return resp, nil
+}
+
+// DeleteWatchHistory implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) DeleteWatchHistory(ctx context.Context, req *video.DeleteWatchHistoryReq) (resp *video.DeleteWatchHistoryResp, err error) {
+	successMsg := "成功"
+	resp = &video.DeleteWatchHistoryResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.videoService.DeleteWatchHistory(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// ClearWatchHistory implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ClearWatchHistory(ctx context.Context, req *video.ClearWatchHistoryReq) (resp *video.ClearWatchHistoryResp, err error) {
+	successMsg := "成功"
+	resp = &video.ClearWatchHistoryResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.videoService.ClearWatchHistory(ctx, req.UserId)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
+
+// SetWatchHistoryPaused implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) SetWatchHistoryPaused(ctx context.Context, req *video.SetWatchHistoryPausedReq) (resp *video.SetWatchHistoryPausedResp, err error) {
+	successMsg := "成功"
+	resp = &video.SetWatchHistoryPausedResp{
+		BaseResp: &common.BaseResp{
+			StatusCode: 0,
+			Msg:        &successMsg,
+		},
+	}
+
+	err = s.videoService.SetWatchHistoryPaused(ctx, req.UserId, req.Paused)
+	if err != nil {
+		errorMsg := err.Error()
+		resp.BaseResp.StatusCode = 1
+		resp.BaseResp.Msg = &errorMsg
+		return resp, nil
+	}
+
+	return resp, nil
+}
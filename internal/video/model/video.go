@@ -5,19 +5,21 @@ import (
 )
 
 type Video struct {
-	ID           int64     `gorm:"primaryKey;autoIncrement;comment:视频ID"`
-	AuthorID     int64     `gorm:"index;not null;comment:作者ID"`
-	URL          string    `gorm:"type:varchar(500);not null;comment:视频地址"`
-	CoverURL     string    `gorm:"type:varchar(500);comment:封面地址"`
-	LikeCount    int64     `gorm:"default:0;comment:点赞数"`
-	CommentCount int64     `gorm:"default:0;comment:评论数"`
-	ViewCount    int64     `gorm:"default:0;comment:观看数"`
-	ShareCount   int64     `gorm:"default:0;comment:分享数"`
-	Title        string    `gorm:"size:200;not null;comment:标题"`
-	PublishTime  int64     `gorm:"index;not null;comment:发布时间戳"`
-	Description  string    `gorm:"type:text;comment:描述"`
-	CreatedAt    time.Time `gorm:"autoCreateTime;comment:创建时间"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+	ID             int64     `gorm:"primaryKey;autoIncrement;comment:视频ID"`
+	AuthorID       int64     `gorm:"index;not null;comment:作者ID"`
+	URL            string    `gorm:"type:varchar(500);not null;comment:视频地址"`
+	CoverURL       string    `gorm:"type:varchar(500);comment:封面地址"`
+	LikeCount      int64     `gorm:"default:0;comment:点赞数"`
+	CommentCount   int64     `gorm:"default:0;comment:评论数"`
+	ViewCount      int64     `gorm:"default:0;comment:观看数"`
+	ShareCount     int64     `gorm:"default:0;comment:分享数"`
+	Title          string    `gorm:"size:200;not null;comment:标题"`
+	PublishTime    int64     `gorm:"index;not null;comment:发布时间戳"`
+	Description    string    `gorm:"type:text;comment:描述"`
+	AudienceListID int64     `gorm:"index;default:0;comment:可见范围名单ID(0为公开)"`
+	RemixOfID      int64     `gorm:"index;default:0;comment:二次创作的原视频ID(0为原创)"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime;comment:更新时间"`
 }
 
 func (Video) TableName() string {
@@ -31,3 +33,39 @@ type VideoStats struct {
 	CommentCount int64 `json:"comment_count"`
 	ShareCount   int64 `json:"share_count"`
 }
+
+// 观看历史，Redis中保存最近记录，定期归档到数据库
+type WatchHistory struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	UserID    int64     `gorm:"uniqueIndex:idx_watch_history_user_video;index:idx_watch_history_user_time,priority:1;not null;comment:用户ID"`
+	VideoID   int64     `gorm:"uniqueIndex:idx_watch_history_user_video;not null;comment:视频ID"`
+	Position  int32     `gorm:"default:0;comment:上次播放位置(秒)"`
+	Duration  int32     `gorm:"default:0;comment:视频时长(秒)"`
+	Progress  float64   `gorm:"default:0;comment:播放进度百分比"`
+	WatchedAt int64     `gorm:"index:idx_watch_history_user_time,priority:2;not null;comment:最后观看时间(毫秒)"`
+	CreatedAt time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (WatchHistory) TableName() string {
+	return "watch_histories"
+}
+
+// 观看历史设置
+type WatchHistorySetting struct {
+	UserID    int64     `gorm:"primaryKey;comment:用户ID"`
+	Paused    bool      `gorm:"default:false;comment:是否暂停记录观看历史"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (WatchHistorySetting) TableName() string {
+	return "watch_history_settings"
+}
+
+// 续播位置，已基本看完的视频从头播放
+func (h *WatchHistory) ResumePosition() int32 {
+	if h.Progress >= 95 {
+		return 0
+	}
+	return h.Position
+}
@@ -6,13 +6,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	socialDao "shortvideo/internal/social/dao"
+	"shortvideo/internal/user/settings"
 	"shortvideo/internal/video/dao"
 	"shortvideo/internal/video/model"
 	"shortvideo/pkg/cache"
 	"shortvideo/pkg/es"
 	"shortvideo/pkg/logger"
 	"shortvideo/pkg/mq"
+	"shortvideo/pkg/pagination"
+	"shortvideo/pkg/rbac"
 	"shortvideo/pkg/storage"
+	"sort"
+	"strconv"
 	"time"
 )
 
@@ -24,11 +30,25 @@ var (
 	ErrVideoUploadFailed = errors.New("视频上传失败")
 	ErrCoverUploadFailed = errors.New("封面上传失败")
 	ErrInvalidFile       = errors.New("无效的文件")
+	ErrInvalidProgress   = errors.New("无效的播放进度")
+	ErrHistoryNotFound   = errors.New("观看记录不存在")
+	ErrRemixNotAllowed   = errors.New("作者不允许二次创作该视频")
+)
+
+const (
+	//Redis中每个用户保留的观看记录数量
+	watchHistoryCacheLimit = 500
+	//观看历史缓存过期时间
+	watchHistoryCacheTTL = 30 * 24 * time.Hour
+	//同一视频两次归档到数据库的最小间隔
+	watchHistoryArchiveInterval = 30 * time.Second
+	//注销清理时每批删除的视频数量
+	purgeBatchSize = 100
 )
 
 type VideoService interface {
 	//视频发布
-	PublishVideo(ctx context.Context, userID int64, title, videoURL, coverURL, description string) (int64, error)
+	PublishVideo(ctx context.Context, userID int64, title, videoURL, coverURL, description string, audienceListID, remixOfID int64) (int64, error)
 
 	//视频上传
 	UploadVideo(ctx context.Context, userID int64, videoData []byte, coverData []byte, title, description string) (string, string, error)
@@ -37,7 +57,7 @@ type VideoService interface {
 	GetVideoByID(ctx context.Context, videoID, currentUserID int64) (*model.Video, error)
 
 	//用户视频列表
-	GetUserVideos(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*model.Video, int64, error)
+	GetUserVideos(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*model.Video, string, int64, error)
 
 	//视频流
 	GetFeedVideos(ctx context.Context, currentUserID int64, latestTime int64, pageSize int) ([]*model.Video, int64, error)
@@ -53,6 +73,7 @@ type VideoService interface {
 
 	//更新视频信息
 	UpdateVideo(ctx context.Context, videoID, userID int64, title, description string) error
+	UpdateVideoAudience(ctx context.Context, videoID, userID, audienceListID int64) error
 
 	//视频统计
 	GetVideoStats(ctx context.Context, videoID int64) (*model.VideoStats, error)
@@ -68,21 +89,44 @@ type VideoService interface {
 	CountVideosByUserID(ctx context.Context, userID int64) (int64, error)
 	GetTotalVideoCount(ctx context.Context) (int64, error)
 
+	//观看历史
+	ReportWatchProgress(ctx context.Context, userID, videoID int64, position, duration int32, finished bool) error
+	GetWatchHistory(ctx context.Context, userID int64, cursor int64, pageSize int) ([]*model.WatchHistory, map[int64]*model.Video, int64, bool, error)
+	GetWatchProgress(ctx context.Context, userID, videoID int64) (*model.WatchHistory, error)
+	DeleteWatchHistory(ctx context.Context, userID, videoID int64) error
+	ClearWatchHistory(ctx context.Context, userID int64) error
+	SetWatchHistoryPaused(ctx context.Context, userID int64, paused bool) error
+	IsWatchHistoryPaused(ctx context.Context, userID int64) (bool, error)
+
+	//作者是否允许他人二次创作其视频
+	AllowRemix(ctx context.Context, authorID int64) bool
+	//获取二次创作的原视频，作者不允许时返回ErrRemixNotAllowed
+	GetRemixSource(ctx context.Context, userID, videoID int64) (*model.Video, error)
+
+	//账号注销后清理用户数据
+	PurgeUserData(ctx context.Context, userID int64) error
+
 	//事务相关
 	WithTransaction(ctx context.Context, fn func(txService VideoService) error) error
 }
 
 type videoServiceImpl struct {
 	repo          dao.VideoRepository
+	historyRepo   dao.WatchHistoryRepository
+	blockRepo     socialDao.BlockRepository
+	settings      settings.Client
 	storage       storage.Storage
 	kafkaProducer *mq.Producer
 	cache         cache.Cache
 	es            *es.ESManager
 }
 
-func NewVideoService(repo dao.VideoRepository, storage storage.Storage, kafkaProducer *mq.Producer, cache cache.Cache, es *es.ESManager) VideoService {
+func NewVideoService(repo dao.VideoRepository, historyRepo dao.WatchHistoryRepository, blockRepo socialDao.BlockRepository, settings settings.Client, storage storage.Storage, kafkaProducer *mq.Producer, cache cache.Cache, es *es.ESManager) VideoService {
 	return &videoServiceImpl{
 		repo:          repo,
+		historyRepo:   historyRepo,
+		blockRepo:     blockRepo,
+		settings:      settings,
 		storage:       storage,
 		kafkaProducer: kafkaProducer,
 		cache:         cache,
@@ -172,6 +216,7 @@ func (s *videoServiceImpl) UploadVideo(ctx context.Context, userID int64, videoD
 			logger.Int64Field("video_id", video.ID),
 			logger.Int64Field("user_id", userID))
 	}
+	s.publishActivity(ctx, userID, mq.ActivityPublish, video.ID)
 
 	//将视频信息同步到Elasticsearch
 	if s.es != nil {
@@ -353,6 +398,48 @@ func (s *videoServiceImpl) UpdateVideo(ctx context.Context, videoID int64, userI
 	return nil
 }
 
+// 设置视频的可见范围，audienceListID为0表示公开，名单归属由调用方通过社交服务校验
+func (s *videoServiceImpl) UpdateVideoAudience(ctx context.Context, videoID, userID, audienceListID int64) error {
+	logger.Info("更新视频可见范围请求",
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("audience_list_id", audienceListID))
+
+	video, err := s.repo.FindByID(ctx, videoID)
+	if err != nil {
+		logger.Error("查询视频失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return ErrInternalServer
+	}
+	if video == nil {
+		return ErrVideoNotFound
+	}
+	if video.AuthorID != userID {
+		logger.Warn("不是视频所有者",
+			logger.Int64Field("video_id", videoID),
+			logger.Int64Field("user_id", userID))
+		return ErrNotVideoOwner
+	}
+
+	video.AudienceListID = audienceListID
+	if err := s.repo.Update(ctx, video); err != nil {
+		logger.Error("更新视频可见范围失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return ErrInternalServer
+	}
+
+	if s.cache != nil {
+		s.cache.Delete(ctx, fmt.Sprintf("video:%d", videoID))
+	}
+
+	logger.Info("更新视频可见范围成功",
+		logger.Int64Field("video_id", videoID),
+		logger.Int64Field("audience_list_id", audienceListID))
+	return nil
+}
+
 // 删除视频
 func (s *videoServiceImpl) DeleteVideo(ctx context.Context, videoID int64, userID int64) error {
 	logger.Info("删除视频请求",
@@ -373,7 +460,8 @@ func (s *videoServiceImpl) DeleteVideo(ctx context.Context, videoID int64, userI
 			logger.Int64Field("user_id", userID))
 		return ErrVideoNotFound
 	}
-	if video.AuthorID != userID {
+	//版主和管理员可以删除任何视频
+	if video.AuthorID != userID && !rbac.Allowed(ctx, rbac.PermVideoDeleteAny) {
 		logger.Warn("不是视频所有者",
 			logger.Int64Field("video_id", videoID),
 			logger.Int64Field("user_id", userID),
@@ -381,7 +469,7 @@ func (s *videoServiceImpl) DeleteVideo(ctx context.Context, videoID int64, userI
 		return ErrNotVideoOwner
 	}
 
-	err = s.repo.Delete(ctx, videoID, userID)
+	err = s.repo.Delete(ctx, videoID, video.AuthorID)
 	if err != nil {
 		logger.Error("删除视频失败",
 			logger.ErrorField(err),
@@ -400,7 +488,8 @@ func (s *videoServiceImpl) DeleteVideo(ctx context.Context, videoID int64, userI
 	if s.kafkaProducer != nil {
 		eventData, _ := json.Marshal(map[string]interface{}{
 			"video_id":    videoID,
-			"user_id":     userID,
+			"user_id":     video.AuthorID,
+			"deleted_by":  userID,
 			"video_title": video.Title,
 			"deleted_at":  time.Now(),
 		})
@@ -429,28 +518,62 @@ func (s *videoServiceImpl) DeleteVideo(ctx context.Context, videoID int64, userI
 }
 
 // 获取用户视频列表
-func (s *videoServiceImpl) GetUserVideos(ctx context.Context, userID, currentUserID int64, page, pageSize int) ([]*model.Video, int64, error) {
+func (s *videoServiceImpl) GetUserVideos(ctx context.Context, userID, currentUserID int64, cursor string, pageSize int, needTotal bool) ([]*model.Video, string, int64, error) {
 	logger.Info("获取用户视频列表请求",
 		logger.Int64Field("user_id", userID),
 		logger.Int64Field("current_user_id", currentUserID),
-		logger.IntField("page", page),
+		logger.StringField("cursor", cursor),
 		logger.IntField("page_size", pageSize))
 
-	videos, total, err := s.repo.ListByAuthorID(ctx, userID, page, pageSize)
+	pageScope := pagination.Scope{List: "user_videos", Owner: userID}
+	pageCursor, err := pagination.Decode(cursor, pageScope)
+	if err != nil {
+		logger.Warn("分页游标无效", logger.StringField("cursor", cursor))
+		return nil, "", 0, err
+	}
+	pageSize = pagination.NormalizePageSize(pageSize)
+
+	//作者对当前用户隐藏时整个列表为空，总数也为0
+	if currentUserID != userID {
+		for _, hiddenID := range s.hiddenAuthorIDs(ctx, currentUserID) {
+			if hiddenID == userID {
+				return []*model.Video{}, "", 0, nil
+			}
+		}
+	}
+
+	videos, err := s.repo.ListByAuthorID(ctx, userID, pageCursor, pageSize+1)
 	if err != nil {
 		logger.Error("查询用户视频失败",
 			logger.ErrorField(err),
 			logger.Int64Field("user_id", userID),
 			logger.Int64Field("current_user_id", currentUserID))
-		return nil, 0, ErrInternalServer
+		return nil, "", 0, ErrInternalServer
+	}
+
+	videos, nextCursor := pagination.Paginate(videos, pageSize, pageScope, func(v *model.Video) pagination.Cursor {
+		return pagination.Cursor{SortKey: v.PublishTime, ID: v.ID}
+	})
+
+	var total int64
+	if needTotal {
+		total, err = pagination.CachedTotal(ctx, s.cache, pagination.TotalKey("user_videos", userID), func() (int64, error) {
+			return s.repo.CountByAuthorID(ctx, userID)
+		})
+		if err != nil {
+			logger.Error("统计用户视频数失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, "", 0, ErrInternalServer
+		}
 	}
 
 	logger.Info("获取用户视频列表成功",
 		logger.Int64Field("user_id", userID),
 		logger.IntField("video_count", len(videos)),
-		logger.Int64Field("total_count", total))
+		logger.BoolField("has_more", nextCursor != ""))
 
-	return videos, total, nil
+	return videos, nextCursor, total, nil
 }
 
 // 获取视频流
@@ -460,7 +583,7 @@ func (s *videoServiceImpl) GetFeedVideos(ctx context.Context, currentUserID int6
 		logger.Int64Field("latest_time", latestTime),
 		logger.IntField("page_size", pageSize))
 
-	videos, err := s.repo.ListFeedVideos(ctx, latestTime, pageSize)
+	videos, err := s.repo.ListFeedVideos(ctx, latestTime, pageSize, s.hiddenAuthorIDs(ctx, currentUserID))
 	if err != nil {
 		logger.Error("查询视频流失败",
 			logger.ErrorField(err),
@@ -489,11 +612,13 @@ func (s *videoServiceImpl) SearchVideos(ctx context.Context, keyword string, cur
 		logger.IntField("page", page),
 		logger.IntField("page_size", pageSize))
 
+	hiddenIDs := s.hiddenAuthorIDs(ctx, currentUserID)
+
 	//优先使用Elasticsearch进行搜索
 	if s.es != nil {
-		//构建搜索查询
-		query := es.SearchQuery{
-			Query: map[string]interface{}{
+		//构建搜索查询，隐藏的作者在查询中排除，保证总数准确
+		boolQuery := map[string]interface{}{
+			"must": map[string]interface{}{
 				"multi_match": map[string]interface{}{
 					"query":    keyword,
 					"fields":   []string{"title", "description"},
@@ -501,6 +626,18 @@ func (s *videoServiceImpl) SearchVideos(ctx context.Context, keyword string, cur
 					"operator": "and",
 				},
 			},
+		}
+		if len(hiddenIDs) > 0 {
+			boolQuery["must_not"] = map[string]interface{}{
+				"terms": map[string]interface{}{
+					"user_id": hiddenIDs,
+				},
+			}
+		}
+		query := es.SearchQuery{
+			Query: map[string]interface{}{
+				"bool": boolQuery,
+			},
 			From: (page - 1) * pageSize,
 			Size: pageSize,
 			Sort: []map[string]interface{}{
@@ -578,7 +715,7 @@ func (s *videoServiceImpl) SearchVideos(ctx context.Context, keyword string, cur
 	}
 
 	//如果ES搜索失败，回退到数据库搜索
-	videos, total, err := s.repo.Search(ctx, keyword, page, pageSize)
+	videos, total, err := s.repo.Search(ctx, keyword, page, pageSize, hiddenIDs)
 	if err != nil {
 		logger.Error("搜索视频失败",
 			logger.ErrorField(err),
@@ -781,7 +918,7 @@ func (s *videoServiceImpl) WithTransaction(ctx context.Context, fn func(txServic
 }
 
 // 发布视频
-func (s *videoServiceImpl) PublishVideo(ctx context.Context, userID int64, title, videoURL, coverURL, description string) (int64, error) {
+func (s *videoServiceImpl) PublishVideo(ctx context.Context, userID int64, title, videoURL, coverURL, description string, audienceListID, remixOfID int64) (int64, error) {
 	logger.Info("发布视频请求",
 		logger.Int64Field("user_id", userID),
 		logger.StringField("title", title))
@@ -793,14 +930,16 @@ func (s *videoServiceImpl) PublishVideo(ctx context.Context, userID int64, title
 	}
 
 	video := &model.Video{
-		AuthorID:     userID,
-		Title:        title,
-		URL:          videoURL,
-		CoverURL:     coverURL,
-		Description:  description,
-		PublishTime:  time.Now().Unix(),
-		LikeCount:    0,
-		CommentCount: 0,
+		AuthorID:       userID,
+		Title:          title,
+		URL:            videoURL,
+		CoverURL:       coverURL,
+		Description:    description,
+		AudienceListID: audienceListID,
+		RemixOfID:      remixOfID,
+		PublishTime:    time.Now().Unix(),
+		LikeCount:      0,
+		CommentCount:   0,
 	}
 
 	if err := s.repo.Create(ctx, video); err != nil {
@@ -825,6 +964,7 @@ func (s *videoServiceImpl) PublishVideo(ctx context.Context, userID int64, title
 			logger.Int64Field("video_id", video.ID),
 			logger.Int64Field("user_id", userID))
 	}
+	s.publishActivity(ctx, userID, mq.ActivityPublish, video.ID)
 
 	logger.Info("视频发布成功",
 		logger.Int64Field("video_id", video.ID),
@@ -889,7 +1029,7 @@ func (s *videoServiceImpl) GetHotVideos(ctx context.Context, currentUserID int64
 		logger.Int64Field("current_user_id", currentUserID),
 		logger.IntField("page_size", pageSize))
 
-	videos, err := s.repo.ListFeedVideos(ctx, 0, pageSize)
+	videos, err := s.repo.ListFeedVideos(ctx, 0, pageSize, s.hiddenAuthorIDs(ctx, currentUserID))
 	if err != nil {
 		logger.Error("查询热门视频失败",
 			logger.ErrorField(err),
@@ -940,3 +1080,563 @@ func (s *videoServiceImpl) GetTotalVideoCount(ctx context.Context) (int64, error
 
 	return count, nil
 }
+
+// 观看进度缓存，archived_at记录最近一次归档到数据库的时间
+type watchProgressCache struct {
+	Position   int32   `json:"position"`
+	Duration   int32   `json:"duration"`
+	Progress   float64 `json:"progress"`
+	WatchedAt  int64   `json:"watched_at"`
+	ArchivedAt int64   `json:"archived_at"`
+}
+
+func (c *watchProgressCache) toHistory(userID, videoID int64) *model.WatchHistory {
+	return &model.WatchHistory{
+		UserID:    userID,
+		VideoID:   videoID,
+		Position:  c.Position,
+		Duration:  c.Duration,
+		Progress:  c.Progress,
+		WatchedAt: c.WatchedAt,
+	}
+}
+
+// 上报播放心跳，记录观看位置和进度
+func (s *videoServiceImpl) ReportWatchProgress(ctx context.Context, userID, videoID int64, position, duration int32, finished bool) error {
+	if userID <= 0 || videoID <= 0 || position < 0 || duration < 0 {
+		return ErrInvalidProgress
+	}
+	if duration > 0 && position > duration {
+		position = duration
+	}
+
+	//看完视频获得经验值，暂停观看记录不影响
+	if finished {
+		s.publishActivity(ctx, userID, mq.ActivityWatch, videoID)
+	}
+
+	paused, err := s.IsWatchHistoryPaused(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	progress := 0.0
+	if duration > 0 {
+		progress = float64(position) * 100 / float64(duration)
+	}
+	if finished {
+		progress = 100
+	}
+
+	now := time.Now()
+	entry := &watchProgressCache{
+		Position:  position,
+		Duration:  duration,
+		Progress:  progress,
+		WatchedAt: now.UnixMilli(),
+	}
+
+	var cached *watchProgressCache
+	field := fmt.Sprintf("%d", videoID)
+	if s.cache != nil {
+		data, err := s.cache.HGet(ctx, cache.GenerateWatchProgressKey(userID), field)
+		if err == nil && data != "" {
+			var c watchProgressCache
+			if err := json.Unmarshal([]byte(data), &c); err == nil {
+				cached = &c
+			}
+		}
+	}
+
+	//首次上报时校验视频是否存在
+	if cached == nil {
+		video, err := s.repo.FindByID(ctx, videoID)
+		if err != nil {
+			logger.Error("查询视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("video_id", videoID))
+			return ErrInternalServer
+		}
+		if video == nil {
+			return ErrVideoNotFound
+		}
+	} else {
+		entry.ArchivedAt = cached.ArchivedAt
+	}
+
+	//到达归档间隔、看完或缓存不可用时写入数据库
+	if s.cache == nil || finished || now.UnixMilli()-entry.ArchivedAt >= watchHistoryArchiveInterval.Milliseconds() {
+		if err := s.historyRepo.Upsert(ctx, entry.toHistory(userID, videoID)); err != nil {
+			logger.Error("归档观看记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+			if s.cache == nil {
+				return ErrInternalServer
+			}
+		} else {
+			entry.ArchivedAt = now.UnixMilli()
+		}
+	}
+
+	if s.cache != nil {
+		if err := s.cacheWatchProgress(ctx, userID, videoID, entry); err != nil {
+			logger.Warn("缓存观看进度失败，直接写入数据库",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID),
+				logger.Int64Field("video_id", videoID))
+			if entry.ArchivedAt != now.UnixMilli() {
+				if err := s.historyRepo.Upsert(ctx, entry.toHistory(userID, videoID)); err != nil {
+					logger.Error("归档观看记录失败",
+						logger.ErrorField(err),
+						logger.Int64Field("user_id", userID),
+						logger.Int64Field("video_id", videoID))
+					return ErrInternalServer
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// 发送可获得经验值的用户行为事件，发送失败只记录日志
+func (s *videoServiceImpl) publishActivity(ctx context.Context, userID int64, source string, videoID int64) {
+	if s.kafkaProducer == nil {
+		return
+	}
+	if err := s.kafkaProducer.SendUserActivityEvent(ctx, userID, source, fmt.Sprintf("%d", videoID)); err != nil {
+		logger.Warn("发送用户行为事件失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.StringField("source", source))
+	}
+}
+
+// 写入观看进度缓存，超出数量上限时归档并淘汰最早的记录
+func (s *videoServiceImpl) cacheWatchProgress(ctx context.Context, userID, videoID int64, entry *watchProgressCache) error {
+	historyKey := cache.GenerateWatchHistoryKey(userID)
+	progressKey := cache.GenerateWatchProgressKey(userID)
+	field := fmt.Sprintf("%d", videoID)
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := s.cache.HSet(ctx, progressKey, field, string(data)); err != nil {
+		return err
+	}
+	if err := s.cache.ZAdd(ctx, historyKey, float64(entry.WatchedAt), field); err != nil {
+		return err
+	}
+	s.cache.Expire(ctx, historyKey, watchHistoryCacheTTL)
+	s.cache.Expire(ctx, progressKey, watchHistoryCacheTTL)
+
+	count, err := s.cache.ZCard(ctx, historyKey)
+	if err != nil || count <= watchHistoryCacheLimit {
+		return nil
+	}
+
+	evicted, err := s.cache.ZRange(ctx, historyKey, 0, count-watchHistoryCacheLimit-1)
+	if err != nil || len(evicted) == 0 {
+		return nil
+	}
+
+	//淘汰前把尚未归档的进度写入数据库
+	entries, err := s.cache.HMGet(ctx, progressKey, evicted)
+	if err == nil {
+		pending := make([]*model.WatchHistory, 0, len(entries))
+		for member, value := range entries {
+			var c watchProgressCache
+			if json.Unmarshal([]byte(value), &c) != nil || c.ArchivedAt >= c.WatchedAt {
+				continue
+			}
+			id, err := strconv.ParseInt(member, 10, 64)
+			if err != nil {
+				continue
+			}
+			pending = append(pending, c.toHistory(userID, id))
+		}
+		if err := s.historyRepo.BatchUpsert(ctx, pending); err != nil {
+			logger.Error("归档淘汰的观看记录失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil
+		}
+	}
+
+	s.cache.ZRemRangeByRank(ctx, historyKey, 0, count-watchHistoryCacheLimit-1)
+	s.cache.HDel(ctx, progressKey, evicted)
+	return nil
+}
+
+// 获取观看历史，cursor为上一页最后一条的观看时间(毫秒)，0表示从最新开始
+func (s *videoServiceImpl) GetWatchHistory(ctx context.Context, userID int64, cursor int64, pageSize int) ([]*model.WatchHistory, map[int64]*model.Video, int64, bool, error) {
+	logger.Info("获取观看历史请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("cursor", cursor),
+		logger.IntField("page_size", pageSize))
+
+	if userID <= 0 {
+		return nil, nil, 0, false, ErrInvalidProgress
+	}
+	if pageSize <= 0 || pageSize > 50 {
+		pageSize = 20
+	}
+
+	histories, err := s.listWatchHistory(ctx, userID, cursor, pageSize+1)
+	if err != nil {
+		logger.Error("查询观看历史失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return nil, nil, 0, false, ErrInternalServer
+	}
+
+	hasMore := len(histories) > pageSize
+	if hasMore {
+		histories = histories[:pageSize]
+	}
+
+	var nextCursor int64
+	if len(histories) > 0 {
+		nextCursor = histories[len(histories)-1].WatchedAt
+	}
+
+	videoIDs := make([]int64, 0, len(histories))
+	for _, h := range histories {
+		videoIDs = append(videoIDs, h.VideoID)
+	}
+
+	videos := make(map[int64]*model.Video)
+	if len(videoIDs) > 0 {
+		videos, err = s.repo.BatchGetByIDs(ctx, videoIDs)
+		if err != nil {
+			logger.Error("批量查询视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return nil, nil, 0, false, ErrInternalServer
+		}
+	}
+
+	//跳过已删除的视频
+	visible := make([]*model.WatchHistory, 0, len(histories))
+	for _, h := range histories {
+		if _, exists := videos[h.VideoID]; exists {
+			visible = append(visible, h)
+		}
+	}
+
+	logger.Info("获取观看历史成功",
+		logger.Int64Field("user_id", userID),
+		logger.IntField("count", len(visible)),
+		logger.BoolField("has_more", hasMore))
+
+	return visible, videos, nextCursor, hasMore, nil
+}
+
+// 按观看时间倒序读取观看历史：缓存中的记录以缓存为准，缓存之外的更早记录从数据库补齐
+func (s *videoServiceImpl) listWatchHistory(ctx context.Context, userID int64, cursor int64, limit int) ([]*model.WatchHistory, error) {
+	histories := s.listCachedWatchHistory(ctx, userID, cursor, limit)
+	if len(histories) >= limit {
+		return histories, nil
+	}
+
+	progressKey := cache.GenerateWatchProgressKey(userID)
+	before := cursor
+	for {
+		rows, err := s.historyRepo.ListByUserID(ctx, userID, before, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		//过滤掉仍在缓存中的记录，这些记录的数据库副本可能不是最新的
+		var cached map[string]string
+		if s.cache != nil && len(rows) > 0 {
+			fields := make([]string, 0, len(rows))
+			for _, row := range rows {
+				fields = append(fields, fmt.Sprintf("%d", row.VideoID))
+			}
+			cached, _ = s.cache.HMGet(ctx, progressKey, fields)
+		}
+		for _, row := range rows {
+			if _, exists := cached[fmt.Sprintf("%d", row.VideoID)]; !exists {
+				histories = append(histories, row)
+			}
+		}
+
+		if len(rows) < limit || len(histories) >= limit {
+			break
+		}
+		before = rows[len(rows)-1].WatchedAt
+	}
+
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[i].WatchedAt > histories[j].WatchedAt
+	})
+	if len(histories) > limit {
+		histories = histories[:limit]
+	}
+	return histories, nil
+}
+
+// 从缓存读取观看时间早于cursor的记录
+func (s *videoServiceImpl) listCachedWatchHistory(ctx context.Context, userID int64, cursor int64, limit int) []*model.WatchHistory {
+	histories := make([]*model.WatchHistory, 0, limit)
+	if s.cache == nil {
+		return histories
+	}
+
+	max := "+inf"
+	if cursor > 0 {
+		max = fmt.Sprintf("(%d", cursor)
+	}
+
+	members, err := s.cache.ZRevRangeByScore(ctx, cache.GenerateWatchHistoryKey(userID), max, "-inf", int64(limit))
+	if err != nil || len(members) == 0 {
+		return histories
+	}
+
+	entries, err := s.cache.HMGet(ctx, cache.GenerateWatchProgressKey(userID), members)
+	if err != nil {
+		return histories
+	}
+
+	for _, member := range members {
+		var c watchProgressCache
+		value, exists := entries[member]
+		if !exists || json.Unmarshal([]byte(value), &c) != nil {
+			continue
+		}
+		videoID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		histories = append(histories, c.toHistory(userID, videoID))
+	}
+	return histories
+}
+
+// 获取续播进度，没有观看记录时返回nil
+func (s *videoServiceImpl) GetWatchProgress(ctx context.Context, userID, videoID int64) (*model.WatchHistory, error) {
+	if userID <= 0 || videoID <= 0 {
+		return nil, ErrInvalidProgress
+	}
+
+	if s.cache != nil {
+		data, err := s.cache.HGet(ctx, cache.GenerateWatchProgressKey(userID), fmt.Sprintf("%d", videoID))
+		if err == nil && data != "" {
+			var c watchProgressCache
+			if err := json.Unmarshal([]byte(data), &c); err == nil {
+				return c.toHistory(userID, videoID), nil
+			}
+		}
+	}
+
+	history, err := s.historyRepo.FindByUserAndVideo(ctx, userID, videoID)
+	if err != nil {
+		logger.Error("查询观看进度失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
+	}
+	return history, nil
+}
+
+// 删除单条观看记录
+func (s *videoServiceImpl) DeleteWatchHistory(ctx context.Context, userID, videoID int64) error {
+	logger.Info("删除观看记录请求",
+		logger.Int64Field("user_id", userID),
+		logger.Int64Field("video_id", videoID))
+
+	if userID <= 0 || videoID <= 0 {
+		return ErrInvalidProgress
+	}
+
+	if err := s.historyRepo.Delete(ctx, userID, videoID); err != nil {
+		logger.Error("删除观看记录失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return ErrInternalServer
+	}
+
+	if s.cache != nil {
+		field := fmt.Sprintf("%d", videoID)
+		s.cache.ZRem(ctx, cache.GenerateWatchHistoryKey(userID), field)
+		s.cache.HDel(ctx, cache.GenerateWatchProgressKey(userID), []string{field})
+	}
+
+	return nil
+}
+
+// 清空观看历史
+func (s *videoServiceImpl) ClearWatchHistory(ctx context.Context, userID int64) error {
+	logger.Info("清空观看历史请求", logger.Int64Field("user_id", userID))
+
+	if userID <= 0 {
+		return ErrInvalidProgress
+	}
+
+	//先清缓存，避免清空期间的心跳把旧进度再次归档
+	if s.cache != nil {
+		s.cache.MDelete(ctx, []string{
+			cache.GenerateWatchHistoryKey(userID),
+			cache.GenerateWatchProgressKey(userID),
+		})
+	}
+
+	if err := s.historyRepo.DeleteByUserID(ctx, userID); err != nil {
+		logger.Error("清空观看历史失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	return nil
+}
+
+// 暂停或恢复记录观看历史
+func (s *videoServiceImpl) SetWatchHistoryPaused(ctx context.Context, userID int64, paused bool) error {
+	logger.Info("设置观看历史记录状态",
+		logger.Int64Field("user_id", userID),
+		logger.BoolField("paused", paused))
+
+	if userID <= 0 {
+		return ErrInvalidProgress
+	}
+
+	if err := s.historyRepo.SaveSetting(ctx, &model.WatchHistorySetting{UserID: userID, Paused: paused}); err != nil {
+		logger.Error("保存观看历史设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateWatchHistoryPausedKey(userID))
+	}
+
+	return nil
+}
+
+// 是否暂停记录观看历史，结果缓存以减少心跳时的数据库查询
+func (s *videoServiceImpl) IsWatchHistoryPaused(ctx context.Context, userID int64) (bool, error) {
+	pausedKey := cache.GenerateWatchHistoryPausedKey(userID)
+	if s.cache != nil {
+		value, err := s.cache.Get(ctx, pausedKey)
+		if err == nil && value != "" {
+			return value == "1", nil
+		}
+	}
+
+	setting, err := s.historyRepo.GetSetting(ctx, userID)
+	if err != nil {
+		logger.Error("查询观看历史设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return false, ErrInternalServer
+	}
+	paused := setting != nil && setting.Paused
+
+	if s.cache != nil {
+		value := "0"
+		if paused {
+			value = "1"
+		}
+		s.cache.Set(ctx, pausedKey, value, time.Hour)
+	}
+
+	return paused, nil
+}
+
+// 读取作者的二次创作设置，读取失败时按默认设置允许
+func (s *videoServiceImpl) AllowRemix(ctx context.Context, authorID int64) bool {
+	if s.settings == nil {
+		return true
+	}
+
+	authorSettings, err := s.settings.Get(ctx, authorID)
+	if err != nil {
+		return true
+	}
+	return authorSettings.AllowRemix
+}
+
+// 对当前用户隐藏内容的作者：拉黑、静音的用户和拉黑了当前用户的用户，查询失败时不过滤
+func (s *videoServiceImpl) hiddenAuthorIDs(ctx context.Context, currentUserID int64) []int64 {
+	if currentUserID <= 0 || s.blockRepo == nil {
+		return nil
+	}
+
+	hiddenIDs, err := s.blockRepo.FindHiddenUserIDs(ctx, currentUserID)
+	if err != nil {
+		logger.Warn("查询隐藏用户失败",
+			logger.ErrorField(err),
+			logger.Int64Field("current_user_id", currentUserID))
+		return nil
+	}
+	return hiddenIDs
+}
+
+// 二次创作前检查原视频，作者本人不受二次创作设置限制
+func (s *videoServiceImpl) GetRemixSource(ctx context.Context, userID, videoID int64) (*model.Video, error) {
+	source, err := s.repo.FindByID(ctx, videoID)
+	if err != nil {
+		logger.Error("查询原视频失败",
+			logger.ErrorField(err),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrInternalServer
+	}
+	if source == nil {
+		return nil, ErrVideoNotFound
+	}
+	if source.AuthorID != userID && !s.AllowRemix(ctx, source.AuthorID) {
+		logger.Warn("作者不允许二次创作",
+			logger.Int64Field("user_id", userID),
+			logger.Int64Field("video_id", videoID))
+		return nil, ErrRemixNotAllowed
+	}
+	return source, nil
+}
+
+// 账号注销后删除用户发布的视频、观看历史和观看历史设置，可重复执行
+func (s *videoServiceImpl) PurgeUserData(ctx context.Context, userID int64) error {
+	logger.Info("清理注销用户的视频数据", logger.Int64Field("user_id", userID))
+
+	for {
+		videos, err := s.repo.ListByAuthorID(ctx, userID, nil, purgeBatchSize)
+		if err != nil {
+			logger.Error("查询用户视频失败",
+				logger.ErrorField(err),
+				logger.Int64Field("user_id", userID))
+			return ErrInternalServer
+		}
+		if len(videos) == 0 {
+			break
+		}
+		for _, video := range videos {
+			if err := s.DeleteVideo(ctx, video.ID, userID); err != nil && err != ErrVideoNotFound {
+				return err
+			}
+		}
+	}
+
+	if err := s.ClearWatchHistory(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.historyRepo.DeleteSetting(ctx, userID); err != nil {
+		logger.Error("删除观看历史设置失败",
+			logger.ErrorField(err),
+			logger.Int64Field("user_id", userID))
+		return ErrInternalServer
+	}
+	if s.cache != nil {
+		s.cache.Delete(ctx, cache.GenerateWatchHistoryPausedKey(userID))
+	}
+
+	return nil
+}
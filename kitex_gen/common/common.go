@@ -62,6 +62,8 @@ type User struct {
 	FollowCount   int64   `thrift:"followCount,6" frugal:"6,default,i64" json:"followCount"`
 	FollowerCount int64   `thrift:"followerCount,7" frugal:"7,default,i64" json:"followerCount"`
 	IsFollow      bool    `thrift:"isFollow,8" frugal:"8,default,bool" json:"isFollow"`
+	IsPrivate     *bool   `thrift:"isPrivate,9,optional" frugal:"9,optional,bool" json:"isPrivate,omitempty"`
+	Level         int32   `thrift:"level,10" frugal:"10,default,i32" json:"level"`
 }
 
 func NewUser() *User {
@@ -112,6 +114,19 @@ func (p *User) GetFollowerCount() (v int64) {
 func (p *User) GetIsFollow() (v bool) {
 	return p.IsFollow
 }
+
+var User_IsPrivate_DEFAULT bool
+
+func (p *User) GetIsPrivate() (v bool) {
+	if !p.IsSetIsPrivate() {
+		return User_IsPrivate_DEFAULT
+	}
+	return *p.IsPrivate
+}
+
+func (p *User) GetLevel() (v int32) {
+	return p.Level
+}
 func (p *User) SetId(val int64) {
 	p.Id = val
 }
@@ -136,6 +151,12 @@ func (p *User) SetFollowerCount(val int64) {
 func (p *User) SetIsFollow(val bool) {
 	p.IsFollow = val
 }
+func (p *User) SetIsPrivate(val *bool) {
+	p.IsPrivate = val
+}
+func (p *User) SetLevel(val int32) {
+	p.Level = val
+}
 
 func (p *User) IsSetAvatar() bool {
 	return p.Avatar != nil
@@ -145,6 +166,10 @@ func (p *User) IsSetAbout() bool {
 	return p.About != nil
 }
 
+func (p *User) IsSetIsPrivate() bool {
+	return p.IsPrivate != nil
+}
+
 func (p *User) String() string {
 	if p == nil {
 		return "<nil>"
@@ -153,27 +178,33 @@ func (p *User) String() string {
 }
 
 var fieldIDToName_User = map[int16]string{
-	1: "id",
-	2: "username",
-	3: "password",
-	4: "avatar",
-	5: "about",
-	6: "followCount",
-	7: "followerCount",
-	8: "isFollow",
+	1:  "id",
+	2:  "username",
+	3:  "password",
+	4:  "avatar",
+	5:  "about",
+	6:  "followCount",
+	7:  "followerCount",
+	8:  "isFollow",
+	9:  "isPrivate",
+	10: "level",
 }
 
 type Video struct {
-	Id           int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	AuthorId     int64  `thrift:"authorId,2" frugal:"2,default,i64" json:"authorId"`
-	Url          string `thrift:"url,3" frugal:"3,default,string" json:"url"`
-	CoverUrl     string `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
-	LikeCount    int64  `thrift:"likeCount,5" frugal:"5,default,i64" json:"likeCount"`
-	CommentCount int64  `thrift:"commentCount,6" frugal:"6,default,i64" json:"commentCount"`
-	IsLike       bool   `thrift:"isLike,7" frugal:"7,default,bool" json:"isLike"`
-	Title        string `thrift:"title,8" frugal:"8,default,string" json:"title"`
-	PublishTime  int64  `thrift:"publishTime,9" frugal:"9,default,i64" json:"publishTime"`
-	Description  string `thrift:"description,10" frugal:"10,default,string" json:"description"`
+	Id             int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	AuthorId       int64   `thrift:"authorId,2" frugal:"2,default,i64" json:"authorId"`
+	Url            string  `thrift:"url,3" frugal:"3,default,string" json:"url"`
+	CoverUrl       string  `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
+	LikeCount      int64   `thrift:"likeCount,5" frugal:"5,default,i64" json:"likeCount"`
+	CommentCount   int64   `thrift:"commentCount,6" frugal:"6,default,i64" json:"commentCount"`
+	IsLike         bool    `thrift:"isLike,7" frugal:"7,default,bool" json:"isLike"`
+	Title          string  `thrift:"title,8" frugal:"8,default,string" json:"title"`
+	PublishTime    int64   `thrift:"publishTime,9" frugal:"9,default,i64" json:"publishTime"`
+	Description    string  `thrift:"description,10" frugal:"10,default,string" json:"description"`
+	MyReaction     *string `thrift:"myReaction,11,optional" frugal:"11,optional,string" json:"myReaction,omitempty"`
+	AudienceListId *int64  `thrift:"audienceListId,12,optional" frugal:"12,optional,i64" json:"audienceListId,omitempty"`
+	AllowRemix     *bool   `thrift:"allowRemix,13,optional" frugal:"13,optional,bool" json:"allowRemix,omitempty"`
+	RemixOfVideoId *int64  `thrift:"remixOfVideoId,14,optional" frugal:"14,optional,i64" json:"remixOfVideoId,omitempty"`
 }
 
 func NewVideo() *Video {
@@ -222,6 +253,42 @@ func (p *Video) GetPublishTime() (v int64) {
 func (p *Video) GetDescription() (v string) {
 	return p.Description
 }
+
+var Video_MyReaction_DEFAULT string
+
+func (p *Video) GetMyReaction() (v string) {
+	if !p.IsSetMyReaction() {
+		return Video_MyReaction_DEFAULT
+	}
+	return *p.MyReaction
+}
+
+var Video_AudienceListId_DEFAULT int64
+
+func (p *Video) GetAudienceListId() (v int64) {
+	if !p.IsSetAudienceListId() {
+		return Video_AudienceListId_DEFAULT
+	}
+	return *p.AudienceListId
+}
+
+var Video_AllowRemix_DEFAULT bool
+
+func (p *Video) GetAllowRemix() (v bool) {
+	if !p.IsSetAllowRemix() {
+		return Video_AllowRemix_DEFAULT
+	}
+	return *p.AllowRemix
+}
+
+var Video_RemixOfVideoId_DEFAULT int64
+
+func (p *Video) GetRemixOfVideoId() (v int64) {
+	if !p.IsSetRemixOfVideoId() {
+		return Video_RemixOfVideoId_DEFAULT
+	}
+	return *p.RemixOfVideoId
+}
 func (p *Video) SetId(val int64) {
 	p.Id = val
 }
@@ -252,6 +319,34 @@ func (p *Video) SetPublishTime(val int64) {
 func (p *Video) SetDescription(val string) {
 	p.Description = val
 }
+func (p *Video) SetMyReaction(val *string) {
+	p.MyReaction = val
+}
+func (p *Video) SetAudienceListId(val *int64) {
+	p.AudienceListId = val
+}
+func (p *Video) SetAllowRemix(val *bool) {
+	p.AllowRemix = val
+}
+func (p *Video) SetRemixOfVideoId(val *int64) {
+	p.RemixOfVideoId = val
+}
+
+func (p *Video) IsSetMyReaction() bool {
+	return p.MyReaction != nil
+}
+
+func (p *Video) IsSetAudienceListId() bool {
+	return p.AudienceListId != nil
+}
+
+func (p *Video) IsSetAllowRemix() bool {
+	return p.AllowRemix != nil
+}
+
+func (p *Video) IsSetRemixOfVideoId() bool {
+	return p.RemixOfVideoId != nil
+}
 
 func (p *Video) String() string {
 	if p == nil {
@@ -271,15 +366,30 @@ var fieldIDToName_Video = map[int16]string{
 	8:  "title",
 	9:  "publishTime",
 	10: "description",
+	11: "myReaction",
+	12: "audienceListId",
+	13: "allowRemix",
+	14: "remixOfVideoId",
 }
 
 type Comment struct {
-	Id         int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	UserId     int64  `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
-	VideoId    int64  `thrift:"videoId,3" frugal:"3,default,i64" json:"videoId"`
-	Content    string `thrift:"content,4" frugal:"4,default,string" json:"content"`
-	CreateTime string `thrift:"createTime,5" frugal:"5,default,string" json:"createTime"`
-	ReplyToId  int64  `thrift:"replyToId,6" frugal:"6,default,i64" json:"replyToId"`
+	Id              int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId          int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	VideoId         int64   `thrift:"videoId,3" frugal:"3,default,i64" json:"videoId"`
+	Content         string  `thrift:"content,4" frugal:"4,default,string" json:"content"`
+	CreateTime      string  `thrift:"createTime,5" frugal:"5,default,string" json:"createTime"`
+	ReplyToId       int64   `thrift:"replyToId,6" frugal:"6,default,i64" json:"replyToId"`
+	RootId          int64   `thrift:"rootId,7" frugal:"7,default,i64" json:"rootId"`
+	ReplyToUserId   *int64  `thrift:"replyToUserId,8,optional" frugal:"8,optional,i64" json:"replyToUserId,omitempty"`
+	ReplyToUsername *string `thrift:"replyToUsername,9,optional" frugal:"9,optional,string" json:"replyToUsername,omitempty"`
+	ReplyCount      int64   `thrift:"replyCount,10" frugal:"10,default,i64" json:"replyCount"`
+	IsDeleted       bool    `thrift:"isDeleted,11" frugal:"11,default,bool" json:"isDeleted"`
+	LikeCount       int64   `thrift:"likeCount,12" frugal:"12,default,i64" json:"likeCount"`
+	IsLiked         bool    `thrift:"isLiked,13" frugal:"13,default,bool" json:"isLiked"`
+	IsPinned        bool    `thrift:"isPinned,14" frugal:"14,default,bool" json:"isPinned"`
+	AuthorLiked     bool    `thrift:"authorLiked,15" frugal:"15,default,bool" json:"authorLiked"`
+	Status          int32   `thrift:"status,16" frugal:"16,default,i32" json:"status"`
+	UserLevel       int32   `thrift:"userLevel,17" frugal:"17,default,i32" json:"userLevel"`
 }
 
 func NewComment() *Comment {
@@ -312,6 +422,60 @@ func (p *Comment) GetCreateTime() (v string) {
 func (p *Comment) GetReplyToId() (v int64) {
 	return p.ReplyToId
 }
+
+func (p *Comment) GetRootId() (v int64) {
+	return p.RootId
+}
+
+var Comment_ReplyToUserId_DEFAULT int64
+
+func (p *Comment) GetReplyToUserId() (v int64) {
+	if !p.IsSetReplyToUserId() {
+		return Comment_ReplyToUserId_DEFAULT
+	}
+	return *p.ReplyToUserId
+}
+
+var Comment_ReplyToUsername_DEFAULT string
+
+func (p *Comment) GetReplyToUsername() (v string) {
+	if !p.IsSetReplyToUsername() {
+		return Comment_ReplyToUsername_DEFAULT
+	}
+	return *p.ReplyToUsername
+}
+
+func (p *Comment) GetReplyCount() (v int64) {
+	return p.ReplyCount
+}
+
+func (p *Comment) GetIsDeleted() (v bool) {
+	return p.IsDeleted
+}
+
+func (p *Comment) GetLikeCount() (v int64) {
+	return p.LikeCount
+}
+
+func (p *Comment) GetIsLiked() (v bool) {
+	return p.IsLiked
+}
+
+func (p *Comment) GetIsPinned() (v bool) {
+	return p.IsPinned
+}
+
+func (p *Comment) GetAuthorLiked() (v bool) {
+	return p.AuthorLiked
+}
+
+func (p *Comment) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *Comment) GetUserLevel() (v int32) {
+	return p.UserLevel
+}
 func (p *Comment) SetId(val int64) {
 	p.Id = val
 }
@@ -330,6 +494,47 @@ func (p *Comment) SetCreateTime(val string) {
 func (p *Comment) SetReplyToId(val int64) {
 	p.ReplyToId = val
 }
+func (p *Comment) SetRootId(val int64) {
+	p.RootId = val
+}
+func (p *Comment) SetReplyToUserId(val *int64) {
+	p.ReplyToUserId = val
+}
+func (p *Comment) SetReplyToUsername(val *string) {
+	p.ReplyToUsername = val
+}
+func (p *Comment) SetReplyCount(val int64) {
+	p.ReplyCount = val
+}
+func (p *Comment) SetIsDeleted(val bool) {
+	p.IsDeleted = val
+}
+func (p *Comment) SetLikeCount(val int64) {
+	p.LikeCount = val
+}
+func (p *Comment) SetIsLiked(val bool) {
+	p.IsLiked = val
+}
+func (p *Comment) SetIsPinned(val bool) {
+	p.IsPinned = val
+}
+func (p *Comment) SetAuthorLiked(val bool) {
+	p.AuthorLiked = val
+}
+func (p *Comment) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *Comment) SetUserLevel(val int32) {
+	p.UserLevel = val
+}
+
+func (p *Comment) IsSetReplyToUserId() bool {
+	return p.ReplyToUserId != nil
+}
+
+func (p *Comment) IsSetReplyToUsername() bool {
+	return p.ReplyToUsername != nil
+}
 
 func (p *Comment) String() string {
 	if p == nil {
@@ -339,12 +544,23 @@ func (p *Comment) String() string {
 }
 
 var fieldIDToName_Comment = map[int16]string{
-	1: "id",
-	2: "userId",
-	3: "videoId",
-	4: "content",
-	5: "createTime",
-	6: "replyToId",
+	1:  "id",
+	2:  "userId",
+	3:  "videoId",
+	4:  "content",
+	5:  "createTime",
+	6:  "replyToId",
+	7:  "rootId",
+	8:  "replyToUserId",
+	9:  "replyToUsername",
+	10: "replyCount",
+	11: "isDeleted",
+	12: "likeCount",
+	13: "isLiked",
+	14: "isPinned",
+	15: "authorLiked",
+	16: "status",
+	17: "userLevel",
 }
 
 type Message struct {
@@ -354,6 +570,7 @@ type Message struct {
 	Content    string `thrift:"content,4" frugal:"4,default,string" json:"content"`
 	CreateTime string `thrift:"createTime,5" frugal:"5,default,string" json:"createTime"`
 	IsRead     bool   `thrift:"isRead,6" frugal:"6,default,bool" json:"isRead"`
+	Pending    bool   `thrift:"pending,7" frugal:"7,default,bool" json:"pending"`
 }
 
 func NewMessage() *Message {
@@ -386,6 +603,10 @@ func (p *Message) GetCreateTime() (v string) {
 func (p *Message) GetIsRead() (v bool) {
 	return p.IsRead
 }
+
+func (p *Message) GetPending() (v bool) {
+	return p.Pending
+}
 func (p *Message) SetId(val int64) {
 	p.Id = val
 }
@@ -404,6 +625,9 @@ func (p *Message) SetCreateTime(val string) {
 func (p *Message) SetIsRead(val bool) {
 	p.IsRead = val
 }
+func (p *Message) SetPending(val bool) {
+	p.Pending = val
+}
 
 func (p *Message) String() string {
 	if p == nil {
@@ -419,18 +643,20 @@ var fieldIDToName_Message = map[int16]string{
 	4: "content",
 	5: "createTime",
 	6: "isRead",
+	7: "pending",
 }
 
 type LiveRoom struct {
-	Id          int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
-	HostId      int64  `thrift:"hostId,2" frugal:"2,default,i64" json:"hostId"`
-	Title       string `thrift:"title,3" frugal:"3,default,string" json:"title"`
-	CoverUrl    string `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
-	RtmpUrl     string `thrift:"rtmpUrl,5" frugal:"5,default,string" json:"rtmpUrl"`
-	HlsUrl      string `thrift:"hlsUrl,6" frugal:"6,default,string" json:"hlsUrl"`
-	ViewerCount int64  `thrift:"viewerCount,7" frugal:"7,default,i64" json:"viewerCount"`
-	IsLive      bool   `thrift:"isLive,8" frugal:"8,default,bool" json:"isLive"`
-	CreateTime  string `thrift:"createTime,9" frugal:"9,default,string" json:"createTime"`
+	Id             int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	HostId         int64  `thrift:"hostId,2" frugal:"2,default,i64" json:"hostId"`
+	Title          string `thrift:"title,3" frugal:"3,default,string" json:"title"`
+	CoverUrl       string `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
+	RtmpUrl        string `thrift:"rtmpUrl,5" frugal:"5,default,string" json:"rtmpUrl"`
+	HlsUrl         string `thrift:"hlsUrl,6" frugal:"6,default,string" json:"hlsUrl"`
+	ViewerCount    int64  `thrift:"viewerCount,7" frugal:"7,default,i64" json:"viewerCount"`
+	IsLive         bool   `thrift:"isLive,8" frugal:"8,default,bool" json:"isLive"`
+	CreateTime     string `thrift:"createTime,9" frugal:"9,default,string" json:"createTime"`
+	AudienceListId *int64 `thrift:"audienceListId,10,optional" frugal:"10,optional,i64" json:"audienceListId,omitempty"`
 }
 
 func NewLiveRoom() *LiveRoom {
@@ -475,6 +701,15 @@ func (p *LiveRoom) GetIsLive() (v bool) {
 func (p *LiveRoom) GetCreateTime() (v string) {
 	return p.CreateTime
 }
+
+var LiveRoom_AudienceListId_DEFAULT int64
+
+func (p *LiveRoom) GetAudienceListId() (v int64) {
+	if !p.IsSetAudienceListId() {
+		return LiveRoom_AudienceListId_DEFAULT
+	}
+	return *p.AudienceListId
+}
 func (p *LiveRoom) SetId(val int64) {
 	p.Id = val
 }
@@ -502,6 +737,13 @@ func (p *LiveRoom) SetIsLive(val bool) {
 func (p *LiveRoom) SetCreateTime(val string) {
 	p.CreateTime = val
 }
+func (p *LiveRoom) SetAudienceListId(val *int64) {
+	p.AudienceListId = val
+}
+
+func (p *LiveRoom) IsSetAudienceListId() bool {
+	return p.AudienceListId != nil
+}
 
 func (p *LiveRoom) String() string {
 	if p == nil {
@@ -511,15 +753,16 @@ func (p *LiveRoom) String() string {
 }
 
 var fieldIDToName_LiveRoom = map[int16]string{
-	1: "id",
-	2: "hostId",
-	3: "title",
-	4: "coverUrl",
-	5: "rtmpUrl",
-	6: "hlsUrl",
-	7: "viewerCount",
-	8: "isLive",
-	9: "createTime",
+	1:  "id",
+	2:  "hostId",
+	3:  "title",
+	4:  "coverUrl",
+	5:  "rtmpUrl",
+	6:  "hlsUrl",
+	7:  "viewerCount",
+	8:  "isLive",
+	9:  "createTime",
+	10: "audienceListId",
 }
 
 type Danmu struct {
@@ -529,6 +772,7 @@ type Danmu struct {
 	Content    string `thrift:"content,4" frugal:"4,default,string" json:"content"`
 	Color      string `thrift:"color,5" frugal:"5,default,string" json:"color"`
 	CreateTime string `thrift:"createTime,6" frugal:"6,default,string" json:"createTime"`
+	UserLevel  int32  `thrift:"userLevel,7" frugal:"7,default,i32" json:"userLevel"`
 }
 
 func NewDanmu() *Danmu {
@@ -561,6 +805,10 @@ func (p *Danmu) GetColor() (v string) {
 func (p *Danmu) GetCreateTime() (v string) {
 	return p.CreateTime
 }
+
+func (p *Danmu) GetUserLevel() (v int32) {
+	return p.UserLevel
+}
 func (p *Danmu) SetId(val int64) {
 	p.Id = val
 }
@@ -579,6 +827,9 @@ func (p *Danmu) SetColor(val string) {
 func (p *Danmu) SetCreateTime(val string) {
 	p.CreateTime = val
 }
+func (p *Danmu) SetUserLevel(val int32) {
+	p.UserLevel = val
+}
 
 func (p *Danmu) String() string {
 	if p == nil {
@@ -594,4 +845,5 @@ var fieldIDToName_Danmu = map[int16]string{
 	4: "content",
 	5: "color",
 	6: "createTime",
+	7: "userLevel",
 }
@@ -110,8 +110,10 @@ var fieldIDToName_SendDanmuReq = map[int16]string{
 }
 
 type SendDanmuResp struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	DanmuId  int64            `thrift:"danmuId,2" frugal:"2,default,i64" json:"danmuId"`
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	DanmuId   int64            `thrift:"danmuId,2" frugal:"2,default,i64" json:"danmuId"`
+	Content   *string          `thrift:"content,3,optional" frugal:"3,optional,string" json:"content,omitempty"`
+	UserLevel *int32           `thrift:"userLevel,4,optional" frugal:"4,optional,i32" json:"userLevel,omitempty"`
 }
 
 func NewSendDanmuResp() *SendDanmuResp {
@@ -133,17 +135,49 @@ func (p *SendDanmuResp) GetBaseResp() (v *common.BaseResp) {
 func (p *SendDanmuResp) GetDanmuId() (v int64) {
 	return p.DanmuId
 }
+
+var SendDanmuResp_Content_DEFAULT string
+
+func (p *SendDanmuResp) GetContent() (v string) {
+	if !p.IsSetContent() {
+		return SendDanmuResp_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+var SendDanmuResp_UserLevel_DEFAULT int32
+
+func (p *SendDanmuResp) GetUserLevel() (v int32) {
+	if !p.IsSetUserLevel() {
+		return SendDanmuResp_UserLevel_DEFAULT
+	}
+	return *p.UserLevel
+}
 func (p *SendDanmuResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
 func (p *SendDanmuResp) SetDanmuId(val int64) {
 	p.DanmuId = val
 }
+func (p *SendDanmuResp) SetContent(val *string) {
+	p.Content = val
+}
+func (p *SendDanmuResp) SetUserLevel(val *int32) {
+	p.UserLevel = val
+}
 
 func (p *SendDanmuResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *SendDanmuResp) IsSetContent() bool {
+	return p.Content != nil
+}
+
+func (p *SendDanmuResp) IsSetUserLevel() bool {
+	return p.UserLevel != nil
+}
+
 func (p *SendDanmuResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -154,13 +188,16 @@ func (p *SendDanmuResp) String() string {
 var fieldIDToName_SendDanmuResp = map[int16]string{
 	1: "BaseResp",
 	2: "danmuId",
+	3: "content",
+	4: "userLevel",
 }
 
 type GetDanmuHistoryReq struct {
-	LiveId    int64 `thrift:"liveId,1" frugal:"1,default,i64" json:"liveId"`
-	StartTime int64 `thrift:"startTime,2" frugal:"2,default,i64" json:"startTime"`
-	EndTime   int64 `thrift:"endTime,3" frugal:"3,default,i64" json:"endTime"`
-	Limit     int32 `thrift:"limit,4" frugal:"4,default,i32" json:"limit"`
+	LiveId        int64  `thrift:"liveId,1" frugal:"1,default,i64" json:"liveId"`
+	StartTime     int64  `thrift:"startTime,2" frugal:"2,default,i64" json:"startTime"`
+	EndTime       int64  `thrift:"endTime,3" frugal:"3,default,i64" json:"endTime"`
+	Limit         int32  `thrift:"limit,4" frugal:"4,default,i32" json:"limit"`
+	CurrentUserId *int64 `thrift:"currentUserId,5,optional" frugal:"5,optional,i64" json:"currentUserId,omitempty"`
 }
 
 func NewGetDanmuHistoryReq() *GetDanmuHistoryReq {
@@ -185,6 +222,15 @@ func (p *GetDanmuHistoryReq) GetEndTime() (v int64) {
 func (p *GetDanmuHistoryReq) GetLimit() (v int32) {
 	return p.Limit
 }
+
+var GetDanmuHistoryReq_CurrentUserId_DEFAULT int64
+
+func (p *GetDanmuHistoryReq) GetCurrentUserId() (v int64) {
+	if !p.IsSetCurrentUserId() {
+		return GetDanmuHistoryReq_CurrentUserId_DEFAULT
+	}
+	return *p.CurrentUserId
+}
 func (p *GetDanmuHistoryReq) SetLiveId(val int64) {
 	p.LiveId = val
 }
@@ -197,6 +243,13 @@ func (p *GetDanmuHistoryReq) SetEndTime(val int64) {
 func (p *GetDanmuHistoryReq) SetLimit(val int32) {
 	p.Limit = val
 }
+func (p *GetDanmuHistoryReq) SetCurrentUserId(val *int64) {
+	p.CurrentUserId = val
+}
+
+func (p *GetDanmuHistoryReq) IsSetCurrentUserId() bool {
+	return p.CurrentUserId != nil
+}
 
 func (p *GetDanmuHistoryReq) String() string {
 	if p == nil {
@@ -210,6 +263,7 @@ var fieldIDToName_GetDanmuHistoryReq = map[int16]string{
 	2: "startTime",
 	3: "endTime",
 	4: "limit",
+	5: "currentUserId",
 }
 
 type GetDanmuHistoryResp struct {
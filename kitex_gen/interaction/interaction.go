@@ -94,10 +94,12 @@ var fieldIDToName_LikeActionResp = map[int16]string{
 }
 
 type LikeVideoListReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewLikeVideoListReq() *LikeVideoListReq {
@@ -122,6 +124,24 @@ func (p *LikeVideoListReq) GetPage() (v int32) {
 func (p *LikeVideoListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var LikeVideoListReq_Cursor_DEFAULT string
+
+func (p *LikeVideoListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return LikeVideoListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var LikeVideoListReq_NeedTotal_DEFAULT bool
+
+func (p *LikeVideoListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return LikeVideoListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *LikeVideoListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -134,6 +154,20 @@ func (p *LikeVideoListReq) SetPage(val int32) {
 func (p *LikeVideoListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *LikeVideoListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *LikeVideoListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *LikeVideoListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *LikeVideoListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *LikeVideoListReq) String() string {
 	if p == nil {
@@ -147,12 +181,16 @@ var fieldIDToName_LikeVideoListReq = map[int16]string{
 	2: "currentUserId",
 	3: "page",
 	4: "pageSize",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type LikeVideoListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Videos     []*common.Video  `thrift:"videos,2" frugal:"2,default,list<common.Video>" json:"videos"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewLikeVideoListResp() *LikeVideoListResp {
@@ -178,6 +216,19 @@ func (p *LikeVideoListResp) GetVideos() (v []*common.Video) {
 func (p *LikeVideoListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var LikeVideoListResp_NextCursor_DEFAULT string
+
+func (p *LikeVideoListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return LikeVideoListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *LikeVideoListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *LikeVideoListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -187,11 +238,21 @@ func (p *LikeVideoListResp) SetVideos(val []*common.Video) {
 func (p *LikeVideoListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *LikeVideoListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *LikeVideoListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *LikeVideoListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *LikeVideoListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *LikeVideoListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -203,12 +264,15 @@ var fieldIDToName_LikeVideoListResp = map[int16]string{
 	1: "BaseResp",
 	2: "videos",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type StarActionReq struct {
-	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
-	Action  bool  `thrift:"action,3" frugal:"3,default,bool" json:"action"`
+	UserId    int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId   int64   `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Action    bool    `thrift:"action,3" frugal:"3,default,bool" json:"action"`
+	FolderIds []int64 `thrift:"folderIds,4,optional" frugal:"4,optional,list<i64>" json:"folderIds,omitempty"`
 }
 
 func NewStarActionReq() *StarActionReq {
@@ -229,6 +293,15 @@ func (p *StarActionReq) GetVideoId() (v int64) {
 func (p *StarActionReq) GetAction() (v bool) {
 	return p.Action
 }
+
+var StarActionReq_FolderIds_DEFAULT []int64
+
+func (p *StarActionReq) GetFolderIds() (v []int64) {
+	if !p.IsSetFolderIds() {
+		return StarActionReq_FolderIds_DEFAULT
+	}
+	return p.FolderIds
+}
 func (p *StarActionReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -238,6 +311,13 @@ func (p *StarActionReq) SetVideoId(val int64) {
 func (p *StarActionReq) SetAction(val bool) {
 	p.Action = val
 }
+func (p *StarActionReq) SetFolderIds(val []int64) {
+	p.FolderIds = val
+}
+
+func (p *StarActionReq) IsSetFolderIds() bool {
+	return p.FolderIds != nil
+}
 
 func (p *StarActionReq) String() string {
 	if p == nil {
@@ -250,6 +330,7 @@ var fieldIDToName_StarActionReq = map[int16]string{
 	1: "userId",
 	2: "videoId",
 	3: "action",
+	4: "folderIds",
 }
 
 type StarActionResp struct {
@@ -291,10 +372,13 @@ var fieldIDToName_StarActionResp = map[int16]string{
 }
 
 type StarVideoListReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	FolderId      *int64  `thrift:"folderId,5,optional" frugal:"5,optional,i64" json:"folderId,omitempty"`
+	Cursor        *string `thrift:"cursor,6,optional" frugal:"6,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,7,optional" frugal:"7,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewStarVideoListReq() *StarVideoListReq {
@@ -319,6 +403,33 @@ func (p *StarVideoListReq) GetPage() (v int32) {
 func (p *StarVideoListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var StarVideoListReq_FolderId_DEFAULT int64
+
+func (p *StarVideoListReq) GetFolderId() (v int64) {
+	if !p.IsSetFolderId() {
+		return StarVideoListReq_FolderId_DEFAULT
+	}
+	return *p.FolderId
+}
+
+var StarVideoListReq_Cursor_DEFAULT string
+
+func (p *StarVideoListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return StarVideoListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var StarVideoListReq_NeedTotal_DEFAULT bool
+
+func (p *StarVideoListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return StarVideoListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *StarVideoListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -331,6 +442,27 @@ func (p *StarVideoListReq) SetPage(val int32) {
 func (p *StarVideoListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *StarVideoListReq) SetFolderId(val *int64) {
+	p.FolderId = val
+}
+func (p *StarVideoListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *StarVideoListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *StarVideoListReq) IsSetFolderId() bool {
+	return p.FolderId != nil
+}
+
+func (p *StarVideoListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *StarVideoListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *StarVideoListReq) String() string {
 	if p == nil {
@@ -344,12 +476,17 @@ var fieldIDToName_StarVideoListReq = map[int16]string{
 	2: "currentUserId",
 	3: "page",
 	4: "pageSize",
+	5: "folderId",
+	6: "cursor",
+	7: "needTotal",
 }
 
 type StarVideoListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Videos     []*common.Video  `thrift:"videos,2" frugal:"2,default,list<common.Video>" json:"videos"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewStarVideoListResp() *StarVideoListResp {
@@ -375,6 +512,19 @@ func (p *StarVideoListResp) GetVideos() (v []*common.Video) {
 func (p *StarVideoListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var StarVideoListResp_NextCursor_DEFAULT string
+
+func (p *StarVideoListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return StarVideoListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *StarVideoListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *StarVideoListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -384,11 +534,21 @@ func (p *StarVideoListResp) SetVideos(val []*common.Video) {
 func (p *StarVideoListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *StarVideoListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *StarVideoListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *StarVideoListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *StarVideoListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *StarVideoListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -400,1456 +560,6357 @@ var fieldIDToName_StarVideoListResp = map[int16]string{
 	1: "BaseResp",
 	2: "videos",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
-type CommentActionReq struct {
-	UserId    int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId   int64  `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
-	Content   string `thrift:"content,3" frugal:"3,default,string" json:"content"`
-	ReplyToId *int64 `thrift:"replyToId,4,optional" frugal:"4,optional,i64" json:"replyToId,omitempty"`
+type StarFolder struct {
+	Id            int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId        int64  `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	Name          string `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	IsPublic      bool   `thrift:"isPublic,4" frugal:"4,default,bool" json:"isPublic"`
+	VideoCount    int64  `thrift:"videoCount,5" frugal:"5,default,i64" json:"videoCount"`
+	FollowerCount int64  `thrift:"followerCount,6" frugal:"6,default,i64" json:"followerCount"`
+	IsFollowed    bool   `thrift:"isFollowed,7" frugal:"7,default,bool" json:"isFollowed"`
+	CreatedAt     int64  `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
 }
 
-func NewCommentActionReq() *CommentActionReq {
-	return &CommentActionReq{}
+func NewStarFolder() *StarFolder {
+	return &StarFolder{}
 }
 
-func (p *CommentActionReq) InitDefault() {
+func (p *StarFolder) InitDefault() {
 }
 
-func (p *CommentActionReq) GetUserId() (v int64) {
+func (p *StarFolder) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *StarFolder) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *CommentActionReq) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *StarFolder) GetName() (v string) {
+	return p.Name
 }
 
-func (p *CommentActionReq) GetContent() (v string) {
-	return p.Content
+func (p *StarFolder) GetIsPublic() (v bool) {
+	return p.IsPublic
 }
 
-var CommentActionReq_ReplyToId_DEFAULT int64
+func (p *StarFolder) GetVideoCount() (v int64) {
+	return p.VideoCount
+}
 
-func (p *CommentActionReq) GetReplyToId() (v int64) {
-	if !p.IsSetReplyToId() {
-		return CommentActionReq_ReplyToId_DEFAULT
-	}
-	return *p.ReplyToId
+func (p *StarFolder) GetFollowerCount() (v int64) {
+	return p.FollowerCount
 }
-func (p *CommentActionReq) SetUserId(val int64) {
+
+func (p *StarFolder) GetIsFollowed() (v bool) {
+	return p.IsFollowed
+}
+
+func (p *StarFolder) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *StarFolder) SetId(val int64) {
+	p.Id = val
+}
+func (p *StarFolder) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *CommentActionReq) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *StarFolder) SetName(val string) {
+	p.Name = val
 }
-func (p *CommentActionReq) SetContent(val string) {
-	p.Content = val
+func (p *StarFolder) SetIsPublic(val bool) {
+	p.IsPublic = val
 }
-func (p *CommentActionReq) SetReplyToId(val *int64) {
-	p.ReplyToId = val
+func (p *StarFolder) SetVideoCount(val int64) {
+	p.VideoCount = val
+}
+func (p *StarFolder) SetFollowerCount(val int64) {
+	p.FollowerCount = val
+}
+func (p *StarFolder) SetIsFollowed(val bool) {
+	p.IsFollowed = val
+}
+func (p *StarFolder) SetCreatedAt(val int64) {
+	p.CreatedAt = val
 }
 
-func (p *CommentActionReq) IsSetReplyToId() bool {
-	return p.ReplyToId != nil
+func (p *StarFolder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StarFolder(%+v)", *p)
 }
 
-func (p *CommentActionReq) String() string {
+var fieldIDToName_StarFolder = map[int16]string{
+	1: "id",
+	2: "userId",
+	3: "name",
+	4: "isPublic",
+	5: "videoCount",
+	6: "followerCount",
+	7: "isFollowed",
+	8: "createdAt",
+}
+
+type CreateStarFolderReq struct {
+	UserId   int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Name     string `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	IsPublic bool   `thrift:"isPublic,3" frugal:"3,default,bool" json:"isPublic"`
+}
+
+func NewCreateStarFolderReq() *CreateStarFolderReq {
+	return &CreateStarFolderReq{}
+}
+
+func (p *CreateStarFolderReq) InitDefault() {
+}
+
+func (p *CreateStarFolderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateStarFolderReq) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CreateStarFolderReq) GetIsPublic() (v bool) {
+	return p.IsPublic
+}
+func (p *CreateStarFolderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateStarFolderReq) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateStarFolderReq) SetIsPublic(val bool) {
+	p.IsPublic = val
+}
+
+func (p *CreateStarFolderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentActionReq(%+v)", *p)
+	return fmt.Sprintf("CreateStarFolderReq(%+v)", *p)
 }
 
-var fieldIDToName_CommentActionReq = map[int16]string{
+var fieldIDToName_CreateStarFolderReq = map[int16]string{
 	1: "userId",
-	2: "videoId",
-	3: "content",
-	4: "replyToId",
+	2: "name",
+	3: "isPublic",
 }
 
-type CommentActionResp struct {
+type CreateStarFolderResp struct {
 	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	Comment  *common.Comment  `thrift:"comment,2" frugal:"2,default,common.Comment" json:"comment"`
+	Folder   *StarFolder      `thrift:"folder,2" frugal:"2,default,StarFolder" json:"folder"`
 }
 
-func NewCommentActionResp() *CommentActionResp {
-	return &CommentActionResp{}
+func NewCreateStarFolderResp() *CreateStarFolderResp {
+	return &CreateStarFolderResp{}
 }
 
-func (p *CommentActionResp) InitDefault() {
+func (p *CreateStarFolderResp) InitDefault() {
 }
 
-var CommentActionResp_BaseResp_DEFAULT *common.BaseResp
+var CreateStarFolderResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CommentActionResp) GetBaseResp() (v *common.BaseResp) {
+func (p *CreateStarFolderResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CommentActionResp_BaseResp_DEFAULT
+		return CreateStarFolderResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var CommentActionResp_Comment_DEFAULT *common.Comment
+var CreateStarFolderResp_Folder_DEFAULT *StarFolder
 
-func (p *CommentActionResp) GetComment() (v *common.Comment) {
-	if !p.IsSetComment() {
-		return CommentActionResp_Comment_DEFAULT
+func (p *CreateStarFolderResp) GetFolder() (v *StarFolder) {
+	if !p.IsSetFolder() {
+		return CreateStarFolderResp_Folder_DEFAULT
 	}
-	return p.Comment
+	return p.Folder
 }
-func (p *CommentActionResp) SetBaseResp(val *common.BaseResp) {
+func (p *CreateStarFolderResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
-func (p *CommentActionResp) SetComment(val *common.Comment) {
-	p.Comment = val
+func (p *CreateStarFolderResp) SetFolder(val *StarFolder) {
+	p.Folder = val
 }
 
-func (p *CommentActionResp) IsSetBaseResp() bool {
+func (p *CreateStarFolderResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CommentActionResp) IsSetComment() bool {
-	return p.Comment != nil
+func (p *CreateStarFolderResp) IsSetFolder() bool {
+	return p.Folder != nil
 }
 
-func (p *CommentActionResp) String() string {
+func (p *CreateStarFolderResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentActionResp(%+v)", *p)
+	return fmt.Sprintf("CreateStarFolderResp(%+v)", *p)
 }
 
-var fieldIDToName_CommentActionResp = map[int16]string{
+var fieldIDToName_CreateStarFolderResp = map[int16]string{
 	1: "BaseResp",
-	2: "comment",
+	2: "folder",
 }
 
-type CommentListReq struct {
-	VideoId       int64 `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+type UpdateStarFolderReq struct {
+	UserId   int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	FolderId int64   `thrift:"folderId,2" frugal:"2,default,i64" json:"folderId"`
+	Name     *string `thrift:"name,3,optional" frugal:"3,optional,string" json:"name,omitempty"`
+	IsPublic *bool   `thrift:"isPublic,4,optional" frugal:"4,optional,bool" json:"isPublic,omitempty"`
 }
 
-func NewCommentListReq() *CommentListReq {
-	return &CommentListReq{}
+func NewUpdateStarFolderReq() *UpdateStarFolderReq {
+	return &UpdateStarFolderReq{}
 }
 
-func (p *CommentListReq) InitDefault() {
+func (p *UpdateStarFolderReq) InitDefault() {
 }
 
-func (p *CommentListReq) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *UpdateStarFolderReq) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *CommentListReq) GetCurrentUserId() (v int64) {
-	return p.CurrentUserId
+func (p *UpdateStarFolderReq) GetFolderId() (v int64) {
+	return p.FolderId
 }
 
-func (p *CommentListReq) GetPage() (v int32) {
-	return p.Page
+var UpdateStarFolderReq_Name_DEFAULT string
+
+func (p *UpdateStarFolderReq) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateStarFolderReq_Name_DEFAULT
+	}
+	return *p.Name
 }
 
-func (p *CommentListReq) GetPageSize() (v int32) {
-	return p.PageSize
+var UpdateStarFolderReq_IsPublic_DEFAULT bool
+
+func (p *UpdateStarFolderReq) GetIsPublic() (v bool) {
+	if !p.IsSetIsPublic() {
+		return UpdateStarFolderReq_IsPublic_DEFAULT
+	}
+	return *p.IsPublic
 }
-func (p *CommentListReq) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *UpdateStarFolderReq) SetUserId(val int64) {
+	p.UserId = val
 }
-func (p *CommentListReq) SetCurrentUserId(val int64) {
-	p.CurrentUserId = val
+func (p *UpdateStarFolderReq) SetFolderId(val int64) {
+	p.FolderId = val
 }
-func (p *CommentListReq) SetPage(val int32) {
-	p.Page = val
+func (p *UpdateStarFolderReq) SetName(val *string) {
+	p.Name = val
 }
-func (p *CommentListReq) SetPageSize(val int32) {
-	p.PageSize = val
+func (p *UpdateStarFolderReq) SetIsPublic(val *bool) {
+	p.IsPublic = val
 }
 
-func (p *CommentListReq) String() string {
+func (p *UpdateStarFolderReq) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateStarFolderReq) IsSetIsPublic() bool {
+	return p.IsPublic != nil
+}
+
+func (p *UpdateStarFolderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentListReq(%+v)", *p)
+	return fmt.Sprintf("UpdateStarFolderReq(%+v)", *p)
 }
 
-var fieldIDToName_CommentListReq = map[int16]string{
-	1: "videoId",
-	2: "currentUserId",
-	3: "page",
-	4: "pageSize",
+var fieldIDToName_UpdateStarFolderReq = map[int16]string{
+	1: "userId",
+	2: "folderId",
+	3: "name",
+	4: "isPublic",
 }
 
-type CommentListResp struct {
-	BaseResp   *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	Comments   []*common.Comment `thrift:"comments,2" frugal:"2,default,list<common.Comment>" json:"comments"`
-	TotalCount int32             `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+type UpdateStarFolderResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Folder   *StarFolder      `thrift:"folder,2" frugal:"2,default,StarFolder" json:"folder"`
 }
 
-func NewCommentListResp() *CommentListResp {
-	return &CommentListResp{}
+func NewUpdateStarFolderResp() *UpdateStarFolderResp {
+	return &UpdateStarFolderResp{}
 }
 
-func (p *CommentListResp) InitDefault() {
+func (p *UpdateStarFolderResp) InitDefault() {
 }
 
-var CommentListResp_BaseResp_DEFAULT *common.BaseResp
+var UpdateStarFolderResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CommentListResp) GetBaseResp() (v *common.BaseResp) {
+func (p *UpdateStarFolderResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CommentListResp_BaseResp_DEFAULT
+		return UpdateStarFolderResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-func (p *CommentListResp) GetComments() (v []*common.Comment) {
-	return p.Comments
-}
+var UpdateStarFolderResp_Folder_DEFAULT *StarFolder
 
-func (p *CommentListResp) GetTotalCount() (v int32) {
-	return p.TotalCount
+func (p *UpdateStarFolderResp) GetFolder() (v *StarFolder) {
+	if !p.IsSetFolder() {
+		return UpdateStarFolderResp_Folder_DEFAULT
+	}
+	return p.Folder
 }
-func (p *CommentListResp) SetBaseResp(val *common.BaseResp) {
+func (p *UpdateStarFolderResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
-func (p *CommentListResp) SetComments(val []*common.Comment) {
-	p.Comments = val
-}
-func (p *CommentListResp) SetTotalCount(val int32) {
-	p.TotalCount = val
+func (p *UpdateStarFolderResp) SetFolder(val *StarFolder) {
+	p.Folder = val
 }
 
-func (p *CommentListResp) IsSetBaseResp() bool {
+func (p *UpdateStarFolderResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CommentListResp) String() string {
+func (p *UpdateStarFolderResp) IsSetFolder() bool {
+	return p.Folder != nil
+}
+
+func (p *UpdateStarFolderResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentListResp(%+v)", *p)
+	return fmt.Sprintf("UpdateStarFolderResp(%+v)", *p)
 }
 
-var fieldIDToName_CommentListResp = map[int16]string{
+var fieldIDToName_UpdateStarFolderResp = map[int16]string{
 	1: "BaseResp",
-	2: "comments",
-	3: "totalCount",
+	2: "folder",
 }
 
-type DeleteCommentReq struct {
-	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId   int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
-	CommentId int64 `thrift:"commentId,3" frugal:"3,default,i64" json:"commentId"`
+type DeleteStarFolderReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	FolderId int64 `thrift:"folderId,2" frugal:"2,default,i64" json:"folderId"`
 }
 
-func NewDeleteCommentReq() *DeleteCommentReq {
-	return &DeleteCommentReq{}
+func NewDeleteStarFolderReq() *DeleteStarFolderReq {
+	return &DeleteStarFolderReq{}
 }
 
-func (p *DeleteCommentReq) InitDefault() {
+func (p *DeleteStarFolderReq) InitDefault() {
 }
 
-func (p *DeleteCommentReq) GetUserId() (v int64) {
+func (p *DeleteStarFolderReq) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *DeleteCommentReq) GetVideoId() (v int64) {
-	return p.VideoId
-}
-
-func (p *DeleteCommentReq) GetCommentId() (v int64) {
-	return p.CommentId
+func (p *DeleteStarFolderReq) GetFolderId() (v int64) {
+	return p.FolderId
 }
-func (p *DeleteCommentReq) SetUserId(val int64) {
+func (p *DeleteStarFolderReq) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *DeleteCommentReq) SetVideoId(val int64) {
-	p.VideoId = val
-}
-func (p *DeleteCommentReq) SetCommentId(val int64) {
-	p.CommentId = val
+func (p *DeleteStarFolderReq) SetFolderId(val int64) {
+	p.FolderId = val
 }
 
-func (p *DeleteCommentReq) String() string {
+func (p *DeleteStarFolderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteCommentReq(%+v)", *p)
+	return fmt.Sprintf("DeleteStarFolderReq(%+v)", *p)
 }
 
-var fieldIDToName_DeleteCommentReq = map[int16]string{
+var fieldIDToName_DeleteStarFolderReq = map[int16]string{
 	1: "userId",
-	2: "videoId",
-	3: "commentId",
+	2: "folderId",
 }
 
-type DeleteCommentResp struct {
+type DeleteStarFolderResp struct {
 	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 }
 
-func NewDeleteCommentResp() *DeleteCommentResp {
-	return &DeleteCommentResp{}
+func NewDeleteStarFolderResp() *DeleteStarFolderResp {
+	return &DeleteStarFolderResp{}
 }
 
-func (p *DeleteCommentResp) InitDefault() {
+func (p *DeleteStarFolderResp) InitDefault() {
 }
 
-var DeleteCommentResp_BaseResp_DEFAULT *common.BaseResp
+var DeleteStarFolderResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *DeleteCommentResp) GetBaseResp() (v *common.BaseResp) {
+func (p *DeleteStarFolderResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return DeleteCommentResp_BaseResp_DEFAULT
+		return DeleteStarFolderResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *DeleteCommentResp) SetBaseResp(val *common.BaseResp) {
+func (p *DeleteStarFolderResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
 
-func (p *DeleteCommentResp) IsSetBaseResp() bool {
+func (p *DeleteStarFolderResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *DeleteCommentResp) String() string {
+func (p *DeleteStarFolderResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteCommentResp(%+v)", *p)
+	return fmt.Sprintf("DeleteStarFolderResp(%+v)", *p)
 }
 
-var fieldIDToName_DeleteCommentResp = map[int16]string{
+var fieldIDToName_DeleteStarFolderResp = map[int16]string{
 	1: "BaseResp",
 }
 
-type ShareActionReq struct {
-	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+type StarFolderListReq struct {
+	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
 }
 
-func NewShareActionReq() *ShareActionReq {
-	return &ShareActionReq{}
+func NewStarFolderListReq() *StarFolderListReq {
+	return &StarFolderListReq{}
 }
 
-func (p *ShareActionReq) InitDefault() {
+func (p *StarFolderListReq) InitDefault() {
 }
 
-func (p *ShareActionReq) GetUserId() (v int64) {
+func (p *StarFolderListReq) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *ShareActionReq) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *StarFolderListReq) GetCurrentUserId() (v int64) {
+	return p.CurrentUserId
 }
-func (p *ShareActionReq) SetUserId(val int64) {
+func (p *StarFolderListReq) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *ShareActionReq) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *StarFolderListReq) SetCurrentUserId(val int64) {
+	p.CurrentUserId = val
 }
 
-func (p *ShareActionReq) String() string {
+func (p *StarFolderListReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ShareActionReq(%+v)", *p)
+	return fmt.Sprintf("StarFolderListReq(%+v)", *p)
 }
 
-var fieldIDToName_ShareActionReq = map[int16]string{
+var fieldIDToName_StarFolderListReq = map[int16]string{
 	1: "userId",
-	2: "videoId",
+	2: "currentUserId",
 }
 
-type ShareActionResp struct {
+type StarFolderListResp struct {
 	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Folders  []*StarFolder    `thrift:"folders,2" frugal:"2,default,list<StarFolder>" json:"folders"`
 }
 
-func NewShareActionResp() *ShareActionResp {
-	return &ShareActionResp{}
+func NewStarFolderListResp() *StarFolderListResp {
+	return &StarFolderListResp{}
 }
 
-func (p *ShareActionResp) InitDefault() {
+func (p *StarFolderListResp) InitDefault() {
 }
 
-var ShareActionResp_BaseResp_DEFAULT *common.BaseResp
+var StarFolderListResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *ShareActionResp) GetBaseResp() (v *common.BaseResp) {
+func (p *StarFolderListResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return ShareActionResp_BaseResp_DEFAULT
+		return StarFolderListResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ShareActionResp) SetBaseResp(val *common.BaseResp) {
+
+func (p *StarFolderListResp) GetFolders() (v []*StarFolder) {
+	return p.Folders
+}
+func (p *StarFolderListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
+func (p *StarFolderListResp) SetFolders(val []*StarFolder) {
+	p.Folders = val
+}
 
-func (p *ShareActionResp) IsSetBaseResp() bool {
+func (p *StarFolderListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ShareActionResp) String() string {
+func (p *StarFolderListResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ShareActionResp(%+v)", *p)
+	return fmt.Sprintf("StarFolderListResp(%+v)", *p)
 }
 
-var fieldIDToName_ShareActionResp = map[int16]string{
+var fieldIDToName_StarFolderListResp = map[int16]string{
 	1: "BaseResp",
+	2: "folders",
 }
 
-type CountReq struct {
-	VideoId int64 `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+type StarFolderItemActionReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	FolderId int64 `thrift:"folderId,2" frugal:"2,default,i64" json:"folderId"`
+	VideoId  int64 `thrift:"videoId,3" frugal:"3,default,i64" json:"videoId"`
+	Action   bool  `thrift:"action,4" frugal:"4,default,bool" json:"action"`
 }
 
-func NewCountReq() *CountReq {
-	return &CountReq{}
+func NewStarFolderItemActionReq() *StarFolderItemActionReq {
+	return &StarFolderItemActionReq{}
 }
 
-func (p *CountReq) InitDefault() {
+func (p *StarFolderItemActionReq) InitDefault() {
 }
 
-func (p *CountReq) GetVideoId() (v int64) {
+func (p *StarFolderItemActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *StarFolderItemActionReq) GetFolderId() (v int64) {
+	return p.FolderId
+}
+
+func (p *StarFolderItemActionReq) GetVideoId() (v int64) {
 	return p.VideoId
 }
-func (p *CountReq) SetVideoId(val int64) {
+
+func (p *StarFolderItemActionReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *StarFolderItemActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *StarFolderItemActionReq) SetFolderId(val int64) {
+	p.FolderId = val
+}
+func (p *StarFolderItemActionReq) SetVideoId(val int64) {
 	p.VideoId = val
 }
+func (p *StarFolderItemActionReq) SetAction(val bool) {
+	p.Action = val
+}
 
-func (p *CountReq) String() string {
+func (p *StarFolderItemActionReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CountReq(%+v)", *p)
+	return fmt.Sprintf("StarFolderItemActionReq(%+v)", *p)
 }
 
-var fieldIDToName_CountReq = map[int16]string{
-	1: "videoId",
+var fieldIDToName_StarFolderItemActionReq = map[int16]string{
+	1: "userId",
+	2: "folderId",
+	3: "videoId",
+	4: "action",
 }
 
-type CountResp struct {
-	BaseResp     *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	LikeCount    int64            `thrift:"likeCount,2" frugal:"2,default,i64" json:"likeCount"`
-	CommentCount int64            `thrift:"commentCount,3" frugal:"3,default,i64" json:"commentCount"`
-	StarCount    int64            `thrift:"starCount,4" frugal:"4,default,i64" json:"starCount"`
-	ShareCount   int64            `thrift:"shareCount,5" frugal:"5,default,i64" json:"shareCount"`
+type StarFolderItemActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 }
 
-func NewCountResp() *CountResp {
-	return &CountResp{}
+func NewStarFolderItemActionResp() *StarFolderItemActionResp {
+	return &StarFolderItemActionResp{}
 }
 
-func (p *CountResp) InitDefault() {
+func (p *StarFolderItemActionResp) InitDefault() {
 }
 
-var CountResp_BaseResp_DEFAULT *common.BaseResp
+var StarFolderItemActionResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CountResp) GetBaseResp() (v *common.BaseResp) {
+func (p *StarFolderItemActionResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CountResp_BaseResp_DEFAULT
+		return StarFolderItemActionResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
+func (p *StarFolderItemActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
 
-func (p *CountResp) GetLikeCount() (v int64) {
-	return p.LikeCount
+func (p *StarFolderItemActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *CountResp) GetCommentCount() (v int64) {
-	return p.CommentCount
+func (p *StarFolderItemActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StarFolderItemActionResp(%+v)", *p)
 }
 
-func (p *CountResp) GetStarCount() (v int64) {
-	return p.StarCount
+var fieldIDToName_StarFolderItemActionResp = map[int16]string{
+	1: "BaseResp",
 }
 
-func (p *CountResp) GetShareCount() (v int64) {
-	return p.ShareCount
+type MoveStarVideoReq struct {
+	UserId       int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId      int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	FromFolderId int64 `thrift:"fromFolderId,3" frugal:"3,default,i64" json:"fromFolderId"`
+	ToFolderId   int64 `thrift:"toFolderId,4" frugal:"4,default,i64" json:"toFolderId"`
 }
-func (p *CountResp) SetBaseResp(val *common.BaseResp) {
-	p.BaseResp = val
+
+func NewMoveStarVideoReq() *MoveStarVideoReq {
+	return &MoveStarVideoReq{}
 }
-func (p *CountResp) SetLikeCount(val int64) {
-	p.LikeCount = val
+
+func (p *MoveStarVideoReq) InitDefault() {
 }
-func (p *CountResp) SetCommentCount(val int64) {
-	p.CommentCount = val
+
+func (p *MoveStarVideoReq) GetUserId() (v int64) {
+	return p.UserId
 }
-func (p *CountResp) SetStarCount(val int64) {
-	p.StarCount = val
+
+func (p *MoveStarVideoReq) GetVideoId() (v int64) {
+	return p.VideoId
 }
-func (p *CountResp) SetShareCount(val int64) {
-	p.ShareCount = val
+
+func (p *MoveStarVideoReq) GetFromFolderId() (v int64) {
+	return p.FromFolderId
 }
 
-func (p *CountResp) IsSetBaseResp() bool {
+func (p *MoveStarVideoReq) GetToFolderId() (v int64) {
+	return p.ToFolderId
+}
+func (p *MoveStarVideoReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *MoveStarVideoReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *MoveStarVideoReq) SetFromFolderId(val int64) {
+	p.FromFolderId = val
+}
+func (p *MoveStarVideoReq) SetToFolderId(val int64) {
+	p.ToFolderId = val
+}
+
+func (p *MoveStarVideoReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MoveStarVideoReq(%+v)", *p)
+}
+
+var fieldIDToName_MoveStarVideoReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "fromFolderId",
+	4: "toFolderId",
+}
+
+type MoveStarVideoResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewMoveStarVideoResp() *MoveStarVideoResp {
+	return &MoveStarVideoResp{}
+}
+
+func (p *MoveStarVideoResp) InitDefault() {
+}
+
+var MoveStarVideoResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *MoveStarVideoResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return MoveStarVideoResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *MoveStarVideoResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *MoveStarVideoResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CountResp) String() string {
+func (p *MoveStarVideoResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CountResp(%+v)", *p)
+	return fmt.Sprintf("MoveStarVideoResp(%+v)", *p)
 }
 
-var fieldIDToName_CountResp = map[int16]string{
+var fieldIDToName_MoveStarVideoResp = map[int16]string{
 	1: "BaseResp",
-	2: "likeCount",
-	3: "commentCount",
-	4: "starCount",
-	5: "shareCount",
 }
 
-type CheckLikeStatusReq struct {
-	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+type StarFolderFollowReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	FolderId int64 `thrift:"folderId,2" frugal:"2,default,i64" json:"folderId"`
+	Action   bool  `thrift:"action,3" frugal:"3,default,bool" json:"action"`
 }
 
-func NewCheckLikeStatusReq() *CheckLikeStatusReq {
-	return &CheckLikeStatusReq{}
+func NewStarFolderFollowReq() *StarFolderFollowReq {
+	return &StarFolderFollowReq{}
 }
 
-func (p *CheckLikeStatusReq) InitDefault() {
+func (p *StarFolderFollowReq) InitDefault() {
 }
 
-func (p *CheckLikeStatusReq) GetUserId() (v int64) {
+func (p *StarFolderFollowReq) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *CheckLikeStatusReq) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *StarFolderFollowReq) GetFolderId() (v int64) {
+	return p.FolderId
 }
-func (p *CheckLikeStatusReq) SetUserId(val int64) {
+
+func (p *StarFolderFollowReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *StarFolderFollowReq) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *CheckLikeStatusReq) SetVideoId(val int64) {
-	p.VideoId = val
+func (p *StarFolderFollowReq) SetFolderId(val int64) {
+	p.FolderId = val
+}
+func (p *StarFolderFollowReq) SetAction(val bool) {
+	p.Action = val
 }
 
-func (p *CheckLikeStatusReq) String() string {
+func (p *StarFolderFollowReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckLikeStatusReq(%+v)", *p)
+	return fmt.Sprintf("StarFolderFollowReq(%+v)", *p)
 }
 
-var fieldIDToName_CheckLikeStatusReq = map[int16]string{
+var fieldIDToName_StarFolderFollowReq = map[int16]string{
 	1: "userId",
-	2: "videoId",
+	2: "folderId",
+	3: "action",
 }
 
-type CheckLikeStatusResp struct {
+type StarFolderFollowResp struct {
 	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	IsLiked  bool             `thrift:"isLiked,2" frugal:"2,default,bool" json:"isLiked"`
 }
 
-func NewCheckLikeStatusResp() *CheckLikeStatusResp {
-	return &CheckLikeStatusResp{}
+func NewStarFolderFollowResp() *StarFolderFollowResp {
+	return &StarFolderFollowResp{}
 }
 
-func (p *CheckLikeStatusResp) InitDefault() {
+func (p *StarFolderFollowResp) InitDefault() {
 }
 
-var CheckLikeStatusResp_BaseResp_DEFAULT *common.BaseResp
+var StarFolderFollowResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *CheckLikeStatusResp) GetBaseResp() (v *common.BaseResp) {
+func (p *StarFolderFollowResp) GetBaseResp() (v *common.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return CheckLikeStatusResp_BaseResp_DEFAULT
+		return StarFolderFollowResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *StarFolderFollowResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *StarFolderFollowResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *StarFolderFollowResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StarFolderFollowResp(%+v)", *p)
+}
+
+var fieldIDToName_StarFolderFollowResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type FollowedStarFolderListReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewFollowedStarFolderListReq() *FollowedStarFolderListReq {
+	return &FollowedStarFolderListReq{}
+}
+
+func (p *FollowedStarFolderListReq) InitDefault() {
+}
+
+func (p *FollowedStarFolderListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *FollowedStarFolderListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *FollowedStarFolderListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowedStarFolderListReq(%+v)", *p)
+}
+
+var fieldIDToName_FollowedStarFolderListReq = map[int16]string{
+	1: "userId",
+}
+
+type FollowedStarFolderListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Folders  []*StarFolder    `thrift:"folders,2" frugal:"2,default,list<StarFolder>" json:"folders"`
+}
+
+func NewFollowedStarFolderListResp() *FollowedStarFolderListResp {
+	return &FollowedStarFolderListResp{}
+}
+
+func (p *FollowedStarFolderListResp) InitDefault() {
+}
+
+var FollowedStarFolderListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *FollowedStarFolderListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return FollowedStarFolderListResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-func (p *CheckLikeStatusResp) GetIsLiked() (v bool) {
-	return p.IsLiked
+func (p *FollowedStarFolderListResp) GetFolders() (v []*StarFolder) {
+	return p.Folders
+}
+func (p *FollowedStarFolderListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *FollowedStarFolderListResp) SetFolders(val []*StarFolder) {
+	p.Folders = val
+}
+
+func (p *FollowedStarFolderListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *FollowedStarFolderListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowedStarFolderListResp(%+v)", *p)
+}
+
+var fieldIDToName_FollowedStarFolderListResp = map[int16]string{
+	1: "BaseResp",
+	2: "folders",
+}
+
+type CommentActionReq struct {
+	UserId    int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId   int64  `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Content   string `thrift:"content,3" frugal:"3,default,string" json:"content"`
+	ReplyToId *int64 `thrift:"replyToId,4,optional" frugal:"4,optional,i64" json:"replyToId,omitempty"`
+}
+
+func NewCommentActionReq() *CommentActionReq {
+	return &CommentActionReq{}
+}
+
+func (p *CommentActionReq) InitDefault() {
+}
+
+func (p *CommentActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CommentActionReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CommentActionReq) GetContent() (v string) {
+	return p.Content
+}
+
+var CommentActionReq_ReplyToId_DEFAULT int64
+
+func (p *CommentActionReq) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return CommentActionReq_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
+}
+func (p *CommentActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CommentActionReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CommentActionReq) SetContent(val string) {
+	p.Content = val
+}
+func (p *CommentActionReq) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
+
+func (p *CommentActionReq) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
+
+func (p *CommentActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentActionReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentActionReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "content",
+	4: "replyToId",
+}
+
+type CommentActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Comment  *common.Comment  `thrift:"comment,2" frugal:"2,default,common.Comment" json:"comment"`
+}
+
+func NewCommentActionResp() *CommentActionResp {
+	return &CommentActionResp{}
+}
+
+func (p *CommentActionResp) InitDefault() {
+}
+
+var CommentActionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CommentActionResp_Comment_DEFAULT *common.Comment
+
+func (p *CommentActionResp) GetComment() (v *common.Comment) {
+	if !p.IsSetComment() {
+		return CommentActionResp_Comment_DEFAULT
+	}
+	return p.Comment
+}
+func (p *CommentActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CommentActionResp) SetComment(val *common.Comment) {
+	p.Comment = val
+}
+
+func (p *CommentActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentActionResp) IsSetComment() bool {
+	return p.Comment != nil
+}
+
+func (p *CommentActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentActionResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentActionResp = map[int16]string{
+	1: "BaseResp",
+	2: "comment",
+}
+
+type CommentListReq struct {
+	VideoId       int64   `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
+	Sort          *string `thrift:"sort,7,optional" frugal:"7,optional,string" json:"sort,omitempty"`
+}
+
+func NewCommentListReq() *CommentListReq {
+	return &CommentListReq{}
+}
+
+func (p *CommentListReq) InitDefault() {
+}
+
+func (p *CommentListReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CommentListReq) GetCurrentUserId() (v int64) {
+	return p.CurrentUserId
+}
+
+func (p *CommentListReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *CommentListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var CommentListReq_Cursor_DEFAULT string
+
+func (p *CommentListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return CommentListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var CommentListReq_NeedTotal_DEFAULT bool
+
+func (p *CommentListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return CommentListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
+
+var CommentListReq_Sort_DEFAULT string
+
+func (p *CommentListReq) GetSort() (v string) {
+	if !p.IsSetSort() {
+		return CommentListReq_Sort_DEFAULT
+	}
+	return *p.Sort
+}
+func (p *CommentListReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CommentListReq) SetCurrentUserId(val int64) {
+	p.CurrentUserId = val
+}
+func (p *CommentListReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *CommentListReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *CommentListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *CommentListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+func (p *CommentListReq) SetSort(val *string) {
+	p.Sort = val
+}
+
+func (p *CommentListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *CommentListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
+
+func (p *CommentListReq) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *CommentListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentListReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentListReq = map[int16]string{
+	1: "videoId",
+	2: "currentUserId",
+	3: "page",
+	4: "pageSize",
+	5: "cursor",
+	6: "needTotal",
+	7: "sort",
+}
+
+type CommentListResp struct {
+	BaseResp      *common.BaseResp            `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Comments      []*common.Comment           `thrift:"comments,2" frugal:"2,default,list<common.Comment>" json:"comments"`
+	TotalCount    int32                       `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor    *string                     `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore       bool                        `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
+	ReplyPreviews map[int64][]*common.Comment `thrift:"replyPreviews,6" frugal:"6,default,map<i64:list<common.Comment>>" json:"replyPreviews"`
+	PinnedComment *common.Comment             `thrift:"pinnedComment,7,optional" frugal:"7,optional,common.Comment" json:"pinnedComment,omitempty"`
+}
+
+func NewCommentListResp() *CommentListResp {
+	return &CommentListResp{}
+}
+
+func (p *CommentListResp) InitDefault() {
+}
+
+var CommentListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CommentListResp) GetComments() (v []*common.Comment) {
+	return p.Comments
+}
+
+func (p *CommentListResp) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+
+var CommentListResp_NextCursor_DEFAULT string
+
+func (p *CommentListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return CommentListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *CommentListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *CommentListResp) GetReplyPreviews() (v map[int64][]*common.Comment) {
+	return p.ReplyPreviews
+}
+
+var CommentListResp_PinnedComment_DEFAULT *common.Comment
+
+func (p *CommentListResp) GetPinnedComment() (v *common.Comment) {
+	if !p.IsSetPinnedComment() {
+		return CommentListResp_PinnedComment_DEFAULT
+	}
+	return p.PinnedComment
+}
+func (p *CommentListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CommentListResp) SetComments(val []*common.Comment) {
+	p.Comments = val
+}
+func (p *CommentListResp) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+func (p *CommentListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *CommentListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *CommentListResp) SetReplyPreviews(val map[int64][]*common.Comment) {
+	p.ReplyPreviews = val
+}
+func (p *CommentListResp) SetPinnedComment(val *common.Comment) {
+	p.PinnedComment = val
+}
+
+func (p *CommentListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *CommentListResp) IsSetPinnedComment() bool {
+	return p.PinnedComment != nil
+}
+
+func (p *CommentListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentListResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentListResp = map[int16]string{
+	1: "BaseResp",
+	2: "comments",
+	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
+	6: "replyPreviews",
+	7: "pinnedComment",
+}
+
+type CommentRepliesReq struct {
+	CommentId     int64   `thrift:"commentId,1" frugal:"1,default,i64" json:"commentId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	PageSize      int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,5,optional" frugal:"5,optional,bool" json:"needTotal,omitempty"`
+}
+
+func NewCommentRepliesReq() *CommentRepliesReq {
+	return &CommentRepliesReq{}
+}
+
+func (p *CommentRepliesReq) InitDefault() {
+}
+
+func (p *CommentRepliesReq) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentRepliesReq) GetCurrentUserId() (v int64) {
+	return p.CurrentUserId
+}
+
+func (p *CommentRepliesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var CommentRepliesReq_Cursor_DEFAULT string
+
+func (p *CommentRepliesReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return CommentRepliesReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var CommentRepliesReq_NeedTotal_DEFAULT bool
+
+func (p *CommentRepliesReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return CommentRepliesReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
+func (p *CommentRepliesReq) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentRepliesReq) SetCurrentUserId(val int64) {
+	p.CurrentUserId = val
+}
+func (p *CommentRepliesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *CommentRepliesReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *CommentRepliesReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *CommentRepliesReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *CommentRepliesReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
+
+func (p *CommentRepliesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentRepliesReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentRepliesReq = map[int16]string{
+	1: "commentId",
+	2: "currentUserId",
+	3: "pageSize",
+	4: "cursor",
+	5: "needTotal",
+}
+
+type CommentRepliesResp struct {
+	BaseResp   *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Comments   []*common.Comment `thrift:"comments,2" frugal:"2,default,list<common.Comment>" json:"comments"`
+	TotalCount int32             `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string           `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool              `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
+}
+
+func NewCommentRepliesResp() *CommentRepliesResp {
+	return &CommentRepliesResp{}
+}
+
+func (p *CommentRepliesResp) InitDefault() {
+}
+
+var CommentRepliesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentRepliesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentRepliesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CommentRepliesResp) GetComments() (v []*common.Comment) {
+	return p.Comments
+}
+
+func (p *CommentRepliesResp) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+
+var CommentRepliesResp_NextCursor_DEFAULT string
+
+func (p *CommentRepliesResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return CommentRepliesResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *CommentRepliesResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *CommentRepliesResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CommentRepliesResp) SetComments(val []*common.Comment) {
+	p.Comments = val
+}
+func (p *CommentRepliesResp) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+func (p *CommentRepliesResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *CommentRepliesResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *CommentRepliesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentRepliesResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *CommentRepliesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentRepliesResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentRepliesResp = map[int16]string{
+	1: "BaseResp",
+	2: "comments",
+	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
+}
+
+type DeleteCommentReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId   int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	CommentId int64 `thrift:"commentId,3" frugal:"3,default,i64" json:"commentId"`
+}
+
+func NewDeleteCommentReq() *DeleteCommentReq {
+	return &DeleteCommentReq{}
+}
+
+func (p *DeleteCommentReq) InitDefault() {
+}
+
+func (p *DeleteCommentReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *DeleteCommentReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *DeleteCommentReq) GetCommentId() (v int64) {
+	return p.CommentId
+}
+func (p *DeleteCommentReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *DeleteCommentReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *DeleteCommentReq) SetCommentId(val int64) {
+	p.CommentId = val
+}
+
+func (p *DeleteCommentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCommentReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCommentReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "commentId",
+}
+
+type DeleteCommentResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteCommentResp() *DeleteCommentResp {
+	return &DeleteCommentResp{}
+}
+
+func (p *DeleteCommentResp) InitDefault() {
+}
+
+var DeleteCommentResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteCommentResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteCommentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteCommentResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteCommentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteCommentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCommentResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCommentResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type CommentLikeActionReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CommentId int64 `thrift:"commentId,2" frugal:"2,default,i64" json:"commentId"`
+	Action    bool  `thrift:"action,3" frugal:"3,default,bool" json:"action"`
+}
+
+func NewCommentLikeActionReq() *CommentLikeActionReq {
+	return &CommentLikeActionReq{}
+}
+
+func (p *CommentLikeActionReq) InitDefault() {
+}
+
+func (p *CommentLikeActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CommentLikeActionReq) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentLikeActionReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *CommentLikeActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CommentLikeActionReq) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentLikeActionReq) SetAction(val bool) {
+	p.Action = val
+}
+
+func (p *CommentLikeActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentLikeActionReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentLikeActionReq = map[int16]string{
+	1: "userId",
+	2: "commentId",
+	3: "action",
+}
+
+type CommentLikeActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCommentLikeActionResp() *CommentLikeActionResp {
+	return &CommentLikeActionResp{}
+}
+
+func (p *CommentLikeActionResp) InitDefault() {
+}
+
+var CommentLikeActionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentLikeActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentLikeActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CommentLikeActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CommentLikeActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentLikeActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentLikeActionResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentLikeActionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type PinCommentReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId   int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	CommentId int64 `thrift:"commentId,3" frugal:"3,default,i64" json:"commentId"`
+	Action    bool  `thrift:"action,4" frugal:"4,default,bool" json:"action"`
+}
+
+func NewPinCommentReq() *PinCommentReq {
+	return &PinCommentReq{}
+}
+
+func (p *PinCommentReq) InitDefault() {
+}
+
+func (p *PinCommentReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *PinCommentReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *PinCommentReq) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *PinCommentReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *PinCommentReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *PinCommentReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *PinCommentReq) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *PinCommentReq) SetAction(val bool) {
+	p.Action = val
+}
+
+func (p *PinCommentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PinCommentReq(%+v)", *p)
+}
+
+var fieldIDToName_PinCommentReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "commentId",
+	4: "action",
+}
+
+type PinCommentResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewPinCommentResp() *PinCommentResp {
+	return &PinCommentResp{}
+}
+
+func (p *PinCommentResp) InitDefault() {
+}
+
+var PinCommentResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *PinCommentResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return PinCommentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *PinCommentResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *PinCommentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *PinCommentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PinCommentResp(%+v)", *p)
+}
+
+var fieldIDToName_PinCommentResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type SetCommentPermissionReq struct {
+	UserId     int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId    int64  `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Permission string `thrift:"permission,3" frugal:"3,default,string" json:"permission"`
+}
+
+func NewSetCommentPermissionReq() *SetCommentPermissionReq {
+	return &SetCommentPermissionReq{}
+}
+
+func (p *SetCommentPermissionReq) InitDefault() {
+}
+
+func (p *SetCommentPermissionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetCommentPermissionReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *SetCommentPermissionReq) GetPermission() (v string) {
+	return p.Permission
+}
+func (p *SetCommentPermissionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetCommentPermissionReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *SetCommentPermissionReq) SetPermission(val string) {
+	p.Permission = val
+}
+
+func (p *SetCommentPermissionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetCommentPermissionReq(%+v)", *p)
+}
+
+var fieldIDToName_SetCommentPermissionReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "permission",
+}
+
+type SetCommentPermissionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewSetCommentPermissionResp() *SetCommentPermissionResp {
+	return &SetCommentPermissionResp{}
+}
+
+func (p *SetCommentPermissionResp) InitDefault() {
+}
+
+var SetCommentPermissionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SetCommentPermissionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SetCommentPermissionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SetCommentPermissionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SetCommentPermissionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SetCommentPermissionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetCommentPermissionResp(%+v)", *p)
+}
+
+var fieldIDToName_SetCommentPermissionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type GetCommentPermissionReq struct {
+	VideoId int64 `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+}
+
+func NewGetCommentPermissionReq() *GetCommentPermissionReq {
+	return &GetCommentPermissionReq{}
+}
+
+func (p *GetCommentPermissionReq) InitDefault() {
+}
+
+func (p *GetCommentPermissionReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *GetCommentPermissionReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *GetCommentPermissionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCommentPermissionReq(%+v)", *p)
+}
+
+var fieldIDToName_GetCommentPermissionReq = map[int16]string{
+	1: "videoId",
+}
+
+type GetCommentPermissionResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Permission string           `thrift:"permission,2" frugal:"2,default,string" json:"permission"`
+}
+
+func NewGetCommentPermissionResp() *GetCommentPermissionResp {
+	return &GetCommentPermissionResp{}
+}
+
+func (p *GetCommentPermissionResp) InitDefault() {
+}
+
+var GetCommentPermissionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetCommentPermissionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetCommentPermissionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetCommentPermissionResp) GetPermission() (v string) {
+	return p.Permission
+}
+func (p *GetCommentPermissionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *GetCommentPermissionResp) SetPermission(val string) {
+	p.Permission = val
+}
+
+func (p *GetCommentPermissionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetCommentPermissionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCommentPermissionResp(%+v)", *p)
+}
+
+var fieldIDToName_GetCommentPermissionResp = map[int16]string{
+	1: "BaseResp",
+	2: "permission",
+}
+
+type CommentKeywordActionReq struct {
+	UserId  int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Keyword string `thrift:"keyword,2" frugal:"2,default,string" json:"keyword"`
+	Action  bool   `thrift:"action,3" frugal:"3,default,bool" json:"action"`
+}
+
+func NewCommentKeywordActionReq() *CommentKeywordActionReq {
+	return &CommentKeywordActionReq{}
+}
+
+func (p *CommentKeywordActionReq) InitDefault() {
+}
+
+func (p *CommentKeywordActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CommentKeywordActionReq) GetKeyword() (v string) {
+	return p.Keyword
+}
+
+func (p *CommentKeywordActionReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *CommentKeywordActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CommentKeywordActionReq) SetKeyword(val string) {
+	p.Keyword = val
+}
+func (p *CommentKeywordActionReq) SetAction(val bool) {
+	p.Action = val
+}
+
+func (p *CommentKeywordActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentKeywordActionReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentKeywordActionReq = map[int16]string{
+	1: "userId",
+	2: "keyword",
+	3: "action",
+}
+
+type CommentKeywordActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCommentKeywordActionResp() *CommentKeywordActionResp {
+	return &CommentKeywordActionResp{}
+}
+
+func (p *CommentKeywordActionResp) InitDefault() {
+}
+
+var CommentKeywordActionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentKeywordActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentKeywordActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CommentKeywordActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CommentKeywordActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentKeywordActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentKeywordActionResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentKeywordActionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type CommentKeywordListReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewCommentKeywordListReq() *CommentKeywordListReq {
+	return &CommentKeywordListReq{}
+}
+
+func (p *CommentKeywordListReq) InitDefault() {
+}
+
+func (p *CommentKeywordListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *CommentKeywordListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *CommentKeywordListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentKeywordListReq(%+v)", *p)
+}
+
+var fieldIDToName_CommentKeywordListReq = map[int16]string{
+	1: "userId",
+}
+
+type CommentKeywordListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Keywords []string         `thrift:"keywords,2" frugal:"2,default,list<string>" json:"keywords"`
+}
+
+func NewCommentKeywordListResp() *CommentKeywordListResp {
+	return &CommentKeywordListResp{}
+}
+
+func (p *CommentKeywordListResp) InitDefault() {
+}
+
+var CommentKeywordListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CommentKeywordListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CommentKeywordListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CommentKeywordListResp) GetKeywords() (v []string) {
+	return p.Keywords
+}
+func (p *CommentKeywordListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CommentKeywordListResp) SetKeywords(val []string) {
+	p.Keywords = val
+}
+
+func (p *CommentKeywordListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CommentKeywordListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentKeywordListResp(%+v)", *p)
+}
+
+var fieldIDToName_CommentKeywordListResp = map[int16]string{
+	1: "BaseResp",
+	2: "keywords",
+}
+
+type PendingCommentListReq struct {
+	UserId     int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	PageSize   int32   `thrift:"pageSize,2" frugal:"2,default,i32" json:"pageSize"`
+	Cursor     *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
+	Moderation *bool   `thrift:"moderation,4,optional" frugal:"4,optional,bool" json:"moderation,omitempty"`
+}
+
+func NewPendingCommentListReq() *PendingCommentListReq {
+	return &PendingCommentListReq{}
+}
+
+func (p *PendingCommentListReq) InitDefault() {
+}
+
+func (p *PendingCommentListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *PendingCommentListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var PendingCommentListReq_Cursor_DEFAULT string
+
+func (p *PendingCommentListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return PendingCommentListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var PendingCommentListReq_Moderation_DEFAULT bool
+
+func (p *PendingCommentListReq) GetModeration() (v bool) {
+	if !p.IsSetModeration() {
+		return PendingCommentListReq_Moderation_DEFAULT
+	}
+	return *p.Moderation
+}
+func (p *PendingCommentListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *PendingCommentListReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *PendingCommentListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *PendingCommentListReq) SetModeration(val *bool) {
+	p.Moderation = val
+}
+
+func (p *PendingCommentListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *PendingCommentListReq) IsSetModeration() bool {
+	return p.Moderation != nil
+}
+
+func (p *PendingCommentListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PendingCommentListReq(%+v)", *p)
+}
+
+var fieldIDToName_PendingCommentListReq = map[int16]string{
+	1: "userId",
+	2: "pageSize",
+	3: "cursor",
+	4: "moderation",
+}
+
+type PendingCommentListResp struct {
+	BaseResp   *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Comments   []*common.Comment `thrift:"comments,2" frugal:"2,default,list<common.Comment>" json:"comments"`
+	NextCursor *string           `thrift:"nextCursor,3,optional" frugal:"3,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool              `thrift:"hasMore,4" frugal:"4,default,bool" json:"hasMore"`
+}
+
+func NewPendingCommentListResp() *PendingCommentListResp {
+	return &PendingCommentListResp{}
+}
+
+func (p *PendingCommentListResp) InitDefault() {
+}
+
+var PendingCommentListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *PendingCommentListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return PendingCommentListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *PendingCommentListResp) GetComments() (v []*common.Comment) {
+	return p.Comments
+}
+
+var PendingCommentListResp_NextCursor_DEFAULT string
+
+func (p *PendingCommentListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return PendingCommentListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *PendingCommentListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *PendingCommentListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *PendingCommentListResp) SetComments(val []*common.Comment) {
+	p.Comments = val
+}
+func (p *PendingCommentListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *PendingCommentListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *PendingCommentListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *PendingCommentListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *PendingCommentListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PendingCommentListResp(%+v)", *p)
+}
+
+var fieldIDToName_PendingCommentListResp = map[int16]string{
+	1: "BaseResp",
+	2: "comments",
+	3: "nextCursor",
+	4: "hasMore",
+}
+
+type ReviewCommentReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CommentId int64 `thrift:"commentId,2" frugal:"2,default,i64" json:"commentId"`
+	Approve   bool  `thrift:"approve,3" frugal:"3,default,bool" json:"approve"`
+}
+
+func NewReviewCommentReq() *ReviewCommentReq {
+	return &ReviewCommentReq{}
+}
+
+func (p *ReviewCommentReq) InitDefault() {
+}
+
+func (p *ReviewCommentReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ReviewCommentReq) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *ReviewCommentReq) GetApprove() (v bool) {
+	return p.Approve
+}
+func (p *ReviewCommentReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ReviewCommentReq) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *ReviewCommentReq) SetApprove(val bool) {
+	p.Approve = val
+}
+
+func (p *ReviewCommentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewCommentReq(%+v)", *p)
+}
+
+var fieldIDToName_ReviewCommentReq = map[int16]string{
+	1: "userId",
+	2: "commentId",
+	3: "approve",
+}
+
+type ReviewCommentResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewReviewCommentResp() *ReviewCommentResp {
+	return &ReviewCommentResp{}
+}
+
+func (p *ReviewCommentResp) InitDefault() {
+}
+
+var ReviewCommentResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReviewCommentResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReviewCommentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ReviewCommentResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ReviewCommentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReviewCommentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewCommentResp(%+v)", *p)
+}
+
+var fieldIDToName_ReviewCommentResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type ShareActionReq struct {
+	UserId  int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64   `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Channel *string `thrift:"channel,3,optional" frugal:"3,optional,string" json:"channel,omitempty"`
+}
+
+func NewShareActionReq() *ShareActionReq {
+	return &ShareActionReq{}
+}
+
+func (p *ShareActionReq) InitDefault() {
+}
+
+func (p *ShareActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ShareActionReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var ShareActionReq_Channel_DEFAULT string
+
+func (p *ShareActionReq) GetChannel() (v string) {
+	if !p.IsSetChannel() {
+		return ShareActionReq_Channel_DEFAULT
+	}
+	return *p.Channel
+}
+func (p *ShareActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ShareActionReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ShareActionReq) SetChannel(val *string) {
+	p.Channel = val
+}
+
+func (p *ShareActionReq) IsSetChannel() bool {
+	return p.Channel != nil
+}
+
+func (p *ShareActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareActionReq(%+v)", *p)
+}
+
+var fieldIDToName_ShareActionReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "channel",
+}
+
+type ShareActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewShareActionResp() *ShareActionResp {
+	return &ShareActionResp{}
+}
+
+func (p *ShareActionResp) InitDefault() {
+}
+
+var ShareActionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ShareActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ShareActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ShareActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ShareActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ShareActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareActionResp(%+v)", *p)
+}
+
+var fieldIDToName_ShareActionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type CreateShareLinkReq struct {
+	UserId  int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64  `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Channel string `thrift:"channel,3" frugal:"3,default,string" json:"channel"`
+}
+
+func NewCreateShareLinkReq() *CreateShareLinkReq {
+	return &CreateShareLinkReq{}
+}
+
+func (p *CreateShareLinkReq) InitDefault() {
+}
+
+func (p *CreateShareLinkReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateShareLinkReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CreateShareLinkReq) GetChannel() (v string) {
+	return p.Channel
+}
+func (p *CreateShareLinkReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateShareLinkReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CreateShareLinkReq) SetChannel(val string) {
+	p.Channel = val
+}
+
+func (p *CreateShareLinkReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShareLinkReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateShareLinkReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "channel",
+}
+
+type CreateShareLinkResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Code     string           `thrift:"code,2" frugal:"2,default,string" json:"code"`
+	Channel  string           `thrift:"channel,3" frugal:"3,default,string" json:"channel"`
+}
+
+func NewCreateShareLinkResp() *CreateShareLinkResp {
+	return &CreateShareLinkResp{}
+}
+
+func (p *CreateShareLinkResp) InitDefault() {
+}
+
+var CreateShareLinkResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateShareLinkResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateShareLinkResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CreateShareLinkResp) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *CreateShareLinkResp) GetChannel() (v string) {
+	return p.Channel
+}
+func (p *CreateShareLinkResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CreateShareLinkResp) SetCode(val string) {
+	p.Code = val
+}
+func (p *CreateShareLinkResp) SetChannel(val string) {
+	p.Channel = val
+}
+
+func (p *CreateShareLinkResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateShareLinkResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShareLinkResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateShareLinkResp = map[int16]string{
+	1: "BaseResp",
+	2: "code",
+	3: "channel",
+}
+
+type ResolveShareLinkReq struct {
+	Code      string `thrift:"code,1" frugal:"1,default,string" json:"code"`
+	VisitorId int64  `thrift:"visitorId,2" frugal:"2,default,i64" json:"visitorId"`
+}
+
+func NewResolveShareLinkReq() *ResolveShareLinkReq {
+	return &ResolveShareLinkReq{}
+}
+
+func (p *ResolveShareLinkReq) InitDefault() {
+}
+
+func (p *ResolveShareLinkReq) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ResolveShareLinkReq) GetVisitorId() (v int64) {
+	return p.VisitorId
+}
+func (p *ResolveShareLinkReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *ResolveShareLinkReq) SetVisitorId(val int64) {
+	p.VisitorId = val
+}
+
+func (p *ResolveShareLinkReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveShareLinkReq(%+v)", *p)
+}
+
+var fieldIDToName_ResolveShareLinkReq = map[int16]string{
+	1: "code",
+	2: "visitorId",
+}
+
+type ResolveShareLinkResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	VideoId  int64            `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	SharerId int64            `thrift:"sharerId,3" frugal:"3,default,i64" json:"sharerId"`
+	Channel  string           `thrift:"channel,4" frugal:"4,default,string" json:"channel"`
+}
+
+func NewResolveShareLinkResp() *ResolveShareLinkResp {
+	return &ResolveShareLinkResp{}
+}
+
+func (p *ResolveShareLinkResp) InitDefault() {
+}
+
+var ResolveShareLinkResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ResolveShareLinkResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ResolveShareLinkResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ResolveShareLinkResp) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *ResolveShareLinkResp) GetSharerId() (v int64) {
+	return p.SharerId
+}
+
+func (p *ResolveShareLinkResp) GetChannel() (v string) {
+	return p.Channel
+}
+func (p *ResolveShareLinkResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *ResolveShareLinkResp) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ResolveShareLinkResp) SetSharerId(val int64) {
+	p.SharerId = val
+}
+func (p *ResolveShareLinkResp) SetChannel(val string) {
+	p.Channel = val
+}
+
+func (p *ResolveShareLinkResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ResolveShareLinkResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveShareLinkResp(%+v)", *p)
+}
+
+var fieldIDToName_ResolveShareLinkResp = map[int16]string{
+	1: "BaseResp",
+	2: "videoId",
+	3: "sharerId",
+	4: "channel",
+}
+
+type ShareConversionReq struct {
+	Code      string `thrift:"code,1" frugal:"1,default,string" json:"code"`
+	VisitorId int64  `thrift:"visitorId,2" frugal:"2,default,i64" json:"visitorId"`
+	Event     string `thrift:"event,3" frugal:"3,default,string" json:"event"`
+}
+
+func NewShareConversionReq() *ShareConversionReq {
+	return &ShareConversionReq{}
+}
+
+func (p *ShareConversionReq) InitDefault() {
+}
+
+func (p *ShareConversionReq) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ShareConversionReq) GetVisitorId() (v int64) {
+	return p.VisitorId
+}
+
+func (p *ShareConversionReq) GetEvent() (v string) {
+	return p.Event
+}
+func (p *ShareConversionReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *ShareConversionReq) SetVisitorId(val int64) {
+	p.VisitorId = val
+}
+func (p *ShareConversionReq) SetEvent(val string) {
+	p.Event = val
+}
+
+func (p *ShareConversionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareConversionReq(%+v)", *p)
+}
+
+var fieldIDToName_ShareConversionReq = map[int16]string{
+	1: "code",
+	2: "visitorId",
+	3: "event",
+}
+
+type ShareConversionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewShareConversionResp() *ShareConversionResp {
+	return &ShareConversionResp{}
+}
+
+func (p *ShareConversionResp) InitDefault() {
+}
+
+var ShareConversionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ShareConversionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ShareConversionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ShareConversionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ShareConversionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ShareConversionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareConversionResp(%+v)", *p)
+}
+
+var fieldIDToName_ShareConversionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type ShareChannelStats struct {
+	Channel      string `thrift:"channel,1" frugal:"1,default,string" json:"channel"`
+	ShareCount   int64  `thrift:"shareCount,2" frugal:"2,default,i64" json:"shareCount"`
+	ClickCount   int64  `thrift:"clickCount,3" frugal:"3,default,i64" json:"clickCount"`
+	ViewCount    int64  `thrift:"viewCount,4" frugal:"4,default,i64" json:"viewCount"`
+	InstallCount int64  `thrift:"installCount,5" frugal:"5,default,i64" json:"installCount"`
+}
+
+func NewShareChannelStats() *ShareChannelStats {
+	return &ShareChannelStats{}
+}
+
+func (p *ShareChannelStats) InitDefault() {
+}
+
+func (p *ShareChannelStats) GetChannel() (v string) {
+	return p.Channel
+}
+
+func (p *ShareChannelStats) GetShareCount() (v int64) {
+	return p.ShareCount
+}
+
+func (p *ShareChannelStats) GetClickCount() (v int64) {
+	return p.ClickCount
+}
+
+func (p *ShareChannelStats) GetViewCount() (v int64) {
+	return p.ViewCount
+}
+
+func (p *ShareChannelStats) GetInstallCount() (v int64) {
+	return p.InstallCount
+}
+func (p *ShareChannelStats) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *ShareChannelStats) SetShareCount(val int64) {
+	p.ShareCount = val
+}
+func (p *ShareChannelStats) SetClickCount(val int64) {
+	p.ClickCount = val
+}
+func (p *ShareChannelStats) SetViewCount(val int64) {
+	p.ViewCount = val
+}
+func (p *ShareChannelStats) SetInstallCount(val int64) {
+	p.InstallCount = val
+}
+
+func (p *ShareChannelStats) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareChannelStats(%+v)", *p)
+}
+
+var fieldIDToName_ShareChannelStats = map[int16]string{
+	1: "channel",
+	2: "shareCount",
+	3: "clickCount",
+	4: "viewCount",
+	5: "installCount",
+}
+
+type ShareStatsReq struct {
+	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+}
+
+func NewShareStatsReq() *ShareStatsReq {
+	return &ShareStatsReq{}
+}
+
+func (p *ShareStatsReq) InitDefault() {
+}
+
+func (p *ShareStatsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ShareStatsReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *ShareStatsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ShareStatsReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *ShareStatsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareStatsReq(%+v)", *p)
+}
+
+var fieldIDToName_ShareStatsReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+}
+
+type ShareStatsResp struct {
+	BaseResp *common.BaseResp     `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Channels []*ShareChannelStats `thrift:"channels,2" frugal:"2,default,list<ShareChannelStats>" json:"channels"`
+}
+
+func NewShareStatsResp() *ShareStatsResp {
+	return &ShareStatsResp{}
+}
+
+func (p *ShareStatsResp) InitDefault() {
+}
+
+var ShareStatsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ShareStatsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ShareStatsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ShareStatsResp) GetChannels() (v []*ShareChannelStats) {
+	return p.Channels
+}
+func (p *ShareStatsResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *ShareStatsResp) SetChannels(val []*ShareChannelStats) {
+	p.Channels = val
+}
+
+func (p *ShareStatsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ShareStatsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareStatsResp(%+v)", *p)
+}
+
+var fieldIDToName_ShareStatsResp = map[int16]string{
+	1: "BaseResp",
+	2: "channels",
+}
+
+type InvitedByReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewInvitedByReq() *InvitedByReq {
+	return &InvitedByReq{}
+}
+
+func (p *InvitedByReq) InitDefault() {
+}
+
+func (p *InvitedByReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *InvitedByReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *InvitedByReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvitedByReq(%+v)", *p)
+}
+
+var fieldIDToName_InvitedByReq = map[int16]string{
+	1: "userId",
+}
+
+type InvitedByResp struct {
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Inviter   *common.User     `thrift:"inviter,2,optional" frugal:"2,optional,common.User" json:"inviter,omitempty"`
+	VideoId   int64            `thrift:"videoId,3" frugal:"3,default,i64" json:"videoId"`
+	Channel   string           `thrift:"channel,4" frugal:"4,default,string" json:"channel"`
+	InvitedAt int64            `thrift:"invitedAt,5" frugal:"5,default,i64" json:"invitedAt"`
+}
+
+func NewInvitedByResp() *InvitedByResp {
+	return &InvitedByResp{}
+}
+
+func (p *InvitedByResp) InitDefault() {
+}
+
+var InvitedByResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *InvitedByResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return InvitedByResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var InvitedByResp_Inviter_DEFAULT *common.User
+
+func (p *InvitedByResp) GetInviter() (v *common.User) {
+	if !p.IsSetInviter() {
+		return InvitedByResp_Inviter_DEFAULT
+	}
+	return p.Inviter
+}
+
+func (p *InvitedByResp) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *InvitedByResp) GetChannel() (v string) {
+	return p.Channel
+}
+
+func (p *InvitedByResp) GetInvitedAt() (v int64) {
+	return p.InvitedAt
+}
+func (p *InvitedByResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *InvitedByResp) SetInviter(val *common.User) {
+	p.Inviter = val
+}
+func (p *InvitedByResp) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *InvitedByResp) SetChannel(val string) {
+	p.Channel = val
+}
+func (p *InvitedByResp) SetInvitedAt(val int64) {
+	p.InvitedAt = val
+}
+
+func (p *InvitedByResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *InvitedByResp) IsSetInviter() bool {
+	return p.Inviter != nil
+}
+
+func (p *InvitedByResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvitedByResp(%+v)", *p)
+}
+
+var fieldIDToName_InvitedByResp = map[int16]string{
+	1: "BaseResp",
+	2: "inviter",
+	3: "videoId",
+	4: "channel",
+	5: "invitedAt",
+}
+
+type CountReq struct {
+	VideoId int64 `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+}
+
+func NewCountReq() *CountReq {
+	return &CountReq{}
+}
+
+func (p *CountReq) InitDefault() {
+}
+
+func (p *CountReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *CountReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *CountReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CountReq(%+v)", *p)
+}
+
+var fieldIDToName_CountReq = map[int16]string{
+	1: "videoId",
+}
+
+type CountResp struct {
+	BaseResp       *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	LikeCount      int64            `thrift:"likeCount,2" frugal:"2,default,i64" json:"likeCount"`
+	CommentCount   int64            `thrift:"commentCount,3" frugal:"3,default,i64" json:"commentCount"`
+	StarCount      int64            `thrift:"starCount,4" frugal:"4,default,i64" json:"starCount"`
+	ShareCount     int64            `thrift:"shareCount,5" frugal:"5,default,i64" json:"shareCount"`
+	ReactionCounts map[string]int64 `thrift:"reactionCounts,6" frugal:"6,default,map<string:i64>" json:"reactionCounts"`
+}
+
+func NewCountResp() *CountResp {
+	return &CountResp{}
+}
+
+func (p *CountResp) InitDefault() {
+}
+
+var CountResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CountResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CountResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CountResp) GetLikeCount() (v int64) {
+	return p.LikeCount
+}
+
+func (p *CountResp) GetCommentCount() (v int64) {
+	return p.CommentCount
+}
+
+func (p *CountResp) GetStarCount() (v int64) {
+	return p.StarCount
+}
+
+func (p *CountResp) GetShareCount() (v int64) {
+	return p.ShareCount
+}
+
+func (p *CountResp) GetReactionCounts() (v map[string]int64) {
+	return p.ReactionCounts
+}
+func (p *CountResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CountResp) SetLikeCount(val int64) {
+	p.LikeCount = val
+}
+func (p *CountResp) SetCommentCount(val int64) {
+	p.CommentCount = val
+}
+func (p *CountResp) SetStarCount(val int64) {
+	p.StarCount = val
+}
+func (p *CountResp) SetShareCount(val int64) {
+	p.ShareCount = val
+}
+func (p *CountResp) SetReactionCounts(val map[string]int64) {
+	p.ReactionCounts = val
+}
+
+func (p *CountResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CountResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CountResp(%+v)", *p)
+}
+
+var fieldIDToName_CountResp = map[int16]string{
+	1: "BaseResp",
+	2: "likeCount",
+	3: "commentCount",
+	4: "starCount",
+	5: "shareCount",
+	6: "reactionCounts",
+}
+
+type ReactionActionReq struct {
+	UserId   int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId  int64  `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Reaction string `thrift:"reaction,3" frugal:"3,default,string" json:"reaction"`
+}
+
+func NewReactionActionReq() *ReactionActionReq {
+	return &ReactionActionReq{}
+}
+
+func (p *ReactionActionReq) InitDefault() {
+}
+
+func (p *ReactionActionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ReactionActionReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *ReactionActionReq) GetReaction() (v string) {
+	return p.Reaction
+}
+func (p *ReactionActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ReactionActionReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ReactionActionReq) SetReaction(val string) {
+	p.Reaction = val
+}
+
+func (p *ReactionActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactionActionReq(%+v)", *p)
+}
+
+var fieldIDToName_ReactionActionReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "reaction",
+}
+
+type ReactionActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewReactionActionResp() *ReactionActionResp {
+	return &ReactionActionResp{}
+}
+
+func (p *ReactionActionResp) InitDefault() {
+}
+
+var ReactionActionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReactionActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReactionActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ReactionActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ReactionActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReactionActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactionActionResp(%+v)", *p)
+}
+
+var fieldIDToName_ReactionActionResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type BatchGetReactionsReq struct {
+	UserId   int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoIds []int64 `thrift:"videoIds,2" frugal:"2,default,list<i64>" json:"videoIds"`
+}
+
+func NewBatchGetReactionsReq() *BatchGetReactionsReq {
+	return &BatchGetReactionsReq{}
+}
+
+func (p *BatchGetReactionsReq) InitDefault() {
+}
+
+func (p *BatchGetReactionsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *BatchGetReactionsReq) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *BatchGetReactionsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BatchGetReactionsReq) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+func (p *BatchGetReactionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetReactionsReq(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetReactionsReq = map[int16]string{
+	1: "userId",
+	2: "videoIds",
+}
+
+type BatchGetReactionsResp struct {
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Reactions map[int64]string `thrift:"reactions,2" frugal:"2,default,map<i64:string>" json:"reactions"`
+}
+
+func NewBatchGetReactionsResp() *BatchGetReactionsResp {
+	return &BatchGetReactionsResp{}
+}
+
+func (p *BatchGetReactionsResp) InitDefault() {
+}
+
+var BatchGetReactionsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *BatchGetReactionsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BatchGetReactionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BatchGetReactionsResp) GetReactions() (v map[int64]string) {
+	return p.Reactions
+}
+func (p *BatchGetReactionsResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *BatchGetReactionsResp) SetReactions(val map[int64]string) {
+	p.Reactions = val
+}
+
+func (p *BatchGetReactionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchGetReactionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetReactionsResp(%+v)", *p)
+}
+
+var fieldIDToName_BatchGetReactionsResp = map[int16]string{
+	1: "BaseResp",
+	2: "reactions",
+}
+
+type Reactor struct {
+	User      *common.User `thrift:"user,1" frugal:"1,default,common.User" json:"user"`
+	Reaction  string       `thrift:"reaction,2" frugal:"2,default,string" json:"reaction"`
+	ReactedAt int64        `thrift:"reactedAt,3" frugal:"3,default,i64" json:"reactedAt"`
+}
+
+func NewReactor() *Reactor {
+	return &Reactor{}
+}
+
+func (p *Reactor) InitDefault() {
+}
+
+var Reactor_User_DEFAULT *common.User
+
+func (p *Reactor) GetUser() (v *common.User) {
+	if !p.IsSetUser() {
+		return Reactor_User_DEFAULT
+	}
+	return p.User
+}
+
+func (p *Reactor) GetReaction() (v string) {
+	return p.Reaction
+}
+
+func (p *Reactor) GetReactedAt() (v int64) {
+	return p.ReactedAt
+}
+func (p *Reactor) SetUser(val *common.User) {
+	p.User = val
+}
+func (p *Reactor) SetReaction(val string) {
+	p.Reaction = val
+}
+func (p *Reactor) SetReactedAt(val int64) {
+	p.ReactedAt = val
+}
+
+func (p *Reactor) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *Reactor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Reactor(%+v)", *p)
+}
+
+var fieldIDToName_Reactor = map[int16]string{
+	1: "user",
+	2: "reaction",
+	3: "reactedAt",
+}
+
+type ReactionUserListReq struct {
+	VideoId  int64   `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+	Reaction *string `thrift:"reaction,2,optional" frugal:"2,optional,string" json:"reaction,omitempty"`
+	PageSize int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Cursor   *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+}
+
+func NewReactionUserListReq() *ReactionUserListReq {
+	return &ReactionUserListReq{}
+}
+
+func (p *ReactionUserListReq) InitDefault() {
+}
+
+func (p *ReactionUserListReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var ReactionUserListReq_Reaction_DEFAULT string
+
+func (p *ReactionUserListReq) GetReaction() (v string) {
+	if !p.IsSetReaction() {
+		return ReactionUserListReq_Reaction_DEFAULT
+	}
+	return *p.Reaction
+}
+
+func (p *ReactionUserListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var ReactionUserListReq_Cursor_DEFAULT string
+
+func (p *ReactionUserListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return ReactionUserListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+func (p *ReactionUserListReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ReactionUserListReq) SetReaction(val *string) {
+	p.Reaction = val
+}
+func (p *ReactionUserListReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ReactionUserListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+
+func (p *ReactionUserListReq) IsSetReaction() bool {
+	return p.Reaction != nil
+}
+
+func (p *ReactionUserListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *ReactionUserListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactionUserListReq(%+v)", *p)
+}
+
+var fieldIDToName_ReactionUserListReq = map[int16]string{
+	1: "videoId",
+	2: "reaction",
+	3: "pageSize",
+	4: "cursor",
+}
+
+type ReactionUserListResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Users      []*Reactor       `thrift:"users,2" frugal:"2,default,list<Reactor>" json:"users"`
+	NextCursor *string          `thrift:"nextCursor,3,optional" frugal:"3,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,4" frugal:"4,default,bool" json:"hasMore"`
+}
+
+func NewReactionUserListResp() *ReactionUserListResp {
+	return &ReactionUserListResp{}
+}
+
+func (p *ReactionUserListResp) InitDefault() {
+}
+
+var ReactionUserListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReactionUserListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReactionUserListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ReactionUserListResp) GetUsers() (v []*Reactor) {
+	return p.Users
+}
+
+var ReactionUserListResp_NextCursor_DEFAULT string
+
+func (p *ReactionUserListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return ReactionUserListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *ReactionUserListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *ReactionUserListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *ReactionUserListResp) SetUsers(val []*Reactor) {
+	p.Users = val
+}
+func (p *ReactionUserListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *ReactionUserListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *ReactionUserListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReactionUserListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *ReactionUserListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactionUserListResp(%+v)", *p)
+}
+
+var fieldIDToName_ReactionUserListResp = map[int16]string{
+	1: "BaseResp",
+	2: "users",
+	3: "nextCursor",
+	4: "hasMore",
+}
+
+type CheckLikeStatusReq struct {
+	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+}
+
+func NewCheckLikeStatusReq() *CheckLikeStatusReq {
+	return &CheckLikeStatusReq{}
+}
+
+func (p *CheckLikeStatusReq) InitDefault() {
+}
+
+func (p *CheckLikeStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CheckLikeStatusReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *CheckLikeStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CheckLikeStatusReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *CheckLikeStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckLikeStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_CheckLikeStatusReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+}
+
+type CheckLikeStatusResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	IsLiked  bool             `thrift:"isLiked,2" frugal:"2,default,bool" json:"isLiked"`
+}
+
+func NewCheckLikeStatusResp() *CheckLikeStatusResp {
+	return &CheckLikeStatusResp{}
+}
+
+func (p *CheckLikeStatusResp) InitDefault() {
+}
+
+var CheckLikeStatusResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CheckLikeStatusResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CheckLikeStatusResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CheckLikeStatusResp) GetIsLiked() (v bool) {
+	return p.IsLiked
+}
+func (p *CheckLikeStatusResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CheckLikeStatusResp) SetIsLiked(val bool) {
+	p.IsLiked = val
+}
+
+func (p *CheckLikeStatusResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CheckLikeStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckLikeStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_CheckLikeStatusResp = map[int16]string{
+	1: "BaseResp",
+	2: "isLiked",
+}
+
+type InteractionStatus struct {
+	IsLiked           bool    `thrift:"isLiked,1" frugal:"1,default,bool" json:"isLiked"`
+	Reaction          *string `thrift:"reaction,2,optional" frugal:"2,optional,string" json:"reaction,omitempty"`
+	IsStarred         bool    `thrift:"isStarred,3" frugal:"3,default,bool" json:"isStarred"`
+	IsFollowingAuthor bool    `thrift:"isFollowingAuthor,4" frugal:"4,default,bool" json:"isFollowingAuthor"`
+}
+
+func NewInteractionStatus() *InteractionStatus {
+	return &InteractionStatus{}
+}
+
+func (p *InteractionStatus) InitDefault() {
+}
+
+func (p *InteractionStatus) GetIsLiked() (v bool) {
+	return p.IsLiked
+}
+
+var InteractionStatus_Reaction_DEFAULT string
+
+func (p *InteractionStatus) GetReaction() (v string) {
+	if !p.IsSetReaction() {
+		return InteractionStatus_Reaction_DEFAULT
+	}
+	return *p.Reaction
+}
+
+func (p *InteractionStatus) GetIsStarred() (v bool) {
+	return p.IsStarred
+}
+
+func (p *InteractionStatus) GetIsFollowingAuthor() (v bool) {
+	return p.IsFollowingAuthor
+}
+func (p *InteractionStatus) SetIsLiked(val bool) {
+	p.IsLiked = val
+}
+func (p *InteractionStatus) SetReaction(val *string) {
+	p.Reaction = val
+}
+func (p *InteractionStatus) SetIsStarred(val bool) {
+	p.IsStarred = val
+}
+func (p *InteractionStatus) SetIsFollowingAuthor(val bool) {
+	p.IsFollowingAuthor = val
+}
+
+func (p *InteractionStatus) IsSetReaction() bool {
+	return p.Reaction != nil
+}
+
+func (p *InteractionStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionStatus(%+v)", *p)
+}
+
+var fieldIDToName_InteractionStatus = map[int16]string{
+	1: "isLiked",
+	2: "reaction",
+	3: "isStarred",
+	4: "isFollowingAuthor",
+}
+
+type BatchCheckInteractionStatusReq struct {
+	UserId   int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoIds []int64 `thrift:"videoIds,2" frugal:"2,default,list<i64>" json:"videoIds"`
+}
+
+func NewBatchCheckInteractionStatusReq() *BatchCheckInteractionStatusReq {
+	return &BatchCheckInteractionStatusReq{}
+}
+
+func (p *BatchCheckInteractionStatusReq) InitDefault() {
+}
+
+func (p *BatchCheckInteractionStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *BatchCheckInteractionStatusReq) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *BatchCheckInteractionStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BatchCheckInteractionStatusReq) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+func (p *BatchCheckInteractionStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCheckInteractionStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_BatchCheckInteractionStatusReq = map[int16]string{
+	1: "userId",
+	2: "videoIds",
+}
+
+type BatchCheckInteractionStatusResp struct {
+	BaseResp *common.BaseResp             `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Statuses map[int64]*InteractionStatus `thrift:"statuses,2" frugal:"2,default,map<i64:InteractionStatus>" json:"statuses"`
+}
+
+func NewBatchCheckInteractionStatusResp() *BatchCheckInteractionStatusResp {
+	return &BatchCheckInteractionStatusResp{}
+}
+
+func (p *BatchCheckInteractionStatusResp) InitDefault() {
+}
+
+var BatchCheckInteractionStatusResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *BatchCheckInteractionStatusResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BatchCheckInteractionStatusResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BatchCheckInteractionStatusResp) GetStatuses() (v map[int64]*InteractionStatus) {
+	return p.Statuses
+}
+func (p *BatchCheckInteractionStatusResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *BatchCheckInteractionStatusResp) SetStatuses(val map[int64]*InteractionStatus) {
+	p.Statuses = val
+}
+
+func (p *BatchCheckInteractionStatusResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchCheckInteractionStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCheckInteractionStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_BatchCheckInteractionStatusResp = map[int16]string{
+	1: "BaseResp",
+	2: "statuses",
+}
+
+type CheckStarStatusReq struct {
+	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+}
+
+func NewCheckStarStatusReq() *CheckStarStatusReq {
+	return &CheckStarStatusReq{}
+}
+
+func (p *CheckStarStatusReq) InitDefault() {
+}
+
+func (p *CheckStarStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CheckStarStatusReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *CheckStarStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CheckStarStatusReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *CheckStarStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckStarStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_CheckStarStatusReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+}
+
+type CheckStarStatusResp struct {
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	IsStarred bool             `thrift:"isStarred,2" frugal:"2,default,bool" json:"isStarred"`
+}
+
+func NewCheckStarStatusResp() *CheckStarStatusResp {
+	return &CheckStarStatusResp{}
+}
+
+func (p *CheckStarStatusResp) InitDefault() {
+}
+
+var CheckStarStatusResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CheckStarStatusResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CheckStarStatusResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CheckStarStatusResp) GetIsStarred() (v bool) {
+	return p.IsStarred
+}
+func (p *CheckStarStatusResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CheckStarStatusResp) SetIsStarred(val bool) {
+	p.IsStarred = val
+}
+
+func (p *CheckStarStatusResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CheckStarStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckStarStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_CheckStarStatusResp = map[int16]string{
+	1: "BaseResp",
+	2: "isStarred",
+}
+
+type InteractionService interface {
+	LikeAction(ctx context.Context, req *LikeActionReq) (r *LikeActionResp, err error)
+
+	GetLikeVideoList(ctx context.Context, req *LikeVideoListReq) (r *LikeVideoListResp, err error)
+
+	StarAction(ctx context.Context, req *StarActionReq) (r *StarActionResp, err error)
+
+	GetStarVideoList(ctx context.Context, req *StarVideoListReq) (r *StarVideoListResp, err error)
+
+	CreateStarFolder(ctx context.Context, req *CreateStarFolderReq) (r *CreateStarFolderResp, err error)
+
+	UpdateStarFolder(ctx context.Context, req *UpdateStarFolderReq) (r *UpdateStarFolderResp, err error)
+
+	DeleteStarFolder(ctx context.Context, req *DeleteStarFolderReq) (r *DeleteStarFolderResp, err error)
+
+	GetStarFolders(ctx context.Context, req *StarFolderListReq) (r *StarFolderListResp, err error)
+
+	StarFolderItemAction(ctx context.Context, req *StarFolderItemActionReq) (r *StarFolderItemActionResp, err error)
+
+	MoveStarVideo(ctx context.Context, req *MoveStarVideoReq) (r *MoveStarVideoResp, err error)
+
+	StarFolderFollowAction(ctx context.Context, req *StarFolderFollowReq) (r *StarFolderFollowResp, err error)
+
+	GetFollowedStarFolders(ctx context.Context, req *FollowedStarFolderListReq) (r *FollowedStarFolderListResp, err error)
+
+	CommentAction(ctx context.Context, req *CommentActionReq) (r *CommentActionResp, err error)
+
+	GetCommentList(ctx context.Context, req *CommentListReq) (r *CommentListResp, err error)
+
+	GetCommentReplies(ctx context.Context, req *CommentRepliesReq) (r *CommentRepliesResp, err error)
+
+	DeleteComment(ctx context.Context, req *DeleteCommentReq) (r *DeleteCommentResp, err error)
+
+	CommentLikeAction(ctx context.Context, req *CommentLikeActionReq) (r *CommentLikeActionResp, err error)
+
+	PinComment(ctx context.Context, req *PinCommentReq) (r *PinCommentResp, err error)
+
+	SetCommentPermission(ctx context.Context, req *SetCommentPermissionReq) (r *SetCommentPermissionResp, err error)
+
+	GetCommentPermission(ctx context.Context, req *GetCommentPermissionReq) (r *GetCommentPermissionResp, err error)
+
+	CommentKeywordAction(ctx context.Context, req *CommentKeywordActionReq) (r *CommentKeywordActionResp, err error)
+
+	GetCommentKeywords(ctx context.Context, req *CommentKeywordListReq) (r *CommentKeywordListResp, err error)
+
+	GetPendingComments(ctx context.Context, req *PendingCommentListReq) (r *PendingCommentListResp, err error)
+
+	ReviewComment(ctx context.Context, req *ReviewCommentReq) (r *ReviewCommentResp, err error)
+
+	ShareAction(ctx context.Context, req *ShareActionReq) (r *ShareActionResp, err error)
+
+	CreateShareLink(ctx context.Context, req *CreateShareLinkReq) (r *CreateShareLinkResp, err error)
+
+	ResolveShareLink(ctx context.Context, req *ResolveShareLinkReq) (r *ResolveShareLinkResp, err error)
+
+	RecordShareConversion(ctx context.Context, req *ShareConversionReq) (r *ShareConversionResp, err error)
+
+	GetShareStats(ctx context.Context, req *ShareStatsReq) (r *ShareStatsResp, err error)
+
+	GetInvitedBy(ctx context.Context, req *InvitedByReq) (r *InvitedByResp, err error)
+
+	GetCount(ctx context.Context, req *CountReq) (r *CountResp, err error)
+
+	CheckLikeStatus(ctx context.Context, req *CheckLikeStatusReq) (r *CheckLikeStatusResp, err error)
+
+	ReactionAction(ctx context.Context, req *ReactionActionReq) (r *ReactionActionResp, err error)
+
+	BatchGetReactions(ctx context.Context, req *BatchGetReactionsReq) (r *BatchGetReactionsResp, err error)
+
+	GetReactionUsers(ctx context.Context, req *ReactionUserListReq) (r *ReactionUserListResp, err error)
+
+	CheckStarStatus(ctx context.Context, req *CheckStarStatusReq) (r *CheckStarStatusResp, err error)
+
+	BatchCheckInteractionStatus(ctx context.Context, req *BatchCheckInteractionStatusReq) (r *BatchCheckInteractionStatusResp, err error)
+}
+
+type InteractionServiceLikeActionArgs struct {
+	Req *LikeActionReq `thrift:"req,1" frugal:"1,default,LikeActionReq" json:"req"`
+}
+
+func NewInteractionServiceLikeActionArgs() *InteractionServiceLikeActionArgs {
+	return &InteractionServiceLikeActionArgs{}
+}
+
+func (p *InteractionServiceLikeActionArgs) InitDefault() {
+}
+
+var InteractionServiceLikeActionArgs_Req_DEFAULT *LikeActionReq
+
+func (p *InteractionServiceLikeActionArgs) GetReq() (v *LikeActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceLikeActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceLikeActionArgs) SetReq(val *LikeActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceLikeActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceLikeActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceLikeActionResult struct {
+	Success *LikeActionResp `thrift:"success,0,optional" frugal:"0,optional,LikeActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceLikeActionResult() *InteractionServiceLikeActionResult {
+	return &InteractionServiceLikeActionResult{}
+}
+
+func (p *InteractionServiceLikeActionResult) InitDefault() {
+}
+
+var InteractionServiceLikeActionResult_Success_DEFAULT *LikeActionResp
+
+func (p *InteractionServiceLikeActionResult) GetSuccess() (v *LikeActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceLikeActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceLikeActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeActionResp)
+}
+
+func (p *InteractionServiceLikeActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceLikeActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetLikeVideoListArgs struct {
+	Req *LikeVideoListReq `thrift:"req,1" frugal:"1,default,LikeVideoListReq" json:"req"`
+}
+
+func NewInteractionServiceGetLikeVideoListArgs() *InteractionServiceGetLikeVideoListArgs {
+	return &InteractionServiceGetLikeVideoListArgs{}
+}
+
+func (p *InteractionServiceGetLikeVideoListArgs) InitDefault() {
+}
+
+var InteractionServiceGetLikeVideoListArgs_Req_DEFAULT *LikeVideoListReq
+
+func (p *InteractionServiceGetLikeVideoListArgs) GetReq() (v *LikeVideoListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetLikeVideoListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetLikeVideoListArgs) SetReq(val *LikeVideoListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetLikeVideoListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetLikeVideoListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetLikeVideoListArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetLikeVideoListArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetLikeVideoListResult struct {
+	Success *LikeVideoListResp `thrift:"success,0,optional" frugal:"0,optional,LikeVideoListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetLikeVideoListResult() *InteractionServiceGetLikeVideoListResult {
+	return &InteractionServiceGetLikeVideoListResult{}
+}
+
+func (p *InteractionServiceGetLikeVideoListResult) InitDefault() {
+}
+
+var InteractionServiceGetLikeVideoListResult_Success_DEFAULT *LikeVideoListResp
+
+func (p *InteractionServiceGetLikeVideoListResult) GetSuccess() (v *LikeVideoListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetLikeVideoListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetLikeVideoListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeVideoListResp)
+}
+
+func (p *InteractionServiceGetLikeVideoListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetLikeVideoListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetLikeVideoListResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetLikeVideoListResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceStarActionArgs struct {
+	Req *StarActionReq `thrift:"req,1" frugal:"1,default,StarActionReq" json:"req"`
+}
+
+func NewInteractionServiceStarActionArgs() *InteractionServiceStarActionArgs {
+	return &InteractionServiceStarActionArgs{}
+}
+
+func (p *InteractionServiceStarActionArgs) InitDefault() {
+}
+
+var InteractionServiceStarActionArgs_Req_DEFAULT *StarActionReq
+
+func (p *InteractionServiceStarActionArgs) GetReq() (v *StarActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceStarActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceStarActionArgs) SetReq(val *StarActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceStarActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceStarActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceStarActionResult struct {
+	Success *StarActionResp `thrift:"success,0,optional" frugal:"0,optional,StarActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceStarActionResult() *InteractionServiceStarActionResult {
+	return &InteractionServiceStarActionResult{}
+}
+
+func (p *InteractionServiceStarActionResult) InitDefault() {
+}
+
+var InteractionServiceStarActionResult_Success_DEFAULT *StarActionResp
+
+func (p *InteractionServiceStarActionResult) GetSuccess() (v *StarActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceStarActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceStarActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StarActionResp)
+}
+
+func (p *InteractionServiceStarActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceStarActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetStarVideoListArgs struct {
+	Req *StarVideoListReq `thrift:"req,1" frugal:"1,default,StarVideoListReq" json:"req"`
+}
+
+func NewInteractionServiceGetStarVideoListArgs() *InteractionServiceGetStarVideoListArgs {
+	return &InteractionServiceGetStarVideoListArgs{}
+}
+
+func (p *InteractionServiceGetStarVideoListArgs) InitDefault() {
+}
+
+var InteractionServiceGetStarVideoListArgs_Req_DEFAULT *StarVideoListReq
+
+func (p *InteractionServiceGetStarVideoListArgs) GetReq() (v *StarVideoListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetStarVideoListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetStarVideoListArgs) SetReq(val *StarVideoListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetStarVideoListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetStarVideoListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetStarVideoListArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetStarVideoListArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetStarVideoListResult struct {
+	Success *StarVideoListResp `thrift:"success,0,optional" frugal:"0,optional,StarVideoListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetStarVideoListResult() *InteractionServiceGetStarVideoListResult {
+	return &InteractionServiceGetStarVideoListResult{}
+}
+
+func (p *InteractionServiceGetStarVideoListResult) InitDefault() {
+}
+
+var InteractionServiceGetStarVideoListResult_Success_DEFAULT *StarVideoListResp
+
+func (p *InteractionServiceGetStarVideoListResult) GetSuccess() (v *StarVideoListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetStarVideoListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetStarVideoListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StarVideoListResp)
+}
+
+func (p *InteractionServiceGetStarVideoListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetStarVideoListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetStarVideoListResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetStarVideoListResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCreateStarFolderArgs struct {
+	Req *CreateStarFolderReq `thrift:"req,1" frugal:"1,default,CreateStarFolderReq" json:"req"`
+}
+
+func NewInteractionServiceCreateStarFolderArgs() *InteractionServiceCreateStarFolderArgs {
+	return &InteractionServiceCreateStarFolderArgs{}
+}
+
+func (p *InteractionServiceCreateStarFolderArgs) InitDefault() {
+}
+
+var InteractionServiceCreateStarFolderArgs_Req_DEFAULT *CreateStarFolderReq
+
+func (p *InteractionServiceCreateStarFolderArgs) GetReq() (v *CreateStarFolderReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceCreateStarFolderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCreateStarFolderArgs) SetReq(val *CreateStarFolderReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCreateStarFolderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCreateStarFolderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCreateStarFolderArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCreateStarFolderArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCreateStarFolderResult struct {
+	Success *CreateStarFolderResp `thrift:"success,0,optional" frugal:"0,optional,CreateStarFolderResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCreateStarFolderResult() *InteractionServiceCreateStarFolderResult {
+	return &InteractionServiceCreateStarFolderResult{}
+}
+
+func (p *InteractionServiceCreateStarFolderResult) InitDefault() {
+}
+
+var InteractionServiceCreateStarFolderResult_Success_DEFAULT *CreateStarFolderResp
+
+func (p *InteractionServiceCreateStarFolderResult) GetSuccess() (v *CreateStarFolderResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCreateStarFolderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCreateStarFolderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateStarFolderResp)
+}
+
+func (p *InteractionServiceCreateStarFolderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCreateStarFolderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCreateStarFolderResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCreateStarFolderResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceUpdateStarFolderArgs struct {
+	Req *UpdateStarFolderReq `thrift:"req,1" frugal:"1,default,UpdateStarFolderReq" json:"req"`
+}
+
+func NewInteractionServiceUpdateStarFolderArgs() *InteractionServiceUpdateStarFolderArgs {
+	return &InteractionServiceUpdateStarFolderArgs{}
+}
+
+func (p *InteractionServiceUpdateStarFolderArgs) InitDefault() {
+}
+
+var InteractionServiceUpdateStarFolderArgs_Req_DEFAULT *UpdateStarFolderReq
+
+func (p *InteractionServiceUpdateStarFolderArgs) GetReq() (v *UpdateStarFolderReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceUpdateStarFolderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceUpdateStarFolderArgs) SetReq(val *UpdateStarFolderReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceUpdateStarFolderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceUpdateStarFolderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceUpdateStarFolderArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceUpdateStarFolderArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceUpdateStarFolderResult struct {
+	Success *UpdateStarFolderResp `thrift:"success,0,optional" frugal:"0,optional,UpdateStarFolderResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceUpdateStarFolderResult() *InteractionServiceUpdateStarFolderResult {
+	return &InteractionServiceUpdateStarFolderResult{}
+}
+
+func (p *InteractionServiceUpdateStarFolderResult) InitDefault() {
+}
+
+var InteractionServiceUpdateStarFolderResult_Success_DEFAULT *UpdateStarFolderResp
+
+func (p *InteractionServiceUpdateStarFolderResult) GetSuccess() (v *UpdateStarFolderResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceUpdateStarFolderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceUpdateStarFolderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateStarFolderResp)
+}
+
+func (p *InteractionServiceUpdateStarFolderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceUpdateStarFolderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceUpdateStarFolderResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceUpdateStarFolderResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceDeleteStarFolderArgs struct {
+	Req *DeleteStarFolderReq `thrift:"req,1" frugal:"1,default,DeleteStarFolderReq" json:"req"`
+}
+
+func NewInteractionServiceDeleteStarFolderArgs() *InteractionServiceDeleteStarFolderArgs {
+	return &InteractionServiceDeleteStarFolderArgs{}
+}
+
+func (p *InteractionServiceDeleteStarFolderArgs) InitDefault() {
+}
+
+var InteractionServiceDeleteStarFolderArgs_Req_DEFAULT *DeleteStarFolderReq
+
+func (p *InteractionServiceDeleteStarFolderArgs) GetReq() (v *DeleteStarFolderReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceDeleteStarFolderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceDeleteStarFolderArgs) SetReq(val *DeleteStarFolderReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceDeleteStarFolderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceDeleteStarFolderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteStarFolderArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteStarFolderArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceDeleteStarFolderResult struct {
+	Success *DeleteStarFolderResp `thrift:"success,0,optional" frugal:"0,optional,DeleteStarFolderResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceDeleteStarFolderResult() *InteractionServiceDeleteStarFolderResult {
+	return &InteractionServiceDeleteStarFolderResult{}
+}
+
+func (p *InteractionServiceDeleteStarFolderResult) InitDefault() {
+}
+
+var InteractionServiceDeleteStarFolderResult_Success_DEFAULT *DeleteStarFolderResp
+
+func (p *InteractionServiceDeleteStarFolderResult) GetSuccess() (v *DeleteStarFolderResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceDeleteStarFolderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceDeleteStarFolderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteStarFolderResp)
+}
+
+func (p *InteractionServiceDeleteStarFolderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceDeleteStarFolderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteStarFolderResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteStarFolderResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetStarFoldersArgs struct {
+	Req *StarFolderListReq `thrift:"req,1" frugal:"1,default,StarFolderListReq" json:"req"`
+}
+
+func NewInteractionServiceGetStarFoldersArgs() *InteractionServiceGetStarFoldersArgs {
+	return &InteractionServiceGetStarFoldersArgs{}
+}
+
+func (p *InteractionServiceGetStarFoldersArgs) InitDefault() {
+}
+
+var InteractionServiceGetStarFoldersArgs_Req_DEFAULT *StarFolderListReq
+
+func (p *InteractionServiceGetStarFoldersArgs) GetReq() (v *StarFolderListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetStarFoldersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetStarFoldersArgs) SetReq(val *StarFolderListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetStarFoldersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetStarFoldersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetStarFoldersArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetStarFoldersArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetStarFoldersResult struct {
+	Success *StarFolderListResp `thrift:"success,0,optional" frugal:"0,optional,StarFolderListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetStarFoldersResult() *InteractionServiceGetStarFoldersResult {
+	return &InteractionServiceGetStarFoldersResult{}
+}
+
+func (p *InteractionServiceGetStarFoldersResult) InitDefault() {
+}
+
+var InteractionServiceGetStarFoldersResult_Success_DEFAULT *StarFolderListResp
+
+func (p *InteractionServiceGetStarFoldersResult) GetSuccess() (v *StarFolderListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetStarFoldersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetStarFoldersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StarFolderListResp)
+}
+
+func (p *InteractionServiceGetStarFoldersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetStarFoldersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetStarFoldersResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetStarFoldersResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceStarFolderItemActionArgs struct {
+	Req *StarFolderItemActionReq `thrift:"req,1" frugal:"1,default,StarFolderItemActionReq" json:"req"`
+}
+
+func NewInteractionServiceStarFolderItemActionArgs() *InteractionServiceStarFolderItemActionArgs {
+	return &InteractionServiceStarFolderItemActionArgs{}
+}
+
+func (p *InteractionServiceStarFolderItemActionArgs) InitDefault() {
+}
+
+var InteractionServiceStarFolderItemActionArgs_Req_DEFAULT *StarFolderItemActionReq
+
+func (p *InteractionServiceStarFolderItemActionArgs) GetReq() (v *StarFolderItemActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceStarFolderItemActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceStarFolderItemActionArgs) SetReq(val *StarFolderItemActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceStarFolderItemActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceStarFolderItemActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarFolderItemActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarFolderItemActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceStarFolderItemActionResult struct {
+	Success *StarFolderItemActionResp `thrift:"success,0,optional" frugal:"0,optional,StarFolderItemActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceStarFolderItemActionResult() *InteractionServiceStarFolderItemActionResult {
+	return &InteractionServiceStarFolderItemActionResult{}
+}
+
+func (p *InteractionServiceStarFolderItemActionResult) InitDefault() {
+}
+
+var InteractionServiceStarFolderItemActionResult_Success_DEFAULT *StarFolderItemActionResp
+
+func (p *InteractionServiceStarFolderItemActionResult) GetSuccess() (v *StarFolderItemActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceStarFolderItemActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceStarFolderItemActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StarFolderItemActionResp)
+}
+
+func (p *InteractionServiceStarFolderItemActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceStarFolderItemActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarFolderItemActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarFolderItemActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceMoveStarVideoArgs struct {
+	Req *MoveStarVideoReq `thrift:"req,1" frugal:"1,default,MoveStarVideoReq" json:"req"`
+}
+
+func NewInteractionServiceMoveStarVideoArgs() *InteractionServiceMoveStarVideoArgs {
+	return &InteractionServiceMoveStarVideoArgs{}
+}
+
+func (p *InteractionServiceMoveStarVideoArgs) InitDefault() {
+}
+
+var InteractionServiceMoveStarVideoArgs_Req_DEFAULT *MoveStarVideoReq
+
+func (p *InteractionServiceMoveStarVideoArgs) GetReq() (v *MoveStarVideoReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceMoveStarVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceMoveStarVideoArgs) SetReq(val *MoveStarVideoReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceMoveStarVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceMoveStarVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceMoveStarVideoArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceMoveStarVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceMoveStarVideoResult struct {
+	Success *MoveStarVideoResp `thrift:"success,0,optional" frugal:"0,optional,MoveStarVideoResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceMoveStarVideoResult() *InteractionServiceMoveStarVideoResult {
+	return &InteractionServiceMoveStarVideoResult{}
+}
+
+func (p *InteractionServiceMoveStarVideoResult) InitDefault() {
+}
+
+var InteractionServiceMoveStarVideoResult_Success_DEFAULT *MoveStarVideoResp
+
+func (p *InteractionServiceMoveStarVideoResult) GetSuccess() (v *MoveStarVideoResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceMoveStarVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceMoveStarVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MoveStarVideoResp)
+}
+
+func (p *InteractionServiceMoveStarVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceMoveStarVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceMoveStarVideoResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceMoveStarVideoResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceStarFolderFollowActionArgs struct {
+	Req *StarFolderFollowReq `thrift:"req,1" frugal:"1,default,StarFolderFollowReq" json:"req"`
+}
+
+func NewInteractionServiceStarFolderFollowActionArgs() *InteractionServiceStarFolderFollowActionArgs {
+	return &InteractionServiceStarFolderFollowActionArgs{}
+}
+
+func (p *InteractionServiceStarFolderFollowActionArgs) InitDefault() {
+}
+
+var InteractionServiceStarFolderFollowActionArgs_Req_DEFAULT *StarFolderFollowReq
+
+func (p *InteractionServiceStarFolderFollowActionArgs) GetReq() (v *StarFolderFollowReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceStarFolderFollowActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceStarFolderFollowActionArgs) SetReq(val *StarFolderFollowReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceStarFolderFollowActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceStarFolderFollowActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarFolderFollowActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarFolderFollowActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceStarFolderFollowActionResult struct {
+	Success *StarFolderFollowResp `thrift:"success,0,optional" frugal:"0,optional,StarFolderFollowResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceStarFolderFollowActionResult() *InteractionServiceStarFolderFollowActionResult {
+	return &InteractionServiceStarFolderFollowActionResult{}
+}
+
+func (p *InteractionServiceStarFolderFollowActionResult) InitDefault() {
+}
+
+var InteractionServiceStarFolderFollowActionResult_Success_DEFAULT *StarFolderFollowResp
+
+func (p *InteractionServiceStarFolderFollowActionResult) GetSuccess() (v *StarFolderFollowResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceStarFolderFollowActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceStarFolderFollowActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*StarFolderFollowResp)
+}
+
+func (p *InteractionServiceStarFolderFollowActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceStarFolderFollowActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceStarFolderFollowActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceStarFolderFollowActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetFollowedStarFoldersArgs struct {
+	Req *FollowedStarFolderListReq `thrift:"req,1" frugal:"1,default,FollowedStarFolderListReq" json:"req"`
+}
+
+func NewInteractionServiceGetFollowedStarFoldersArgs() *InteractionServiceGetFollowedStarFoldersArgs {
+	return &InteractionServiceGetFollowedStarFoldersArgs{}
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersArgs) InitDefault() {
+}
+
+var InteractionServiceGetFollowedStarFoldersArgs_Req_DEFAULT *FollowedStarFolderListReq
+
+func (p *InteractionServiceGetFollowedStarFoldersArgs) GetReq() (v *FollowedStarFolderListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetFollowedStarFoldersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetFollowedStarFoldersArgs) SetReq(val *FollowedStarFolderListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetFollowedStarFoldersArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetFollowedStarFoldersArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetFollowedStarFoldersResult struct {
+	Success *FollowedStarFolderListResp `thrift:"success,0,optional" frugal:"0,optional,FollowedStarFolderListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetFollowedStarFoldersResult() *InteractionServiceGetFollowedStarFoldersResult {
+	return &InteractionServiceGetFollowedStarFoldersResult{}
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersResult) InitDefault() {
+}
+
+var InteractionServiceGetFollowedStarFoldersResult_Success_DEFAULT *FollowedStarFolderListResp
+
+func (p *InteractionServiceGetFollowedStarFoldersResult) GetSuccess() (v *FollowedStarFolderListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetFollowedStarFoldersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetFollowedStarFoldersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowedStarFolderListResp)
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetFollowedStarFoldersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetFollowedStarFoldersResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetFollowedStarFoldersResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCommentActionArgs struct {
+	Req *CommentActionReq `thrift:"req,1" frugal:"1,default,CommentActionReq" json:"req"`
+}
+
+func NewInteractionServiceCommentActionArgs() *InteractionServiceCommentActionArgs {
+	return &InteractionServiceCommentActionArgs{}
+}
+
+func (p *InteractionServiceCommentActionArgs) InitDefault() {
+}
+
+var InteractionServiceCommentActionArgs_Req_DEFAULT *CommentActionReq
+
+func (p *InteractionServiceCommentActionArgs) GetReq() (v *CommentActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceCommentActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCommentActionArgs) SetReq(val *CommentActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCommentActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCommentActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCommentActionResult struct {
+	Success *CommentActionResp `thrift:"success,0,optional" frugal:"0,optional,CommentActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCommentActionResult() *InteractionServiceCommentActionResult {
+	return &InteractionServiceCommentActionResult{}
+}
+
+func (p *InteractionServiceCommentActionResult) InitDefault() {
+}
+
+var InteractionServiceCommentActionResult_Success_DEFAULT *CommentActionResp
+
+func (p *InteractionServiceCommentActionResult) GetSuccess() (v *CommentActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCommentActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCommentActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentActionResp)
+}
+
+func (p *InteractionServiceCommentActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCommentActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentListArgs struct {
+	Req *CommentListReq `thrift:"req,1" frugal:"1,default,CommentListReq" json:"req"`
+}
+
+func NewInteractionServiceGetCommentListArgs() *InteractionServiceGetCommentListArgs {
+	return &InteractionServiceGetCommentListArgs{}
+}
+
+func (p *InteractionServiceGetCommentListArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentListArgs_Req_DEFAULT *CommentListReq
+
+func (p *InteractionServiceGetCommentListArgs) GetReq() (v *CommentListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentListArgs) SetReq(val *CommentListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentListArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentListArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentListResult struct {
+	Success *CommentListResp `thrift:"success,0,optional" frugal:"0,optional,CommentListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentListResult() *InteractionServiceGetCommentListResult {
+	return &InteractionServiceGetCommentListResult{}
+}
+
+func (p *InteractionServiceGetCommentListResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentListResult_Success_DEFAULT *CommentListResp
+
+func (p *InteractionServiceGetCommentListResult) GetSuccess() (v *CommentListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentListResp)
+}
+
+func (p *InteractionServiceGetCommentListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentListResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentListResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentRepliesArgs struct {
+	Req *CommentRepliesReq `thrift:"req,1" frugal:"1,default,CommentRepliesReq" json:"req"`
+}
+
+func NewInteractionServiceGetCommentRepliesArgs() *InteractionServiceGetCommentRepliesArgs {
+	return &InteractionServiceGetCommentRepliesArgs{}
+}
+
+func (p *InteractionServiceGetCommentRepliesArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentRepliesArgs_Req_DEFAULT *CommentRepliesReq
+
+func (p *InteractionServiceGetCommentRepliesArgs) GetReq() (v *CommentRepliesReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentRepliesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentRepliesArgs) SetReq(val *CommentRepliesReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentRepliesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentRepliesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentRepliesArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentRepliesArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentRepliesResult struct {
+	Success *CommentRepliesResp `thrift:"success,0,optional" frugal:"0,optional,CommentRepliesResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentRepliesResult() *InteractionServiceGetCommentRepliesResult {
+	return &InteractionServiceGetCommentRepliesResult{}
+}
+
+func (p *InteractionServiceGetCommentRepliesResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentRepliesResult_Success_DEFAULT *CommentRepliesResp
+
+func (p *InteractionServiceGetCommentRepliesResult) GetSuccess() (v *CommentRepliesResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentRepliesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentRepliesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentRepliesResp)
+}
+
+func (p *InteractionServiceGetCommentRepliesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentRepliesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentRepliesResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentRepliesResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceDeleteCommentArgs struct {
+	Req *DeleteCommentReq `thrift:"req,1" frugal:"1,default,DeleteCommentReq" json:"req"`
+}
+
+func NewInteractionServiceDeleteCommentArgs() *InteractionServiceDeleteCommentArgs {
+	return &InteractionServiceDeleteCommentArgs{}
+}
+
+func (p *InteractionServiceDeleteCommentArgs) InitDefault() {
+}
+
+var InteractionServiceDeleteCommentArgs_Req_DEFAULT *DeleteCommentReq
+
+func (p *InteractionServiceDeleteCommentArgs) GetReq() (v *DeleteCommentReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceDeleteCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceDeleteCommentArgs) SetReq(val *DeleteCommentReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceDeleteCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceDeleteCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceDeleteCommentResult struct {
+	Success *DeleteCommentResp `thrift:"success,0,optional" frugal:"0,optional,DeleteCommentResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceDeleteCommentResult() *InteractionServiceDeleteCommentResult {
+	return &InteractionServiceDeleteCommentResult{}
+}
+
+func (p *InteractionServiceDeleteCommentResult) InitDefault() {
+}
+
+var InteractionServiceDeleteCommentResult_Success_DEFAULT *DeleteCommentResp
+
+func (p *InteractionServiceDeleteCommentResult) GetSuccess() (v *DeleteCommentResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceDeleteCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceDeleteCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteCommentResp)
+}
+
+func (p *InteractionServiceDeleteCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceDeleteCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCommentLikeActionArgs struct {
+	Req *CommentLikeActionReq `thrift:"req,1" frugal:"1,default,CommentLikeActionReq" json:"req"`
+}
+
+func NewInteractionServiceCommentLikeActionArgs() *InteractionServiceCommentLikeActionArgs {
+	return &InteractionServiceCommentLikeActionArgs{}
+}
+
+func (p *InteractionServiceCommentLikeActionArgs) InitDefault() {
+}
+
+var InteractionServiceCommentLikeActionArgs_Req_DEFAULT *CommentLikeActionReq
+
+func (p *InteractionServiceCommentLikeActionArgs) GetReq() (v *CommentLikeActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceCommentLikeActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCommentLikeActionArgs) SetReq(val *CommentLikeActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCommentLikeActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCommentLikeActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentLikeActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentLikeActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCommentLikeActionResult struct {
+	Success *CommentLikeActionResp `thrift:"success,0,optional" frugal:"0,optional,CommentLikeActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCommentLikeActionResult() *InteractionServiceCommentLikeActionResult {
+	return &InteractionServiceCommentLikeActionResult{}
+}
+
+func (p *InteractionServiceCommentLikeActionResult) InitDefault() {
+}
+
+var InteractionServiceCommentLikeActionResult_Success_DEFAULT *CommentLikeActionResp
+
+func (p *InteractionServiceCommentLikeActionResult) GetSuccess() (v *CommentLikeActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCommentLikeActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCommentLikeActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentLikeActionResp)
+}
+
+func (p *InteractionServiceCommentLikeActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCommentLikeActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentLikeActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentLikeActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServicePinCommentArgs struct {
+	Req *PinCommentReq `thrift:"req,1" frugal:"1,default,PinCommentReq" json:"req"`
+}
+
+func NewInteractionServicePinCommentArgs() *InteractionServicePinCommentArgs {
+	return &InteractionServicePinCommentArgs{}
+}
+
+func (p *InteractionServicePinCommentArgs) InitDefault() {
+}
+
+var InteractionServicePinCommentArgs_Req_DEFAULT *PinCommentReq
+
+func (p *InteractionServicePinCommentArgs) GetReq() (v *PinCommentReq) {
+	if !p.IsSetReq() {
+		return InteractionServicePinCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServicePinCommentArgs) SetReq(val *PinCommentReq) {
+	p.Req = val
+}
+
+func (p *InteractionServicePinCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServicePinCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServicePinCommentResult struct {
+	Success *PinCommentResp `thrift:"success,0,optional" frugal:"0,optional,PinCommentResp" json:"success,omitempty"`
+}
+
+func NewInteractionServicePinCommentResult() *InteractionServicePinCommentResult {
+	return &InteractionServicePinCommentResult{}
+}
+
+func (p *InteractionServicePinCommentResult) InitDefault() {
+}
+
+var InteractionServicePinCommentResult_Success_DEFAULT *PinCommentResp
+
+func (p *InteractionServicePinCommentResult) GetSuccess() (v *PinCommentResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServicePinCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServicePinCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PinCommentResp)
+}
+
+func (p *InteractionServicePinCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServicePinCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceSetCommentPermissionArgs struct {
+	Req *SetCommentPermissionReq `thrift:"req,1" frugal:"1,default,SetCommentPermissionReq" json:"req"`
+}
+
+func NewInteractionServiceSetCommentPermissionArgs() *InteractionServiceSetCommentPermissionArgs {
+	return &InteractionServiceSetCommentPermissionArgs{}
+}
+
+func (p *InteractionServiceSetCommentPermissionArgs) InitDefault() {
+}
+
+var InteractionServiceSetCommentPermissionArgs_Req_DEFAULT *SetCommentPermissionReq
+
+func (p *InteractionServiceSetCommentPermissionArgs) GetReq() (v *SetCommentPermissionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceSetCommentPermissionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceSetCommentPermissionArgs) SetReq(val *SetCommentPermissionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceSetCommentPermissionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceSetCommentPermissionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceSetCommentPermissionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceSetCommentPermissionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceSetCommentPermissionResult struct {
+	Success *SetCommentPermissionResp `thrift:"success,0,optional" frugal:"0,optional,SetCommentPermissionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceSetCommentPermissionResult() *InteractionServiceSetCommentPermissionResult {
+	return &InteractionServiceSetCommentPermissionResult{}
+}
+
+func (p *InteractionServiceSetCommentPermissionResult) InitDefault() {
+}
+
+var InteractionServiceSetCommentPermissionResult_Success_DEFAULT *SetCommentPermissionResp
+
+func (p *InteractionServiceSetCommentPermissionResult) GetSuccess() (v *SetCommentPermissionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceSetCommentPermissionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceSetCommentPermissionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetCommentPermissionResp)
+}
+
+func (p *InteractionServiceSetCommentPermissionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceSetCommentPermissionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceSetCommentPermissionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceSetCommentPermissionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentPermissionArgs struct {
+	Req *GetCommentPermissionReq `thrift:"req,1" frugal:"1,default,GetCommentPermissionReq" json:"req"`
+}
+
+func NewInteractionServiceGetCommentPermissionArgs() *InteractionServiceGetCommentPermissionArgs {
+	return &InteractionServiceGetCommentPermissionArgs{}
+}
+
+func (p *InteractionServiceGetCommentPermissionArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentPermissionArgs_Req_DEFAULT *GetCommentPermissionReq
+
+func (p *InteractionServiceGetCommentPermissionArgs) GetReq() (v *GetCommentPermissionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentPermissionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentPermissionArgs) SetReq(val *GetCommentPermissionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentPermissionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentPermissionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentPermissionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentPermissionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentPermissionResult struct {
+	Success *GetCommentPermissionResp `thrift:"success,0,optional" frugal:"0,optional,GetCommentPermissionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentPermissionResult() *InteractionServiceGetCommentPermissionResult {
+	return &InteractionServiceGetCommentPermissionResult{}
+}
+
+func (p *InteractionServiceGetCommentPermissionResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentPermissionResult_Success_DEFAULT *GetCommentPermissionResp
+
+func (p *InteractionServiceGetCommentPermissionResult) GetSuccess() (v *GetCommentPermissionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentPermissionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentPermissionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCommentPermissionResp)
+}
+
+func (p *InteractionServiceGetCommentPermissionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentPermissionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentPermissionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentPermissionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCommentKeywordActionArgs struct {
+	Req *CommentKeywordActionReq `thrift:"req,1" frugal:"1,default,CommentKeywordActionReq" json:"req"`
+}
+
+func NewInteractionServiceCommentKeywordActionArgs() *InteractionServiceCommentKeywordActionArgs {
+	return &InteractionServiceCommentKeywordActionArgs{}
+}
+
+func (p *InteractionServiceCommentKeywordActionArgs) InitDefault() {
+}
+
+var InteractionServiceCommentKeywordActionArgs_Req_DEFAULT *CommentKeywordActionReq
+
+func (p *InteractionServiceCommentKeywordActionArgs) GetReq() (v *CommentKeywordActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceCommentKeywordActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCommentKeywordActionArgs) SetReq(val *CommentKeywordActionReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCommentKeywordActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCommentKeywordActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentKeywordActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentKeywordActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCommentKeywordActionResult struct {
+	Success *CommentKeywordActionResp `thrift:"success,0,optional" frugal:"0,optional,CommentKeywordActionResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCommentKeywordActionResult() *InteractionServiceCommentKeywordActionResult {
+	return &InteractionServiceCommentKeywordActionResult{}
+}
+
+func (p *InteractionServiceCommentKeywordActionResult) InitDefault() {
+}
+
+var InteractionServiceCommentKeywordActionResult_Success_DEFAULT *CommentKeywordActionResp
+
+func (p *InteractionServiceCommentKeywordActionResult) GetSuccess() (v *CommentKeywordActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCommentKeywordActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCommentKeywordActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentKeywordActionResp)
+}
+
+func (p *InteractionServiceCommentKeywordActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCommentKeywordActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentKeywordActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentKeywordActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentKeywordsArgs struct {
+	Req *CommentKeywordListReq `thrift:"req,1" frugal:"1,default,CommentKeywordListReq" json:"req"`
+}
+
+func NewInteractionServiceGetCommentKeywordsArgs() *InteractionServiceGetCommentKeywordsArgs {
+	return &InteractionServiceGetCommentKeywordsArgs{}
+}
+
+func (p *InteractionServiceGetCommentKeywordsArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentKeywordsArgs_Req_DEFAULT *CommentKeywordListReq
+
+func (p *InteractionServiceGetCommentKeywordsArgs) GetReq() (v *CommentKeywordListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentKeywordsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentKeywordsArgs) SetReq(val *CommentKeywordListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentKeywordsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentKeywordsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentKeywordsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentKeywordsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentKeywordsResult struct {
+	Success *CommentKeywordListResp `thrift:"success,0,optional" frugal:"0,optional,CommentKeywordListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentKeywordsResult() *InteractionServiceGetCommentKeywordsResult {
+	return &InteractionServiceGetCommentKeywordsResult{}
+}
+
+func (p *InteractionServiceGetCommentKeywordsResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentKeywordsResult_Success_DEFAULT *CommentKeywordListResp
+
+func (p *InteractionServiceGetCommentKeywordsResult) GetSuccess() (v *CommentKeywordListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentKeywordsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentKeywordsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentKeywordListResp)
+}
+
+func (p *InteractionServiceGetCommentKeywordsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentKeywordsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentKeywordsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentKeywordsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetPendingCommentsArgs struct {
+	Req *PendingCommentListReq `thrift:"req,1" frugal:"1,default,PendingCommentListReq" json:"req"`
+}
+
+func NewInteractionServiceGetPendingCommentsArgs() *InteractionServiceGetPendingCommentsArgs {
+	return &InteractionServiceGetPendingCommentsArgs{}
+}
+
+func (p *InteractionServiceGetPendingCommentsArgs) InitDefault() {
+}
+
+var InteractionServiceGetPendingCommentsArgs_Req_DEFAULT *PendingCommentListReq
+
+func (p *InteractionServiceGetPendingCommentsArgs) GetReq() (v *PendingCommentListReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetPendingCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetPendingCommentsArgs) SetReq(val *PendingCommentListReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetPendingCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetPendingCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetPendingCommentsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetPendingCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetPendingCommentsResult struct {
+	Success *PendingCommentListResp `thrift:"success,0,optional" frugal:"0,optional,PendingCommentListResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetPendingCommentsResult() *InteractionServiceGetPendingCommentsResult {
+	return &InteractionServiceGetPendingCommentsResult{}
+}
+
+func (p *InteractionServiceGetPendingCommentsResult) InitDefault() {
+}
+
+var InteractionServiceGetPendingCommentsResult_Success_DEFAULT *PendingCommentListResp
+
+func (p *InteractionServiceGetPendingCommentsResult) GetSuccess() (v *PendingCommentListResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetPendingCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetPendingCommentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PendingCommentListResp)
+}
+
+func (p *InteractionServiceGetPendingCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetPendingCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetPendingCommentsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetPendingCommentsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceReviewCommentArgs struct {
+	Req *ReviewCommentReq `thrift:"req,1" frugal:"1,default,ReviewCommentReq" json:"req"`
+}
+
+func NewInteractionServiceReviewCommentArgs() *InteractionServiceReviewCommentArgs {
+	return &InteractionServiceReviewCommentArgs{}
 }
-func (p *CheckLikeStatusResp) SetBaseResp(val *common.BaseResp) {
-	p.BaseResp = val
+
+func (p *InteractionServiceReviewCommentArgs) InitDefault() {
 }
-func (p *CheckLikeStatusResp) SetIsLiked(val bool) {
-	p.IsLiked = val
+
+var InteractionServiceReviewCommentArgs_Req_DEFAULT *ReviewCommentReq
+
+func (p *InteractionServiceReviewCommentArgs) GetReq() (v *ReviewCommentReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceReviewCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceReviewCommentArgs) SetReq(val *ReviewCommentReq) {
+	p.Req = val
 }
 
-func (p *CheckLikeStatusResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *InteractionServiceReviewCommentArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CheckLikeStatusResp) String() string {
+func (p *InteractionServiceReviewCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckLikeStatusResp(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReviewCommentArgs(%+v)", *p)
 }
 
-var fieldIDToName_CheckLikeStatusResp = map[int16]string{
-	1: "BaseResp",
-	2: "isLiked",
+var fieldIDToName_InteractionServiceReviewCommentArgs = map[int16]string{
+	1: "req",
 }
 
-type CheckStarStatusReq struct {
-	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+type InteractionServiceReviewCommentResult struct {
+	Success *ReviewCommentResp `thrift:"success,0,optional" frugal:"0,optional,ReviewCommentResp" json:"success,omitempty"`
 }
 
-func NewCheckStarStatusReq() *CheckStarStatusReq {
-	return &CheckStarStatusReq{}
+func NewInteractionServiceReviewCommentResult() *InteractionServiceReviewCommentResult {
+	return &InteractionServiceReviewCommentResult{}
 }
 
-func (p *CheckStarStatusReq) InitDefault() {
+func (p *InteractionServiceReviewCommentResult) InitDefault() {
 }
 
-func (p *CheckStarStatusReq) GetUserId() (v int64) {
-	return p.UserId
-}
+var InteractionServiceReviewCommentResult_Success_DEFAULT *ReviewCommentResp
 
-func (p *CheckStarStatusReq) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *InteractionServiceReviewCommentResult) GetSuccess() (v *ReviewCommentResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceReviewCommentResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *CheckStarStatusReq) SetUserId(val int64) {
-	p.UserId = val
+func (p *InteractionServiceReviewCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewCommentResp)
 }
-func (p *CheckStarStatusReq) SetVideoId(val int64) {
-	p.VideoId = val
+
+func (p *InteractionServiceReviewCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *CheckStarStatusReq) String() string {
+func (p *InteractionServiceReviewCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckStarStatusReq(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReviewCommentResult(%+v)", *p)
 }
 
-var fieldIDToName_CheckStarStatusReq = map[int16]string{
-	1: "userId",
-	2: "videoId",
+var fieldIDToName_InteractionServiceReviewCommentResult = map[int16]string{
+	0: "success",
 }
 
-type CheckStarStatusResp struct {
-	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	IsStarred bool             `thrift:"isStarred,2" frugal:"2,default,bool" json:"isStarred"`
+type InteractionServiceShareActionArgs struct {
+	Req *ShareActionReq `thrift:"req,1" frugal:"1,default,ShareActionReq" json:"req"`
 }
 
-func NewCheckStarStatusResp() *CheckStarStatusResp {
-	return &CheckStarStatusResp{}
+func NewInteractionServiceShareActionArgs() *InteractionServiceShareActionArgs {
+	return &InteractionServiceShareActionArgs{}
 }
 
-func (p *CheckStarStatusResp) InitDefault() {
+func (p *InteractionServiceShareActionArgs) InitDefault() {
 }
 
-var CheckStarStatusResp_BaseResp_DEFAULT *common.BaseResp
+var InteractionServiceShareActionArgs_Req_DEFAULT *ShareActionReq
 
-func (p *CheckStarStatusResp) GetBaseResp() (v *common.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return CheckStarStatusResp_BaseResp_DEFAULT
+func (p *InteractionServiceShareActionArgs) GetReq() (v *ShareActionReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceShareActionArgs_Req_DEFAULT
 	}
-	return p.BaseResp
-}
-
-func (p *CheckStarStatusResp) GetIsStarred() (v bool) {
-	return p.IsStarred
-}
-func (p *CheckStarStatusResp) SetBaseResp(val *common.BaseResp) {
-	p.BaseResp = val
+	return p.Req
 }
-func (p *CheckStarStatusResp) SetIsStarred(val bool) {
-	p.IsStarred = val
+func (p *InteractionServiceShareActionArgs) SetReq(val *ShareActionReq) {
+	p.Req = val
 }
 
-func (p *CheckStarStatusResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *InteractionServiceShareActionArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CheckStarStatusResp) String() string {
+func (p *InteractionServiceShareActionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CheckStarStatusResp(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceShareActionArgs(%+v)", *p)
 }
 
-var fieldIDToName_CheckStarStatusResp = map[int16]string{
-	1: "BaseResp",
-	2: "isStarred",
+var fieldIDToName_InteractionServiceShareActionArgs = map[int16]string{
+	1: "req",
 }
 
-type InteractionService interface {
-	LikeAction(ctx context.Context, req *LikeActionReq) (r *LikeActionResp, err error)
-
-	GetLikeVideoList(ctx context.Context, req *LikeVideoListReq) (r *LikeVideoListResp, err error)
-
-	StarAction(ctx context.Context, req *StarActionReq) (r *StarActionResp, err error)
-
-	GetStarVideoList(ctx context.Context, req *StarVideoListReq) (r *StarVideoListResp, err error)
+type InteractionServiceShareActionResult struct {
+	Success *ShareActionResp `thrift:"success,0,optional" frugal:"0,optional,ShareActionResp" json:"success,omitempty"`
+}
 
-	CommentAction(ctx context.Context, req *CommentActionReq) (r *CommentActionResp, err error)
+func NewInteractionServiceShareActionResult() *InteractionServiceShareActionResult {
+	return &InteractionServiceShareActionResult{}
+}
 
-	GetCommentList(ctx context.Context, req *CommentListReq) (r *CommentListResp, err error)
+func (p *InteractionServiceShareActionResult) InitDefault() {
+}
 
-	DeleteComment(ctx context.Context, req *DeleteCommentReq) (r *DeleteCommentResp, err error)
+var InteractionServiceShareActionResult_Success_DEFAULT *ShareActionResp
 
-	ShareAction(ctx context.Context, req *ShareActionReq) (r *ShareActionResp, err error)
+func (p *InteractionServiceShareActionResult) GetSuccess() (v *ShareActionResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceShareActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceShareActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ShareActionResp)
+}
 
-	GetCount(ctx context.Context, req *CountReq) (r *CountResp, err error)
+func (p *InteractionServiceShareActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	CheckLikeStatus(ctx context.Context, req *CheckLikeStatusReq) (r *CheckLikeStatusResp, err error)
+func (p *InteractionServiceShareActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceShareActionResult(%+v)", *p)
+}
 
-	CheckStarStatus(ctx context.Context, req *CheckStarStatusReq) (r *CheckStarStatusResp, err error)
+var fieldIDToName_InteractionServiceShareActionResult = map[int16]string{
+	0: "success",
 }
 
-type InteractionServiceLikeActionArgs struct {
-	Req *LikeActionReq `thrift:"req,1" frugal:"1,default,LikeActionReq" json:"req"`
+type InteractionServiceCreateShareLinkArgs struct {
+	Req *CreateShareLinkReq `thrift:"req,1" frugal:"1,default,CreateShareLinkReq" json:"req"`
 }
 
-func NewInteractionServiceLikeActionArgs() *InteractionServiceLikeActionArgs {
-	return &InteractionServiceLikeActionArgs{}
+func NewInteractionServiceCreateShareLinkArgs() *InteractionServiceCreateShareLinkArgs {
+	return &InteractionServiceCreateShareLinkArgs{}
 }
 
-func (p *InteractionServiceLikeActionArgs) InitDefault() {
+func (p *InteractionServiceCreateShareLinkArgs) InitDefault() {
 }
 
-var InteractionServiceLikeActionArgs_Req_DEFAULT *LikeActionReq
+var InteractionServiceCreateShareLinkArgs_Req_DEFAULT *CreateShareLinkReq
 
-func (p *InteractionServiceLikeActionArgs) GetReq() (v *LikeActionReq) {
+func (p *InteractionServiceCreateShareLinkArgs) GetReq() (v *CreateShareLinkReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceLikeActionArgs_Req_DEFAULT
+		return InteractionServiceCreateShareLinkArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceLikeActionArgs) SetReq(val *LikeActionReq) {
+func (p *InteractionServiceCreateShareLinkArgs) SetReq(val *CreateShareLinkReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceLikeActionArgs) IsSetReq() bool {
+func (p *InteractionServiceCreateShareLinkArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceLikeActionArgs) String() string {
+func (p *InteractionServiceCreateShareLinkArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeActionArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCreateShareLinkArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeActionArgs = map[int16]string{
+var fieldIDToName_InteractionServiceCreateShareLinkArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceLikeActionResult struct {
-	Success *LikeActionResp `thrift:"success,0,optional" frugal:"0,optional,LikeActionResp" json:"success,omitempty"`
+type InteractionServiceCreateShareLinkResult struct {
+	Success *CreateShareLinkResp `thrift:"success,0,optional" frugal:"0,optional,CreateShareLinkResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceLikeActionResult() *InteractionServiceLikeActionResult {
-	return &InteractionServiceLikeActionResult{}
+func NewInteractionServiceCreateShareLinkResult() *InteractionServiceCreateShareLinkResult {
+	return &InteractionServiceCreateShareLinkResult{}
 }
 
-func (p *InteractionServiceLikeActionResult) InitDefault() {
+func (p *InteractionServiceCreateShareLinkResult) InitDefault() {
 }
 
-var InteractionServiceLikeActionResult_Success_DEFAULT *LikeActionResp
+var InteractionServiceCreateShareLinkResult_Success_DEFAULT *CreateShareLinkResp
 
-func (p *InteractionServiceLikeActionResult) GetSuccess() (v *LikeActionResp) {
+func (p *InteractionServiceCreateShareLinkResult) GetSuccess() (v *CreateShareLinkResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceLikeActionResult_Success_DEFAULT
+		return InteractionServiceCreateShareLinkResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceLikeActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeActionResp)
+func (p *InteractionServiceCreateShareLinkResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateShareLinkResp)
 }
 
-func (p *InteractionServiceLikeActionResult) IsSetSuccess() bool {
+func (p *InteractionServiceCreateShareLinkResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceLikeActionResult) String() string {
+func (p *InteractionServiceCreateShareLinkResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeActionResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCreateShareLinkResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeActionResult = map[int16]string{
+var fieldIDToName_InteractionServiceCreateShareLinkResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetLikeVideoListArgs struct {
-	Req *LikeVideoListReq `thrift:"req,1" frugal:"1,default,LikeVideoListReq" json:"req"`
+type InteractionServiceResolveShareLinkArgs struct {
+	Req *ResolveShareLinkReq `thrift:"req,1" frugal:"1,default,ResolveShareLinkReq" json:"req"`
 }
 
-func NewInteractionServiceGetLikeVideoListArgs() *InteractionServiceGetLikeVideoListArgs {
-	return &InteractionServiceGetLikeVideoListArgs{}
+func NewInteractionServiceResolveShareLinkArgs() *InteractionServiceResolveShareLinkArgs {
+	return &InteractionServiceResolveShareLinkArgs{}
 }
 
-func (p *InteractionServiceGetLikeVideoListArgs) InitDefault() {
+func (p *InteractionServiceResolveShareLinkArgs) InitDefault() {
 }
 
-var InteractionServiceGetLikeVideoListArgs_Req_DEFAULT *LikeVideoListReq
+var InteractionServiceResolveShareLinkArgs_Req_DEFAULT *ResolveShareLinkReq
 
-func (p *InteractionServiceGetLikeVideoListArgs) GetReq() (v *LikeVideoListReq) {
+func (p *InteractionServiceResolveShareLinkArgs) GetReq() (v *ResolveShareLinkReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetLikeVideoListArgs_Req_DEFAULT
+		return InteractionServiceResolveShareLinkArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetLikeVideoListArgs) SetReq(val *LikeVideoListReq) {
+func (p *InteractionServiceResolveShareLinkArgs) SetReq(val *ResolveShareLinkReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetLikeVideoListArgs) IsSetReq() bool {
+func (p *InteractionServiceResolveShareLinkArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetLikeVideoListArgs) String() string {
+func (p *InteractionServiceResolveShareLinkArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetLikeVideoListArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceResolveShareLinkArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetLikeVideoListArgs = map[int16]string{
+var fieldIDToName_InteractionServiceResolveShareLinkArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetLikeVideoListResult struct {
-	Success *LikeVideoListResp `thrift:"success,0,optional" frugal:"0,optional,LikeVideoListResp" json:"success,omitempty"`
+type InteractionServiceResolveShareLinkResult struct {
+	Success *ResolveShareLinkResp `thrift:"success,0,optional" frugal:"0,optional,ResolveShareLinkResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetLikeVideoListResult() *InteractionServiceGetLikeVideoListResult {
-	return &InteractionServiceGetLikeVideoListResult{}
+func NewInteractionServiceResolveShareLinkResult() *InteractionServiceResolveShareLinkResult {
+	return &InteractionServiceResolveShareLinkResult{}
 }
 
-func (p *InteractionServiceGetLikeVideoListResult) InitDefault() {
+func (p *InteractionServiceResolveShareLinkResult) InitDefault() {
 }
 
-var InteractionServiceGetLikeVideoListResult_Success_DEFAULT *LikeVideoListResp
+var InteractionServiceResolveShareLinkResult_Success_DEFAULT *ResolveShareLinkResp
 
-func (p *InteractionServiceGetLikeVideoListResult) GetSuccess() (v *LikeVideoListResp) {
+func (p *InteractionServiceResolveShareLinkResult) GetSuccess() (v *ResolveShareLinkResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetLikeVideoListResult_Success_DEFAULT
+		return InteractionServiceResolveShareLinkResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetLikeVideoListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeVideoListResp)
+func (p *InteractionServiceResolveShareLinkResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResolveShareLinkResp)
 }
 
-func (p *InteractionServiceGetLikeVideoListResult) IsSetSuccess() bool {
+func (p *InteractionServiceResolveShareLinkResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetLikeVideoListResult) String() string {
+func (p *InteractionServiceResolveShareLinkResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetLikeVideoListResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceResolveShareLinkResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetLikeVideoListResult = map[int16]string{
+var fieldIDToName_InteractionServiceResolveShareLinkResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceStarActionArgs struct {
-	Req *StarActionReq `thrift:"req,1" frugal:"1,default,StarActionReq" json:"req"`
+type InteractionServiceRecordShareConversionArgs struct {
+	Req *ShareConversionReq `thrift:"req,1" frugal:"1,default,ShareConversionReq" json:"req"`
 }
 
-func NewInteractionServiceStarActionArgs() *InteractionServiceStarActionArgs {
-	return &InteractionServiceStarActionArgs{}
+func NewInteractionServiceRecordShareConversionArgs() *InteractionServiceRecordShareConversionArgs {
+	return &InteractionServiceRecordShareConversionArgs{}
 }
 
-func (p *InteractionServiceStarActionArgs) InitDefault() {
+func (p *InteractionServiceRecordShareConversionArgs) InitDefault() {
 }
 
-var InteractionServiceStarActionArgs_Req_DEFAULT *StarActionReq
+var InteractionServiceRecordShareConversionArgs_Req_DEFAULT *ShareConversionReq
 
-func (p *InteractionServiceStarActionArgs) GetReq() (v *StarActionReq) {
+func (p *InteractionServiceRecordShareConversionArgs) GetReq() (v *ShareConversionReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceStarActionArgs_Req_DEFAULT
+		return InteractionServiceRecordShareConversionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceStarActionArgs) SetReq(val *StarActionReq) {
+func (p *InteractionServiceRecordShareConversionArgs) SetReq(val *ShareConversionReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceStarActionArgs) IsSetReq() bool {
+func (p *InteractionServiceRecordShareConversionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceStarActionArgs) String() string {
+func (p *InteractionServiceRecordShareConversionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceStarActionArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceRecordShareConversionArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceStarActionArgs = map[int16]string{
+var fieldIDToName_InteractionServiceRecordShareConversionArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceStarActionResult struct {
-	Success *StarActionResp `thrift:"success,0,optional" frugal:"0,optional,StarActionResp" json:"success,omitempty"`
+type InteractionServiceRecordShareConversionResult struct {
+	Success *ShareConversionResp `thrift:"success,0,optional" frugal:"0,optional,ShareConversionResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceStarActionResult() *InteractionServiceStarActionResult {
-	return &InteractionServiceStarActionResult{}
+func NewInteractionServiceRecordShareConversionResult() *InteractionServiceRecordShareConversionResult {
+	return &InteractionServiceRecordShareConversionResult{}
 }
 
-func (p *InteractionServiceStarActionResult) InitDefault() {
+func (p *InteractionServiceRecordShareConversionResult) InitDefault() {
 }
 
-var InteractionServiceStarActionResult_Success_DEFAULT *StarActionResp
+var InteractionServiceRecordShareConversionResult_Success_DEFAULT *ShareConversionResp
 
-func (p *InteractionServiceStarActionResult) GetSuccess() (v *StarActionResp) {
+func (p *InteractionServiceRecordShareConversionResult) GetSuccess() (v *ShareConversionResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceStarActionResult_Success_DEFAULT
+		return InteractionServiceRecordShareConversionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceStarActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*StarActionResp)
+func (p *InteractionServiceRecordShareConversionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ShareConversionResp)
 }
 
-func (p *InteractionServiceStarActionResult) IsSetSuccess() bool {
+func (p *InteractionServiceRecordShareConversionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceStarActionResult) String() string {
+func (p *InteractionServiceRecordShareConversionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceStarActionResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceRecordShareConversionResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceStarActionResult = map[int16]string{
+var fieldIDToName_InteractionServiceRecordShareConversionResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetStarVideoListArgs struct {
-	Req *StarVideoListReq `thrift:"req,1" frugal:"1,default,StarVideoListReq" json:"req"`
+type InteractionServiceGetShareStatsArgs struct {
+	Req *ShareStatsReq `thrift:"req,1" frugal:"1,default,ShareStatsReq" json:"req"`
 }
 
-func NewInteractionServiceGetStarVideoListArgs() *InteractionServiceGetStarVideoListArgs {
-	return &InteractionServiceGetStarVideoListArgs{}
+func NewInteractionServiceGetShareStatsArgs() *InteractionServiceGetShareStatsArgs {
+	return &InteractionServiceGetShareStatsArgs{}
 }
 
-func (p *InteractionServiceGetStarVideoListArgs) InitDefault() {
+func (p *InteractionServiceGetShareStatsArgs) InitDefault() {
 }
 
-var InteractionServiceGetStarVideoListArgs_Req_DEFAULT *StarVideoListReq
+var InteractionServiceGetShareStatsArgs_Req_DEFAULT *ShareStatsReq
 
-func (p *InteractionServiceGetStarVideoListArgs) GetReq() (v *StarVideoListReq) {
+func (p *InteractionServiceGetShareStatsArgs) GetReq() (v *ShareStatsReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetStarVideoListArgs_Req_DEFAULT
+		return InteractionServiceGetShareStatsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetStarVideoListArgs) SetReq(val *StarVideoListReq) {
+func (p *InteractionServiceGetShareStatsArgs) SetReq(val *ShareStatsReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetStarVideoListArgs) IsSetReq() bool {
+func (p *InteractionServiceGetShareStatsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetStarVideoListArgs) String() string {
+func (p *InteractionServiceGetShareStatsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetStarVideoListArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetShareStatsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetStarVideoListArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetShareStatsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetStarVideoListResult struct {
-	Success *StarVideoListResp `thrift:"success,0,optional" frugal:"0,optional,StarVideoListResp" json:"success,omitempty"`
+type InteractionServiceGetShareStatsResult struct {
+	Success *ShareStatsResp `thrift:"success,0,optional" frugal:"0,optional,ShareStatsResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetStarVideoListResult() *InteractionServiceGetStarVideoListResult {
-	return &InteractionServiceGetStarVideoListResult{}
+func NewInteractionServiceGetShareStatsResult() *InteractionServiceGetShareStatsResult {
+	return &InteractionServiceGetShareStatsResult{}
 }
 
-func (p *InteractionServiceGetStarVideoListResult) InitDefault() {
+func (p *InteractionServiceGetShareStatsResult) InitDefault() {
 }
 
-var InteractionServiceGetStarVideoListResult_Success_DEFAULT *StarVideoListResp
+var InteractionServiceGetShareStatsResult_Success_DEFAULT *ShareStatsResp
 
-func (p *InteractionServiceGetStarVideoListResult) GetSuccess() (v *StarVideoListResp) {
+func (p *InteractionServiceGetShareStatsResult) GetSuccess() (v *ShareStatsResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetStarVideoListResult_Success_DEFAULT
+		return InteractionServiceGetShareStatsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetStarVideoListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*StarVideoListResp)
+func (p *InteractionServiceGetShareStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ShareStatsResp)
 }
 
-func (p *InteractionServiceGetStarVideoListResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetShareStatsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetStarVideoListResult) String() string {
+func (p *InteractionServiceGetShareStatsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetStarVideoListResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetShareStatsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetStarVideoListResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetShareStatsResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceCommentActionArgs struct {
-	Req *CommentActionReq `thrift:"req,1" frugal:"1,default,CommentActionReq" json:"req"`
+type InteractionServiceGetInvitedByArgs struct {
+	Req *InvitedByReq `thrift:"req,1" frugal:"1,default,InvitedByReq" json:"req"`
 }
 
-func NewInteractionServiceCommentActionArgs() *InteractionServiceCommentActionArgs {
-	return &InteractionServiceCommentActionArgs{}
+func NewInteractionServiceGetInvitedByArgs() *InteractionServiceGetInvitedByArgs {
+	return &InteractionServiceGetInvitedByArgs{}
 }
 
-func (p *InteractionServiceCommentActionArgs) InitDefault() {
+func (p *InteractionServiceGetInvitedByArgs) InitDefault() {
 }
 
-var InteractionServiceCommentActionArgs_Req_DEFAULT *CommentActionReq
+var InteractionServiceGetInvitedByArgs_Req_DEFAULT *InvitedByReq
 
-func (p *InteractionServiceCommentActionArgs) GetReq() (v *CommentActionReq) {
+func (p *InteractionServiceGetInvitedByArgs) GetReq() (v *InvitedByReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceCommentActionArgs_Req_DEFAULT
+		return InteractionServiceGetInvitedByArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceCommentActionArgs) SetReq(val *CommentActionReq) {
+func (p *InteractionServiceGetInvitedByArgs) SetReq(val *InvitedByReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceCommentActionArgs) IsSetReq() bool {
+func (p *InteractionServiceGetInvitedByArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceCommentActionArgs) String() string {
+func (p *InteractionServiceGetInvitedByArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCommentActionArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetInvitedByArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCommentActionArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetInvitedByArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceCommentActionResult struct {
-	Success *CommentActionResp `thrift:"success,0,optional" frugal:"0,optional,CommentActionResp" json:"success,omitempty"`
+type InteractionServiceGetInvitedByResult struct {
+	Success *InvitedByResp `thrift:"success,0,optional" frugal:"0,optional,InvitedByResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceCommentActionResult() *InteractionServiceCommentActionResult {
-	return &InteractionServiceCommentActionResult{}
+func NewInteractionServiceGetInvitedByResult() *InteractionServiceGetInvitedByResult {
+	return &InteractionServiceGetInvitedByResult{}
 }
 
-func (p *InteractionServiceCommentActionResult) InitDefault() {
+func (p *InteractionServiceGetInvitedByResult) InitDefault() {
 }
 
-var InteractionServiceCommentActionResult_Success_DEFAULT *CommentActionResp
+var InteractionServiceGetInvitedByResult_Success_DEFAULT *InvitedByResp
 
-func (p *InteractionServiceCommentActionResult) GetSuccess() (v *CommentActionResp) {
+func (p *InteractionServiceGetInvitedByResult) GetSuccess() (v *InvitedByResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceCommentActionResult_Success_DEFAULT
+		return InteractionServiceGetInvitedByResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceCommentActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommentActionResp)
+func (p *InteractionServiceGetInvitedByResult) SetSuccess(x interface{}) {
+	p.Success = x.(*InvitedByResp)
 }
 
-func (p *InteractionServiceCommentActionResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetInvitedByResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceCommentActionResult) String() string {
+func (p *InteractionServiceGetInvitedByResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCommentActionResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetInvitedByResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCommentActionResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetInvitedByResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetCommentListArgs struct {
-	Req *CommentListReq `thrift:"req,1" frugal:"1,default,CommentListReq" json:"req"`
+type InteractionServiceGetCountArgs struct {
+	Req *CountReq `thrift:"req,1" frugal:"1,default,CountReq" json:"req"`
 }
 
-func NewInteractionServiceGetCommentListArgs() *InteractionServiceGetCommentListArgs {
-	return &InteractionServiceGetCommentListArgs{}
+func NewInteractionServiceGetCountArgs() *InteractionServiceGetCountArgs {
+	return &InteractionServiceGetCountArgs{}
 }
 
-func (p *InteractionServiceGetCommentListArgs) InitDefault() {
+func (p *InteractionServiceGetCountArgs) InitDefault() {
 }
 
-var InteractionServiceGetCommentListArgs_Req_DEFAULT *CommentListReq
+var InteractionServiceGetCountArgs_Req_DEFAULT *CountReq
 
-func (p *InteractionServiceGetCommentListArgs) GetReq() (v *CommentListReq) {
+func (p *InteractionServiceGetCountArgs) GetReq() (v *CountReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetCommentListArgs_Req_DEFAULT
+		return InteractionServiceGetCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetCommentListArgs) SetReq(val *CommentListReq) {
+func (p *InteractionServiceGetCountArgs) SetReq(val *CountReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetCommentListArgs) IsSetReq() bool {
+func (p *InteractionServiceGetCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetCommentListArgs) String() string {
+func (p *InteractionServiceGetCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCommentListArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCountArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCommentListArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetCountArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetCommentListResult struct {
-	Success *CommentListResp `thrift:"success,0,optional" frugal:"0,optional,CommentListResp" json:"success,omitempty"`
+type InteractionServiceGetCountResult struct {
+	Success *CountResp `thrift:"success,0,optional" frugal:"0,optional,CountResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetCommentListResult() *InteractionServiceGetCommentListResult {
-	return &InteractionServiceGetCommentListResult{}
+func NewInteractionServiceGetCountResult() *InteractionServiceGetCountResult {
+	return &InteractionServiceGetCountResult{}
 }
 
-func (p *InteractionServiceGetCommentListResult) InitDefault() {
+func (p *InteractionServiceGetCountResult) InitDefault() {
 }
 
-var InteractionServiceGetCommentListResult_Success_DEFAULT *CommentListResp
+var InteractionServiceGetCountResult_Success_DEFAULT *CountResp
 
-func (p *InteractionServiceGetCommentListResult) GetSuccess() (v *CommentListResp) {
+func (p *InteractionServiceGetCountResult) GetSuccess() (v *CountResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetCommentListResult_Success_DEFAULT
+		return InteractionServiceGetCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetCommentListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommentListResp)
+func (p *InteractionServiceGetCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CountResp)
 }
 
-func (p *InteractionServiceGetCommentListResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetCommentListResult) String() string {
+func (p *InteractionServiceGetCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCommentListResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCountResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCommentListResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetCountResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceDeleteCommentArgs struct {
-	Req *DeleteCommentReq `thrift:"req,1" frugal:"1,default,DeleteCommentReq" json:"req"`
+type InteractionServiceCheckLikeStatusArgs struct {
+	Req *CheckLikeStatusReq `thrift:"req,1" frugal:"1,default,CheckLikeStatusReq" json:"req"`
 }
 
-func NewInteractionServiceDeleteCommentArgs() *InteractionServiceDeleteCommentArgs {
-	return &InteractionServiceDeleteCommentArgs{}
+func NewInteractionServiceCheckLikeStatusArgs() *InteractionServiceCheckLikeStatusArgs {
+	return &InteractionServiceCheckLikeStatusArgs{}
 }
 
-func (p *InteractionServiceDeleteCommentArgs) InitDefault() {
+func (p *InteractionServiceCheckLikeStatusArgs) InitDefault() {
 }
 
-var InteractionServiceDeleteCommentArgs_Req_DEFAULT *DeleteCommentReq
+var InteractionServiceCheckLikeStatusArgs_Req_DEFAULT *CheckLikeStatusReq
 
-func (p *InteractionServiceDeleteCommentArgs) GetReq() (v *DeleteCommentReq) {
+func (p *InteractionServiceCheckLikeStatusArgs) GetReq() (v *CheckLikeStatusReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceDeleteCommentArgs_Req_DEFAULT
+		return InteractionServiceCheckLikeStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceDeleteCommentArgs) SetReq(val *DeleteCommentReq) {
+func (p *InteractionServiceCheckLikeStatusArgs) SetReq(val *CheckLikeStatusReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceDeleteCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceCheckLikeStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceDeleteCommentArgs) String() string {
+func (p *InteractionServiceCheckLikeStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceDeleteCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCheckLikeStatusArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceDeleteCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceCheckLikeStatusArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceDeleteCommentResult struct {
-	Success *DeleteCommentResp `thrift:"success,0,optional" frugal:"0,optional,DeleteCommentResp" json:"success,omitempty"`
+type InteractionServiceCheckLikeStatusResult struct {
+	Success *CheckLikeStatusResp `thrift:"success,0,optional" frugal:"0,optional,CheckLikeStatusResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceDeleteCommentResult() *InteractionServiceDeleteCommentResult {
-	return &InteractionServiceDeleteCommentResult{}
+func NewInteractionServiceCheckLikeStatusResult() *InteractionServiceCheckLikeStatusResult {
+	return &InteractionServiceCheckLikeStatusResult{}
 }
 
-func (p *InteractionServiceDeleteCommentResult) InitDefault() {
+func (p *InteractionServiceCheckLikeStatusResult) InitDefault() {
 }
 
-var InteractionServiceDeleteCommentResult_Success_DEFAULT *DeleteCommentResp
+var InteractionServiceCheckLikeStatusResult_Success_DEFAULT *CheckLikeStatusResp
 
-func (p *InteractionServiceDeleteCommentResult) GetSuccess() (v *DeleteCommentResp) {
+func (p *InteractionServiceCheckLikeStatusResult) GetSuccess() (v *CheckLikeStatusResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceDeleteCommentResult_Success_DEFAULT
+		return InteractionServiceCheckLikeStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceDeleteCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteCommentResp)
+func (p *InteractionServiceCheckLikeStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckLikeStatusResp)
 }
 
-func (p *InteractionServiceDeleteCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceCheckLikeStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceDeleteCommentResult) String() string {
+func (p *InteractionServiceCheckLikeStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceDeleteCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCheckLikeStatusResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceDeleteCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceCheckLikeStatusResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceShareActionArgs struct {
-	Req *ShareActionReq `thrift:"req,1" frugal:"1,default,ShareActionReq" json:"req"`
+type InteractionServiceReactionActionArgs struct {
+	Req *ReactionActionReq `thrift:"req,1" frugal:"1,default,ReactionActionReq" json:"req"`
 }
 
-func NewInteractionServiceShareActionArgs() *InteractionServiceShareActionArgs {
-	return &InteractionServiceShareActionArgs{}
+func NewInteractionServiceReactionActionArgs() *InteractionServiceReactionActionArgs {
+	return &InteractionServiceReactionActionArgs{}
 }
 
-func (p *InteractionServiceShareActionArgs) InitDefault() {
+func (p *InteractionServiceReactionActionArgs) InitDefault() {
 }
 
-var InteractionServiceShareActionArgs_Req_DEFAULT *ShareActionReq
+var InteractionServiceReactionActionArgs_Req_DEFAULT *ReactionActionReq
 
-func (p *InteractionServiceShareActionArgs) GetReq() (v *ShareActionReq) {
+func (p *InteractionServiceReactionActionArgs) GetReq() (v *ReactionActionReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceShareActionArgs_Req_DEFAULT
+		return InteractionServiceReactionActionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceShareActionArgs) SetReq(val *ShareActionReq) {
+func (p *InteractionServiceReactionActionArgs) SetReq(val *ReactionActionReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceShareActionArgs) IsSetReq() bool {
+func (p *InteractionServiceReactionActionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceShareActionArgs) String() string {
+func (p *InteractionServiceReactionActionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceShareActionArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReactionActionArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceShareActionArgs = map[int16]string{
+var fieldIDToName_InteractionServiceReactionActionArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceShareActionResult struct {
-	Success *ShareActionResp `thrift:"success,0,optional" frugal:"0,optional,ShareActionResp" json:"success,omitempty"`
+type InteractionServiceReactionActionResult struct {
+	Success *ReactionActionResp `thrift:"success,0,optional" frugal:"0,optional,ReactionActionResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceShareActionResult() *InteractionServiceShareActionResult {
-	return &InteractionServiceShareActionResult{}
+func NewInteractionServiceReactionActionResult() *InteractionServiceReactionActionResult {
+	return &InteractionServiceReactionActionResult{}
 }
 
-func (p *InteractionServiceShareActionResult) InitDefault() {
+func (p *InteractionServiceReactionActionResult) InitDefault() {
 }
 
-var InteractionServiceShareActionResult_Success_DEFAULT *ShareActionResp
+var InteractionServiceReactionActionResult_Success_DEFAULT *ReactionActionResp
 
-func (p *InteractionServiceShareActionResult) GetSuccess() (v *ShareActionResp) {
+func (p *InteractionServiceReactionActionResult) GetSuccess() (v *ReactionActionResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceShareActionResult_Success_DEFAULT
+		return InteractionServiceReactionActionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceShareActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ShareActionResp)
+func (p *InteractionServiceReactionActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReactionActionResp)
 }
 
-func (p *InteractionServiceShareActionResult) IsSetSuccess() bool {
+func (p *InteractionServiceReactionActionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceShareActionResult) String() string {
+func (p *InteractionServiceReactionActionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceShareActionResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReactionActionResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceShareActionResult = map[int16]string{
+var fieldIDToName_InteractionServiceReactionActionResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetCountArgs struct {
-	Req *CountReq `thrift:"req,1" frugal:"1,default,CountReq" json:"req"`
+type InteractionServiceBatchGetReactionsArgs struct {
+	Req *BatchGetReactionsReq `thrift:"req,1" frugal:"1,default,BatchGetReactionsReq" json:"req"`
 }
 
-func NewInteractionServiceGetCountArgs() *InteractionServiceGetCountArgs {
-	return &InteractionServiceGetCountArgs{}
+func NewInteractionServiceBatchGetReactionsArgs() *InteractionServiceBatchGetReactionsArgs {
+	return &InteractionServiceBatchGetReactionsArgs{}
 }
 
-func (p *InteractionServiceGetCountArgs) InitDefault() {
+func (p *InteractionServiceBatchGetReactionsArgs) InitDefault() {
 }
 
-var InteractionServiceGetCountArgs_Req_DEFAULT *CountReq
+var InteractionServiceBatchGetReactionsArgs_Req_DEFAULT *BatchGetReactionsReq
 
-func (p *InteractionServiceGetCountArgs) GetReq() (v *CountReq) {
+func (p *InteractionServiceBatchGetReactionsArgs) GetReq() (v *BatchGetReactionsReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetCountArgs_Req_DEFAULT
+		return InteractionServiceBatchGetReactionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetCountArgs) SetReq(val *CountReq) {
+func (p *InteractionServiceBatchGetReactionsArgs) SetReq(val *BatchGetReactionsReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetCountArgs) IsSetReq() bool {
+func (p *InteractionServiceBatchGetReactionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetCountArgs) String() string {
+func (p *InteractionServiceBatchGetReactionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCountArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceBatchGetReactionsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCountArgs = map[int16]string{
+var fieldIDToName_InteractionServiceBatchGetReactionsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetCountResult struct {
-	Success *CountResp `thrift:"success,0,optional" frugal:"0,optional,CountResp" json:"success,omitempty"`
+type InteractionServiceBatchGetReactionsResult struct {
+	Success *BatchGetReactionsResp `thrift:"success,0,optional" frugal:"0,optional,BatchGetReactionsResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetCountResult() *InteractionServiceGetCountResult {
-	return &InteractionServiceGetCountResult{}
+func NewInteractionServiceBatchGetReactionsResult() *InteractionServiceBatchGetReactionsResult {
+	return &InteractionServiceBatchGetReactionsResult{}
 }
 
-func (p *InteractionServiceGetCountResult) InitDefault() {
+func (p *InteractionServiceBatchGetReactionsResult) InitDefault() {
 }
 
-var InteractionServiceGetCountResult_Success_DEFAULT *CountResp
+var InteractionServiceBatchGetReactionsResult_Success_DEFAULT *BatchGetReactionsResp
 
-func (p *InteractionServiceGetCountResult) GetSuccess() (v *CountResp) {
+func (p *InteractionServiceBatchGetReactionsResult) GetSuccess() (v *BatchGetReactionsResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetCountResult_Success_DEFAULT
+		return InteractionServiceBatchGetReactionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CountResp)
+func (p *InteractionServiceBatchGetReactionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetReactionsResp)
 }
 
-func (p *InteractionServiceGetCountResult) IsSetSuccess() bool {
+func (p *InteractionServiceBatchGetReactionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetCountResult) String() string {
+func (p *InteractionServiceBatchGetReactionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCountResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceBatchGetReactionsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCountResult = map[int16]string{
+var fieldIDToName_InteractionServiceBatchGetReactionsResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceCheckLikeStatusArgs struct {
-	Req *CheckLikeStatusReq `thrift:"req,1" frugal:"1,default,CheckLikeStatusReq" json:"req"`
+type InteractionServiceGetReactionUsersArgs struct {
+	Req *ReactionUserListReq `thrift:"req,1" frugal:"1,default,ReactionUserListReq" json:"req"`
 }
 
-func NewInteractionServiceCheckLikeStatusArgs() *InteractionServiceCheckLikeStatusArgs {
-	return &InteractionServiceCheckLikeStatusArgs{}
+func NewInteractionServiceGetReactionUsersArgs() *InteractionServiceGetReactionUsersArgs {
+	return &InteractionServiceGetReactionUsersArgs{}
 }
 
-func (p *InteractionServiceCheckLikeStatusArgs) InitDefault() {
+func (p *InteractionServiceGetReactionUsersArgs) InitDefault() {
 }
 
-var InteractionServiceCheckLikeStatusArgs_Req_DEFAULT *CheckLikeStatusReq
+var InteractionServiceGetReactionUsersArgs_Req_DEFAULT *ReactionUserListReq
 
-func (p *InteractionServiceCheckLikeStatusArgs) GetReq() (v *CheckLikeStatusReq) {
+func (p *InteractionServiceGetReactionUsersArgs) GetReq() (v *ReactionUserListReq) {
 	if !p.IsSetReq() {
-		return InteractionServiceCheckLikeStatusArgs_Req_DEFAULT
+		return InteractionServiceGetReactionUsersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceCheckLikeStatusArgs) SetReq(val *CheckLikeStatusReq) {
+func (p *InteractionServiceGetReactionUsersArgs) SetReq(val *ReactionUserListReq) {
 	p.Req = val
 }
 
-func (p *InteractionServiceCheckLikeStatusArgs) IsSetReq() bool {
+func (p *InteractionServiceGetReactionUsersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceCheckLikeStatusArgs) String() string {
+func (p *InteractionServiceGetReactionUsersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCheckLikeStatusArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetReactionUsersArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCheckLikeStatusArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetReactionUsersArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceCheckLikeStatusResult struct {
-	Success *CheckLikeStatusResp `thrift:"success,0,optional" frugal:"0,optional,CheckLikeStatusResp" json:"success,omitempty"`
+type InteractionServiceGetReactionUsersResult struct {
+	Success *ReactionUserListResp `thrift:"success,0,optional" frugal:"0,optional,ReactionUserListResp" json:"success,omitempty"`
 }
 
-func NewInteractionServiceCheckLikeStatusResult() *InteractionServiceCheckLikeStatusResult {
-	return &InteractionServiceCheckLikeStatusResult{}
+func NewInteractionServiceGetReactionUsersResult() *InteractionServiceGetReactionUsersResult {
+	return &InteractionServiceGetReactionUsersResult{}
 }
 
-func (p *InteractionServiceCheckLikeStatusResult) InitDefault() {
+func (p *InteractionServiceGetReactionUsersResult) InitDefault() {
 }
 
-var InteractionServiceCheckLikeStatusResult_Success_DEFAULT *CheckLikeStatusResp
+var InteractionServiceGetReactionUsersResult_Success_DEFAULT *ReactionUserListResp
 
-func (p *InteractionServiceCheckLikeStatusResult) GetSuccess() (v *CheckLikeStatusResp) {
+func (p *InteractionServiceGetReactionUsersResult) GetSuccess() (v *ReactionUserListResp) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceCheckLikeStatusResult_Success_DEFAULT
+		return InteractionServiceGetReactionUsersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceCheckLikeStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckLikeStatusResp)
+func (p *InteractionServiceGetReactionUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReactionUserListResp)
 }
 
-func (p *InteractionServiceCheckLikeStatusResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetReactionUsersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceCheckLikeStatusResult) String() string {
+func (p *InteractionServiceGetReactionUsersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCheckLikeStatusResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetReactionUsersResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCheckLikeStatusResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetReactionUsersResult = map[int16]string{
 	0: "success",
 }
 
@@ -1928,3 +6989,79 @@ func (p *InteractionServiceCheckStarStatusResult) String() string {
 var fieldIDToName_InteractionServiceCheckStarStatusResult = map[int16]string{
 	0: "success",
 }
+
+type InteractionServiceBatchCheckInteractionStatusArgs struct {
+	Req *BatchCheckInteractionStatusReq `thrift:"req,1" frugal:"1,default,BatchCheckInteractionStatusReq" json:"req"`
+}
+
+func NewInteractionServiceBatchCheckInteractionStatusArgs() *InteractionServiceBatchCheckInteractionStatusArgs {
+	return &InteractionServiceBatchCheckInteractionStatusArgs{}
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusArgs) InitDefault() {
+}
+
+var InteractionServiceBatchCheckInteractionStatusArgs_Req_DEFAULT *BatchCheckInteractionStatusReq
+
+func (p *InteractionServiceBatchCheckInteractionStatusArgs) GetReq() (v *BatchCheckInteractionStatusReq) {
+	if !p.IsSetReq() {
+		return InteractionServiceBatchCheckInteractionStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceBatchCheckInteractionStatusArgs) SetReq(val *BatchCheckInteractionStatusReq) {
+	p.Req = val
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceBatchCheckInteractionStatusArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceBatchCheckInteractionStatusArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceBatchCheckInteractionStatusResult struct {
+	Success *BatchCheckInteractionStatusResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckInteractionStatusResp" json:"success,omitempty"`
+}
+
+func NewInteractionServiceBatchCheckInteractionStatusResult() *InteractionServiceBatchCheckInteractionStatusResult {
+	return &InteractionServiceBatchCheckInteractionStatusResult{}
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusResult) InitDefault() {
+}
+
+var InteractionServiceBatchCheckInteractionStatusResult_Success_DEFAULT *BatchCheckInteractionStatusResp
+
+func (p *InteractionServiceBatchCheckInteractionStatusResult) GetSuccess() (v *BatchCheckInteractionStatusResp) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceBatchCheckInteractionStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceBatchCheckInteractionStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchCheckInteractionStatusResp)
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceBatchCheckInteractionStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceBatchCheckInteractionStatusResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceBatchCheckInteractionStatusResult = map[int16]string{
+	0: "success",
+}
@@ -15,13 +15,39 @@ type Client interface {
 	GetLikeVideoList(ctx context.Context, req *interaction.LikeVideoListReq, callOptions ...callopt.Option) (r *interaction.LikeVideoListResp, err error)
 	StarAction(ctx context.Context, req *interaction.StarActionReq, callOptions ...callopt.Option) (r *interaction.StarActionResp, err error)
 	GetStarVideoList(ctx context.Context, req *interaction.StarVideoListReq, callOptions ...callopt.Option) (r *interaction.StarVideoListResp, err error)
+	CreateStarFolder(ctx context.Context, req *interaction.CreateStarFolderReq, callOptions ...callopt.Option) (r *interaction.CreateStarFolderResp, err error)
+	UpdateStarFolder(ctx context.Context, req *interaction.UpdateStarFolderReq, callOptions ...callopt.Option) (r *interaction.UpdateStarFolderResp, err error)
+	DeleteStarFolder(ctx context.Context, req *interaction.DeleteStarFolderReq, callOptions ...callopt.Option) (r *interaction.DeleteStarFolderResp, err error)
+	GetStarFolders(ctx context.Context, req *interaction.StarFolderListReq, callOptions ...callopt.Option) (r *interaction.StarFolderListResp, err error)
+	StarFolderItemAction(ctx context.Context, req *interaction.StarFolderItemActionReq, callOptions ...callopt.Option) (r *interaction.StarFolderItemActionResp, err error)
+	MoveStarVideo(ctx context.Context, req *interaction.MoveStarVideoReq, callOptions ...callopt.Option) (r *interaction.MoveStarVideoResp, err error)
+	StarFolderFollowAction(ctx context.Context, req *interaction.StarFolderFollowReq, callOptions ...callopt.Option) (r *interaction.StarFolderFollowResp, err error)
+	GetFollowedStarFolders(ctx context.Context, req *interaction.FollowedStarFolderListReq, callOptions ...callopt.Option) (r *interaction.FollowedStarFolderListResp, err error)
 	CommentAction(ctx context.Context, req *interaction.CommentActionReq, callOptions ...callopt.Option) (r *interaction.CommentActionResp, err error)
 	GetCommentList(ctx context.Context, req *interaction.CommentListReq, callOptions ...callopt.Option) (r *interaction.CommentListResp, err error)
+	GetCommentReplies(ctx context.Context, req *interaction.CommentRepliesReq, callOptions ...callopt.Option) (r *interaction.CommentRepliesResp, err error)
 	DeleteComment(ctx context.Context, req *interaction.DeleteCommentReq, callOptions ...callopt.Option) (r *interaction.DeleteCommentResp, err error)
+	CommentLikeAction(ctx context.Context, req *interaction.CommentLikeActionReq, callOptions ...callopt.Option) (r *interaction.CommentLikeActionResp, err error)
+	PinComment(ctx context.Context, req *interaction.PinCommentReq, callOptions ...callopt.Option) (r *interaction.PinCommentResp, err error)
+	SetCommentPermission(ctx context.Context, req *interaction.SetCommentPermissionReq, callOptions ...callopt.Option) (r *interaction.SetCommentPermissionResp, err error)
+	GetCommentPermission(ctx context.Context, req *interaction.GetCommentPermissionReq, callOptions ...callopt.Option) (r *interaction.GetCommentPermissionResp, err error)
+	CommentKeywordAction(ctx context.Context, req *interaction.CommentKeywordActionReq, callOptions ...callopt.Option) (r *interaction.CommentKeywordActionResp, err error)
+	GetCommentKeywords(ctx context.Context, req *interaction.CommentKeywordListReq, callOptions ...callopt.Option) (r *interaction.CommentKeywordListResp, err error)
+	GetPendingComments(ctx context.Context, req *interaction.PendingCommentListReq, callOptions ...callopt.Option) (r *interaction.PendingCommentListResp, err error)
+	ReviewComment(ctx context.Context, req *interaction.ReviewCommentReq, callOptions ...callopt.Option) (r *interaction.ReviewCommentResp, err error)
 	ShareAction(ctx context.Context, req *interaction.ShareActionReq, callOptions ...callopt.Option) (r *interaction.ShareActionResp, err error)
+	CreateShareLink(ctx context.Context, req *interaction.CreateShareLinkReq, callOptions ...callopt.Option) (r *interaction.CreateShareLinkResp, err error)
+	ResolveShareLink(ctx context.Context, req *interaction.ResolveShareLinkReq, callOptions ...callopt.Option) (r *interaction.ResolveShareLinkResp, err error)
+	RecordShareConversion(ctx context.Context, req *interaction.ShareConversionReq, callOptions ...callopt.Option) (r *interaction.ShareConversionResp, err error)
+	GetShareStats(ctx context.Context, req *interaction.ShareStatsReq, callOptions ...callopt.Option) (r *interaction.ShareStatsResp, err error)
+	GetInvitedBy(ctx context.Context, req *interaction.InvitedByReq, callOptions ...callopt.Option) (r *interaction.InvitedByResp, err error)
 	GetCount(ctx context.Context, req *interaction.CountReq, callOptions ...callopt.Option) (r *interaction.CountResp, err error)
 	CheckLikeStatus(ctx context.Context, req *interaction.CheckLikeStatusReq, callOptions ...callopt.Option) (r *interaction.CheckLikeStatusResp, err error)
+	ReactionAction(ctx context.Context, req *interaction.ReactionActionReq, callOptions ...callopt.Option) (r *interaction.ReactionActionResp, err error)
+	BatchGetReactions(ctx context.Context, req *interaction.BatchGetReactionsReq, callOptions ...callopt.Option) (r *interaction.BatchGetReactionsResp, err error)
+	GetReactionUsers(ctx context.Context, req *interaction.ReactionUserListReq, callOptions ...callopt.Option) (r *interaction.ReactionUserListResp, err error)
 	CheckStarStatus(ctx context.Context, req *interaction.CheckStarStatusReq, callOptions ...callopt.Option) (r *interaction.CheckStarStatusResp, err error)
+	BatchCheckInteractionStatus(ctx context.Context, req *interaction.BatchCheckInteractionStatusReq, callOptions ...callopt.Option) (r *interaction.BatchCheckInteractionStatusResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -73,6 +99,46 @@ func (p *kInteractionServiceClient) GetStarVideoList(ctx context.Context, req *i
 	return p.kClient.GetStarVideoList(ctx, req)
 }
 
+func (p *kInteractionServiceClient) CreateStarFolder(ctx context.Context, req *interaction.CreateStarFolderReq, callOptions ...callopt.Option) (r *interaction.CreateStarFolderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateStarFolder(ctx, req)
+}
+
+func (p *kInteractionServiceClient) UpdateStarFolder(ctx context.Context, req *interaction.UpdateStarFolderReq, callOptions ...callopt.Option) (r *interaction.UpdateStarFolderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateStarFolder(ctx, req)
+}
+
+func (p *kInteractionServiceClient) DeleteStarFolder(ctx context.Context, req *interaction.DeleteStarFolderReq, callOptions ...callopt.Option) (r *interaction.DeleteStarFolderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteStarFolder(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetStarFolders(ctx context.Context, req *interaction.StarFolderListReq, callOptions ...callopt.Option) (r *interaction.StarFolderListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetStarFolders(ctx, req)
+}
+
+func (p *kInteractionServiceClient) StarFolderItemAction(ctx context.Context, req *interaction.StarFolderItemActionReq, callOptions ...callopt.Option) (r *interaction.StarFolderItemActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.StarFolderItemAction(ctx, req)
+}
+
+func (p *kInteractionServiceClient) MoveStarVideo(ctx context.Context, req *interaction.MoveStarVideoReq, callOptions ...callopt.Option) (r *interaction.MoveStarVideoResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MoveStarVideo(ctx, req)
+}
+
+func (p *kInteractionServiceClient) StarFolderFollowAction(ctx context.Context, req *interaction.StarFolderFollowReq, callOptions ...callopt.Option) (r *interaction.StarFolderFollowResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.StarFolderFollowAction(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetFollowedStarFolders(ctx context.Context, req *interaction.FollowedStarFolderListReq, callOptions ...callopt.Option) (r *interaction.FollowedStarFolderListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowedStarFolders(ctx, req)
+}
+
 func (p *kInteractionServiceClient) CommentAction(ctx context.Context, req *interaction.CommentActionReq, callOptions ...callopt.Option) (r *interaction.CommentActionResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CommentAction(ctx, req)
@@ -83,16 +149,86 @@ func (p *kInteractionServiceClient) GetCommentList(ctx context.Context, req *int
 	return p.kClient.GetCommentList(ctx, req)
 }
 
+func (p *kInteractionServiceClient) GetCommentReplies(ctx context.Context, req *interaction.CommentRepliesReq, callOptions ...callopt.Option) (r *interaction.CommentRepliesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCommentReplies(ctx, req)
+}
+
 func (p *kInteractionServiceClient) DeleteComment(ctx context.Context, req *interaction.DeleteCommentReq, callOptions ...callopt.Option) (r *interaction.DeleteCommentResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DeleteComment(ctx, req)
 }
 
+func (p *kInteractionServiceClient) CommentLikeAction(ctx context.Context, req *interaction.CommentLikeActionReq, callOptions ...callopt.Option) (r *interaction.CommentLikeActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CommentLikeAction(ctx, req)
+}
+
+func (p *kInteractionServiceClient) PinComment(ctx context.Context, req *interaction.PinCommentReq, callOptions ...callopt.Option) (r *interaction.PinCommentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PinComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) SetCommentPermission(ctx context.Context, req *interaction.SetCommentPermissionReq, callOptions ...callopt.Option) (r *interaction.SetCommentPermissionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetCommentPermission(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCommentPermission(ctx context.Context, req *interaction.GetCommentPermissionReq, callOptions ...callopt.Option) (r *interaction.GetCommentPermissionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCommentPermission(ctx, req)
+}
+
+func (p *kInteractionServiceClient) CommentKeywordAction(ctx context.Context, req *interaction.CommentKeywordActionReq, callOptions ...callopt.Option) (r *interaction.CommentKeywordActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CommentKeywordAction(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCommentKeywords(ctx context.Context, req *interaction.CommentKeywordListReq, callOptions ...callopt.Option) (r *interaction.CommentKeywordListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCommentKeywords(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetPendingComments(ctx context.Context, req *interaction.PendingCommentListReq, callOptions ...callopt.Option) (r *interaction.PendingCommentListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPendingComments(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ReviewComment(ctx context.Context, req *interaction.ReviewCommentReq, callOptions ...callopt.Option) (r *interaction.ReviewCommentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewComment(ctx, req)
+}
+
 func (p *kInteractionServiceClient) ShareAction(ctx context.Context, req *interaction.ShareActionReq, callOptions ...callopt.Option) (r *interaction.ShareActionResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ShareAction(ctx, req)
 }
 
+func (p *kInteractionServiceClient) CreateShareLink(ctx context.Context, req *interaction.CreateShareLinkReq, callOptions ...callopt.Option) (r *interaction.CreateShareLinkResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateShareLink(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ResolveShareLink(ctx context.Context, req *interaction.ResolveShareLinkReq, callOptions ...callopt.Option) (r *interaction.ResolveShareLinkResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveShareLink(ctx, req)
+}
+
+func (p *kInteractionServiceClient) RecordShareConversion(ctx context.Context, req *interaction.ShareConversionReq, callOptions ...callopt.Option) (r *interaction.ShareConversionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecordShareConversion(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetShareStats(ctx context.Context, req *interaction.ShareStatsReq, callOptions ...callopt.Option) (r *interaction.ShareStatsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetShareStats(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetInvitedBy(ctx context.Context, req *interaction.InvitedByReq, callOptions ...callopt.Option) (r *interaction.InvitedByResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetInvitedBy(ctx, req)
+}
+
 func (p *kInteractionServiceClient) GetCount(ctx context.Context, req *interaction.CountReq, callOptions ...callopt.Option) (r *interaction.CountResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetCount(ctx, req)
@@ -103,7 +239,27 @@ func (p *kInteractionServiceClient) CheckLikeStatus(ctx context.Context, req *in
 	return p.kClient.CheckLikeStatus(ctx, req)
 }
 
+func (p *kInteractionServiceClient) ReactionAction(ctx context.Context, req *interaction.ReactionActionReq, callOptions ...callopt.Option) (r *interaction.ReactionActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReactionAction(ctx, req)
+}
+
+func (p *kInteractionServiceClient) BatchGetReactions(ctx context.Context, req *interaction.BatchGetReactionsReq, callOptions ...callopt.Option) (r *interaction.BatchGetReactionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchGetReactions(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetReactionUsers(ctx context.Context, req *interaction.ReactionUserListReq, callOptions ...callopt.Option) (r *interaction.ReactionUserListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetReactionUsers(ctx, req)
+}
+
 func (p *kInteractionServiceClient) CheckStarStatus(ctx context.Context, req *interaction.CheckStarStatusReq, callOptions ...callopt.Option) (r *interaction.CheckStarStatusResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CheckStarStatus(ctx, req)
 }
+
+func (p *kInteractionServiceClient) BatchCheckInteractionStatus(ctx context.Context, req *interaction.BatchCheckInteractionStatusReq, callOptions ...callopt.Option) (r *interaction.BatchCheckInteractionStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchCheckInteractionStatus(ctx, req)
+}
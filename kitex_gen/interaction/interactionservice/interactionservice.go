@@ -41,6 +41,62 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateStarFolder": kitex.NewMethodInfo(
+		createStarFolderHandler,
+		newInteractionServiceCreateStarFolderArgs,
+		newInteractionServiceCreateStarFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateStarFolder": kitex.NewMethodInfo(
+		updateStarFolderHandler,
+		newInteractionServiceUpdateStarFolderArgs,
+		newInteractionServiceUpdateStarFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteStarFolder": kitex.NewMethodInfo(
+		deleteStarFolderHandler,
+		newInteractionServiceDeleteStarFolderArgs,
+		newInteractionServiceDeleteStarFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetStarFolders": kitex.NewMethodInfo(
+		getStarFoldersHandler,
+		newInteractionServiceGetStarFoldersArgs,
+		newInteractionServiceGetStarFoldersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"StarFolderItemAction": kitex.NewMethodInfo(
+		starFolderItemActionHandler,
+		newInteractionServiceStarFolderItemActionArgs,
+		newInteractionServiceStarFolderItemActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MoveStarVideo": kitex.NewMethodInfo(
+		moveStarVideoHandler,
+		newInteractionServiceMoveStarVideoArgs,
+		newInteractionServiceMoveStarVideoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"StarFolderFollowAction": kitex.NewMethodInfo(
+		starFolderFollowActionHandler,
+		newInteractionServiceStarFolderFollowActionArgs,
+		newInteractionServiceStarFolderFollowActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowedStarFolders": kitex.NewMethodInfo(
+		getFollowedStarFoldersHandler,
+		newInteractionServiceGetFollowedStarFoldersArgs,
+		newInteractionServiceGetFollowedStarFoldersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CommentAction": kitex.NewMethodInfo(
 		commentActionHandler,
 		newInteractionServiceCommentActionArgs,
@@ -55,6 +111,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetCommentReplies": kitex.NewMethodInfo(
+		getCommentRepliesHandler,
+		newInteractionServiceGetCommentRepliesArgs,
+		newInteractionServiceGetCommentRepliesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"DeleteComment": kitex.NewMethodInfo(
 		deleteCommentHandler,
 		newInteractionServiceDeleteCommentArgs,
@@ -62,6 +125,62 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CommentLikeAction": kitex.NewMethodInfo(
+		commentLikeActionHandler,
+		newInteractionServiceCommentLikeActionArgs,
+		newInteractionServiceCommentLikeActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PinComment": kitex.NewMethodInfo(
+		pinCommentHandler,
+		newInteractionServicePinCommentArgs,
+		newInteractionServicePinCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetCommentPermission": kitex.NewMethodInfo(
+		setCommentPermissionHandler,
+		newInteractionServiceSetCommentPermissionArgs,
+		newInteractionServiceSetCommentPermissionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCommentPermission": kitex.NewMethodInfo(
+		getCommentPermissionHandler,
+		newInteractionServiceGetCommentPermissionArgs,
+		newInteractionServiceGetCommentPermissionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CommentKeywordAction": kitex.NewMethodInfo(
+		commentKeywordActionHandler,
+		newInteractionServiceCommentKeywordActionArgs,
+		newInteractionServiceCommentKeywordActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCommentKeywords": kitex.NewMethodInfo(
+		getCommentKeywordsHandler,
+		newInteractionServiceGetCommentKeywordsArgs,
+		newInteractionServiceGetCommentKeywordsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPendingComments": kitex.NewMethodInfo(
+		getPendingCommentsHandler,
+		newInteractionServiceGetPendingCommentsArgs,
+		newInteractionServiceGetPendingCommentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewComment": kitex.NewMethodInfo(
+		reviewCommentHandler,
+		newInteractionServiceReviewCommentArgs,
+		newInteractionServiceReviewCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ShareAction": kitex.NewMethodInfo(
 		shareActionHandler,
 		newInteractionServiceShareActionArgs,
@@ -69,6 +188,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateShareLink": kitex.NewMethodInfo(
+		createShareLinkHandler,
+		newInteractionServiceCreateShareLinkArgs,
+		newInteractionServiceCreateShareLinkResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveShareLink": kitex.NewMethodInfo(
+		resolveShareLinkHandler,
+		newInteractionServiceResolveShareLinkArgs,
+		newInteractionServiceResolveShareLinkResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RecordShareConversion": kitex.NewMethodInfo(
+		recordShareConversionHandler,
+		newInteractionServiceRecordShareConversionArgs,
+		newInteractionServiceRecordShareConversionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetShareStats": kitex.NewMethodInfo(
+		getShareStatsHandler,
+		newInteractionServiceGetShareStatsArgs,
+		newInteractionServiceGetShareStatsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetInvitedBy": kitex.NewMethodInfo(
+		getInvitedByHandler,
+		newInteractionServiceGetInvitedByArgs,
+		newInteractionServiceGetInvitedByResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetCount": kitex.NewMethodInfo(
 		getCountHandler,
 		newInteractionServiceGetCountArgs,
@@ -83,6 +237,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ReactionAction": kitex.NewMethodInfo(
+		reactionActionHandler,
+		newInteractionServiceReactionActionArgs,
+		newInteractionServiceReactionActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BatchGetReactions": kitex.NewMethodInfo(
+		batchGetReactionsHandler,
+		newInteractionServiceBatchGetReactionsArgs,
+		newInteractionServiceBatchGetReactionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetReactionUsers": kitex.NewMethodInfo(
+		getReactionUsersHandler,
+		newInteractionServiceGetReactionUsersArgs,
+		newInteractionServiceGetReactionUsersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CheckStarStatus": kitex.NewMethodInfo(
 		checkStarStatusHandler,
 		newInteractionServiceCheckStarStatusArgs,
@@ -90,6 +265,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"BatchCheckInteractionStatus": kitex.NewMethodInfo(
+		batchCheckInteractionStatusHandler,
+		newInteractionServiceBatchCheckInteractionStatusArgs,
+		newInteractionServiceBatchCheckInteractionStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -228,6 +410,150 @@ func newInteractionServiceGetStarVideoListResult() interface{} {
 	return interaction.NewInteractionServiceGetStarVideoListResult()
 }
 
+func createStarFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceCreateStarFolderArgs)
+	realResult := result.(*interaction.InteractionServiceCreateStarFolderResult)
+	success, err := handler.(interaction.InteractionService).CreateStarFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceCreateStarFolderArgs() interface{} {
+	return interaction.NewInteractionServiceCreateStarFolderArgs()
+}
+
+func newInteractionServiceCreateStarFolderResult() interface{} {
+	return interaction.NewInteractionServiceCreateStarFolderResult()
+}
+
+func updateStarFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceUpdateStarFolderArgs)
+	realResult := result.(*interaction.InteractionServiceUpdateStarFolderResult)
+	success, err := handler.(interaction.InteractionService).UpdateStarFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceUpdateStarFolderArgs() interface{} {
+	return interaction.NewInteractionServiceUpdateStarFolderArgs()
+}
+
+func newInteractionServiceUpdateStarFolderResult() interface{} {
+	return interaction.NewInteractionServiceUpdateStarFolderResult()
+}
+
+func deleteStarFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceDeleteStarFolderArgs)
+	realResult := result.(*interaction.InteractionServiceDeleteStarFolderResult)
+	success, err := handler.(interaction.InteractionService).DeleteStarFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceDeleteStarFolderArgs() interface{} {
+	return interaction.NewInteractionServiceDeleteStarFolderArgs()
+}
+
+func newInteractionServiceDeleteStarFolderResult() interface{} {
+	return interaction.NewInteractionServiceDeleteStarFolderResult()
+}
+
+func getStarFoldersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetStarFoldersArgs)
+	realResult := result.(*interaction.InteractionServiceGetStarFoldersResult)
+	success, err := handler.(interaction.InteractionService).GetStarFolders(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetStarFoldersArgs() interface{} {
+	return interaction.NewInteractionServiceGetStarFoldersArgs()
+}
+
+func newInteractionServiceGetStarFoldersResult() interface{} {
+	return interaction.NewInteractionServiceGetStarFoldersResult()
+}
+
+func starFolderItemActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceStarFolderItemActionArgs)
+	realResult := result.(*interaction.InteractionServiceStarFolderItemActionResult)
+	success, err := handler.(interaction.InteractionService).StarFolderItemAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceStarFolderItemActionArgs() interface{} {
+	return interaction.NewInteractionServiceStarFolderItemActionArgs()
+}
+
+func newInteractionServiceStarFolderItemActionResult() interface{} {
+	return interaction.NewInteractionServiceStarFolderItemActionResult()
+}
+
+func moveStarVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceMoveStarVideoArgs)
+	realResult := result.(*interaction.InteractionServiceMoveStarVideoResult)
+	success, err := handler.(interaction.InteractionService).MoveStarVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceMoveStarVideoArgs() interface{} {
+	return interaction.NewInteractionServiceMoveStarVideoArgs()
+}
+
+func newInteractionServiceMoveStarVideoResult() interface{} {
+	return interaction.NewInteractionServiceMoveStarVideoResult()
+}
+
+func starFolderFollowActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceStarFolderFollowActionArgs)
+	realResult := result.(*interaction.InteractionServiceStarFolderFollowActionResult)
+	success, err := handler.(interaction.InteractionService).StarFolderFollowAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceStarFolderFollowActionArgs() interface{} {
+	return interaction.NewInteractionServiceStarFolderFollowActionArgs()
+}
+
+func newInteractionServiceStarFolderFollowActionResult() interface{} {
+	return interaction.NewInteractionServiceStarFolderFollowActionResult()
+}
+
+func getFollowedStarFoldersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetFollowedStarFoldersArgs)
+	realResult := result.(*interaction.InteractionServiceGetFollowedStarFoldersResult)
+	success, err := handler.(interaction.InteractionService).GetFollowedStarFolders(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetFollowedStarFoldersArgs() interface{} {
+	return interaction.NewInteractionServiceGetFollowedStarFoldersArgs()
+}
+
+func newInteractionServiceGetFollowedStarFoldersResult() interface{} {
+	return interaction.NewInteractionServiceGetFollowedStarFoldersResult()
+}
+
 func commentActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interaction.InteractionServiceCommentActionArgs)
 	realResult := result.(*interaction.InteractionServiceCommentActionResult)
@@ -264,6 +590,24 @@ func newInteractionServiceGetCommentListResult() interface{} {
 	return interaction.NewInteractionServiceGetCommentListResult()
 }
 
+func getCommentRepliesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCommentRepliesArgs)
+	realResult := result.(*interaction.InteractionServiceGetCommentRepliesResult)
+	success, err := handler.(interaction.InteractionService).GetCommentReplies(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCommentRepliesArgs() interface{} {
+	return interaction.NewInteractionServiceGetCommentRepliesArgs()
+}
+
+func newInteractionServiceGetCommentRepliesResult() interface{} {
+	return interaction.NewInteractionServiceGetCommentRepliesResult()
+}
+
 func deleteCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interaction.InteractionServiceDeleteCommentArgs)
 	realResult := result.(*interaction.InteractionServiceDeleteCommentResult)
@@ -282,6 +626,150 @@ func newInteractionServiceDeleteCommentResult() interface{} {
 	return interaction.NewInteractionServiceDeleteCommentResult()
 }
 
+func commentLikeActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceCommentLikeActionArgs)
+	realResult := result.(*interaction.InteractionServiceCommentLikeActionResult)
+	success, err := handler.(interaction.InteractionService).CommentLikeAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceCommentLikeActionArgs() interface{} {
+	return interaction.NewInteractionServiceCommentLikeActionArgs()
+}
+
+func newInteractionServiceCommentLikeActionResult() interface{} {
+	return interaction.NewInteractionServiceCommentLikeActionResult()
+}
+
+func pinCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServicePinCommentArgs)
+	realResult := result.(*interaction.InteractionServicePinCommentResult)
+	success, err := handler.(interaction.InteractionService).PinComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServicePinCommentArgs() interface{} {
+	return interaction.NewInteractionServicePinCommentArgs()
+}
+
+func newInteractionServicePinCommentResult() interface{} {
+	return interaction.NewInteractionServicePinCommentResult()
+}
+
+func setCommentPermissionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceSetCommentPermissionArgs)
+	realResult := result.(*interaction.InteractionServiceSetCommentPermissionResult)
+	success, err := handler.(interaction.InteractionService).SetCommentPermission(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceSetCommentPermissionArgs() interface{} {
+	return interaction.NewInteractionServiceSetCommentPermissionArgs()
+}
+
+func newInteractionServiceSetCommentPermissionResult() interface{} {
+	return interaction.NewInteractionServiceSetCommentPermissionResult()
+}
+
+func getCommentPermissionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCommentPermissionArgs)
+	realResult := result.(*interaction.InteractionServiceGetCommentPermissionResult)
+	success, err := handler.(interaction.InteractionService).GetCommentPermission(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCommentPermissionArgs() interface{} {
+	return interaction.NewInteractionServiceGetCommentPermissionArgs()
+}
+
+func newInteractionServiceGetCommentPermissionResult() interface{} {
+	return interaction.NewInteractionServiceGetCommentPermissionResult()
+}
+
+func commentKeywordActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceCommentKeywordActionArgs)
+	realResult := result.(*interaction.InteractionServiceCommentKeywordActionResult)
+	success, err := handler.(interaction.InteractionService).CommentKeywordAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceCommentKeywordActionArgs() interface{} {
+	return interaction.NewInteractionServiceCommentKeywordActionArgs()
+}
+
+func newInteractionServiceCommentKeywordActionResult() interface{} {
+	return interaction.NewInteractionServiceCommentKeywordActionResult()
+}
+
+func getCommentKeywordsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCommentKeywordsArgs)
+	realResult := result.(*interaction.InteractionServiceGetCommentKeywordsResult)
+	success, err := handler.(interaction.InteractionService).GetCommentKeywords(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCommentKeywordsArgs() interface{} {
+	return interaction.NewInteractionServiceGetCommentKeywordsArgs()
+}
+
+func newInteractionServiceGetCommentKeywordsResult() interface{} {
+	return interaction.NewInteractionServiceGetCommentKeywordsResult()
+}
+
+func getPendingCommentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetPendingCommentsArgs)
+	realResult := result.(*interaction.InteractionServiceGetPendingCommentsResult)
+	success, err := handler.(interaction.InteractionService).GetPendingComments(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetPendingCommentsArgs() interface{} {
+	return interaction.NewInteractionServiceGetPendingCommentsArgs()
+}
+
+func newInteractionServiceGetPendingCommentsResult() interface{} {
+	return interaction.NewInteractionServiceGetPendingCommentsResult()
+}
+
+func reviewCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceReviewCommentArgs)
+	realResult := result.(*interaction.InteractionServiceReviewCommentResult)
+	success, err := handler.(interaction.InteractionService).ReviewComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReviewCommentArgs() interface{} {
+	return interaction.NewInteractionServiceReviewCommentArgs()
+}
+
+func newInteractionServiceReviewCommentResult() interface{} {
+	return interaction.NewInteractionServiceReviewCommentResult()
+}
+
 func shareActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interaction.InteractionServiceShareActionArgs)
 	realResult := result.(*interaction.InteractionServiceShareActionResult)
@@ -300,6 +788,96 @@ func newInteractionServiceShareActionResult() interface{} {
 	return interaction.NewInteractionServiceShareActionResult()
 }
 
+func createShareLinkHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceCreateShareLinkArgs)
+	realResult := result.(*interaction.InteractionServiceCreateShareLinkResult)
+	success, err := handler.(interaction.InteractionService).CreateShareLink(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceCreateShareLinkArgs() interface{} {
+	return interaction.NewInteractionServiceCreateShareLinkArgs()
+}
+
+func newInteractionServiceCreateShareLinkResult() interface{} {
+	return interaction.NewInteractionServiceCreateShareLinkResult()
+}
+
+func resolveShareLinkHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceResolveShareLinkArgs)
+	realResult := result.(*interaction.InteractionServiceResolveShareLinkResult)
+	success, err := handler.(interaction.InteractionService).ResolveShareLink(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceResolveShareLinkArgs() interface{} {
+	return interaction.NewInteractionServiceResolveShareLinkArgs()
+}
+
+func newInteractionServiceResolveShareLinkResult() interface{} {
+	return interaction.NewInteractionServiceResolveShareLinkResult()
+}
+
+func recordShareConversionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceRecordShareConversionArgs)
+	realResult := result.(*interaction.InteractionServiceRecordShareConversionResult)
+	success, err := handler.(interaction.InteractionService).RecordShareConversion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceRecordShareConversionArgs() interface{} {
+	return interaction.NewInteractionServiceRecordShareConversionArgs()
+}
+
+func newInteractionServiceRecordShareConversionResult() interface{} {
+	return interaction.NewInteractionServiceRecordShareConversionResult()
+}
+
+func getShareStatsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetShareStatsArgs)
+	realResult := result.(*interaction.InteractionServiceGetShareStatsResult)
+	success, err := handler.(interaction.InteractionService).GetShareStats(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetShareStatsArgs() interface{} {
+	return interaction.NewInteractionServiceGetShareStatsArgs()
+}
+
+func newInteractionServiceGetShareStatsResult() interface{} {
+	return interaction.NewInteractionServiceGetShareStatsResult()
+}
+
+func getInvitedByHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetInvitedByArgs)
+	realResult := result.(*interaction.InteractionServiceGetInvitedByResult)
+	success, err := handler.(interaction.InteractionService).GetInvitedBy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetInvitedByArgs() interface{} {
+	return interaction.NewInteractionServiceGetInvitedByArgs()
+}
+
+func newInteractionServiceGetInvitedByResult() interface{} {
+	return interaction.NewInteractionServiceGetInvitedByResult()
+}
+
 func getCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interaction.InteractionServiceGetCountArgs)
 	realResult := result.(*interaction.InteractionServiceGetCountResult)
@@ -336,6 +914,60 @@ func newInteractionServiceCheckLikeStatusResult() interface{} {
 	return interaction.NewInteractionServiceCheckLikeStatusResult()
 }
 
+func reactionActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceReactionActionArgs)
+	realResult := result.(*interaction.InteractionServiceReactionActionResult)
+	success, err := handler.(interaction.InteractionService).ReactionAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReactionActionArgs() interface{} {
+	return interaction.NewInteractionServiceReactionActionArgs()
+}
+
+func newInteractionServiceReactionActionResult() interface{} {
+	return interaction.NewInteractionServiceReactionActionResult()
+}
+
+func batchGetReactionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceBatchGetReactionsArgs)
+	realResult := result.(*interaction.InteractionServiceBatchGetReactionsResult)
+	success, err := handler.(interaction.InteractionService).BatchGetReactions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceBatchGetReactionsArgs() interface{} {
+	return interaction.NewInteractionServiceBatchGetReactionsArgs()
+}
+
+func newInteractionServiceBatchGetReactionsResult() interface{} {
+	return interaction.NewInteractionServiceBatchGetReactionsResult()
+}
+
+func getReactionUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetReactionUsersArgs)
+	realResult := result.(*interaction.InteractionServiceGetReactionUsersResult)
+	success, err := handler.(interaction.InteractionService).GetReactionUsers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetReactionUsersArgs() interface{} {
+	return interaction.NewInteractionServiceGetReactionUsersArgs()
+}
+
+func newInteractionServiceGetReactionUsersResult() interface{} {
+	return interaction.NewInteractionServiceGetReactionUsersResult()
+}
+
 func checkStarStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interaction.InteractionServiceCheckStarStatusArgs)
 	realResult := result.(*interaction.InteractionServiceCheckStarStatusResult)
@@ -354,6 +986,24 @@ func newInteractionServiceCheckStarStatusResult() interface{} {
 	return interaction.NewInteractionServiceCheckStarStatusResult()
 }
 
+func batchCheckInteractionStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceBatchCheckInteractionStatusArgs)
+	realResult := result.(*interaction.InteractionServiceBatchCheckInteractionStatusResult)
+	success, err := handler.(interaction.InteractionService).BatchCheckInteractionStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceBatchCheckInteractionStatusArgs() interface{} {
+	return interaction.NewInteractionServiceBatchCheckInteractionStatusArgs()
+}
+
+func newInteractionServiceBatchCheckInteractionStatusResult() interface{} {
+	return interaction.NewInteractionServiceBatchCheckInteractionStatusResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -404,6 +1054,86 @@ func (p *kClient) GetStarVideoList(ctx context.Context, req *interaction.StarVid
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CreateStarFolder(ctx context.Context, req *interaction.CreateStarFolderReq) (r *interaction.CreateStarFolderResp, err error) {
+	var _args interaction.InteractionServiceCreateStarFolderArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceCreateStarFolderResult
+	if err = p.c.Call(ctx, "CreateStarFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateStarFolder(ctx context.Context, req *interaction.UpdateStarFolderReq) (r *interaction.UpdateStarFolderResp, err error) {
+	var _args interaction.InteractionServiceUpdateStarFolderArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceUpdateStarFolderResult
+	if err = p.c.Call(ctx, "UpdateStarFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteStarFolder(ctx context.Context, req *interaction.DeleteStarFolderReq) (r *interaction.DeleteStarFolderResp, err error) {
+	var _args interaction.InteractionServiceDeleteStarFolderArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceDeleteStarFolderResult
+	if err = p.c.Call(ctx, "DeleteStarFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetStarFolders(ctx context.Context, req *interaction.StarFolderListReq) (r *interaction.StarFolderListResp, err error) {
+	var _args interaction.InteractionServiceGetStarFoldersArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetStarFoldersResult
+	if err = p.c.Call(ctx, "GetStarFolders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) StarFolderItemAction(ctx context.Context, req *interaction.StarFolderItemActionReq) (r *interaction.StarFolderItemActionResp, err error) {
+	var _args interaction.InteractionServiceStarFolderItemActionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceStarFolderItemActionResult
+	if err = p.c.Call(ctx, "StarFolderItemAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MoveStarVideo(ctx context.Context, req *interaction.MoveStarVideoReq) (r *interaction.MoveStarVideoResp, err error) {
+	var _args interaction.InteractionServiceMoveStarVideoArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceMoveStarVideoResult
+	if err = p.c.Call(ctx, "MoveStarVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) StarFolderFollowAction(ctx context.Context, req *interaction.StarFolderFollowReq) (r *interaction.StarFolderFollowResp, err error) {
+	var _args interaction.InteractionServiceStarFolderFollowActionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceStarFolderFollowActionResult
+	if err = p.c.Call(ctx, "StarFolderFollowAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowedStarFolders(ctx context.Context, req *interaction.FollowedStarFolderListReq) (r *interaction.FollowedStarFolderListResp, err error) {
+	var _args interaction.InteractionServiceGetFollowedStarFoldersArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetFollowedStarFoldersResult
+	if err = p.c.Call(ctx, "GetFollowedStarFolders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CommentAction(ctx context.Context, req *interaction.CommentActionReq) (r *interaction.CommentActionResp, err error) {
 	var _args interaction.InteractionServiceCommentActionArgs
 	_args.Req = req
@@ -424,6 +1154,16 @@ func (p *kClient) GetCommentList(ctx context.Context, req *interaction.CommentLi
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetCommentReplies(ctx context.Context, req *interaction.CommentRepliesReq) (r *interaction.CommentRepliesResp, err error) {
+	var _args interaction.InteractionServiceGetCommentRepliesArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCommentRepliesResult
+	if err = p.c.Call(ctx, "GetCommentReplies", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) DeleteComment(ctx context.Context, req *interaction.DeleteCommentReq) (r *interaction.DeleteCommentResp, err error) {
 	var _args interaction.InteractionServiceDeleteCommentArgs
 	_args.Req = req
@@ -434,6 +1174,86 @@ func (p *kClient) DeleteComment(ctx context.Context, req *interaction.DeleteComm
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CommentLikeAction(ctx context.Context, req *interaction.CommentLikeActionReq) (r *interaction.CommentLikeActionResp, err error) {
+	var _args interaction.InteractionServiceCommentLikeActionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceCommentLikeActionResult
+	if err = p.c.Call(ctx, "CommentLikeAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PinComment(ctx context.Context, req *interaction.PinCommentReq) (r *interaction.PinCommentResp, err error) {
+	var _args interaction.InteractionServicePinCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServicePinCommentResult
+	if err = p.c.Call(ctx, "PinComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetCommentPermission(ctx context.Context, req *interaction.SetCommentPermissionReq) (r *interaction.SetCommentPermissionResp, err error) {
+	var _args interaction.InteractionServiceSetCommentPermissionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceSetCommentPermissionResult
+	if err = p.c.Call(ctx, "SetCommentPermission", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCommentPermission(ctx context.Context, req *interaction.GetCommentPermissionReq) (r *interaction.GetCommentPermissionResp, err error) {
+	var _args interaction.InteractionServiceGetCommentPermissionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCommentPermissionResult
+	if err = p.c.Call(ctx, "GetCommentPermission", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CommentKeywordAction(ctx context.Context, req *interaction.CommentKeywordActionReq) (r *interaction.CommentKeywordActionResp, err error) {
+	var _args interaction.InteractionServiceCommentKeywordActionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceCommentKeywordActionResult
+	if err = p.c.Call(ctx, "CommentKeywordAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCommentKeywords(ctx context.Context, req *interaction.CommentKeywordListReq) (r *interaction.CommentKeywordListResp, err error) {
+	var _args interaction.InteractionServiceGetCommentKeywordsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCommentKeywordsResult
+	if err = p.c.Call(ctx, "GetCommentKeywords", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPendingComments(ctx context.Context, req *interaction.PendingCommentListReq) (r *interaction.PendingCommentListResp, err error) {
+	var _args interaction.InteractionServiceGetPendingCommentsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetPendingCommentsResult
+	if err = p.c.Call(ctx, "GetPendingComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewComment(ctx context.Context, req *interaction.ReviewCommentReq) (r *interaction.ReviewCommentResp, err error) {
+	var _args interaction.InteractionServiceReviewCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceReviewCommentResult
+	if err = p.c.Call(ctx, "ReviewComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ShareAction(ctx context.Context, req *interaction.ShareActionReq) (r *interaction.ShareActionResp, err error) {
 	var _args interaction.InteractionServiceShareActionArgs
 	_args.Req = req
@@ -444,6 +1264,56 @@ func (p *kClient) ShareAction(ctx context.Context, req *interaction.ShareActionR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CreateShareLink(ctx context.Context, req *interaction.CreateShareLinkReq) (r *interaction.CreateShareLinkResp, err error) {
+	var _args interaction.InteractionServiceCreateShareLinkArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceCreateShareLinkResult
+	if err = p.c.Call(ctx, "CreateShareLink", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResolveShareLink(ctx context.Context, req *interaction.ResolveShareLinkReq) (r *interaction.ResolveShareLinkResp, err error) {
+	var _args interaction.InteractionServiceResolveShareLinkArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceResolveShareLinkResult
+	if err = p.c.Call(ctx, "ResolveShareLink", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecordShareConversion(ctx context.Context, req *interaction.ShareConversionReq) (r *interaction.ShareConversionResp, err error) {
+	var _args interaction.InteractionServiceRecordShareConversionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceRecordShareConversionResult
+	if err = p.c.Call(ctx, "RecordShareConversion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetShareStats(ctx context.Context, req *interaction.ShareStatsReq) (r *interaction.ShareStatsResp, err error) {
+	var _args interaction.InteractionServiceGetShareStatsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetShareStatsResult
+	if err = p.c.Call(ctx, "GetShareStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetInvitedBy(ctx context.Context, req *interaction.InvitedByReq) (r *interaction.InvitedByResp, err error) {
+	var _args interaction.InteractionServiceGetInvitedByArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetInvitedByResult
+	if err = p.c.Call(ctx, "GetInvitedBy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetCount(ctx context.Context, req *interaction.CountReq) (r *interaction.CountResp, err error) {
 	var _args interaction.InteractionServiceGetCountArgs
 	_args.Req = req
@@ -464,6 +1334,36 @@ func (p *kClient) CheckLikeStatus(ctx context.Context, req *interaction.CheckLik
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ReactionAction(ctx context.Context, req *interaction.ReactionActionReq) (r *interaction.ReactionActionResp, err error) {
+	var _args interaction.InteractionServiceReactionActionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceReactionActionResult
+	if err = p.c.Call(ctx, "ReactionAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchGetReactions(ctx context.Context, req *interaction.BatchGetReactionsReq) (r *interaction.BatchGetReactionsResp, err error) {
+	var _args interaction.InteractionServiceBatchGetReactionsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceBatchGetReactionsResult
+	if err = p.c.Call(ctx, "BatchGetReactions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetReactionUsers(ctx context.Context, req *interaction.ReactionUserListReq) (r *interaction.ReactionUserListResp, err error) {
+	var _args interaction.InteractionServiceGetReactionUsersArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetReactionUsersResult
+	if err = p.c.Call(ctx, "GetReactionUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CheckStarStatus(ctx context.Context, req *interaction.CheckStarStatusReq) (r *interaction.CheckStarStatusResp, err error) {
 	var _args interaction.InteractionServiceCheckStarStatusArgs
 	_args.Req = req
@@ -473,3 +1373,13 @@ func (p *kClient) CheckStarStatus(ctx context.Context, req *interaction.CheckSta
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchCheckInteractionStatus(ctx context.Context, req *interaction.BatchCheckInteractionStatusReq) (r *interaction.BatchCheckInteractionStatusResp, err error) {
+	var _args interaction.InteractionServiceBatchCheckInteractionStatusArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceBatchCheckInteractionStatusResult
+	if err = p.c.Call(ctx, "BatchCheckInteractionStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
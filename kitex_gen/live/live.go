@@ -9,10 +9,11 @@ import (
 )
 
 type CreateLiveRoomReq struct {
-	HostId      int64   `thrift:"hostId,1" frugal:"1,default,i64" json:"hostId"`
-	Title       string  `thrift:"title,2" frugal:"2,default,string" json:"title"`
-	CoverUrl    string  `thrift:"coverUrl,3" frugal:"3,default,string" json:"coverUrl"`
-	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	HostId         int64   `thrift:"hostId,1" frugal:"1,default,i64" json:"hostId"`
+	Title          string  `thrift:"title,2" frugal:"2,default,string" json:"title"`
+	CoverUrl       string  `thrift:"coverUrl,3" frugal:"3,default,string" json:"coverUrl"`
+	Description    *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	AudienceListId *int64  `thrift:"audienceListId,5,optional" frugal:"5,optional,i64" json:"audienceListId,omitempty"`
 }
 
 func NewCreateLiveRoomReq() *CreateLiveRoomReq {
@@ -42,6 +43,15 @@ func (p *CreateLiveRoomReq) GetDescription() (v string) {
 	}
 	return *p.Description
 }
+
+var CreateLiveRoomReq_AudienceListId_DEFAULT int64
+
+func (p *CreateLiveRoomReq) GetAudienceListId() (v int64) {
+	if !p.IsSetAudienceListId() {
+		return CreateLiveRoomReq_AudienceListId_DEFAULT
+	}
+	return *p.AudienceListId
+}
 func (p *CreateLiveRoomReq) SetHostId(val int64) {
 	p.HostId = val
 }
@@ -54,11 +64,18 @@ func (p *CreateLiveRoomReq) SetCoverUrl(val string) {
 func (p *CreateLiveRoomReq) SetDescription(val *string) {
 	p.Description = val
 }
+func (p *CreateLiveRoomReq) SetAudienceListId(val *int64) {
+	p.AudienceListId = val
+}
 
 func (p *CreateLiveRoomReq) IsSetDescription() bool {
 	return p.Description != nil
 }
 
+func (p *CreateLiveRoomReq) IsSetAudienceListId() bool {
+	return p.AudienceListId != nil
+}
+
 func (p *CreateLiveRoomReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -71,6 +88,7 @@ var fieldIDToName_CreateLiveRoomReq = map[int16]string{
 	2: "title",
 	3: "coverUrl",
 	4: "description",
+	5: "audienceListId",
 }
 
 type CreateLiveRoomResp struct {
@@ -291,10 +309,12 @@ var fieldIDToName_StopLiveResp = map[int16]string{
 }
 
 type GetLiveRoomsReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Page          int32 `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
-	FollowingOnly *bool `thrift:"followingOnly,4,optional" frugal:"4,optional,bool" json:"followingOnly,omitempty"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Page          int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	FollowingOnly *bool   `thrift:"followingOnly,4,optional" frugal:"4,optional,bool" json:"followingOnly,omitempty"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewGetLiveRoomsReq() *GetLiveRoomsReq {
@@ -324,6 +344,24 @@ func (p *GetLiveRoomsReq) GetFollowingOnly() (v bool) {
 	}
 	return *p.FollowingOnly
 }
+
+var GetLiveRoomsReq_Cursor_DEFAULT string
+
+func (p *GetLiveRoomsReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetLiveRoomsReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var GetLiveRoomsReq_NeedTotal_DEFAULT bool
+
+func (p *GetLiveRoomsReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return GetLiveRoomsReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *GetLiveRoomsReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -336,11 +374,25 @@ func (p *GetLiveRoomsReq) SetPageSize(val int32) {
 func (p *GetLiveRoomsReq) SetFollowingOnly(val *bool) {
 	p.FollowingOnly = val
 }
+func (p *GetLiveRoomsReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *GetLiveRoomsReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
 
 func (p *GetLiveRoomsReq) IsSetFollowingOnly() bool {
 	return p.FollowingOnly != nil
 }
 
+func (p *GetLiveRoomsReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetLiveRoomsReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
+
 func (p *GetLiveRoomsReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -353,12 +405,16 @@ var fieldIDToName_GetLiveRoomsReq = map[int16]string{
 	2: "page",
 	3: "pageSize",
 	4: "followingOnly",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type GetLiveRoomsResp struct {
 	BaseResp   *common.BaseResp   `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Rooms      []*common.LiveRoom `thrift:"rooms,2" frugal:"2,default,list<common.LiveRoom>" json:"rooms"`
 	TotalCount int32              `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string            `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool               `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewGetLiveRoomsResp() *GetLiveRoomsResp {
@@ -384,6 +440,19 @@ func (p *GetLiveRoomsResp) GetRooms() (v []*common.LiveRoom) {
 func (p *GetLiveRoomsResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var GetLiveRoomsResp_NextCursor_DEFAULT string
+
+func (p *GetLiveRoomsResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetLiveRoomsResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *GetLiveRoomsResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *GetLiveRoomsResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -393,11 +462,21 @@ func (p *GetLiveRoomsResp) SetRooms(val []*common.LiveRoom) {
 func (p *GetLiveRoomsResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *GetLiveRoomsResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *GetLiveRoomsResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *GetLiveRoomsResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *GetLiveRoomsResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *GetLiveRoomsResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -409,6 +488,8 @@ var fieldIDToName_GetLiveRoomsResp = map[int16]string{
 	1: "BaseResp",
 	2: "rooms",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type GetLiveRoomDetailReq struct {
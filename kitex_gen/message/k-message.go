@@ -258,6 +258,34 @@ func (p *SendMessageResp) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -302,6 +330,34 @@ func (p *SendMessageResp) FastReadField2(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SendMessageResp) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Content = _field
+	return offset, nil
+}
+
+func (p *SendMessageResp) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Pending = _field
+	return offset, nil
+}
+
 func (p *SendMessageResp) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -310,7 +366,9 @@ func (p *SendMessageResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -321,6 +379,8 @@ func (p *SendMessageResp) BLength() int {
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -340,6 +400,22 @@ func (p *SendMessageResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int
 	return offset
 }
 
+func (p *SendMessageResp) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetContent() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
+	}
+	return offset
+}
+
+func (p *SendMessageResp) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.Pending)
+	return offset
+}
+
 func (p *SendMessageResp) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -354,6 +430,22 @@ func (p *SendMessageResp) field2Length() int {
 	return l
 }
 
+func (p *SendMessageResp) field3Length() int {
+	l := 0
+	if p.IsSetContent() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Content)
+	}
+	return l
+}
+
+func (p *SendMessageResp) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
 func (p *GetChatHistoryReq) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -427,6 +519,20 @@ func (p *GetChatHistoryReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -501,6 +607,20 @@ func (p *GetChatHistoryReq) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetChatHistoryReq) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cursor = _field
+	return offset, nil
+}
+
 func (p *GetChatHistoryReq) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -512,6 +632,7 @@ func (p *GetChatHistoryReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) i
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -524,6 +645,7 @@ func (p *GetChatHistoryReq) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -557,6 +679,15 @@ func (p *GetChatHistoryReq) fastWriteField4(buf []byte, w thrift.NocopyWriter) i
 	return offset
 }
 
+func (p *GetChatHistoryReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cursor)
+	}
+	return offset
+}
+
 func (p *GetChatHistoryReq) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -585,6 +716,15 @@ func (p *GetChatHistoryReq) field4Length() int {
 	return l
 }
 
+func (p *GetChatHistoryReq) field5Length() int {
+	l := 0
+	if p.IsSetCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Cursor)
+	}
+	return l
+}
+
 func (p *GetChatHistoryResp) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -644,6 +784,34 @@ func (p *GetChatHistoryResp) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -713,6 +881,34 @@ func (p *GetChatHistoryResp) FastReadField3(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetChatHistoryResp) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextCursor = _field
+	return offset, nil
+}
+
+func (p *GetChatHistoryResp) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
 func (p *GetChatHistoryResp) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -721,8 +917,10 @@ func (p *GetChatHistoryResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter)
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -734,6 +932,8 @@ func (p *GetChatHistoryResp) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -767,6 +967,22 @@ func (p *GetChatHistoryResp) fastWriteField3(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *GetChatHistoryResp) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextCursor)
+	}
+	return offset
+}
+
+func (p *GetChatHistoryResp) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
 func (p *GetChatHistoryResp) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -792,6 +1008,22 @@ func (p *GetChatHistoryResp) field3Length() int {
 	return l
 }
 
+func (p *GetChatHistoryResp) field4Length() int {
+	l := 0
+	if p.IsSetNextCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextCursor)
+	}
+	return l
+}
+
+func (p *GetChatHistoryResp) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
 func (p *GetLatestMessagesReq) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -2481,6 +2713,34 @@ func (p *GetNotificationsReq) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2555,6 +2815,34 @@ func (p *GetNotificationsReq) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetNotificationsReq) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cursor = _field
+	return offset, nil
+}
+
+func (p *GetNotificationsReq) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NeedTotal = _field
+	return offset, nil
+}
+
 func (p *GetNotificationsReq) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -2566,6 +2854,8 @@ func (p *GetNotificationsReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -2578,6 +2868,8 @@ func (p *GetNotificationsReq) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -2613,6 +2905,24 @@ func (p *GetNotificationsReq) fastWriteField4(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *GetNotificationsReq) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cursor)
+	}
+	return offset
+}
+
+func (p *GetNotificationsReq) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNeedTotal() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.NeedTotal)
+	}
+	return offset
+}
+
 func (p *GetNotificationsReq) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -2643,7 +2953,25 @@ func (p *GetNotificationsReq) field4Length() int {
 	return l
 }
 
-func (p *GetNotificationsResp) FastRead(buf []byte) (int, error) {
+func (p *GetNotificationsReq) field5Length() int {
+	l := 0
+	if p.IsSetCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Cursor)
+	}
+	return l
+}
+
+func (p *GetNotificationsReq) field6Length() int {
+	l := 0
+	if p.IsSetNeedTotal() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *GetNotificationsResp) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2702,6 +3030,34 @@ func (p *GetNotificationsResp) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2771,6 +3127,34 @@ func (p *GetNotificationsResp) FastReadField3(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetNotificationsResp) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextCursor = _field
+	return offset, nil
+}
+
+func (p *GetNotificationsResp) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
 func (p *GetNotificationsResp) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -2779,8 +3163,10 @@ func (p *GetNotificationsResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -2792,6 +3178,8 @@ func (p *GetNotificationsResp) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -2825,6 +3213,22 @@ func (p *GetNotificationsResp) fastWriteField3(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
+func (p *GetNotificationsResp) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextCursor)
+	}
+	return offset
+}
+
+func (p *GetNotificationsResp) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
 func (p *GetNotificationsResp) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -2850,6 +3254,22 @@ func (p *GetNotificationsResp) field3Length() int {
 	return l
 }
 
+func (p *GetNotificationsResp) field4Length() int {
+	l := 0
+	if p.IsSetNextCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextCursor)
+	}
+	return l
+}
+
+func (p *GetNotificationsResp) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
 func (p *MarkNotificationReadReq) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -3090,7 +3510,7 @@ func (p *MarkNotificationReadResp) field1Length() int {
 	return l
 }
 
-func (p *MessageServiceSendMessageArgs) FastRead(buf []byte) (int, error) {
+func (p *GetPendingMessagesReq) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3108,7 +3528,7 @@ func (p *MessageServiceSendMessageArgs) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3121,6 +3541,20 @@ func (p *MessageServiceSendMessageArgs) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3134,14 +3568,1084 @@ func (p *MessageServiceSendMessageArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceSendMessageArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPendingMessagesReq[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceSendMessageArgs) FastReadField1(buf []byte) (int, error) {
+func (p *GetPendingMessagesReq) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSendMessageReq()
+
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cursor = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetPendingMessagesReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetPendingMessagesReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetPendingMessagesReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.PageSize)
+	return offset
+}
+
+func (p *GetPendingMessagesReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cursor)
+	}
+	return offset
+}
+
+func (p *GetPendingMessagesReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
+}
+
+func (p *GetPendingMessagesReq) field2Length() int {
+	l := 0
+	if p.IsSetCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Cursor)
+	}
+	return l
+}
+
+func (p *GetPendingMessagesResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPendingMessagesResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetPendingMessagesResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*common.Message, 0, size)
+	values := make([]common.Message, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Messages = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesResp) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextCursor = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesResp) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *GetPendingMessagesResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetPendingMessagesResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetPendingMessagesResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetPendingMessagesResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetPendingMessagesResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Messages {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *GetPendingMessagesResp) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.NextCursor)
+	}
+	return offset
+}
+
+func (p *GetPendingMessagesResp) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
+func (p *GetPendingMessagesResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *GetPendingMessagesResp) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Messages {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *GetPendingMessagesResp) field3Length() int {
+	l := 0
+	if p.IsSetNextCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.NextCursor)
+	}
+	return l
+}
+
+func (p *GetPendingMessagesResp) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *ReviewMessageReq) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewMessageReq[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ReviewMessageReq) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.MessageId = _field
+	return offset, nil
+}
+
+func (p *ReviewMessageReq) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Approve = _field
+	return offset, nil
+}
+
+func (p *ReviewMessageReq) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ReviewMessageReq) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ReviewMessageReq) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ReviewMessageReq) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.MessageId)
+	return offset
+}
+
+func (p *ReviewMessageReq) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 2)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.Approve)
+	return offset
+}
+
+func (p *ReviewMessageReq) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ReviewMessageReq) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *ReviewMessageResp) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewMessageResp[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ReviewMessageResp) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ReviewMessageResp) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := common.NewMessage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Message = _field
+	return offset, nil
+}
+
+func (p *ReviewMessageResp) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ReviewMessageResp) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ReviewMessageResp) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ReviewMessageResp) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ReviewMessageResp) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.Message.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ReviewMessageResp) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *ReviewMessageResp) field2Length() int {
+	l := 0
+	if p.IsSetMessage() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Message.BLength()
+	}
+	return l
+}
+
+func (p *MessageServiceSendMessageArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceSendMessageArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *MessageServiceSendMessageArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSendMessageReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *MessageServiceSendMessageArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *MessageServiceSendMessageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *MessageServiceSendMessageArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *MessageServiceSendMessageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *MessageServiceSendMessageArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *MessageServiceSendMessageResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceSendMessageResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *MessageServiceSendMessageResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSendMessageResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *MessageServiceSendMessageResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *MessageServiceSendMessageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *MessageServiceSendMessageResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *MessageServiceSendMessageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *MessageServiceSendMessageResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *MessageServiceGetChatHistoryArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetChatHistoryArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *MessageServiceGetChatHistoryArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetChatHistoryReq()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *MessageServiceGetChatHistoryArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *MessageServiceGetChatHistoryArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *MessageServiceGetChatHistoryArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *MessageServiceGetChatHistoryArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *MessageServiceGetChatHistoryArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *MessageServiceGetChatHistoryResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetChatHistoryResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *MessageServiceGetChatHistoryResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetChatHistoryResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *MessageServiceGetChatHistoryResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *MessageServiceGetChatHistoryResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *MessageServiceGetChatHistoryResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *MessageServiceGetChatHistoryResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *MessageServiceGetChatHistoryResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *MessageServiceGetLatestMessagesArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetLatestMessagesArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *MessageServiceGetLatestMessagesArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetLatestMessagesReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3151,11 +4655,11 @@ func (p *MessageServiceSendMessageArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *MessageServiceSendMessageArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceGetLatestMessagesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceSendMessageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetLatestMessagesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3164,7 +4668,7 @@ func (p *MessageServiceSendMessageArgs) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *MessageServiceSendMessageArgs) BLength() int {
+func (p *MessageServiceGetLatestMessagesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3173,21 +4677,21 @@ func (p *MessageServiceSendMessageArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceSendMessageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetLatestMessagesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceSendMessageArgs) field1Length() int {
+func (p *MessageServiceGetLatestMessagesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceSendMessageResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetLatestMessagesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3231,14 +4735,14 @@ func (p *MessageServiceSendMessageResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceSendMessageResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetLatestMessagesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceSendMessageResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceGetLatestMessagesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSendMessageResp()
+	_field := NewGetLatestMessagesResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3248,11 +4752,11 @@ func (p *MessageServiceSendMessageResult) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *MessageServiceSendMessageResult) FastWrite(buf []byte) int {
+func (p *MessageServiceGetLatestMessagesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceSendMessageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetLatestMessagesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3261,7 +4765,7 @@ func (p *MessageServiceSendMessageResult) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *MessageServiceSendMessageResult) BLength() int {
+func (p *MessageServiceGetLatestMessagesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3270,7 +4774,7 @@ func (p *MessageServiceSendMessageResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceSendMessageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetLatestMessagesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3279,7 +4783,7 @@ func (p *MessageServiceSendMessageResult) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *MessageServiceSendMessageResult) field0Length() int {
+func (p *MessageServiceGetLatestMessagesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3288,7 +4792,7 @@ func (p *MessageServiceSendMessageResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceGetChatHistoryArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceMarkMessageReadArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3332,14 +4836,14 @@ func (p *MessageServiceGetChatHistoryArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetChatHistoryArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkMessageReadArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetChatHistoryArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceMarkMessageReadArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetChatHistoryReq()
+	_field := NewMarkMessageReadReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3349,11 +4853,11 @@ func (p *MessageServiceGetChatHistoryArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *MessageServiceGetChatHistoryArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceMarkMessageReadArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetChatHistoryArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkMessageReadArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3362,7 +4866,7 @@ func (p *MessageServiceGetChatHistoryArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *MessageServiceGetChatHistoryArgs) BLength() int {
+func (p *MessageServiceMarkMessageReadArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3371,21 +4875,21 @@ func (p *MessageServiceGetChatHistoryArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetChatHistoryArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkMessageReadArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceGetChatHistoryArgs) field1Length() int {
+func (p *MessageServiceMarkMessageReadArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceGetChatHistoryResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceMarkMessageReadResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3429,14 +4933,14 @@ func (p *MessageServiceGetChatHistoryResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetChatHistoryResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkMessageReadResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetChatHistoryResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceMarkMessageReadResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetChatHistoryResp()
+	_field := NewMarkMessageReadResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3446,11 +4950,11 @@ func (p *MessageServiceGetChatHistoryResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *MessageServiceGetChatHistoryResult) FastWrite(buf []byte) int {
+func (p *MessageServiceMarkMessageReadResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetChatHistoryResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkMessageReadResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3459,7 +4963,7 @@ func (p *MessageServiceGetChatHistoryResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *MessageServiceGetChatHistoryResult) BLength() int {
+func (p *MessageServiceMarkMessageReadResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3468,7 +4972,7 @@ func (p *MessageServiceGetChatHistoryResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetChatHistoryResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkMessageReadResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3477,7 +4981,7 @@ func (p *MessageServiceGetChatHistoryResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *MessageServiceGetChatHistoryResult) field0Length() int {
+func (p *MessageServiceMarkMessageReadResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3486,7 +4990,7 @@ func (p *MessageServiceGetChatHistoryResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceDeleteMessageArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3530,14 +5034,14 @@ func (p *MessageServiceGetLatestMessagesArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetLatestMessagesArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceDeleteMessageArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceDeleteMessageArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetLatestMessagesReq()
+	_field := NewDeleteMessageReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3547,11 +5051,11 @@ func (p *MessageServiceGetLatestMessagesArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceDeleteMessageArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceDeleteMessageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3560,7 +5064,7 @@ func (p *MessageServiceGetLatestMessagesArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) BLength() int {
+func (p *MessageServiceDeleteMessageArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3569,21 +5073,21 @@ func (p *MessageServiceGetLatestMessagesArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceDeleteMessageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceGetLatestMessagesArgs) field1Length() int {
+func (p *MessageServiceDeleteMessageArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceGetLatestMessagesResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceDeleteMessageResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3627,14 +5131,14 @@ func (p *MessageServiceGetLatestMessagesResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetLatestMessagesResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceDeleteMessageResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetLatestMessagesResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceDeleteMessageResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetLatestMessagesResp()
+	_field := NewDeleteMessageResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3644,11 +5148,11 @@ func (p *MessageServiceGetLatestMessagesResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *MessageServiceGetLatestMessagesResult) FastWrite(buf []byte) int {
+func (p *MessageServiceDeleteMessageResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetLatestMessagesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceDeleteMessageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3657,7 +5161,7 @@ func (p *MessageServiceGetLatestMessagesResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *MessageServiceGetLatestMessagesResult) BLength() int {
+func (p *MessageServiceDeleteMessageResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3666,7 +5170,7 @@ func (p *MessageServiceGetLatestMessagesResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetLatestMessagesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceDeleteMessageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3675,7 +5179,7 @@ func (p *MessageServiceGetLatestMessagesResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *MessageServiceGetLatestMessagesResult) field0Length() int {
+func (p *MessageServiceDeleteMessageResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3684,7 +5188,7 @@ func (p *MessageServiceGetLatestMessagesResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceMarkMessageReadArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetUnreadCountArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3728,14 +5232,14 @@ func (p *MessageServiceMarkMessageReadArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkMessageReadArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetUnreadCountArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceMarkMessageReadArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceGetUnreadCountArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMarkMessageReadReq()
+	_field := NewGetUnreadCountReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3745,11 +5249,11 @@ func (p *MessageServiceMarkMessageReadArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *MessageServiceMarkMessageReadArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceGetUnreadCountArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceMarkMessageReadArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetUnreadCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3758,7 +5262,7 @@ func (p *MessageServiceMarkMessageReadArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *MessageServiceMarkMessageReadArgs) BLength() int {
+func (p *MessageServiceGetUnreadCountArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3767,21 +5271,21 @@ func (p *MessageServiceMarkMessageReadArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceMarkMessageReadArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetUnreadCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceMarkMessageReadArgs) field1Length() int {
+func (p *MessageServiceGetUnreadCountArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceMarkMessageReadResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetUnreadCountResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3825,14 +5329,14 @@ func (p *MessageServiceMarkMessageReadResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkMessageReadResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetUnreadCountResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceMarkMessageReadResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceGetUnreadCountResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMarkMessageReadResp()
+	_field := NewGetUnreadCountResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3842,11 +5346,11 @@ func (p *MessageServiceMarkMessageReadResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *MessageServiceMarkMessageReadResult) FastWrite(buf []byte) int {
+func (p *MessageServiceGetUnreadCountResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceMarkMessageReadResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetUnreadCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -3855,7 +5359,7 @@ func (p *MessageServiceMarkMessageReadResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *MessageServiceMarkMessageReadResult) BLength() int {
+func (p *MessageServiceGetUnreadCountResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -3864,7 +5368,7 @@ func (p *MessageServiceMarkMessageReadResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceMarkMessageReadResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetUnreadCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -3873,7 +5377,7 @@ func (p *MessageServiceMarkMessageReadResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *MessageServiceMarkMessageReadResult) field0Length() int {
+func (p *MessageServiceGetUnreadCountResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -3882,7 +5386,7 @@ func (p *MessageServiceMarkMessageReadResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceDeleteMessageArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetNotificationsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3926,14 +5430,14 @@ func (p *MessageServiceDeleteMessageArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceDeleteMessageArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetNotificationsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceDeleteMessageArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceGetNotificationsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteMessageReq()
+	_field := NewGetNotificationsReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -3943,11 +5447,11 @@ func (p *MessageServiceDeleteMessageArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *MessageServiceDeleteMessageArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceGetNotificationsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceDeleteMessageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetNotificationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -3956,7 +5460,7 @@ func (p *MessageServiceDeleteMessageArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *MessageServiceDeleteMessageArgs) BLength() int {
+func (p *MessageServiceGetNotificationsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -3965,21 +5469,21 @@ func (p *MessageServiceDeleteMessageArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceDeleteMessageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetNotificationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceDeleteMessageArgs) field1Length() int {
+func (p *MessageServiceGetNotificationsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceDeleteMessageResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetNotificationsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4023,14 +5527,14 @@ func (p *MessageServiceDeleteMessageResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceDeleteMessageResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetNotificationsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceDeleteMessageResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceGetNotificationsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteMessageResp()
+	_field := NewGetNotificationsResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4040,11 +5544,11 @@ func (p *MessageServiceDeleteMessageResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *MessageServiceDeleteMessageResult) FastWrite(buf []byte) int {
+func (p *MessageServiceGetNotificationsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceDeleteMessageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetNotificationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4053,7 +5557,7 @@ func (p *MessageServiceDeleteMessageResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *MessageServiceDeleteMessageResult) BLength() int {
+func (p *MessageServiceGetNotificationsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4062,7 +5566,7 @@ func (p *MessageServiceDeleteMessageResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceDeleteMessageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetNotificationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4071,7 +5575,7 @@ func (p *MessageServiceDeleteMessageResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *MessageServiceDeleteMessageResult) field0Length() int {
+func (p *MessageServiceGetNotificationsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4080,7 +5584,7 @@ func (p *MessageServiceDeleteMessageResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceGetUnreadCountArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceMarkNotificationReadArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4124,14 +5628,14 @@ func (p *MessageServiceGetUnreadCountArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetUnreadCountArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkNotificationReadArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetUnreadCountArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceMarkNotificationReadArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetUnreadCountReq()
+	_field := NewMarkNotificationReadReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4141,11 +5645,11 @@ func (p *MessageServiceGetUnreadCountArgs) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *MessageServiceGetUnreadCountArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceMarkNotificationReadArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetUnreadCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkNotificationReadArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4154,7 +5658,7 @@ func (p *MessageServiceGetUnreadCountArgs) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *MessageServiceGetUnreadCountArgs) BLength() int {
+func (p *MessageServiceMarkNotificationReadArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4163,21 +5667,21 @@ func (p *MessageServiceGetUnreadCountArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetUnreadCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkNotificationReadArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceGetUnreadCountArgs) field1Length() int {
+func (p *MessageServiceMarkNotificationReadArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceGetUnreadCountResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceMarkNotificationReadResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4221,14 +5725,14 @@ func (p *MessageServiceGetUnreadCountResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetUnreadCountResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkNotificationReadResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetUnreadCountResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceMarkNotificationReadResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetUnreadCountResp()
+	_field := NewMarkNotificationReadResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4238,11 +5742,11 @@ func (p *MessageServiceGetUnreadCountResult) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *MessageServiceGetUnreadCountResult) FastWrite(buf []byte) int {
+func (p *MessageServiceMarkNotificationReadResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetUnreadCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkNotificationReadResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4251,7 +5755,7 @@ func (p *MessageServiceGetUnreadCountResult) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *MessageServiceGetUnreadCountResult) BLength() int {
+func (p *MessageServiceMarkNotificationReadResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4260,7 +5764,7 @@ func (p *MessageServiceGetUnreadCountResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetUnreadCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceMarkNotificationReadResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4269,7 +5773,7 @@ func (p *MessageServiceGetUnreadCountResult) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *MessageServiceGetUnreadCountResult) field0Length() int {
+func (p *MessageServiceMarkNotificationReadResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4278,7 +5782,7 @@ func (p *MessageServiceGetUnreadCountResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceGetNotificationsArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetPendingMessagesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4322,14 +5826,14 @@ func (p *MessageServiceGetNotificationsArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetNotificationsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetPendingMessagesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetNotificationsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceGetPendingMessagesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetNotificationsReq()
+	_field := NewGetPendingMessagesReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4339,11 +5843,11 @@ func (p *MessageServiceGetNotificationsArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *MessageServiceGetNotificationsArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceGetPendingMessagesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetNotificationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetPendingMessagesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4352,7 +5856,7 @@ func (p *MessageServiceGetNotificationsArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *MessageServiceGetNotificationsArgs) BLength() int {
+func (p *MessageServiceGetPendingMessagesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4361,21 +5865,21 @@ func (p *MessageServiceGetNotificationsArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetNotificationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetPendingMessagesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceGetNotificationsArgs) field1Length() int {
+func (p *MessageServiceGetPendingMessagesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceGetNotificationsResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceGetPendingMessagesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4419,14 +5923,14 @@ func (p *MessageServiceGetNotificationsResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetNotificationsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceGetPendingMessagesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceGetNotificationsResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceGetPendingMessagesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetNotificationsResp()
+	_field := NewGetPendingMessagesResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4436,11 +5940,11 @@ func (p *MessageServiceGetNotificationsResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *MessageServiceGetNotificationsResult) FastWrite(buf []byte) int {
+func (p *MessageServiceGetPendingMessagesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceGetNotificationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetPendingMessagesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4449,7 +5953,7 @@ func (p *MessageServiceGetNotificationsResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *MessageServiceGetNotificationsResult) BLength() int {
+func (p *MessageServiceGetPendingMessagesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4458,7 +5962,7 @@ func (p *MessageServiceGetNotificationsResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceGetNotificationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceGetPendingMessagesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4467,7 +5971,7 @@ func (p *MessageServiceGetNotificationsResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *MessageServiceGetNotificationsResult) field0Length() int {
+func (p *MessageServiceGetPendingMessagesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4476,7 +5980,7 @@ func (p *MessageServiceGetNotificationsResult) field0Length() int {
 	return l
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceReviewMessageArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4520,14 +6024,14 @@ func (p *MessageServiceMarkNotificationReadArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkNotificationReadArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceReviewMessageArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) FastReadField1(buf []byte) (int, error) {
+func (p *MessageServiceReviewMessageArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMarkNotificationReadReq()
+	_field := NewReviewMessageReq()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4537,11 +6041,11 @@ func (p *MessageServiceMarkNotificationReadArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) FastWrite(buf []byte) int {
+func (p *MessageServiceReviewMessageArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceReviewMessageArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4550,7 +6054,7 @@ func (p *MessageServiceMarkNotificationReadArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) BLength() int {
+func (p *MessageServiceReviewMessageArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4559,21 +6063,21 @@ func (p *MessageServiceMarkNotificationReadArgs) BLength() int {
 	return l
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceReviewMessageArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *MessageServiceMarkNotificationReadArgs) field1Length() int {
+func (p *MessageServiceReviewMessageArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *MessageServiceMarkNotificationReadResult) FastRead(buf []byte) (int, error) {
+func (p *MessageServiceReviewMessageResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4617,14 +6121,14 @@ func (p *MessageServiceMarkNotificationReadResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceMarkNotificationReadResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MessageServiceReviewMessageResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *MessageServiceMarkNotificationReadResult) FastReadField0(buf []byte) (int, error) {
+func (p *MessageServiceReviewMessageResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewMarkNotificationReadResp()
+	_field := NewReviewMessageResp()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4634,11 +6138,11 @@ func (p *MessageServiceMarkNotificationReadResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *MessageServiceMarkNotificationReadResult) FastWrite(buf []byte) int {
+func (p *MessageServiceReviewMessageResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *MessageServiceMarkNotificationReadResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceReviewMessageResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4647,7 +6151,7 @@ func (p *MessageServiceMarkNotificationReadResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *MessageServiceMarkNotificationReadResult) BLength() int {
+func (p *MessageServiceReviewMessageResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4656,7 +6160,7 @@ func (p *MessageServiceMarkNotificationReadResult) BLength() int {
 	return l
 }
 
-func (p *MessageServiceMarkNotificationReadResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *MessageServiceReviewMessageResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4665,7 +6169,7 @@ func (p *MessageServiceMarkNotificationReadResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *MessageServiceMarkNotificationReadResult) field0Length() int {
+func (p *MessageServiceReviewMessageResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4737,3 +6241,19 @@ func (p *MessageServiceMarkNotificationReadArgs) GetFirstArgument() interface{}
 func (p *MessageServiceMarkNotificationReadResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *MessageServiceGetPendingMessagesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *MessageServiceGetPendingMessagesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *MessageServiceReviewMessageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *MessageServiceReviewMessageResult) GetResult() interface{} {
+	return p.Success
+}
@@ -58,6 +58,8 @@ var fieldIDToName_SendMessageReq = map[int16]string{
 type SendMessageResp struct {
 	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	MessageId int64            `thrift:"messageId,2" frugal:"2,default,i64" json:"messageId"`
+	Content   *string          `thrift:"content,3,optional" frugal:"3,optional,string" json:"content,omitempty"`
+	Pending   bool             `thrift:"pending,4" frugal:"4,default,bool" json:"pending"`
 }
 
 func NewSendMessageResp() *SendMessageResp {
@@ -79,17 +81,40 @@ func (p *SendMessageResp) GetBaseResp() (v *common.BaseResp) {
 func (p *SendMessageResp) GetMessageId() (v int64) {
 	return p.MessageId
 }
+
+var SendMessageResp_Content_DEFAULT string
+
+func (p *SendMessageResp) GetContent() (v string) {
+	if !p.IsSetContent() {
+		return SendMessageResp_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+func (p *SendMessageResp) GetPending() (v bool) {
+	return p.Pending
+}
 func (p *SendMessageResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
 func (p *SendMessageResp) SetMessageId(val int64) {
 	p.MessageId = val
 }
+func (p *SendMessageResp) SetContent(val *string) {
+	p.Content = val
+}
+func (p *SendMessageResp) SetPending(val bool) {
+	p.Pending = val
+}
 
 func (p *SendMessageResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *SendMessageResp) IsSetContent() bool {
+	return p.Content != nil
+}
+
 func (p *SendMessageResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -100,13 +125,16 @@ func (p *SendMessageResp) String() string {
 var fieldIDToName_SendMessageResp = map[int16]string{
 	1: "BaseResp",
 	2: "messageId",
+	3: "content",
+	4: "pending",
 }
 
 type GetChatHistoryReq struct {
-	UserId1       int64 `thrift:"userId1,1" frugal:"1,default,i64" json:"userId1"`
-	UserId2       int64 `thrift:"userId2,2" frugal:"2,default,i64" json:"userId2"`
-	LastMessageId int64 `thrift:"lastMessageId,3" frugal:"3,default,i64" json:"lastMessageId"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId1       int64   `thrift:"userId1,1" frugal:"1,default,i64" json:"userId1"`
+	UserId2       int64   `thrift:"userId2,2" frugal:"2,default,i64" json:"userId2"`
+	LastMessageId int64   `thrift:"lastMessageId,3" frugal:"3,default,i64" json:"lastMessageId"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
 }
 
 func NewGetChatHistoryReq() *GetChatHistoryReq {
@@ -131,6 +159,15 @@ func (p *GetChatHistoryReq) GetLastMessageId() (v int64) {
 func (p *GetChatHistoryReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetChatHistoryReq_Cursor_DEFAULT string
+
+func (p *GetChatHistoryReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetChatHistoryReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *GetChatHistoryReq) SetUserId1(val int64) {
 	p.UserId1 = val
 }
@@ -143,6 +180,13 @@ func (p *GetChatHistoryReq) SetLastMessageId(val int64) {
 func (p *GetChatHistoryReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetChatHistoryReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+
+func (p *GetChatHistoryReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
 
 func (p *GetChatHistoryReq) String() string {
 	if p == nil {
@@ -156,12 +200,15 @@ var fieldIDToName_GetChatHistoryReq = map[int16]string{
 	2: "userId2",
 	3: "lastMessageId",
 	4: "pageSize",
+	5: "cursor",
 }
 
 type GetChatHistoryResp struct {
 	BaseResp      *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Messages      []*common.Message `thrift:"messages,2" frugal:"2,default,list<common.Message>" json:"messages"`
 	NextMessageId int64             `thrift:"nextMessageId,3" frugal:"3,default,i64" json:"nextMessageId"`
+	NextCursor    *string           `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore       bool              `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewGetChatHistoryResp() *GetChatHistoryResp {
@@ -187,6 +234,19 @@ func (p *GetChatHistoryResp) GetMessages() (v []*common.Message) {
 func (p *GetChatHistoryResp) GetNextMessageId() (v int64) {
 	return p.NextMessageId
 }
+
+var GetChatHistoryResp_NextCursor_DEFAULT string
+
+func (p *GetChatHistoryResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetChatHistoryResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *GetChatHistoryResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *GetChatHistoryResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -196,11 +256,21 @@ func (p *GetChatHistoryResp) SetMessages(val []*common.Message) {
 func (p *GetChatHistoryResp) SetNextMessageId(val int64) {
 	p.NextMessageId = val
 }
+func (p *GetChatHistoryResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *GetChatHistoryResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *GetChatHistoryResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *GetChatHistoryResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *GetChatHistoryResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -212,6 +282,8 @@ var fieldIDToName_GetChatHistoryResp = map[int16]string{
 	1: "BaseResp",
 	2: "messages",
 	3: "nextMessageId",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type GetLatestMessagesReq struct {
@@ -685,10 +757,12 @@ var fieldIDToName_SystemNotification = map[int16]string{
 }
 
 type GetNotificationsReq struct {
-	UserId   int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Page     int32  `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize int32  `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
-	Type     *int32 `thrift:"type,4,optional" frugal:"4,optional,i32" json:"type,omitempty"`
+	UserId    int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Page      int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Type      *int32  `thrift:"type,4,optional" frugal:"4,optional,i32" json:"type,omitempty"`
+	Cursor    *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewGetNotificationsReq() *GetNotificationsReq {
@@ -718,6 +792,24 @@ func (p *GetNotificationsReq) GetType() (v int32) {
 	}
 	return *p.Type
 }
+
+var GetNotificationsReq_Cursor_DEFAULT string
+
+func (p *GetNotificationsReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetNotificationsReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var GetNotificationsReq_NeedTotal_DEFAULT bool
+
+func (p *GetNotificationsReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return GetNotificationsReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *GetNotificationsReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -730,11 +822,25 @@ func (p *GetNotificationsReq) SetPageSize(val int32) {
 func (p *GetNotificationsReq) SetType(val *int32) {
 	p.Type = val
 }
+func (p *GetNotificationsReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *GetNotificationsReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
 
 func (p *GetNotificationsReq) IsSetType() bool {
 	return p.Type != nil
 }
 
+func (p *GetNotificationsReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetNotificationsReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
+
 func (p *GetNotificationsReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -747,12 +853,16 @@ var fieldIDToName_GetNotificationsReq = map[int16]string{
 	2: "page",
 	3: "pageSize",
 	4: "type",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type GetNotificationsResp struct {
 	BaseResp      *common.BaseResp      `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Notifications []*SystemNotification `thrift:"notifications,2" frugal:"2,default,list<SystemNotification>" json:"notifications"`
 	TotalCount    int32                 `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor    *string               `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore       bool                  `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewGetNotificationsResp() *GetNotificationsResp {
@@ -778,6 +888,19 @@ func (p *GetNotificationsResp) GetNotifications() (v []*SystemNotification) {
 func (p *GetNotificationsResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var GetNotificationsResp_NextCursor_DEFAULT string
+
+func (p *GetNotificationsResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetNotificationsResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *GetNotificationsResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *GetNotificationsResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -787,11 +910,21 @@ func (p *GetNotificationsResp) SetNotifications(val []*SystemNotification) {
 func (p *GetNotificationsResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *GetNotificationsResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *GetNotificationsResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *GetNotificationsResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *GetNotificationsResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *GetNotificationsResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -803,6 +936,8 @@ var fieldIDToName_GetNotificationsResp = map[int16]string{
 	1: "BaseResp",
 	2: "notifications",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type MarkNotificationReadReq struct {
@@ -881,6 +1016,221 @@ var fieldIDToName_MarkNotificationReadResp = map[int16]string{
 	1: "BaseResp",
 }
 
+type GetPendingMessagesReq struct {
+	PageSize int32   `thrift:"pageSize,1" frugal:"1,default,i32" json:"pageSize"`
+	Cursor   *string `thrift:"cursor,2,optional" frugal:"2,optional,string" json:"cursor,omitempty"`
+}
+
+func NewGetPendingMessagesReq() *GetPendingMessagesReq {
+	return &GetPendingMessagesReq{}
+}
+
+func (p *GetPendingMessagesReq) InitDefault() {
+}
+
+func (p *GetPendingMessagesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var GetPendingMessagesReq_Cursor_DEFAULT string
+
+func (p *GetPendingMessagesReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetPendingMessagesReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+func (p *GetPendingMessagesReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *GetPendingMessagesReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+
+func (p *GetPendingMessagesReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetPendingMessagesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPendingMessagesReq(%+v)", *p)
+}
+
+var fieldIDToName_GetPendingMessagesReq = map[int16]string{
+	1: "pageSize",
+	2: "cursor",
+}
+
+type GetPendingMessagesResp struct {
+	BaseResp   *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Messages   []*common.Message `thrift:"messages,2" frugal:"2,default,list<common.Message>" json:"messages"`
+	NextCursor *string           `thrift:"nextCursor,3,optional" frugal:"3,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool              `thrift:"hasMore,4" frugal:"4,default,bool" json:"hasMore"`
+}
+
+func NewGetPendingMessagesResp() *GetPendingMessagesResp {
+	return &GetPendingMessagesResp{}
+}
+
+func (p *GetPendingMessagesResp) InitDefault() {
+}
+
+var GetPendingMessagesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetPendingMessagesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetPendingMessagesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetPendingMessagesResp) GetMessages() (v []*common.Message) {
+	return p.Messages
+}
+
+var GetPendingMessagesResp_NextCursor_DEFAULT string
+
+func (p *GetPendingMessagesResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetPendingMessagesResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *GetPendingMessagesResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *GetPendingMessagesResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *GetPendingMessagesResp) SetMessages(val []*common.Message) {
+	p.Messages = val
+}
+func (p *GetPendingMessagesResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *GetPendingMessagesResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *GetPendingMessagesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetPendingMessagesResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *GetPendingMessagesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPendingMessagesResp(%+v)", *p)
+}
+
+var fieldIDToName_GetPendingMessagesResp = map[int16]string{
+	1: "BaseResp",
+	2: "messages",
+	3: "nextCursor",
+	4: "hasMore",
+}
+
+type ReviewMessageReq struct {
+	MessageId int64 `thrift:"messageId,1" frugal:"1,default,i64" json:"messageId"`
+	Approve   bool  `thrift:"approve,2" frugal:"2,default,bool" json:"approve"`
+}
+
+func NewReviewMessageReq() *ReviewMessageReq {
+	return &ReviewMessageReq{}
+}
+
+func (p *ReviewMessageReq) InitDefault() {
+}
+
+func (p *ReviewMessageReq) GetMessageId() (v int64) {
+	return p.MessageId
+}
+
+func (p *ReviewMessageReq) GetApprove() (v bool) {
+	return p.Approve
+}
+func (p *ReviewMessageReq) SetMessageId(val int64) {
+	p.MessageId = val
+}
+func (p *ReviewMessageReq) SetApprove(val bool) {
+	p.Approve = val
+}
+
+func (p *ReviewMessageReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewMessageReq(%+v)", *p)
+}
+
+var fieldIDToName_ReviewMessageReq = map[int16]string{
+	1: "messageId",
+	2: "approve",
+}
+
+type ReviewMessageResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Message  *common.Message  `thrift:"message,2,optional" frugal:"2,optional,common.Message" json:"message,omitempty"`
+}
+
+func NewReviewMessageResp() *ReviewMessageResp {
+	return &ReviewMessageResp{}
+}
+
+func (p *ReviewMessageResp) InitDefault() {
+}
+
+var ReviewMessageResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ReviewMessageResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReviewMessageResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ReviewMessageResp_Message_DEFAULT *common.Message
+
+func (p *ReviewMessageResp) GetMessage() (v *common.Message) {
+	if !p.IsSetMessage() {
+		return ReviewMessageResp_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *ReviewMessageResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *ReviewMessageResp) SetMessage(val *common.Message) {
+	p.Message = val
+}
+
+func (p *ReviewMessageResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReviewMessageResp) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ReviewMessageResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewMessageResp(%+v)", *p)
+}
+
+var fieldIDToName_ReviewMessageResp = map[int16]string{
+	1: "BaseResp",
+	2: "message",
+}
+
 type MessageService interface {
 	SendMessage(ctx context.Context, req *SendMessageReq) (r *SendMessageResp, err error)
 
@@ -897,6 +1247,10 @@ type MessageService interface {
 	GetNotifications(ctx context.Context, req *GetNotificationsReq) (r *GetNotificationsResp, err error)
 
 	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadReq) (r *MarkNotificationReadResp, err error)
+
+	GetPendingMessages(ctx context.Context, req *GetPendingMessagesReq) (r *GetPendingMessagesResp, err error)
+
+	ReviewMessage(ctx context.Context, req *ReviewMessageReq) (r *ReviewMessageResp, err error)
 }
 
 type MessageServiceSendMessageArgs struct {
@@ -1506,3 +1860,155 @@ func (p *MessageServiceMarkNotificationReadResult) String() string {
 var fieldIDToName_MessageServiceMarkNotificationReadResult = map[int16]string{
 	0: "success",
 }
+
+type MessageServiceGetPendingMessagesArgs struct {
+	Req *GetPendingMessagesReq `thrift:"req,1" frugal:"1,default,GetPendingMessagesReq" json:"req"`
+}
+
+func NewMessageServiceGetPendingMessagesArgs() *MessageServiceGetPendingMessagesArgs {
+	return &MessageServiceGetPendingMessagesArgs{}
+}
+
+func (p *MessageServiceGetPendingMessagesArgs) InitDefault() {
+}
+
+var MessageServiceGetPendingMessagesArgs_Req_DEFAULT *GetPendingMessagesReq
+
+func (p *MessageServiceGetPendingMessagesArgs) GetReq() (v *GetPendingMessagesReq) {
+	if !p.IsSetReq() {
+		return MessageServiceGetPendingMessagesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MessageServiceGetPendingMessagesArgs) SetReq(val *GetPendingMessagesReq) {
+	p.Req = val
+}
+
+func (p *MessageServiceGetPendingMessagesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MessageServiceGetPendingMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MessageServiceGetPendingMessagesArgs(%+v)", *p)
+}
+
+var fieldIDToName_MessageServiceGetPendingMessagesArgs = map[int16]string{
+	1: "req",
+}
+
+type MessageServiceGetPendingMessagesResult struct {
+	Success *GetPendingMessagesResp `thrift:"success,0,optional" frugal:"0,optional,GetPendingMessagesResp" json:"success,omitempty"`
+}
+
+func NewMessageServiceGetPendingMessagesResult() *MessageServiceGetPendingMessagesResult {
+	return &MessageServiceGetPendingMessagesResult{}
+}
+
+func (p *MessageServiceGetPendingMessagesResult) InitDefault() {
+}
+
+var MessageServiceGetPendingMessagesResult_Success_DEFAULT *GetPendingMessagesResp
+
+func (p *MessageServiceGetPendingMessagesResult) GetSuccess() (v *GetPendingMessagesResp) {
+	if !p.IsSetSuccess() {
+		return MessageServiceGetPendingMessagesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MessageServiceGetPendingMessagesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPendingMessagesResp)
+}
+
+func (p *MessageServiceGetPendingMessagesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MessageServiceGetPendingMessagesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MessageServiceGetPendingMessagesResult(%+v)", *p)
+}
+
+var fieldIDToName_MessageServiceGetPendingMessagesResult = map[int16]string{
+	0: "success",
+}
+
+type MessageServiceReviewMessageArgs struct {
+	Req *ReviewMessageReq `thrift:"req,1" frugal:"1,default,ReviewMessageReq" json:"req"`
+}
+
+func NewMessageServiceReviewMessageArgs() *MessageServiceReviewMessageArgs {
+	return &MessageServiceReviewMessageArgs{}
+}
+
+func (p *MessageServiceReviewMessageArgs) InitDefault() {
+}
+
+var MessageServiceReviewMessageArgs_Req_DEFAULT *ReviewMessageReq
+
+func (p *MessageServiceReviewMessageArgs) GetReq() (v *ReviewMessageReq) {
+	if !p.IsSetReq() {
+		return MessageServiceReviewMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *MessageServiceReviewMessageArgs) SetReq(val *ReviewMessageReq) {
+	p.Req = val
+}
+
+func (p *MessageServiceReviewMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MessageServiceReviewMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MessageServiceReviewMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_MessageServiceReviewMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type MessageServiceReviewMessageResult struct {
+	Success *ReviewMessageResp `thrift:"success,0,optional" frugal:"0,optional,ReviewMessageResp" json:"success,omitempty"`
+}
+
+func NewMessageServiceReviewMessageResult() *MessageServiceReviewMessageResult {
+	return &MessageServiceReviewMessageResult{}
+}
+
+func (p *MessageServiceReviewMessageResult) InitDefault() {
+}
+
+var MessageServiceReviewMessageResult_Success_DEFAULT *ReviewMessageResp
+
+func (p *MessageServiceReviewMessageResult) GetSuccess() (v *ReviewMessageResp) {
+	if !p.IsSetSuccess() {
+		return MessageServiceReviewMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *MessageServiceReviewMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewMessageResp)
+}
+
+func (p *MessageServiceReviewMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MessageServiceReviewMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MessageServiceReviewMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_MessageServiceReviewMessageResult = map[int16]string{
+	0: "success",
+}
@@ -19,6 +19,8 @@ type Client interface {
 	GetUnreadCount(ctx context.Context, req *message.GetUnreadCountReq, callOptions ...callopt.Option) (r *message.GetUnreadCountResp, err error)
 	GetNotifications(ctx context.Context, req *message.GetNotificationsReq, callOptions ...callopt.Option) (r *message.GetNotificationsResp, err error)
 	MarkNotificationRead(ctx context.Context, req *message.MarkNotificationReadReq, callOptions ...callopt.Option) (r *message.MarkNotificationReadResp, err error)
+	GetPendingMessages(ctx context.Context, req *message.GetPendingMessagesReq, callOptions ...callopt.Option) (r *message.GetPendingMessagesResp, err error)
+	ReviewMessage(ctx context.Context, req *message.ReviewMessageReq, callOptions ...callopt.Option) (r *message.ReviewMessageResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -89,3 +91,13 @@ func (p *kMessageServiceClient) MarkNotificationRead(ctx context.Context, req *m
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkNotificationRead(ctx, req)
 }
+
+func (p *kMessageServiceClient) GetPendingMessages(ctx context.Context, req *message.GetPendingMessagesReq, callOptions ...callopt.Option) (r *message.GetPendingMessagesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPendingMessages(ctx, req)
+}
+
+func (p *kMessageServiceClient) ReviewMessage(ctx context.Context, req *message.ReviewMessageReq, callOptions ...callopt.Option) (r *message.ReviewMessageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewMessage(ctx, req)
+}
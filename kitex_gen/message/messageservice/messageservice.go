@@ -69,6 +69,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetPendingMessages": kitex.NewMethodInfo(
+		getPendingMessagesHandler,
+		newMessageServiceGetPendingMessagesArgs,
+		newMessageServiceGetPendingMessagesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewMessage": kitex.NewMethodInfo(
+		reviewMessageHandler,
+		newMessageServiceReviewMessageArgs,
+		newMessageServiceReviewMessageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -279,6 +293,42 @@ func newMessageServiceMarkNotificationReadResult() interface{} {
 	return message.NewMessageServiceMarkNotificationReadResult()
 }
 
+func getPendingMessagesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceGetPendingMessagesArgs)
+	realResult := result.(*message.MessageServiceGetPendingMessagesResult)
+	success, err := handler.(message.MessageService).GetPendingMessages(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceGetPendingMessagesArgs() interface{} {
+	return message.NewMessageServiceGetPendingMessagesArgs()
+}
+
+func newMessageServiceGetPendingMessagesResult() interface{} {
+	return message.NewMessageServiceGetPendingMessagesResult()
+}
+
+func reviewMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*message.MessageServiceReviewMessageArgs)
+	realResult := result.(*message.MessageServiceReviewMessageResult)
+	success, err := handler.(message.MessageService).ReviewMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newMessageServiceReviewMessageArgs() interface{} {
+	return message.NewMessageServiceReviewMessageArgs()
+}
+
+func newMessageServiceReviewMessageResult() interface{} {
+	return message.NewMessageServiceReviewMessageResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -368,3 +418,23 @@ func (p *kClient) MarkNotificationRead(ctx context.Context, req *message.MarkNot
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetPendingMessages(ctx context.Context, req *message.GetPendingMessagesReq) (r *message.GetPendingMessagesResp, err error) {
+	var _args message.MessageServiceGetPendingMessagesArgs
+	_args.Req = req
+	var _result message.MessageServiceGetPendingMessagesResult
+	if err = p.c.Call(ctx, "GetPendingMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewMessage(ctx context.Context, req *message.ReviewMessageReq) (r *message.ReviewMessageResp, err error) {
+	var _args message.MessageServiceReviewMessageArgs
+	_args.Req = req
+	var _result message.MessageServiceReviewMessageResult
+	if err = p.c.Call(ctx, "ReviewMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
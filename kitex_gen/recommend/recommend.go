@@ -673,6 +673,501 @@ var fieldIDToName_GetPersonalizedFeedResp = map[int16]string{
 	3: "nextLastVideoId",
 }
 
+type FeedbackItem struct {
+	Id         int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Reason     string `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
+	TargetType string `thrift:"targetType,3" frugal:"3,default,string" json:"targetType"`
+	TargetId   int64  `thrift:"targetId,4" frugal:"4,default,i64" json:"targetId"`
+	TagName    string `thrift:"tagName,5" frugal:"5,default,string" json:"tagName"`
+	CreatedAt  int64  `thrift:"createdAt,6" frugal:"6,default,i64" json:"createdAt"`
+}
+
+func NewFeedbackItem() *FeedbackItem {
+	return &FeedbackItem{}
+}
+
+func (p *FeedbackItem) InitDefault() {
+}
+
+func (p *FeedbackItem) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *FeedbackItem) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *FeedbackItem) GetTargetType() (v string) {
+	return p.TargetType
+}
+
+func (p *FeedbackItem) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *FeedbackItem) GetTagName() (v string) {
+	return p.TagName
+}
+
+func (p *FeedbackItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *FeedbackItem) SetId(val int64) {
+	p.Id = val
+}
+func (p *FeedbackItem) SetReason(val string) {
+	p.Reason = val
+}
+func (p *FeedbackItem) SetTargetType(val string) {
+	p.TargetType = val
+}
+func (p *FeedbackItem) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *FeedbackItem) SetTagName(val string) {
+	p.TagName = val
+}
+func (p *FeedbackItem) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *FeedbackItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FeedbackItem(%+v)", *p)
+}
+
+var fieldIDToName_FeedbackItem = map[int16]string{
+	1: "id",
+	2: "reason",
+	3: "targetType",
+	4: "targetId",
+	5: "tagName",
+	6: "createdAt",
+}
+
+type SubmitFeedbackReq struct {
+	UserId   int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Reason   string  `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
+	VideoId  *int64  `thrift:"videoId,3,optional" frugal:"3,optional,i64" json:"videoId,omitempty"`
+	AuthorId *int64  `thrift:"authorId,4,optional" frugal:"4,optional,i64" json:"authorId,omitempty"`
+	Tag      *string `thrift:"tag,5,optional" frugal:"5,optional,string" json:"tag,omitempty"`
+}
+
+func NewSubmitFeedbackReq() *SubmitFeedbackReq {
+	return &SubmitFeedbackReq{}
+}
+
+func (p *SubmitFeedbackReq) InitDefault() {
+}
+
+func (p *SubmitFeedbackReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SubmitFeedbackReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var SubmitFeedbackReq_VideoId_DEFAULT int64
+
+func (p *SubmitFeedbackReq) GetVideoId() (v int64) {
+	if !p.IsSetVideoId() {
+		return SubmitFeedbackReq_VideoId_DEFAULT
+	}
+	return *p.VideoId
+}
+
+var SubmitFeedbackReq_AuthorId_DEFAULT int64
+
+func (p *SubmitFeedbackReq) GetAuthorId() (v int64) {
+	if !p.IsSetAuthorId() {
+		return SubmitFeedbackReq_AuthorId_DEFAULT
+	}
+	return *p.AuthorId
+}
+
+var SubmitFeedbackReq_Tag_DEFAULT string
+
+func (p *SubmitFeedbackReq) GetTag() (v string) {
+	if !p.IsSetTag() {
+		return SubmitFeedbackReq_Tag_DEFAULT
+	}
+	return *p.Tag
+}
+func (p *SubmitFeedbackReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SubmitFeedbackReq) SetReason(val string) {
+	p.Reason = val
+}
+func (p *SubmitFeedbackReq) SetVideoId(val *int64) {
+	p.VideoId = val
+}
+func (p *SubmitFeedbackReq) SetAuthorId(val *int64) {
+	p.AuthorId = val
+}
+func (p *SubmitFeedbackReq) SetTag(val *string) {
+	p.Tag = val
+}
+
+func (p *SubmitFeedbackReq) IsSetVideoId() bool {
+	return p.VideoId != nil
+}
+
+func (p *SubmitFeedbackReq) IsSetAuthorId() bool {
+	return p.AuthorId != nil
+}
+
+func (p *SubmitFeedbackReq) IsSetTag() bool {
+	return p.Tag != nil
+}
+
+func (p *SubmitFeedbackReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitFeedbackReq(%+v)", *p)
+}
+
+var fieldIDToName_SubmitFeedbackReq = map[int16]string{
+	1: "userId",
+	2: "reason",
+	3: "videoId",
+	4: "authorId",
+	5: "tag",
+}
+
+type SubmitFeedbackResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	FeedbackId int64            `thrift:"feedbackId,2" frugal:"2,default,i64" json:"feedbackId"`
+}
+
+func NewSubmitFeedbackResp() *SubmitFeedbackResp {
+	return &SubmitFeedbackResp{}
+}
+
+func (p *SubmitFeedbackResp) InitDefault() {
+}
+
+var SubmitFeedbackResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SubmitFeedbackResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SubmitFeedbackResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *SubmitFeedbackResp) GetFeedbackId() (v int64) {
+	return p.FeedbackId
+}
+func (p *SubmitFeedbackResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *SubmitFeedbackResp) SetFeedbackId(val int64) {
+	p.FeedbackId = val
+}
+
+func (p *SubmitFeedbackResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SubmitFeedbackResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SubmitFeedbackResp(%+v)", *p)
+}
+
+var fieldIDToName_SubmitFeedbackResp = map[int16]string{
+	1: "BaseResp",
+	2: "feedbackId",
+}
+
+type GetFeedbackListReq struct {
+	UserId     int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TargetType *string `thrift:"targetType,2,optional" frugal:"2,optional,string" json:"targetType,omitempty"`
+	Page       int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize   int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewGetFeedbackListReq() *GetFeedbackListReq {
+	return &GetFeedbackListReq{}
+}
+
+func (p *GetFeedbackListReq) InitDefault() {
+}
+
+func (p *GetFeedbackListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var GetFeedbackListReq_TargetType_DEFAULT string
+
+func (p *GetFeedbackListReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return GetFeedbackListReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+func (p *GetFeedbackListReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetFeedbackListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *GetFeedbackListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetFeedbackListReq) SetTargetType(val *string) {
+	p.TargetType = val
+}
+func (p *GetFeedbackListReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetFeedbackListReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *GetFeedbackListReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *GetFeedbackListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFeedbackListReq(%+v)", *p)
+}
+
+var fieldIDToName_GetFeedbackListReq = map[int16]string{
+	1: "userId",
+	2: "targetType",
+	3: "page",
+	4: "pageSize",
+}
+
+type GetFeedbackListResp struct {
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Feedbacks []*FeedbackItem  `thrift:"feedbacks,2" frugal:"2,default,list<FeedbackItem>" json:"feedbacks"`
+	Total     int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewGetFeedbackListResp() *GetFeedbackListResp {
+	return &GetFeedbackListResp{}
+}
+
+func (p *GetFeedbackListResp) InitDefault() {
+}
+
+var GetFeedbackListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *GetFeedbackListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetFeedbackListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetFeedbackListResp) GetFeedbacks() (v []*FeedbackItem) {
+	return p.Feedbacks
+}
+
+func (p *GetFeedbackListResp) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetFeedbackListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *GetFeedbackListResp) SetFeedbacks(val []*FeedbackItem) {
+	p.Feedbacks = val
+}
+func (p *GetFeedbackListResp) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetFeedbackListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetFeedbackListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFeedbackListResp(%+v)", *p)
+}
+
+var fieldIDToName_GetFeedbackListResp = map[int16]string{
+	1: "BaseResp",
+	2: "feedbacks",
+	3: "total",
+}
+
+type UndoFeedbackReq struct {
+	UserId     int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	FeedbackId int64 `thrift:"feedbackId,2" frugal:"2,default,i64" json:"feedbackId"`
+}
+
+func NewUndoFeedbackReq() *UndoFeedbackReq {
+	return &UndoFeedbackReq{}
+}
+
+func (p *UndoFeedbackReq) InitDefault() {
+}
+
+func (p *UndoFeedbackReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UndoFeedbackReq) GetFeedbackId() (v int64) {
+	return p.FeedbackId
+}
+func (p *UndoFeedbackReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UndoFeedbackReq) SetFeedbackId(val int64) {
+	p.FeedbackId = val
+}
+
+func (p *UndoFeedbackReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UndoFeedbackReq(%+v)", *p)
+}
+
+var fieldIDToName_UndoFeedbackReq = map[int16]string{
+	1: "userId",
+	2: "feedbackId",
+}
+
+type UndoFeedbackResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewUndoFeedbackResp() *UndoFeedbackResp {
+	return &UndoFeedbackResp{}
+}
+
+func (p *UndoFeedbackResp) InitDefault() {
+}
+
+var UndoFeedbackResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UndoFeedbackResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UndoFeedbackResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UndoFeedbackResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *UndoFeedbackResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UndoFeedbackResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UndoFeedbackResp(%+v)", *p)
+}
+
+var fieldIDToName_UndoFeedbackResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type FilterHiddenVideosReq struct {
+	UserId int64           `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Videos []*common.Video `thrift:"videos,2" frugal:"2,default,list<common.Video>" json:"videos"`
+}
+
+func NewFilterHiddenVideosReq() *FilterHiddenVideosReq {
+	return &FilterHiddenVideosReq{}
+}
+
+func (p *FilterHiddenVideosReq) InitDefault() {
+}
+
+func (p *FilterHiddenVideosReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FilterHiddenVideosReq) GetVideos() (v []*common.Video) {
+	return p.Videos
+}
+func (p *FilterHiddenVideosReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FilterHiddenVideosReq) SetVideos(val []*common.Video) {
+	p.Videos = val
+}
+
+func (p *FilterHiddenVideosReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FilterHiddenVideosReq(%+v)", *p)
+}
+
+var fieldIDToName_FilterHiddenVideosReq = map[int16]string{
+	1: "userId",
+	2: "videos",
+}
+
+type FilterHiddenVideosResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	VideoIds []int64          `thrift:"videoIds,2" frugal:"2,default,list<i64>" json:"videoIds"`
+}
+
+func NewFilterHiddenVideosResp() *FilterHiddenVideosResp {
+	return &FilterHiddenVideosResp{}
+}
+
+func (p *FilterHiddenVideosResp) InitDefault() {
+}
+
+var FilterHiddenVideosResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *FilterHiddenVideosResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return FilterHiddenVideosResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *FilterHiddenVideosResp) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *FilterHiddenVideosResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *FilterHiddenVideosResp) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+func (p *FilterHiddenVideosResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *FilterHiddenVideosResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FilterHiddenVideosResp(%+v)", *p)
+}
+
+var fieldIDToName_FilterHiddenVideosResp = map[int16]string{
+	1: "BaseResp",
+	2: "videoIds",
+}
+
 type RecommendService interface {
 	GetRecommendVideos(ctx context.Context, req *GetRecommendVideosReq) (r *GetRecommendVideosResp, err error)
 
@@ -685,6 +1180,14 @@ type RecommendService interface {
 	GetTagVideos(ctx context.Context, req *GetTagVideosReq) (r *GetTagVideosResp, err error)
 
 	GetPersonalizedFeed(ctx context.Context, req *GetPersonalizedFeedReq) (r *GetPersonalizedFeedResp, err error)
+
+	SubmitFeedback(ctx context.Context, req *SubmitFeedbackReq) (r *SubmitFeedbackResp, err error)
+
+	GetFeedbackList(ctx context.Context, req *GetFeedbackListReq) (r *GetFeedbackListResp, err error)
+
+	UndoFeedback(ctx context.Context, req *UndoFeedbackReq) (r *UndoFeedbackResp, err error)
+
+	FilterHiddenVideos(ctx context.Context, req *FilterHiddenVideosReq) (r *FilterHiddenVideosResp, err error)
 }
 
 type RecommendServiceGetRecommendVideosArgs struct {
@@ -1142,3 +1645,307 @@ func (p *RecommendServiceGetPersonalizedFeedResult) String() string {
 var fieldIDToName_RecommendServiceGetPersonalizedFeedResult = map[int16]string{
 	0: "success",
 }
+
+type RecommendServiceSubmitFeedbackArgs struct {
+	Req *SubmitFeedbackReq `thrift:"req,1" frugal:"1,default,SubmitFeedbackReq" json:"req"`
+}
+
+func NewRecommendServiceSubmitFeedbackArgs() *RecommendServiceSubmitFeedbackArgs {
+	return &RecommendServiceSubmitFeedbackArgs{}
+}
+
+func (p *RecommendServiceSubmitFeedbackArgs) InitDefault() {
+}
+
+var RecommendServiceSubmitFeedbackArgs_Req_DEFAULT *SubmitFeedbackReq
+
+func (p *RecommendServiceSubmitFeedbackArgs) GetReq() (v *SubmitFeedbackReq) {
+	if !p.IsSetReq() {
+		return RecommendServiceSubmitFeedbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RecommendServiceSubmitFeedbackArgs) SetReq(val *SubmitFeedbackReq) {
+	p.Req = val
+}
+
+func (p *RecommendServiceSubmitFeedbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RecommendServiceSubmitFeedbackArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceSubmitFeedbackArgs(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceSubmitFeedbackArgs = map[int16]string{
+	1: "req",
+}
+
+type RecommendServiceSubmitFeedbackResult struct {
+	Success *SubmitFeedbackResp `thrift:"success,0,optional" frugal:"0,optional,SubmitFeedbackResp" json:"success,omitempty"`
+}
+
+func NewRecommendServiceSubmitFeedbackResult() *RecommendServiceSubmitFeedbackResult {
+	return &RecommendServiceSubmitFeedbackResult{}
+}
+
+func (p *RecommendServiceSubmitFeedbackResult) InitDefault() {
+}
+
+var RecommendServiceSubmitFeedbackResult_Success_DEFAULT *SubmitFeedbackResp
+
+func (p *RecommendServiceSubmitFeedbackResult) GetSuccess() (v *SubmitFeedbackResp) {
+	if !p.IsSetSuccess() {
+		return RecommendServiceSubmitFeedbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RecommendServiceSubmitFeedbackResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitFeedbackResp)
+}
+
+func (p *RecommendServiceSubmitFeedbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RecommendServiceSubmitFeedbackResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceSubmitFeedbackResult(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceSubmitFeedbackResult = map[int16]string{
+	0: "success",
+}
+
+type RecommendServiceGetFeedbackListArgs struct {
+	Req *GetFeedbackListReq `thrift:"req,1" frugal:"1,default,GetFeedbackListReq" json:"req"`
+}
+
+func NewRecommendServiceGetFeedbackListArgs() *RecommendServiceGetFeedbackListArgs {
+	return &RecommendServiceGetFeedbackListArgs{}
+}
+
+func (p *RecommendServiceGetFeedbackListArgs) InitDefault() {
+}
+
+var RecommendServiceGetFeedbackListArgs_Req_DEFAULT *GetFeedbackListReq
+
+func (p *RecommendServiceGetFeedbackListArgs) GetReq() (v *GetFeedbackListReq) {
+	if !p.IsSetReq() {
+		return RecommendServiceGetFeedbackListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RecommendServiceGetFeedbackListArgs) SetReq(val *GetFeedbackListReq) {
+	p.Req = val
+}
+
+func (p *RecommendServiceGetFeedbackListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RecommendServiceGetFeedbackListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceGetFeedbackListArgs(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceGetFeedbackListArgs = map[int16]string{
+	1: "req",
+}
+
+type RecommendServiceGetFeedbackListResult struct {
+	Success *GetFeedbackListResp `thrift:"success,0,optional" frugal:"0,optional,GetFeedbackListResp" json:"success,omitempty"`
+}
+
+func NewRecommendServiceGetFeedbackListResult() *RecommendServiceGetFeedbackListResult {
+	return &RecommendServiceGetFeedbackListResult{}
+}
+
+func (p *RecommendServiceGetFeedbackListResult) InitDefault() {
+}
+
+var RecommendServiceGetFeedbackListResult_Success_DEFAULT *GetFeedbackListResp
+
+func (p *RecommendServiceGetFeedbackListResult) GetSuccess() (v *GetFeedbackListResp) {
+	if !p.IsSetSuccess() {
+		return RecommendServiceGetFeedbackListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RecommendServiceGetFeedbackListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFeedbackListResp)
+}
+
+func (p *RecommendServiceGetFeedbackListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RecommendServiceGetFeedbackListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceGetFeedbackListResult(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceGetFeedbackListResult = map[int16]string{
+	0: "success",
+}
+
+type RecommendServiceUndoFeedbackArgs struct {
+	Req *UndoFeedbackReq `thrift:"req,1" frugal:"1,default,UndoFeedbackReq" json:"req"`
+}
+
+func NewRecommendServiceUndoFeedbackArgs() *RecommendServiceUndoFeedbackArgs {
+	return &RecommendServiceUndoFeedbackArgs{}
+}
+
+func (p *RecommendServiceUndoFeedbackArgs) InitDefault() {
+}
+
+var RecommendServiceUndoFeedbackArgs_Req_DEFAULT *UndoFeedbackReq
+
+func (p *RecommendServiceUndoFeedbackArgs) GetReq() (v *UndoFeedbackReq) {
+	if !p.IsSetReq() {
+		return RecommendServiceUndoFeedbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RecommendServiceUndoFeedbackArgs) SetReq(val *UndoFeedbackReq) {
+	p.Req = val
+}
+
+func (p *RecommendServiceUndoFeedbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RecommendServiceUndoFeedbackArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceUndoFeedbackArgs(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceUndoFeedbackArgs = map[int16]string{
+	1: "req",
+}
+
+type RecommendServiceUndoFeedbackResult struct {
+	Success *UndoFeedbackResp `thrift:"success,0,optional" frugal:"0,optional,UndoFeedbackResp" json:"success,omitempty"`
+}
+
+func NewRecommendServiceUndoFeedbackResult() *RecommendServiceUndoFeedbackResult {
+	return &RecommendServiceUndoFeedbackResult{}
+}
+
+func (p *RecommendServiceUndoFeedbackResult) InitDefault() {
+}
+
+var RecommendServiceUndoFeedbackResult_Success_DEFAULT *UndoFeedbackResp
+
+func (p *RecommendServiceUndoFeedbackResult) GetSuccess() (v *UndoFeedbackResp) {
+	if !p.IsSetSuccess() {
+		return RecommendServiceUndoFeedbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RecommendServiceUndoFeedbackResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UndoFeedbackResp)
+}
+
+func (p *RecommendServiceUndoFeedbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RecommendServiceUndoFeedbackResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceUndoFeedbackResult(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceUndoFeedbackResult = map[int16]string{
+	0: "success",
+}
+
+type RecommendServiceFilterHiddenVideosArgs struct {
+	Req *FilterHiddenVideosReq `thrift:"req,1" frugal:"1,default,FilterHiddenVideosReq" json:"req"`
+}
+
+func NewRecommendServiceFilterHiddenVideosArgs() *RecommendServiceFilterHiddenVideosArgs {
+	return &RecommendServiceFilterHiddenVideosArgs{}
+}
+
+func (p *RecommendServiceFilterHiddenVideosArgs) InitDefault() {
+}
+
+var RecommendServiceFilterHiddenVideosArgs_Req_DEFAULT *FilterHiddenVideosReq
+
+func (p *RecommendServiceFilterHiddenVideosArgs) GetReq() (v *FilterHiddenVideosReq) {
+	if !p.IsSetReq() {
+		return RecommendServiceFilterHiddenVideosArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *RecommendServiceFilterHiddenVideosArgs) SetReq(val *FilterHiddenVideosReq) {
+	p.Req = val
+}
+
+func (p *RecommendServiceFilterHiddenVideosArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RecommendServiceFilterHiddenVideosArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceFilterHiddenVideosArgs(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceFilterHiddenVideosArgs = map[int16]string{
+	1: "req",
+}
+
+type RecommendServiceFilterHiddenVideosResult struct {
+	Success *FilterHiddenVideosResp `thrift:"success,0,optional" frugal:"0,optional,FilterHiddenVideosResp" json:"success,omitempty"`
+}
+
+func NewRecommendServiceFilterHiddenVideosResult() *RecommendServiceFilterHiddenVideosResult {
+	return &RecommendServiceFilterHiddenVideosResult{}
+}
+
+func (p *RecommendServiceFilterHiddenVideosResult) InitDefault() {
+}
+
+var RecommendServiceFilterHiddenVideosResult_Success_DEFAULT *FilterHiddenVideosResp
+
+func (p *RecommendServiceFilterHiddenVideosResult) GetSuccess() (v *FilterHiddenVideosResp) {
+	if !p.IsSetSuccess() {
+		return RecommendServiceFilterHiddenVideosResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *RecommendServiceFilterHiddenVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FilterHiddenVideosResp)
+}
+
+func (p *RecommendServiceFilterHiddenVideosResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RecommendServiceFilterHiddenVideosResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendServiceFilterHiddenVideosResult(%+v)", *p)
+}
+
+var fieldIDToName_RecommendServiceFilterHiddenVideosResult = map[int16]string{
+	0: "success",
+}
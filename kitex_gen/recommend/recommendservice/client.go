@@ -17,6 +17,10 @@ type Client interface {
 	GetHotTags(ctx context.Context, req *recommend.GetHotTagsReq, callOptions ...callopt.Option) (r *recommend.GetHotTagsResp, err error)
 	GetTagVideos(ctx context.Context, req *recommend.GetTagVideosReq, callOptions ...callopt.Option) (r *recommend.GetTagVideosResp, err error)
 	GetPersonalizedFeed(ctx context.Context, req *recommend.GetPersonalizedFeedReq, callOptions ...callopt.Option) (r *recommend.GetPersonalizedFeedResp, err error)
+	SubmitFeedback(ctx context.Context, req *recommend.SubmitFeedbackReq, callOptions ...callopt.Option) (r *recommend.SubmitFeedbackResp, err error)
+	GetFeedbackList(ctx context.Context, req *recommend.GetFeedbackListReq, callOptions ...callopt.Option) (r *recommend.GetFeedbackListResp, err error)
+	UndoFeedback(ctx context.Context, req *recommend.UndoFeedbackReq, callOptions ...callopt.Option) (r *recommend.UndoFeedbackResp, err error)
+	FilterHiddenVideos(ctx context.Context, req *recommend.FilterHiddenVideosReq, callOptions ...callopt.Option) (r *recommend.FilterHiddenVideosResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -77,3 +81,23 @@ func (p *kRecommendServiceClient) GetPersonalizedFeed(ctx context.Context, req *
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetPersonalizedFeed(ctx, req)
 }
+
+func (p *kRecommendServiceClient) SubmitFeedback(ctx context.Context, req *recommend.SubmitFeedbackReq, callOptions ...callopt.Option) (r *recommend.SubmitFeedbackResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitFeedback(ctx, req)
+}
+
+func (p *kRecommendServiceClient) GetFeedbackList(ctx context.Context, req *recommend.GetFeedbackListReq, callOptions ...callopt.Option) (r *recommend.GetFeedbackListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFeedbackList(ctx, req)
+}
+
+func (p *kRecommendServiceClient) UndoFeedback(ctx context.Context, req *recommend.UndoFeedbackReq, callOptions ...callopt.Option) (r *recommend.UndoFeedbackResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UndoFeedback(ctx, req)
+}
+
+func (p *kRecommendServiceClient) FilterHiddenVideos(ctx context.Context, req *recommend.FilterHiddenVideosReq, callOptions ...callopt.Option) (r *recommend.FilterHiddenVideosResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FilterHiddenVideos(ctx, req)
+}
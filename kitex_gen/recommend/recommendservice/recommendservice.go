@@ -55,6 +55,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"SubmitFeedback": kitex.NewMethodInfo(
+		submitFeedbackHandler,
+		newRecommendServiceSubmitFeedbackArgs,
+		newRecommendServiceSubmitFeedbackResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFeedbackList": kitex.NewMethodInfo(
+		getFeedbackListHandler,
+		newRecommendServiceGetFeedbackListArgs,
+		newRecommendServiceGetFeedbackListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UndoFeedback": kitex.NewMethodInfo(
+		undoFeedbackHandler,
+		newRecommendServiceUndoFeedbackArgs,
+		newRecommendServiceUndoFeedbackResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"FilterHiddenVideos": kitex.NewMethodInfo(
+		filterHiddenVideosHandler,
+		newRecommendServiceFilterHiddenVideosArgs,
+		newRecommendServiceFilterHiddenVideosResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -229,6 +257,78 @@ func newRecommendServiceGetPersonalizedFeedResult() interface{} {
 	return recommend.NewRecommendServiceGetPersonalizedFeedResult()
 }
 
+func submitFeedbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*recommend.RecommendServiceSubmitFeedbackArgs)
+	realResult := result.(*recommend.RecommendServiceSubmitFeedbackResult)
+	success, err := handler.(recommend.RecommendService).SubmitFeedback(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRecommendServiceSubmitFeedbackArgs() interface{} {
+	return recommend.NewRecommendServiceSubmitFeedbackArgs()
+}
+
+func newRecommendServiceSubmitFeedbackResult() interface{} {
+	return recommend.NewRecommendServiceSubmitFeedbackResult()
+}
+
+func getFeedbackListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*recommend.RecommendServiceGetFeedbackListArgs)
+	realResult := result.(*recommend.RecommendServiceGetFeedbackListResult)
+	success, err := handler.(recommend.RecommendService).GetFeedbackList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRecommendServiceGetFeedbackListArgs() interface{} {
+	return recommend.NewRecommendServiceGetFeedbackListArgs()
+}
+
+func newRecommendServiceGetFeedbackListResult() interface{} {
+	return recommend.NewRecommendServiceGetFeedbackListResult()
+}
+
+func undoFeedbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*recommend.RecommendServiceUndoFeedbackArgs)
+	realResult := result.(*recommend.RecommendServiceUndoFeedbackResult)
+	success, err := handler.(recommend.RecommendService).UndoFeedback(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRecommendServiceUndoFeedbackArgs() interface{} {
+	return recommend.NewRecommendServiceUndoFeedbackArgs()
+}
+
+func newRecommendServiceUndoFeedbackResult() interface{} {
+	return recommend.NewRecommendServiceUndoFeedbackResult()
+}
+
+func filterHiddenVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*recommend.RecommendServiceFilterHiddenVideosArgs)
+	realResult := result.(*recommend.RecommendServiceFilterHiddenVideosResult)
+	success, err := handler.(recommend.RecommendService).FilterHiddenVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newRecommendServiceFilterHiddenVideosArgs() interface{} {
+	return recommend.NewRecommendServiceFilterHiddenVideosArgs()
+}
+
+func newRecommendServiceFilterHiddenVideosResult() interface{} {
+	return recommend.NewRecommendServiceFilterHiddenVideosResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -298,3 +398,43 @@ func (p *kClient) GetPersonalizedFeed(ctx context.Context, req *recommend.GetPer
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SubmitFeedback(ctx context.Context, req *recommend.SubmitFeedbackReq) (r *recommend.SubmitFeedbackResp, err error) {
+	var _args recommend.RecommendServiceSubmitFeedbackArgs
+	_args.Req = req
+	var _result recommend.RecommendServiceSubmitFeedbackResult
+	if err = p.c.Call(ctx, "SubmitFeedback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFeedbackList(ctx context.Context, req *recommend.GetFeedbackListReq) (r *recommend.GetFeedbackListResp, err error) {
+	var _args recommend.RecommendServiceGetFeedbackListArgs
+	_args.Req = req
+	var _result recommend.RecommendServiceGetFeedbackListResult
+	if err = p.c.Call(ctx, "GetFeedbackList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UndoFeedback(ctx context.Context, req *recommend.UndoFeedbackReq) (r *recommend.UndoFeedbackResp, err error) {
+	var _args recommend.RecommendServiceUndoFeedbackArgs
+	_args.Req = req
+	var _result recommend.RecommendServiceUndoFeedbackResult
+	if err = p.c.Call(ctx, "UndoFeedback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) FilterHiddenVideos(ctx context.Context, req *recommend.FilterHiddenVideosReq) (r *recommend.FilterHiddenVideosResp, err error) {
+	var _args recommend.RecommendServiceFilterHiddenVideosArgs
+	_args.Req = req
+	var _result recommend.RecommendServiceFilterHiddenVideosResult
+	if err = p.c.Call(ctx, "FilterHiddenVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -56,7 +56,8 @@ var fieldIDToName_FollowActionReq = map[int16]string{
 }
 
 type FollowActionResp struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	IsPending bool             `thrift:"isPending,2" frugal:"2,default,bool" json:"isPending"`
 }
 
 func NewFollowActionResp() *FollowActionResp {
@@ -74,9 +75,16 @@ func (p *FollowActionResp) GetBaseResp() (v *common.BaseResp) {
 	}
 	return p.BaseResp
 }
+
+func (p *FollowActionResp) GetIsPending() (v bool) {
+	return p.IsPending
+}
 func (p *FollowActionResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
+func (p *FollowActionResp) SetIsPending(val bool) {
+	p.IsPending = val
+}
 
 func (p *FollowActionResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
@@ -91,13 +99,16 @@ func (p *FollowActionResp) String() string {
 
 var fieldIDToName_FollowActionResp = map[int16]string{
 	1: "BaseResp",
+	2: "isPending",
 }
 
 type FollowListReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewFollowListReq() *FollowListReq {
@@ -122,6 +133,24 @@ func (p *FollowListReq) GetPage() (v int32) {
 func (p *FollowListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var FollowListReq_Cursor_DEFAULT string
+
+func (p *FollowListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FollowListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FollowListReq_NeedTotal_DEFAULT bool
+
+func (p *FollowListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return FollowListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *FollowListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -134,6 +163,20 @@ func (p *FollowListReq) SetPage(val int32) {
 func (p *FollowListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *FollowListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *FollowListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *FollowListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FollowListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *FollowListReq) String() string {
 	if p == nil {
@@ -147,12 +190,16 @@ var fieldIDToName_FollowListReq = map[int16]string{
 	2: "currentUserId",
 	3: "page",
 	4: "pageSize",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type FollowListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewFollowListResp() *FollowListResp {
@@ -178,6 +225,19 @@ func (p *FollowListResp) GetUsers() (v []*common.User) {
 func (p *FollowListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var FollowListResp_NextCursor_DEFAULT string
+
+func (p *FollowListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FollowListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FollowListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *FollowListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -187,11 +247,21 @@ func (p *FollowListResp) SetUsers(val []*common.User) {
 func (p *FollowListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *FollowListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *FollowListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *FollowListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *FollowListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *FollowListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -203,13 +273,17 @@ var fieldIDToName_FollowListResp = map[int16]string{
 	1: "BaseResp",
 	2: "users",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type FollowerListReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewFollowerListReq() *FollowerListReq {
@@ -234,6 +308,24 @@ func (p *FollowerListReq) GetPage() (v int32) {
 func (p *FollowerListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var FollowerListReq_Cursor_DEFAULT string
+
+func (p *FollowerListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FollowerListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FollowerListReq_NeedTotal_DEFAULT bool
+
+func (p *FollowerListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return FollowerListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *FollowerListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -246,6 +338,20 @@ func (p *FollowerListReq) SetPage(val int32) {
 func (p *FollowerListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *FollowerListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *FollowerListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *FollowerListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FollowerListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *FollowerListReq) String() string {
 	if p == nil {
@@ -259,12 +365,16 @@ var fieldIDToName_FollowerListReq = map[int16]string{
 	2: "currentUserId",
 	3: "page",
 	4: "pageSize",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type FollowerListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewFollowerListResp() *FollowerListResp {
@@ -290,6 +400,19 @@ func (p *FollowerListResp) GetUsers() (v []*common.User) {
 func (p *FollowerListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var FollowerListResp_NextCursor_DEFAULT string
+
+func (p *FollowerListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FollowerListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FollowerListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *FollowerListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -299,11 +422,21 @@ func (p *FollowerListResp) SetUsers(val []*common.User) {
 func (p *FollowerListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *FollowerListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *FollowerListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *FollowerListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *FollowerListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *FollowerListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -315,12 +448,16 @@ var fieldIDToName_FollowerListResp = map[int16]string{
 	1: "BaseResp",
 	2: "users",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type FriendListReq struct {
-	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Page     int32 `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize int32 `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	UserId    int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Page      int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Cursor    *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+	NeedTotal *bool   `thrift:"needTotal,5,optional" frugal:"5,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewFriendListReq() *FriendListReq {
@@ -341,6 +478,24 @@ func (p *FriendListReq) GetPage() (v int32) {
 func (p *FriendListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var FriendListReq_Cursor_DEFAULT string
+
+func (p *FriendListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FriendListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FriendListReq_NeedTotal_DEFAULT bool
+
+func (p *FriendListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return FriendListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *FriendListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -350,6 +505,20 @@ func (p *FriendListReq) SetPage(val int32) {
 func (p *FriendListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *FriendListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *FriendListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *FriendListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FriendListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *FriendListReq) String() string {
 	if p == nil {
@@ -362,12 +531,16 @@ var fieldIDToName_FriendListReq = map[int16]string{
 	1: "userId",
 	2: "page",
 	3: "pageSize",
+	4: "cursor",
+	5: "needTotal",
 }
 
 type FriendListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewFriendListResp() *FriendListResp {
@@ -393,6 +566,19 @@ func (p *FriendListResp) GetUsers() (v []*common.User) {
 func (p *FriendListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var FriendListResp_NextCursor_DEFAULT string
+
+func (p *FriendListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FriendListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FriendListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *FriendListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -402,11 +588,21 @@ func (p *FriendListResp) SetUsers(val []*common.User) {
 func (p *FriendListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *FriendListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *FriendListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *FriendListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *FriendListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *FriendListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -418,6 +614,8 @@ var fieldIDToName_FriendListResp = map[int16]string{
 	1: "BaseResp",
 	2: "users",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type CheckFollowReq struct {
@@ -816,628 +1014,3619 @@ var fieldIDToName_BatchCheckFollowResp = map[int16]string{
 	2: "followStatus",
 }
 
-type SocialService interface {
-	FollowAction(ctx context.Context, req *FollowActionReq) (r *FollowActionResp, err error)
-
-	GetFollowList(ctx context.Context, req *FollowListReq) (r *FollowListResp, err error)
-
-	GetFollowerList(ctx context.Context, req *FollowerListReq) (r *FollowerListResp, err error)
-
-	GetFriendList(ctx context.Context, req *FriendListReq) (r *FriendListResp, err error)
-
-	CheckFollow(ctx context.Context, req *CheckFollowReq) (r *CheckFollowResp, err error)
-
-	CheckMutualFollow(ctx context.Context, req *CheckMutualFollowReq) (r *CheckMutualFollowResp, err error)
-
-	GetFollowStats(ctx context.Context, req *FollowStatsReq) (r *FollowStatsResp, err error)
-
-	BatchCheckFollow(ctx context.Context, req *BatchCheckFollowReq) (r *BatchCheckFollowResp, err error)
+type BlockActionReq struct {
+	UserId       int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TargetUserId int64 `thrift:"targetUserId,2" frugal:"2,default,i64" json:"targetUserId"`
+	Action       bool  `thrift:"action,3" frugal:"3,default,bool" json:"action"`
 }
 
-type SocialServiceFollowActionArgs struct {
-	Req *FollowActionReq `thrift:"req,1" frugal:"1,default,FollowActionReq" json:"req"`
+func NewBlockActionReq() *BlockActionReq {
+	return &BlockActionReq{}
 }
 
-func NewSocialServiceFollowActionArgs() *SocialServiceFollowActionArgs {
-	return &SocialServiceFollowActionArgs{}
+func (p *BlockActionReq) InitDefault() {
 }
 
-func (p *SocialServiceFollowActionArgs) InitDefault() {
+func (p *BlockActionReq) GetUserId() (v int64) {
+	return p.UserId
 }
 
-var SocialServiceFollowActionArgs_Req_DEFAULT *FollowActionReq
+func (p *BlockActionReq) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
 
-func (p *SocialServiceFollowActionArgs) GetReq() (v *FollowActionReq) {
-	if !p.IsSetReq() {
-		return SocialServiceFollowActionArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *BlockActionReq) GetAction() (v bool) {
+	return p.Action
 }
-func (p *SocialServiceFollowActionArgs) SetReq(val *FollowActionReq) {
-	p.Req = val
+func (p *BlockActionReq) SetUserId(val int64) {
+	p.UserId = val
 }
-
-func (p *SocialServiceFollowActionArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *BlockActionReq) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *BlockActionReq) SetAction(val bool) {
+	p.Action = val
 }
 
-func (p *SocialServiceFollowActionArgs) String() string {
+func (p *BlockActionReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceFollowActionArgs(%+v)", *p)
+	return fmt.Sprintf("BlockActionReq(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceFollowActionArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_BlockActionReq = map[int16]string{
+	1: "userId",
+	2: "targetUserId",
+	3: "action",
 }
 
-type SocialServiceFollowActionResult struct {
-	Success *FollowActionResp `thrift:"success,0,optional" frugal:"0,optional,FollowActionResp" json:"success,omitempty"`
+type BlockActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 }
 
-func NewSocialServiceFollowActionResult() *SocialServiceFollowActionResult {
-	return &SocialServiceFollowActionResult{}
+func NewBlockActionResp() *BlockActionResp {
+	return &BlockActionResp{}
 }
 
-func (p *SocialServiceFollowActionResult) InitDefault() {
+func (p *BlockActionResp) InitDefault() {
 }
 
-var SocialServiceFollowActionResult_Success_DEFAULT *FollowActionResp
+var BlockActionResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *SocialServiceFollowActionResult) GetSuccess() (v *FollowActionResp) {
-	if !p.IsSetSuccess() {
-		return SocialServiceFollowActionResult_Success_DEFAULT
+func (p *BlockActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BlockActionResp_BaseResp_DEFAULT
 	}
-	return p.Success
+	return p.BaseResp
 }
-func (p *SocialServiceFollowActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowActionResp)
+func (p *BlockActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *SocialServiceFollowActionResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *BlockActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *SocialServiceFollowActionResult) String() string {
+func (p *BlockActionResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceFollowActionResult(%+v)", *p)
+	return fmt.Sprintf("BlockActionResp(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceFollowActionResult = map[int16]string{
-	0: "success",
+var fieldIDToName_BlockActionResp = map[int16]string{
+	1: "BaseResp",
 }
 
-type SocialServiceGetFollowListArgs struct {
-	Req *FollowListReq `thrift:"req,1" frugal:"1,default,FollowListReq" json:"req"`
+type MuteActionReq struct {
+	UserId       int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TargetUserId int64 `thrift:"targetUserId,2" frugal:"2,default,i64" json:"targetUserId"`
+	Action       bool  `thrift:"action,3" frugal:"3,default,bool" json:"action"`
 }
 
-func NewSocialServiceGetFollowListArgs() *SocialServiceGetFollowListArgs {
-	return &SocialServiceGetFollowListArgs{}
+func NewMuteActionReq() *MuteActionReq {
+	return &MuteActionReq{}
 }
 
-func (p *SocialServiceGetFollowListArgs) InitDefault() {
+func (p *MuteActionReq) InitDefault() {
 }
 
-var SocialServiceGetFollowListArgs_Req_DEFAULT *FollowListReq
-
-func (p *SocialServiceGetFollowListArgs) GetReq() (v *FollowListReq) {
-	if !p.IsSetReq() {
-		return SocialServiceGetFollowListArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *MuteActionReq) GetUserId() (v int64) {
+	return p.UserId
 }
-func (p *SocialServiceGetFollowListArgs) SetReq(val *FollowListReq) {
-	p.Req = val
+
+func (p *MuteActionReq) GetTargetUserId() (v int64) {
+	return p.TargetUserId
 }
 
-func (p *SocialServiceGetFollowListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *MuteActionReq) GetAction() (v bool) {
+	return p.Action
+}
+func (p *MuteActionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *MuteActionReq) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *MuteActionReq) SetAction(val bool) {
+	p.Action = val
 }
 
-func (p *SocialServiceGetFollowListArgs) String() string {
+func (p *MuteActionReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowListArgs(%+v)", *p)
+	return fmt.Sprintf("MuteActionReq(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowListArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_MuteActionReq = map[int16]string{
+	1: "userId",
+	2: "targetUserId",
+	3: "action",
 }
 
-type SocialServiceGetFollowListResult struct {
-	Success *FollowListResp `thrift:"success,0,optional" frugal:"0,optional,FollowListResp" json:"success,omitempty"`
+type MuteActionResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 }
 
-func NewSocialServiceGetFollowListResult() *SocialServiceGetFollowListResult {
-	return &SocialServiceGetFollowListResult{}
+func NewMuteActionResp() *MuteActionResp {
+	return &MuteActionResp{}
 }
 
-func (p *SocialServiceGetFollowListResult) InitDefault() {
+func (p *MuteActionResp) InitDefault() {
 }
 
-var SocialServiceGetFollowListResult_Success_DEFAULT *FollowListResp
+var MuteActionResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *SocialServiceGetFollowListResult) GetSuccess() (v *FollowListResp) {
-	if !p.IsSetSuccess() {
-		return SocialServiceGetFollowListResult_Success_DEFAULT
+func (p *MuteActionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return MuteActionResp_BaseResp_DEFAULT
 	}
-	return p.Success
+	return p.BaseResp
 }
-func (p *SocialServiceGetFollowListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowListResp)
+func (p *MuteActionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *SocialServiceGetFollowListResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *MuteActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *SocialServiceGetFollowListResult) String() string {
+func (p *MuteActionResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowListResult(%+v)", *p)
+	return fmt.Sprintf("MuteActionResp(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowListResult = map[int16]string{
-	0: "success",
+var fieldIDToName_MuteActionResp = map[int16]string{
+	1: "BaseResp",
 }
 
-type SocialServiceGetFollowerListArgs struct {
-	Req *FollowerListReq `thrift:"req,1" frugal:"1,default,FollowerListReq" json:"req"`
+type BlockListReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	BlockType int8  `thrift:"blockType,2" frugal:"2,default,i8" json:"blockType"`
+	Page      int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize  int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
 }
 
-func NewSocialServiceGetFollowerListArgs() *SocialServiceGetFollowerListArgs {
-	return &SocialServiceGetFollowerListArgs{}
+func NewBlockListReq() *BlockListReq {
+	return &BlockListReq{}
 }
 
-func (p *SocialServiceGetFollowerListArgs) InitDefault() {
+func (p *BlockListReq) InitDefault() {
 }
 
-var SocialServiceGetFollowerListArgs_Req_DEFAULT *FollowerListReq
+func (p *BlockListReq) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *SocialServiceGetFollowerListArgs) GetReq() (v *FollowerListReq) {
-	if !p.IsSetReq() {
-		return SocialServiceGetFollowerListArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *BlockListReq) GetBlockType() (v int8) {
+	return p.BlockType
 }
-func (p *SocialServiceGetFollowerListArgs) SetReq(val *FollowerListReq) {
-	p.Req = val
+
+func (p *BlockListReq) GetPage() (v int32) {
+	return p.Page
 }
 
-func (p *SocialServiceGetFollowerListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *BlockListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *BlockListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BlockListReq) SetBlockType(val int8) {
+	p.BlockType = val
+}
+func (p *BlockListReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *BlockListReq) SetPageSize(val int32) {
+	p.PageSize = val
 }
 
-func (p *SocialServiceGetFollowerListArgs) String() string {
+func (p *BlockListReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowerListArgs(%+v)", *p)
+	return fmt.Sprintf("BlockListReq(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowerListArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_BlockListReq = map[int16]string{
+	1: "userId",
+	2: "blockType",
+	3: "page",
+	4: "pageSize",
 }
 
-type SocialServiceGetFollowerListResult struct {
-	Success *FollowerListResp `thrift:"success,0,optional" frugal:"0,optional,FollowerListResp" json:"success,omitempty"`
+type BlockListResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
+	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
 }
 
-func NewSocialServiceGetFollowerListResult() *SocialServiceGetFollowerListResult {
-	return &SocialServiceGetFollowerListResult{}
+func NewBlockListResp() *BlockListResp {
+	return &BlockListResp{}
+}
+
+func (p *BlockListResp) InitDefault() {
+}
+
+var BlockListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *BlockListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BlockListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BlockListResp) GetUsers() (v []*common.User) {
+	return p.Users
+}
+
+func (p *BlockListResp) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+func (p *BlockListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *BlockListResp) SetUsers(val []*common.User) {
+	p.Users = val
+}
+func (p *BlockListResp) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+
+func (p *BlockListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BlockListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockListResp(%+v)", *p)
+}
+
+var fieldIDToName_BlockListResp = map[int16]string{
+	1: "BaseResp",
+	2: "users",
+	3: "totalCount",
+}
+
+type CheckBlockReq struct {
+	UserId       int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TargetUserId int64 `thrift:"targetUserId,2" frugal:"2,default,i64" json:"targetUserId"`
+}
+
+func NewCheckBlockReq() *CheckBlockReq {
+	return &CheckBlockReq{}
+}
+
+func (p *CheckBlockReq) InitDefault() {
+}
+
+func (p *CheckBlockReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CheckBlockReq) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+func (p *CheckBlockReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CheckBlockReq) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+
+func (p *CheckBlockReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckBlockReq(%+v)", *p)
+}
+
+var fieldIDToName_CheckBlockReq = map[int16]string{
+	1: "userId",
+	2: "targetUserId",
+}
+
+type CheckBlockResp struct {
+	BaseResp    *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	IsBlocked   bool             `thrift:"isBlocked,2" frugal:"2,default,bool" json:"isBlocked"`
+	IsBlockedBy bool             `thrift:"isBlockedBy,3" frugal:"3,default,bool" json:"isBlockedBy"`
+	IsMuted     bool             `thrift:"isMuted,4" frugal:"4,default,bool" json:"isMuted"`
+}
+
+func NewCheckBlockResp() *CheckBlockResp {
+	return &CheckBlockResp{}
+}
+
+func (p *CheckBlockResp) InitDefault() {
+}
+
+var CheckBlockResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CheckBlockResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CheckBlockResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CheckBlockResp) GetIsBlocked() (v bool) {
+	return p.IsBlocked
+}
+
+func (p *CheckBlockResp) GetIsBlockedBy() (v bool) {
+	return p.IsBlockedBy
+}
+
+func (p *CheckBlockResp) GetIsMuted() (v bool) {
+	return p.IsMuted
+}
+func (p *CheckBlockResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CheckBlockResp) SetIsBlocked(val bool) {
+	p.IsBlocked = val
+}
+func (p *CheckBlockResp) SetIsBlockedBy(val bool) {
+	p.IsBlockedBy = val
+}
+func (p *CheckBlockResp) SetIsMuted(val bool) {
+	p.IsMuted = val
+}
+
+func (p *CheckBlockResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CheckBlockResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckBlockResp(%+v)", *p)
+}
+
+var fieldIDToName_CheckBlockResp = map[int16]string{
+	1: "BaseResp",
+	2: "isBlocked",
+	3: "isBlockedBy",
+	4: "isMuted",
+}
+
+type FollowRequestItem struct {
+	Id           int64        `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId       int64        `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	TargetUserId int64        `thrift:"targetUserId,3" frugal:"3,default,i64" json:"targetUserId"`
+	CreateTime   string       `thrift:"createTime,4" frugal:"4,default,string" json:"createTime"`
+	User         *common.User `thrift:"user,5,optional" frugal:"5,optional,common.User" json:"user,omitempty"`
+}
+
+func NewFollowRequestItem() *FollowRequestItem {
+	return &FollowRequestItem{}
+}
+
+func (p *FollowRequestItem) InitDefault() {
+}
+
+func (p *FollowRequestItem) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *FollowRequestItem) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FollowRequestItem) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+
+func (p *FollowRequestItem) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+
+var FollowRequestItem_User_DEFAULT *common.User
+
+func (p *FollowRequestItem) GetUser() (v *common.User) {
+	if !p.IsSetUser() {
+		return FollowRequestItem_User_DEFAULT
+	}
+	return p.User
+}
+func (p *FollowRequestItem) SetId(val int64) {
+	p.Id = val
+}
+func (p *FollowRequestItem) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FollowRequestItem) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *FollowRequestItem) SetCreateTime(val string) {
+	p.CreateTime = val
+}
+func (p *FollowRequestItem) SetUser(val *common.User) {
+	p.User = val
+}
+
+func (p *FollowRequestItem) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *FollowRequestItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowRequestItem(%+v)", *p)
+}
+
+var fieldIDToName_FollowRequestItem = map[int16]string{
+	1: "id",
+	2: "userId",
+	3: "targetUserId",
+	4: "createTime",
+	5: "user",
+}
+
+type HandleFollowRequestReq struct {
+	UserId      int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	RequesterId int64 `thrift:"requesterId,2" frugal:"2,default,i64" json:"requesterId"`
+	Approve     bool  `thrift:"approve,3" frugal:"3,default,bool" json:"approve"`
+}
+
+func NewHandleFollowRequestReq() *HandleFollowRequestReq {
+	return &HandleFollowRequestReq{}
+}
+
+func (p *HandleFollowRequestReq) InitDefault() {
+}
+
+func (p *HandleFollowRequestReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *HandleFollowRequestReq) GetRequesterId() (v int64) {
+	return p.RequesterId
+}
+
+func (p *HandleFollowRequestReq) GetApprove() (v bool) {
+	return p.Approve
+}
+func (p *HandleFollowRequestReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *HandleFollowRequestReq) SetRequesterId(val int64) {
+	p.RequesterId = val
+}
+func (p *HandleFollowRequestReq) SetApprove(val bool) {
+	p.Approve = val
+}
+
+func (p *HandleFollowRequestReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HandleFollowRequestReq(%+v)", *p)
+}
+
+var fieldIDToName_HandleFollowRequestReq = map[int16]string{
+	1: "userId",
+	2: "requesterId",
+	3: "approve",
+}
+
+type HandleFollowRequestResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewHandleFollowRequestResp() *HandleFollowRequestResp {
+	return &HandleFollowRequestResp{}
+}
+
+func (p *HandleFollowRequestResp) InitDefault() {
+}
+
+var HandleFollowRequestResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *HandleFollowRequestResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return HandleFollowRequestResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *HandleFollowRequestResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *HandleFollowRequestResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *HandleFollowRequestResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HandleFollowRequestResp(%+v)", *p)
+}
+
+var fieldIDToName_HandleFollowRequestResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type CancelFollowRequestReq struct {
+	UserId       int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TargetUserId int64 `thrift:"targetUserId,2" frugal:"2,default,i64" json:"targetUserId"`
+}
+
+func NewCancelFollowRequestReq() *CancelFollowRequestReq {
+	return &CancelFollowRequestReq{}
+}
+
+func (p *CancelFollowRequestReq) InitDefault() {
+}
+
+func (p *CancelFollowRequestReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CancelFollowRequestReq) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+func (p *CancelFollowRequestReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CancelFollowRequestReq) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+
+func (p *CancelFollowRequestReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelFollowRequestReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelFollowRequestReq = map[int16]string{
+	1: "userId",
+	2: "targetUserId",
+}
+
+type CancelFollowRequestResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewCancelFollowRequestResp() *CancelFollowRequestResp {
+	return &CancelFollowRequestResp{}
+}
+
+func (p *CancelFollowRequestResp) InitDefault() {
+}
+
+var CancelFollowRequestResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CancelFollowRequestResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CancelFollowRequestResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CancelFollowRequestResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *CancelFollowRequestResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CancelFollowRequestResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelFollowRequestResp(%+v)", *p)
+}
+
+var fieldIDToName_CancelFollowRequestResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type FollowRequestListReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Incoming bool  `thrift:"incoming,2" frugal:"2,default,bool" json:"incoming"`
+	Page     int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewFollowRequestListReq() *FollowRequestListReq {
+	return &FollowRequestListReq{}
+}
+
+func (p *FollowRequestListReq) InitDefault() {
+}
+
+func (p *FollowRequestListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FollowRequestListReq) GetIncoming() (v bool) {
+	return p.Incoming
+}
+
+func (p *FollowRequestListReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *FollowRequestListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *FollowRequestListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FollowRequestListReq) SetIncoming(val bool) {
+	p.Incoming = val
+}
+func (p *FollowRequestListReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *FollowRequestListReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *FollowRequestListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowRequestListReq(%+v)", *p)
+}
+
+var fieldIDToName_FollowRequestListReq = map[int16]string{
+	1: "userId",
+	2: "incoming",
+	3: "page",
+	4: "pageSize",
+}
+
+type FollowRequestListResp struct {
+	BaseResp   *common.BaseResp     `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Requests   []*FollowRequestItem `thrift:"requests,2" frugal:"2,default,list<FollowRequestItem>" json:"requests"`
+	TotalCount int32                `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+}
+
+func NewFollowRequestListResp() *FollowRequestListResp {
+	return &FollowRequestListResp{}
+}
+
+func (p *FollowRequestListResp) InitDefault() {
+}
+
+var FollowRequestListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *FollowRequestListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return FollowRequestListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *FollowRequestListResp) GetRequests() (v []*FollowRequestItem) {
+	return p.Requests
+}
+
+func (p *FollowRequestListResp) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+func (p *FollowRequestListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *FollowRequestListResp) SetRequests(val []*FollowRequestItem) {
+	p.Requests = val
+}
+func (p *FollowRequestListResp) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+
+func (p *FollowRequestListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *FollowRequestListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowRequestListResp(%+v)", *p)
+}
+
+var fieldIDToName_FollowRequestListResp = map[int16]string{
+	1: "BaseResp",
+	2: "requests",
+	3: "totalCount",
+}
+
+type SetAccountPrivacyReq struct {
+	UserId    int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	IsPrivate bool  `thrift:"isPrivate,2" frugal:"2,default,bool" json:"isPrivate"`
+}
+
+func NewSetAccountPrivacyReq() *SetAccountPrivacyReq {
+	return &SetAccountPrivacyReq{}
+}
+
+func (p *SetAccountPrivacyReq) InitDefault() {
+}
+
+func (p *SetAccountPrivacyReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetAccountPrivacyReq) GetIsPrivate() (v bool) {
+	return p.IsPrivate
+}
+func (p *SetAccountPrivacyReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetAccountPrivacyReq) SetIsPrivate(val bool) {
+	p.IsPrivate = val
+}
+
+func (p *SetAccountPrivacyReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetAccountPrivacyReq(%+v)", *p)
+}
+
+var fieldIDToName_SetAccountPrivacyReq = map[int16]string{
+	1: "userId",
+	2: "isPrivate",
+}
+
+type SetAccountPrivacyResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewSetAccountPrivacyResp() *SetAccountPrivacyResp {
+	return &SetAccountPrivacyResp{}
+}
+
+func (p *SetAccountPrivacyResp) InitDefault() {
+}
+
+var SetAccountPrivacyResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SetAccountPrivacyResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SetAccountPrivacyResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SetAccountPrivacyResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SetAccountPrivacyResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SetAccountPrivacyResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetAccountPrivacyResp(%+v)", *p)
+}
+
+var fieldIDToName_SetAccountPrivacyResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type CheckContentAccessReq struct {
+	ViewerId int64 `thrift:"viewerId,1" frugal:"1,default,i64" json:"viewerId"`
+	OwnerId  int64 `thrift:"ownerId,2" frugal:"2,default,i64" json:"ownerId"`
+}
+
+func NewCheckContentAccessReq() *CheckContentAccessReq {
+	return &CheckContentAccessReq{}
+}
+
+func (p *CheckContentAccessReq) InitDefault() {
+}
+
+func (p *CheckContentAccessReq) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *CheckContentAccessReq) GetOwnerId() (v int64) {
+	return p.OwnerId
+}
+func (p *CheckContentAccessReq) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *CheckContentAccessReq) SetOwnerId(val int64) {
+	p.OwnerId = val
+}
+
+func (p *CheckContentAccessReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckContentAccessReq(%+v)", *p)
+}
+
+var fieldIDToName_CheckContentAccessReq = map[int16]string{
+	1: "viewerId",
+	2: "ownerId",
+}
+
+type CheckContentAccessResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	CanView  bool             `thrift:"canView,2" frugal:"2,default,bool" json:"canView"`
+}
+
+func NewCheckContentAccessResp() *CheckContentAccessResp {
+	return &CheckContentAccessResp{}
+}
+
+func (p *CheckContentAccessResp) InitDefault() {
+}
+
+var CheckContentAccessResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CheckContentAccessResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CheckContentAccessResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CheckContentAccessResp) GetCanView() (v bool) {
+	return p.CanView
+}
+func (p *CheckContentAccessResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CheckContentAccessResp) SetCanView(val bool) {
+	p.CanView = val
+}
+
+func (p *CheckContentAccessResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CheckContentAccessResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckContentAccessResp(%+v)", *p)
+}
+
+var fieldIDToName_CheckContentAccessResp = map[int16]string{
+	1: "BaseResp",
+	2: "canView",
+}
+
+type AudienceList struct {
+	Id             int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Name           string `thrift:"name,2" frugal:"2,default,string" json:"name"`
+	IsCloseFriends bool   `thrift:"isCloseFriends,3" frugal:"3,default,bool" json:"isCloseFriends"`
+	MemberCount    int64  `thrift:"memberCount,4" frugal:"4,default,i64" json:"memberCount"`
+	CreateTime     string `thrift:"createTime,5" frugal:"5,default,string" json:"createTime"`
+}
+
+func NewAudienceList() *AudienceList {
+	return &AudienceList{}
+}
+
+func (p *AudienceList) InitDefault() {
+}
+
+func (p *AudienceList) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *AudienceList) GetName() (v string) {
+	return p.Name
+}
+
+func (p *AudienceList) GetIsCloseFriends() (v bool) {
+	return p.IsCloseFriends
+}
+
+func (p *AudienceList) GetMemberCount() (v int64) {
+	return p.MemberCount
+}
+
+func (p *AudienceList) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+func (p *AudienceList) SetId(val int64) {
+	p.Id = val
+}
+func (p *AudienceList) SetName(val string) {
+	p.Name = val
+}
+func (p *AudienceList) SetIsCloseFriends(val bool) {
+	p.IsCloseFriends = val
+}
+func (p *AudienceList) SetMemberCount(val int64) {
+	p.MemberCount = val
+}
+func (p *AudienceList) SetCreateTime(val string) {
+	p.CreateTime = val
+}
+
+func (p *AudienceList) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AudienceList(%+v)", *p)
+}
+
+var fieldIDToName_AudienceList = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "isCloseFriends",
+	4: "memberCount",
+	5: "createTime",
+}
+
+type CreateAudienceListReq struct {
+	UserId int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Name   string `thrift:"name,2" frugal:"2,default,string" json:"name"`
+}
+
+func NewCreateAudienceListReq() *CreateAudienceListReq {
+	return &CreateAudienceListReq{}
+}
+
+func (p *CreateAudienceListReq) InitDefault() {
+}
+
+func (p *CreateAudienceListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateAudienceListReq) GetName() (v string) {
+	return p.Name
+}
+func (p *CreateAudienceListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateAudienceListReq) SetName(val string) {
+	p.Name = val
+}
+
+func (p *CreateAudienceListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAudienceListReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateAudienceListReq = map[int16]string{
+	1: "userId",
+	2: "name",
+}
+
+type CreateAudienceListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	List     *AudienceList    `thrift:"list,2" frugal:"2,default,AudienceList" json:"list"`
+}
+
+func NewCreateAudienceListResp() *CreateAudienceListResp {
+	return &CreateAudienceListResp{}
+}
+
+func (p *CreateAudienceListResp) InitDefault() {
+}
+
+var CreateAudienceListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *CreateAudienceListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateAudienceListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CreateAudienceListResp_List_DEFAULT *AudienceList
+
+func (p *CreateAudienceListResp) GetList() (v *AudienceList) {
+	if !p.IsSetList() {
+		return CreateAudienceListResp_List_DEFAULT
+	}
+	return p.List
+}
+func (p *CreateAudienceListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CreateAudienceListResp) SetList(val *AudienceList) {
+	p.List = val
+}
+
+func (p *CreateAudienceListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateAudienceListResp) IsSetList() bool {
+	return p.List != nil
+}
+
+func (p *CreateAudienceListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAudienceListResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateAudienceListResp = map[int16]string{
+	1: "BaseResp",
+	2: "list",
+}
+
+type RenameAudienceListReq struct {
+	UserId int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ListId int64  `thrift:"listId,2" frugal:"2,default,i64" json:"listId"`
+	Name   string `thrift:"name,3" frugal:"3,default,string" json:"name"`
+}
+
+func NewRenameAudienceListReq() *RenameAudienceListReq {
+	return &RenameAudienceListReq{}
+}
+
+func (p *RenameAudienceListReq) InitDefault() {
+}
+
+func (p *RenameAudienceListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RenameAudienceListReq) GetListId() (v int64) {
+	return p.ListId
+}
+
+func (p *RenameAudienceListReq) GetName() (v string) {
+	return p.Name
+}
+func (p *RenameAudienceListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RenameAudienceListReq) SetListId(val int64) {
+	p.ListId = val
+}
+func (p *RenameAudienceListReq) SetName(val string) {
+	p.Name = val
+}
+
+func (p *RenameAudienceListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameAudienceListReq(%+v)", *p)
+}
+
+var fieldIDToName_RenameAudienceListReq = map[int16]string{
+	1: "userId",
+	2: "listId",
+	3: "name",
+}
+
+type RenameAudienceListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewRenameAudienceListResp() *RenameAudienceListResp {
+	return &RenameAudienceListResp{}
+}
+
+func (p *RenameAudienceListResp) InitDefault() {
+}
+
+var RenameAudienceListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RenameAudienceListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RenameAudienceListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RenameAudienceListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *RenameAudienceListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RenameAudienceListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameAudienceListResp(%+v)", *p)
+}
+
+var fieldIDToName_RenameAudienceListResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type DeleteAudienceListReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ListId int64 `thrift:"listId,2" frugal:"2,default,i64" json:"listId"`
+}
+
+func NewDeleteAudienceListReq() *DeleteAudienceListReq {
+	return &DeleteAudienceListReq{}
+}
+
+func (p *DeleteAudienceListReq) InitDefault() {
+}
+
+func (p *DeleteAudienceListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *DeleteAudienceListReq) GetListId() (v int64) {
+	return p.ListId
+}
+func (p *DeleteAudienceListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *DeleteAudienceListReq) SetListId(val int64) {
+	p.ListId = val
+}
+
+func (p *DeleteAudienceListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAudienceListReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAudienceListReq = map[int16]string{
+	1: "userId",
+	2: "listId",
+}
+
+type DeleteAudienceListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteAudienceListResp() *DeleteAudienceListResp {
+	return &DeleteAudienceListResp{}
+}
+
+func (p *DeleteAudienceListResp) InitDefault() {
+}
+
+var DeleteAudienceListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteAudienceListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteAudienceListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteAudienceListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteAudienceListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteAudienceListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAudienceListResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAudienceListResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type AudienceListsReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewAudienceListsReq() *AudienceListsReq {
+	return &AudienceListsReq{}
+}
+
+func (p *AudienceListsReq) InitDefault() {
+}
+
+func (p *AudienceListsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *AudienceListsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *AudienceListsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AudienceListsReq(%+v)", *p)
+}
+
+var fieldIDToName_AudienceListsReq = map[int16]string{
+	1: "userId",
+}
+
+type AudienceListsResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Lists    []*AudienceList  `thrift:"lists,2" frugal:"2,default,list<AudienceList>" json:"lists"`
+}
+
+func NewAudienceListsResp() *AudienceListsResp {
+	return &AudienceListsResp{}
+}
+
+func (p *AudienceListsResp) InitDefault() {
+}
+
+var AudienceListsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AudienceListsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AudienceListsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AudienceListsResp) GetLists() (v []*AudienceList) {
+	return p.Lists
+}
+func (p *AudienceListsResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *AudienceListsResp) SetLists(val []*AudienceList) {
+	p.Lists = val
+}
+
+func (p *AudienceListsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AudienceListsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AudienceListsResp(%+v)", *p)
+}
+
+var fieldIDToName_AudienceListsResp = map[int16]string{
+	1: "BaseResp",
+	2: "lists",
+}
+
+type UpdateAudienceMembersReq struct {
+	UserId    int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ListId    int64   `thrift:"listId,2" frugal:"2,default,i64" json:"listId"`
+	MemberIds []int64 `thrift:"memberIds,3" frugal:"3,default,list<i64>" json:"memberIds"`
+	Add       bool    `thrift:"add,4" frugal:"4,default,bool" json:"add"`
+}
+
+func NewUpdateAudienceMembersReq() *UpdateAudienceMembersReq {
+	return &UpdateAudienceMembersReq{}
+}
+
+func (p *UpdateAudienceMembersReq) InitDefault() {
+}
+
+func (p *UpdateAudienceMembersReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UpdateAudienceMembersReq) GetListId() (v int64) {
+	return p.ListId
+}
+
+func (p *UpdateAudienceMembersReq) GetMemberIds() (v []int64) {
+	return p.MemberIds
+}
+
+func (p *UpdateAudienceMembersReq) GetAdd() (v bool) {
+	return p.Add
+}
+func (p *UpdateAudienceMembersReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateAudienceMembersReq) SetListId(val int64) {
+	p.ListId = val
+}
+func (p *UpdateAudienceMembersReq) SetMemberIds(val []int64) {
+	p.MemberIds = val
+}
+func (p *UpdateAudienceMembersReq) SetAdd(val bool) {
+	p.Add = val
+}
+
+func (p *UpdateAudienceMembersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAudienceMembersReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAudienceMembersReq = map[int16]string{
+	1: "userId",
+	2: "listId",
+	3: "memberIds",
+	4: "add",
+}
+
+type UpdateAudienceMembersResp struct {
+	BaseResp     *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	ChangedCount int64            `thrift:"changedCount,2" frugal:"2,default,i64" json:"changedCount"`
+}
+
+func NewUpdateAudienceMembersResp() *UpdateAudienceMembersResp {
+	return &UpdateAudienceMembersResp{}
+}
+
+func (p *UpdateAudienceMembersResp) InitDefault() {
+}
+
+var UpdateAudienceMembersResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UpdateAudienceMembersResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateAudienceMembersResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UpdateAudienceMembersResp) GetChangedCount() (v int64) {
+	return p.ChangedCount
+}
+func (p *UpdateAudienceMembersResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *UpdateAudienceMembersResp) SetChangedCount(val int64) {
+	p.ChangedCount = val
+}
+
+func (p *UpdateAudienceMembersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateAudienceMembersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAudienceMembersResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAudienceMembersResp = map[int16]string{
+	1: "BaseResp",
+	2: "changedCount",
+}
+
+type AudienceMembersReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ListId   int64 `thrift:"listId,2" frugal:"2,default,i64" json:"listId"`
+	Page     int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+}
+
+func NewAudienceMembersReq() *AudienceMembersReq {
+	return &AudienceMembersReq{}
+}
+
+func (p *AudienceMembersReq) InitDefault() {
+}
+
+func (p *AudienceMembersReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *AudienceMembersReq) GetListId() (v int64) {
+	return p.ListId
+}
+
+func (p *AudienceMembersReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *AudienceMembersReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *AudienceMembersReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *AudienceMembersReq) SetListId(val int64) {
+	p.ListId = val
+}
+func (p *AudienceMembersReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *AudienceMembersReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *AudienceMembersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AudienceMembersReq(%+v)", *p)
+}
+
+var fieldIDToName_AudienceMembersReq = map[int16]string{
+	1: "userId",
+	2: "listId",
+	3: "page",
+	4: "pageSize",
+}
+
+type AudienceMembersResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
+	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+}
+
+func NewAudienceMembersResp() *AudienceMembersResp {
+	return &AudienceMembersResp{}
+}
+
+func (p *AudienceMembersResp) InitDefault() {
+}
+
+var AudienceMembersResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AudienceMembersResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AudienceMembersResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AudienceMembersResp) GetUsers() (v []*common.User) {
+	return p.Users
+}
+
+func (p *AudienceMembersResp) GetTotalCount() (v int32) {
+	return p.TotalCount
+}
+func (p *AudienceMembersResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *AudienceMembersResp) SetUsers(val []*common.User) {
+	p.Users = val
+}
+func (p *AudienceMembersResp) SetTotalCount(val int32) {
+	p.TotalCount = val
+}
+
+func (p *AudienceMembersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AudienceMembersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AudienceMembersResp(%+v)", *p)
+}
+
+var fieldIDToName_AudienceMembersResp = map[int16]string{
+	1: "BaseResp",
+	2: "users",
+	3: "totalCount",
+}
+
+type ValidateAudienceListReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	ListId int64 `thrift:"listId,2" frugal:"2,default,i64" json:"listId"`
+}
+
+func NewValidateAudienceListReq() *ValidateAudienceListReq {
+	return &ValidateAudienceListReq{}
+}
+
+func (p *ValidateAudienceListReq) InitDefault() {
+}
+
+func (p *ValidateAudienceListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ValidateAudienceListReq) GetListId() (v int64) {
+	return p.ListId
+}
+func (p *ValidateAudienceListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ValidateAudienceListReq) SetListId(val int64) {
+	p.ListId = val
+}
+
+func (p *ValidateAudienceListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ValidateAudienceListReq(%+v)", *p)
+}
+
+var fieldIDToName_ValidateAudienceListReq = map[int16]string{
+	1: "userId",
+	2: "listId",
+}
+
+type ValidateAudienceListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewValidateAudienceListResp() *ValidateAudienceListResp {
+	return &ValidateAudienceListResp{}
+}
+
+func (p *ValidateAudienceListResp) InitDefault() {
+}
+
+var ValidateAudienceListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ValidateAudienceListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ValidateAudienceListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ValidateAudienceListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ValidateAudienceListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ValidateAudienceListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ValidateAudienceListResp(%+v)", *p)
+}
+
+var fieldIDToName_ValidateAudienceListResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type BatchCheckAudienceReq struct {
+	ViewerId int64   `thrift:"viewerId,1" frugal:"1,default,i64" json:"viewerId"`
+	ListIds  []int64 `thrift:"listIds,2" frugal:"2,default,list<i64>" json:"listIds"`
+}
+
+func NewBatchCheckAudienceReq() *BatchCheckAudienceReq {
+	return &BatchCheckAudienceReq{}
+}
+
+func (p *BatchCheckAudienceReq) InitDefault() {
+}
+
+func (p *BatchCheckAudienceReq) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *BatchCheckAudienceReq) GetListIds() (v []int64) {
+	return p.ListIds
+}
+func (p *BatchCheckAudienceReq) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *BatchCheckAudienceReq) SetListIds(val []int64) {
+	p.ListIds = val
+}
+
+func (p *BatchCheckAudienceReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCheckAudienceReq(%+v)", *p)
+}
+
+var fieldIDToName_BatchCheckAudienceReq = map[int16]string{
+	1: "viewerId",
+	2: "listIds",
+}
+
+type BatchCheckAudienceResp struct {
+	BaseResp      *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	MemberListIds []int64          `thrift:"memberListIds,2" frugal:"2,default,list<i64>" json:"memberListIds"`
+}
+
+func NewBatchCheckAudienceResp() *BatchCheckAudienceResp {
+	return &BatchCheckAudienceResp{}
+}
+
+func (p *BatchCheckAudienceResp) InitDefault() {
+}
+
+var BatchCheckAudienceResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *BatchCheckAudienceResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BatchCheckAudienceResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BatchCheckAudienceResp) GetMemberListIds() (v []int64) {
+	return p.MemberListIds
+}
+func (p *BatchCheckAudienceResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *BatchCheckAudienceResp) SetMemberListIds(val []int64) {
+	p.MemberListIds = val
+}
+
+func (p *BatchCheckAudienceResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchCheckAudienceResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCheckAudienceResp(%+v)", *p)
+}
+
+var fieldIDToName_BatchCheckAudienceResp = map[int16]string{
+	1: "BaseResp",
+	2: "memberListIds",
+}
+
+type SocialService interface {
+	FollowAction(ctx context.Context, req *FollowActionReq) (r *FollowActionResp, err error)
+
+	GetFollowList(ctx context.Context, req *FollowListReq) (r *FollowListResp, err error)
+
+	GetFollowerList(ctx context.Context, req *FollowerListReq) (r *FollowerListResp, err error)
+
+	GetFriendList(ctx context.Context, req *FriendListReq) (r *FriendListResp, err error)
+
+	CheckFollow(ctx context.Context, req *CheckFollowReq) (r *CheckFollowResp, err error)
+
+	CheckMutualFollow(ctx context.Context, req *CheckMutualFollowReq) (r *CheckMutualFollowResp, err error)
+
+	GetFollowStats(ctx context.Context, req *FollowStatsReq) (r *FollowStatsResp, err error)
+
+	BatchCheckFollow(ctx context.Context, req *BatchCheckFollowReq) (r *BatchCheckFollowResp, err error)
+
+	BlockAction(ctx context.Context, req *BlockActionReq) (r *BlockActionResp, err error)
+
+	MuteAction(ctx context.Context, req *MuteActionReq) (r *MuteActionResp, err error)
+
+	GetBlockList(ctx context.Context, req *BlockListReq) (r *BlockListResp, err error)
+
+	CheckBlock(ctx context.Context, req *CheckBlockReq) (r *CheckBlockResp, err error)
+
+	HandleFollowRequest(ctx context.Context, req *HandleFollowRequestReq) (r *HandleFollowRequestResp, err error)
+
+	CancelFollowRequest(ctx context.Context, req *CancelFollowRequestReq) (r *CancelFollowRequestResp, err error)
+
+	GetFollowRequests(ctx context.Context, req *FollowRequestListReq) (r *FollowRequestListResp, err error)
+
+	SetAccountPrivacy(ctx context.Context, req *SetAccountPrivacyReq) (r *SetAccountPrivacyResp, err error)
+
+	CheckContentAccess(ctx context.Context, req *CheckContentAccessReq) (r *CheckContentAccessResp, err error)
+
+	CreateAudienceList(ctx context.Context, req *CreateAudienceListReq) (r *CreateAudienceListResp, err error)
+
+	RenameAudienceList(ctx context.Context, req *RenameAudienceListReq) (r *RenameAudienceListResp, err error)
+
+	DeleteAudienceList(ctx context.Context, req *DeleteAudienceListReq) (r *DeleteAudienceListResp, err error)
+
+	GetAudienceLists(ctx context.Context, req *AudienceListsReq) (r *AudienceListsResp, err error)
+
+	UpdateAudienceMembers(ctx context.Context, req *UpdateAudienceMembersReq) (r *UpdateAudienceMembersResp, err error)
+
+	GetAudienceMembers(ctx context.Context, req *AudienceMembersReq) (r *AudienceMembersResp, err error)
+
+	ValidateAudienceList(ctx context.Context, req *ValidateAudienceListReq) (r *ValidateAudienceListResp, err error)
+
+	BatchCheckAudience(ctx context.Context, req *BatchCheckAudienceReq) (r *BatchCheckAudienceResp, err error)
+}
+
+type SocialServiceFollowActionArgs struct {
+	Req *FollowActionReq `thrift:"req,1" frugal:"1,default,FollowActionReq" json:"req"`
+}
+
+func NewSocialServiceFollowActionArgs() *SocialServiceFollowActionArgs {
+	return &SocialServiceFollowActionArgs{}
+}
+
+func (p *SocialServiceFollowActionArgs) InitDefault() {
+}
+
+var SocialServiceFollowActionArgs_Req_DEFAULT *FollowActionReq
+
+func (p *SocialServiceFollowActionArgs) GetReq() (v *FollowActionReq) {
+	if !p.IsSetReq() {
+		return SocialServiceFollowActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceFollowActionArgs) SetReq(val *FollowActionReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceFollowActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceFollowActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceFollowActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceFollowActionArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceFollowActionResult struct {
+	Success *FollowActionResp `thrift:"success,0,optional" frugal:"0,optional,FollowActionResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceFollowActionResult() *SocialServiceFollowActionResult {
+	return &SocialServiceFollowActionResult{}
+}
+
+func (p *SocialServiceFollowActionResult) InitDefault() {
+}
+
+var SocialServiceFollowActionResult_Success_DEFAULT *FollowActionResp
+
+func (p *SocialServiceFollowActionResult) GetSuccess() (v *FollowActionResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceFollowActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceFollowActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowActionResp)
+}
+
+func (p *SocialServiceFollowActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceFollowActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceFollowActionResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceFollowActionResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFollowListArgs struct {
+	Req *FollowListReq `thrift:"req,1" frugal:"1,default,FollowListReq" json:"req"`
+}
+
+func NewSocialServiceGetFollowListArgs() *SocialServiceGetFollowListArgs {
+	return &SocialServiceGetFollowListArgs{}
+}
+
+func (p *SocialServiceGetFollowListArgs) InitDefault() {
+}
+
+var SocialServiceGetFollowListArgs_Req_DEFAULT *FollowListReq
+
+func (p *SocialServiceGetFollowListArgs) GetReq() (v *FollowListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFollowListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFollowListArgs) SetReq(val *FollowListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFollowListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFollowListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowListArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFollowListResult struct {
+	Success *FollowListResp `thrift:"success,0,optional" frugal:"0,optional,FollowListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFollowListResult() *SocialServiceGetFollowListResult {
+	return &SocialServiceGetFollowListResult{}
+}
+
+func (p *SocialServiceGetFollowListResult) InitDefault() {
+}
+
+var SocialServiceGetFollowListResult_Success_DEFAULT *FollowListResp
+
+func (p *SocialServiceGetFollowListResult) GetSuccess() (v *FollowListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFollowListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFollowListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowListResp)
+}
+
+func (p *SocialServiceGetFollowListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFollowListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowListResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowListResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFollowerListArgs struct {
+	Req *FollowerListReq `thrift:"req,1" frugal:"1,default,FollowerListReq" json:"req"`
+}
+
+func NewSocialServiceGetFollowerListArgs() *SocialServiceGetFollowerListArgs {
+	return &SocialServiceGetFollowerListArgs{}
+}
+
+func (p *SocialServiceGetFollowerListArgs) InitDefault() {
+}
+
+var SocialServiceGetFollowerListArgs_Req_DEFAULT *FollowerListReq
+
+func (p *SocialServiceGetFollowerListArgs) GetReq() (v *FollowerListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFollowerListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFollowerListArgs) SetReq(val *FollowerListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFollowerListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFollowerListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowerListArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowerListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFollowerListResult struct {
+	Success *FollowerListResp `thrift:"success,0,optional" frugal:"0,optional,FollowerListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFollowerListResult() *SocialServiceGetFollowerListResult {
+	return &SocialServiceGetFollowerListResult{}
 }
 
 func (p *SocialServiceGetFollowerListResult) InitDefault() {
 }
 
-var SocialServiceGetFollowerListResult_Success_DEFAULT *FollowerListResp
+var SocialServiceGetFollowerListResult_Success_DEFAULT *FollowerListResp
+
+func (p *SocialServiceGetFollowerListResult) GetSuccess() (v *FollowerListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFollowerListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFollowerListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowerListResp)
+}
+
+func (p *SocialServiceGetFollowerListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFollowerListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowerListResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowerListResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFriendListArgs struct {
+	Req *FriendListReq `thrift:"req,1" frugal:"1,default,FriendListReq" json:"req"`
+}
+
+func NewSocialServiceGetFriendListArgs() *SocialServiceGetFriendListArgs {
+	return &SocialServiceGetFriendListArgs{}
+}
+
+func (p *SocialServiceGetFriendListArgs) InitDefault() {
+}
+
+var SocialServiceGetFriendListArgs_Req_DEFAULT *FriendListReq
+
+func (p *SocialServiceGetFriendListArgs) GetReq() (v *FriendListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFriendListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFriendListArgs) SetReq(val *FriendListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFriendListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFriendListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendListArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFriendListResult struct {
+	Success *FriendListResp `thrift:"success,0,optional" frugal:"0,optional,FriendListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFriendListResult() *SocialServiceGetFriendListResult {
+	return &SocialServiceGetFriendListResult{}
+}
+
+func (p *SocialServiceGetFriendListResult) InitDefault() {
+}
+
+var SocialServiceGetFriendListResult_Success_DEFAULT *FriendListResp
+
+func (p *SocialServiceGetFriendListResult) GetSuccess() (v *FriendListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFriendListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFriendListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FriendListResp)
+}
+
+func (p *SocialServiceGetFriendListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFriendListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendListResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendListResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckFollowArgs struct {
+	Req *CheckFollowReq `thrift:"req,1" frugal:"1,default,CheckFollowReq" json:"req"`
+}
+
+func NewSocialServiceCheckFollowArgs() *SocialServiceCheckFollowArgs {
+	return &SocialServiceCheckFollowArgs{}
+}
+
+func (p *SocialServiceCheckFollowArgs) InitDefault() {
+}
+
+var SocialServiceCheckFollowArgs_Req_DEFAULT *CheckFollowReq
+
+func (p *SocialServiceCheckFollowArgs) GetReq() (v *CheckFollowReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckFollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckFollowArgs) SetReq(val *CheckFollowReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckFollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckFollowArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckFollowArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckFollowResult struct {
+	Success *CheckFollowResp `thrift:"success,0,optional" frugal:"0,optional,CheckFollowResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckFollowResult() *SocialServiceCheckFollowResult {
+	return &SocialServiceCheckFollowResult{}
+}
+
+func (p *SocialServiceCheckFollowResult) InitDefault() {
+}
+
+var SocialServiceCheckFollowResult_Success_DEFAULT *CheckFollowResp
+
+func (p *SocialServiceCheckFollowResult) GetSuccess() (v *CheckFollowResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckFollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckFollowResp)
+}
+
+func (p *SocialServiceCheckFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckFollowResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckFollowResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckMutualFollowArgs struct {
+	Req *CheckMutualFollowReq `thrift:"req,1" frugal:"1,default,CheckMutualFollowReq" json:"req"`
+}
+
+func NewSocialServiceCheckMutualFollowArgs() *SocialServiceCheckMutualFollowArgs {
+	return &SocialServiceCheckMutualFollowArgs{}
+}
+
+func (p *SocialServiceCheckMutualFollowArgs) InitDefault() {
+}
+
+var SocialServiceCheckMutualFollowArgs_Req_DEFAULT *CheckMutualFollowReq
+
+func (p *SocialServiceCheckMutualFollowArgs) GetReq() (v *CheckMutualFollowReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckMutualFollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckMutualFollowArgs) SetReq(val *CheckMutualFollowReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckMutualFollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckMutualFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckMutualFollowArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckMutualFollowArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckMutualFollowResult struct {
+	Success *CheckMutualFollowResp `thrift:"success,0,optional" frugal:"0,optional,CheckMutualFollowResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckMutualFollowResult() *SocialServiceCheckMutualFollowResult {
+	return &SocialServiceCheckMutualFollowResult{}
+}
+
+func (p *SocialServiceCheckMutualFollowResult) InitDefault() {
+}
+
+var SocialServiceCheckMutualFollowResult_Success_DEFAULT *CheckMutualFollowResp
+
+func (p *SocialServiceCheckMutualFollowResult) GetSuccess() (v *CheckMutualFollowResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckMutualFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckMutualFollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckMutualFollowResp)
+}
+
+func (p *SocialServiceCheckMutualFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckMutualFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckMutualFollowResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckMutualFollowResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFollowStatsArgs struct {
+	Req *FollowStatsReq `thrift:"req,1" frugal:"1,default,FollowStatsReq" json:"req"`
+}
+
+func NewSocialServiceGetFollowStatsArgs() *SocialServiceGetFollowStatsArgs {
+	return &SocialServiceGetFollowStatsArgs{}
+}
+
+func (p *SocialServiceGetFollowStatsArgs) InitDefault() {
+}
+
+var SocialServiceGetFollowStatsArgs_Req_DEFAULT *FollowStatsReq
+
+func (p *SocialServiceGetFollowStatsArgs) GetReq() (v *FollowStatsReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFollowStatsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFollowStatsArgs) SetReq(val *FollowStatsReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFollowStatsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFollowStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowStatsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowStatsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFollowStatsResult struct {
+	Success *FollowStatsResp `thrift:"success,0,optional" frugal:"0,optional,FollowStatsResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFollowStatsResult() *SocialServiceGetFollowStatsResult {
+	return &SocialServiceGetFollowStatsResult{}
+}
+
+func (p *SocialServiceGetFollowStatsResult) InitDefault() {
+}
+
+var SocialServiceGetFollowStatsResult_Success_DEFAULT *FollowStatsResp
+
+func (p *SocialServiceGetFollowStatsResult) GetSuccess() (v *FollowStatsResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFollowStatsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFollowStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowStatsResp)
+}
+
+func (p *SocialServiceGetFollowStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFollowStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowStatsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowStatsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceBatchCheckFollowArgs struct {
+	Req *BatchCheckFollowReq `thrift:"req,1" frugal:"1,default,BatchCheckFollowReq" json:"req"`
+}
+
+func NewSocialServiceBatchCheckFollowArgs() *SocialServiceBatchCheckFollowArgs {
+	return &SocialServiceBatchCheckFollowArgs{}
+}
+
+func (p *SocialServiceBatchCheckFollowArgs) InitDefault() {
+}
+
+var SocialServiceBatchCheckFollowArgs_Req_DEFAULT *BatchCheckFollowReq
+
+func (p *SocialServiceBatchCheckFollowArgs) GetReq() (v *BatchCheckFollowReq) {
+	if !p.IsSetReq() {
+		return SocialServiceBatchCheckFollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceBatchCheckFollowArgs) SetReq(val *BatchCheckFollowReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceBatchCheckFollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceBatchCheckFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBatchCheckFollowArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBatchCheckFollowArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceBatchCheckFollowResult struct {
+	Success *BatchCheckFollowResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckFollowResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceBatchCheckFollowResult() *SocialServiceBatchCheckFollowResult {
+	return &SocialServiceBatchCheckFollowResult{}
+}
+
+func (p *SocialServiceBatchCheckFollowResult) InitDefault() {
+}
+
+var SocialServiceBatchCheckFollowResult_Success_DEFAULT *BatchCheckFollowResp
+
+func (p *SocialServiceBatchCheckFollowResult) GetSuccess() (v *BatchCheckFollowResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceBatchCheckFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceBatchCheckFollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchCheckFollowResp)
+}
+
+func (p *SocialServiceBatchCheckFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceBatchCheckFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBatchCheckFollowResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBatchCheckFollowResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceBlockActionArgs struct {
+	Req *BlockActionReq `thrift:"req,1" frugal:"1,default,BlockActionReq" json:"req"`
+}
+
+func NewSocialServiceBlockActionArgs() *SocialServiceBlockActionArgs {
+	return &SocialServiceBlockActionArgs{}
+}
+
+func (p *SocialServiceBlockActionArgs) InitDefault() {
+}
+
+var SocialServiceBlockActionArgs_Req_DEFAULT *BlockActionReq
+
+func (p *SocialServiceBlockActionArgs) GetReq() (v *BlockActionReq) {
+	if !p.IsSetReq() {
+		return SocialServiceBlockActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceBlockActionArgs) SetReq(val *BlockActionReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceBlockActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceBlockActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBlockActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBlockActionArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceBlockActionResult struct {
+	Success *BlockActionResp `thrift:"success,0,optional" frugal:"0,optional,BlockActionResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceBlockActionResult() *SocialServiceBlockActionResult {
+	return &SocialServiceBlockActionResult{}
+}
+
+func (p *SocialServiceBlockActionResult) InitDefault() {
+}
+
+var SocialServiceBlockActionResult_Success_DEFAULT *BlockActionResp
+
+func (p *SocialServiceBlockActionResult) GetSuccess() (v *BlockActionResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceBlockActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceBlockActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockActionResp)
+}
+
+func (p *SocialServiceBlockActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceBlockActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBlockActionResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBlockActionResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceMuteActionArgs struct {
+	Req *MuteActionReq `thrift:"req,1" frugal:"1,default,MuteActionReq" json:"req"`
+}
+
+func NewSocialServiceMuteActionArgs() *SocialServiceMuteActionArgs {
+	return &SocialServiceMuteActionArgs{}
+}
+
+func (p *SocialServiceMuteActionArgs) InitDefault() {
+}
+
+var SocialServiceMuteActionArgs_Req_DEFAULT *MuteActionReq
+
+func (p *SocialServiceMuteActionArgs) GetReq() (v *MuteActionReq) {
+	if !p.IsSetReq() {
+		return SocialServiceMuteActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceMuteActionArgs) SetReq(val *MuteActionReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceMuteActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceMuteActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceMuteActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceMuteActionArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceMuteActionResult struct {
+	Success *MuteActionResp `thrift:"success,0,optional" frugal:"0,optional,MuteActionResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceMuteActionResult() *SocialServiceMuteActionResult {
+	return &SocialServiceMuteActionResult{}
+}
+
+func (p *SocialServiceMuteActionResult) InitDefault() {
+}
+
+var SocialServiceMuteActionResult_Success_DEFAULT *MuteActionResp
+
+func (p *SocialServiceMuteActionResult) GetSuccess() (v *MuteActionResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceMuteActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceMuteActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MuteActionResp)
+}
+
+func (p *SocialServiceMuteActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceMuteActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceMuteActionResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceMuteActionResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetBlockListArgs struct {
+	Req *BlockListReq `thrift:"req,1" frugal:"1,default,BlockListReq" json:"req"`
+}
+
+func NewSocialServiceGetBlockListArgs() *SocialServiceGetBlockListArgs {
+	return &SocialServiceGetBlockListArgs{}
+}
+
+func (p *SocialServiceGetBlockListArgs) InitDefault() {
+}
+
+var SocialServiceGetBlockListArgs_Req_DEFAULT *BlockListReq
+
+func (p *SocialServiceGetBlockListArgs) GetReq() (v *BlockListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetBlockListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetBlockListArgs) SetReq(val *BlockListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetBlockListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetBlockListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetBlockListArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetBlockListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetBlockListResult struct {
+	Success *BlockListResp `thrift:"success,0,optional" frugal:"0,optional,BlockListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetBlockListResult() *SocialServiceGetBlockListResult {
+	return &SocialServiceGetBlockListResult{}
+}
+
+func (p *SocialServiceGetBlockListResult) InitDefault() {
+}
+
+var SocialServiceGetBlockListResult_Success_DEFAULT *BlockListResp
+
+func (p *SocialServiceGetBlockListResult) GetSuccess() (v *BlockListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetBlockListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetBlockListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockListResp)
+}
+
+func (p *SocialServiceGetBlockListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetBlockListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetBlockListResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetBlockListResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckBlockArgs struct {
+	Req *CheckBlockReq `thrift:"req,1" frugal:"1,default,CheckBlockReq" json:"req"`
+}
+
+func NewSocialServiceCheckBlockArgs() *SocialServiceCheckBlockArgs {
+	return &SocialServiceCheckBlockArgs{}
+}
+
+func (p *SocialServiceCheckBlockArgs) InitDefault() {
+}
+
+var SocialServiceCheckBlockArgs_Req_DEFAULT *CheckBlockReq
+
+func (p *SocialServiceCheckBlockArgs) GetReq() (v *CheckBlockReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckBlockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckBlockArgs) SetReq(val *CheckBlockReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckBlockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckBlockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckBlockArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckBlockArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckBlockResult struct {
+	Success *CheckBlockResp `thrift:"success,0,optional" frugal:"0,optional,CheckBlockResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckBlockResult() *SocialServiceCheckBlockResult {
+	return &SocialServiceCheckBlockResult{}
+}
+
+func (p *SocialServiceCheckBlockResult) InitDefault() {
+}
+
+var SocialServiceCheckBlockResult_Success_DEFAULT *CheckBlockResp
+
+func (p *SocialServiceCheckBlockResult) GetSuccess() (v *CheckBlockResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckBlockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckBlockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckBlockResp)
+}
+
+func (p *SocialServiceCheckBlockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckBlockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckBlockResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckBlockResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceHandleFollowRequestArgs struct {
+	Req *HandleFollowRequestReq `thrift:"req,1" frugal:"1,default,HandleFollowRequestReq" json:"req"`
+}
+
+func NewSocialServiceHandleFollowRequestArgs() *SocialServiceHandleFollowRequestArgs {
+	return &SocialServiceHandleFollowRequestArgs{}
+}
+
+func (p *SocialServiceHandleFollowRequestArgs) InitDefault() {
+}
+
+var SocialServiceHandleFollowRequestArgs_Req_DEFAULT *HandleFollowRequestReq
+
+func (p *SocialServiceHandleFollowRequestArgs) GetReq() (v *HandleFollowRequestReq) {
+	if !p.IsSetReq() {
+		return SocialServiceHandleFollowRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceHandleFollowRequestArgs) SetReq(val *HandleFollowRequestReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceHandleFollowRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceHandleFollowRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceHandleFollowRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceHandleFollowRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceHandleFollowRequestResult struct {
+	Success *HandleFollowRequestResp `thrift:"success,0,optional" frugal:"0,optional,HandleFollowRequestResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceHandleFollowRequestResult() *SocialServiceHandleFollowRequestResult {
+	return &SocialServiceHandleFollowRequestResult{}
+}
+
+func (p *SocialServiceHandleFollowRequestResult) InitDefault() {
+}
+
+var SocialServiceHandleFollowRequestResult_Success_DEFAULT *HandleFollowRequestResp
+
+func (p *SocialServiceHandleFollowRequestResult) GetSuccess() (v *HandleFollowRequestResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceHandleFollowRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceHandleFollowRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HandleFollowRequestResp)
+}
+
+func (p *SocialServiceHandleFollowRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceHandleFollowRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceHandleFollowRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceHandleFollowRequestResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCancelFollowRequestArgs struct {
+	Req *CancelFollowRequestReq `thrift:"req,1" frugal:"1,default,CancelFollowRequestReq" json:"req"`
+}
+
+func NewSocialServiceCancelFollowRequestArgs() *SocialServiceCancelFollowRequestArgs {
+	return &SocialServiceCancelFollowRequestArgs{}
+}
+
+func (p *SocialServiceCancelFollowRequestArgs) InitDefault() {
+}
+
+var SocialServiceCancelFollowRequestArgs_Req_DEFAULT *CancelFollowRequestReq
+
+func (p *SocialServiceCancelFollowRequestArgs) GetReq() (v *CancelFollowRequestReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCancelFollowRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCancelFollowRequestArgs) SetReq(val *CancelFollowRequestReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCancelFollowRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCancelFollowRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCancelFollowRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCancelFollowRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCancelFollowRequestResult struct {
+	Success *CancelFollowRequestResp `thrift:"success,0,optional" frugal:"0,optional,CancelFollowRequestResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCancelFollowRequestResult() *SocialServiceCancelFollowRequestResult {
+	return &SocialServiceCancelFollowRequestResult{}
+}
+
+func (p *SocialServiceCancelFollowRequestResult) InitDefault() {
+}
+
+var SocialServiceCancelFollowRequestResult_Success_DEFAULT *CancelFollowRequestResp
+
+func (p *SocialServiceCancelFollowRequestResult) GetSuccess() (v *CancelFollowRequestResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCancelFollowRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCancelFollowRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelFollowRequestResp)
+}
+
+func (p *SocialServiceCancelFollowRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCancelFollowRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCancelFollowRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCancelFollowRequestResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFollowRequestsArgs struct {
+	Req *FollowRequestListReq `thrift:"req,1" frugal:"1,default,FollowRequestListReq" json:"req"`
+}
+
+func NewSocialServiceGetFollowRequestsArgs() *SocialServiceGetFollowRequestsArgs {
+	return &SocialServiceGetFollowRequestsArgs{}
+}
+
+func (p *SocialServiceGetFollowRequestsArgs) InitDefault() {
+}
+
+var SocialServiceGetFollowRequestsArgs_Req_DEFAULT *FollowRequestListReq
+
+func (p *SocialServiceGetFollowRequestsArgs) GetReq() (v *FollowRequestListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFollowRequestsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFollowRequestsArgs) SetReq(val *FollowRequestListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFollowRequestsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFollowRequestsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowRequestsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowRequestsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFollowRequestsResult struct {
+	Success *FollowRequestListResp `thrift:"success,0,optional" frugal:"0,optional,FollowRequestListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFollowRequestsResult() *SocialServiceGetFollowRequestsResult {
+	return &SocialServiceGetFollowRequestsResult{}
+}
+
+func (p *SocialServiceGetFollowRequestsResult) InitDefault() {
+}
+
+var SocialServiceGetFollowRequestsResult_Success_DEFAULT *FollowRequestListResp
+
+func (p *SocialServiceGetFollowRequestsResult) GetSuccess() (v *FollowRequestListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFollowRequestsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFollowRequestsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowRequestListResp)
+}
+
+func (p *SocialServiceGetFollowRequestsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFollowRequestsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFollowRequestsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFollowRequestsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceSetAccountPrivacyArgs struct {
+	Req *SetAccountPrivacyReq `thrift:"req,1" frugal:"1,default,SetAccountPrivacyReq" json:"req"`
+}
+
+func NewSocialServiceSetAccountPrivacyArgs() *SocialServiceSetAccountPrivacyArgs {
+	return &SocialServiceSetAccountPrivacyArgs{}
+}
+
+func (p *SocialServiceSetAccountPrivacyArgs) InitDefault() {
+}
+
+var SocialServiceSetAccountPrivacyArgs_Req_DEFAULT *SetAccountPrivacyReq
+
+func (p *SocialServiceSetAccountPrivacyArgs) GetReq() (v *SetAccountPrivacyReq) {
+	if !p.IsSetReq() {
+		return SocialServiceSetAccountPrivacyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceSetAccountPrivacyArgs) SetReq(val *SetAccountPrivacyReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceSetAccountPrivacyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceSetAccountPrivacyArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSetAccountPrivacyArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSetAccountPrivacyArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceSetAccountPrivacyResult struct {
+	Success *SetAccountPrivacyResp `thrift:"success,0,optional" frugal:"0,optional,SetAccountPrivacyResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceSetAccountPrivacyResult() *SocialServiceSetAccountPrivacyResult {
+	return &SocialServiceSetAccountPrivacyResult{}
+}
+
+func (p *SocialServiceSetAccountPrivacyResult) InitDefault() {
+}
+
+var SocialServiceSetAccountPrivacyResult_Success_DEFAULT *SetAccountPrivacyResp
+
+func (p *SocialServiceSetAccountPrivacyResult) GetSuccess() (v *SetAccountPrivacyResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceSetAccountPrivacyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceSetAccountPrivacyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetAccountPrivacyResp)
+}
+
+func (p *SocialServiceSetAccountPrivacyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceSetAccountPrivacyResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSetAccountPrivacyResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSetAccountPrivacyResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckContentAccessArgs struct {
+	Req *CheckContentAccessReq `thrift:"req,1" frugal:"1,default,CheckContentAccessReq" json:"req"`
+}
+
+func NewSocialServiceCheckContentAccessArgs() *SocialServiceCheckContentAccessArgs {
+	return &SocialServiceCheckContentAccessArgs{}
+}
+
+func (p *SocialServiceCheckContentAccessArgs) InitDefault() {
+}
+
+var SocialServiceCheckContentAccessArgs_Req_DEFAULT *CheckContentAccessReq
+
+func (p *SocialServiceCheckContentAccessArgs) GetReq() (v *CheckContentAccessReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckContentAccessArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckContentAccessArgs) SetReq(val *CheckContentAccessReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckContentAccessArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckContentAccessArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckContentAccessArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckContentAccessArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckContentAccessResult struct {
+	Success *CheckContentAccessResp `thrift:"success,0,optional" frugal:"0,optional,CheckContentAccessResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckContentAccessResult() *SocialServiceCheckContentAccessResult {
+	return &SocialServiceCheckContentAccessResult{}
+}
+
+func (p *SocialServiceCheckContentAccessResult) InitDefault() {
+}
+
+var SocialServiceCheckContentAccessResult_Success_DEFAULT *CheckContentAccessResp
+
+func (p *SocialServiceCheckContentAccessResult) GetSuccess() (v *CheckContentAccessResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckContentAccessResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckContentAccessResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckContentAccessResp)
+}
+
+func (p *SocialServiceCheckContentAccessResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckContentAccessResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckContentAccessResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckContentAccessResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCreateAudienceListArgs struct {
+	Req *CreateAudienceListReq `thrift:"req,1" frugal:"1,default,CreateAudienceListReq" json:"req"`
+}
+
+func NewSocialServiceCreateAudienceListArgs() *SocialServiceCreateAudienceListArgs {
+	return &SocialServiceCreateAudienceListArgs{}
+}
+
+func (p *SocialServiceCreateAudienceListArgs) InitDefault() {
+}
+
+var SocialServiceCreateAudienceListArgs_Req_DEFAULT *CreateAudienceListReq
+
+func (p *SocialServiceCreateAudienceListArgs) GetReq() (v *CreateAudienceListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceCreateAudienceListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCreateAudienceListArgs) SetReq(val *CreateAudienceListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceCreateAudienceListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCreateAudienceListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateAudienceListArgs(%+v)", *p)
+}
 
-func (p *SocialServiceGetFollowerListResult) GetSuccess() (v *FollowerListResp) {
+var fieldIDToName_SocialServiceCreateAudienceListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCreateAudienceListResult struct {
+	Success *CreateAudienceListResp `thrift:"success,0,optional" frugal:"0,optional,CreateAudienceListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceCreateAudienceListResult() *SocialServiceCreateAudienceListResult {
+	return &SocialServiceCreateAudienceListResult{}
+}
+
+func (p *SocialServiceCreateAudienceListResult) InitDefault() {
+}
+
+var SocialServiceCreateAudienceListResult_Success_DEFAULT *CreateAudienceListResp
+
+func (p *SocialServiceCreateAudienceListResult) GetSuccess() (v *CreateAudienceListResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetFollowerListResult_Success_DEFAULT
+		return SocialServiceCreateAudienceListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetFollowerListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowerListResp)
+func (p *SocialServiceCreateAudienceListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateAudienceListResp)
 }
 
-func (p *SocialServiceGetFollowerListResult) IsSetSuccess() bool {
+func (p *SocialServiceCreateAudienceListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetFollowerListResult) String() string {
+func (p *SocialServiceCreateAudienceListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowerListResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceCreateAudienceListResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowerListResult = map[int16]string{
+var fieldIDToName_SocialServiceCreateAudienceListResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetFriendListArgs struct {
-	Req *FriendListReq `thrift:"req,1" frugal:"1,default,FriendListReq" json:"req"`
+type SocialServiceRenameAudienceListArgs struct {
+	Req *RenameAudienceListReq `thrift:"req,1" frugal:"1,default,RenameAudienceListReq" json:"req"`
 }
 
-func NewSocialServiceGetFriendListArgs() *SocialServiceGetFriendListArgs {
-	return &SocialServiceGetFriendListArgs{}
+func NewSocialServiceRenameAudienceListArgs() *SocialServiceRenameAudienceListArgs {
+	return &SocialServiceRenameAudienceListArgs{}
 }
 
-func (p *SocialServiceGetFriendListArgs) InitDefault() {
+func (p *SocialServiceRenameAudienceListArgs) InitDefault() {
 }
 
-var SocialServiceGetFriendListArgs_Req_DEFAULT *FriendListReq
+var SocialServiceRenameAudienceListArgs_Req_DEFAULT *RenameAudienceListReq
 
-func (p *SocialServiceGetFriendListArgs) GetReq() (v *FriendListReq) {
+func (p *SocialServiceRenameAudienceListArgs) GetReq() (v *RenameAudienceListReq) {
 	if !p.IsSetReq() {
-		return SocialServiceGetFriendListArgs_Req_DEFAULT
+		return SocialServiceRenameAudienceListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetFriendListArgs) SetReq(val *FriendListReq) {
+func (p *SocialServiceRenameAudienceListArgs) SetReq(val *RenameAudienceListReq) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetFriendListArgs) IsSetReq() bool {
+func (p *SocialServiceRenameAudienceListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetFriendListArgs) String() string {
+func (p *SocialServiceRenameAudienceListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendListArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceRenameAudienceListArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendListArgs = map[int16]string{
+var fieldIDToName_SocialServiceRenameAudienceListArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetFriendListResult struct {
-	Success *FriendListResp `thrift:"success,0,optional" frugal:"0,optional,FriendListResp" json:"success,omitempty"`
+type SocialServiceRenameAudienceListResult struct {
+	Success *RenameAudienceListResp `thrift:"success,0,optional" frugal:"0,optional,RenameAudienceListResp" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetFriendListResult() *SocialServiceGetFriendListResult {
-	return &SocialServiceGetFriendListResult{}
+func NewSocialServiceRenameAudienceListResult() *SocialServiceRenameAudienceListResult {
+	return &SocialServiceRenameAudienceListResult{}
 }
 
-func (p *SocialServiceGetFriendListResult) InitDefault() {
+func (p *SocialServiceRenameAudienceListResult) InitDefault() {
 }
 
-var SocialServiceGetFriendListResult_Success_DEFAULT *FriendListResp
+var SocialServiceRenameAudienceListResult_Success_DEFAULT *RenameAudienceListResp
 
-func (p *SocialServiceGetFriendListResult) GetSuccess() (v *FriendListResp) {
+func (p *SocialServiceRenameAudienceListResult) GetSuccess() (v *RenameAudienceListResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetFriendListResult_Success_DEFAULT
+		return SocialServiceRenameAudienceListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetFriendListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FriendListResp)
+func (p *SocialServiceRenameAudienceListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RenameAudienceListResp)
 }
 
-func (p *SocialServiceGetFriendListResult) IsSetSuccess() bool {
+func (p *SocialServiceRenameAudienceListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetFriendListResult) String() string {
+func (p *SocialServiceRenameAudienceListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendListResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceRenameAudienceListResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendListResult = map[int16]string{
+var fieldIDToName_SocialServiceRenameAudienceListResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceCheckFollowArgs struct {
-	Req *CheckFollowReq `thrift:"req,1" frugal:"1,default,CheckFollowReq" json:"req"`
+type SocialServiceDeleteAudienceListArgs struct {
+	Req *DeleteAudienceListReq `thrift:"req,1" frugal:"1,default,DeleteAudienceListReq" json:"req"`
 }
 
-func NewSocialServiceCheckFollowArgs() *SocialServiceCheckFollowArgs {
-	return &SocialServiceCheckFollowArgs{}
+func NewSocialServiceDeleteAudienceListArgs() *SocialServiceDeleteAudienceListArgs {
+	return &SocialServiceDeleteAudienceListArgs{}
 }
 
-func (p *SocialServiceCheckFollowArgs) InitDefault() {
+func (p *SocialServiceDeleteAudienceListArgs) InitDefault() {
 }
 
-var SocialServiceCheckFollowArgs_Req_DEFAULT *CheckFollowReq
+var SocialServiceDeleteAudienceListArgs_Req_DEFAULT *DeleteAudienceListReq
 
-func (p *SocialServiceCheckFollowArgs) GetReq() (v *CheckFollowReq) {
+func (p *SocialServiceDeleteAudienceListArgs) GetReq() (v *DeleteAudienceListReq) {
 	if !p.IsSetReq() {
-		return SocialServiceCheckFollowArgs_Req_DEFAULT
+		return SocialServiceDeleteAudienceListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceCheckFollowArgs) SetReq(val *CheckFollowReq) {
+func (p *SocialServiceDeleteAudienceListArgs) SetReq(val *DeleteAudienceListReq) {
 	p.Req = val
 }
 
-func (p *SocialServiceCheckFollowArgs) IsSetReq() bool {
+func (p *SocialServiceDeleteAudienceListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceCheckFollowArgs) String() string {
+func (p *SocialServiceDeleteAudienceListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCheckFollowArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceDeleteAudienceListArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCheckFollowArgs = map[int16]string{
+var fieldIDToName_SocialServiceDeleteAudienceListArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceCheckFollowResult struct {
-	Success *CheckFollowResp `thrift:"success,0,optional" frugal:"0,optional,CheckFollowResp" json:"success,omitempty"`
+type SocialServiceDeleteAudienceListResult struct {
+	Success *DeleteAudienceListResp `thrift:"success,0,optional" frugal:"0,optional,DeleteAudienceListResp" json:"success,omitempty"`
 }
 
-func NewSocialServiceCheckFollowResult() *SocialServiceCheckFollowResult {
-	return &SocialServiceCheckFollowResult{}
+func NewSocialServiceDeleteAudienceListResult() *SocialServiceDeleteAudienceListResult {
+	return &SocialServiceDeleteAudienceListResult{}
 }
 
-func (p *SocialServiceCheckFollowResult) InitDefault() {
+func (p *SocialServiceDeleteAudienceListResult) InitDefault() {
 }
 
-var SocialServiceCheckFollowResult_Success_DEFAULT *CheckFollowResp
+var SocialServiceDeleteAudienceListResult_Success_DEFAULT *DeleteAudienceListResp
 
-func (p *SocialServiceCheckFollowResult) GetSuccess() (v *CheckFollowResp) {
+func (p *SocialServiceDeleteAudienceListResult) GetSuccess() (v *DeleteAudienceListResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceCheckFollowResult_Success_DEFAULT
+		return SocialServiceDeleteAudienceListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceCheckFollowResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckFollowResp)
+func (p *SocialServiceDeleteAudienceListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteAudienceListResp)
 }
 
-func (p *SocialServiceCheckFollowResult) IsSetSuccess() bool {
+func (p *SocialServiceDeleteAudienceListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceCheckFollowResult) String() string {
+func (p *SocialServiceDeleteAudienceListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCheckFollowResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceDeleteAudienceListResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCheckFollowResult = map[int16]string{
+var fieldIDToName_SocialServiceDeleteAudienceListResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceCheckMutualFollowArgs struct {
-	Req *CheckMutualFollowReq `thrift:"req,1" frugal:"1,default,CheckMutualFollowReq" json:"req"`
+type SocialServiceGetAudienceListsArgs struct {
+	Req *AudienceListsReq `thrift:"req,1" frugal:"1,default,AudienceListsReq" json:"req"`
 }
 
-func NewSocialServiceCheckMutualFollowArgs() *SocialServiceCheckMutualFollowArgs {
-	return &SocialServiceCheckMutualFollowArgs{}
+func NewSocialServiceGetAudienceListsArgs() *SocialServiceGetAudienceListsArgs {
+	return &SocialServiceGetAudienceListsArgs{}
 }
 
-func (p *SocialServiceCheckMutualFollowArgs) InitDefault() {
+func (p *SocialServiceGetAudienceListsArgs) InitDefault() {
 }
 
-var SocialServiceCheckMutualFollowArgs_Req_DEFAULT *CheckMutualFollowReq
+var SocialServiceGetAudienceListsArgs_Req_DEFAULT *AudienceListsReq
 
-func (p *SocialServiceCheckMutualFollowArgs) GetReq() (v *CheckMutualFollowReq) {
+func (p *SocialServiceGetAudienceListsArgs) GetReq() (v *AudienceListsReq) {
 	if !p.IsSetReq() {
-		return SocialServiceCheckMutualFollowArgs_Req_DEFAULT
+		return SocialServiceGetAudienceListsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceCheckMutualFollowArgs) SetReq(val *CheckMutualFollowReq) {
+func (p *SocialServiceGetAudienceListsArgs) SetReq(val *AudienceListsReq) {
 	p.Req = val
 }
 
-func (p *SocialServiceCheckMutualFollowArgs) IsSetReq() bool {
+func (p *SocialServiceGetAudienceListsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceCheckMutualFollowArgs) String() string {
+func (p *SocialServiceGetAudienceListsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCheckMutualFollowArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetAudienceListsArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCheckMutualFollowArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetAudienceListsArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceCheckMutualFollowResult struct {
-	Success *CheckMutualFollowResp `thrift:"success,0,optional" frugal:"0,optional,CheckMutualFollowResp" json:"success,omitempty"`
+type SocialServiceGetAudienceListsResult struct {
+	Success *AudienceListsResp `thrift:"success,0,optional" frugal:"0,optional,AudienceListsResp" json:"success,omitempty"`
 }
 
-func NewSocialServiceCheckMutualFollowResult() *SocialServiceCheckMutualFollowResult {
-	return &SocialServiceCheckMutualFollowResult{}
+func NewSocialServiceGetAudienceListsResult() *SocialServiceGetAudienceListsResult {
+	return &SocialServiceGetAudienceListsResult{}
 }
 
-func (p *SocialServiceCheckMutualFollowResult) InitDefault() {
+func (p *SocialServiceGetAudienceListsResult) InitDefault() {
 }
 
-var SocialServiceCheckMutualFollowResult_Success_DEFAULT *CheckMutualFollowResp
+var SocialServiceGetAudienceListsResult_Success_DEFAULT *AudienceListsResp
 
-func (p *SocialServiceCheckMutualFollowResult) GetSuccess() (v *CheckMutualFollowResp) {
+func (p *SocialServiceGetAudienceListsResult) GetSuccess() (v *AudienceListsResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceCheckMutualFollowResult_Success_DEFAULT
+		return SocialServiceGetAudienceListsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceCheckMutualFollowResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckMutualFollowResp)
+func (p *SocialServiceGetAudienceListsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AudienceListsResp)
 }
 
-func (p *SocialServiceCheckMutualFollowResult) IsSetSuccess() bool {
+func (p *SocialServiceGetAudienceListsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceCheckMutualFollowResult) String() string {
+func (p *SocialServiceGetAudienceListsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCheckMutualFollowResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetAudienceListsResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCheckMutualFollowResult = map[int16]string{
+var fieldIDToName_SocialServiceGetAudienceListsResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetFollowStatsArgs struct {
-	Req *FollowStatsReq `thrift:"req,1" frugal:"1,default,FollowStatsReq" json:"req"`
+type SocialServiceUpdateAudienceMembersArgs struct {
+	Req *UpdateAudienceMembersReq `thrift:"req,1" frugal:"1,default,UpdateAudienceMembersReq" json:"req"`
 }
 
-func NewSocialServiceGetFollowStatsArgs() *SocialServiceGetFollowStatsArgs {
-	return &SocialServiceGetFollowStatsArgs{}
+func NewSocialServiceUpdateAudienceMembersArgs() *SocialServiceUpdateAudienceMembersArgs {
+	return &SocialServiceUpdateAudienceMembersArgs{}
 }
 
-func (p *SocialServiceGetFollowStatsArgs) InitDefault() {
+func (p *SocialServiceUpdateAudienceMembersArgs) InitDefault() {
 }
 
-var SocialServiceGetFollowStatsArgs_Req_DEFAULT *FollowStatsReq
+var SocialServiceUpdateAudienceMembersArgs_Req_DEFAULT *UpdateAudienceMembersReq
 
-func (p *SocialServiceGetFollowStatsArgs) GetReq() (v *FollowStatsReq) {
+func (p *SocialServiceUpdateAudienceMembersArgs) GetReq() (v *UpdateAudienceMembersReq) {
 	if !p.IsSetReq() {
-		return SocialServiceGetFollowStatsArgs_Req_DEFAULT
+		return SocialServiceUpdateAudienceMembersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetFollowStatsArgs) SetReq(val *FollowStatsReq) {
+func (p *SocialServiceUpdateAudienceMembersArgs) SetReq(val *UpdateAudienceMembersReq) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetFollowStatsArgs) IsSetReq() bool {
+func (p *SocialServiceUpdateAudienceMembersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetFollowStatsArgs) String() string {
+func (p *SocialServiceUpdateAudienceMembersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowStatsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdateAudienceMembersArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowStatsArgs = map[int16]string{
+var fieldIDToName_SocialServiceUpdateAudienceMembersArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetFollowStatsResult struct {
-	Success *FollowStatsResp `thrift:"success,0,optional" frugal:"0,optional,FollowStatsResp" json:"success,omitempty"`
+type SocialServiceUpdateAudienceMembersResult struct {
+	Success *UpdateAudienceMembersResp `thrift:"success,0,optional" frugal:"0,optional,UpdateAudienceMembersResp" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetFollowStatsResult() *SocialServiceGetFollowStatsResult {
-	return &SocialServiceGetFollowStatsResult{}
+func NewSocialServiceUpdateAudienceMembersResult() *SocialServiceUpdateAudienceMembersResult {
+	return &SocialServiceUpdateAudienceMembersResult{}
 }
 
-func (p *SocialServiceGetFollowStatsResult) InitDefault() {
+func (p *SocialServiceUpdateAudienceMembersResult) InitDefault() {
 }
 
-var SocialServiceGetFollowStatsResult_Success_DEFAULT *FollowStatsResp
+var SocialServiceUpdateAudienceMembersResult_Success_DEFAULT *UpdateAudienceMembersResp
 
-func (p *SocialServiceGetFollowStatsResult) GetSuccess() (v *FollowStatsResp) {
+func (p *SocialServiceUpdateAudienceMembersResult) GetSuccess() (v *UpdateAudienceMembersResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetFollowStatsResult_Success_DEFAULT
+		return SocialServiceUpdateAudienceMembersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetFollowStatsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowStatsResp)
+func (p *SocialServiceUpdateAudienceMembersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateAudienceMembersResp)
 }
 
-func (p *SocialServiceGetFollowStatsResult) IsSetSuccess() bool {
+func (p *SocialServiceUpdateAudienceMembersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetFollowStatsResult) String() string {
+func (p *SocialServiceUpdateAudienceMembersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFollowStatsResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdateAudienceMembersResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFollowStatsResult = map[int16]string{
+var fieldIDToName_SocialServiceUpdateAudienceMembersResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceBatchCheckFollowArgs struct {
-	Req *BatchCheckFollowReq `thrift:"req,1" frugal:"1,default,BatchCheckFollowReq" json:"req"`
+type SocialServiceGetAudienceMembersArgs struct {
+	Req *AudienceMembersReq `thrift:"req,1" frugal:"1,default,AudienceMembersReq" json:"req"`
 }
 
-func NewSocialServiceBatchCheckFollowArgs() *SocialServiceBatchCheckFollowArgs {
-	return &SocialServiceBatchCheckFollowArgs{}
+func NewSocialServiceGetAudienceMembersArgs() *SocialServiceGetAudienceMembersArgs {
+	return &SocialServiceGetAudienceMembersArgs{}
 }
 
-func (p *SocialServiceBatchCheckFollowArgs) InitDefault() {
+func (p *SocialServiceGetAudienceMembersArgs) InitDefault() {
 }
 
-var SocialServiceBatchCheckFollowArgs_Req_DEFAULT *BatchCheckFollowReq
+var SocialServiceGetAudienceMembersArgs_Req_DEFAULT *AudienceMembersReq
 
-func (p *SocialServiceBatchCheckFollowArgs) GetReq() (v *BatchCheckFollowReq) {
+func (p *SocialServiceGetAudienceMembersArgs) GetReq() (v *AudienceMembersReq) {
 	if !p.IsSetReq() {
-		return SocialServiceBatchCheckFollowArgs_Req_DEFAULT
+		return SocialServiceGetAudienceMembersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceBatchCheckFollowArgs) SetReq(val *BatchCheckFollowReq) {
+func (p *SocialServiceGetAudienceMembersArgs) SetReq(val *AudienceMembersReq) {
 	p.Req = val
 }
 
-func (p *SocialServiceBatchCheckFollowArgs) IsSetReq() bool {
+func (p *SocialServiceGetAudienceMembersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceBatchCheckFollowArgs) String() string {
+func (p *SocialServiceGetAudienceMembersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceBatchCheckFollowArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetAudienceMembersArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceBatchCheckFollowArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetAudienceMembersArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceBatchCheckFollowResult struct {
-	Success *BatchCheckFollowResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckFollowResp" json:"success,omitempty"`
+type SocialServiceGetAudienceMembersResult struct {
+	Success *AudienceMembersResp `thrift:"success,0,optional" frugal:"0,optional,AudienceMembersResp" json:"success,omitempty"`
 }
 
-func NewSocialServiceBatchCheckFollowResult() *SocialServiceBatchCheckFollowResult {
-	return &SocialServiceBatchCheckFollowResult{}
+func NewSocialServiceGetAudienceMembersResult() *SocialServiceGetAudienceMembersResult {
+	return &SocialServiceGetAudienceMembersResult{}
 }
 
-func (p *SocialServiceBatchCheckFollowResult) InitDefault() {
+func (p *SocialServiceGetAudienceMembersResult) InitDefault() {
 }
 
-var SocialServiceBatchCheckFollowResult_Success_DEFAULT *BatchCheckFollowResp
+var SocialServiceGetAudienceMembersResult_Success_DEFAULT *AudienceMembersResp
 
-func (p *SocialServiceBatchCheckFollowResult) GetSuccess() (v *BatchCheckFollowResp) {
+func (p *SocialServiceGetAudienceMembersResult) GetSuccess() (v *AudienceMembersResp) {
 	if !p.IsSetSuccess() {
-		return SocialServiceBatchCheckFollowResult_Success_DEFAULT
+		return SocialServiceGetAudienceMembersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceBatchCheckFollowResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchCheckFollowResp)
+func (p *SocialServiceGetAudienceMembersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AudienceMembersResp)
 }
 
-func (p *SocialServiceBatchCheckFollowResult) IsSetSuccess() bool {
+func (p *SocialServiceGetAudienceMembersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceBatchCheckFollowResult) String() string {
+func (p *SocialServiceGetAudienceMembersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceBatchCheckFollowResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetAudienceMembersResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceBatchCheckFollowResult = map[int16]string{
+var fieldIDToName_SocialServiceGetAudienceMembersResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceValidateAudienceListArgs struct {
+	Req *ValidateAudienceListReq `thrift:"req,1" frugal:"1,default,ValidateAudienceListReq" json:"req"`
+}
+
+func NewSocialServiceValidateAudienceListArgs() *SocialServiceValidateAudienceListArgs {
+	return &SocialServiceValidateAudienceListArgs{}
+}
+
+func (p *SocialServiceValidateAudienceListArgs) InitDefault() {
+}
+
+var SocialServiceValidateAudienceListArgs_Req_DEFAULT *ValidateAudienceListReq
+
+func (p *SocialServiceValidateAudienceListArgs) GetReq() (v *ValidateAudienceListReq) {
+	if !p.IsSetReq() {
+		return SocialServiceValidateAudienceListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceValidateAudienceListArgs) SetReq(val *ValidateAudienceListReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceValidateAudienceListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceValidateAudienceListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceValidateAudienceListArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceValidateAudienceListArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceValidateAudienceListResult struct {
+	Success *ValidateAudienceListResp `thrift:"success,0,optional" frugal:"0,optional,ValidateAudienceListResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceValidateAudienceListResult() *SocialServiceValidateAudienceListResult {
+	return &SocialServiceValidateAudienceListResult{}
+}
+
+func (p *SocialServiceValidateAudienceListResult) InitDefault() {
+}
+
+var SocialServiceValidateAudienceListResult_Success_DEFAULT *ValidateAudienceListResp
+
+func (p *SocialServiceValidateAudienceListResult) GetSuccess() (v *ValidateAudienceListResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceValidateAudienceListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceValidateAudienceListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ValidateAudienceListResp)
+}
+
+func (p *SocialServiceValidateAudienceListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceValidateAudienceListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceValidateAudienceListResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceValidateAudienceListResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceBatchCheckAudienceArgs struct {
+	Req *BatchCheckAudienceReq `thrift:"req,1" frugal:"1,default,BatchCheckAudienceReq" json:"req"`
+}
+
+func NewSocialServiceBatchCheckAudienceArgs() *SocialServiceBatchCheckAudienceArgs {
+	return &SocialServiceBatchCheckAudienceArgs{}
+}
+
+func (p *SocialServiceBatchCheckAudienceArgs) InitDefault() {
+}
+
+var SocialServiceBatchCheckAudienceArgs_Req_DEFAULT *BatchCheckAudienceReq
+
+func (p *SocialServiceBatchCheckAudienceArgs) GetReq() (v *BatchCheckAudienceReq) {
+	if !p.IsSetReq() {
+		return SocialServiceBatchCheckAudienceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceBatchCheckAudienceArgs) SetReq(val *BatchCheckAudienceReq) {
+	p.Req = val
+}
+
+func (p *SocialServiceBatchCheckAudienceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceBatchCheckAudienceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBatchCheckAudienceArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBatchCheckAudienceArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceBatchCheckAudienceResult struct {
+	Success *BatchCheckAudienceResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckAudienceResp" json:"success,omitempty"`
+}
+
+func NewSocialServiceBatchCheckAudienceResult() *SocialServiceBatchCheckAudienceResult {
+	return &SocialServiceBatchCheckAudienceResult{}
+}
+
+func (p *SocialServiceBatchCheckAudienceResult) InitDefault() {
+}
+
+var SocialServiceBatchCheckAudienceResult_Success_DEFAULT *BatchCheckAudienceResp
+
+func (p *SocialServiceBatchCheckAudienceResult) GetSuccess() (v *BatchCheckAudienceResp) {
+	if !p.IsSetSuccess() {
+		return SocialServiceBatchCheckAudienceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceBatchCheckAudienceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchCheckAudienceResp)
+}
+
+func (p *SocialServiceBatchCheckAudienceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceBatchCheckAudienceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceBatchCheckAudienceResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceBatchCheckAudienceResult = map[int16]string{
 	0: "success",
 }
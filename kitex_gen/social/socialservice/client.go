@@ -19,6 +19,23 @@ type Client interface {
 	CheckMutualFollow(ctx context.Context, req *social.CheckMutualFollowReq, callOptions ...callopt.Option) (r *social.CheckMutualFollowResp, err error)
 	GetFollowStats(ctx context.Context, req *social.FollowStatsReq, callOptions ...callopt.Option) (r *social.FollowStatsResp, err error)
 	BatchCheckFollow(ctx context.Context, req *social.BatchCheckFollowReq, callOptions ...callopt.Option) (r *social.BatchCheckFollowResp, err error)
+	BlockAction(ctx context.Context, req *social.BlockActionReq, callOptions ...callopt.Option) (r *social.BlockActionResp, err error)
+	MuteAction(ctx context.Context, req *social.MuteActionReq, callOptions ...callopt.Option) (r *social.MuteActionResp, err error)
+	GetBlockList(ctx context.Context, req *social.BlockListReq, callOptions ...callopt.Option) (r *social.BlockListResp, err error)
+	CheckBlock(ctx context.Context, req *social.CheckBlockReq, callOptions ...callopt.Option) (r *social.CheckBlockResp, err error)
+	HandleFollowRequest(ctx context.Context, req *social.HandleFollowRequestReq, callOptions ...callopt.Option) (r *social.HandleFollowRequestResp, err error)
+	CancelFollowRequest(ctx context.Context, req *social.CancelFollowRequestReq, callOptions ...callopt.Option) (r *social.CancelFollowRequestResp, err error)
+	GetFollowRequests(ctx context.Context, req *social.FollowRequestListReq, callOptions ...callopt.Option) (r *social.FollowRequestListResp, err error)
+	SetAccountPrivacy(ctx context.Context, req *social.SetAccountPrivacyReq, callOptions ...callopt.Option) (r *social.SetAccountPrivacyResp, err error)
+	CheckContentAccess(ctx context.Context, req *social.CheckContentAccessReq, callOptions ...callopt.Option) (r *social.CheckContentAccessResp, err error)
+	CreateAudienceList(ctx context.Context, req *social.CreateAudienceListReq, callOptions ...callopt.Option) (r *social.CreateAudienceListResp, err error)
+	RenameAudienceList(ctx context.Context, req *social.RenameAudienceListReq, callOptions ...callopt.Option) (r *social.RenameAudienceListResp, err error)
+	DeleteAudienceList(ctx context.Context, req *social.DeleteAudienceListReq, callOptions ...callopt.Option) (r *social.DeleteAudienceListResp, err error)
+	GetAudienceLists(ctx context.Context, req *social.AudienceListsReq, callOptions ...callopt.Option) (r *social.AudienceListsResp, err error)
+	UpdateAudienceMembers(ctx context.Context, req *social.UpdateAudienceMembersReq, callOptions ...callopt.Option) (r *social.UpdateAudienceMembersResp, err error)
+	GetAudienceMembers(ctx context.Context, req *social.AudienceMembersReq, callOptions ...callopt.Option) (r *social.AudienceMembersResp, err error)
+	ValidateAudienceList(ctx context.Context, req *social.ValidateAudienceListReq, callOptions ...callopt.Option) (r *social.ValidateAudienceListResp, err error)
+	BatchCheckAudience(ctx context.Context, req *social.BatchCheckAudienceReq, callOptions ...callopt.Option) (r *social.BatchCheckAudienceResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -89,3 +106,88 @@ func (p *kSocialServiceClient) BatchCheckFollow(ctx context.Context, req *social
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.BatchCheckFollow(ctx, req)
 }
+
+func (p *kSocialServiceClient) BlockAction(ctx context.Context, req *social.BlockActionReq, callOptions ...callopt.Option) (r *social.BlockActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BlockAction(ctx, req)
+}
+
+func (p *kSocialServiceClient) MuteAction(ctx context.Context, req *social.MuteActionReq, callOptions ...callopt.Option) (r *social.MuteActionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MuteAction(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetBlockList(ctx context.Context, req *social.BlockListReq, callOptions ...callopt.Option) (r *social.BlockListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlockList(ctx, req)
+}
+
+func (p *kSocialServiceClient) CheckBlock(ctx context.Context, req *social.CheckBlockReq, callOptions ...callopt.Option) (r *social.CheckBlockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckBlock(ctx, req)
+}
+
+func (p *kSocialServiceClient) HandleFollowRequest(ctx context.Context, req *social.HandleFollowRequestReq, callOptions ...callopt.Option) (r *social.HandleFollowRequestResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.HandleFollowRequest(ctx, req)
+}
+
+func (p *kSocialServiceClient) CancelFollowRequest(ctx context.Context, req *social.CancelFollowRequestReq, callOptions ...callopt.Option) (r *social.CancelFollowRequestResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelFollowRequest(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetFollowRequests(ctx context.Context, req *social.FollowRequestListReq, callOptions ...callopt.Option) (r *social.FollowRequestListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowRequests(ctx, req)
+}
+
+func (p *kSocialServiceClient) SetAccountPrivacy(ctx context.Context, req *social.SetAccountPrivacyReq, callOptions ...callopt.Option) (r *social.SetAccountPrivacyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetAccountPrivacy(ctx, req)
+}
+
+func (p *kSocialServiceClient) CheckContentAccess(ctx context.Context, req *social.CheckContentAccessReq, callOptions ...callopt.Option) (r *social.CheckContentAccessResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckContentAccess(ctx, req)
+}
+
+func (p *kSocialServiceClient) CreateAudienceList(ctx context.Context, req *social.CreateAudienceListReq, callOptions ...callopt.Option) (r *social.CreateAudienceListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAudienceList(ctx, req)
+}
+
+func (p *kSocialServiceClient) RenameAudienceList(ctx context.Context, req *social.RenameAudienceListReq, callOptions ...callopt.Option) (r *social.RenameAudienceListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RenameAudienceList(ctx, req)
+}
+
+func (p *kSocialServiceClient) DeleteAudienceList(ctx context.Context, req *social.DeleteAudienceListReq, callOptions ...callopt.Option) (r *social.DeleteAudienceListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAudienceList(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetAudienceLists(ctx context.Context, req *social.AudienceListsReq, callOptions ...callopt.Option) (r *social.AudienceListsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAudienceLists(ctx, req)
+}
+
+func (p *kSocialServiceClient) UpdateAudienceMembers(ctx context.Context, req *social.UpdateAudienceMembersReq, callOptions ...callopt.Option) (r *social.UpdateAudienceMembersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAudienceMembers(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetAudienceMembers(ctx context.Context, req *social.AudienceMembersReq, callOptions ...callopt.Option) (r *social.AudienceMembersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAudienceMembers(ctx, req)
+}
+
+func (p *kSocialServiceClient) ValidateAudienceList(ctx context.Context, req *social.ValidateAudienceListReq, callOptions ...callopt.Option) (r *social.ValidateAudienceListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ValidateAudienceList(ctx, req)
+}
+
+func (p *kSocialServiceClient) BatchCheckAudience(ctx context.Context, req *social.BatchCheckAudienceReq, callOptions ...callopt.Option) (r *social.BatchCheckAudienceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchCheckAudience(ctx, req)
+}
@@ -69,6 +69,125 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"BlockAction": kitex.NewMethodInfo(
+		blockActionHandler,
+		newSocialServiceBlockActionArgs,
+		newSocialServiceBlockActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MuteAction": kitex.NewMethodInfo(
+		muteActionHandler,
+		newSocialServiceMuteActionArgs,
+		newSocialServiceMuteActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBlockList": kitex.NewMethodInfo(
+		getBlockListHandler,
+		newSocialServiceGetBlockListArgs,
+		newSocialServiceGetBlockListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckBlock": kitex.NewMethodInfo(
+		checkBlockHandler,
+		newSocialServiceCheckBlockArgs,
+		newSocialServiceCheckBlockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"HandleFollowRequest": kitex.NewMethodInfo(
+		handleFollowRequestHandler,
+		newSocialServiceHandleFollowRequestArgs,
+		newSocialServiceHandleFollowRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelFollowRequest": kitex.NewMethodInfo(
+		cancelFollowRequestHandler,
+		newSocialServiceCancelFollowRequestArgs,
+		newSocialServiceCancelFollowRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowRequests": kitex.NewMethodInfo(
+		getFollowRequestsHandler,
+		newSocialServiceGetFollowRequestsArgs,
+		newSocialServiceGetFollowRequestsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetAccountPrivacy": kitex.NewMethodInfo(
+		setAccountPrivacyHandler,
+		newSocialServiceSetAccountPrivacyArgs,
+		newSocialServiceSetAccountPrivacyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckContentAccess": kitex.NewMethodInfo(
+		checkContentAccessHandler,
+		newSocialServiceCheckContentAccessArgs,
+		newSocialServiceCheckContentAccessResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateAudienceList": kitex.NewMethodInfo(
+		createAudienceListHandler,
+		newSocialServiceCreateAudienceListArgs,
+		newSocialServiceCreateAudienceListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RenameAudienceList": kitex.NewMethodInfo(
+		renameAudienceListHandler,
+		newSocialServiceRenameAudienceListArgs,
+		newSocialServiceRenameAudienceListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteAudienceList": kitex.NewMethodInfo(
+		deleteAudienceListHandler,
+		newSocialServiceDeleteAudienceListArgs,
+		newSocialServiceDeleteAudienceListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetAudienceLists": kitex.NewMethodInfo(
+		getAudienceListsHandler,
+		newSocialServiceGetAudienceListsArgs,
+		newSocialServiceGetAudienceListsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateAudienceMembers": kitex.NewMethodInfo(
+		updateAudienceMembersHandler,
+		newSocialServiceUpdateAudienceMembersArgs,
+		newSocialServiceUpdateAudienceMembersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetAudienceMembers": kitex.NewMethodInfo(
+		getAudienceMembersHandler,
+		newSocialServiceGetAudienceMembersArgs,
+		newSocialServiceGetAudienceMembersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ValidateAudienceList": kitex.NewMethodInfo(
+		validateAudienceListHandler,
+		newSocialServiceValidateAudienceListArgs,
+		newSocialServiceValidateAudienceListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BatchCheckAudience": kitex.NewMethodInfo(
+		batchCheckAudienceHandler,
+		newSocialServiceBatchCheckAudienceArgs,
+		newSocialServiceBatchCheckAudienceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -279,6 +398,312 @@ func newSocialServiceBatchCheckFollowResult() interface{} {
 	return social.NewSocialServiceBatchCheckFollowResult()
 }
 
+func blockActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceBlockActionArgs)
+	realResult := result.(*social.SocialServiceBlockActionResult)
+	success, err := handler.(social.SocialService).BlockAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceBlockActionArgs() interface{} {
+	return social.NewSocialServiceBlockActionArgs()
+}
+
+func newSocialServiceBlockActionResult() interface{} {
+	return social.NewSocialServiceBlockActionResult()
+}
+
+func muteActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceMuteActionArgs)
+	realResult := result.(*social.SocialServiceMuteActionResult)
+	success, err := handler.(social.SocialService).MuteAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceMuteActionArgs() interface{} {
+	return social.NewSocialServiceMuteActionArgs()
+}
+
+func newSocialServiceMuteActionResult() interface{} {
+	return social.NewSocialServiceMuteActionResult()
+}
+
+func getBlockListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetBlockListArgs)
+	realResult := result.(*social.SocialServiceGetBlockListResult)
+	success, err := handler.(social.SocialService).GetBlockList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetBlockListArgs() interface{} {
+	return social.NewSocialServiceGetBlockListArgs()
+}
+
+func newSocialServiceGetBlockListResult() interface{} {
+	return social.NewSocialServiceGetBlockListResult()
+}
+
+func checkBlockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCheckBlockArgs)
+	realResult := result.(*social.SocialServiceCheckBlockResult)
+	success, err := handler.(social.SocialService).CheckBlock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCheckBlockArgs() interface{} {
+	return social.NewSocialServiceCheckBlockArgs()
+}
+
+func newSocialServiceCheckBlockResult() interface{} {
+	return social.NewSocialServiceCheckBlockResult()
+}
+
+func handleFollowRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceHandleFollowRequestArgs)
+	realResult := result.(*social.SocialServiceHandleFollowRequestResult)
+	success, err := handler.(social.SocialService).HandleFollowRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceHandleFollowRequestArgs() interface{} {
+	return social.NewSocialServiceHandleFollowRequestArgs()
+}
+
+func newSocialServiceHandleFollowRequestResult() interface{} {
+	return social.NewSocialServiceHandleFollowRequestResult()
+}
+
+func cancelFollowRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCancelFollowRequestArgs)
+	realResult := result.(*social.SocialServiceCancelFollowRequestResult)
+	success, err := handler.(social.SocialService).CancelFollowRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCancelFollowRequestArgs() interface{} {
+	return social.NewSocialServiceCancelFollowRequestArgs()
+}
+
+func newSocialServiceCancelFollowRequestResult() interface{} {
+	return social.NewSocialServiceCancelFollowRequestResult()
+}
+
+func getFollowRequestsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetFollowRequestsArgs)
+	realResult := result.(*social.SocialServiceGetFollowRequestsResult)
+	success, err := handler.(social.SocialService).GetFollowRequests(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetFollowRequestsArgs() interface{} {
+	return social.NewSocialServiceGetFollowRequestsArgs()
+}
+
+func newSocialServiceGetFollowRequestsResult() interface{} {
+	return social.NewSocialServiceGetFollowRequestsResult()
+}
+
+func setAccountPrivacyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceSetAccountPrivacyArgs)
+	realResult := result.(*social.SocialServiceSetAccountPrivacyResult)
+	success, err := handler.(social.SocialService).SetAccountPrivacy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceSetAccountPrivacyArgs() interface{} {
+	return social.NewSocialServiceSetAccountPrivacyArgs()
+}
+
+func newSocialServiceSetAccountPrivacyResult() interface{} {
+	return social.NewSocialServiceSetAccountPrivacyResult()
+}
+
+func checkContentAccessHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCheckContentAccessArgs)
+	realResult := result.(*social.SocialServiceCheckContentAccessResult)
+	success, err := handler.(social.SocialService).CheckContentAccess(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCheckContentAccessArgs() interface{} {
+	return social.NewSocialServiceCheckContentAccessArgs()
+}
+
+func newSocialServiceCheckContentAccessResult() interface{} {
+	return social.NewSocialServiceCheckContentAccessResult()
+}
+
+func createAudienceListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCreateAudienceListArgs)
+	realResult := result.(*social.SocialServiceCreateAudienceListResult)
+	success, err := handler.(social.SocialService).CreateAudienceList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCreateAudienceListArgs() interface{} {
+	return social.NewSocialServiceCreateAudienceListArgs()
+}
+
+func newSocialServiceCreateAudienceListResult() interface{} {
+	return social.NewSocialServiceCreateAudienceListResult()
+}
+
+func renameAudienceListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceRenameAudienceListArgs)
+	realResult := result.(*social.SocialServiceRenameAudienceListResult)
+	success, err := handler.(social.SocialService).RenameAudienceList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceRenameAudienceListArgs() interface{} {
+	return social.NewSocialServiceRenameAudienceListArgs()
+}
+
+func newSocialServiceRenameAudienceListResult() interface{} {
+	return social.NewSocialServiceRenameAudienceListResult()
+}
+
+func deleteAudienceListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceDeleteAudienceListArgs)
+	realResult := result.(*social.SocialServiceDeleteAudienceListResult)
+	success, err := handler.(social.SocialService).DeleteAudienceList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceDeleteAudienceListArgs() interface{} {
+	return social.NewSocialServiceDeleteAudienceListArgs()
+}
+
+func newSocialServiceDeleteAudienceListResult() interface{} {
+	return social.NewSocialServiceDeleteAudienceListResult()
+}
+
+func getAudienceListsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetAudienceListsArgs)
+	realResult := result.(*social.SocialServiceGetAudienceListsResult)
+	success, err := handler.(social.SocialService).GetAudienceLists(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetAudienceListsArgs() interface{} {
+	return social.NewSocialServiceGetAudienceListsArgs()
+}
+
+func newSocialServiceGetAudienceListsResult() interface{} {
+	return social.NewSocialServiceGetAudienceListsResult()
+}
+
+func updateAudienceMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceUpdateAudienceMembersArgs)
+	realResult := result.(*social.SocialServiceUpdateAudienceMembersResult)
+	success, err := handler.(social.SocialService).UpdateAudienceMembers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceUpdateAudienceMembersArgs() interface{} {
+	return social.NewSocialServiceUpdateAudienceMembersArgs()
+}
+
+func newSocialServiceUpdateAudienceMembersResult() interface{} {
+	return social.NewSocialServiceUpdateAudienceMembersResult()
+}
+
+func getAudienceMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetAudienceMembersArgs)
+	realResult := result.(*social.SocialServiceGetAudienceMembersResult)
+	success, err := handler.(social.SocialService).GetAudienceMembers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetAudienceMembersArgs() interface{} {
+	return social.NewSocialServiceGetAudienceMembersArgs()
+}
+
+func newSocialServiceGetAudienceMembersResult() interface{} {
+	return social.NewSocialServiceGetAudienceMembersResult()
+}
+
+func validateAudienceListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceValidateAudienceListArgs)
+	realResult := result.(*social.SocialServiceValidateAudienceListResult)
+	success, err := handler.(social.SocialService).ValidateAudienceList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceValidateAudienceListArgs() interface{} {
+	return social.NewSocialServiceValidateAudienceListArgs()
+}
+
+func newSocialServiceValidateAudienceListResult() interface{} {
+	return social.NewSocialServiceValidateAudienceListResult()
+}
+
+func batchCheckAudienceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceBatchCheckAudienceArgs)
+	realResult := result.(*social.SocialServiceBatchCheckAudienceResult)
+	success, err := handler.(social.SocialService).BatchCheckAudience(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceBatchCheckAudienceArgs() interface{} {
+	return social.NewSocialServiceBatchCheckAudienceArgs()
+}
+
+func newSocialServiceBatchCheckAudienceResult() interface{} {
+	return social.NewSocialServiceBatchCheckAudienceResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -368,3 +793,173 @@ func (p *kClient) BatchCheckFollow(ctx context.Context, req *social.BatchCheckFo
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BlockAction(ctx context.Context, req *social.BlockActionReq) (r *social.BlockActionResp, err error) {
+	var _args social.SocialServiceBlockActionArgs
+	_args.Req = req
+	var _result social.SocialServiceBlockActionResult
+	if err = p.c.Call(ctx, "BlockAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MuteAction(ctx context.Context, req *social.MuteActionReq) (r *social.MuteActionResp, err error) {
+	var _args social.SocialServiceMuteActionArgs
+	_args.Req = req
+	var _result social.SocialServiceMuteActionResult
+	if err = p.c.Call(ctx, "MuteAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlockList(ctx context.Context, req *social.BlockListReq) (r *social.BlockListResp, err error) {
+	var _args social.SocialServiceGetBlockListArgs
+	_args.Req = req
+	var _result social.SocialServiceGetBlockListResult
+	if err = p.c.Call(ctx, "GetBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckBlock(ctx context.Context, req *social.CheckBlockReq) (r *social.CheckBlockResp, err error) {
+	var _args social.SocialServiceCheckBlockArgs
+	_args.Req = req
+	var _result social.SocialServiceCheckBlockResult
+	if err = p.c.Call(ctx, "CheckBlock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) HandleFollowRequest(ctx context.Context, req *social.HandleFollowRequestReq) (r *social.HandleFollowRequestResp, err error) {
+	var _args social.SocialServiceHandleFollowRequestArgs
+	_args.Req = req
+	var _result social.SocialServiceHandleFollowRequestResult
+	if err = p.c.Call(ctx, "HandleFollowRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelFollowRequest(ctx context.Context, req *social.CancelFollowRequestReq) (r *social.CancelFollowRequestResp, err error) {
+	var _args social.SocialServiceCancelFollowRequestArgs
+	_args.Req = req
+	var _result social.SocialServiceCancelFollowRequestResult
+	if err = p.c.Call(ctx, "CancelFollowRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowRequests(ctx context.Context, req *social.FollowRequestListReq) (r *social.FollowRequestListResp, err error) {
+	var _args social.SocialServiceGetFollowRequestsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetFollowRequestsResult
+	if err = p.c.Call(ctx, "GetFollowRequests", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetAccountPrivacy(ctx context.Context, req *social.SetAccountPrivacyReq) (r *social.SetAccountPrivacyResp, err error) {
+	var _args social.SocialServiceSetAccountPrivacyArgs
+	_args.Req = req
+	var _result social.SocialServiceSetAccountPrivacyResult
+	if err = p.c.Call(ctx, "SetAccountPrivacy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckContentAccess(ctx context.Context, req *social.CheckContentAccessReq) (r *social.CheckContentAccessResp, err error) {
+	var _args social.SocialServiceCheckContentAccessArgs
+	_args.Req = req
+	var _result social.SocialServiceCheckContentAccessResult
+	if err = p.c.Call(ctx, "CheckContentAccess", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAudienceList(ctx context.Context, req *social.CreateAudienceListReq) (r *social.CreateAudienceListResp, err error) {
+	var _args social.SocialServiceCreateAudienceListArgs
+	_args.Req = req
+	var _result social.SocialServiceCreateAudienceListResult
+	if err = p.c.Call(ctx, "CreateAudienceList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RenameAudienceList(ctx context.Context, req *social.RenameAudienceListReq) (r *social.RenameAudienceListResp, err error) {
+	var _args social.SocialServiceRenameAudienceListArgs
+	_args.Req = req
+	var _result social.SocialServiceRenameAudienceListResult
+	if err = p.c.Call(ctx, "RenameAudienceList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAudienceList(ctx context.Context, req *social.DeleteAudienceListReq) (r *social.DeleteAudienceListResp, err error) {
+	var _args social.SocialServiceDeleteAudienceListArgs
+	_args.Req = req
+	var _result social.SocialServiceDeleteAudienceListResult
+	if err = p.c.Call(ctx, "DeleteAudienceList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAudienceLists(ctx context.Context, req *social.AudienceListsReq) (r *social.AudienceListsResp, err error) {
+	var _args social.SocialServiceGetAudienceListsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetAudienceListsResult
+	if err = p.c.Call(ctx, "GetAudienceLists", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAudienceMembers(ctx context.Context, req *social.UpdateAudienceMembersReq) (r *social.UpdateAudienceMembersResp, err error) {
+	var _args social.SocialServiceUpdateAudienceMembersArgs
+	_args.Req = req
+	var _result social.SocialServiceUpdateAudienceMembersResult
+	if err = p.c.Call(ctx, "UpdateAudienceMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAudienceMembers(ctx context.Context, req *social.AudienceMembersReq) (r *social.AudienceMembersResp, err error) {
+	var _args social.SocialServiceGetAudienceMembersArgs
+	_args.Req = req
+	var _result social.SocialServiceGetAudienceMembersResult
+	if err = p.c.Call(ctx, "GetAudienceMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ValidateAudienceList(ctx context.Context, req *social.ValidateAudienceListReq) (r *social.ValidateAudienceListResp, err error) {
+	var _args social.SocialServiceValidateAudienceListArgs
+	_args.Req = req
+	var _result social.SocialServiceValidateAudienceListResult
+	if err = p.c.Call(ctx, "ValidateAudienceList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchCheckAudience(ctx context.Context, req *social.BatchCheckAudienceReq) (r *social.BatchCheckAudienceResp, err error) {
+	var _args social.SocialServiceBatchCheckAudienceArgs
+	_args.Req = req
+	var _result social.SocialServiceBatchCheckAudienceResult
+	if err = p.c.Call(ctx, "BatchCheckAudience", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -8,11 +8,78 @@ import (
 	"shortvideo/kitex_gen/common"
 )
 
+type DeviceInfo struct {
+	DeviceId   string `thrift:"deviceId,1" frugal:"1,default,string" json:"deviceId"`
+	DeviceName string `thrift:"deviceName,2" frugal:"2,default,string" json:"deviceName"`
+	Platform   string `thrift:"platform,3" frugal:"3,default,string" json:"platform"`
+	Ip         string `thrift:"ip,4" frugal:"4,default,string" json:"ip"`
+	UserAgent  string `thrift:"userAgent,5" frugal:"5,default,string" json:"userAgent"`
+}
+
+func NewDeviceInfo() *DeviceInfo {
+	return &DeviceInfo{}
+}
+
+func (p *DeviceInfo) InitDefault() {
+}
+
+func (p *DeviceInfo) GetDeviceId() (v string) {
+	return p.DeviceId
+}
+
+func (p *DeviceInfo) GetDeviceName() (v string) {
+	return p.DeviceName
+}
+
+func (p *DeviceInfo) GetPlatform() (v string) {
+	return p.Platform
+}
+
+func (p *DeviceInfo) GetIp() (v string) {
+	return p.Ip
+}
+
+func (p *DeviceInfo) GetUserAgent() (v string) {
+	return p.UserAgent
+}
+func (p *DeviceInfo) SetDeviceId(val string) {
+	p.DeviceId = val
+}
+func (p *DeviceInfo) SetDeviceName(val string) {
+	p.DeviceName = val
+}
+func (p *DeviceInfo) SetPlatform(val string) {
+	p.Platform = val
+}
+func (p *DeviceInfo) SetIp(val string) {
+	p.Ip = val
+}
+func (p *DeviceInfo) SetUserAgent(val string) {
+	p.UserAgent = val
+}
+
+func (p *DeviceInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeviceInfo(%+v)", *p)
+}
+
+var fieldIDToName_DeviceInfo = map[int16]string{
+	1: "deviceId",
+	2: "deviceName",
+	3: "platform",
+	4: "ip",
+	5: "userAgent",
+}
+
 type RegisterReq struct {
-	Username string  `thrift:"username,1" frugal:"1,default,string" json:"username"`
-	Password string  `thrift:"password,2" frugal:"2,default,string" json:"password"`
-	Avatar   *string `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
-	About    *string `thrift:"about,4,optional" frugal:"4,optional,string" json:"about,omitempty"`
+	Username     string      `thrift:"username,1" frugal:"1,default,string" json:"username"`
+	Password     string      `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	Avatar       *string     `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
+	About        *string     `thrift:"about,4,optional" frugal:"4,optional,string" json:"about,omitempty"`
+	Device       *DeviceInfo `thrift:"device,5,optional" frugal:"5,optional,DeviceInfo" json:"device,omitempty"`
+	CaptchaToken *string     `thrift:"captchaToken,6,optional" frugal:"6,optional,string" json:"captchaToken,omitempty"`
 }
 
 func NewRegisterReq() *RegisterReq {
@@ -47,6 +114,24 @@ func (p *RegisterReq) GetAbout() (v string) {
 	}
 	return *p.About
 }
+
+var RegisterReq_Device_DEFAULT *DeviceInfo
+
+func (p *RegisterReq) GetDevice() (v *DeviceInfo) {
+	if !p.IsSetDevice() {
+		return RegisterReq_Device_DEFAULT
+	}
+	return p.Device
+}
+
+var RegisterReq_CaptchaToken_DEFAULT string
+
+func (p *RegisterReq) GetCaptchaToken() (v string) {
+	if !p.IsSetCaptchaToken() {
+		return RegisterReq_CaptchaToken_DEFAULT
+	}
+	return *p.CaptchaToken
+}
 func (p *RegisterReq) SetUsername(val string) {
 	p.Username = val
 }
@@ -59,6 +144,12 @@ func (p *RegisterReq) SetAvatar(val *string) {
 func (p *RegisterReq) SetAbout(val *string) {
 	p.About = val
 }
+func (p *RegisterReq) SetDevice(val *DeviceInfo) {
+	p.Device = val
+}
+func (p *RegisterReq) SetCaptchaToken(val *string) {
+	p.CaptchaToken = val
+}
 
 func (p *RegisterReq) IsSetAvatar() bool {
 	return p.Avatar != nil
@@ -68,6 +159,14 @@ func (p *RegisterReq) IsSetAbout() bool {
 	return p.About != nil
 }
 
+func (p *RegisterReq) IsSetDevice() bool {
+	return p.Device != nil
+}
+
+func (p *RegisterReq) IsSetCaptchaToken() bool {
+	return p.CaptchaToken != nil
+}
+
 func (p *RegisterReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -80,11 +179,15 @@ var fieldIDToName_RegisterReq = map[int16]string{
 	2: "password",
 	3: "avatar",
 	4: "about",
+	5: "device",
+	6: "captchaToken",
 }
 
 type LoginReq struct {
-	Username string `thrift:"username,1" frugal:"1,default,string" json:"username"`
-	Password string `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	Username     string      `thrift:"username,1" frugal:"1,default,string" json:"username"`
+	Password     string      `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	Device       *DeviceInfo `thrift:"device,3,optional" frugal:"3,optional,DeviceInfo" json:"device,omitempty"`
+	CaptchaToken *string     `thrift:"captchaToken,4,optional" frugal:"4,optional,string" json:"captchaToken,omitempty"`
 }
 
 func NewLoginReq() *LoginReq {
@@ -101,12 +204,44 @@ func (p *LoginReq) GetUsername() (v string) {
 func (p *LoginReq) GetPassword() (v string) {
 	return p.Password
 }
+
+var LoginReq_Device_DEFAULT *DeviceInfo
+
+func (p *LoginReq) GetDevice() (v *DeviceInfo) {
+	if !p.IsSetDevice() {
+		return LoginReq_Device_DEFAULT
+	}
+	return p.Device
+}
+
+var LoginReq_CaptchaToken_DEFAULT string
+
+func (p *LoginReq) GetCaptchaToken() (v string) {
+	if !p.IsSetCaptchaToken() {
+		return LoginReq_CaptchaToken_DEFAULT
+	}
+	return *p.CaptchaToken
+}
 func (p *LoginReq) SetUsername(val string) {
 	p.Username = val
 }
 func (p *LoginReq) SetPassword(val string) {
 	p.Password = val
 }
+func (p *LoginReq) SetDevice(val *DeviceInfo) {
+	p.Device = val
+}
+func (p *LoginReq) SetCaptchaToken(val *string) {
+	p.CaptchaToken = val
+}
+
+func (p *LoginReq) IsSetDevice() bool {
+	return p.Device != nil
+}
+
+func (p *LoginReq) IsSetCaptchaToken() bool {
+	return p.CaptchaToken != nil
+}
 
 func (p *LoginReq) String() string {
 	if p == nil {
@@ -118,12 +253,21 @@ func (p *LoginReq) String() string {
 var fieldIDToName_LoginReq = map[int16]string{
 	1: "username",
 	2: "password",
+	3: "device",
+	4: "captchaToken",
 }
 
 type LoginRegisterResp struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	User     *common.User     `thrift:"user,2" frugal:"2,default,common.User" json:"user"`
-	Token    string           `thrift:"token,3" frugal:"3,default,string" json:"token"`
+	BaseResp           *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	User               *common.User     `thrift:"user,2" frugal:"2,default,common.User" json:"user"`
+	Token              string           `thrift:"token,3" frugal:"3,default,string" json:"token"`
+	RefreshToken       string           `thrift:"refreshToken,4" frugal:"4,default,string" json:"refreshToken"`
+	ExpiresAt          int64            `thrift:"expiresAt,5" frugal:"5,default,i64" json:"expiresAt"`
+	TwoFactorRequired  bool             `thrift:"twoFactorRequired,6" frugal:"6,default,bool" json:"twoFactorRequired"`
+	ChallengeToken     string           `thrift:"challengeToken,7" frugal:"7,default,string" json:"challengeToken"`
+	ChallengeExpiresAt int64            `thrift:"challengeExpiresAt,8" frugal:"8,default,i64" json:"challengeExpiresAt"`
+	Registered         bool             `thrift:"registered,9" frugal:"9,default,bool" json:"registered"`
+	IdentityLinked     bool             `thrift:"identityLinked,10" frugal:"10,default,bool" json:"identityLinked"`
 }
 
 func NewLoginRegisterResp() *LoginRegisterResp {
@@ -154,6 +298,34 @@ func (p *LoginRegisterResp) GetUser() (v *common.User) {
 func (p *LoginRegisterResp) GetToken() (v string) {
 	return p.Token
 }
+
+func (p *LoginRegisterResp) GetRefreshToken() (v string) {
+	return p.RefreshToken
+}
+
+func (p *LoginRegisterResp) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+
+func (p *LoginRegisterResp) GetTwoFactorRequired() (v bool) {
+	return p.TwoFactorRequired
+}
+
+func (p *LoginRegisterResp) GetChallengeToken() (v string) {
+	return p.ChallengeToken
+}
+
+func (p *LoginRegisterResp) GetChallengeExpiresAt() (v int64) {
+	return p.ChallengeExpiresAt
+}
+
+func (p *LoginRegisterResp) GetRegistered() (v bool) {
+	return p.Registered
+}
+
+func (p *LoginRegisterResp) GetIdentityLinked() (v bool) {
+	return p.IdentityLinked
+}
 func (p *LoginRegisterResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -163,6 +335,27 @@ func (p *LoginRegisterResp) SetUser(val *common.User) {
 func (p *LoginRegisterResp) SetToken(val string) {
 	p.Token = val
 }
+func (p *LoginRegisterResp) SetRefreshToken(val string) {
+	p.RefreshToken = val
+}
+func (p *LoginRegisterResp) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+func (p *LoginRegisterResp) SetTwoFactorRequired(val bool) {
+	p.TwoFactorRequired = val
+}
+func (p *LoginRegisterResp) SetChallengeToken(val string) {
+	p.ChallengeToken = val
+}
+func (p *LoginRegisterResp) SetChallengeExpiresAt(val int64) {
+	p.ChallengeExpiresAt = val
+}
+func (p *LoginRegisterResp) SetRegistered(val bool) {
+	p.Registered = val
+}
+func (p *LoginRegisterResp) SetIdentityLinked(val bool) {
+	p.IdentityLinked = val
+}
 
 func (p *LoginRegisterResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
@@ -180,9 +373,16 @@ func (p *LoginRegisterResp) String() string {
 }
 
 var fieldIDToName_LoginRegisterResp = map[int16]string{
-	1: "BaseResp",
-	2: "user",
-	3: "token",
+	1:  "BaseResp",
+	2:  "user",
+	3:  "token",
+	4:  "refreshToken",
+	5:  "expiresAt",
+	6:  "twoFactorRequired",
+	7:  "challengeToken",
+	8:  "challengeExpiresAt",
+	9:  "registered",
+	10: "identityLinked",
 }
 
 type UserInfoReq struct {
@@ -365,11 +565,12 @@ var fieldIDToName_BatchUserInfoResp = map[int16]string{
 }
 
 type UpdateUserReq struct {
-	UserId       int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Avatar       *string `thrift:"avatar,2,optional" frugal:"2,optional,string" json:"avatar,omitempty"`
-	About        *string `thrift:"about,3,optional" frugal:"3,optional,string" json:"about,omitempty"`
-	OldPassword  *string `thrift:"oldPassword,4,optional" frugal:"4,optional,string" json:"oldPassword,omitempty"`
-	NewPassword_ *string `thrift:"newPassword,5,optional" frugal:"5,optional,string" json:"newPassword,omitempty"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Avatar        *string `thrift:"avatar,2,optional" frugal:"2,optional,string" json:"avatar,omitempty"`
+	About         *string `thrift:"about,3,optional" frugal:"3,optional,string" json:"about,omitempty"`
+	OldPassword   *string `thrift:"oldPassword,4,optional" frugal:"4,optional,string" json:"oldPassword,omitempty"`
+	NewPassword_  *string `thrift:"newPassword,5,optional" frugal:"5,optional,string" json:"newPassword,omitempty"`
+	TwoFactorCode *string `thrift:"twoFactorCode,6,optional" frugal:"6,optional,string" json:"twoFactorCode,omitempty"`
 }
 
 func NewUpdateUserReq() *UpdateUserReq {
@@ -418,6 +619,15 @@ func (p *UpdateUserReq) GetNewPassword_() (v string) {
 	}
 	return *p.NewPassword_
 }
+
+var UpdateUserReq_TwoFactorCode_DEFAULT string
+
+func (p *UpdateUserReq) GetTwoFactorCode() (v string) {
+	if !p.IsSetTwoFactorCode() {
+		return UpdateUserReq_TwoFactorCode_DEFAULT
+	}
+	return *p.TwoFactorCode
+}
 func (p *UpdateUserReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -433,6 +643,9 @@ func (p *UpdateUserReq) SetOldPassword(val *string) {
 func (p *UpdateUserReq) SetNewPassword_(val *string) {
 	p.NewPassword_ = val
 }
+func (p *UpdateUserReq) SetTwoFactorCode(val *string) {
+	p.TwoFactorCode = val
+}
 
 func (p *UpdateUserReq) IsSetAvatar() bool {
 	return p.Avatar != nil
@@ -450,6 +663,10 @@ func (p *UpdateUserReq) IsSetNewPassword_() bool {
 	return p.NewPassword_ != nil
 }
 
+func (p *UpdateUserReq) IsSetTwoFactorCode() bool {
+	return p.TwoFactorCode != nil
+}
+
 func (p *UpdateUserReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -463,6 +680,7 @@ var fieldIDToName_UpdateUserReq = map[int16]string{
 	3: "about",
 	4: "oldPassword",
 	5: "newPassword",
+	6: "twoFactorCode",
 }
 
 type CheckUsernameReq struct {
@@ -835,9 +1053,11 @@ var fieldIDToName_BatchCheckUsernamesResp = map[int16]string{
 }
 
 type SearchUsersReq struct {
-	Keyword  string `thrift:"keyword,1" frugal:"1,default,string" json:"keyword"`
-	Page     int32  `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize int32  `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Keyword   string  `thrift:"keyword,1" frugal:"1,default,string" json:"keyword"`
+	Page      int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize  int32   `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
+	Cursor    *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+	NeedTotal *bool   `thrift:"needTotal,5,optional" frugal:"5,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewSearchUsersReq() *SearchUsersReq {
@@ -858,6 +1078,24 @@ func (p *SearchUsersReq) GetPage() (v int32) {
 func (p *SearchUsersReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var SearchUsersReq_Cursor_DEFAULT string
+
+func (p *SearchUsersReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return SearchUsersReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var SearchUsersReq_NeedTotal_DEFAULT bool
+
+func (p *SearchUsersReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return SearchUsersReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *SearchUsersReq) SetKeyword(val string) {
 	p.Keyword = val
 }
@@ -867,6 +1105,20 @@ func (p *SearchUsersReq) SetPage(val int32) {
 func (p *SearchUsersReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *SearchUsersReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *SearchUsersReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *SearchUsersReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *SearchUsersReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *SearchUsersReq) String() string {
 	if p == nil {
@@ -879,12 +1131,16 @@ var fieldIDToName_SearchUsersReq = map[int16]string{
 	1: "keyword",
 	2: "page",
 	3: "pageSize",
+	4: "cursor",
+	5: "needTotal",
 }
 
 type SearchUsersResp struct {
-	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
-	Users    []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
-	Total    int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Users      []*common.User   `thrift:"users,2" frugal:"2,default,list<common.User>" json:"users"`
+	Total      int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewSearchUsersResp() *SearchUsersResp {
@@ -910,6 +1166,19 @@ func (p *SearchUsersResp) GetUsers() (v []*common.User) {
 func (p *SearchUsersResp) GetTotal() (v int64) {
 	return p.Total
 }
+
+var SearchUsersResp_NextCursor_DEFAULT string
+
+func (p *SearchUsersResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return SearchUsersResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *SearchUsersResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *SearchUsersResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -919,11 +1188,21 @@ func (p *SearchUsersResp) SetUsers(val []*common.User) {
 func (p *SearchUsersResp) SetTotal(val int64) {
 	p.Total = val
 }
+func (p *SearchUsersResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *SearchUsersResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *SearchUsersResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *SearchUsersResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *SearchUsersResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -935,6 +1214,8 @@ var fieldIDToName_SearchUsersResp = map[int16]string{
 	1: "BaseResp",
 	2: "users",
 	3: "total",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type UpdateFollowCountReq struct {
@@ -1013,1087 +1294,5682 @@ var fieldIDToName_UpdateFollowerCountReq = map[int16]string{
 	2: "delta",
 }
 
-type UserService interface {
-	Register(ctx context.Context, req *RegisterReq) (r *LoginRegisterResp, err error)
-
-	Login(ctx context.Context, req *LoginReq) (r *LoginRegisterResp, err error)
-
-	GetUserInfo(ctx context.Context, req *UserInfoReq) (r *UserInfoResp, err error)
-
-	GetUserInfoByUsername(ctx context.Context, req *UserInfoByUsernameReq) (r *UserInfoResp, err error)
-
-	BatchGetUserInfo(ctx context.Context, req *BatchUserInfoReq) (r *BatchUserInfoResp, err error)
-
-	UpdateUser(ctx context.Context, req *UpdateUserReq) (r *common.BaseResp, err error)
-
-	UpdateAvatar(ctx context.Context, req *UpdateAvatarReq) (r *common.BaseResp, err error)
-
-	CheckUsername(ctx context.Context, req *CheckUsernameReq) (r *CheckUsernameResp, err error)
-
-	BatchCheckUsernames(ctx context.Context, req *BatchCheckUsernamesReq) (r *BatchCheckUsernamesResp, err error)
-
-	GetUserStats(ctx context.Context, req *UserStatsReq) (r *UserStatsResp, err error)
+type VerifyTokenResp struct {
+	BaseResp  *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	UserId    int64            `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	SessionId string           `thrift:"sessionId,3" frugal:"3,default,string" json:"sessionId"`
+	Roles     []string         `thrift:"roles,4" frugal:"4,default,list<string>" json:"roles"`
+}
 
-	SearchUsers(ctx context.Context, req *SearchUsersReq) (r *SearchUsersResp, err error)
+func NewVerifyTokenResp() *VerifyTokenResp {
+	return &VerifyTokenResp{}
+}
 
-	UpdateFollowCount(ctx context.Context, req *UpdateFollowCountReq) (r *common.BaseResp, err error)
+func (p *VerifyTokenResp) InitDefault() {
+}
 
-	UpdateFollowerCount(ctx context.Context, req *UpdateFollowerCountReq) (r *common.BaseResp, err error)
+var VerifyTokenResp_BaseResp_DEFAULT *common.BaseResp
 
-	VerifyToken(ctx context.Context, token string) (r bool, err error)
+func (p *VerifyTokenResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VerifyTokenResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
 
-type UserServiceRegisterArgs struct {
-	Req *RegisterReq `thrift:"req,1" frugal:"1,default,RegisterReq" json:"req"`
+func (p *VerifyTokenResp) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+func (p *VerifyTokenResp) GetSessionId() (v string) {
+	return p.SessionId
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+func (p *VerifyTokenResp) GetRoles() (v []string) {
+	return p.Roles
 }
-
-var UserServiceRegisterArgs_Req_DEFAULT *RegisterReq
-
-func (p *UserServiceRegisterArgs) GetReq() (v *RegisterReq) {
-	if !p.IsSetReq() {
-		return UserServiceRegisterArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *VerifyTokenResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
 }
-func (p *UserServiceRegisterArgs) SetReq(val *RegisterReq) {
-	p.Req = val
+func (p *VerifyTokenResp) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *VerifyTokenResp) SetSessionId(val string) {
+	p.SessionId = val
+}
+func (p *VerifyTokenResp) SetRoles(val []string) {
+	p.Roles = val
 }
 
-func (p *UserServiceRegisterArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *VerifyTokenResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *VerifyTokenResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("VerifyTokenResp(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_VerifyTokenResp = map[int16]string{
+	1: "BaseResp",
+	2: "userId",
+	3: "sessionId",
+	4: "roles",
 }
 
-type UserServiceRegisterResult struct {
-	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+type RefreshTokenReq struct {
+	RefreshToken string `thrift:"refreshToken,1" frugal:"1,default,string" json:"refreshToken"`
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+func NewRefreshTokenReq() *RefreshTokenReq {
+	return &RefreshTokenReq{}
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func (p *RefreshTokenReq) InitDefault() {
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *LoginRegisterResp
-
-func (p *UserServiceRegisterResult) GetSuccess() (v *LoginRegisterResp) {
-	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
-	}
-	return p.Success
-}
-func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginRegisterResp)
+func (p *RefreshTokenReq) GetRefreshToken() (v string) {
+	return p.RefreshToken
 }
-
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *RefreshTokenReq) SetRefreshToken(val string) {
+	p.RefreshToken = val
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *RefreshTokenReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("RefreshTokenReq(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
-	0: "success",
+var fieldIDToName_RefreshTokenReq = map[int16]string{
+	1: "refreshToken",
 }
 
-type UserServiceLoginArgs struct {
-	Req *LoginReq `thrift:"req,1" frugal:"1,default,LoginReq" json:"req"`
+type RefreshTokenResp struct {
+	BaseResp     *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Token        string           `thrift:"token,2" frugal:"2,default,string" json:"token"`
+	RefreshToken string           `thrift:"refreshToken,3" frugal:"3,default,string" json:"refreshToken"`
+	ExpiresAt    int64            `thrift:"expiresAt,4" frugal:"4,default,i64" json:"expiresAt"`
 }
 
-func NewUserServiceLoginArgs() *UserServiceLoginArgs {
-	return &UserServiceLoginArgs{}
+func NewRefreshTokenResp() *RefreshTokenResp {
+	return &RefreshTokenResp{}
 }
 
-func (p *UserServiceLoginArgs) InitDefault() {
+func (p *RefreshTokenResp) InitDefault() {
 }
 
-var UserServiceLoginArgs_Req_DEFAULT *LoginReq
+var RefreshTokenResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *UserServiceLoginArgs) GetReq() (v *LoginReq) {
-	if !p.IsSetReq() {
-		return UserServiceLoginArgs_Req_DEFAULT
+func (p *RefreshTokenResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RefreshTokenResp_BaseResp_DEFAULT
 	}
-	return p.Req
+	return p.BaseResp
 }
-func (p *UserServiceLoginArgs) SetReq(val *LoginReq) {
-	p.Req = val
+
+func (p *RefreshTokenResp) GetToken() (v string) {
+	return p.Token
 }
 
-func (p *UserServiceLoginArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *RefreshTokenResp) GetRefreshToken() (v string) {
+	return p.RefreshToken
 }
 
-func (p *UserServiceLoginArgs) String() string {
+func (p *RefreshTokenResp) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+func (p *RefreshTokenResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *RefreshTokenResp) SetToken(val string) {
+	p.Token = val
+}
+func (p *RefreshTokenResp) SetRefreshToken(val string) {
+	p.RefreshToken = val
+}
+func (p *RefreshTokenResp) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+
+func (p *RefreshTokenResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RefreshTokenResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("RefreshTokenResp(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceLoginArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_RefreshTokenResp = map[int16]string{
+	1: "BaseResp",
+	2: "token",
+	3: "refreshToken",
+	4: "expiresAt",
 }
 
-type UserServiceLoginResult struct {
-	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+type LogoutReq struct {
+	Token string `thrift:"token,1" frugal:"1,default,string" json:"token"`
 }
 
-func NewUserServiceLoginResult() *UserServiceLoginResult {
-	return &UserServiceLoginResult{}
+func NewLogoutReq() *LogoutReq {
+	return &LogoutReq{}
 }
 
-func (p *UserServiceLoginResult) InitDefault() {
+func (p *LogoutReq) InitDefault() {
 }
 
-var UserServiceLoginResult_Success_DEFAULT *LoginRegisterResp
-
-func (p *UserServiceLoginResult) GetSuccess() (v *LoginRegisterResp) {
-	if !p.IsSetSuccess() {
-		return UserServiceLoginResult_Success_DEFAULT
-	}
-	return p.Success
-}
-func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginRegisterResp)
+func (p *LogoutReq) GetToken() (v string) {
+	return p.Token
 }
-
-func (p *UserServiceLoginResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *LogoutReq) SetToken(val string) {
+	p.Token = val
 }
 
-func (p *UserServiceLoginResult) String() string {
+func (p *LogoutReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("LogoutReq(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceLoginResult = map[int16]string{
-	0: "success",
+var fieldIDToName_LogoutReq = map[int16]string{
+	1: "token",
 }
 
-type UserServiceGetUserInfoArgs struct {
-	Req *UserInfoReq `thrift:"req,1" frugal:"1,default,UserInfoReq" json:"req"`
+type LogoutAllReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewLogoutAllReq() *LogoutAllReq {
+	return &LogoutAllReq{}
+}
+
+func (p *LogoutAllReq) InitDefault() {
+}
+
+func (p *LogoutAllReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *LogoutAllReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *LogoutAllReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LogoutAllReq(%+v)", *p)
+}
+
+var fieldIDToName_LogoutAllReq = map[int16]string{
+	1: "userId",
+}
+
+type UserSession struct {
+	SessionId    string `thrift:"sessionId,1" frugal:"1,default,string" json:"sessionId"`
+	DeviceName   string `thrift:"deviceName,2" frugal:"2,default,string" json:"deviceName"`
+	Platform     string `thrift:"platform,3" frugal:"3,default,string" json:"platform"`
+	Ip           string `thrift:"ip,4" frugal:"4,default,string" json:"ip"`
+	UserAgent    string `thrift:"userAgent,5" frugal:"5,default,string" json:"userAgent"`
+	CreateTime   string `thrift:"createTime,6" frugal:"6,default,string" json:"createTime"`
+	LastSeenTime string `thrift:"lastSeenTime,7" frugal:"7,default,string" json:"lastSeenTime"`
+	IsCurrent    bool   `thrift:"isCurrent,8" frugal:"8,default,bool" json:"isCurrent"`
+}
+
+func NewUserSession() *UserSession {
+	return &UserSession{}
+}
+
+func (p *UserSession) InitDefault() {
+}
+
+func (p *UserSession) GetSessionId() (v string) {
+	return p.SessionId
+}
+
+func (p *UserSession) GetDeviceName() (v string) {
+	return p.DeviceName
+}
+
+func (p *UserSession) GetPlatform() (v string) {
+	return p.Platform
+}
+
+func (p *UserSession) GetIp() (v string) {
+	return p.Ip
+}
+
+func (p *UserSession) GetUserAgent() (v string) {
+	return p.UserAgent
+}
+
+func (p *UserSession) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+
+func (p *UserSession) GetLastSeenTime() (v string) {
+	return p.LastSeenTime
+}
+
+func (p *UserSession) GetIsCurrent() (v bool) {
+	return p.IsCurrent
+}
+func (p *UserSession) SetSessionId(val string) {
+	p.SessionId = val
+}
+func (p *UserSession) SetDeviceName(val string) {
+	p.DeviceName = val
+}
+func (p *UserSession) SetPlatform(val string) {
+	p.Platform = val
+}
+func (p *UserSession) SetIp(val string) {
+	p.Ip = val
+}
+func (p *UserSession) SetUserAgent(val string) {
+	p.UserAgent = val
+}
+func (p *UserSession) SetCreateTime(val string) {
+	p.CreateTime = val
+}
+func (p *UserSession) SetLastSeenTime(val string) {
+	p.LastSeenTime = val
+}
+func (p *UserSession) SetIsCurrent(val bool) {
+	p.IsCurrent = val
+}
+
+func (p *UserSession) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserSession(%+v)", *p)
+}
+
+var fieldIDToName_UserSession = map[int16]string{
+	1: "sessionId",
+	2: "deviceName",
+	3: "platform",
+	4: "ip",
+	5: "userAgent",
+	6: "createTime",
+	7: "lastSeenTime",
+	8: "isCurrent",
+}
+
+type SessionListReq struct {
+	UserId           int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentSessionId string `thrift:"currentSessionId,2" frugal:"2,default,string" json:"currentSessionId"`
+}
+
+func NewSessionListReq() *SessionListReq {
+	return &SessionListReq{}
+}
+
+func (p *SessionListReq) InitDefault() {
+}
+
+func (p *SessionListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SessionListReq) GetCurrentSessionId() (v string) {
+	return p.CurrentSessionId
+}
+func (p *SessionListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SessionListReq) SetCurrentSessionId(val string) {
+	p.CurrentSessionId = val
+}
+
+func (p *SessionListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionListReq(%+v)", *p)
+}
+
+var fieldIDToName_SessionListReq = map[int16]string{
+	1: "userId",
+	2: "currentSessionId",
+}
+
+type SessionListResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Sessions []*UserSession   `thrift:"sessions,2" frugal:"2,default,list<UserSession>" json:"sessions"`
+}
+
+func NewSessionListResp() *SessionListResp {
+	return &SessionListResp{}
+}
+
+func (p *SessionListResp) InitDefault() {
+}
+
+var SessionListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SessionListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SessionListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *SessionListResp) GetSessions() (v []*UserSession) {
+	return p.Sessions
+}
+func (p *SessionListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *SessionListResp) SetSessions(val []*UserSession) {
+	p.Sessions = val
+}
+
+func (p *SessionListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SessionListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionListResp(%+v)", *p)
+}
+
+var fieldIDToName_SessionListResp = map[int16]string{
+	1: "BaseResp",
+	2: "sessions",
+}
+
+type RevokeSessionReq struct {
+	UserId    int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	SessionId string `thrift:"sessionId,2" frugal:"2,default,string" json:"sessionId"`
+}
+
+func NewRevokeSessionReq() *RevokeSessionReq {
+	return &RevokeSessionReq{}
+}
+
+func (p *RevokeSessionReq) InitDefault() {
+}
+
+func (p *RevokeSessionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RevokeSessionReq) GetSessionId() (v string) {
+	return p.SessionId
+}
+func (p *RevokeSessionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RevokeSessionReq) SetSessionId(val string) {
+	p.SessionId = val
+}
+
+func (p *RevokeSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeSessionReq(%+v)", *p)
+}
+
+var fieldIDToName_RevokeSessionReq = map[int16]string{
+	1: "userId",
+	2: "sessionId",
+}
+
+type TwoFactorLoginReq struct {
+	ChallengeToken string `thrift:"challengeToken,1" frugal:"1,default,string" json:"challengeToken"`
+	Code           string `thrift:"code,2" frugal:"2,default,string" json:"code"`
+}
+
+func NewTwoFactorLoginReq() *TwoFactorLoginReq {
+	return &TwoFactorLoginReq{}
+}
+
+func (p *TwoFactorLoginReq) InitDefault() {
+}
+
+func (p *TwoFactorLoginReq) GetChallengeToken() (v string) {
+	return p.ChallengeToken
+}
+
+func (p *TwoFactorLoginReq) GetCode() (v string) {
+	return p.Code
+}
+func (p *TwoFactorLoginReq) SetChallengeToken(val string) {
+	p.ChallengeToken = val
+}
+func (p *TwoFactorLoginReq) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *TwoFactorLoginReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorLoginReq(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorLoginReq = map[int16]string{
+	1: "challengeToken",
+	2: "code",
+}
+
+type TwoFactorSetupReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewTwoFactorSetupReq() *TwoFactorSetupReq {
+	return &TwoFactorSetupReq{}
+}
+
+func (p *TwoFactorSetupReq) InitDefault() {
+}
+
+func (p *TwoFactorSetupReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *TwoFactorSetupReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *TwoFactorSetupReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorSetupReq(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorSetupReq = map[int16]string{
+	1: "userId",
+}
+
+type TwoFactorSetupResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Secret     string           `thrift:"secret,2" frugal:"2,default,string" json:"secret"`
+	OtpauthUri string           `thrift:"otpauthUri,3" frugal:"3,default,string" json:"otpauthUri"`
+}
+
+func NewTwoFactorSetupResp() *TwoFactorSetupResp {
+	return &TwoFactorSetupResp{}
+}
+
+func (p *TwoFactorSetupResp) InitDefault() {
+}
+
+var TwoFactorSetupResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *TwoFactorSetupResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return TwoFactorSetupResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *TwoFactorSetupResp) GetSecret() (v string) {
+	return p.Secret
+}
+
+func (p *TwoFactorSetupResp) GetOtpauthUri() (v string) {
+	return p.OtpauthUri
+}
+func (p *TwoFactorSetupResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *TwoFactorSetupResp) SetSecret(val string) {
+	p.Secret = val
+}
+func (p *TwoFactorSetupResp) SetOtpauthUri(val string) {
+	p.OtpauthUri = val
+}
+
+func (p *TwoFactorSetupResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *TwoFactorSetupResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorSetupResp(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorSetupResp = map[int16]string{
+	1: "BaseResp",
+	2: "secret",
+	3: "otpauthUri",
+}
+
+type TwoFactorCodeReq struct {
+	UserId int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Code   string `thrift:"code,2" frugal:"2,default,string" json:"code"`
+}
+
+func NewTwoFactorCodeReq() *TwoFactorCodeReq {
+	return &TwoFactorCodeReq{}
+}
+
+func (p *TwoFactorCodeReq) InitDefault() {
+}
+
+func (p *TwoFactorCodeReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *TwoFactorCodeReq) GetCode() (v string) {
+	return p.Code
+}
+func (p *TwoFactorCodeReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *TwoFactorCodeReq) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *TwoFactorCodeReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorCodeReq(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorCodeReq = map[int16]string{
+	1: "userId",
+	2: "code",
+}
+
+type RecoveryCodesResp struct {
+	BaseResp      *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	RecoveryCodes []string         `thrift:"recoveryCodes,2" frugal:"2,default,list<string>" json:"recoveryCodes"`
+}
+
+func NewRecoveryCodesResp() *RecoveryCodesResp {
+	return &RecoveryCodesResp{}
+}
+
+func (p *RecoveryCodesResp) InitDefault() {
+}
+
+var RecoveryCodesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *RecoveryCodesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RecoveryCodesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *RecoveryCodesResp) GetRecoveryCodes() (v []string) {
+	return p.RecoveryCodes
+}
+func (p *RecoveryCodesResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *RecoveryCodesResp) SetRecoveryCodes(val []string) {
+	p.RecoveryCodes = val
+}
+
+func (p *RecoveryCodesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RecoveryCodesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecoveryCodesResp(%+v)", *p)
+}
+
+var fieldIDToName_RecoveryCodesResp = map[int16]string{
+	1: "BaseResp",
+	2: "recoveryCodes",
+}
+
+type TwoFactorStatusReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewTwoFactorStatusReq() *TwoFactorStatusReq {
+	return &TwoFactorStatusReq{}
+}
+
+func (p *TwoFactorStatusReq) InitDefault() {
+}
+
+func (p *TwoFactorStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *TwoFactorStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *TwoFactorStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorStatusReq = map[int16]string{
+	1: "userId",
+}
+
+type TwoFactorStatusResp struct {
+	BaseResp               *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Enabled                bool             `thrift:"enabled,2" frugal:"2,default,bool" json:"enabled"`
+	RemainingRecoveryCodes int64            `thrift:"remainingRecoveryCodes,3" frugal:"3,default,i64" json:"remainingRecoveryCodes"`
+}
+
+func NewTwoFactorStatusResp() *TwoFactorStatusResp {
+	return &TwoFactorStatusResp{}
+}
+
+func (p *TwoFactorStatusResp) InitDefault() {
+}
+
+var TwoFactorStatusResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *TwoFactorStatusResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return TwoFactorStatusResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *TwoFactorStatusResp) GetEnabled() (v bool) {
+	return p.Enabled
+}
+
+func (p *TwoFactorStatusResp) GetRemainingRecoveryCodes() (v int64) {
+	return p.RemainingRecoveryCodes
+}
+func (p *TwoFactorStatusResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *TwoFactorStatusResp) SetEnabled(val bool) {
+	p.Enabled = val
+}
+func (p *TwoFactorStatusResp) SetRemainingRecoveryCodes(val int64) {
+	p.RemainingRecoveryCodes = val
+}
+
+func (p *TwoFactorStatusResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *TwoFactorStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TwoFactorStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_TwoFactorStatusResp = map[int16]string{
+	1: "BaseResp",
+	2: "enabled",
+	3: "remainingRecoveryCodes",
+}
+
+type OAuthStartReq struct {
+	Provider       string `thrift:"provider,1" frugal:"1,default,string" json:"provider"`
+	UserId         int64  `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	BrowserBinding string `thrift:"browserBinding,3" frugal:"3,default,string" json:"browserBinding"`
+}
+
+func NewOAuthStartReq() *OAuthStartReq {
+	return &OAuthStartReq{}
+}
+
+func (p *OAuthStartReq) InitDefault() {
+}
+
+func (p *OAuthStartReq) GetProvider() (v string) {
+	return p.Provider
+}
+
+func (p *OAuthStartReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *OAuthStartReq) GetBrowserBinding() (v string) {
+	return p.BrowserBinding
+}
+func (p *OAuthStartReq) SetProvider(val string) {
+	p.Provider = val
+}
+func (p *OAuthStartReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *OAuthStartReq) SetBrowserBinding(val string) {
+	p.BrowserBinding = val
+}
+
+func (p *OAuthStartReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OAuthStartReq(%+v)", *p)
+}
+
+var fieldIDToName_OAuthStartReq = map[int16]string{
+	1: "provider",
+	2: "userId",
+	3: "browserBinding",
+}
+
+type OAuthStartResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	AuthUrl  string           `thrift:"authUrl,2" frugal:"2,default,string" json:"authUrl"`
+}
+
+func NewOAuthStartResp() *OAuthStartResp {
+	return &OAuthStartResp{}
+}
+
+func (p *OAuthStartResp) InitDefault() {
+}
+
+var OAuthStartResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *OAuthStartResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return OAuthStartResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *OAuthStartResp) GetAuthUrl() (v string) {
+	return p.AuthUrl
+}
+func (p *OAuthStartResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *OAuthStartResp) SetAuthUrl(val string) {
+	p.AuthUrl = val
+}
+
+func (p *OAuthStartResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *OAuthStartResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OAuthStartResp(%+v)", *p)
+}
+
+var fieldIDToName_OAuthStartResp = map[int16]string{
+	1: "BaseResp",
+	2: "authUrl",
+}
+
+type OAuthCallbackReq struct {
+	Provider       string      `thrift:"provider,1" frugal:"1,default,string" json:"provider"`
+	State          string      `thrift:"state,2" frugal:"2,default,string" json:"state"`
+	Code           string      `thrift:"code,3" frugal:"3,default,string" json:"code"`
+	Device         *DeviceInfo `thrift:"device,4,optional" frugal:"4,optional,DeviceInfo" json:"device,omitempty"`
+	BrowserBinding string      `thrift:"browserBinding,5" frugal:"5,default,string" json:"browserBinding"`
+}
+
+func NewOAuthCallbackReq() *OAuthCallbackReq {
+	return &OAuthCallbackReq{}
+}
+
+func (p *OAuthCallbackReq) InitDefault() {
+}
+
+func (p *OAuthCallbackReq) GetProvider() (v string) {
+	return p.Provider
+}
+
+func (p *OAuthCallbackReq) GetState() (v string) {
+	return p.State
+}
+
+func (p *OAuthCallbackReq) GetCode() (v string) {
+	return p.Code
+}
+
+var OAuthCallbackReq_Device_DEFAULT *DeviceInfo
+
+func (p *OAuthCallbackReq) GetDevice() (v *DeviceInfo) {
+	if !p.IsSetDevice() {
+		return OAuthCallbackReq_Device_DEFAULT
+	}
+	return p.Device
+}
+
+func (p *OAuthCallbackReq) GetBrowserBinding() (v string) {
+	return p.BrowserBinding
+}
+func (p *OAuthCallbackReq) SetProvider(val string) {
+	p.Provider = val
+}
+func (p *OAuthCallbackReq) SetState(val string) {
+	p.State = val
+}
+func (p *OAuthCallbackReq) SetCode(val string) {
+	p.Code = val
+}
+func (p *OAuthCallbackReq) SetDevice(val *DeviceInfo) {
+	p.Device = val
+}
+func (p *OAuthCallbackReq) SetBrowserBinding(val string) {
+	p.BrowserBinding = val
+}
+
+func (p *OAuthCallbackReq) IsSetDevice() bool {
+	return p.Device != nil
+}
+
+func (p *OAuthCallbackReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OAuthCallbackReq(%+v)", *p)
+}
+
+var fieldIDToName_OAuthCallbackReq = map[int16]string{
+	1: "provider",
+	2: "state",
+	3: "code",
+	4: "device",
+	5: "browserBinding",
+}
+
+type Identity struct {
+	Provider   string `thrift:"provider,1" frugal:"1,default,string" json:"provider"`
+	Email      string `thrift:"email,2" frugal:"2,default,string" json:"email"`
+	Name       string `thrift:"name,3" frugal:"3,default,string" json:"name"`
+	CreateTime string `thrift:"createTime,4" frugal:"4,default,string" json:"createTime"`
+}
+
+func NewIdentity() *Identity {
+	return &Identity{}
+}
+
+func (p *Identity) InitDefault() {
+}
+
+func (p *Identity) GetProvider() (v string) {
+	return p.Provider
+}
+
+func (p *Identity) GetEmail() (v string) {
+	return p.Email
+}
+
+func (p *Identity) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Identity) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+func (p *Identity) SetProvider(val string) {
+	p.Provider = val
+}
+func (p *Identity) SetEmail(val string) {
+	p.Email = val
+}
+func (p *Identity) SetName(val string) {
+	p.Name = val
+}
+func (p *Identity) SetCreateTime(val string) {
+	p.CreateTime = val
+}
+
+func (p *Identity) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Identity(%+v)", *p)
+}
+
+var fieldIDToName_Identity = map[int16]string{
+	1: "provider",
+	2: "email",
+	3: "name",
+	4: "createTime",
+}
+
+type IdentityListReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewIdentityListReq() *IdentityListReq {
+	return &IdentityListReq{}
+}
+
+func (p *IdentityListReq) InitDefault() {
+}
+
+func (p *IdentityListReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *IdentityListReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *IdentityListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityListReq(%+v)", *p)
+}
+
+var fieldIDToName_IdentityListReq = map[int16]string{
+	1: "userId",
+}
+
+type IdentityListResp struct {
+	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Identities []*Identity      `thrift:"identities,2" frugal:"2,default,list<Identity>" json:"identities"`
+}
+
+func NewIdentityListResp() *IdentityListResp {
+	return &IdentityListResp{}
+}
+
+func (p *IdentityListResp) InitDefault() {
+}
+
+var IdentityListResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *IdentityListResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return IdentityListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *IdentityListResp) GetIdentities() (v []*Identity) {
+	return p.Identities
+}
+func (p *IdentityListResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *IdentityListResp) SetIdentities(val []*Identity) {
+	p.Identities = val
+}
+
+func (p *IdentityListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *IdentityListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityListResp(%+v)", *p)
+}
+
+var fieldIDToName_IdentityListResp = map[int16]string{
+	1: "BaseResp",
+	2: "identities",
+}
+
+type UnlinkIdentityReq struct {
+	UserId   int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Provider string `thrift:"provider,2" frugal:"2,default,string" json:"provider"`
+}
+
+func NewUnlinkIdentityReq() *UnlinkIdentityReq {
+	return &UnlinkIdentityReq{}
+}
+
+func (p *UnlinkIdentityReq) InitDefault() {
+}
+
+func (p *UnlinkIdentityReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UnlinkIdentityReq) GetProvider() (v string) {
+	return p.Provider
+}
+func (p *UnlinkIdentityReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UnlinkIdentityReq) SetProvider(val string) {
+	p.Provider = val
+}
+
+func (p *UnlinkIdentityReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnlinkIdentityReq(%+v)", *p)
+}
+
+var fieldIDToName_UnlinkIdentityReq = map[int16]string{
+	1: "userId",
+	2: "provider",
+}
+
+type AccountDeletionReq struct {
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Password      string  `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	TwoFactorCode *string `thrift:"twoFactorCode,3,optional" frugal:"3,optional,string" json:"twoFactorCode,omitempty"`
+}
+
+func NewAccountDeletionReq() *AccountDeletionReq {
+	return &AccountDeletionReq{}
+}
+
+func (p *AccountDeletionReq) InitDefault() {
+}
+
+func (p *AccountDeletionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *AccountDeletionReq) GetPassword() (v string) {
+	return p.Password
+}
+
+var AccountDeletionReq_TwoFactorCode_DEFAULT string
+
+func (p *AccountDeletionReq) GetTwoFactorCode() (v string) {
+	if !p.IsSetTwoFactorCode() {
+		return AccountDeletionReq_TwoFactorCode_DEFAULT
+	}
+	return *p.TwoFactorCode
+}
+func (p *AccountDeletionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *AccountDeletionReq) SetPassword(val string) {
+	p.Password = val
+}
+func (p *AccountDeletionReq) SetTwoFactorCode(val *string) {
+	p.TwoFactorCode = val
+}
+
+func (p *AccountDeletionReq) IsSetTwoFactorCode() bool {
+	return p.TwoFactorCode != nil
+}
+
+func (p *AccountDeletionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AccountDeletionReq(%+v)", *p)
+}
+
+var fieldIDToName_AccountDeletionReq = map[int16]string{
+	1: "userId",
+	2: "password",
+	3: "twoFactorCode",
+}
+
+type AccountDeletionStatusReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewAccountDeletionStatusReq() *AccountDeletionStatusReq {
+	return &AccountDeletionStatusReq{}
+}
+
+func (p *AccountDeletionStatusReq) InitDefault() {
+}
+
+func (p *AccountDeletionStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *AccountDeletionStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *AccountDeletionStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AccountDeletionStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_AccountDeletionStatusReq = map[int16]string{
+	1: "userId",
+}
+
+type AccountDeletionResp struct {
+	BaseResp      *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Status        string           `thrift:"status,2" frugal:"2,default,string" json:"status"`
+	RequestTime   string           `thrift:"requestTime,3" frugal:"3,default,string" json:"requestTime"`
+	ScheduledTime string           `thrift:"scheduledTime,4" frugal:"4,default,string" json:"scheduledTime"`
+}
+
+func NewAccountDeletionResp() *AccountDeletionResp {
+	return &AccountDeletionResp{}
+}
+
+func (p *AccountDeletionResp) InitDefault() {
+}
+
+var AccountDeletionResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *AccountDeletionResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AccountDeletionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AccountDeletionResp) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *AccountDeletionResp) GetRequestTime() (v string) {
+	return p.RequestTime
+}
+
+func (p *AccountDeletionResp) GetScheduledTime() (v string) {
+	return p.ScheduledTime
+}
+func (p *AccountDeletionResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *AccountDeletionResp) SetStatus(val string) {
+	p.Status = val
+}
+func (p *AccountDeletionResp) SetRequestTime(val string) {
+	p.RequestTime = val
+}
+func (p *AccountDeletionResp) SetScheduledTime(val string) {
+	p.ScheduledTime = val
+}
+
+func (p *AccountDeletionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AccountDeletionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AccountDeletionResp(%+v)", *p)
+}
+
+var fieldIDToName_AccountDeletionResp = map[int16]string{
+	1: "BaseResp",
+	2: "status",
+	3: "requestTime",
+	4: "scheduledTime",
+}
+
+type CancelAccountDeletionReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewCancelAccountDeletionReq() *CancelAccountDeletionReq {
+	return &CancelAccountDeletionReq{}
+}
+
+func (p *CancelAccountDeletionReq) InitDefault() {
+}
+
+func (p *CancelAccountDeletionReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *CancelAccountDeletionReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *CancelAccountDeletionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelAccountDeletionReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelAccountDeletionReq = map[int16]string{
+	1: "userId",
+}
+
+type DataExportReq struct {
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	TwoFactorCode *string `thrift:"twoFactorCode,2,optional" frugal:"2,optional,string" json:"twoFactorCode,omitempty"`
+}
+
+func NewDataExportReq() *DataExportReq {
+	return &DataExportReq{}
+}
+
+func (p *DataExportReq) InitDefault() {
+}
+
+func (p *DataExportReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var DataExportReq_TwoFactorCode_DEFAULT string
+
+func (p *DataExportReq) GetTwoFactorCode() (v string) {
+	if !p.IsSetTwoFactorCode() {
+		return DataExportReq_TwoFactorCode_DEFAULT
+	}
+	return *p.TwoFactorCode
+}
+func (p *DataExportReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *DataExportReq) SetTwoFactorCode(val *string) {
+	p.TwoFactorCode = val
+}
+
+func (p *DataExportReq) IsSetTwoFactorCode() bool {
+	return p.TwoFactorCode != nil
+}
+
+func (p *DataExportReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataExportReq(%+v)", *p)
+}
+
+var fieldIDToName_DataExportReq = map[int16]string{
+	1: "userId",
+	2: "twoFactorCode",
+}
+
+type DataExportStatusReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewDataExportStatusReq() *DataExportStatusReq {
+	return &DataExportStatusReq{}
+}
+
+func (p *DataExportStatusReq) InitDefault() {
+}
+
+func (p *DataExportStatusReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *DataExportStatusReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *DataExportStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataExportStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_DataExportStatusReq = map[int16]string{
+	1: "userId",
+}
+
+type DataExport struct {
+	ExportId     int64  `thrift:"exportId,1" frugal:"1,default,i64" json:"exportId"`
+	Status       string `thrift:"status,2" frugal:"2,default,string" json:"status"`
+	CreateTime   string `thrift:"createTime,3" frugal:"3,default,string" json:"createTime"`
+	CompleteTime string `thrift:"completeTime,4" frugal:"4,default,string" json:"completeTime"`
+	ExpireTime   string `thrift:"expireTime,5" frugal:"5,default,string" json:"expireTime"`
+	DownloadUrl  string `thrift:"downloadUrl,6" frugal:"6,default,string" json:"downloadUrl"`
+}
+
+func NewDataExport() *DataExport {
+	return &DataExport{}
+}
+
+func (p *DataExport) InitDefault() {
+}
+
+func (p *DataExport) GetExportId() (v int64) {
+	return p.ExportId
+}
+
+func (p *DataExport) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *DataExport) GetCreateTime() (v string) {
+	return p.CreateTime
+}
+
+func (p *DataExport) GetCompleteTime() (v string) {
+	return p.CompleteTime
+}
+
+func (p *DataExport) GetExpireTime() (v string) {
+	return p.ExpireTime
+}
+
+func (p *DataExport) GetDownloadUrl() (v string) {
+	return p.DownloadUrl
+}
+func (p *DataExport) SetExportId(val int64) {
+	p.ExportId = val
+}
+func (p *DataExport) SetStatus(val string) {
+	p.Status = val
+}
+func (p *DataExport) SetCreateTime(val string) {
+	p.CreateTime = val
+}
+func (p *DataExport) SetCompleteTime(val string) {
+	p.CompleteTime = val
+}
+func (p *DataExport) SetExpireTime(val string) {
+	p.ExpireTime = val
+}
+func (p *DataExport) SetDownloadUrl(val string) {
+	p.DownloadUrl = val
+}
+
+func (p *DataExport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataExport(%+v)", *p)
+}
+
+var fieldIDToName_DataExport = map[int16]string{
+	1: "exportId",
+	2: "status",
+	3: "createTime",
+	4: "completeTime",
+	5: "expireTime",
+	6: "downloadUrl",
+}
+
+type DataExportResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Export   *DataExport      `thrift:"export,2" frugal:"2,default,DataExport" json:"export"`
+}
+
+func NewDataExportResp() *DataExportResp {
+	return &DataExportResp{}
+}
+
+func (p *DataExportResp) InitDefault() {
+}
+
+var DataExportResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DataExportResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DataExportResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var DataExportResp_Export_DEFAULT *DataExport
+
+func (p *DataExportResp) GetExport() (v *DataExport) {
+	if !p.IsSetExport() {
+		return DataExportResp_Export_DEFAULT
+	}
+	return p.Export
+}
+func (p *DataExportResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *DataExportResp) SetExport(val *DataExport) {
+	p.Export = val
+}
+
+func (p *DataExportResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DataExportResp) IsSetExport() bool {
+	return p.Export != nil
+}
+
+func (p *DataExportResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataExportResp(%+v)", *p)
+}
+
+var fieldIDToName_DataExportResp = map[int16]string{
+	1: "BaseResp",
+	2: "export",
+}
+
+type UserRolesReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewUserRolesReq() *UserRolesReq {
+	return &UserRolesReq{}
+}
+
+func (p *UserRolesReq) InitDefault() {
+}
+
+func (p *UserRolesReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *UserRolesReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *UserRolesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserRolesReq(%+v)", *p)
+}
+
+var fieldIDToName_UserRolesReq = map[int16]string{
+	1: "userId",
+}
+
+type UserRolesResp struct {
+	BaseResp    *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Roles       []string         `thrift:"roles,2" frugal:"2,default,list<string>" json:"roles"`
+	Permissions []string         `thrift:"permissions,3" frugal:"3,default,list<string>" json:"permissions"`
+}
+
+func NewUserRolesResp() *UserRolesResp {
+	return &UserRolesResp{}
+}
+
+func (p *UserRolesResp) InitDefault() {
+}
+
+var UserRolesResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UserRolesResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UserRolesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UserRolesResp) GetRoles() (v []string) {
+	return p.Roles
+}
+
+func (p *UserRolesResp) GetPermissions() (v []string) {
+	return p.Permissions
+}
+func (p *UserRolesResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *UserRolesResp) SetRoles(val []string) {
+	p.Roles = val
+}
+func (p *UserRolesResp) SetPermissions(val []string) {
+	p.Permissions = val
+}
+
+func (p *UserRolesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UserRolesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserRolesResp(%+v)", *p)
+}
+
+var fieldIDToName_UserRolesResp = map[int16]string{
+	1: "BaseResp",
+	2: "roles",
+	3: "permissions",
+}
+
+type RoleReq struct {
+	OperatorId int64  `thrift:"operatorId,1" frugal:"1,default,i64" json:"operatorId"`
+	UserId     int64  `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	Role       string `thrift:"role,3" frugal:"3,default,string" json:"role"`
+}
+
+func NewRoleReq() *RoleReq {
+	return &RoleReq{}
+}
+
+func (p *RoleReq) InitDefault() {
+}
+
+func (p *RoleReq) GetOperatorId() (v int64) {
+	return p.OperatorId
+}
+
+func (p *RoleReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RoleReq) GetRole() (v string) {
+	return p.Role
+}
+func (p *RoleReq) SetOperatorId(val int64) {
+	p.OperatorId = val
+}
+func (p *RoleReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RoleReq) SetRole(val string) {
+	p.Role = val
+}
+
+func (p *RoleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoleReq(%+v)", *p)
+}
+
+var fieldIDToName_RoleReq = map[int16]string{
+	1: "operatorId",
+	2: "userId",
+	3: "role",
+}
+
+type BanUserReq struct {
+	OperatorId int64 `thrift:"operatorId,1" frugal:"1,default,i64" json:"operatorId"`
+	UserId     int64 `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	Banned     bool  `thrift:"banned,3" frugal:"3,default,bool" json:"banned"`
+}
+
+func NewBanUserReq() *BanUserReq {
+	return &BanUserReq{}
+}
+
+func (p *BanUserReq) InitDefault() {
+}
+
+func (p *BanUserReq) GetOperatorId() (v int64) {
+	return p.OperatorId
+}
+
+func (p *BanUserReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *BanUserReq) GetBanned() (v bool) {
+	return p.Banned
+}
+func (p *BanUserReq) SetOperatorId(val int64) {
+	p.OperatorId = val
+}
+func (p *BanUserReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BanUserReq) SetBanned(val bool) {
+	p.Banned = val
+}
+
+func (p *BanUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BanUserReq(%+v)", *p)
+}
+
+var fieldIDToName_BanUserReq = map[int16]string{
+	1: "operatorId",
+	2: "userId",
+	3: "banned",
+}
+
+type UserLevelReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewUserLevelReq() *UserLevelReq {
+	return &UserLevelReq{}
+}
+
+func (p *UserLevelReq) InitDefault() {
+}
+
+func (p *UserLevelReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *UserLevelReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *UserLevelReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserLevelReq(%+v)", *p)
+}
+
+var fieldIDToName_UserLevelReq = map[int16]string{
+	1: "userId",
+}
+
+type UserLevelResp struct {
+	BaseResp            *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Level               int32            `thrift:"level,2" frugal:"2,default,i32" json:"level"`
+	Experience          int64            `thrift:"experience,3" frugal:"3,default,i64" json:"experience"`
+	NextLevelExperience int64            `thrift:"nextLevelExperience,4" frugal:"4,default,i64" json:"nextLevelExperience"`
+	TodayExperience     map[string]int64 `thrift:"todayExperience,5" frugal:"5,default,map<string:i64>" json:"todayExperience"`
+}
+
+func NewUserLevelResp() *UserLevelResp {
+	return &UserLevelResp{}
+}
+
+func (p *UserLevelResp) InitDefault() {
+}
+
+var UserLevelResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UserLevelResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UserLevelResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UserLevelResp) GetLevel() (v int32) {
+	return p.Level
+}
+
+func (p *UserLevelResp) GetExperience() (v int64) {
+	return p.Experience
+}
+
+func (p *UserLevelResp) GetNextLevelExperience() (v int64) {
+	return p.NextLevelExperience
+}
+
+func (p *UserLevelResp) GetTodayExperience() (v map[string]int64) {
+	return p.TodayExperience
+}
+func (p *UserLevelResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *UserLevelResp) SetLevel(val int32) {
+	p.Level = val
+}
+func (p *UserLevelResp) SetExperience(val int64) {
+	p.Experience = val
+}
+func (p *UserLevelResp) SetNextLevelExperience(val int64) {
+	p.NextLevelExperience = val
+}
+func (p *UserLevelResp) SetTodayExperience(val map[string]int64) {
+	p.TodayExperience = val
+}
+
+func (p *UserLevelResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UserLevelResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserLevelResp(%+v)", *p)
+}
+
+var fieldIDToName_UserLevelResp = map[int16]string{
+	1: "BaseResp",
+	2: "level",
+	3: "experience",
+	4: "nextLevelExperience",
+	5: "todayExperience",
+}
+
+type UserSettings struct {
+	DmPermission      string `thrift:"dmPermission,1" frugal:"1,default,string" json:"dmPermission"`
+	CommentPermission string `thrift:"commentPermission,2" frugal:"2,default,string" json:"commentPermission"`
+	LikedListPublic   bool   `thrift:"likedListPublic,3" frugal:"3,default,bool" json:"likedListPublic"`
+	StarredListPublic bool   `thrift:"starredListPublic,4" frugal:"4,default,bool" json:"starredListPublic"`
+	FollowListVisible bool   `thrift:"followListVisible,5" frugal:"5,default,bool" json:"followListVisible"`
+	AllowRemix        bool   `thrift:"allowRemix,6" frugal:"6,default,bool" json:"allowRemix"`
+	ShowOnlineStatus  bool   `thrift:"showOnlineStatus,7" frugal:"7,default,bool" json:"showOnlineStatus"`
+	Version           int64  `thrift:"version,8" frugal:"8,default,i64" json:"version"`
+}
+
+func NewUserSettings() *UserSettings {
+	return &UserSettings{}
+}
+
+func (p *UserSettings) InitDefault() {
+}
+
+func (p *UserSettings) GetDmPermission() (v string) {
+	return p.DmPermission
+}
+
+func (p *UserSettings) GetCommentPermission() (v string) {
+	return p.CommentPermission
+}
+
+func (p *UserSettings) GetLikedListPublic() (v bool) {
+	return p.LikedListPublic
+}
+
+func (p *UserSettings) GetStarredListPublic() (v bool) {
+	return p.StarredListPublic
+}
+
+func (p *UserSettings) GetFollowListVisible() (v bool) {
+	return p.FollowListVisible
+}
+
+func (p *UserSettings) GetAllowRemix() (v bool) {
+	return p.AllowRemix
+}
+
+func (p *UserSettings) GetShowOnlineStatus() (v bool) {
+	return p.ShowOnlineStatus
+}
+
+func (p *UserSettings) GetVersion() (v int64) {
+	return p.Version
+}
+func (p *UserSettings) SetDmPermission(val string) {
+	p.DmPermission = val
+}
+func (p *UserSettings) SetCommentPermission(val string) {
+	p.CommentPermission = val
+}
+func (p *UserSettings) SetLikedListPublic(val bool) {
+	p.LikedListPublic = val
+}
+func (p *UserSettings) SetStarredListPublic(val bool) {
+	p.StarredListPublic = val
+}
+func (p *UserSettings) SetFollowListVisible(val bool) {
+	p.FollowListVisible = val
+}
+func (p *UserSettings) SetAllowRemix(val bool) {
+	p.AllowRemix = val
+}
+func (p *UserSettings) SetShowOnlineStatus(val bool) {
+	p.ShowOnlineStatus = val
+}
+func (p *UserSettings) SetVersion(val int64) {
+	p.Version = val
+}
+
+func (p *UserSettings) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserSettings(%+v)", *p)
+}
+
+var fieldIDToName_UserSettings = map[int16]string{
+	1: "dmPermission",
+	2: "commentPermission",
+	3: "likedListPublic",
+	4: "starredListPublic",
+	5: "followListVisible",
+	6: "allowRemix",
+	7: "showOnlineStatus",
+	8: "version",
+}
+
+type GetSettingsReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewGetSettingsReq() *GetSettingsReq {
+	return &GetSettingsReq{}
+}
+
+func (p *GetSettingsReq) InitDefault() {
+}
+
+func (p *GetSettingsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetSettingsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *GetSettingsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetSettingsReq(%+v)", *p)
+}
+
+var fieldIDToName_GetSettingsReq = map[int16]string{
+	1: "userId",
+}
+
+type UpdateSettingsReq struct {
+	UserId            int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	DmPermission      *string `thrift:"dmPermission,2,optional" frugal:"2,optional,string" json:"dmPermission,omitempty"`
+	CommentPermission *string `thrift:"commentPermission,3,optional" frugal:"3,optional,string" json:"commentPermission,omitempty"`
+	LikedListPublic   *bool   `thrift:"likedListPublic,4,optional" frugal:"4,optional,bool" json:"likedListPublic,omitempty"`
+	StarredListPublic *bool   `thrift:"starredListPublic,5,optional" frugal:"5,optional,bool" json:"starredListPublic,omitempty"`
+	FollowListVisible *bool   `thrift:"followListVisible,6,optional" frugal:"6,optional,bool" json:"followListVisible,omitempty"`
+	AllowRemix        *bool   `thrift:"allowRemix,7,optional" frugal:"7,optional,bool" json:"allowRemix,omitempty"`
+	ShowOnlineStatus  *bool   `thrift:"showOnlineStatus,8,optional" frugal:"8,optional,bool" json:"showOnlineStatus,omitempty"`
+	Version           *int64  `thrift:"version,9,optional" frugal:"9,optional,i64" json:"version,omitempty"`
+}
+
+func NewUpdateSettingsReq() *UpdateSettingsReq {
+	return &UpdateSettingsReq{}
+}
+
+func (p *UpdateSettingsReq) InitDefault() {
+}
+
+func (p *UpdateSettingsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var UpdateSettingsReq_DmPermission_DEFAULT string
+
+func (p *UpdateSettingsReq) GetDmPermission() (v string) {
+	if !p.IsSetDmPermission() {
+		return UpdateSettingsReq_DmPermission_DEFAULT
+	}
+	return *p.DmPermission
+}
+
+var UpdateSettingsReq_CommentPermission_DEFAULT string
+
+func (p *UpdateSettingsReq) GetCommentPermission() (v string) {
+	if !p.IsSetCommentPermission() {
+		return UpdateSettingsReq_CommentPermission_DEFAULT
+	}
+	return *p.CommentPermission
+}
+
+var UpdateSettingsReq_LikedListPublic_DEFAULT bool
+
+func (p *UpdateSettingsReq) GetLikedListPublic() (v bool) {
+	if !p.IsSetLikedListPublic() {
+		return UpdateSettingsReq_LikedListPublic_DEFAULT
+	}
+	return *p.LikedListPublic
+}
+
+var UpdateSettingsReq_StarredListPublic_DEFAULT bool
+
+func (p *UpdateSettingsReq) GetStarredListPublic() (v bool) {
+	if !p.IsSetStarredListPublic() {
+		return UpdateSettingsReq_StarredListPublic_DEFAULT
+	}
+	return *p.StarredListPublic
+}
+
+var UpdateSettingsReq_FollowListVisible_DEFAULT bool
+
+func (p *UpdateSettingsReq) GetFollowListVisible() (v bool) {
+	if !p.IsSetFollowListVisible() {
+		return UpdateSettingsReq_FollowListVisible_DEFAULT
+	}
+	return *p.FollowListVisible
+}
+
+var UpdateSettingsReq_AllowRemix_DEFAULT bool
+
+func (p *UpdateSettingsReq) GetAllowRemix() (v bool) {
+	if !p.IsSetAllowRemix() {
+		return UpdateSettingsReq_AllowRemix_DEFAULT
+	}
+	return *p.AllowRemix
+}
+
+var UpdateSettingsReq_ShowOnlineStatus_DEFAULT bool
+
+func (p *UpdateSettingsReq) GetShowOnlineStatus() (v bool) {
+	if !p.IsSetShowOnlineStatus() {
+		return UpdateSettingsReq_ShowOnlineStatus_DEFAULT
+	}
+	return *p.ShowOnlineStatus
+}
+
+var UpdateSettingsReq_Version_DEFAULT int64
+
+func (p *UpdateSettingsReq) GetVersion() (v int64) {
+	if !p.IsSetVersion() {
+		return UpdateSettingsReq_Version_DEFAULT
+	}
+	return *p.Version
+}
+func (p *UpdateSettingsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateSettingsReq) SetDmPermission(val *string) {
+	p.DmPermission = val
+}
+func (p *UpdateSettingsReq) SetCommentPermission(val *string) {
+	p.CommentPermission = val
+}
+func (p *UpdateSettingsReq) SetLikedListPublic(val *bool) {
+	p.LikedListPublic = val
+}
+func (p *UpdateSettingsReq) SetStarredListPublic(val *bool) {
+	p.StarredListPublic = val
+}
+func (p *UpdateSettingsReq) SetFollowListVisible(val *bool) {
+	p.FollowListVisible = val
+}
+func (p *UpdateSettingsReq) SetAllowRemix(val *bool) {
+	p.AllowRemix = val
+}
+func (p *UpdateSettingsReq) SetShowOnlineStatus(val *bool) {
+	p.ShowOnlineStatus = val
+}
+func (p *UpdateSettingsReq) SetVersion(val *int64) {
+	p.Version = val
+}
+
+func (p *UpdateSettingsReq) IsSetDmPermission() bool {
+	return p.DmPermission != nil
+}
+
+func (p *UpdateSettingsReq) IsSetCommentPermission() bool {
+	return p.CommentPermission != nil
+}
+
+func (p *UpdateSettingsReq) IsSetLikedListPublic() bool {
+	return p.LikedListPublic != nil
+}
+
+func (p *UpdateSettingsReq) IsSetStarredListPublic() bool {
+	return p.StarredListPublic != nil
+}
+
+func (p *UpdateSettingsReq) IsSetFollowListVisible() bool {
+	return p.FollowListVisible != nil
+}
+
+func (p *UpdateSettingsReq) IsSetAllowRemix() bool {
+	return p.AllowRemix != nil
+}
+
+func (p *UpdateSettingsReq) IsSetShowOnlineStatus() bool {
+	return p.ShowOnlineStatus != nil
+}
+
+func (p *UpdateSettingsReq) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *UpdateSettingsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateSettingsReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateSettingsReq = map[int16]string{
+	1: "userId",
+	2: "dmPermission",
+	3: "commentPermission",
+	4: "likedListPublic",
+	5: "starredListPublic",
+	6: "followListVisible",
+	7: "allowRemix",
+	8: "showOnlineStatus",
+	9: "version",
+}
+
+type SettingsResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Settings *UserSettings    `thrift:"settings,2" frugal:"2,default,UserSettings" json:"settings"`
+}
+
+func NewSettingsResp() *SettingsResp {
+	return &SettingsResp{}
+}
+
+func (p *SettingsResp) InitDefault() {
+}
+
+var SettingsResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SettingsResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SettingsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var SettingsResp_Settings_DEFAULT *UserSettings
+
+func (p *SettingsResp) GetSettings() (v *UserSettings) {
+	if !p.IsSetSettings() {
+		return SettingsResp_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *SettingsResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *SettingsResp) SetSettings(val *UserSettings) {
+	p.Settings = val
+}
+
+func (p *SettingsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SettingsResp) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *SettingsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SettingsResp(%+v)", *p)
+}
+
+var fieldIDToName_SettingsResp = map[int16]string{
+	1: "BaseResp",
+	2: "settings",
+}
+
+type ChangeUsernameReq struct {
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Username      string  `thrift:"username,2" frugal:"2,default,string" json:"username"`
+	TwoFactorCode *string `thrift:"twoFactorCode,3,optional" frugal:"3,optional,string" json:"twoFactorCode,omitempty"`
+}
+
+func NewChangeUsernameReq() *ChangeUsernameReq {
+	return &ChangeUsernameReq{}
+}
+
+func (p *ChangeUsernameReq) InitDefault() {
+}
+
+func (p *ChangeUsernameReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ChangeUsernameReq) GetUsername() (v string) {
+	return p.Username
+}
+
+var ChangeUsernameReq_TwoFactorCode_DEFAULT string
+
+func (p *ChangeUsernameReq) GetTwoFactorCode() (v string) {
+	if !p.IsSetTwoFactorCode() {
+		return ChangeUsernameReq_TwoFactorCode_DEFAULT
+	}
+	return *p.TwoFactorCode
+}
+func (p *ChangeUsernameReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ChangeUsernameReq) SetUsername(val string) {
+	p.Username = val
+}
+func (p *ChangeUsernameReq) SetTwoFactorCode(val *string) {
+	p.TwoFactorCode = val
+}
+
+func (p *ChangeUsernameReq) IsSetTwoFactorCode() bool {
+	return p.TwoFactorCode != nil
+}
+
+func (p *ChangeUsernameReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangeUsernameReq(%+v)", *p)
+}
+
+var fieldIDToName_ChangeUsernameReq = map[int16]string{
+	1: "userId",
+	2: "username",
+	3: "twoFactorCode",
+}
+
+type ChangeUsernameResp struct {
+	BaseResp       *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Username       string           `thrift:"username,2" frugal:"2,default,string" json:"username"`
+	NextChangeTime int64            `thrift:"nextChangeTime,3" frugal:"3,default,i64" json:"nextChangeTime"`
+}
+
+func NewChangeUsernameResp() *ChangeUsernameResp {
+	return &ChangeUsernameResp{}
+}
+
+func (p *ChangeUsernameResp) InitDefault() {
+}
+
+var ChangeUsernameResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ChangeUsernameResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ChangeUsernameResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ChangeUsernameResp) GetUsername() (v string) {
+	return p.Username
+}
+
+func (p *ChangeUsernameResp) GetNextChangeTime() (v int64) {
+	return p.NextChangeTime
+}
+func (p *ChangeUsernameResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *ChangeUsernameResp) SetUsername(val string) {
+	p.Username = val
+}
+func (p *ChangeUsernameResp) SetNextChangeTime(val int64) {
+	p.NextChangeTime = val
+}
+
+func (p *ChangeUsernameResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ChangeUsernameResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangeUsernameResp(%+v)", *p)
+}
+
+var fieldIDToName_ChangeUsernameResp = map[int16]string{
+	1: "BaseResp",
+	2: "username",
+	3: "nextChangeTime",
+}
+
+type UsernameChange struct {
+	OldUsername   string `thrift:"oldUsername,1" frugal:"1,default,string" json:"oldUsername"`
+	RenamedTo     string `thrift:"renamedTo,2" frugal:"2,default,string" json:"renamedTo"`
+	ChangedAt     int64  `thrift:"changedAt,3" frugal:"3,default,i64" json:"changedAt"`
+	RedirectUntil int64  `thrift:"redirectUntil,4" frugal:"4,default,i64" json:"redirectUntil"`
+}
+
+func NewUsernameChange() *UsernameChange {
+	return &UsernameChange{}
+}
+
+func (p *UsernameChange) InitDefault() {
+}
+
+func (p *UsernameChange) GetOldUsername() (v string) {
+	return p.OldUsername
+}
+
+func (p *UsernameChange) GetRenamedTo() (v string) {
+	return p.RenamedTo
+}
+
+func (p *UsernameChange) GetChangedAt() (v int64) {
+	return p.ChangedAt
+}
+
+func (p *UsernameChange) GetRedirectUntil() (v int64) {
+	return p.RedirectUntil
+}
+func (p *UsernameChange) SetOldUsername(val string) {
+	p.OldUsername = val
+}
+func (p *UsernameChange) SetRenamedTo(val string) {
+	p.RenamedTo = val
+}
+func (p *UsernameChange) SetChangedAt(val int64) {
+	p.ChangedAt = val
+}
+func (p *UsernameChange) SetRedirectUntil(val int64) {
+	p.RedirectUntil = val
+}
+
+func (p *UsernameChange) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsernameChange(%+v)", *p)
+}
+
+var fieldIDToName_UsernameChange = map[int16]string{
+	1: "oldUsername",
+	2: "renamedTo",
+	3: "changedAt",
+	4: "redirectUntil",
+}
+
+type UsernameHistoryReq struct {
+	OperatorId int64 `thrift:"operatorId,1" frugal:"1,default,i64" json:"operatorId"`
+	UserId     int64 `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+}
+
+func NewUsernameHistoryReq() *UsernameHistoryReq {
+	return &UsernameHistoryReq{}
+}
+
+func (p *UsernameHistoryReq) InitDefault() {
+}
+
+func (p *UsernameHistoryReq) GetOperatorId() (v int64) {
+	return p.OperatorId
+}
+
+func (p *UsernameHistoryReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *UsernameHistoryReq) SetOperatorId(val int64) {
+	p.OperatorId = val
+}
+func (p *UsernameHistoryReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *UsernameHistoryReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsernameHistoryReq(%+v)", *p)
+}
+
+var fieldIDToName_UsernameHistoryReq = map[int16]string{
+	1: "operatorId",
+	2: "userId",
+}
+
+type UsernameHistoryResp struct {
+	BaseResp *common.BaseResp  `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	History  []*UsernameChange `thrift:"history,2" frugal:"2,default,list<UsernameChange>" json:"history"`
+}
+
+func NewUsernameHistoryResp() *UsernameHistoryResp {
+	return &UsernameHistoryResp{}
+}
+
+func (p *UsernameHistoryResp) InitDefault() {
+}
+
+var UsernameHistoryResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *UsernameHistoryResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UsernameHistoryResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UsernameHistoryResp) GetHistory() (v []*UsernameChange) {
+	return p.History
+}
+func (p *UsernameHistoryResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *UsernameHistoryResp) SetHistory(val []*UsernameChange) {
+	p.History = val
+}
+
+func (p *UsernameHistoryResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UsernameHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsernameHistoryResp(%+v)", *p)
+}
+
+var fieldIDToName_UsernameHistoryResp = map[int16]string{
+	1: "BaseResp",
+	2: "history",
+}
+
+type UserService interface {
+	Register(ctx context.Context, req *RegisterReq) (r *LoginRegisterResp, err error)
+
+	Login(ctx context.Context, req *LoginReq) (r *LoginRegisterResp, err error)
+
+	GetUserInfo(ctx context.Context, req *UserInfoReq) (r *UserInfoResp, err error)
+
+	GetUserInfoByUsername(ctx context.Context, req *UserInfoByUsernameReq) (r *UserInfoResp, err error)
+
+	BatchGetUserInfo(ctx context.Context, req *BatchUserInfoReq) (r *BatchUserInfoResp, err error)
+
+	UpdateUser(ctx context.Context, req *UpdateUserReq) (r *common.BaseResp, err error)
+
+	UpdateAvatar(ctx context.Context, req *UpdateAvatarReq) (r *common.BaseResp, err error)
+
+	CheckUsername(ctx context.Context, req *CheckUsernameReq) (r *CheckUsernameResp, err error)
+
+	BatchCheckUsernames(ctx context.Context, req *BatchCheckUsernamesReq) (r *BatchCheckUsernamesResp, err error)
+
+	GetUserStats(ctx context.Context, req *UserStatsReq) (r *UserStatsResp, err error)
+
+	SearchUsers(ctx context.Context, req *SearchUsersReq) (r *SearchUsersResp, err error)
+
+	UpdateFollowCount(ctx context.Context, req *UpdateFollowCountReq) (r *common.BaseResp, err error)
+
+	UpdateFollowerCount(ctx context.Context, req *UpdateFollowerCountReq) (r *common.BaseResp, err error)
+
+	VerifyToken(ctx context.Context, token string) (r *VerifyTokenResp, err error)
+
+	RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error)
+
+	Logout(ctx context.Context, req *LogoutReq) (r *common.BaseResp, err error)
+
+	LogoutAll(ctx context.Context, req *LogoutAllReq) (r *common.BaseResp, err error)
+
+	GetSessions(ctx context.Context, req *SessionListReq) (r *SessionListResp, err error)
+
+	RevokeSession(ctx context.Context, req *RevokeSessionReq) (r *common.BaseResp, err error)
+
+	VerifyTwoFactorLogin(ctx context.Context, req *TwoFactorLoginReq) (r *LoginRegisterResp, err error)
+
+	SetupTwoFactor(ctx context.Context, req *TwoFactorSetupReq) (r *TwoFactorSetupResp, err error)
+
+	EnableTwoFactor(ctx context.Context, req *TwoFactorCodeReq) (r *RecoveryCodesResp, err error)
+
+	DisableTwoFactor(ctx context.Context, req *TwoFactorCodeReq) (r *common.BaseResp, err error)
+
+	RegenerateRecoveryCodes(ctx context.Context, req *TwoFactorCodeReq) (r *RecoveryCodesResp, err error)
+
+	GetTwoFactorStatus(ctx context.Context, req *TwoFactorStatusReq) (r *TwoFactorStatusResp, err error)
+
+	StartOAuth(ctx context.Context, req *OAuthStartReq) (r *OAuthStartResp, err error)
+
+	OAuthCallback(ctx context.Context, req *OAuthCallbackReq) (r *LoginRegisterResp, err error)
+
+	ListIdentities(ctx context.Context, req *IdentityListReq) (r *IdentityListResp, err error)
+
+	UnlinkIdentity(ctx context.Context, req *UnlinkIdentityReq) (r *common.BaseResp, err error)
+
+	RequestAccountDeletion(ctx context.Context, req *AccountDeletionReq) (r *AccountDeletionResp, err error)
+
+	GetAccountDeletion(ctx context.Context, req *AccountDeletionStatusReq) (r *AccountDeletionResp, err error)
+
+	CancelAccountDeletion(ctx context.Context, req *CancelAccountDeletionReq) (r *common.BaseResp, err error)
+
+	RequestDataExport(ctx context.Context, req *DataExportReq) (r *DataExportResp, err error)
+
+	GetDataExport(ctx context.Context, req *DataExportStatusReq) (r *DataExportResp, err error)
+
+	GetUserRoles(ctx context.Context, req *UserRolesReq) (r *UserRolesResp, err error)
+
+	AssignRole(ctx context.Context, req *RoleReq) (r *common.BaseResp, err error)
+
+	RevokeRole(ctx context.Context, req *RoleReq) (r *common.BaseResp, err error)
+
+	BanUser(ctx context.Context, req *BanUserReq) (r *common.BaseResp, err error)
+
+	GetUserLevel(ctx context.Context, req *UserLevelReq) (r *UserLevelResp, err error)
+
+	GetSettings(ctx context.Context, req *GetSettingsReq) (r *SettingsResp, err error)
+
+	UpdateSettings(ctx context.Context, req *UpdateSettingsReq) (r *SettingsResp, err error)
+
+	ChangeUsername(ctx context.Context, req *ChangeUsernameReq) (r *ChangeUsernameResp, err error)
+
+	GetUsernameHistory(ctx context.Context, req *UsernameHistoryReq) (r *UsernameHistoryResp, err error)
+}
+
+type UserServiceRegisterArgs struct {
+	Req *RegisterReq `thrift:"req,1" frugal:"1,default,RegisterReq" json:"req"`
+}
+
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
+}
+
+func (p *UserServiceRegisterArgs) InitDefault() {
+}
+
+var UserServiceRegisterArgs_Req_DEFAULT *RegisterReq
+
+func (p *UserServiceRegisterArgs) GetReq() (v *RegisterReq) {
+	if !p.IsSetReq() {
+		return UserServiceRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRegisterArgs) SetReq(val *RegisterReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRegisterResult struct {
+	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
+}
+
+func (p *UserServiceRegisterResult) InitDefault() {
+}
+
+var UserServiceRegisterResult_Success_DEFAULT *LoginRegisterResp
+
+func (p *UserServiceRegisterResult) GetSuccess() (v *LoginRegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginRegisterResp)
+}
+
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLoginArgs struct {
+	Req *LoginReq `thrift:"req,1" frugal:"1,default,LoginReq" json:"req"`
+}
+
+func NewUserServiceLoginArgs() *UserServiceLoginArgs {
+	return &UserServiceLoginArgs{}
+}
+
+func (p *UserServiceLoginArgs) InitDefault() {
+}
+
+var UserServiceLoginArgs_Req_DEFAULT *LoginReq
+
+func (p *UserServiceLoginArgs) GetReq() (v *LoginReq) {
+	if !p.IsSetReq() {
+		return UserServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLoginArgs) SetReq(val *LoginReq) {
+	p.Req = val
+}
+
+func (p *UserServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLoginResult struct {
+	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+}
+
+func NewUserServiceLoginResult() *UserServiceLoginResult {
+	return &UserServiceLoginResult{}
+}
+
+func (p *UserServiceLoginResult) InitDefault() {
+}
+
+var UserServiceLoginResult_Success_DEFAULT *LoginRegisterResp
+
+func (p *UserServiceLoginResult) GetSuccess() (v *LoginRegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginRegisterResp)
+}
+
+func (p *UserServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetUserInfoArgs struct {
+	Req *UserInfoReq `thrift:"req,1" frugal:"1,default,UserInfoReq" json:"req"`
+}
+
+func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
+	return &UserServiceGetUserInfoArgs{}
+}
+
+func (p *UserServiceGetUserInfoArgs) InitDefault() {
+}
+
+var UserServiceGetUserInfoArgs_Req_DEFAULT *UserInfoReq
+
+func (p *UserServiceGetUserInfoArgs) GetReq() (v *UserInfoReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetUserInfoArgs) SetReq(val *UserInfoReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetUserInfoResult struct {
+	Success *UserInfoResp `thrift:"success,0,optional" frugal:"0,optional,UserInfoResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
+	return &UserServiceGetUserInfoResult{}
+}
+
+func (p *UserServiceGetUserInfoResult) InitDefault() {
+}
+
+var UserServiceGetUserInfoResult_Success_DEFAULT *UserInfoResp
+
+func (p *UserServiceGetUserInfoResult) GetSuccess() (v *UserInfoResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserInfoResp)
+}
+
+func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetUserInfoByUsernameArgs struct {
+	Req *UserInfoByUsernameReq `thrift:"req,1" frugal:"1,default,UserInfoByUsernameReq" json:"req"`
+}
+
+func NewUserServiceGetUserInfoByUsernameArgs() *UserServiceGetUserInfoByUsernameArgs {
+	return &UserServiceGetUserInfoByUsernameArgs{}
+}
+
+func (p *UserServiceGetUserInfoByUsernameArgs) InitDefault() {
+}
+
+var UserServiceGetUserInfoByUsernameArgs_Req_DEFAULT *UserInfoByUsernameReq
+
+func (p *UserServiceGetUserInfoByUsernameArgs) GetReq() (v *UserInfoByUsernameReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserInfoByUsernameArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetUserInfoByUsernameArgs) SetReq(val *UserInfoByUsernameReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetUserInfoByUsernameArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserInfoByUsernameArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoByUsernameArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoByUsernameArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetUserInfoByUsernameResult struct {
+	Success *UserInfoResp `thrift:"success,0,optional" frugal:"0,optional,UserInfoResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetUserInfoByUsernameResult() *UserServiceGetUserInfoByUsernameResult {
+	return &UserServiceGetUserInfoByUsernameResult{}
+}
+
+func (p *UserServiceGetUserInfoByUsernameResult) InitDefault() {
+}
+
+var UserServiceGetUserInfoByUsernameResult_Success_DEFAULT *UserInfoResp
+
+func (p *UserServiceGetUserInfoByUsernameResult) GetSuccess() (v *UserInfoResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserInfoByUsernameResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetUserInfoByUsernameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserInfoResp)
+}
+
+func (p *UserServiceGetUserInfoByUsernameResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserInfoByUsernameResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoByUsernameResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoByUsernameResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceBatchGetUserInfoArgs struct {
+	Req *BatchUserInfoReq `thrift:"req,1" frugal:"1,default,BatchUserInfoReq" json:"req"`
+}
+
+func NewUserServiceBatchGetUserInfoArgs() *UserServiceBatchGetUserInfoArgs {
+	return &UserServiceBatchGetUserInfoArgs{}
+}
+
+func (p *UserServiceBatchGetUserInfoArgs) InitDefault() {
+}
+
+var UserServiceBatchGetUserInfoArgs_Req_DEFAULT *BatchUserInfoReq
+
+func (p *UserServiceBatchGetUserInfoArgs) GetReq() (v *BatchUserInfoReq) {
+	if !p.IsSetReq() {
+		return UserServiceBatchGetUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceBatchGetUserInfoArgs) SetReq(val *BatchUserInfoReq) {
+	p.Req = val
+}
+
+func (p *UserServiceBatchGetUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceBatchGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBatchGetUserInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceBatchGetUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceBatchGetUserInfoResult struct {
+	Success *BatchUserInfoResp `thrift:"success,0,optional" frugal:"0,optional,BatchUserInfoResp" json:"success,omitempty"`
+}
+
+func NewUserServiceBatchGetUserInfoResult() *UserServiceBatchGetUserInfoResult {
+	return &UserServiceBatchGetUserInfoResult{}
+}
+
+func (p *UserServiceBatchGetUserInfoResult) InitDefault() {
+}
+
+var UserServiceBatchGetUserInfoResult_Success_DEFAULT *BatchUserInfoResp
+
+func (p *UserServiceBatchGetUserInfoResult) GetSuccess() (v *BatchUserInfoResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceBatchGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceBatchGetUserInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchUserInfoResp)
+}
+
+func (p *UserServiceBatchGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceBatchGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBatchGetUserInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceBatchGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateUserArgs struct {
+	Req *UpdateUserReq `thrift:"req,1" frugal:"1,default,UpdateUserReq" json:"req"`
+}
+
+func NewUserServiceUpdateUserArgs() *UserServiceUpdateUserArgs {
+	return &UserServiceUpdateUserArgs{}
+}
+
+func (p *UserServiceUpdateUserArgs) InitDefault() {
+}
+
+var UserServiceUpdateUserArgs_Req_DEFAULT *UpdateUserReq
+
+func (p *UserServiceUpdateUserArgs) GetReq() (v *UpdateUserReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateUserArgs) SetReq(val *UpdateUserReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateUserArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateUserArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateUserResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateUserResult() *UserServiceUpdateUserResult {
+	return &UserServiceUpdateUserResult{}
+}
+
+func (p *UserServiceUpdateUserResult) InitDefault() {
+}
+
+var UserServiceUpdateUserResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceUpdateUserResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceUpdateUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateUserResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateUserResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateAvatarArgs struct {
+	Req *UpdateAvatarReq `thrift:"req,1" frugal:"1,default,UpdateAvatarReq" json:"req"`
+}
+
+func NewUserServiceUpdateAvatarArgs() *UserServiceUpdateAvatarArgs {
+	return &UserServiceUpdateAvatarArgs{}
+}
+
+func (p *UserServiceUpdateAvatarArgs) InitDefault() {
+}
+
+var UserServiceUpdateAvatarArgs_Req_DEFAULT *UpdateAvatarReq
+
+func (p *UserServiceUpdateAvatarArgs) GetReq() (v *UpdateAvatarReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateAvatarArgs) SetReq(val *UpdateAvatarReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateAvatarArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateAvatarArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateAvatarArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateAvatarResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateAvatarResult() *UserServiceUpdateAvatarResult {
+	return &UserServiceUpdateAvatarResult{}
+}
+
+func (p *UserServiceUpdateAvatarResult) InitDefault() {
+}
+
+var UserServiceUpdateAvatarResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceUpdateAvatarResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateAvatarResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceUpdateAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateAvatarResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateAvatarResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateAvatarResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCheckUsernameArgs struct {
+	Req *CheckUsernameReq `thrift:"req,1" frugal:"1,default,CheckUsernameReq" json:"req"`
+}
+
+func NewUserServiceCheckUsernameArgs() *UserServiceCheckUsernameArgs {
+	return &UserServiceCheckUsernameArgs{}
+}
+
+func (p *UserServiceCheckUsernameArgs) InitDefault() {
+}
+
+var UserServiceCheckUsernameArgs_Req_DEFAULT *CheckUsernameReq
+
+func (p *UserServiceCheckUsernameArgs) GetReq() (v *CheckUsernameReq) {
+	if !p.IsSetReq() {
+		return UserServiceCheckUsernameArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCheckUsernameArgs) SetReq(val *CheckUsernameReq) {
+	p.Req = val
+}
+
+func (p *UserServiceCheckUsernameArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCheckUsernameArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCheckUsernameArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceCheckUsernameArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCheckUsernameResult struct {
+	Success *CheckUsernameResp `thrift:"success,0,optional" frugal:"0,optional,CheckUsernameResp" json:"success,omitempty"`
+}
+
+func NewUserServiceCheckUsernameResult() *UserServiceCheckUsernameResult {
+	return &UserServiceCheckUsernameResult{}
+}
+
+func (p *UserServiceCheckUsernameResult) InitDefault() {
+}
+
+var UserServiceCheckUsernameResult_Success_DEFAULT *CheckUsernameResp
+
+func (p *UserServiceCheckUsernameResult) GetSuccess() (v *CheckUsernameResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCheckUsernameResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCheckUsernameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckUsernameResp)
+}
+
+func (p *UserServiceCheckUsernameResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCheckUsernameResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCheckUsernameResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceCheckUsernameResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceBatchCheckUsernamesArgs struct {
+	Req *BatchCheckUsernamesReq `thrift:"req,1" frugal:"1,default,BatchCheckUsernamesReq" json:"req"`
+}
+
+func NewUserServiceBatchCheckUsernamesArgs() *UserServiceBatchCheckUsernamesArgs {
+	return &UserServiceBatchCheckUsernamesArgs{}
+}
+
+func (p *UserServiceBatchCheckUsernamesArgs) InitDefault() {
+}
+
+var UserServiceBatchCheckUsernamesArgs_Req_DEFAULT *BatchCheckUsernamesReq
+
+func (p *UserServiceBatchCheckUsernamesArgs) GetReq() (v *BatchCheckUsernamesReq) {
+	if !p.IsSetReq() {
+		return UserServiceBatchCheckUsernamesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceBatchCheckUsernamesArgs) SetReq(val *BatchCheckUsernamesReq) {
+	p.Req = val
+}
+
+func (p *UserServiceBatchCheckUsernamesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceBatchCheckUsernamesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBatchCheckUsernamesArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceBatchCheckUsernamesArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceBatchCheckUsernamesResult struct {
+	Success *BatchCheckUsernamesResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckUsernamesResp" json:"success,omitempty"`
+}
+
+func NewUserServiceBatchCheckUsernamesResult() *UserServiceBatchCheckUsernamesResult {
+	return &UserServiceBatchCheckUsernamesResult{}
+}
+
+func (p *UserServiceBatchCheckUsernamesResult) InitDefault() {
+}
+
+var UserServiceBatchCheckUsernamesResult_Success_DEFAULT *BatchCheckUsernamesResp
+
+func (p *UserServiceBatchCheckUsernamesResult) GetSuccess() (v *BatchCheckUsernamesResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceBatchCheckUsernamesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceBatchCheckUsernamesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchCheckUsernamesResp)
+}
+
+func (p *UserServiceBatchCheckUsernamesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceBatchCheckUsernamesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceBatchCheckUsernamesResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceBatchCheckUsernamesResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetUserStatsArgs struct {
+	Req *UserStatsReq `thrift:"req,1" frugal:"1,default,UserStatsReq" json:"req"`
+}
+
+func NewUserServiceGetUserStatsArgs() *UserServiceGetUserStatsArgs {
+	return &UserServiceGetUserStatsArgs{}
+}
+
+func (p *UserServiceGetUserStatsArgs) InitDefault() {
+}
+
+var UserServiceGetUserStatsArgs_Req_DEFAULT *UserStatsReq
+
+func (p *UserServiceGetUserStatsArgs) GetReq() (v *UserStatsReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserStatsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetUserStatsArgs) SetReq(val *UserStatsReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetUserStatsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserStatsArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserStatsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetUserStatsResult struct {
+	Success *UserStatsResp `thrift:"success,0,optional" frugal:"0,optional,UserStatsResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetUserStatsResult() *UserServiceGetUserStatsResult {
+	return &UserServiceGetUserStatsResult{}
+}
+
+func (p *UserServiceGetUserStatsResult) InitDefault() {
+}
+
+var UserServiceGetUserStatsResult_Success_DEFAULT *UserStatsResp
+
+func (p *UserServiceGetUserStatsResult) GetSuccess() (v *UserStatsResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserStatsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetUserStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserStatsResp)
+}
+
+func (p *UserServiceGetUserStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserStatsResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserStatsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceSearchUsersArgs struct {
+	Req *SearchUsersReq `thrift:"req,1" frugal:"1,default,SearchUsersReq" json:"req"`
+}
+
+func NewUserServiceSearchUsersArgs() *UserServiceSearchUsersArgs {
+	return &UserServiceSearchUsersArgs{}
+}
+
+func (p *UserServiceSearchUsersArgs) InitDefault() {
+}
+
+var UserServiceSearchUsersArgs_Req_DEFAULT *SearchUsersReq
+
+func (p *UserServiceSearchUsersArgs) GetReq() (v *SearchUsersReq) {
+	if !p.IsSetReq() {
+		return UserServiceSearchUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceSearchUsersArgs) SetReq(val *SearchUsersReq) {
+	p.Req = val
+}
+
+func (p *UserServiceSearchUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceSearchUsersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSearchUsersArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceSearchUsersArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceSearchUsersResult struct {
+	Success *SearchUsersResp `thrift:"success,0,optional" frugal:"0,optional,SearchUsersResp" json:"success,omitempty"`
+}
+
+func NewUserServiceSearchUsersResult() *UserServiceSearchUsersResult {
+	return &UserServiceSearchUsersResult{}
+}
+
+func (p *UserServiceSearchUsersResult) InitDefault() {
+}
+
+var UserServiceSearchUsersResult_Success_DEFAULT *SearchUsersResp
+
+func (p *UserServiceSearchUsersResult) GetSuccess() (v *SearchUsersResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceSearchUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceSearchUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchUsersResp)
+}
+
+func (p *UserServiceSearchUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceSearchUsersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSearchUsersResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceSearchUsersResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateFollowCountArgs struct {
+	Req *UpdateFollowCountReq `thrift:"req,1" frugal:"1,default,UpdateFollowCountReq" json:"req"`
+}
+
+func NewUserServiceUpdateFollowCountArgs() *UserServiceUpdateFollowCountArgs {
+	return &UserServiceUpdateFollowCountArgs{}
+}
+
+func (p *UserServiceUpdateFollowCountArgs) InitDefault() {
+}
+
+var UserServiceUpdateFollowCountArgs_Req_DEFAULT *UpdateFollowCountReq
+
+func (p *UserServiceUpdateFollowCountArgs) GetReq() (v *UpdateFollowCountReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateFollowCountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateFollowCountArgs) SetReq(val *UpdateFollowCountReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateFollowCountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateFollowCountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateFollowCountArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateFollowCountArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateFollowCountResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateFollowCountResult() *UserServiceUpdateFollowCountResult {
+	return &UserServiceUpdateFollowCountResult{}
+}
+
+func (p *UserServiceUpdateFollowCountResult) InitDefault() {
+}
+
+var UserServiceUpdateFollowCountResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceUpdateFollowCountResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateFollowCountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateFollowCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceUpdateFollowCountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateFollowCountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateFollowCountResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateFollowCountResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateFollowerCountArgs struct {
+	Req *UpdateFollowerCountReq `thrift:"req,1" frugal:"1,default,UpdateFollowerCountReq" json:"req"`
+}
+
+func NewUserServiceUpdateFollowerCountArgs() *UserServiceUpdateFollowerCountArgs {
+	return &UserServiceUpdateFollowerCountArgs{}
+}
+
+func (p *UserServiceUpdateFollowerCountArgs) InitDefault() {
+}
+
+var UserServiceUpdateFollowerCountArgs_Req_DEFAULT *UpdateFollowerCountReq
+
+func (p *UserServiceUpdateFollowerCountArgs) GetReq() (v *UpdateFollowerCountReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateFollowerCountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateFollowerCountArgs) SetReq(val *UpdateFollowerCountReq) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateFollowerCountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateFollowerCountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateFollowerCountArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateFollowerCountArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateFollowerCountResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateFollowerCountResult() *UserServiceUpdateFollowerCountResult {
+	return &UserServiceUpdateFollowerCountResult{}
+}
+
+func (p *UserServiceUpdateFollowerCountResult) InitDefault() {
+}
+
+var UserServiceUpdateFollowerCountResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceUpdateFollowerCountResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateFollowerCountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateFollowerCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceUpdateFollowerCountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateFollowerCountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateFollowerCountResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateFollowerCountResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceVerifyTokenArgs struct {
+	Token string `thrift:"token,1" frugal:"1,default,string" json:"token"`
+}
+
+func NewUserServiceVerifyTokenArgs() *UserServiceVerifyTokenArgs {
+	return &UserServiceVerifyTokenArgs{}
+}
+
+func (p *UserServiceVerifyTokenArgs) InitDefault() {
+}
+
+func (p *UserServiceVerifyTokenArgs) GetToken() (v string) {
+	return p.Token
+}
+func (p *UserServiceVerifyTokenArgs) SetToken(val string) {
+	p.Token = val
+}
+
+func (p *UserServiceVerifyTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyTokenArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceVerifyTokenArgs = map[int16]string{
+	1: "token",
+}
+
+type UserServiceVerifyTokenResult struct {
+	Success *VerifyTokenResp `thrift:"success,0,optional" frugal:"0,optional,VerifyTokenResp" json:"success,omitempty"`
+}
+
+func NewUserServiceVerifyTokenResult() *UserServiceVerifyTokenResult {
+	return &UserServiceVerifyTokenResult{}
+}
+
+func (p *UserServiceVerifyTokenResult) InitDefault() {
+}
+
+var UserServiceVerifyTokenResult_Success_DEFAULT *VerifyTokenResp
+
+func (p *UserServiceVerifyTokenResult) GetSuccess() (v *VerifyTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceVerifyTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceVerifyTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VerifyTokenResp)
+}
+
+func (p *UserServiceVerifyTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceVerifyTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyTokenResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceVerifyTokenResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRefreshTokenArgs struct {
+	Req *RefreshTokenReq `thrift:"req,1" frugal:"1,default,RefreshTokenReq" json:"req"`
+}
+
+func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
+	return &UserServiceRefreshTokenArgs{}
+}
+
+func (p *UserServiceRefreshTokenArgs) InitDefault() {
+}
+
+var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenReq
+
+func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenReq) {
+	if !p.IsSetReq() {
+		return UserServiceRefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRefreshTokenArgs) SetReq(val *RefreshTokenReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRefreshTokenResult struct {
+	Success *RefreshTokenResp `thrift:"success,0,optional" frugal:"0,optional,RefreshTokenResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
+	return &UserServiceRefreshTokenResult{}
+}
+
+func (p *UserServiceRefreshTokenResult) InitDefault() {
+}
+
+var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResp
+
+func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RefreshTokenResp)
+}
+
+func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLogoutArgs struct {
+	Req *LogoutReq `thrift:"req,1" frugal:"1,default,LogoutReq" json:"req"`
+}
+
+func NewUserServiceLogoutArgs() *UserServiceLogoutArgs {
+	return &UserServiceLogoutArgs{}
+}
+
+func (p *UserServiceLogoutArgs) InitDefault() {
+}
+
+var UserServiceLogoutArgs_Req_DEFAULT *LogoutReq
+
+func (p *UserServiceLogoutArgs) GetReq() (v *LogoutReq) {
+	if !p.IsSetReq() {
+		return UserServiceLogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLogoutArgs) SetReq(val *LogoutReq) {
+	p.Req = val
+}
+
+func (p *UserServiceLogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLogoutArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLogoutArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLogoutResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceLogoutResult() *UserServiceLogoutResult {
+	return &UserServiceLogoutResult{}
+}
+
+func (p *UserServiceLogoutResult) InitDefault() {
+}
+
+var UserServiceLogoutResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceLogoutResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceLogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLogoutResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLogoutResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLogoutAllArgs struct {
+	Req *LogoutAllReq `thrift:"req,1" frugal:"1,default,LogoutAllReq" json:"req"`
+}
+
+func NewUserServiceLogoutAllArgs() *UserServiceLogoutAllArgs {
+	return &UserServiceLogoutAllArgs{}
+}
+
+func (p *UserServiceLogoutAllArgs) InitDefault() {
+}
+
+var UserServiceLogoutAllArgs_Req_DEFAULT *LogoutAllReq
+
+func (p *UserServiceLogoutAllArgs) GetReq() (v *LogoutAllReq) {
+	if !p.IsSetReq() {
+		return UserServiceLogoutAllArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLogoutAllArgs) SetReq(val *LogoutAllReq) {
+	p.Req = val
+}
+
+func (p *UserServiceLogoutAllArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLogoutAllArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutAllArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLogoutAllArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLogoutAllResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceLogoutAllResult() *UserServiceLogoutAllResult {
+	return &UserServiceLogoutAllResult{}
+}
+
+func (p *UserServiceLogoutAllResult) InitDefault() {
+}
+
+var UserServiceLogoutAllResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceLogoutAllResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLogoutAllResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLogoutAllResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceLogoutAllResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLogoutAllResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLogoutAllResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLogoutAllResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetSessionsArgs struct {
+	Req *SessionListReq `thrift:"req,1" frugal:"1,default,SessionListReq" json:"req"`
+}
+
+func NewUserServiceGetSessionsArgs() *UserServiceGetSessionsArgs {
+	return &UserServiceGetSessionsArgs{}
+}
+
+func (p *UserServiceGetSessionsArgs) InitDefault() {
+}
+
+var UserServiceGetSessionsArgs_Req_DEFAULT *SessionListReq
+
+func (p *UserServiceGetSessionsArgs) GetReq() (v *SessionListReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetSessionsArgs) SetReq(val *SessionListReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetSessionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetSessionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetSessionsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetSessionsResult struct {
+	Success *SessionListResp `thrift:"success,0,optional" frugal:"0,optional,SessionListResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetSessionsResult() *UserServiceGetSessionsResult {
+	return &UserServiceGetSessionsResult{}
+}
+
+func (p *UserServiceGetSessionsResult) InitDefault() {
+}
+
+var UserServiceGetSessionsResult_Success_DEFAULT *SessionListResp
+
+func (p *UserServiceGetSessionsResult) GetSuccess() (v *SessionListResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SessionListResp)
+}
+
+func (p *UserServiceGetSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetSessionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetSessionsResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetSessionsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRevokeSessionArgs struct {
+	Req *RevokeSessionReq `thrift:"req,1" frugal:"1,default,RevokeSessionReq" json:"req"`
+}
+
+func NewUserServiceRevokeSessionArgs() *UserServiceRevokeSessionArgs {
+	return &UserServiceRevokeSessionArgs{}
+}
+
+func (p *UserServiceRevokeSessionArgs) InitDefault() {
+}
+
+var UserServiceRevokeSessionArgs_Req_DEFAULT *RevokeSessionReq
+
+func (p *UserServiceRevokeSessionArgs) GetReq() (v *RevokeSessionReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevokeSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRevokeSessionArgs) SetReq(val *RevokeSessionReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRevokeSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevokeSessionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeSessionArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRevokeSessionArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRevokeSessionResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRevokeSessionResult() *UserServiceRevokeSessionResult {
+	return &UserServiceRevokeSessionResult{}
+}
+
+func (p *UserServiceRevokeSessionResult) InitDefault() {
+}
+
+var UserServiceRevokeSessionResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceRevokeSessionResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevokeSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRevokeSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceRevokeSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRevokeSessionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokeSessionResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRevokeSessionResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceVerifyTwoFactorLoginArgs struct {
+	Req *TwoFactorLoginReq `thrift:"req,1" frugal:"1,default,TwoFactorLoginReq" json:"req"`
+}
+
+func NewUserServiceVerifyTwoFactorLoginArgs() *UserServiceVerifyTwoFactorLoginArgs {
+	return &UserServiceVerifyTwoFactorLoginArgs{}
+}
+
+func (p *UserServiceVerifyTwoFactorLoginArgs) InitDefault() {
+}
+
+var UserServiceVerifyTwoFactorLoginArgs_Req_DEFAULT *TwoFactorLoginReq
+
+func (p *UserServiceVerifyTwoFactorLoginArgs) GetReq() (v *TwoFactorLoginReq) {
+	if !p.IsSetReq() {
+		return UserServiceVerifyTwoFactorLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceVerifyTwoFactorLoginArgs) SetReq(val *TwoFactorLoginReq) {
+	p.Req = val
+}
+
+func (p *UserServiceVerifyTwoFactorLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceVerifyTwoFactorLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyTwoFactorLoginArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceVerifyTwoFactorLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceVerifyTwoFactorLoginResult struct {
+	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+}
+
+func NewUserServiceVerifyTwoFactorLoginResult() *UserServiceVerifyTwoFactorLoginResult {
+	return &UserServiceVerifyTwoFactorLoginResult{}
+}
+
+func (p *UserServiceVerifyTwoFactorLoginResult) InitDefault() {
+}
+
+var UserServiceVerifyTwoFactorLoginResult_Success_DEFAULT *LoginRegisterResp
+
+func (p *UserServiceVerifyTwoFactorLoginResult) GetSuccess() (v *LoginRegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceVerifyTwoFactorLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceVerifyTwoFactorLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginRegisterResp)
+}
+
+func (p *UserServiceVerifyTwoFactorLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceVerifyTwoFactorLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyTwoFactorLoginResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceVerifyTwoFactorLoginResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceSetupTwoFactorArgs struct {
+	Req *TwoFactorSetupReq `thrift:"req,1" frugal:"1,default,TwoFactorSetupReq" json:"req"`
+}
+
+func NewUserServiceSetupTwoFactorArgs() *UserServiceSetupTwoFactorArgs {
+	return &UserServiceSetupTwoFactorArgs{}
+}
+
+func (p *UserServiceSetupTwoFactorArgs) InitDefault() {
+}
+
+var UserServiceSetupTwoFactorArgs_Req_DEFAULT *TwoFactorSetupReq
+
+func (p *UserServiceSetupTwoFactorArgs) GetReq() (v *TwoFactorSetupReq) {
+	if !p.IsSetReq() {
+		return UserServiceSetupTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceSetupTwoFactorArgs) SetReq(val *TwoFactorSetupReq) {
+	p.Req = val
+}
+
+func (p *UserServiceSetupTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceSetupTwoFactorArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetupTwoFactorArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceSetupTwoFactorArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceSetupTwoFactorResult struct {
+	Success *TwoFactorSetupResp `thrift:"success,0,optional" frugal:"0,optional,TwoFactorSetupResp" json:"success,omitempty"`
+}
+
+func NewUserServiceSetupTwoFactorResult() *UserServiceSetupTwoFactorResult {
+	return &UserServiceSetupTwoFactorResult{}
+}
+
+func (p *UserServiceSetupTwoFactorResult) InitDefault() {
+}
+
+var UserServiceSetupTwoFactorResult_Success_DEFAULT *TwoFactorSetupResp
+
+func (p *UserServiceSetupTwoFactorResult) GetSuccess() (v *TwoFactorSetupResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceSetupTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceSetupTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TwoFactorSetupResp)
+}
+
+func (p *UserServiceSetupTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceSetupTwoFactorResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSetupTwoFactorResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceSetupTwoFactorResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceEnableTwoFactorArgs struct {
+	Req *TwoFactorCodeReq `thrift:"req,1" frugal:"1,default,TwoFactorCodeReq" json:"req"`
+}
+
+func NewUserServiceEnableTwoFactorArgs() *UserServiceEnableTwoFactorArgs {
+	return &UserServiceEnableTwoFactorArgs{}
+}
+
+func (p *UserServiceEnableTwoFactorArgs) InitDefault() {
+}
+
+var UserServiceEnableTwoFactorArgs_Req_DEFAULT *TwoFactorCodeReq
+
+func (p *UserServiceEnableTwoFactorArgs) GetReq() (v *TwoFactorCodeReq) {
+	if !p.IsSetReq() {
+		return UserServiceEnableTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceEnableTwoFactorArgs) SetReq(val *TwoFactorCodeReq) {
+	p.Req = val
+}
+
+func (p *UserServiceEnableTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceEnableTwoFactorArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceEnableTwoFactorArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceEnableTwoFactorArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceEnableTwoFactorResult struct {
+	Success *RecoveryCodesResp `thrift:"success,0,optional" frugal:"0,optional,RecoveryCodesResp" json:"success,omitempty"`
+}
+
+func NewUserServiceEnableTwoFactorResult() *UserServiceEnableTwoFactorResult {
+	return &UserServiceEnableTwoFactorResult{}
+}
+
+func (p *UserServiceEnableTwoFactorResult) InitDefault() {
+}
+
+var UserServiceEnableTwoFactorResult_Success_DEFAULT *RecoveryCodesResp
+
+func (p *UserServiceEnableTwoFactorResult) GetSuccess() (v *RecoveryCodesResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceEnableTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceEnableTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecoveryCodesResp)
+}
+
+func (p *UserServiceEnableTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceEnableTwoFactorResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceEnableTwoFactorResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceEnableTwoFactorResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceDisableTwoFactorArgs struct {
+	Req *TwoFactorCodeReq `thrift:"req,1" frugal:"1,default,TwoFactorCodeReq" json:"req"`
+}
+
+func NewUserServiceDisableTwoFactorArgs() *UserServiceDisableTwoFactorArgs {
+	return &UserServiceDisableTwoFactorArgs{}
+}
+
+func (p *UserServiceDisableTwoFactorArgs) InitDefault() {
+}
+
+var UserServiceDisableTwoFactorArgs_Req_DEFAULT *TwoFactorCodeReq
+
+func (p *UserServiceDisableTwoFactorArgs) GetReq() (v *TwoFactorCodeReq) {
+	if !p.IsSetReq() {
+		return UserServiceDisableTwoFactorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceDisableTwoFactorArgs) SetReq(val *TwoFactorCodeReq) {
+	p.Req = val
+}
+
+func (p *UserServiceDisableTwoFactorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceDisableTwoFactorArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDisableTwoFactorArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceDisableTwoFactorArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceDisableTwoFactorResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceDisableTwoFactorResult() *UserServiceDisableTwoFactorResult {
+	return &UserServiceDisableTwoFactorResult{}
+}
+
+func (p *UserServiceDisableTwoFactorResult) InitDefault() {
+}
+
+var UserServiceDisableTwoFactorResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceDisableTwoFactorResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceDisableTwoFactorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceDisableTwoFactorResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceDisableTwoFactorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceDisableTwoFactorResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceDisableTwoFactorResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceDisableTwoFactorResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRegenerateRecoveryCodesArgs struct {
+	Req *TwoFactorCodeReq `thrift:"req,1" frugal:"1,default,TwoFactorCodeReq" json:"req"`
+}
+
+func NewUserServiceRegenerateRecoveryCodesArgs() *UserServiceRegenerateRecoveryCodesArgs {
+	return &UserServiceRegenerateRecoveryCodesArgs{}
+}
+
+func (p *UserServiceRegenerateRecoveryCodesArgs) InitDefault() {
+}
+
+var UserServiceRegenerateRecoveryCodesArgs_Req_DEFAULT *TwoFactorCodeReq
+
+func (p *UserServiceRegenerateRecoveryCodesArgs) GetReq() (v *TwoFactorCodeReq) {
+	if !p.IsSetReq() {
+		return UserServiceRegenerateRecoveryCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRegenerateRecoveryCodesArgs) SetReq(val *TwoFactorCodeReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRegenerateRecoveryCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegenerateRecoveryCodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegenerateRecoveryCodesArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegenerateRecoveryCodesArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRegenerateRecoveryCodesResult struct {
+	Success *RecoveryCodesResp `thrift:"success,0,optional" frugal:"0,optional,RecoveryCodesResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRegenerateRecoveryCodesResult() *UserServiceRegenerateRecoveryCodesResult {
+	return &UserServiceRegenerateRecoveryCodesResult{}
+}
+
+func (p *UserServiceRegenerateRecoveryCodesResult) InitDefault() {
+}
+
+var UserServiceRegenerateRecoveryCodesResult_Success_DEFAULT *RecoveryCodesResp
+
+func (p *UserServiceRegenerateRecoveryCodesResult) GetSuccess() (v *RecoveryCodesResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegenerateRecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegenerateRecoveryCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecoveryCodesResp)
+}
+
+func (p *UserServiceRegenerateRecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegenerateRecoveryCodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegenerateRecoveryCodesResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegenerateRecoveryCodesResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetTwoFactorStatusArgs struct {
+	Req *TwoFactorStatusReq `thrift:"req,1" frugal:"1,default,TwoFactorStatusReq" json:"req"`
+}
+
+func NewUserServiceGetTwoFactorStatusArgs() *UserServiceGetTwoFactorStatusArgs {
+	return &UserServiceGetTwoFactorStatusArgs{}
+}
+
+func (p *UserServiceGetTwoFactorStatusArgs) InitDefault() {
+}
+
+var UserServiceGetTwoFactorStatusArgs_Req_DEFAULT *TwoFactorStatusReq
+
+func (p *UserServiceGetTwoFactorStatusArgs) GetReq() (v *TwoFactorStatusReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetTwoFactorStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetTwoFactorStatusArgs) SetReq(val *TwoFactorStatusReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetTwoFactorStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetTwoFactorStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetTwoFactorStatusArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetTwoFactorStatusArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetTwoFactorStatusResult struct {
+	Success *TwoFactorStatusResp `thrift:"success,0,optional" frugal:"0,optional,TwoFactorStatusResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetTwoFactorStatusResult() *UserServiceGetTwoFactorStatusResult {
+	return &UserServiceGetTwoFactorStatusResult{}
+}
+
+func (p *UserServiceGetTwoFactorStatusResult) InitDefault() {
+}
+
+var UserServiceGetTwoFactorStatusResult_Success_DEFAULT *TwoFactorStatusResp
+
+func (p *UserServiceGetTwoFactorStatusResult) GetSuccess() (v *TwoFactorStatusResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetTwoFactorStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetTwoFactorStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TwoFactorStatusResp)
+}
+
+func (p *UserServiceGetTwoFactorStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetTwoFactorStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetTwoFactorStatusResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetTwoFactorStatusResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceStartOAuthArgs struct {
+	Req *OAuthStartReq `thrift:"req,1" frugal:"1,default,OAuthStartReq" json:"req"`
+}
+
+func NewUserServiceStartOAuthArgs() *UserServiceStartOAuthArgs {
+	return &UserServiceStartOAuthArgs{}
+}
+
+func (p *UserServiceStartOAuthArgs) InitDefault() {
+}
+
+var UserServiceStartOAuthArgs_Req_DEFAULT *OAuthStartReq
+
+func (p *UserServiceStartOAuthArgs) GetReq() (v *OAuthStartReq) {
+	if !p.IsSetReq() {
+		return UserServiceStartOAuthArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceStartOAuthArgs) SetReq(val *OAuthStartReq) {
+	p.Req = val
+}
+
+func (p *UserServiceStartOAuthArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceStartOAuthArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceStartOAuthArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceStartOAuthArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceStartOAuthResult struct {
+	Success *OAuthStartResp `thrift:"success,0,optional" frugal:"0,optional,OAuthStartResp" json:"success,omitempty"`
+}
+
+func NewUserServiceStartOAuthResult() *UserServiceStartOAuthResult {
+	return &UserServiceStartOAuthResult{}
+}
+
+func (p *UserServiceStartOAuthResult) InitDefault() {
+}
+
+var UserServiceStartOAuthResult_Success_DEFAULT *OAuthStartResp
+
+func (p *UserServiceStartOAuthResult) GetSuccess() (v *OAuthStartResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceStartOAuthResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceStartOAuthResult) SetSuccess(x interface{}) {
+	p.Success = x.(*OAuthStartResp)
+}
+
+func (p *UserServiceStartOAuthResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceStartOAuthResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceStartOAuthResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceStartOAuthResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceOAuthCallbackArgs struct {
+	Req *OAuthCallbackReq `thrift:"req,1" frugal:"1,default,OAuthCallbackReq" json:"req"`
+}
+
+func NewUserServiceOAuthCallbackArgs() *UserServiceOAuthCallbackArgs {
+	return &UserServiceOAuthCallbackArgs{}
+}
+
+func (p *UserServiceOAuthCallbackArgs) InitDefault() {
+}
+
+var UserServiceOAuthCallbackArgs_Req_DEFAULT *OAuthCallbackReq
+
+func (p *UserServiceOAuthCallbackArgs) GetReq() (v *OAuthCallbackReq) {
+	if !p.IsSetReq() {
+		return UserServiceOAuthCallbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceOAuthCallbackArgs) SetReq(val *OAuthCallbackReq) {
+	p.Req = val
+}
+
+func (p *UserServiceOAuthCallbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceOAuthCallbackArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOAuthCallbackArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceOAuthCallbackArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceOAuthCallbackResult struct {
+	Success *LoginRegisterResp `thrift:"success,0,optional" frugal:"0,optional,LoginRegisterResp" json:"success,omitempty"`
+}
+
+func NewUserServiceOAuthCallbackResult() *UserServiceOAuthCallbackResult {
+	return &UserServiceOAuthCallbackResult{}
+}
+
+func (p *UserServiceOAuthCallbackResult) InitDefault() {
+}
+
+var UserServiceOAuthCallbackResult_Success_DEFAULT *LoginRegisterResp
+
+func (p *UserServiceOAuthCallbackResult) GetSuccess() (v *LoginRegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceOAuthCallbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceOAuthCallbackResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginRegisterResp)
+}
+
+func (p *UserServiceOAuthCallbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceOAuthCallbackResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOAuthCallbackResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceOAuthCallbackResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListIdentitiesArgs struct {
+	Req *IdentityListReq `thrift:"req,1" frugal:"1,default,IdentityListReq" json:"req"`
+}
+
+func NewUserServiceListIdentitiesArgs() *UserServiceListIdentitiesArgs {
+	return &UserServiceListIdentitiesArgs{}
+}
+
+func (p *UserServiceListIdentitiesArgs) InitDefault() {
+}
+
+var UserServiceListIdentitiesArgs_Req_DEFAULT *IdentityListReq
+
+func (p *UserServiceListIdentitiesArgs) GetReq() (v *IdentityListReq) {
+	if !p.IsSetReq() {
+		return UserServiceListIdentitiesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListIdentitiesArgs) SetReq(val *IdentityListReq) {
+	p.Req = val
+}
+
+func (p *UserServiceListIdentitiesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListIdentitiesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListIdentitiesArgs(%+v)", *p)
 }
 
-func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
-	return &UserServiceGetUserInfoArgs{}
+var fieldIDToName_UserServiceListIdentitiesArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *UserServiceGetUserInfoArgs) InitDefault() {
+type UserServiceListIdentitiesResult struct {
+	Success *IdentityListResp `thrift:"success,0,optional" frugal:"0,optional,IdentityListResp" json:"success,omitempty"`
 }
 
-var UserServiceGetUserInfoArgs_Req_DEFAULT *UserInfoReq
+func NewUserServiceListIdentitiesResult() *UserServiceListIdentitiesResult {
+	return &UserServiceListIdentitiesResult{}
+}
 
-func (p *UserServiceGetUserInfoArgs) GetReq() (v *UserInfoReq) {
+func (p *UserServiceListIdentitiesResult) InitDefault() {
+}
+
+var UserServiceListIdentitiesResult_Success_DEFAULT *IdentityListResp
+
+func (p *UserServiceListIdentitiesResult) GetSuccess() (v *IdentityListResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListIdentitiesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListIdentitiesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*IdentityListResp)
+}
+
+func (p *UserServiceListIdentitiesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListIdentitiesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListIdentitiesResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceListIdentitiesResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUnlinkIdentityArgs struct {
+	Req *UnlinkIdentityReq `thrift:"req,1" frugal:"1,default,UnlinkIdentityReq" json:"req"`
+}
+
+func NewUserServiceUnlinkIdentityArgs() *UserServiceUnlinkIdentityArgs {
+	return &UserServiceUnlinkIdentityArgs{}
+}
+
+func (p *UserServiceUnlinkIdentityArgs) InitDefault() {
+}
+
+var UserServiceUnlinkIdentityArgs_Req_DEFAULT *UnlinkIdentityReq
+
+func (p *UserServiceUnlinkIdentityArgs) GetReq() (v *UnlinkIdentityReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserInfoArgs_Req_DEFAULT
+		return UserServiceUnlinkIdentityArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetUserInfoArgs) SetReq(val *UserInfoReq) {
+func (p *UserServiceUnlinkIdentityArgs) SetReq(val *UnlinkIdentityReq) {
 	p.Req = val
 }
 
-func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *UserServiceUnlinkIdentityArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserInfoArgs) String() string {
+func (p *UserServiceUnlinkIdentityArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnlinkIdentityArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceUnlinkIdentityArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetUserInfoResult struct {
-	Success *UserInfoResp `thrift:"success,0,optional" frugal:"0,optional,UserInfoResp" json:"success,omitempty"`
+type UserServiceUnlinkIdentityResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
 }
 
-func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
-	return &UserServiceGetUserInfoResult{}
+func NewUserServiceUnlinkIdentityResult() *UserServiceUnlinkIdentityResult {
+	return &UserServiceUnlinkIdentityResult{}
 }
 
-func (p *UserServiceGetUserInfoResult) InitDefault() {
+func (p *UserServiceUnlinkIdentityResult) InitDefault() {
 }
 
-var UserServiceGetUserInfoResult_Success_DEFAULT *UserInfoResp
+var UserServiceUnlinkIdentityResult_Success_DEFAULT *common.BaseResp
 
-func (p *UserServiceGetUserInfoResult) GetSuccess() (v *UserInfoResp) {
+func (p *UserServiceUnlinkIdentityResult) GetSuccess() (v *common.BaseResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserInfoResult_Success_DEFAULT
+		return UserServiceUnlinkIdentityResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserInfoResp)
+func (p *UserServiceUnlinkIdentityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
 }
 
-func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *UserServiceUnlinkIdentityResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserInfoResult) String() string {
+func (p *UserServiceUnlinkIdentityResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnlinkIdentityResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_UserServiceUnlinkIdentityResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetUserInfoByUsernameArgs struct {
-	Req *UserInfoByUsernameReq `thrift:"req,1" frugal:"1,default,UserInfoByUsernameReq" json:"req"`
+type UserServiceRequestAccountDeletionArgs struct {
+	Req *AccountDeletionReq `thrift:"req,1" frugal:"1,default,AccountDeletionReq" json:"req"`
 }
 
-func NewUserServiceGetUserInfoByUsernameArgs() *UserServiceGetUserInfoByUsernameArgs {
-	return &UserServiceGetUserInfoByUsernameArgs{}
+func NewUserServiceRequestAccountDeletionArgs() *UserServiceRequestAccountDeletionArgs {
+	return &UserServiceRequestAccountDeletionArgs{}
 }
 
-func (p *UserServiceGetUserInfoByUsernameArgs) InitDefault() {
+func (p *UserServiceRequestAccountDeletionArgs) InitDefault() {
 }
 
-var UserServiceGetUserInfoByUsernameArgs_Req_DEFAULT *UserInfoByUsernameReq
+var UserServiceRequestAccountDeletionArgs_Req_DEFAULT *AccountDeletionReq
 
-func (p *UserServiceGetUserInfoByUsernameArgs) GetReq() (v *UserInfoByUsernameReq) {
+func (p *UserServiceRequestAccountDeletionArgs) GetReq() (v *AccountDeletionReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserInfoByUsernameArgs_Req_DEFAULT
+		return UserServiceRequestAccountDeletionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetUserInfoByUsernameArgs) SetReq(val *UserInfoByUsernameReq) {
+func (p *UserServiceRequestAccountDeletionArgs) SetReq(val *AccountDeletionReq) {
 	p.Req = val
 }
 
-func (p *UserServiceGetUserInfoByUsernameArgs) IsSetReq() bool {
+func (p *UserServiceRequestAccountDeletionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserInfoByUsernameArgs) String() string {
+func (p *UserServiceRequestAccountDeletionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoByUsernameArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceRequestAccountDeletionArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoByUsernameArgs = map[int16]string{
+var fieldIDToName_UserServiceRequestAccountDeletionArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetUserInfoByUsernameResult struct {
-	Success *UserInfoResp `thrift:"success,0,optional" frugal:"0,optional,UserInfoResp" json:"success,omitempty"`
+type UserServiceRequestAccountDeletionResult struct {
+	Success *AccountDeletionResp `thrift:"success,0,optional" frugal:"0,optional,AccountDeletionResp" json:"success,omitempty"`
 }
 
-func NewUserServiceGetUserInfoByUsernameResult() *UserServiceGetUserInfoByUsernameResult {
-	return &UserServiceGetUserInfoByUsernameResult{}
+func NewUserServiceRequestAccountDeletionResult() *UserServiceRequestAccountDeletionResult {
+	return &UserServiceRequestAccountDeletionResult{}
 }
 
-func (p *UserServiceGetUserInfoByUsernameResult) InitDefault() {
+func (p *UserServiceRequestAccountDeletionResult) InitDefault() {
 }
 
-var UserServiceGetUserInfoByUsernameResult_Success_DEFAULT *UserInfoResp
+var UserServiceRequestAccountDeletionResult_Success_DEFAULT *AccountDeletionResp
 
-func (p *UserServiceGetUserInfoByUsernameResult) GetSuccess() (v *UserInfoResp) {
+func (p *UserServiceRequestAccountDeletionResult) GetSuccess() (v *AccountDeletionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserInfoByUsernameResult_Success_DEFAULT
+		return UserServiceRequestAccountDeletionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetUserInfoByUsernameResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserInfoResp)
+func (p *UserServiceRequestAccountDeletionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AccountDeletionResp)
 }
 
-func (p *UserServiceGetUserInfoByUsernameResult) IsSetSuccess() bool {
+func (p *UserServiceRequestAccountDeletionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserInfoByUsernameResult) String() string {
+func (p *UserServiceRequestAccountDeletionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoByUsernameResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRequestAccountDeletionResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoByUsernameResult = map[int16]string{
+var fieldIDToName_UserServiceRequestAccountDeletionResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceBatchGetUserInfoArgs struct {
-	Req *BatchUserInfoReq `thrift:"req,1" frugal:"1,default,BatchUserInfoReq" json:"req"`
+type UserServiceGetAccountDeletionArgs struct {
+	Req *AccountDeletionStatusReq `thrift:"req,1" frugal:"1,default,AccountDeletionStatusReq" json:"req"`
 }
 
-func NewUserServiceBatchGetUserInfoArgs() *UserServiceBatchGetUserInfoArgs {
-	return &UserServiceBatchGetUserInfoArgs{}
+func NewUserServiceGetAccountDeletionArgs() *UserServiceGetAccountDeletionArgs {
+	return &UserServiceGetAccountDeletionArgs{}
 }
 
-func (p *UserServiceBatchGetUserInfoArgs) InitDefault() {
+func (p *UserServiceGetAccountDeletionArgs) InitDefault() {
 }
 
-var UserServiceBatchGetUserInfoArgs_Req_DEFAULT *BatchUserInfoReq
+var UserServiceGetAccountDeletionArgs_Req_DEFAULT *AccountDeletionStatusReq
 
-func (p *UserServiceBatchGetUserInfoArgs) GetReq() (v *BatchUserInfoReq) {
+func (p *UserServiceGetAccountDeletionArgs) GetReq() (v *AccountDeletionStatusReq) {
 	if !p.IsSetReq() {
-		return UserServiceBatchGetUserInfoArgs_Req_DEFAULT
+		return UserServiceGetAccountDeletionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceBatchGetUserInfoArgs) SetReq(val *BatchUserInfoReq) {
+func (p *UserServiceGetAccountDeletionArgs) SetReq(val *AccountDeletionStatusReq) {
 	p.Req = val
 }
 
-func (p *UserServiceBatchGetUserInfoArgs) IsSetReq() bool {
+func (p *UserServiceGetAccountDeletionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceBatchGetUserInfoArgs) String() string {
+func (p *UserServiceGetAccountDeletionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBatchGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetAccountDeletionArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceBatchGetUserInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceGetAccountDeletionArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceBatchGetUserInfoResult struct {
-	Success *BatchUserInfoResp `thrift:"success,0,optional" frugal:"0,optional,BatchUserInfoResp" json:"success,omitempty"`
+type UserServiceGetAccountDeletionResult struct {
+	Success *AccountDeletionResp `thrift:"success,0,optional" frugal:"0,optional,AccountDeletionResp" json:"success,omitempty"`
 }
 
-func NewUserServiceBatchGetUserInfoResult() *UserServiceBatchGetUserInfoResult {
-	return &UserServiceBatchGetUserInfoResult{}
+func NewUserServiceGetAccountDeletionResult() *UserServiceGetAccountDeletionResult {
+	return &UserServiceGetAccountDeletionResult{}
 }
 
-func (p *UserServiceBatchGetUserInfoResult) InitDefault() {
+func (p *UserServiceGetAccountDeletionResult) InitDefault() {
 }
 
-var UserServiceBatchGetUserInfoResult_Success_DEFAULT *BatchUserInfoResp
+var UserServiceGetAccountDeletionResult_Success_DEFAULT *AccountDeletionResp
 
-func (p *UserServiceBatchGetUserInfoResult) GetSuccess() (v *BatchUserInfoResp) {
+func (p *UserServiceGetAccountDeletionResult) GetSuccess() (v *AccountDeletionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceBatchGetUserInfoResult_Success_DEFAULT
+		return UserServiceGetAccountDeletionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceBatchGetUserInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchUserInfoResp)
+func (p *UserServiceGetAccountDeletionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AccountDeletionResp)
+}
+
+func (p *UserServiceGetAccountDeletionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetAccountDeletionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetAccountDeletionResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetAccountDeletionResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCancelAccountDeletionArgs struct {
+	Req *CancelAccountDeletionReq `thrift:"req,1" frugal:"1,default,CancelAccountDeletionReq" json:"req"`
+}
+
+func NewUserServiceCancelAccountDeletionArgs() *UserServiceCancelAccountDeletionArgs {
+	return &UserServiceCancelAccountDeletionArgs{}
+}
+
+func (p *UserServiceCancelAccountDeletionArgs) InitDefault() {
+}
+
+var UserServiceCancelAccountDeletionArgs_Req_DEFAULT *CancelAccountDeletionReq
+
+func (p *UserServiceCancelAccountDeletionArgs) GetReq() (v *CancelAccountDeletionReq) {
+	if !p.IsSetReq() {
+		return UserServiceCancelAccountDeletionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCancelAccountDeletionArgs) SetReq(val *CancelAccountDeletionReq) {
+	p.Req = val
+}
+
+func (p *UserServiceCancelAccountDeletionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCancelAccountDeletionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCancelAccountDeletionArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceCancelAccountDeletionArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCancelAccountDeletionResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+}
+
+func NewUserServiceCancelAccountDeletionResult() *UserServiceCancelAccountDeletionResult {
+	return &UserServiceCancelAccountDeletionResult{}
+}
+
+func (p *UserServiceCancelAccountDeletionResult) InitDefault() {
+}
+
+var UserServiceCancelAccountDeletionResult_Success_DEFAULT *common.BaseResp
+
+func (p *UserServiceCancelAccountDeletionResult) GetSuccess() (v *common.BaseResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCancelAccountDeletionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCancelAccountDeletionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
+}
+
+func (p *UserServiceCancelAccountDeletionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCancelAccountDeletionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCancelAccountDeletionResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceCancelAccountDeletionResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRequestDataExportArgs struct {
+	Req *DataExportReq `thrift:"req,1" frugal:"1,default,DataExportReq" json:"req"`
+}
+
+func NewUserServiceRequestDataExportArgs() *UserServiceRequestDataExportArgs {
+	return &UserServiceRequestDataExportArgs{}
+}
+
+func (p *UserServiceRequestDataExportArgs) InitDefault() {
+}
+
+var UserServiceRequestDataExportArgs_Req_DEFAULT *DataExportReq
+
+func (p *UserServiceRequestDataExportArgs) GetReq() (v *DataExportReq) {
+	if !p.IsSetReq() {
+		return UserServiceRequestDataExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRequestDataExportArgs) SetReq(val *DataExportReq) {
+	p.Req = val
+}
+
+func (p *UserServiceRequestDataExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRequestDataExportArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRequestDataExportArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRequestDataExportArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRequestDataExportResult struct {
+	Success *DataExportResp `thrift:"success,0,optional" frugal:"0,optional,DataExportResp" json:"success,omitempty"`
+}
+
+func NewUserServiceRequestDataExportResult() *UserServiceRequestDataExportResult {
+	return &UserServiceRequestDataExportResult{}
+}
+
+func (p *UserServiceRequestDataExportResult) InitDefault() {
+}
+
+var UserServiceRequestDataExportResult_Success_DEFAULT *DataExportResp
+
+func (p *UserServiceRequestDataExportResult) GetSuccess() (v *DataExportResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRequestDataExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRequestDataExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DataExportResp)
+}
+
+func (p *UserServiceRequestDataExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRequestDataExportResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRequestDataExportResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRequestDataExportResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetDataExportArgs struct {
+	Req *DataExportStatusReq `thrift:"req,1" frugal:"1,default,DataExportStatusReq" json:"req"`
+}
+
+func NewUserServiceGetDataExportArgs() *UserServiceGetDataExportArgs {
+	return &UserServiceGetDataExportArgs{}
+}
+
+func (p *UserServiceGetDataExportArgs) InitDefault() {
+}
+
+var UserServiceGetDataExportArgs_Req_DEFAULT *DataExportStatusReq
+
+func (p *UserServiceGetDataExportArgs) GetReq() (v *DataExportStatusReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetDataExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetDataExportArgs) SetReq(val *DataExportStatusReq) {
+	p.Req = val
+}
+
+func (p *UserServiceGetDataExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetDataExportArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetDataExportArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetDataExportArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetDataExportResult struct {
+	Success *DataExportResp `thrift:"success,0,optional" frugal:"0,optional,DataExportResp" json:"success,omitempty"`
+}
+
+func NewUserServiceGetDataExportResult() *UserServiceGetDataExportResult {
+	return &UserServiceGetDataExportResult{}
+}
+
+func (p *UserServiceGetDataExportResult) InitDefault() {
+}
+
+var UserServiceGetDataExportResult_Success_DEFAULT *DataExportResp
+
+func (p *UserServiceGetDataExportResult) GetSuccess() (v *DataExportResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetDataExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetDataExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DataExportResp)
 }
 
-func (p *UserServiceBatchGetUserInfoResult) IsSetSuccess() bool {
+func (p *UserServiceGetDataExportResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceBatchGetUserInfoResult) String() string {
+func (p *UserServiceGetDataExportResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBatchGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetDataExportResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceBatchGetUserInfoResult = map[int16]string{
+var fieldIDToName_UserServiceGetDataExportResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceUpdateUserArgs struct {
-	Req *UpdateUserReq `thrift:"req,1" frugal:"1,default,UpdateUserReq" json:"req"`
+type UserServiceGetUserRolesArgs struct {
+	Req *UserRolesReq `thrift:"req,1" frugal:"1,default,UserRolesReq" json:"req"`
 }
 
-func NewUserServiceUpdateUserArgs() *UserServiceUpdateUserArgs {
-	return &UserServiceUpdateUserArgs{}
+func NewUserServiceGetUserRolesArgs() *UserServiceGetUserRolesArgs {
+	return &UserServiceGetUserRolesArgs{}
 }
 
-func (p *UserServiceUpdateUserArgs) InitDefault() {
+func (p *UserServiceGetUserRolesArgs) InitDefault() {
 }
 
-var UserServiceUpdateUserArgs_Req_DEFAULT *UpdateUserReq
+var UserServiceGetUserRolesArgs_Req_DEFAULT *UserRolesReq
 
-func (p *UserServiceUpdateUserArgs) GetReq() (v *UpdateUserReq) {
+func (p *UserServiceGetUserRolesArgs) GetReq() (v *UserRolesReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateUserArgs_Req_DEFAULT
+		return UserServiceGetUserRolesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUpdateUserArgs) SetReq(val *UpdateUserReq) {
+func (p *UserServiceGetUserRolesArgs) SetReq(val *UserRolesReq) {
 	p.Req = val
 }
 
-func (p *UserServiceUpdateUserArgs) IsSetReq() bool {
+func (p *UserServiceGetUserRolesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateUserArgs) String() string {
+func (p *UserServiceGetUserRolesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserRolesArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateUserArgs = map[int16]string{
+var fieldIDToName_UserServiceGetUserRolesArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUpdateUserResult struct {
-	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+type UserServiceGetUserRolesResult struct {
+	Success *UserRolesResp `thrift:"success,0,optional" frugal:"0,optional,UserRolesResp" json:"success,omitempty"`
 }
 
-func NewUserServiceUpdateUserResult() *UserServiceUpdateUserResult {
-	return &UserServiceUpdateUserResult{}
+func NewUserServiceGetUserRolesResult() *UserServiceGetUserRolesResult {
+	return &UserServiceGetUserRolesResult{}
 }
 
-func (p *UserServiceUpdateUserResult) InitDefault() {
+func (p *UserServiceGetUserRolesResult) InitDefault() {
 }
 
-var UserServiceUpdateUserResult_Success_DEFAULT *common.BaseResp
+var UserServiceGetUserRolesResult_Success_DEFAULT *UserRolesResp
 
-func (p *UserServiceUpdateUserResult) GetSuccess() (v *common.BaseResp) {
+func (p *UserServiceGetUserRolesResult) GetSuccess() (v *UserRolesResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateUserResult_Success_DEFAULT
+		return UserServiceGetUserRolesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUpdateUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*common.BaseResp)
+func (p *UserServiceGetUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserRolesResp)
 }
 
-func (p *UserServiceUpdateUserResult) IsSetSuccess() bool {
+func (p *UserServiceGetUserRolesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateUserResult) String() string {
+func (p *UserServiceGetUserRolesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserRolesResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateUserResult = map[int16]string{
+var fieldIDToName_UserServiceGetUserRolesResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceUpdateAvatarArgs struct {
-	Req *UpdateAvatarReq `thrift:"req,1" frugal:"1,default,UpdateAvatarReq" json:"req"`
+type UserServiceAssignRoleArgs struct {
+	Req *RoleReq `thrift:"req,1" frugal:"1,default,RoleReq" json:"req"`
 }
 
-func NewUserServiceUpdateAvatarArgs() *UserServiceUpdateAvatarArgs {
-	return &UserServiceUpdateAvatarArgs{}
+func NewUserServiceAssignRoleArgs() *UserServiceAssignRoleArgs {
+	return &UserServiceAssignRoleArgs{}
 }
 
-func (p *UserServiceUpdateAvatarArgs) InitDefault() {
+func (p *UserServiceAssignRoleArgs) InitDefault() {
 }
 
-var UserServiceUpdateAvatarArgs_Req_DEFAULT *UpdateAvatarReq
+var UserServiceAssignRoleArgs_Req_DEFAULT *RoleReq
 
-func (p *UserServiceUpdateAvatarArgs) GetReq() (v *UpdateAvatarReq) {
+func (p *UserServiceAssignRoleArgs) GetReq() (v *RoleReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateAvatarArgs_Req_DEFAULT
+		return UserServiceAssignRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUpdateAvatarArgs) SetReq(val *UpdateAvatarReq) {
+func (p *UserServiceAssignRoleArgs) SetReq(val *RoleReq) {
 	p.Req = val
 }
 
-func (p *UserServiceUpdateAvatarArgs) IsSetReq() bool {
+func (p *UserServiceAssignRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateAvatarArgs) String() string {
+func (p *UserServiceAssignRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateAvatarArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceAssignRoleArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateAvatarArgs = map[int16]string{
+var fieldIDToName_UserServiceAssignRoleArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUpdateAvatarResult struct {
+type UserServiceAssignRoleResult struct {
 	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
 }
 
-func NewUserServiceUpdateAvatarResult() *UserServiceUpdateAvatarResult {
-	return &UserServiceUpdateAvatarResult{}
+func NewUserServiceAssignRoleResult() *UserServiceAssignRoleResult {
+	return &UserServiceAssignRoleResult{}
 }
 
-func (p *UserServiceUpdateAvatarResult) InitDefault() {
+func (p *UserServiceAssignRoleResult) InitDefault() {
 }
 
-var UserServiceUpdateAvatarResult_Success_DEFAULT *common.BaseResp
+var UserServiceAssignRoleResult_Success_DEFAULT *common.BaseResp
 
-func (p *UserServiceUpdateAvatarResult) GetSuccess() (v *common.BaseResp) {
+func (p *UserServiceAssignRoleResult) GetSuccess() (v *common.BaseResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateAvatarResult_Success_DEFAULT
+		return UserServiceAssignRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUpdateAvatarResult) SetSuccess(x interface{}) {
+func (p *UserServiceAssignRoleResult) SetSuccess(x interface{}) {
 	p.Success = x.(*common.BaseResp)
 }
 
-func (p *UserServiceUpdateAvatarResult) IsSetSuccess() bool {
+func (p *UserServiceAssignRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateAvatarResult) String() string {
+func (p *UserServiceAssignRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateAvatarResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceAssignRoleResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateAvatarResult = map[int16]string{
+var fieldIDToName_UserServiceAssignRoleResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceCheckUsernameArgs struct {
-	Req *CheckUsernameReq `thrift:"req,1" frugal:"1,default,CheckUsernameReq" json:"req"`
+type UserServiceRevokeRoleArgs struct {
+	Req *RoleReq `thrift:"req,1" frugal:"1,default,RoleReq" json:"req"`
 }
 
-func NewUserServiceCheckUsernameArgs() *UserServiceCheckUsernameArgs {
-	return &UserServiceCheckUsernameArgs{}
+func NewUserServiceRevokeRoleArgs() *UserServiceRevokeRoleArgs {
+	return &UserServiceRevokeRoleArgs{}
 }
 
-func (p *UserServiceCheckUsernameArgs) InitDefault() {
+func (p *UserServiceRevokeRoleArgs) InitDefault() {
 }
 
-var UserServiceCheckUsernameArgs_Req_DEFAULT *CheckUsernameReq
+var UserServiceRevokeRoleArgs_Req_DEFAULT *RoleReq
 
-func (p *UserServiceCheckUsernameArgs) GetReq() (v *CheckUsernameReq) {
+func (p *UserServiceRevokeRoleArgs) GetReq() (v *RoleReq) {
 	if !p.IsSetReq() {
-		return UserServiceCheckUsernameArgs_Req_DEFAULT
+		return UserServiceRevokeRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceCheckUsernameArgs) SetReq(val *CheckUsernameReq) {
+func (p *UserServiceRevokeRoleArgs) SetReq(val *RoleReq) {
 	p.Req = val
 }
 
-func (p *UserServiceCheckUsernameArgs) IsSetReq() bool {
+func (p *UserServiceRevokeRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceCheckUsernameArgs) String() string {
+func (p *UserServiceRevokeRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCheckUsernameArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceRevokeRoleArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceCheckUsernameArgs = map[int16]string{
+var fieldIDToName_UserServiceRevokeRoleArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceCheckUsernameResult struct {
-	Success *CheckUsernameResp `thrift:"success,0,optional" frugal:"0,optional,CheckUsernameResp" json:"success,omitempty"`
+type UserServiceRevokeRoleResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
 }
 
-func NewUserServiceCheckUsernameResult() *UserServiceCheckUsernameResult {
-	return &UserServiceCheckUsernameResult{}
+func NewUserServiceRevokeRoleResult() *UserServiceRevokeRoleResult {
+	return &UserServiceRevokeRoleResult{}
 }
 
-func (p *UserServiceCheckUsernameResult) InitDefault() {
+func (p *UserServiceRevokeRoleResult) InitDefault() {
 }
 
-var UserServiceCheckUsernameResult_Success_DEFAULT *CheckUsernameResp
+var UserServiceRevokeRoleResult_Success_DEFAULT *common.BaseResp
 
-func (p *UserServiceCheckUsernameResult) GetSuccess() (v *CheckUsernameResp) {
+func (p *UserServiceRevokeRoleResult) GetSuccess() (v *common.BaseResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceCheckUsernameResult_Success_DEFAULT
+		return UserServiceRevokeRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceCheckUsernameResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckUsernameResp)
+func (p *UserServiceRevokeRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
 }
 
-func (p *UserServiceCheckUsernameResult) IsSetSuccess() bool {
+func (p *UserServiceRevokeRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceCheckUsernameResult) String() string {
+func (p *UserServiceRevokeRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCheckUsernameResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRevokeRoleResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceCheckUsernameResult = map[int16]string{
+var fieldIDToName_UserServiceRevokeRoleResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceBatchCheckUsernamesArgs struct {
-	Req *BatchCheckUsernamesReq `thrift:"req,1" frugal:"1,default,BatchCheckUsernamesReq" json:"req"`
+type UserServiceBanUserArgs struct {
+	Req *BanUserReq `thrift:"req,1" frugal:"1,default,BanUserReq" json:"req"`
 }
 
-func NewUserServiceBatchCheckUsernamesArgs() *UserServiceBatchCheckUsernamesArgs {
-	return &UserServiceBatchCheckUsernamesArgs{}
+func NewUserServiceBanUserArgs() *UserServiceBanUserArgs {
+	return &UserServiceBanUserArgs{}
 }
 
-func (p *UserServiceBatchCheckUsernamesArgs) InitDefault() {
+func (p *UserServiceBanUserArgs) InitDefault() {
 }
 
-var UserServiceBatchCheckUsernamesArgs_Req_DEFAULT *BatchCheckUsernamesReq
+var UserServiceBanUserArgs_Req_DEFAULT *BanUserReq
 
-func (p *UserServiceBatchCheckUsernamesArgs) GetReq() (v *BatchCheckUsernamesReq) {
+func (p *UserServiceBanUserArgs) GetReq() (v *BanUserReq) {
 	if !p.IsSetReq() {
-		return UserServiceBatchCheckUsernamesArgs_Req_DEFAULT
+		return UserServiceBanUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceBatchCheckUsernamesArgs) SetReq(val *BatchCheckUsernamesReq) {
+func (p *UserServiceBanUserArgs) SetReq(val *BanUserReq) {
 	p.Req = val
 }
 
-func (p *UserServiceBatchCheckUsernamesArgs) IsSetReq() bool {
+func (p *UserServiceBanUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceBatchCheckUsernamesArgs) String() string {
+func (p *UserServiceBanUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBatchCheckUsernamesArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceBanUserArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceBatchCheckUsernamesArgs = map[int16]string{
+var fieldIDToName_UserServiceBanUserArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceBatchCheckUsernamesResult struct {
-	Success *BatchCheckUsernamesResp `thrift:"success,0,optional" frugal:"0,optional,BatchCheckUsernamesResp" json:"success,omitempty"`
+type UserServiceBanUserResult struct {
+	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
 }
 
-func NewUserServiceBatchCheckUsernamesResult() *UserServiceBatchCheckUsernamesResult {
-	return &UserServiceBatchCheckUsernamesResult{}
+func NewUserServiceBanUserResult() *UserServiceBanUserResult {
+	return &UserServiceBanUserResult{}
 }
 
-func (p *UserServiceBatchCheckUsernamesResult) InitDefault() {
+func (p *UserServiceBanUserResult) InitDefault() {
 }
 
-var UserServiceBatchCheckUsernamesResult_Success_DEFAULT *BatchCheckUsernamesResp
+var UserServiceBanUserResult_Success_DEFAULT *common.BaseResp
 
-func (p *UserServiceBatchCheckUsernamesResult) GetSuccess() (v *BatchCheckUsernamesResp) {
+func (p *UserServiceBanUserResult) GetSuccess() (v *common.BaseResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceBatchCheckUsernamesResult_Success_DEFAULT
+		return UserServiceBanUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceBatchCheckUsernamesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchCheckUsernamesResp)
+func (p *UserServiceBanUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResp)
 }
 
-func (p *UserServiceBatchCheckUsernamesResult) IsSetSuccess() bool {
+func (p *UserServiceBanUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceBatchCheckUsernamesResult) String() string {
+func (p *UserServiceBanUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceBatchCheckUsernamesResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceBanUserResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceBatchCheckUsernamesResult = map[int16]string{
+var fieldIDToName_UserServiceBanUserResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetUserStatsArgs struct {
-	Req *UserStatsReq `thrift:"req,1" frugal:"1,default,UserStatsReq" json:"req"`
+type UserServiceGetUserLevelArgs struct {
+	Req *UserLevelReq `thrift:"req,1" frugal:"1,default,UserLevelReq" json:"req"`
 }
 
-func NewUserServiceGetUserStatsArgs() *UserServiceGetUserStatsArgs {
-	return &UserServiceGetUserStatsArgs{}
+func NewUserServiceGetUserLevelArgs() *UserServiceGetUserLevelArgs {
+	return &UserServiceGetUserLevelArgs{}
 }
 
-func (p *UserServiceGetUserStatsArgs) InitDefault() {
+func (p *UserServiceGetUserLevelArgs) InitDefault() {
 }
 
-var UserServiceGetUserStatsArgs_Req_DEFAULT *UserStatsReq
+var UserServiceGetUserLevelArgs_Req_DEFAULT *UserLevelReq
 
-func (p *UserServiceGetUserStatsArgs) GetReq() (v *UserStatsReq) {
+func (p *UserServiceGetUserLevelArgs) GetReq() (v *UserLevelReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserStatsArgs_Req_DEFAULT
+		return UserServiceGetUserLevelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetUserStatsArgs) SetReq(val *UserStatsReq) {
+func (p *UserServiceGetUserLevelArgs) SetReq(val *UserLevelReq) {
 	p.Req = val
 }
 
-func (p *UserServiceGetUserStatsArgs) IsSetReq() bool {
+func (p *UserServiceGetUserLevelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserStatsArgs) String() string {
+func (p *UserServiceGetUserLevelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserStatsArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserLevelArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserStatsArgs = map[int16]string{
+var fieldIDToName_UserServiceGetUserLevelArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetUserStatsResult struct {
-	Success *UserStatsResp `thrift:"success,0,optional" frugal:"0,optional,UserStatsResp" json:"success,omitempty"`
+type UserServiceGetUserLevelResult struct {
+	Success *UserLevelResp `thrift:"success,0,optional" frugal:"0,optional,UserLevelResp" json:"success,omitempty"`
 }
 
-func NewUserServiceGetUserStatsResult() *UserServiceGetUserStatsResult {
-	return &UserServiceGetUserStatsResult{}
+func NewUserServiceGetUserLevelResult() *UserServiceGetUserLevelResult {
+	return &UserServiceGetUserLevelResult{}
 }
 
-func (p *UserServiceGetUserStatsResult) InitDefault() {
+func (p *UserServiceGetUserLevelResult) InitDefault() {
 }
 
-var UserServiceGetUserStatsResult_Success_DEFAULT *UserStatsResp
+var UserServiceGetUserLevelResult_Success_DEFAULT *UserLevelResp
 
-func (p *UserServiceGetUserStatsResult) GetSuccess() (v *UserStatsResp) {
+func (p *UserServiceGetUserLevelResult) GetSuccess() (v *UserLevelResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserStatsResult_Success_DEFAULT
+		return UserServiceGetUserLevelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetUserStatsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserStatsResp)
+func (p *UserServiceGetUserLevelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserLevelResp)
 }
 
-func (p *UserServiceGetUserStatsResult) IsSetSuccess() bool {
+func (p *UserServiceGetUserLevelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserStatsResult) String() string {
+func (p *UserServiceGetUserLevelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserStatsResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserLevelResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserStatsResult = map[int16]string{
+var fieldIDToName_UserServiceGetUserLevelResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceSearchUsersArgs struct {
-	Req *SearchUsersReq `thrift:"req,1" frugal:"1,default,SearchUsersReq" json:"req"`
+type UserServiceGetSettingsArgs struct {
+	Req *GetSettingsReq `thrift:"req,1" frugal:"1,default,GetSettingsReq" json:"req"`
 }
 
-func NewUserServiceSearchUsersArgs() *UserServiceSearchUsersArgs {
-	return &UserServiceSearchUsersArgs{}
+func NewUserServiceGetSettingsArgs() *UserServiceGetSettingsArgs {
+	return &UserServiceGetSettingsArgs{}
 }
 
-func (p *UserServiceSearchUsersArgs) InitDefault() {
+func (p *UserServiceGetSettingsArgs) InitDefault() {
 }
 
-var UserServiceSearchUsersArgs_Req_DEFAULT *SearchUsersReq
+var UserServiceGetSettingsArgs_Req_DEFAULT *GetSettingsReq
 
-func (p *UserServiceSearchUsersArgs) GetReq() (v *SearchUsersReq) {
+func (p *UserServiceGetSettingsArgs) GetReq() (v *GetSettingsReq) {
 	if !p.IsSetReq() {
-		return UserServiceSearchUsersArgs_Req_DEFAULT
+		return UserServiceGetSettingsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceSearchUsersArgs) SetReq(val *SearchUsersReq) {
+func (p *UserServiceGetSettingsArgs) SetReq(val *GetSettingsReq) {
 	p.Req = val
 }
 
-func (p *UserServiceSearchUsersArgs) IsSetReq() bool {
+func (p *UserServiceGetSettingsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceSearchUsersArgs) String() string {
+func (p *UserServiceGetSettingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSearchUsersArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetSettingsArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceSearchUsersArgs = map[int16]string{
+var fieldIDToName_UserServiceGetSettingsArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceSearchUsersResult struct {
-	Success *SearchUsersResp `thrift:"success,0,optional" frugal:"0,optional,SearchUsersResp" json:"success,omitempty"`
+type UserServiceGetSettingsResult struct {
+	Success *SettingsResp `thrift:"success,0,optional" frugal:"0,optional,SettingsResp" json:"success,omitempty"`
 }
 
-func NewUserServiceSearchUsersResult() *UserServiceSearchUsersResult {
-	return &UserServiceSearchUsersResult{}
+func NewUserServiceGetSettingsResult() *UserServiceGetSettingsResult {
+	return &UserServiceGetSettingsResult{}
 }
 
-func (p *UserServiceSearchUsersResult) InitDefault() {
+func (p *UserServiceGetSettingsResult) InitDefault() {
 }
 
-var UserServiceSearchUsersResult_Success_DEFAULT *SearchUsersResp
+var UserServiceGetSettingsResult_Success_DEFAULT *SettingsResp
 
-func (p *UserServiceSearchUsersResult) GetSuccess() (v *SearchUsersResp) {
+func (p *UserServiceGetSettingsResult) GetSuccess() (v *SettingsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceSearchUsersResult_Success_DEFAULT
+		return UserServiceGetSettingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceSearchUsersResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SearchUsersResp)
+func (p *UserServiceGetSettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SettingsResp)
 }
 
-func (p *UserServiceSearchUsersResult) IsSetSuccess() bool {
+func (p *UserServiceGetSettingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceSearchUsersResult) String() string {
+func (p *UserServiceGetSettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSearchUsersResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetSettingsResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceSearchUsersResult = map[int16]string{
+var fieldIDToName_UserServiceGetSettingsResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceUpdateFollowCountArgs struct {
-	Req *UpdateFollowCountReq `thrift:"req,1" frugal:"1,default,UpdateFollowCountReq" json:"req"`
+type UserServiceUpdateSettingsArgs struct {
+	Req *UpdateSettingsReq `thrift:"req,1" frugal:"1,default,UpdateSettingsReq" json:"req"`
 }
 
-func NewUserServiceUpdateFollowCountArgs() *UserServiceUpdateFollowCountArgs {
-	return &UserServiceUpdateFollowCountArgs{}
+func NewUserServiceUpdateSettingsArgs() *UserServiceUpdateSettingsArgs {
+	return &UserServiceUpdateSettingsArgs{}
 }
 
-func (p *UserServiceUpdateFollowCountArgs) InitDefault() {
+func (p *UserServiceUpdateSettingsArgs) InitDefault() {
 }
 
-var UserServiceUpdateFollowCountArgs_Req_DEFAULT *UpdateFollowCountReq
+var UserServiceUpdateSettingsArgs_Req_DEFAULT *UpdateSettingsReq
 
-func (p *UserServiceUpdateFollowCountArgs) GetReq() (v *UpdateFollowCountReq) {
+func (p *UserServiceUpdateSettingsArgs) GetReq() (v *UpdateSettingsReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateFollowCountArgs_Req_DEFAULT
+		return UserServiceUpdateSettingsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUpdateFollowCountArgs) SetReq(val *UpdateFollowCountReq) {
+func (p *UserServiceUpdateSettingsArgs) SetReq(val *UpdateSettingsReq) {
 	p.Req = val
 }
 
-func (p *UserServiceUpdateFollowCountArgs) IsSetReq() bool {
+func (p *UserServiceUpdateSettingsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateFollowCountArgs) String() string {
+func (p *UserServiceUpdateSettingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateFollowCountArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateSettingsArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateFollowCountArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdateSettingsArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUpdateFollowCountResult struct {
-	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+type UserServiceUpdateSettingsResult struct {
+	Success *SettingsResp `thrift:"success,0,optional" frugal:"0,optional,SettingsResp" json:"success,omitempty"`
 }
 
-func NewUserServiceUpdateFollowCountResult() *UserServiceUpdateFollowCountResult {
-	return &UserServiceUpdateFollowCountResult{}
+func NewUserServiceUpdateSettingsResult() *UserServiceUpdateSettingsResult {
+	return &UserServiceUpdateSettingsResult{}
 }
 
-func (p *UserServiceUpdateFollowCountResult) InitDefault() {
+func (p *UserServiceUpdateSettingsResult) InitDefault() {
 }
 
-var UserServiceUpdateFollowCountResult_Success_DEFAULT *common.BaseResp
+var UserServiceUpdateSettingsResult_Success_DEFAULT *SettingsResp
 
-func (p *UserServiceUpdateFollowCountResult) GetSuccess() (v *common.BaseResp) {
+func (p *UserServiceUpdateSettingsResult) GetSuccess() (v *SettingsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateFollowCountResult_Success_DEFAULT
+		return UserServiceUpdateSettingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUpdateFollowCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*common.BaseResp)
+func (p *UserServiceUpdateSettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SettingsResp)
 }
 
-func (p *UserServiceUpdateFollowCountResult) IsSetSuccess() bool {
+func (p *UserServiceUpdateSettingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateFollowCountResult) String() string {
+func (p *UserServiceUpdateSettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateFollowCountResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateSettingsResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateFollowCountResult = map[int16]string{
+var fieldIDToName_UserServiceUpdateSettingsResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceUpdateFollowerCountArgs struct {
-	Req *UpdateFollowerCountReq `thrift:"req,1" frugal:"1,default,UpdateFollowerCountReq" json:"req"`
+type UserServiceChangeUsernameArgs struct {
+	Req *ChangeUsernameReq `thrift:"req,1" frugal:"1,default,ChangeUsernameReq" json:"req"`
 }
 
-func NewUserServiceUpdateFollowerCountArgs() *UserServiceUpdateFollowerCountArgs {
-	return &UserServiceUpdateFollowerCountArgs{}
+func NewUserServiceChangeUsernameArgs() *UserServiceChangeUsernameArgs {
+	return &UserServiceChangeUsernameArgs{}
 }
 
-func (p *UserServiceUpdateFollowerCountArgs) InitDefault() {
+func (p *UserServiceChangeUsernameArgs) InitDefault() {
 }
 
-var UserServiceUpdateFollowerCountArgs_Req_DEFAULT *UpdateFollowerCountReq
+var UserServiceChangeUsernameArgs_Req_DEFAULT *ChangeUsernameReq
 
-func (p *UserServiceUpdateFollowerCountArgs) GetReq() (v *UpdateFollowerCountReq) {
+func (p *UserServiceChangeUsernameArgs) GetReq() (v *ChangeUsernameReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateFollowerCountArgs_Req_DEFAULT
+		return UserServiceChangeUsernameArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUpdateFollowerCountArgs) SetReq(val *UpdateFollowerCountReq) {
+func (p *UserServiceChangeUsernameArgs) SetReq(val *ChangeUsernameReq) {
 	p.Req = val
 }
 
-func (p *UserServiceUpdateFollowerCountArgs) IsSetReq() bool {
+func (p *UserServiceChangeUsernameArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateFollowerCountArgs) String() string {
+func (p *UserServiceChangeUsernameArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateFollowerCountArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceChangeUsernameArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateFollowerCountArgs = map[int16]string{
+var fieldIDToName_UserServiceChangeUsernameArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUpdateFollowerCountResult struct {
-	Success *common.BaseResp `thrift:"success,0,optional" frugal:"0,optional,common.BaseResp" json:"success,omitempty"`
+type UserServiceChangeUsernameResult struct {
+	Success *ChangeUsernameResp `thrift:"success,0,optional" frugal:"0,optional,ChangeUsernameResp" json:"success,omitempty"`
 }
 
-func NewUserServiceUpdateFollowerCountResult() *UserServiceUpdateFollowerCountResult {
-	return &UserServiceUpdateFollowerCountResult{}
+func NewUserServiceChangeUsernameResult() *UserServiceChangeUsernameResult {
+	return &UserServiceChangeUsernameResult{}
 }
 
-func (p *UserServiceUpdateFollowerCountResult) InitDefault() {
+func (p *UserServiceChangeUsernameResult) InitDefault() {
 }
 
-var UserServiceUpdateFollowerCountResult_Success_DEFAULT *common.BaseResp
+var UserServiceChangeUsernameResult_Success_DEFAULT *ChangeUsernameResp
 
-func (p *UserServiceUpdateFollowerCountResult) GetSuccess() (v *common.BaseResp) {
+func (p *UserServiceChangeUsernameResult) GetSuccess() (v *ChangeUsernameResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateFollowerCountResult_Success_DEFAULT
+		return UserServiceChangeUsernameResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUpdateFollowerCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*common.BaseResp)
+func (p *UserServiceChangeUsernameResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ChangeUsernameResp)
 }
 
-func (p *UserServiceUpdateFollowerCountResult) IsSetSuccess() bool {
+func (p *UserServiceChangeUsernameResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateFollowerCountResult) String() string {
+func (p *UserServiceChangeUsernameResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateFollowerCountResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceChangeUsernameResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUpdateFollowerCountResult = map[int16]string{
+var fieldIDToName_UserServiceChangeUsernameResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceVerifyTokenArgs struct {
-	Token string `thrift:"token,1" frugal:"1,default,string" json:"token"`
+type UserServiceGetUsernameHistoryArgs struct {
+	Req *UsernameHistoryReq `thrift:"req,1" frugal:"1,default,UsernameHistoryReq" json:"req"`
 }
 
-func NewUserServiceVerifyTokenArgs() *UserServiceVerifyTokenArgs {
-	return &UserServiceVerifyTokenArgs{}
+func NewUserServiceGetUsernameHistoryArgs() *UserServiceGetUsernameHistoryArgs {
+	return &UserServiceGetUsernameHistoryArgs{}
 }
 
-func (p *UserServiceVerifyTokenArgs) InitDefault() {
+func (p *UserServiceGetUsernameHistoryArgs) InitDefault() {
 }
 
-func (p *UserServiceVerifyTokenArgs) GetToken() (v string) {
-	return p.Token
+var UserServiceGetUsernameHistoryArgs_Req_DEFAULT *UsernameHistoryReq
+
+func (p *UserServiceGetUsernameHistoryArgs) GetReq() (v *UsernameHistoryReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUsernameHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *UserServiceVerifyTokenArgs) SetToken(val string) {
-	p.Token = val
+func (p *UserServiceGetUsernameHistoryArgs) SetReq(val *UsernameHistoryReq) {
+	p.Req = val
 }
 
-func (p *UserServiceVerifyTokenArgs) String() string {
+func (p *UserServiceGetUsernameHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUsernameHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceVerifyTokenArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUsernameHistoryArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceVerifyTokenArgs = map[int16]string{
-	1: "token",
+var fieldIDToName_UserServiceGetUsernameHistoryArgs = map[int16]string{
+	1: "req",
 }
 
-type UserServiceVerifyTokenResult struct {
-	Success *bool `thrift:"success,0,optional" frugal:"0,optional,bool" json:"success,omitempty"`
+type UserServiceGetUsernameHistoryResult struct {
+	Success *UsernameHistoryResp `thrift:"success,0,optional" frugal:"0,optional,UsernameHistoryResp" json:"success,omitempty"`
 }
 
-func NewUserServiceVerifyTokenResult() *UserServiceVerifyTokenResult {
-	return &UserServiceVerifyTokenResult{}
+func NewUserServiceGetUsernameHistoryResult() *UserServiceGetUsernameHistoryResult {
+	return &UserServiceGetUsernameHistoryResult{}
 }
 
-func (p *UserServiceVerifyTokenResult) InitDefault() {
+func (p *UserServiceGetUsernameHistoryResult) InitDefault() {
 }
 
-var UserServiceVerifyTokenResult_Success_DEFAULT bool
+var UserServiceGetUsernameHistoryResult_Success_DEFAULT *UsernameHistoryResp
 
-func (p *UserServiceVerifyTokenResult) GetSuccess() (v bool) {
+func (p *UserServiceGetUsernameHistoryResult) GetSuccess() (v *UsernameHistoryResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceVerifyTokenResult_Success_DEFAULT
+		return UserServiceGetUsernameHistoryResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
-func (p *UserServiceVerifyTokenResult) SetSuccess(x interface{}) {
-	p.Success = x.(*bool)
+func (p *UserServiceGetUsernameHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UsernameHistoryResp)
 }
 
-func (p *UserServiceVerifyTokenResult) IsSetSuccess() bool {
+func (p *UserServiceGetUsernameHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceVerifyTokenResult) String() string {
+func (p *UserServiceGetUsernameHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceVerifyTokenResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUsernameHistoryResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceVerifyTokenResult = map[int16]string{
+var fieldIDToName_UserServiceGetUsernameHistoryResult = map[int16]string{
 	0: "success",
 }
@@ -25,7 +25,36 @@ type Client interface {
 	SearchUsers(ctx context.Context, req *user.SearchUsersReq, callOptions ...callopt.Option) (r *user.SearchUsersResp, err error)
 	UpdateFollowCount(ctx context.Context, req *user.UpdateFollowCountReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
 	UpdateFollowerCount(ctx context.Context, req *user.UpdateFollowerCountReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
-	VerifyToken(ctx context.Context, token string, callOptions ...callopt.Option) (r bool, err error)
+	VerifyToken(ctx context.Context, token string, callOptions ...callopt.Option) (r *user.VerifyTokenResp, err error)
+	RefreshToken(ctx context.Context, req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error)
+	Logout(ctx context.Context, req *user.LogoutReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	LogoutAll(ctx context.Context, req *user.LogoutAllReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	GetSessions(ctx context.Context, req *user.SessionListReq, callOptions ...callopt.Option) (r *user.SessionListResp, err error)
+	RevokeSession(ctx context.Context, req *user.RevokeSessionReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	VerifyTwoFactorLogin(ctx context.Context, req *user.TwoFactorLoginReq, callOptions ...callopt.Option) (r *user.LoginRegisterResp, err error)
+	SetupTwoFactor(ctx context.Context, req *user.TwoFactorSetupReq, callOptions ...callopt.Option) (r *user.TwoFactorSetupResp, err error)
+	EnableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *user.RecoveryCodesResp, err error)
+	DisableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *user.RecoveryCodesResp, err error)
+	GetTwoFactorStatus(ctx context.Context, req *user.TwoFactorStatusReq, callOptions ...callopt.Option) (r *user.TwoFactorStatusResp, err error)
+	StartOAuth(ctx context.Context, req *user.OAuthStartReq, callOptions ...callopt.Option) (r *user.OAuthStartResp, err error)
+	OAuthCallback(ctx context.Context, req *user.OAuthCallbackReq, callOptions ...callopt.Option) (r *user.LoginRegisterResp, err error)
+	ListIdentities(ctx context.Context, req *user.IdentityListReq, callOptions ...callopt.Option) (r *user.IdentityListResp, err error)
+	UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	RequestAccountDeletion(ctx context.Context, req *user.AccountDeletionReq, callOptions ...callopt.Option) (r *user.AccountDeletionResp, err error)
+	GetAccountDeletion(ctx context.Context, req *user.AccountDeletionStatusReq, callOptions ...callopt.Option) (r *user.AccountDeletionResp, err error)
+	CancelAccountDeletion(ctx context.Context, req *user.CancelAccountDeletionReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	RequestDataExport(ctx context.Context, req *user.DataExportReq, callOptions ...callopt.Option) (r *user.DataExportResp, err error)
+	GetDataExport(ctx context.Context, req *user.DataExportStatusReq, callOptions ...callopt.Option) (r *user.DataExportResp, err error)
+	GetUserRoles(ctx context.Context, req *user.UserRolesReq, callOptions ...callopt.Option) (r *user.UserRolesResp, err error)
+	AssignRole(ctx context.Context, req *user.RoleReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	RevokeRole(ctx context.Context, req *user.RoleReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	BanUser(ctx context.Context, req *user.BanUserReq, callOptions ...callopt.Option) (r *common.BaseResp, err error)
+	GetUserLevel(ctx context.Context, req *user.UserLevelReq, callOptions ...callopt.Option) (r *user.UserLevelResp, err error)
+	GetSettings(ctx context.Context, req *user.GetSettingsReq, callOptions ...callopt.Option) (r *user.SettingsResp, err error)
+	UpdateSettings(ctx context.Context, req *user.UpdateSettingsReq, callOptions ...callopt.Option) (r *user.SettingsResp, err error)
+	ChangeUsername(ctx context.Context, req *user.ChangeUsernameReq, callOptions ...callopt.Option) (r *user.ChangeUsernameResp, err error)
+	GetUsernameHistory(ctx context.Context, req *user.UsernameHistoryReq, callOptions ...callopt.Option) (r *user.UsernameHistoryResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -122,7 +151,152 @@ func (p *kUserServiceClient) UpdateFollowerCount(ctx context.Context, req *user.
 	return p.kClient.UpdateFollowerCount(ctx, req)
 }
 
-func (p *kUserServiceClient) VerifyToken(ctx context.Context, token string, callOptions ...callopt.Option) (r bool, err error) {
+func (p *kUserServiceClient) VerifyToken(ctx context.Context, token string, callOptions ...callopt.Option) (r *user.VerifyTokenResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.VerifyToken(ctx, token)
 }
+
+func (p *kUserServiceClient) RefreshToken(ctx context.Context, req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, req)
+}
+
+func (p *kUserServiceClient) Logout(ctx context.Context, req *user.LogoutReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, req)
+}
+
+func (p *kUserServiceClient) LogoutAll(ctx context.Context, req *user.LogoutAllReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LogoutAll(ctx, req)
+}
+
+func (p *kUserServiceClient) GetSessions(ctx context.Context, req *user.SessionListReq, callOptions ...callopt.Option) (r *user.SessionListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSessions(ctx, req)
+}
+
+func (p *kUserServiceClient) RevokeSession(ctx context.Context, req *user.RevokeSessionReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeSession(ctx, req)
+}
+
+func (p *kUserServiceClient) VerifyTwoFactorLogin(ctx context.Context, req *user.TwoFactorLoginReq, callOptions ...callopt.Option) (r *user.LoginRegisterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyTwoFactorLogin(ctx, req)
+}
+
+func (p *kUserServiceClient) SetupTwoFactor(ctx context.Context, req *user.TwoFactorSetupReq, callOptions ...callopt.Option) (r *user.TwoFactorSetupResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetupTwoFactor(ctx, req)
+}
+
+func (p *kUserServiceClient) EnableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *user.RecoveryCodesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnableTwoFactor(ctx, req)
+}
+
+func (p *kUserServiceClient) DisableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTwoFactor(ctx, req)
+}
+
+func (p *kUserServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *user.TwoFactorCodeReq, callOptions ...callopt.Option) (r *user.RecoveryCodesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegenerateRecoveryCodes(ctx, req)
+}
+
+func (p *kUserServiceClient) GetTwoFactorStatus(ctx context.Context, req *user.TwoFactorStatusReq, callOptions ...callopt.Option) (r *user.TwoFactorStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTwoFactorStatus(ctx, req)
+}
+
+func (p *kUserServiceClient) StartOAuth(ctx context.Context, req *user.OAuthStartReq, callOptions ...callopt.Option) (r *user.OAuthStartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.StartOAuth(ctx, req)
+}
+
+func (p *kUserServiceClient) OAuthCallback(ctx context.Context, req *user.OAuthCallbackReq, callOptions ...callopt.Option) (r *user.LoginRegisterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.OAuthCallback(ctx, req)
+}
+
+func (p *kUserServiceClient) ListIdentities(ctx context.Context, req *user.IdentityListReq, callOptions ...callopt.Option) (r *user.IdentityListResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListIdentities(ctx, req)
+}
+
+func (p *kUserServiceClient) UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnlinkIdentity(ctx, req)
+}
+
+func (p *kUserServiceClient) RequestAccountDeletion(ctx context.Context, req *user.AccountDeletionReq, callOptions ...callopt.Option) (r *user.AccountDeletionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestAccountDeletion(ctx, req)
+}
+
+func (p *kUserServiceClient) GetAccountDeletion(ctx context.Context, req *user.AccountDeletionStatusReq, callOptions ...callopt.Option) (r *user.AccountDeletionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAccountDeletion(ctx, req)
+}
+
+func (p *kUserServiceClient) CancelAccountDeletion(ctx context.Context, req *user.CancelAccountDeletionReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelAccountDeletion(ctx, req)
+}
+
+func (p *kUserServiceClient) RequestDataExport(ctx context.Context, req *user.DataExportReq, callOptions ...callopt.Option) (r *user.DataExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestDataExport(ctx, req)
+}
+
+func (p *kUserServiceClient) GetDataExport(ctx context.Context, req *user.DataExportStatusReq, callOptions ...callopt.Option) (r *user.DataExportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDataExport(ctx, req)
+}
+
+func (p *kUserServiceClient) GetUserRoles(ctx context.Context, req *user.UserRolesReq, callOptions ...callopt.Option) (r *user.UserRolesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserRoles(ctx, req)
+}
+
+func (p *kUserServiceClient) AssignRole(ctx context.Context, req *user.RoleReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AssignRole(ctx, req)
+}
+
+func (p *kUserServiceClient) RevokeRole(ctx context.Context, req *user.RoleReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeRole(ctx, req)
+}
+
+func (p *kUserServiceClient) BanUser(ctx context.Context, req *user.BanUserReq, callOptions ...callopt.Option) (r *common.BaseResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BanUser(ctx, req)
+}
+
+func (p *kUserServiceClient) GetUserLevel(ctx context.Context, req *user.UserLevelReq, callOptions ...callopt.Option) (r *user.UserLevelResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserLevel(ctx, req)
+}
+
+func (p *kUserServiceClient) GetSettings(ctx context.Context, req *user.GetSettingsReq, callOptions ...callopt.Option) (r *user.SettingsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSettings(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdateSettings(ctx context.Context, req *user.UpdateSettingsReq, callOptions ...callopt.Option) (r *user.SettingsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateSettings(ctx, req)
+}
+
+func (p *kUserServiceClient) ChangeUsername(ctx context.Context, req *user.ChangeUsernameReq, callOptions ...callopt.Option) (r *user.ChangeUsernameResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChangeUsername(ctx, req)
+}
+
+func (p *kUserServiceClient) GetUsernameHistory(ctx context.Context, req *user.UsernameHistoryReq, callOptions ...callopt.Option) (r *user.UsernameHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUsernameHistory(ctx, req)
+}
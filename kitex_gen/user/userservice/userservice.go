@@ -112,6 +112,209 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RefreshToken": kitex.NewMethodInfo(
+		refreshTokenHandler,
+		newUserServiceRefreshTokenArgs,
+		newUserServiceRefreshTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Logout": kitex.NewMethodInfo(
+		logoutHandler,
+		newUserServiceLogoutArgs,
+		newUserServiceLogoutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"LogoutAll": kitex.NewMethodInfo(
+		logoutAllHandler,
+		newUserServiceLogoutAllArgs,
+		newUserServiceLogoutAllResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSessions": kitex.NewMethodInfo(
+		getSessionsHandler,
+		newUserServiceGetSessionsArgs,
+		newUserServiceGetSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeSession": kitex.NewMethodInfo(
+		revokeSessionHandler,
+		newUserServiceRevokeSessionArgs,
+		newUserServiceRevokeSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"VerifyTwoFactorLogin": kitex.NewMethodInfo(
+		verifyTwoFactorLoginHandler,
+		newUserServiceVerifyTwoFactorLoginArgs,
+		newUserServiceVerifyTwoFactorLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetupTwoFactor": kitex.NewMethodInfo(
+		setupTwoFactorHandler,
+		newUserServiceSetupTwoFactorArgs,
+		newUserServiceSetupTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EnableTwoFactor": kitex.NewMethodInfo(
+		enableTwoFactorHandler,
+		newUserServiceEnableTwoFactorArgs,
+		newUserServiceEnableTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DisableTwoFactor": kitex.NewMethodInfo(
+		disableTwoFactorHandler,
+		newUserServiceDisableTwoFactorArgs,
+		newUserServiceDisableTwoFactorResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RegenerateRecoveryCodes": kitex.NewMethodInfo(
+		regenerateRecoveryCodesHandler,
+		newUserServiceRegenerateRecoveryCodesArgs,
+		newUserServiceRegenerateRecoveryCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTwoFactorStatus": kitex.NewMethodInfo(
+		getTwoFactorStatusHandler,
+		newUserServiceGetTwoFactorStatusArgs,
+		newUserServiceGetTwoFactorStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"StartOAuth": kitex.NewMethodInfo(
+		startOAuthHandler,
+		newUserServiceStartOAuthArgs,
+		newUserServiceStartOAuthResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"OAuthCallback": kitex.NewMethodInfo(
+		oAuthCallbackHandler,
+		newUserServiceOAuthCallbackArgs,
+		newUserServiceOAuthCallbackResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListIdentities": kitex.NewMethodInfo(
+		listIdentitiesHandler,
+		newUserServiceListIdentitiesArgs,
+		newUserServiceListIdentitiesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnlinkIdentity": kitex.NewMethodInfo(
+		unlinkIdentityHandler,
+		newUserServiceUnlinkIdentityArgs,
+		newUserServiceUnlinkIdentityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RequestAccountDeletion": kitex.NewMethodInfo(
+		requestAccountDeletionHandler,
+		newUserServiceRequestAccountDeletionArgs,
+		newUserServiceRequestAccountDeletionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetAccountDeletion": kitex.NewMethodInfo(
+		getAccountDeletionHandler,
+		newUserServiceGetAccountDeletionArgs,
+		newUserServiceGetAccountDeletionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelAccountDeletion": kitex.NewMethodInfo(
+		cancelAccountDeletionHandler,
+		newUserServiceCancelAccountDeletionArgs,
+		newUserServiceCancelAccountDeletionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RequestDataExport": kitex.NewMethodInfo(
+		requestDataExportHandler,
+		newUserServiceRequestDataExportArgs,
+		newUserServiceRequestDataExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDataExport": kitex.NewMethodInfo(
+		getDataExportHandler,
+		newUserServiceGetDataExportArgs,
+		newUserServiceGetDataExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUserRoles": kitex.NewMethodInfo(
+		getUserRolesHandler,
+		newUserServiceGetUserRolesArgs,
+		newUserServiceGetUserRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AssignRole": kitex.NewMethodInfo(
+		assignRoleHandler,
+		newUserServiceAssignRoleArgs,
+		newUserServiceAssignRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeRole": kitex.NewMethodInfo(
+		revokeRoleHandler,
+		newUserServiceRevokeRoleArgs,
+		newUserServiceRevokeRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BanUser": kitex.NewMethodInfo(
+		banUserHandler,
+		newUserServiceBanUserArgs,
+		newUserServiceBanUserResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUserLevel": kitex.NewMethodInfo(
+		getUserLevelHandler,
+		newUserServiceGetUserLevelArgs,
+		newUserServiceGetUserLevelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSettings": kitex.NewMethodInfo(
+		getSettingsHandler,
+		newUserServiceGetSettingsArgs,
+		newUserServiceGetSettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateSettings": kitex.NewMethodInfo(
+		updateSettingsHandler,
+		newUserServiceUpdateSettingsArgs,
+		newUserServiceUpdateSettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ChangeUsername": kitex.NewMethodInfo(
+		changeUsernameHandler,
+		newUserServiceChangeUsernameArgs,
+		newUserServiceChangeUsernameResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUsernameHistory": kitex.NewMethodInfo(
+		getUsernameHistoryHandler,
+		newUserServiceGetUsernameHistoryArgs,
+		newUserServiceGetUsernameHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -419,7 +622,7 @@ func verifyTokenHandler(ctx context.Context, handler interface{}, arg, result in
 	if err != nil {
 		return err
 	}
-	realResult.Success = &success
+	realResult.Success = success
 	return nil
 }
 func newUserServiceVerifyTokenArgs() interface{} {
@@ -430,147 +633,669 @@ func newUserServiceVerifyTokenResult() interface{} {
 	return user.NewUserServiceVerifyTokenResult()
 }
 
-type kClient struct {
-	c client.Client
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRefreshTokenArgs)
+	realResult := result.(*user.UserServiceRefreshTokenResult)
+	success, err := handler.(user.UserService).RefreshToken(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRefreshTokenArgs() interface{} {
+	return user.NewUserServiceRefreshTokenArgs()
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
-	}
+func newUserServiceRefreshTokenResult() interface{} {
+	return user.NewUserServiceRefreshTokenResult()
 }
 
-func (p *kClient) Register(ctx context.Context, req *user.RegisterReq) (r *user.LoginRegisterResp, err error) {
-	var _args user.UserServiceRegisterArgs
-	_args.Req = req
-	var _result user.UserServiceRegisterResult
-	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
-		return
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceLogoutArgs)
+	realResult := result.(*user.UserServiceLogoutResult)
+	success, err := handler.(user.UserService).Logout(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceLogoutArgs() interface{} {
+	return user.NewUserServiceLogoutArgs()
 }
 
-func (p *kClient) Login(ctx context.Context, req *user.LoginReq) (r *user.LoginRegisterResp, err error) {
-	var _args user.UserServiceLoginArgs
-	_args.Req = req
-	var _result user.UserServiceLoginResult
-	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newUserServiceLogoutResult() interface{} {
+	return user.NewUserServiceLogoutResult()
 }
 
-func (p *kClient) GetUserInfo(ctx context.Context, req *user.UserInfoReq) (r *user.UserInfoResp, err error) {
-	var _args user.UserServiceGetUserInfoArgs
-	_args.Req = req
-	var _result user.UserServiceGetUserInfoResult
-	if err = p.c.Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
-		return
+func logoutAllHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceLogoutAllArgs)
+	realResult := result.(*user.UserServiceLogoutAllResult)
+	success, err := handler.(user.UserService).LogoutAll(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceLogoutAllArgs() interface{} {
+	return user.NewUserServiceLogoutAllArgs()
 }
 
-func (p *kClient) GetUserInfoByUsername(ctx context.Context, req *user.UserInfoByUsernameReq) (r *user.UserInfoResp, err error) {
-	var _args user.UserServiceGetUserInfoByUsernameArgs
-	_args.Req = req
-	var _result user.UserServiceGetUserInfoByUsernameResult
-	if err = p.c.Call(ctx, "GetUserInfoByUsername", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newUserServiceLogoutAllResult() interface{} {
+	return user.NewUserServiceLogoutAllResult()
 }
 
-func (p *kClient) BatchGetUserInfo(ctx context.Context, req *user.BatchUserInfoReq) (r *user.BatchUserInfoResp, err error) {
-	var _args user.UserServiceBatchGetUserInfoArgs
-	_args.Req = req
-	var _result user.UserServiceBatchGetUserInfoResult
-	if err = p.c.Call(ctx, "BatchGetUserInfo", &_args, &_result); err != nil {
-		return
+func getSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetSessionsArgs)
+	realResult := result.(*user.UserServiceGetSessionsResult)
+	success, err := handler.(user.UserService).GetSessions(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetSessionsArgs() interface{} {
+	return user.NewUserServiceGetSessionsArgs()
 }
 
-func (p *kClient) UpdateUser(ctx context.Context, req *user.UpdateUserReq) (r *common.BaseResp, err error) {
-	var _args user.UserServiceUpdateUserArgs
-	_args.Req = req
-	var _result user.UserServiceUpdateUserResult
-	if err = p.c.Call(ctx, "UpdateUser", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newUserServiceGetSessionsResult() interface{} {
+	return user.NewUserServiceGetSessionsResult()
 }
 
-func (p *kClient) UpdateAvatar(ctx context.Context, req *user.UpdateAvatarReq) (r *common.BaseResp, err error) {
-	var _args user.UserServiceUpdateAvatarArgs
-	_args.Req = req
-	var _result user.UserServiceUpdateAvatarResult
-	if err = p.c.Call(ctx, "UpdateAvatar", &_args, &_result); err != nil {
-		return
+func revokeSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRevokeSessionArgs)
+	realResult := result.(*user.UserServiceRevokeSessionResult)
+	success, err := handler.(user.UserService).RevokeSession(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRevokeSessionArgs() interface{} {
+	return user.NewUserServiceRevokeSessionArgs()
 }
 
-func (p *kClient) CheckUsername(ctx context.Context, req *user.CheckUsernameReq) (r *user.CheckUsernameResp, err error) {
-	var _args user.UserServiceCheckUsernameArgs
-	_args.Req = req
-	var _result user.UserServiceCheckUsernameResult
-	if err = p.c.Call(ctx, "CheckUsername", &_args, &_result); err != nil {
-		return
+func newUserServiceRevokeSessionResult() interface{} {
+	return user.NewUserServiceRevokeSessionResult()
+}
+
+func verifyTwoFactorLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceVerifyTwoFactorLoginArgs)
+	realResult := result.(*user.UserServiceVerifyTwoFactorLoginResult)
+	success, err := handler.(user.UserService).VerifyTwoFactorLogin(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceVerifyTwoFactorLoginArgs() interface{} {
+	return user.NewUserServiceVerifyTwoFactorLoginArgs()
 }
 
-func (p *kClient) BatchCheckUsernames(ctx context.Context, req *user.BatchCheckUsernamesReq) (r *user.BatchCheckUsernamesResp, err error) {
-	var _args user.UserServiceBatchCheckUsernamesArgs
-	_args.Req = req
-	var _result user.UserServiceBatchCheckUsernamesResult
-	if err = p.c.Call(ctx, "BatchCheckUsernames", &_args, &_result); err != nil {
-		return
+func newUserServiceVerifyTwoFactorLoginResult() interface{} {
+	return user.NewUserServiceVerifyTwoFactorLoginResult()
+}
+
+func setupTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceSetupTwoFactorArgs)
+	realResult := result.(*user.UserServiceSetupTwoFactorResult)
+	success, err := handler.(user.UserService).SetupTwoFactor(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceSetupTwoFactorArgs() interface{} {
+	return user.NewUserServiceSetupTwoFactorArgs()
 }
 
-func (p *kClient) GetUserStats(ctx context.Context, req *user.UserStatsReq) (r *user.UserStatsResp, err error) {
-	var _args user.UserServiceGetUserStatsArgs
-	_args.Req = req
-	var _result user.UserServiceGetUserStatsResult
-	if err = p.c.Call(ctx, "GetUserStats", &_args, &_result); err != nil {
-		return
+func newUserServiceSetupTwoFactorResult() interface{} {
+	return user.NewUserServiceSetupTwoFactorResult()
+}
+
+func enableTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceEnableTwoFactorArgs)
+	realResult := result.(*user.UserServiceEnableTwoFactorResult)
+	success, err := handler.(user.UserService).EnableTwoFactor(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceEnableTwoFactorArgs() interface{} {
+	return user.NewUserServiceEnableTwoFactorArgs()
 }
 
-func (p *kClient) SearchUsers(ctx context.Context, req *user.SearchUsersReq) (r *user.SearchUsersResp, err error) {
-	var _args user.UserServiceSearchUsersArgs
-	_args.Req = req
-	var _result user.UserServiceSearchUsersResult
-	if err = p.c.Call(ctx, "SearchUsers", &_args, &_result); err != nil {
-		return
+func newUserServiceEnableTwoFactorResult() interface{} {
+	return user.NewUserServiceEnableTwoFactorResult()
+}
+
+func disableTwoFactorHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceDisableTwoFactorArgs)
+	realResult := result.(*user.UserServiceDisableTwoFactorResult)
+	success, err := handler.(user.UserService).DisableTwoFactor(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceDisableTwoFactorArgs() interface{} {
+	return user.NewUserServiceDisableTwoFactorArgs()
 }
 
-func (p *kClient) UpdateFollowCount(ctx context.Context, req *user.UpdateFollowCountReq) (r *common.BaseResp, err error) {
-	var _args user.UserServiceUpdateFollowCountArgs
-	_args.Req = req
-	var _result user.UserServiceUpdateFollowCountResult
-	if err = p.c.Call(ctx, "UpdateFollowCount", &_args, &_result); err != nil {
-		return
+func newUserServiceDisableTwoFactorResult() interface{} {
+	return user.NewUserServiceDisableTwoFactorResult()
+}
+
+func regenerateRecoveryCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRegenerateRecoveryCodesArgs)
+	realResult := result.(*user.UserServiceRegenerateRecoveryCodesResult)
+	success, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRegenerateRecoveryCodesArgs() interface{} {
+	return user.NewUserServiceRegenerateRecoveryCodesArgs()
 }
 
-func (p *kClient) UpdateFollowerCount(ctx context.Context, req *user.UpdateFollowerCountReq) (r *common.BaseResp, err error) {
-	var _args user.UserServiceUpdateFollowerCountArgs
-	_args.Req = req
-	var _result user.UserServiceUpdateFollowerCountResult
-	if err = p.c.Call(ctx, "UpdateFollowerCount", &_args, &_result); err != nil {
-		return
+func newUserServiceRegenerateRecoveryCodesResult() interface{} {
+	return user.NewUserServiceRegenerateRecoveryCodesResult()
+}
+
+func getTwoFactorStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetTwoFactorStatusArgs)
+	realResult := result.(*user.UserServiceGetTwoFactorStatusResult)
+	success, err := handler.(user.UserService).GetTwoFactorStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetTwoFactorStatusArgs() interface{} {
+	return user.NewUserServiceGetTwoFactorStatusArgs()
 }
 
-func (p *kClient) VerifyToken(ctx context.Context, token string) (r bool, err error) {
+func newUserServiceGetTwoFactorStatusResult() interface{} {
+	return user.NewUserServiceGetTwoFactorStatusResult()
+}
+
+func startOAuthHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceStartOAuthArgs)
+	realResult := result.(*user.UserServiceStartOAuthResult)
+	success, err := handler.(user.UserService).StartOAuth(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceStartOAuthArgs() interface{} {
+	return user.NewUserServiceStartOAuthArgs()
+}
+
+func newUserServiceStartOAuthResult() interface{} {
+	return user.NewUserServiceStartOAuthResult()
+}
+
+func oAuthCallbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceOAuthCallbackArgs)
+	realResult := result.(*user.UserServiceOAuthCallbackResult)
+	success, err := handler.(user.UserService).OAuthCallback(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceOAuthCallbackArgs() interface{} {
+	return user.NewUserServiceOAuthCallbackArgs()
+}
+
+func newUserServiceOAuthCallbackResult() interface{} {
+	return user.NewUserServiceOAuthCallbackResult()
+}
+
+func listIdentitiesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListIdentitiesArgs)
+	realResult := result.(*user.UserServiceListIdentitiesResult)
+	success, err := handler.(user.UserService).ListIdentities(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListIdentitiesArgs() interface{} {
+	return user.NewUserServiceListIdentitiesArgs()
+}
+
+func newUserServiceListIdentitiesResult() interface{} {
+	return user.NewUserServiceListIdentitiesResult()
+}
+
+func unlinkIdentityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUnlinkIdentityArgs)
+	realResult := result.(*user.UserServiceUnlinkIdentityResult)
+	success, err := handler.(user.UserService).UnlinkIdentity(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUnlinkIdentityArgs() interface{} {
+	return user.NewUserServiceUnlinkIdentityArgs()
+}
+
+func newUserServiceUnlinkIdentityResult() interface{} {
+	return user.NewUserServiceUnlinkIdentityResult()
+}
+
+func requestAccountDeletionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRequestAccountDeletionArgs)
+	realResult := result.(*user.UserServiceRequestAccountDeletionResult)
+	success, err := handler.(user.UserService).RequestAccountDeletion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRequestAccountDeletionArgs() interface{} {
+	return user.NewUserServiceRequestAccountDeletionArgs()
+}
+
+func newUserServiceRequestAccountDeletionResult() interface{} {
+	return user.NewUserServiceRequestAccountDeletionResult()
+}
+
+func getAccountDeletionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetAccountDeletionArgs)
+	realResult := result.(*user.UserServiceGetAccountDeletionResult)
+	success, err := handler.(user.UserService).GetAccountDeletion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetAccountDeletionArgs() interface{} {
+	return user.NewUserServiceGetAccountDeletionArgs()
+}
+
+func newUserServiceGetAccountDeletionResult() interface{} {
+	return user.NewUserServiceGetAccountDeletionResult()
+}
+
+func cancelAccountDeletionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCancelAccountDeletionArgs)
+	realResult := result.(*user.UserServiceCancelAccountDeletionResult)
+	success, err := handler.(user.UserService).CancelAccountDeletion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCancelAccountDeletionArgs() interface{} {
+	return user.NewUserServiceCancelAccountDeletionArgs()
+}
+
+func newUserServiceCancelAccountDeletionResult() interface{} {
+	return user.NewUserServiceCancelAccountDeletionResult()
+}
+
+func requestDataExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRequestDataExportArgs)
+	realResult := result.(*user.UserServiceRequestDataExportResult)
+	success, err := handler.(user.UserService).RequestDataExport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRequestDataExportArgs() interface{} {
+	return user.NewUserServiceRequestDataExportArgs()
+}
+
+func newUserServiceRequestDataExportResult() interface{} {
+	return user.NewUserServiceRequestDataExportResult()
+}
+
+func getDataExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetDataExportArgs)
+	realResult := result.(*user.UserServiceGetDataExportResult)
+	success, err := handler.(user.UserService).GetDataExport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetDataExportArgs() interface{} {
+	return user.NewUserServiceGetDataExportArgs()
+}
+
+func newUserServiceGetDataExportResult() interface{} {
+	return user.NewUserServiceGetDataExportResult()
+}
+
+func getUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetUserRolesArgs)
+	realResult := result.(*user.UserServiceGetUserRolesResult)
+	success, err := handler.(user.UserService).GetUserRoles(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetUserRolesArgs() interface{} {
+	return user.NewUserServiceGetUserRolesArgs()
+}
+
+func newUserServiceGetUserRolesResult() interface{} {
+	return user.NewUserServiceGetUserRolesResult()
+}
+
+func assignRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceAssignRoleArgs)
+	realResult := result.(*user.UserServiceAssignRoleResult)
+	success, err := handler.(user.UserService).AssignRole(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceAssignRoleArgs() interface{} {
+	return user.NewUserServiceAssignRoleArgs()
+}
+
+func newUserServiceAssignRoleResult() interface{} {
+	return user.NewUserServiceAssignRoleResult()
+}
+
+func revokeRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceRevokeRoleArgs)
+	realResult := result.(*user.UserServiceRevokeRoleResult)
+	success, err := handler.(user.UserService).RevokeRole(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceRevokeRoleArgs() interface{} {
+	return user.NewUserServiceRevokeRoleArgs()
+}
+
+func newUserServiceRevokeRoleResult() interface{} {
+	return user.NewUserServiceRevokeRoleResult()
+}
+
+func banUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceBanUserArgs)
+	realResult := result.(*user.UserServiceBanUserResult)
+	success, err := handler.(user.UserService).BanUser(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceBanUserArgs() interface{} {
+	return user.NewUserServiceBanUserArgs()
+}
+
+func newUserServiceBanUserResult() interface{} {
+	return user.NewUserServiceBanUserResult()
+}
+
+func getUserLevelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetUserLevelArgs)
+	realResult := result.(*user.UserServiceGetUserLevelResult)
+	success, err := handler.(user.UserService).GetUserLevel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetUserLevelArgs() interface{} {
+	return user.NewUserServiceGetUserLevelArgs()
+}
+
+func newUserServiceGetUserLevelResult() interface{} {
+	return user.NewUserServiceGetUserLevelResult()
+}
+
+func getSettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetSettingsArgs)
+	realResult := result.(*user.UserServiceGetSettingsResult)
+	success, err := handler.(user.UserService).GetSettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetSettingsArgs() interface{} {
+	return user.NewUserServiceGetSettingsArgs()
+}
+
+func newUserServiceGetSettingsResult() interface{} {
+	return user.NewUserServiceGetSettingsResult()
+}
+
+func updateSettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdateSettingsArgs)
+	realResult := result.(*user.UserServiceUpdateSettingsResult)
+	success, err := handler.(user.UserService).UpdateSettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateSettingsArgs() interface{} {
+	return user.NewUserServiceUpdateSettingsArgs()
+}
+
+func newUserServiceUpdateSettingsResult() interface{} {
+	return user.NewUserServiceUpdateSettingsResult()
+}
+
+func changeUsernameHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceChangeUsernameArgs)
+	realResult := result.(*user.UserServiceChangeUsernameResult)
+	success, err := handler.(user.UserService).ChangeUsername(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceChangeUsernameArgs() interface{} {
+	return user.NewUserServiceChangeUsernameArgs()
+}
+
+func newUserServiceChangeUsernameResult() interface{} {
+	return user.NewUserServiceChangeUsernameResult()
+}
+
+func getUsernameHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetUsernameHistoryArgs)
+	realResult := result.(*user.UserServiceGetUsernameHistoryResult)
+	success, err := handler.(user.UserService).GetUsernameHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetUsernameHistoryArgs() interface{} {
+	return user.NewUserServiceGetUsernameHistoryArgs()
+}
+
+func newUserServiceGetUsernameHistoryResult() interface{} {
+	return user.NewUserServiceGetUsernameHistoryResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, req *user.RegisterReq) (r *user.LoginRegisterResp, err error) {
+	var _args user.UserServiceRegisterArgs
+	_args.Req = req
+	var _result user.UserServiceRegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, req *user.LoginReq) (r *user.LoginRegisterResp, err error) {
+	var _args user.UserServiceLoginArgs
+	_args.Req = req
+	var _result user.UserServiceLoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserInfo(ctx context.Context, req *user.UserInfoReq) (r *user.UserInfoResp, err error) {
+	var _args user.UserServiceGetUserInfoArgs
+	_args.Req = req
+	var _result user.UserServiceGetUserInfoResult
+	if err = p.c.Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserInfoByUsername(ctx context.Context, req *user.UserInfoByUsernameReq) (r *user.UserInfoResp, err error) {
+	var _args user.UserServiceGetUserInfoByUsernameArgs
+	_args.Req = req
+	var _result user.UserServiceGetUserInfoByUsernameResult
+	if err = p.c.Call(ctx, "GetUserInfoByUsername", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchGetUserInfo(ctx context.Context, req *user.BatchUserInfoReq) (r *user.BatchUserInfoResp, err error) {
+	var _args user.UserServiceBatchGetUserInfoArgs
+	_args.Req = req
+	var _result user.UserServiceBatchGetUserInfoResult
+	if err = p.c.Call(ctx, "BatchGetUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateUser(ctx context.Context, req *user.UpdateUserReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceUpdateUserArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateUserResult
+	if err = p.c.Call(ctx, "UpdateUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAvatar(ctx context.Context, req *user.UpdateAvatarReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceUpdateAvatarArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateAvatarResult
+	if err = p.c.Call(ctx, "UpdateAvatar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckUsername(ctx context.Context, req *user.CheckUsernameReq) (r *user.CheckUsernameResp, err error) {
+	var _args user.UserServiceCheckUsernameArgs
+	_args.Req = req
+	var _result user.UserServiceCheckUsernameResult
+	if err = p.c.Call(ctx, "CheckUsername", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchCheckUsernames(ctx context.Context, req *user.BatchCheckUsernamesReq) (r *user.BatchCheckUsernamesResp, err error) {
+	var _args user.UserServiceBatchCheckUsernamesArgs
+	_args.Req = req
+	var _result user.UserServiceBatchCheckUsernamesResult
+	if err = p.c.Call(ctx, "BatchCheckUsernames", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserStats(ctx context.Context, req *user.UserStatsReq) (r *user.UserStatsResp, err error) {
+	var _args user.UserServiceGetUserStatsArgs
+	_args.Req = req
+	var _result user.UserServiceGetUserStatsResult
+	if err = p.c.Call(ctx, "GetUserStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchUsers(ctx context.Context, req *user.SearchUsersReq) (r *user.SearchUsersResp, err error) {
+	var _args user.UserServiceSearchUsersArgs
+	_args.Req = req
+	var _result user.UserServiceSearchUsersResult
+	if err = p.c.Call(ctx, "SearchUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateFollowCount(ctx context.Context, req *user.UpdateFollowCountReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceUpdateFollowCountArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateFollowCountResult
+	if err = p.c.Call(ctx, "UpdateFollowCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateFollowerCount(ctx context.Context, req *user.UpdateFollowerCountReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceUpdateFollowerCountArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateFollowerCountResult
+	if err = p.c.Call(ctx, "UpdateFollowerCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyToken(ctx context.Context, token string) (r *user.VerifyTokenResp, err error) {
 	var _args user.UserServiceVerifyTokenArgs
 	_args.Token = token
 	var _result user.UserServiceVerifyTokenResult
@@ -579,3 +1304,293 @@ func (p *kClient) VerifyToken(ctx context.Context, token string) (r bool, err er
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (r *user.RefreshTokenResp, err error) {
+	var _args user.UserServiceRefreshTokenArgs
+	_args.Req = req
+	var _result user.UserServiceRefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, req *user.LogoutReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceLogoutArgs
+	_args.Req = req
+	var _result user.UserServiceLogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LogoutAll(ctx context.Context, req *user.LogoutAllReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceLogoutAllArgs
+	_args.Req = req
+	var _result user.UserServiceLogoutAllResult
+	if err = p.c.Call(ctx, "LogoutAll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSessions(ctx context.Context, req *user.SessionListReq) (r *user.SessionListResp, err error) {
+	var _args user.UserServiceGetSessionsArgs
+	_args.Req = req
+	var _result user.UserServiceGetSessionsResult
+	if err = p.c.Call(ctx, "GetSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeSession(ctx context.Context, req *user.RevokeSessionReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceRevokeSessionArgs
+	_args.Req = req
+	var _result user.UserServiceRevokeSessionResult
+	if err = p.c.Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyTwoFactorLogin(ctx context.Context, req *user.TwoFactorLoginReq) (r *user.LoginRegisterResp, err error) {
+	var _args user.UserServiceVerifyTwoFactorLoginArgs
+	_args.Req = req
+	var _result user.UserServiceVerifyTwoFactorLoginResult
+	if err = p.c.Call(ctx, "VerifyTwoFactorLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetupTwoFactor(ctx context.Context, req *user.TwoFactorSetupReq) (r *user.TwoFactorSetupResp, err error) {
+	var _args user.UserServiceSetupTwoFactorArgs
+	_args.Req = req
+	var _result user.UserServiceSetupTwoFactorResult
+	if err = p.c.Call(ctx, "SetupTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq) (r *user.RecoveryCodesResp, err error) {
+	var _args user.UserServiceEnableTwoFactorArgs
+	_args.Req = req
+	var _result user.UserServiceEnableTwoFactorResult
+	if err = p.c.Call(ctx, "EnableTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTwoFactor(ctx context.Context, req *user.TwoFactorCodeReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceDisableTwoFactorArgs
+	_args.Req = req
+	var _result user.UserServiceDisableTwoFactorResult
+	if err = p.c.Call(ctx, "DisableTwoFactor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegenerateRecoveryCodes(ctx context.Context, req *user.TwoFactorCodeReq) (r *user.RecoveryCodesResp, err error) {
+	var _args user.UserServiceRegenerateRecoveryCodesArgs
+	_args.Req = req
+	var _result user.UserServiceRegenerateRecoveryCodesResult
+	if err = p.c.Call(ctx, "RegenerateRecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTwoFactorStatus(ctx context.Context, req *user.TwoFactorStatusReq) (r *user.TwoFactorStatusResp, err error) {
+	var _args user.UserServiceGetTwoFactorStatusArgs
+	_args.Req = req
+	var _result user.UserServiceGetTwoFactorStatusResult
+	if err = p.c.Call(ctx, "GetTwoFactorStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) StartOAuth(ctx context.Context, req *user.OAuthStartReq) (r *user.OAuthStartResp, err error) {
+	var _args user.UserServiceStartOAuthArgs
+	_args.Req = req
+	var _result user.UserServiceStartOAuthResult
+	if err = p.c.Call(ctx, "StartOAuth", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OAuthCallback(ctx context.Context, req *user.OAuthCallbackReq) (r *user.LoginRegisterResp, err error) {
+	var _args user.UserServiceOAuthCallbackArgs
+	_args.Req = req
+	var _result user.UserServiceOAuthCallbackResult
+	if err = p.c.Call(ctx, "OAuthCallback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListIdentities(ctx context.Context, req *user.IdentityListReq) (r *user.IdentityListResp, err error) {
+	var _args user.UserServiceListIdentitiesArgs
+	_args.Req = req
+	var _result user.UserServiceListIdentitiesResult
+	if err = p.c.Call(ctx, "ListIdentities", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceUnlinkIdentityArgs
+	_args.Req = req
+	var _result user.UserServiceUnlinkIdentityResult
+	if err = p.c.Call(ctx, "UnlinkIdentity", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestAccountDeletion(ctx context.Context, req *user.AccountDeletionReq) (r *user.AccountDeletionResp, err error) {
+	var _args user.UserServiceRequestAccountDeletionArgs
+	_args.Req = req
+	var _result user.UserServiceRequestAccountDeletionResult
+	if err = p.c.Call(ctx, "RequestAccountDeletion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAccountDeletion(ctx context.Context, req *user.AccountDeletionStatusReq) (r *user.AccountDeletionResp, err error) {
+	var _args user.UserServiceGetAccountDeletionArgs
+	_args.Req = req
+	var _result user.UserServiceGetAccountDeletionResult
+	if err = p.c.Call(ctx, "GetAccountDeletion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelAccountDeletion(ctx context.Context, req *user.CancelAccountDeletionReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceCancelAccountDeletionArgs
+	_args.Req = req
+	var _result user.UserServiceCancelAccountDeletionResult
+	if err = p.c.Call(ctx, "CancelAccountDeletion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestDataExport(ctx context.Context, req *user.DataExportReq) (r *user.DataExportResp, err error) {
+	var _args user.UserServiceRequestDataExportArgs
+	_args.Req = req
+	var _result user.UserServiceRequestDataExportResult
+	if err = p.c.Call(ctx, "RequestDataExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDataExport(ctx context.Context, req *user.DataExportStatusReq) (r *user.DataExportResp, err error) {
+	var _args user.UserServiceGetDataExportArgs
+	_args.Req = req
+	var _result user.UserServiceGetDataExportResult
+	if err = p.c.Call(ctx, "GetDataExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserRoles(ctx context.Context, req *user.UserRolesReq) (r *user.UserRolesResp, err error) {
+	var _args user.UserServiceGetUserRolesArgs
+	_args.Req = req
+	var _result user.UserServiceGetUserRolesResult
+	if err = p.c.Call(ctx, "GetUserRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AssignRole(ctx context.Context, req *user.RoleReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceAssignRoleArgs
+	_args.Req = req
+	var _result user.UserServiceAssignRoleResult
+	if err = p.c.Call(ctx, "AssignRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeRole(ctx context.Context, req *user.RoleReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceRevokeRoleArgs
+	_args.Req = req
+	var _result user.UserServiceRevokeRoleResult
+	if err = p.c.Call(ctx, "RevokeRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BanUser(ctx context.Context, req *user.BanUserReq) (r *common.BaseResp, err error) {
+	var _args user.UserServiceBanUserArgs
+	_args.Req = req
+	var _result user.UserServiceBanUserResult
+	if err = p.c.Call(ctx, "BanUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserLevel(ctx context.Context, req *user.UserLevelReq) (r *user.UserLevelResp, err error) {
+	var _args user.UserServiceGetUserLevelArgs
+	_args.Req = req
+	var _result user.UserServiceGetUserLevelResult
+	if err = p.c.Call(ctx, "GetUserLevel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSettings(ctx context.Context, req *user.GetSettingsReq) (r *user.SettingsResp, err error) {
+	var _args user.UserServiceGetSettingsArgs
+	_args.Req = req
+	var _result user.UserServiceGetSettingsResult
+	if err = p.c.Call(ctx, "GetSettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateSettings(ctx context.Context, req *user.UpdateSettingsReq) (r *user.SettingsResp, err error) {
+	var _args user.UserServiceUpdateSettingsArgs
+	_args.Req = req
+	var _result user.UserServiceUpdateSettingsResult
+	if err = p.c.Call(ctx, "UpdateSettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ChangeUsername(ctx context.Context, req *user.ChangeUsernameReq) (r *user.ChangeUsernameResp, err error) {
+	var _args user.UserServiceChangeUsernameArgs
+	_args.Req = req
+	var _result user.UserServiceChangeUsernameResult
+	if err = p.c.Call(ctx, "ChangeUsername", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUsernameHistory(ctx context.Context, req *user.UsernameHistoryReq) (r *user.UsernameHistoryResp, err error) {
+	var _args user.UserServiceGetUsernameHistoryArgs
+	_args.Req = req
+	var _result user.UserServiceGetUsernameHistoryResult
+	if err = p.c.Call(ctx, "GetUsernameHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
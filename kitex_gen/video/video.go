@@ -9,11 +9,13 @@ import (
 )
 
 type PublishVideoReq struct {
-	UserId      int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	Title       string `thrift:"title,2" frugal:"2,default,string" json:"title"`
-	VideoUrl    string `thrift:"videoUrl,3" frugal:"3,default,string" json:"videoUrl"`
-	CoverUrl    string `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
-	Description string `thrift:"description,5" frugal:"5,default,string" json:"description"`
+	UserId         int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Title          string `thrift:"title,2" frugal:"2,default,string" json:"title"`
+	VideoUrl       string `thrift:"videoUrl,3" frugal:"3,default,string" json:"videoUrl"`
+	CoverUrl       string `thrift:"coverUrl,4" frugal:"4,default,string" json:"coverUrl"`
+	Description    string `thrift:"description,5" frugal:"5,default,string" json:"description"`
+	AudienceListId *int64 `thrift:"audienceListId,6,optional" frugal:"6,optional,i64" json:"audienceListId,omitempty"`
+	RemixOfVideoId *int64 `thrift:"remixOfVideoId,7,optional" frugal:"7,optional,i64" json:"remixOfVideoId,omitempty"`
 }
 
 func NewPublishVideoReq() *PublishVideoReq {
@@ -42,6 +44,24 @@ func (p *PublishVideoReq) GetCoverUrl() (v string) {
 func (p *PublishVideoReq) GetDescription() (v string) {
 	return p.Description
 }
+
+var PublishVideoReq_AudienceListId_DEFAULT int64
+
+func (p *PublishVideoReq) GetAudienceListId() (v int64) {
+	if !p.IsSetAudienceListId() {
+		return PublishVideoReq_AudienceListId_DEFAULT
+	}
+	return *p.AudienceListId
+}
+
+var PublishVideoReq_RemixOfVideoId_DEFAULT int64
+
+func (p *PublishVideoReq) GetRemixOfVideoId() (v int64) {
+	if !p.IsSetRemixOfVideoId() {
+		return PublishVideoReq_RemixOfVideoId_DEFAULT
+	}
+	return *p.RemixOfVideoId
+}
 func (p *PublishVideoReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -57,6 +77,20 @@ func (p *PublishVideoReq) SetCoverUrl(val string) {
 func (p *PublishVideoReq) SetDescription(val string) {
 	p.Description = val
 }
+func (p *PublishVideoReq) SetAudienceListId(val *int64) {
+	p.AudienceListId = val
+}
+func (p *PublishVideoReq) SetRemixOfVideoId(val *int64) {
+	p.RemixOfVideoId = val
+}
+
+func (p *PublishVideoReq) IsSetAudienceListId() bool {
+	return p.AudienceListId != nil
+}
+
+func (p *PublishVideoReq) IsSetRemixOfVideoId() bool {
+	return p.RemixOfVideoId != nil
+}
 
 func (p *PublishVideoReq) String() string {
 	if p == nil {
@@ -71,6 +105,8 @@ var fieldIDToName_PublishVideoReq = map[int16]string{
 	3: "videoUrl",
 	4: "coverUrl",
 	5: "description",
+	6: "audienceListId",
+	7: "remixOfVideoId",
 }
 
 type PublishVideoResp struct {
@@ -121,10 +157,12 @@ var fieldIDToName_PublishVideoResp = map[int16]string{
 }
 
 type UserVideoListReq struct {
-	UserId        int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
-	CurrentUserId int64 `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
-	Page          int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize      int32 `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	UserId        int64   `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	CurrentUserId int64   `thrift:"currentUserId,2" frugal:"2,default,i64" json:"currentUserId"`
+	Page          int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize      int32   `thrift:"pageSize,4" frugal:"4,default,i32" json:"pageSize"`
+	Cursor        *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
+	NeedTotal     *bool   `thrift:"needTotal,6,optional" frugal:"6,optional,bool" json:"needTotal,omitempty"`
 }
 
 func NewUserVideoListReq() *UserVideoListReq {
@@ -149,6 +187,24 @@ func (p *UserVideoListReq) GetPage() (v int32) {
 func (p *UserVideoListReq) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var UserVideoListReq_Cursor_DEFAULT string
+
+func (p *UserVideoListReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return UserVideoListReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var UserVideoListReq_NeedTotal_DEFAULT bool
+
+func (p *UserVideoListReq) GetNeedTotal() (v bool) {
+	if !p.IsSetNeedTotal() {
+		return UserVideoListReq_NeedTotal_DEFAULT
+	}
+	return *p.NeedTotal
+}
 func (p *UserVideoListReq) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -161,6 +217,20 @@ func (p *UserVideoListReq) SetPage(val int32) {
 func (p *UserVideoListReq) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *UserVideoListReq) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *UserVideoListReq) SetNeedTotal(val *bool) {
+	p.NeedTotal = val
+}
+
+func (p *UserVideoListReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *UserVideoListReq) IsSetNeedTotal() bool {
+	return p.NeedTotal != nil
+}
 
 func (p *UserVideoListReq) String() string {
 	if p == nil {
@@ -174,12 +244,16 @@ var fieldIDToName_UserVideoListReq = map[int16]string{
 	2: "currentUserId",
 	3: "page",
 	4: "pageSize",
+	5: "cursor",
+	6: "needTotal",
 }
 
 type UserVideoListResp struct {
 	BaseResp   *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 	Videos     []*common.Video  `thrift:"videos,2" frugal:"2,default,list<common.Video>" json:"videos"`
 	TotalCount int32            `thrift:"totalCount,3" frugal:"3,default,i32" json:"totalCount"`
+	NextCursor *string          `thrift:"nextCursor,4,optional" frugal:"4,optional,string" json:"nextCursor,omitempty"`
+	HasMore    bool             `thrift:"hasMore,5" frugal:"5,default,bool" json:"hasMore"`
 }
 
 func NewUserVideoListResp() *UserVideoListResp {
@@ -205,6 +279,19 @@ func (p *UserVideoListResp) GetVideos() (v []*common.Video) {
 func (p *UserVideoListResp) GetTotalCount() (v int32) {
 	return p.TotalCount
 }
+
+var UserVideoListResp_NextCursor_DEFAULT string
+
+func (p *UserVideoListResp) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return UserVideoListResp_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *UserVideoListResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 func (p *UserVideoListResp) SetBaseResp(val *common.BaseResp) {
 	p.BaseResp = val
 }
@@ -214,11 +301,21 @@ func (p *UserVideoListResp) SetVideos(val []*common.Video) {
 func (p *UserVideoListResp) SetTotalCount(val int32) {
 	p.TotalCount = val
 }
+func (p *UserVideoListResp) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *UserVideoListResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
 
 func (p *UserVideoListResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *UserVideoListResp) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *UserVideoListResp) String() string {
 	if p == nil {
 		return "<nil>"
@@ -230,6 +327,8 @@ var fieldIDToName_UserVideoListResp = map[int16]string{
 	1: "BaseResp",
 	2: "videos",
 	3: "totalCount",
+	4: "nextCursor",
+	5: "hasMore",
 }
 
 type FeedReq struct {
@@ -703,10 +802,11 @@ var fieldIDToName_DeleteVideoResp = map[int16]string{
 }
 
 type UpdateVideoInfoReq struct {
-	VideoId     int64   `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
-	UserId      int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
-	Title       *string `thrift:"title,3,optional" frugal:"3,optional,string" json:"title,omitempty"`
-	Description *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	VideoId        int64   `thrift:"videoId,1" frugal:"1,default,i64" json:"videoId"`
+	UserId         int64   `thrift:"userId,2" frugal:"2,default,i64" json:"userId"`
+	Title          *string `thrift:"title,3,optional" frugal:"3,optional,string" json:"title,omitempty"`
+	Description    *string `thrift:"description,4,optional" frugal:"4,optional,string" json:"description,omitempty"`
+	AudienceListId *int64  `thrift:"audienceListId,5,optional" frugal:"5,optional,i64" json:"audienceListId,omitempty"`
 }
 
 func NewUpdateVideoInfoReq() *UpdateVideoInfoReq {
@@ -741,6 +841,15 @@ func (p *UpdateVideoInfoReq) GetDescription() (v string) {
 	}
 	return *p.Description
 }
+
+var UpdateVideoInfoReq_AudienceListId_DEFAULT int64
+
+func (p *UpdateVideoInfoReq) GetAudienceListId() (v int64) {
+	if !p.IsSetAudienceListId() {
+		return UpdateVideoInfoReq_AudienceListId_DEFAULT
+	}
+	return *p.AudienceListId
+}
 func (p *UpdateVideoInfoReq) SetVideoId(val int64) {
 	p.VideoId = val
 }
@@ -753,6 +862,9 @@ func (p *UpdateVideoInfoReq) SetTitle(val *string) {
 func (p *UpdateVideoInfoReq) SetDescription(val *string) {
 	p.Description = val
 }
+func (p *UpdateVideoInfoReq) SetAudienceListId(val *int64) {
+	p.AudienceListId = val
+}
 
 func (p *UpdateVideoInfoReq) IsSetTitle() bool {
 	return p.Title != nil
@@ -762,6 +874,10 @@ func (p *UpdateVideoInfoReq) IsSetDescription() bool {
 	return p.Description != nil
 }
 
+func (p *UpdateVideoInfoReq) IsSetAudienceListId() bool {
+	return p.AudienceListId != nil
+}
+
 func (p *UpdateVideoInfoReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -774,6 +890,7 @@ var fieldIDToName_UpdateVideoInfoReq = map[int16]string{
 	2: "userId",
 	3: "title",
 	4: "description",
+	5: "audienceListId",
 }
 
 type UpdateVideoInfoResp struct {
@@ -1312,1018 +1429,2151 @@ var fieldIDToName_GetTotalVideoCountResp = map[int16]string{
 	2: "count",
 }
 
-type VideoService interface {
-	PublishVideo(ctx context.Context, req *PublishVideoReq) (r *PublishVideoResp, err error)
+type WatchHistoryItem struct {
+	Video     *common.Video `thrift:"video,1" frugal:"1,default,common.Video" json:"video"`
+	Position  int32         `thrift:"position,2" frugal:"2,default,i32" json:"position"`
+	Duration  int32         `thrift:"duration,3" frugal:"3,default,i32" json:"duration"`
+	Progress  float64       `thrift:"progress,4" frugal:"4,default,double" json:"progress"`
+	WatchedAt int64         `thrift:"watchedAt,5" frugal:"5,default,i64" json:"watchedAt"`
+}
 
-	GetUserVideoList(ctx context.Context, req *UserVideoListReq) (r *UserVideoListResp, err error)
+func NewWatchHistoryItem() *WatchHistoryItem {
+	return &WatchHistoryItem{}
+}
 
-	GetFeed(ctx context.Context, req *FeedReq) (r *FeedResp, err error)
+func (p *WatchHistoryItem) InitDefault() {
+}
 
-	SearchVideo(ctx context.Context, req *SearchVideoReq) (r *SearchVideoResp, err error)
+var WatchHistoryItem_Video_DEFAULT *common.Video
 
-	GetVideoDetail(ctx context.Context, req *VideoDetailReq) (r *VideoDetailResp, err error)
+func (p *WatchHistoryItem) GetVideo() (v *common.Video) {
+	if !p.IsSetVideo() {
+		return WatchHistoryItem_Video_DEFAULT
+	}
+	return p.Video
+}
 
-	BatchGetVideoInfo(ctx context.Context, req *BatchVideoInfoReq) (r *BatchVideoInfoResp, err error)
+func (p *WatchHistoryItem) GetPosition() (v int32) {
+	return p.Position
+}
 
-	DeleteVideo(ctx context.Context, req *DeleteVideoReq) (r *DeleteVideoResp, err error)
+func (p *WatchHistoryItem) GetDuration() (v int32) {
+	return p.Duration
+}
 
-	UpdateVideoInfo(ctx context.Context, req *UpdateVideoInfoReq) (r *UpdateVideoInfoResp, err error)
+func (p *WatchHistoryItem) GetProgress() (v float64) {
+	return p.Progress
+}
 
-	GetVideoStats(ctx context.Context, req *VideoStatsReq) (r *VideoStatsResp, err error)
+func (p *WatchHistoryItem) GetWatchedAt() (v int64) {
+	return p.WatchedAt
+}
+func (p *WatchHistoryItem) SetVideo(val *common.Video) {
+	p.Video = val
+}
+func (p *WatchHistoryItem) SetPosition(val int32) {
+	p.Position = val
+}
+func (p *WatchHistoryItem) SetDuration(val int32) {
+	p.Duration = val
+}
+func (p *WatchHistoryItem) SetProgress(val float64) {
+	p.Progress = val
+}
+func (p *WatchHistoryItem) SetWatchedAt(val int64) {
+	p.WatchedAt = val
+}
 
-	GetHotVideos(ctx context.Context, req *HotVideoReq) (r *HotVideoResp, err error)
+func (p *WatchHistoryItem) IsSetVideo() bool {
+	return p.Video != nil
+}
 
-	UploadVideo(ctx context.Context, req *UploadVideoReq) (r *UploadVideoResp, err error)
+func (p *WatchHistoryItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WatchHistoryItem(%+v)", *p)
+}
 
-	GetUserVideoCount(ctx context.Context, req *GetUserVideoCountReq) (r *GetUserVideoCountResp, err error)
+var fieldIDToName_WatchHistoryItem = map[int16]string{
+	1: "video",
+	2: "position",
+	3: "duration",
+	4: "progress",
+	5: "watchedAt",
+}
 
-	GetTotalVideoCount(ctx context.Context, req *GetTotalVideoCountReq) (r *GetTotalVideoCountResp, err error)
+type ReportWatchProgressReq struct {
+	UserId   int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId  int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+	Position int32 `thrift:"position,3" frugal:"3,default,i32" json:"position"`
+	Duration int32 `thrift:"duration,4" frugal:"4,default,i32" json:"duration"`
+	Finished *bool `thrift:"finished,5,optional" frugal:"5,optional,bool" json:"finished,omitempty"`
 }
 
-type VideoServicePublishVideoArgs struct {
-	Req *PublishVideoReq `thrift:"req,1" frugal:"1,default,PublishVideoReq" json:"req"`
+func NewReportWatchProgressReq() *ReportWatchProgressReq {
+	return &ReportWatchProgressReq{}
 }
 
-func NewVideoServicePublishVideoArgs() *VideoServicePublishVideoArgs {
-	return &VideoServicePublishVideoArgs{}
+func (p *ReportWatchProgressReq) InitDefault() {
 }
 
-func (p *VideoServicePublishVideoArgs) InitDefault() {
+func (p *ReportWatchProgressReq) GetUserId() (v int64) {
+	return p.UserId
 }
 
-var VideoServicePublishVideoArgs_Req_DEFAULT *PublishVideoReq
+func (p *ReportWatchProgressReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
 
-func (p *VideoServicePublishVideoArgs) GetReq() (v *PublishVideoReq) {
-	if !p.IsSetReq() {
-		return VideoServicePublishVideoArgs_Req_DEFAULT
+func (p *ReportWatchProgressReq) GetPosition() (v int32) {
+	return p.Position
+}
+
+func (p *ReportWatchProgressReq) GetDuration() (v int32) {
+	return p.Duration
+}
+
+var ReportWatchProgressReq_Finished_DEFAULT bool
+
+func (p *ReportWatchProgressReq) GetFinished() (v bool) {
+	if !p.IsSetFinished() {
+		return ReportWatchProgressReq_Finished_DEFAULT
 	}
-	return p.Req
+	return *p.Finished
 }
-func (p *VideoServicePublishVideoArgs) SetReq(val *PublishVideoReq) {
-	p.Req = val
+func (p *ReportWatchProgressReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ReportWatchProgressReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ReportWatchProgressReq) SetPosition(val int32) {
+	p.Position = val
+}
+func (p *ReportWatchProgressReq) SetDuration(val int32) {
+	p.Duration = val
+}
+func (p *ReportWatchProgressReq) SetFinished(val *bool) {
+	p.Finished = val
 }
 
-func (p *VideoServicePublishVideoArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *ReportWatchProgressReq) IsSetFinished() bool {
+	return p.Finished != nil
 }
 
-func (p *VideoServicePublishVideoArgs) String() string {
+func (p *ReportWatchProgressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishVideoArgs(%+v)", *p)
+	return fmt.Sprintf("ReportWatchProgressReq(%+v)", *p)
 }
 
-var fieldIDToName_VideoServicePublishVideoArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_ReportWatchProgressReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+	3: "position",
+	4: "duration",
+	5: "finished",
 }
 
-type VideoServicePublishVideoResult struct {
-	Success *PublishVideoResp `thrift:"success,0,optional" frugal:"0,optional,PublishVideoResp" json:"success,omitempty"`
+type ReportWatchProgressResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
 }
 
-func NewVideoServicePublishVideoResult() *VideoServicePublishVideoResult {
-	return &VideoServicePublishVideoResult{}
+func NewReportWatchProgressResp() *ReportWatchProgressResp {
+	return &ReportWatchProgressResp{}
 }
 
-func (p *VideoServicePublishVideoResult) InitDefault() {
+func (p *ReportWatchProgressResp) InitDefault() {
 }
 
-var VideoServicePublishVideoResult_Success_DEFAULT *PublishVideoResp
+var ReportWatchProgressResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *VideoServicePublishVideoResult) GetSuccess() (v *PublishVideoResp) {
-	if !p.IsSetSuccess() {
-		return VideoServicePublishVideoResult_Success_DEFAULT
+func (p *ReportWatchProgressResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReportWatchProgressResp_BaseResp_DEFAULT
 	}
-	return p.Success
+	return p.BaseResp
 }
-func (p *VideoServicePublishVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PublishVideoResp)
+func (p *ReportWatchProgressResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *VideoServicePublishVideoResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *ReportWatchProgressResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *VideoServicePublishVideoResult) String() string {
+func (p *ReportWatchProgressResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishVideoResult(%+v)", *p)
+	return fmt.Sprintf("ReportWatchProgressResp(%+v)", *p)
 }
 
-var fieldIDToName_VideoServicePublishVideoResult = map[int16]string{
-	0: "success",
+var fieldIDToName_ReportWatchProgressResp = map[int16]string{
+	1: "BaseResp",
 }
 
-type VideoServiceGetUserVideoListArgs struct {
-	Req *UserVideoListReq `thrift:"req,1" frugal:"1,default,UserVideoListReq" json:"req"`
+type WatchHistoryReq struct {
+	UserId   int64  `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Cursor   *int64 `thrift:"cursor,2,optional" frugal:"2,optional,i64" json:"cursor,omitempty"`
+	PageSize int32  `thrift:"pageSize,3" frugal:"3,default,i32" json:"pageSize"`
 }
 
-func NewVideoServiceGetUserVideoListArgs() *VideoServiceGetUserVideoListArgs {
-	return &VideoServiceGetUserVideoListArgs{}
+func NewWatchHistoryReq() *WatchHistoryReq {
+	return &WatchHistoryReq{}
 }
 
-func (p *VideoServiceGetUserVideoListArgs) InitDefault() {
+func (p *WatchHistoryReq) InitDefault() {
 }
 
-var VideoServiceGetUserVideoListArgs_Req_DEFAULT *UserVideoListReq
+func (p *WatchHistoryReq) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *VideoServiceGetUserVideoListArgs) GetReq() (v *UserVideoListReq) {
-	if !p.IsSetReq() {
-		return VideoServiceGetUserVideoListArgs_Req_DEFAULT
+var WatchHistoryReq_Cursor_DEFAULT int64
+
+func (p *WatchHistoryReq) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return WatchHistoryReq_Cursor_DEFAULT
 	}
-	return p.Req
+	return *p.Cursor
 }
-func (p *VideoServiceGetUserVideoListArgs) SetReq(val *UserVideoListReq) {
-	p.Req = val
+
+func (p *WatchHistoryReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *WatchHistoryReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *WatchHistoryReq) SetCursor(val *int64) {
+	p.Cursor = val
+}
+func (p *WatchHistoryReq) SetPageSize(val int32) {
+	p.PageSize = val
 }
 
-func (p *VideoServiceGetUserVideoListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *WatchHistoryReq) IsSetCursor() bool {
+	return p.Cursor != nil
 }
 
-func (p *VideoServiceGetUserVideoListArgs) String() string {
+func (p *WatchHistoryReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetUserVideoListArgs(%+v)", *p)
+	return fmt.Sprintf("WatchHistoryReq(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetUserVideoListArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_WatchHistoryReq = map[int16]string{
+	1: "userId",
+	2: "cursor",
+	3: "pageSize",
 }
 
-type VideoServiceGetUserVideoListResult struct {
-	Success *UserVideoListResp `thrift:"success,0,optional" frugal:"0,optional,UserVideoListResp" json:"success,omitempty"`
+type WatchHistoryResp struct {
+	BaseResp   *common.BaseResp    `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Items      []*WatchHistoryItem `thrift:"items,2" frugal:"2,default,list<WatchHistoryItem>" json:"items"`
+	NextCursor int64               `thrift:"nextCursor,3" frugal:"3,default,i64" json:"nextCursor"`
+	HasMore    bool                `thrift:"hasMore,4" frugal:"4,default,bool" json:"hasMore"`
+	Paused     bool                `thrift:"paused,5" frugal:"5,default,bool" json:"paused"`
 }
 
-func NewVideoServiceGetUserVideoListResult() *VideoServiceGetUserVideoListResult {
-	return &VideoServiceGetUserVideoListResult{}
+func NewWatchHistoryResp() *WatchHistoryResp {
+	return &WatchHistoryResp{}
 }
 
-func (p *VideoServiceGetUserVideoListResult) InitDefault() {
+func (p *WatchHistoryResp) InitDefault() {
 }
 
-var VideoServiceGetUserVideoListResult_Success_DEFAULT *UserVideoListResp
+var WatchHistoryResp_BaseResp_DEFAULT *common.BaseResp
 
-func (p *VideoServiceGetUserVideoListResult) GetSuccess() (v *UserVideoListResp) {
-	if !p.IsSetSuccess() {
-		return VideoServiceGetUserVideoListResult_Success_DEFAULT
+func (p *WatchHistoryResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return WatchHistoryResp_BaseResp_DEFAULT
 	}
-	return p.Success
-}
-func (p *VideoServiceGetUserVideoListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserVideoListResp)
+	return p.BaseResp
 }
 
-func (p *VideoServiceGetUserVideoListResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *WatchHistoryResp) GetItems() (v []*WatchHistoryItem) {
+	return p.Items
 }
 
-func (p *VideoServiceGetUserVideoListResult) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("VideoServiceGetUserVideoListResult(%+v)", *p)
+func (p *WatchHistoryResp) GetNextCursor() (v int64) {
+	return p.NextCursor
 }
 
-var fieldIDToName_VideoServiceGetUserVideoListResult = map[int16]string{
-	0: "success",
+func (p *WatchHistoryResp) GetHasMore() (v bool) {
+	return p.HasMore
 }
 
-type VideoServiceGetFeedArgs struct {
-	Req *FeedReq `thrift:"req,1" frugal:"1,default,FeedReq" json:"req"`
+func (p *WatchHistoryResp) GetPaused() (v bool) {
+	return p.Paused
 }
-
-func NewVideoServiceGetFeedArgs() *VideoServiceGetFeedArgs {
-	return &VideoServiceGetFeedArgs{}
+func (p *WatchHistoryResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
 }
-
-func (p *VideoServiceGetFeedArgs) InitDefault() {
+func (p *WatchHistoryResp) SetItems(val []*WatchHistoryItem) {
+	p.Items = val
 }
-
-var VideoServiceGetFeedArgs_Req_DEFAULT *FeedReq
-
-func (p *VideoServiceGetFeedArgs) GetReq() (v *FeedReq) {
-	if !p.IsSetReq() {
-		return VideoServiceGetFeedArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *WatchHistoryResp) SetNextCursor(val int64) {
+	p.NextCursor = val
 }
-func (p *VideoServiceGetFeedArgs) SetReq(val *FeedReq) {
-	p.Req = val
+func (p *WatchHistoryResp) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *WatchHistoryResp) SetPaused(val bool) {
+	p.Paused = val
 }
 
-func (p *VideoServiceGetFeedArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *WatchHistoryResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *VideoServiceGetFeedArgs) String() string {
+func (p *WatchHistoryResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetFeedArgs(%+v)", *p)
+	return fmt.Sprintf("WatchHistoryResp(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetFeedArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_WatchHistoryResp = map[int16]string{
+	1: "BaseResp",
+	2: "items",
+	3: "nextCursor",
+	4: "hasMore",
+	5: "paused",
 }
 
-type VideoServiceGetFeedResult struct {
-	Success *FeedResp `thrift:"success,0,optional" frugal:"0,optional,FeedResp" json:"success,omitempty"`
+type WatchProgressReq struct {
+	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
 }
 
-func NewVideoServiceGetFeedResult() *VideoServiceGetFeedResult {
-	return &VideoServiceGetFeedResult{}
+func NewWatchProgressReq() *WatchProgressReq {
+	return &WatchProgressReq{}
 }
 
-func (p *VideoServiceGetFeedResult) InitDefault() {
+func (p *WatchProgressReq) InitDefault() {
 }
 
-var VideoServiceGetFeedResult_Success_DEFAULT *FeedResp
+func (p *WatchProgressReq) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *VideoServiceGetFeedResult) GetSuccess() (v *FeedResp) {
-	if !p.IsSetSuccess() {
-		return VideoServiceGetFeedResult_Success_DEFAULT
-	}
-	return p.Success
+func (p *WatchProgressReq) GetVideoId() (v int64) {
+	return p.VideoId
 }
-func (p *VideoServiceGetFeedResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FeedResp)
+func (p *WatchProgressReq) SetUserId(val int64) {
+	p.UserId = val
 }
-
-func (p *VideoServiceGetFeedResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *WatchProgressReq) SetVideoId(val int64) {
+	p.VideoId = val
 }
 
-func (p *VideoServiceGetFeedResult) String() string {
+func (p *WatchProgressReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetFeedResult(%+v)", *p)
+	return fmt.Sprintf("WatchProgressReq(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetFeedResult = map[int16]string{
-	0: "success",
+var fieldIDToName_WatchProgressReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
 }
 
-type VideoServiceSearchVideoArgs struct {
-	Req *SearchVideoReq `thrift:"req,1" frugal:"1,default,SearchVideoReq" json:"req"`
+type WatchProgressResp struct {
+	BaseResp       *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+	Exists         bool             `thrift:"exists,2" frugal:"2,default,bool" json:"exists"`
+	Position       int32            `thrift:"position,3" frugal:"3,default,i32" json:"position"`
+	Duration       int32            `thrift:"duration,4" frugal:"4,default,i32" json:"duration"`
+	Progress       float64          `thrift:"progress,5" frugal:"5,default,double" json:"progress"`
+	ResumePosition int32            `thrift:"resumePosition,6" frugal:"6,default,i32" json:"resumePosition"`
+	WatchedAt      int64            `thrift:"watchedAt,7" frugal:"7,default,i64" json:"watchedAt"`
 }
 
-func NewVideoServiceSearchVideoArgs() *VideoServiceSearchVideoArgs {
+func NewWatchProgressResp() *WatchProgressResp {
+	return &WatchProgressResp{}
+}
+
+func (p *WatchProgressResp) InitDefault() {
+}
+
+var WatchProgressResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *WatchProgressResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return WatchProgressResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *WatchProgressResp) GetExists() (v bool) {
+	return p.Exists
+}
+
+func (p *WatchProgressResp) GetPosition() (v int32) {
+	return p.Position
+}
+
+func (p *WatchProgressResp) GetDuration() (v int32) {
+	return p.Duration
+}
+
+func (p *WatchProgressResp) GetProgress() (v float64) {
+	return p.Progress
+}
+
+func (p *WatchProgressResp) GetResumePosition() (v int32) {
+	return p.ResumePosition
+}
+
+func (p *WatchProgressResp) GetWatchedAt() (v int64) {
+	return p.WatchedAt
+}
+func (p *WatchProgressResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+func (p *WatchProgressResp) SetExists(val bool) {
+	p.Exists = val
+}
+func (p *WatchProgressResp) SetPosition(val int32) {
+	p.Position = val
+}
+func (p *WatchProgressResp) SetDuration(val int32) {
+	p.Duration = val
+}
+func (p *WatchProgressResp) SetProgress(val float64) {
+	p.Progress = val
+}
+func (p *WatchProgressResp) SetResumePosition(val int32) {
+	p.ResumePosition = val
+}
+func (p *WatchProgressResp) SetWatchedAt(val int64) {
+	p.WatchedAt = val
+}
+
+func (p *WatchProgressResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *WatchProgressResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WatchProgressResp(%+v)", *p)
+}
+
+var fieldIDToName_WatchProgressResp = map[int16]string{
+	1: "BaseResp",
+	2: "exists",
+	3: "position",
+	4: "duration",
+	5: "progress",
+	6: "resumePosition",
+	7: "watchedAt",
+}
+
+type DeleteWatchHistoryReq struct {
+	UserId  int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	VideoId int64 `thrift:"videoId,2" frugal:"2,default,i64" json:"videoId"`
+}
+
+func NewDeleteWatchHistoryReq() *DeleteWatchHistoryReq {
+	return &DeleteWatchHistoryReq{}
+}
+
+func (p *DeleteWatchHistoryReq) InitDefault() {
+}
+
+func (p *DeleteWatchHistoryReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *DeleteWatchHistoryReq) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *DeleteWatchHistoryReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *DeleteWatchHistoryReq) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *DeleteWatchHistoryReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWatchHistoryReq(%+v)", *p)
+}
+
+var fieldIDToName_DeleteWatchHistoryReq = map[int16]string{
+	1: "userId",
+	2: "videoId",
+}
+
+type DeleteWatchHistoryResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewDeleteWatchHistoryResp() *DeleteWatchHistoryResp {
+	return &DeleteWatchHistoryResp{}
+}
+
+func (p *DeleteWatchHistoryResp) InitDefault() {
+}
+
+var DeleteWatchHistoryResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *DeleteWatchHistoryResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteWatchHistoryResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteWatchHistoryResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *DeleteWatchHistoryResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteWatchHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWatchHistoryResp(%+v)", *p)
+}
+
+var fieldIDToName_DeleteWatchHistoryResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type ClearWatchHistoryReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+}
+
+func NewClearWatchHistoryReq() *ClearWatchHistoryReq {
+	return &ClearWatchHistoryReq{}
+}
+
+func (p *ClearWatchHistoryReq) InitDefault() {
+}
+
+func (p *ClearWatchHistoryReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *ClearWatchHistoryReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *ClearWatchHistoryReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClearWatchHistoryReq(%+v)", *p)
+}
+
+var fieldIDToName_ClearWatchHistoryReq = map[int16]string{
+	1: "userId",
+}
+
+type ClearWatchHistoryResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewClearWatchHistoryResp() *ClearWatchHistoryResp {
+	return &ClearWatchHistoryResp{}
+}
+
+func (p *ClearWatchHistoryResp) InitDefault() {
+}
+
+var ClearWatchHistoryResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *ClearWatchHistoryResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ClearWatchHistoryResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ClearWatchHistoryResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *ClearWatchHistoryResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ClearWatchHistoryResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClearWatchHistoryResp(%+v)", *p)
+}
+
+var fieldIDToName_ClearWatchHistoryResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type SetWatchHistoryPausedReq struct {
+	UserId int64 `thrift:"userId,1" frugal:"1,default,i64" json:"userId"`
+	Paused bool  `thrift:"paused,2" frugal:"2,default,bool" json:"paused"`
+}
+
+func NewSetWatchHistoryPausedReq() *SetWatchHistoryPausedReq {
+	return &SetWatchHistoryPausedReq{}
+}
+
+func (p *SetWatchHistoryPausedReq) InitDefault() {
+}
+
+func (p *SetWatchHistoryPausedReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetWatchHistoryPausedReq) GetPaused() (v bool) {
+	return p.Paused
+}
+func (p *SetWatchHistoryPausedReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetWatchHistoryPausedReq) SetPaused(val bool) {
+	p.Paused = val
+}
+
+func (p *SetWatchHistoryPausedReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetWatchHistoryPausedReq(%+v)", *p)
+}
+
+var fieldIDToName_SetWatchHistoryPausedReq = map[int16]string{
+	1: "userId",
+	2: "paused",
+}
+
+type SetWatchHistoryPausedResp struct {
+	BaseResp *common.BaseResp `thrift:"BaseResp,1" frugal:"1,default,common.BaseResp" json:"BaseResp"`
+}
+
+func NewSetWatchHistoryPausedResp() *SetWatchHistoryPausedResp {
+	return &SetWatchHistoryPausedResp{}
+}
+
+func (p *SetWatchHistoryPausedResp) InitDefault() {
+}
+
+var SetWatchHistoryPausedResp_BaseResp_DEFAULT *common.BaseResp
+
+func (p *SetWatchHistoryPausedResp) GetBaseResp() (v *common.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SetWatchHistoryPausedResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SetWatchHistoryPausedResp) SetBaseResp(val *common.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SetWatchHistoryPausedResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SetWatchHistoryPausedResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetWatchHistoryPausedResp(%+v)", *p)
+}
+
+var fieldIDToName_SetWatchHistoryPausedResp = map[int16]string{
+	1: "BaseResp",
+}
+
+type VideoService interface {
+	PublishVideo(ctx context.Context, req *PublishVideoReq) (r *PublishVideoResp, err error)
+
+	GetUserVideoList(ctx context.Context, req *UserVideoListReq) (r *UserVideoListResp, err error)
+
+	GetFeed(ctx context.Context, req *FeedReq) (r *FeedResp, err error)
+
+	SearchVideo(ctx context.Context, req *SearchVideoReq) (r *SearchVideoResp, err error)
+
+	GetVideoDetail(ctx context.Context, req *VideoDetailReq) (r *VideoDetailResp, err error)
+
+	BatchGetVideoInfo(ctx context.Context, req *BatchVideoInfoReq) (r *BatchVideoInfoResp, err error)
+
+	DeleteVideo(ctx context.Context, req *DeleteVideoReq) (r *DeleteVideoResp, err error)
+
+	UpdateVideoInfo(ctx context.Context, req *UpdateVideoInfoReq) (r *UpdateVideoInfoResp, err error)
+
+	GetVideoStats(ctx context.Context, req *VideoStatsReq) (r *VideoStatsResp, err error)
+
+	GetHotVideos(ctx context.Context, req *HotVideoReq) (r *HotVideoResp, err error)
+
+	UploadVideo(ctx context.Context, req *UploadVideoReq) (r *UploadVideoResp, err error)
+
+	GetUserVideoCount(ctx context.Context, req *GetUserVideoCountReq) (r *GetUserVideoCountResp, err error)
+
+	GetTotalVideoCount(ctx context.Context, req *GetTotalVideoCountReq) (r *GetTotalVideoCountResp, err error)
+
+	ReportWatchProgress(ctx context.Context, req *ReportWatchProgressReq) (r *ReportWatchProgressResp, err error)
+
+	GetWatchHistory(ctx context.Context, req *WatchHistoryReq) (r *WatchHistoryResp, err error)
+
+	GetWatchProgress(ctx context.Context, req *WatchProgressReq) (r *WatchProgressResp, err error)
+
+	DeleteWatchHistory(ctx context.Context, req *DeleteWatchHistoryReq) (r *DeleteWatchHistoryResp, err error)
+
+	ClearWatchHistory(ctx context.Context, req *ClearWatchHistoryReq) (r *ClearWatchHistoryResp, err error)
+
+	SetWatchHistoryPaused(ctx context.Context, req *SetWatchHistoryPausedReq) (r *SetWatchHistoryPausedResp, err error)
+}
+
+type VideoServicePublishVideoArgs struct {
+	Req *PublishVideoReq `thrift:"req,1" frugal:"1,default,PublishVideoReq" json:"req"`
+}
+
+func NewVideoServicePublishVideoArgs() *VideoServicePublishVideoArgs {
+	return &VideoServicePublishVideoArgs{}
+}
+
+func (p *VideoServicePublishVideoArgs) InitDefault() {
+}
+
+var VideoServicePublishVideoArgs_Req_DEFAULT *PublishVideoReq
+
+func (p *VideoServicePublishVideoArgs) GetReq() (v *PublishVideoReq) {
+	if !p.IsSetReq() {
+		return VideoServicePublishVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServicePublishVideoArgs) SetReq(val *PublishVideoReq) {
+	p.Req = val
+}
+
+func (p *VideoServicePublishVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePublishVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishVideoArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServicePublishVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServicePublishVideoResult struct {
+	Success *PublishVideoResp `thrift:"success,0,optional" frugal:"0,optional,PublishVideoResp" json:"success,omitempty"`
+}
+
+func NewVideoServicePublishVideoResult() *VideoServicePublishVideoResult {
+	return &VideoServicePublishVideoResult{}
+}
+
+func (p *VideoServicePublishVideoResult) InitDefault() {
+}
+
+var VideoServicePublishVideoResult_Success_DEFAULT *PublishVideoResp
+
+func (p *VideoServicePublishVideoResult) GetSuccess() (v *PublishVideoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServicePublishVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServicePublishVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PublishVideoResp)
+}
+
+func (p *VideoServicePublishVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePublishVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishVideoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServicePublishVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetUserVideoListArgs struct {
+	Req *UserVideoListReq `thrift:"req,1" frugal:"1,default,UserVideoListReq" json:"req"`
+}
+
+func NewVideoServiceGetUserVideoListArgs() *VideoServiceGetUserVideoListArgs {
+	return &VideoServiceGetUserVideoListArgs{}
+}
+
+func (p *VideoServiceGetUserVideoListArgs) InitDefault() {
+}
+
+var VideoServiceGetUserVideoListArgs_Req_DEFAULT *UserVideoListReq
+
+func (p *VideoServiceGetUserVideoListArgs) GetReq() (v *UserVideoListReq) {
+	if !p.IsSetReq() {
+		return VideoServiceGetUserVideoListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetUserVideoListArgs) SetReq(val *UserVideoListReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetUserVideoListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetUserVideoListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetUserVideoListArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetUserVideoListArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetUserVideoListResult struct {
+	Success *UserVideoListResp `thrift:"success,0,optional" frugal:"0,optional,UserVideoListResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetUserVideoListResult() *VideoServiceGetUserVideoListResult {
+	return &VideoServiceGetUserVideoListResult{}
+}
+
+func (p *VideoServiceGetUserVideoListResult) InitDefault() {
+}
+
+var VideoServiceGetUserVideoListResult_Success_DEFAULT *UserVideoListResp
+
+func (p *VideoServiceGetUserVideoListResult) GetSuccess() (v *UserVideoListResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetUserVideoListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetUserVideoListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserVideoListResp)
+}
+
+func (p *VideoServiceGetUserVideoListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetUserVideoListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetUserVideoListResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetUserVideoListResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetFeedArgs struct {
+	Req *FeedReq `thrift:"req,1" frugal:"1,default,FeedReq" json:"req"`
+}
+
+func NewVideoServiceGetFeedArgs() *VideoServiceGetFeedArgs {
+	return &VideoServiceGetFeedArgs{}
+}
+
+func (p *VideoServiceGetFeedArgs) InitDefault() {
+}
+
+var VideoServiceGetFeedArgs_Req_DEFAULT *FeedReq
+
+func (p *VideoServiceGetFeedArgs) GetReq() (v *FeedReq) {
+	if !p.IsSetReq() {
+		return VideoServiceGetFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetFeedArgs) SetReq(val *FeedReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetFeedArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetFeedArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetFeedResult struct {
+	Success *FeedResp `thrift:"success,0,optional" frugal:"0,optional,FeedResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetFeedResult() *VideoServiceGetFeedResult {
+	return &VideoServiceGetFeedResult{}
+}
+
+func (p *VideoServiceGetFeedResult) InitDefault() {
+}
+
+var VideoServiceGetFeedResult_Success_DEFAULT *FeedResp
+
+func (p *VideoServiceGetFeedResult) GetSuccess() (v *FeedResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FeedResp)
+}
+
+func (p *VideoServiceGetFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetFeedResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetFeedResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceSearchVideoArgs struct {
+	Req *SearchVideoReq `thrift:"req,1" frugal:"1,default,SearchVideoReq" json:"req"`
+}
+
+func NewVideoServiceSearchVideoArgs() *VideoServiceSearchVideoArgs {
 	return &VideoServiceSearchVideoArgs{}
 }
 
-func (p *VideoServiceSearchVideoArgs) InitDefault() {
+func (p *VideoServiceSearchVideoArgs) InitDefault() {
+}
+
+var VideoServiceSearchVideoArgs_Req_DEFAULT *SearchVideoReq
+
+func (p *VideoServiceSearchVideoArgs) GetReq() (v *SearchVideoReq) {
+	if !p.IsSetReq() {
+		return VideoServiceSearchVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceSearchVideoArgs) SetReq(val *SearchVideoReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceSearchVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceSearchVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSearchVideoArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceSearchVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceSearchVideoResult struct {
+	Success *SearchVideoResp `thrift:"success,0,optional" frugal:"0,optional,SearchVideoResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceSearchVideoResult() *VideoServiceSearchVideoResult {
+	return &VideoServiceSearchVideoResult{}
+}
+
+func (p *VideoServiceSearchVideoResult) InitDefault() {
+}
+
+var VideoServiceSearchVideoResult_Success_DEFAULT *SearchVideoResp
+
+func (p *VideoServiceSearchVideoResult) GetSuccess() (v *SearchVideoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceSearchVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceSearchVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchVideoResp)
+}
+
+func (p *VideoServiceSearchVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceSearchVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSearchVideoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceSearchVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetVideoDetailArgs struct {
+	Req *VideoDetailReq `thrift:"req,1" frugal:"1,default,VideoDetailReq" json:"req"`
+}
+
+func NewVideoServiceGetVideoDetailArgs() *VideoServiceGetVideoDetailArgs {
+	return &VideoServiceGetVideoDetailArgs{}
+}
+
+func (p *VideoServiceGetVideoDetailArgs) InitDefault() {
+}
+
+var VideoServiceGetVideoDetailArgs_Req_DEFAULT *VideoDetailReq
+
+func (p *VideoServiceGetVideoDetailArgs) GetReq() (v *VideoDetailReq) {
+	if !p.IsSetReq() {
+		return VideoServiceGetVideoDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetVideoDetailArgs) SetReq(val *VideoDetailReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetVideoDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetVideoDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoDetailArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetVideoDetailArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetVideoDetailResult struct {
+	Success *VideoDetailResp `thrift:"success,0,optional" frugal:"0,optional,VideoDetailResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetVideoDetailResult() *VideoServiceGetVideoDetailResult {
+	return &VideoServiceGetVideoDetailResult{}
+}
+
+func (p *VideoServiceGetVideoDetailResult) InitDefault() {
+}
+
+var VideoServiceGetVideoDetailResult_Success_DEFAULT *VideoDetailResp
+
+func (p *VideoServiceGetVideoDetailResult) GetSuccess() (v *VideoDetailResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetVideoDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetVideoDetailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoDetailResp)
+}
+
+func (p *VideoServiceGetVideoDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetVideoDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoDetailResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetVideoDetailResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceBatchGetVideoInfoArgs struct {
+	Req *BatchVideoInfoReq `thrift:"req,1" frugal:"1,default,BatchVideoInfoReq" json:"req"`
+}
+
+func NewVideoServiceBatchGetVideoInfoArgs() *VideoServiceBatchGetVideoInfoArgs {
+	return &VideoServiceBatchGetVideoInfoArgs{}
+}
+
+func (p *VideoServiceBatchGetVideoInfoArgs) InitDefault() {
+}
+
+var VideoServiceBatchGetVideoInfoArgs_Req_DEFAULT *BatchVideoInfoReq
+
+func (p *VideoServiceBatchGetVideoInfoArgs) GetReq() (v *BatchVideoInfoReq) {
+	if !p.IsSetReq() {
+		return VideoServiceBatchGetVideoInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceBatchGetVideoInfoArgs) SetReq(val *BatchVideoInfoReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceBatchGetVideoInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceBatchGetVideoInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceBatchGetVideoInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceBatchGetVideoInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceBatchGetVideoInfoResult struct {
+	Success *BatchVideoInfoResp `thrift:"success,0,optional" frugal:"0,optional,BatchVideoInfoResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceBatchGetVideoInfoResult() *VideoServiceBatchGetVideoInfoResult {
+	return &VideoServiceBatchGetVideoInfoResult{}
+}
+
+func (p *VideoServiceBatchGetVideoInfoResult) InitDefault() {
+}
+
+var VideoServiceBatchGetVideoInfoResult_Success_DEFAULT *BatchVideoInfoResp
+
+func (p *VideoServiceBatchGetVideoInfoResult) GetSuccess() (v *BatchVideoInfoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceBatchGetVideoInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceBatchGetVideoInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchVideoInfoResp)
+}
+
+func (p *VideoServiceBatchGetVideoInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceBatchGetVideoInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceBatchGetVideoInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceBatchGetVideoInfoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceDeleteVideoArgs struct {
+	Req *DeleteVideoReq `thrift:"req,1" frugal:"1,default,DeleteVideoReq" json:"req"`
+}
+
+func NewVideoServiceDeleteVideoArgs() *VideoServiceDeleteVideoArgs {
+	return &VideoServiceDeleteVideoArgs{}
+}
+
+func (p *VideoServiceDeleteVideoArgs) InitDefault() {
+}
+
+var VideoServiceDeleteVideoArgs_Req_DEFAULT *DeleteVideoReq
+
+func (p *VideoServiceDeleteVideoArgs) GetReq() (v *DeleteVideoReq) {
+	if !p.IsSetReq() {
+		return VideoServiceDeleteVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceDeleteVideoArgs) SetReq(val *DeleteVideoReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceDeleteVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceDeleteVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceDeleteVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceDeleteVideoResult struct {
+	Success *DeleteVideoResp `thrift:"success,0,optional" frugal:"0,optional,DeleteVideoResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceDeleteVideoResult() *VideoServiceDeleteVideoResult {
+	return &VideoServiceDeleteVideoResult{}
+}
+
+func (p *VideoServiceDeleteVideoResult) InitDefault() {
+}
+
+var VideoServiceDeleteVideoResult_Success_DEFAULT *DeleteVideoResp
+
+func (p *VideoServiceDeleteVideoResult) GetSuccess() (v *DeleteVideoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceDeleteVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceDeleteVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteVideoResp)
+}
+
+func (p *VideoServiceDeleteVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceDeleteVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceDeleteVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceUpdateVideoInfoArgs struct {
+	Req *UpdateVideoInfoReq `thrift:"req,1" frugal:"1,default,UpdateVideoInfoReq" json:"req"`
+}
+
+func NewVideoServiceUpdateVideoInfoArgs() *VideoServiceUpdateVideoInfoArgs {
+	return &VideoServiceUpdateVideoInfoArgs{}
+}
+
+func (p *VideoServiceUpdateVideoInfoArgs) InitDefault() {
+}
+
+var VideoServiceUpdateVideoInfoArgs_Req_DEFAULT *UpdateVideoInfoReq
+
+func (p *VideoServiceUpdateVideoInfoArgs) GetReq() (v *UpdateVideoInfoReq) {
+	if !p.IsSetReq() {
+		return VideoServiceUpdateVideoInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUpdateVideoInfoArgs) SetReq(val *UpdateVideoInfoReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceUpdateVideoInfoArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-var VideoServiceSearchVideoArgs_Req_DEFAULT *SearchVideoReq
+func (p *VideoServiceUpdateVideoInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoInfoArgs(%+v)", *p)
+}
 
-func (p *VideoServiceSearchVideoArgs) GetReq() (v *SearchVideoReq) {
+var fieldIDToName_VideoServiceUpdateVideoInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceUpdateVideoInfoResult struct {
+	Success *UpdateVideoInfoResp `thrift:"success,0,optional" frugal:"0,optional,UpdateVideoInfoResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceUpdateVideoInfoResult() *VideoServiceUpdateVideoInfoResult {
+	return &VideoServiceUpdateVideoInfoResult{}
+}
+
+func (p *VideoServiceUpdateVideoInfoResult) InitDefault() {
+}
+
+var VideoServiceUpdateVideoInfoResult_Success_DEFAULT *UpdateVideoInfoResp
+
+func (p *VideoServiceUpdateVideoInfoResult) GetSuccess() (v *UpdateVideoInfoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUpdateVideoInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUpdateVideoInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateVideoInfoResp)
+}
+
+func (p *VideoServiceUpdateVideoInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUpdateVideoInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceUpdateVideoInfoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetVideoStatsArgs struct {
+	Req *VideoStatsReq `thrift:"req,1" frugal:"1,default,VideoStatsReq" json:"req"`
+}
+
+func NewVideoServiceGetVideoStatsArgs() *VideoServiceGetVideoStatsArgs {
+	return &VideoServiceGetVideoStatsArgs{}
+}
+
+func (p *VideoServiceGetVideoStatsArgs) InitDefault() {
+}
+
+var VideoServiceGetVideoStatsArgs_Req_DEFAULT *VideoStatsReq
+
+func (p *VideoServiceGetVideoStatsArgs) GetReq() (v *VideoStatsReq) {
 	if !p.IsSetReq() {
-		return VideoServiceSearchVideoArgs_Req_DEFAULT
+		return VideoServiceGetVideoStatsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceSearchVideoArgs) SetReq(val *SearchVideoReq) {
+func (p *VideoServiceGetVideoStatsArgs) SetReq(val *VideoStatsReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceSearchVideoArgs) IsSetReq() bool {
+func (p *VideoServiceGetVideoStatsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceSearchVideoArgs) String() string {
+func (p *VideoServiceGetVideoStatsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetVideoStatsArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceSearchVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetVideoStatsArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceSearchVideoResult struct {
-	Success *SearchVideoResp `thrift:"success,0,optional" frugal:"0,optional,SearchVideoResp" json:"success,omitempty"`
+type VideoServiceGetVideoStatsResult struct {
+	Success *VideoStatsResp `thrift:"success,0,optional" frugal:"0,optional,VideoStatsResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceSearchVideoResult() *VideoServiceSearchVideoResult {
-	return &VideoServiceSearchVideoResult{}
+func NewVideoServiceGetVideoStatsResult() *VideoServiceGetVideoStatsResult {
+	return &VideoServiceGetVideoStatsResult{}
 }
 
-func (p *VideoServiceSearchVideoResult) InitDefault() {
+func (p *VideoServiceGetVideoStatsResult) InitDefault() {
 }
 
-var VideoServiceSearchVideoResult_Success_DEFAULT *SearchVideoResp
+var VideoServiceGetVideoStatsResult_Success_DEFAULT *VideoStatsResp
 
-func (p *VideoServiceSearchVideoResult) GetSuccess() (v *SearchVideoResp) {
+func (p *VideoServiceGetVideoStatsResult) GetSuccess() (v *VideoStatsResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceSearchVideoResult_Success_DEFAULT
+		return VideoServiceGetVideoStatsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceSearchVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SearchVideoResp)
+func (p *VideoServiceGetVideoStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoStatsResp)
 }
 
-func (p *VideoServiceSearchVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceGetVideoStatsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceSearchVideoResult) String() string {
+func (p *VideoServiceGetVideoStatsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetVideoStatsResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceSearchVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceGetVideoStatsResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetVideoDetailArgs struct {
-	Req *VideoDetailReq `thrift:"req,1" frugal:"1,default,VideoDetailReq" json:"req"`
+type VideoServiceGetHotVideosArgs struct {
+	Req *HotVideoReq `thrift:"req,1" frugal:"1,default,HotVideoReq" json:"req"`
 }
 
-func NewVideoServiceGetVideoDetailArgs() *VideoServiceGetVideoDetailArgs {
-	return &VideoServiceGetVideoDetailArgs{}
+func NewVideoServiceGetHotVideosArgs() *VideoServiceGetHotVideosArgs {
+	return &VideoServiceGetHotVideosArgs{}
 }
 
-func (p *VideoServiceGetVideoDetailArgs) InitDefault() {
+func (p *VideoServiceGetHotVideosArgs) InitDefault() {
 }
 
-var VideoServiceGetVideoDetailArgs_Req_DEFAULT *VideoDetailReq
+var VideoServiceGetHotVideosArgs_Req_DEFAULT *HotVideoReq
 
-func (p *VideoServiceGetVideoDetailArgs) GetReq() (v *VideoDetailReq) {
+func (p *VideoServiceGetHotVideosArgs) GetReq() (v *HotVideoReq) {
 	if !p.IsSetReq() {
-		return VideoServiceGetVideoDetailArgs_Req_DEFAULT
+		return VideoServiceGetHotVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetVideoDetailArgs) SetReq(val *VideoDetailReq) {
+func (p *VideoServiceGetHotVideosArgs) SetReq(val *HotVideoReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetVideoDetailArgs) IsSetReq() bool {
+func (p *VideoServiceGetHotVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetVideoDetailArgs) String() string {
+func (p *VideoServiceGetHotVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoDetailArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetHotVideosArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetVideoDetailArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetHotVideosArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetVideoDetailResult struct {
-	Success *VideoDetailResp `thrift:"success,0,optional" frugal:"0,optional,VideoDetailResp" json:"success,omitempty"`
+type VideoServiceGetHotVideosResult struct {
+	Success *HotVideoResp `thrift:"success,0,optional" frugal:"0,optional,HotVideoResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetVideoDetailResult() *VideoServiceGetVideoDetailResult {
-	return &VideoServiceGetVideoDetailResult{}
+func NewVideoServiceGetHotVideosResult() *VideoServiceGetHotVideosResult {
+	return &VideoServiceGetHotVideosResult{}
 }
 
-func (p *VideoServiceGetVideoDetailResult) InitDefault() {
+func (p *VideoServiceGetHotVideosResult) InitDefault() {
 }
 
-var VideoServiceGetVideoDetailResult_Success_DEFAULT *VideoDetailResp
+var VideoServiceGetHotVideosResult_Success_DEFAULT *HotVideoResp
 
-func (p *VideoServiceGetVideoDetailResult) GetSuccess() (v *VideoDetailResp) {
+func (p *VideoServiceGetHotVideosResult) GetSuccess() (v *HotVideoResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetVideoDetailResult_Success_DEFAULT
+		return VideoServiceGetHotVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetVideoDetailResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoDetailResp)
+func (p *VideoServiceGetHotVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HotVideoResp)
 }
 
-func (p *VideoServiceGetVideoDetailResult) IsSetSuccess() bool {
+func (p *VideoServiceGetHotVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetVideoDetailResult) String() string {
+func (p *VideoServiceGetHotVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoDetailResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetHotVideosResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetVideoDetailResult = map[int16]string{
+var fieldIDToName_VideoServiceGetHotVideosResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceBatchGetVideoInfoArgs struct {
-	Req *BatchVideoInfoReq `thrift:"req,1" frugal:"1,default,BatchVideoInfoReq" json:"req"`
+type VideoServiceUploadVideoArgs struct {
+	Req *UploadVideoReq `thrift:"req,1" frugal:"1,default,UploadVideoReq" json:"req"`
 }
 
-func NewVideoServiceBatchGetVideoInfoArgs() *VideoServiceBatchGetVideoInfoArgs {
-	return &VideoServiceBatchGetVideoInfoArgs{}
+func NewVideoServiceUploadVideoArgs() *VideoServiceUploadVideoArgs {
+	return &VideoServiceUploadVideoArgs{}
 }
 
-func (p *VideoServiceBatchGetVideoInfoArgs) InitDefault() {
+func (p *VideoServiceUploadVideoArgs) InitDefault() {
 }
 
-var VideoServiceBatchGetVideoInfoArgs_Req_DEFAULT *BatchVideoInfoReq
+var VideoServiceUploadVideoArgs_Req_DEFAULT *UploadVideoReq
 
-func (p *VideoServiceBatchGetVideoInfoArgs) GetReq() (v *BatchVideoInfoReq) {
+func (p *VideoServiceUploadVideoArgs) GetReq() (v *UploadVideoReq) {
+	if !p.IsSetReq() {
+		return VideoServiceUploadVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUploadVideoArgs) SetReq(val *UploadVideoReq) {
+	p.Req = val
+}
+
+func (p *VideoServiceUploadVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUploadVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUploadVideoArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceUploadVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceUploadVideoResult struct {
+	Success *UploadVideoResp `thrift:"success,0,optional" frugal:"0,optional,UploadVideoResp" json:"success,omitempty"`
+}
+
+func NewVideoServiceUploadVideoResult() *VideoServiceUploadVideoResult {
+	return &VideoServiceUploadVideoResult{}
+}
+
+func (p *VideoServiceUploadVideoResult) InitDefault() {
+}
+
+var VideoServiceUploadVideoResult_Success_DEFAULT *UploadVideoResp
+
+func (p *VideoServiceUploadVideoResult) GetSuccess() (v *UploadVideoResp) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUploadVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUploadVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadVideoResp)
+}
+
+func (p *VideoServiceUploadVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUploadVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUploadVideoResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceUploadVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetUserVideoCountArgs struct {
+	Req *GetUserVideoCountReq `thrift:"req,1" frugal:"1,default,GetUserVideoCountReq" json:"req"`
+}
+
+func NewVideoServiceGetUserVideoCountArgs() *VideoServiceGetUserVideoCountArgs {
+	return &VideoServiceGetUserVideoCountArgs{}
+}
+
+func (p *VideoServiceGetUserVideoCountArgs) InitDefault() {
+}
+
+var VideoServiceGetUserVideoCountArgs_Req_DEFAULT *GetUserVideoCountReq
+
+func (p *VideoServiceGetUserVideoCountArgs) GetReq() (v *GetUserVideoCountReq) {
 	if !p.IsSetReq() {
-		return VideoServiceBatchGetVideoInfoArgs_Req_DEFAULT
+		return VideoServiceGetUserVideoCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceBatchGetVideoInfoArgs) SetReq(val *BatchVideoInfoReq) {
+func (p *VideoServiceGetUserVideoCountArgs) SetReq(val *GetUserVideoCountReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceBatchGetVideoInfoArgs) IsSetReq() bool {
+func (p *VideoServiceGetUserVideoCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceBatchGetVideoInfoArgs) String() string {
+func (p *VideoServiceGetUserVideoCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceBatchGetVideoInfoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetUserVideoCountArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceBatchGetVideoInfoArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetUserVideoCountArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceBatchGetVideoInfoResult struct {
-	Success *BatchVideoInfoResp `thrift:"success,0,optional" frugal:"0,optional,BatchVideoInfoResp" json:"success,omitempty"`
+type VideoServiceGetUserVideoCountResult struct {
+	Success *GetUserVideoCountResp `thrift:"success,0,optional" frugal:"0,optional,GetUserVideoCountResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceBatchGetVideoInfoResult() *VideoServiceBatchGetVideoInfoResult {
-	return &VideoServiceBatchGetVideoInfoResult{}
+func NewVideoServiceGetUserVideoCountResult() *VideoServiceGetUserVideoCountResult {
+	return &VideoServiceGetUserVideoCountResult{}
 }
 
-func (p *VideoServiceBatchGetVideoInfoResult) InitDefault() {
+func (p *VideoServiceGetUserVideoCountResult) InitDefault() {
 }
 
-var VideoServiceBatchGetVideoInfoResult_Success_DEFAULT *BatchVideoInfoResp
+var VideoServiceGetUserVideoCountResult_Success_DEFAULT *GetUserVideoCountResp
 
-func (p *VideoServiceBatchGetVideoInfoResult) GetSuccess() (v *BatchVideoInfoResp) {
+func (p *VideoServiceGetUserVideoCountResult) GetSuccess() (v *GetUserVideoCountResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceBatchGetVideoInfoResult_Success_DEFAULT
+		return VideoServiceGetUserVideoCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceBatchGetVideoInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchVideoInfoResp)
+func (p *VideoServiceGetUserVideoCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserVideoCountResp)
 }
 
-func (p *VideoServiceBatchGetVideoInfoResult) IsSetSuccess() bool {
+func (p *VideoServiceGetUserVideoCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceBatchGetVideoInfoResult) String() string {
+func (p *VideoServiceGetUserVideoCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceBatchGetVideoInfoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetUserVideoCountResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceBatchGetVideoInfoResult = map[int16]string{
+var fieldIDToName_VideoServiceGetUserVideoCountResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceDeleteVideoArgs struct {
-	Req *DeleteVideoReq `thrift:"req,1" frugal:"1,default,DeleteVideoReq" json:"req"`
+type VideoServiceGetTotalVideoCountArgs struct {
+	Req *GetTotalVideoCountReq `thrift:"req,1" frugal:"1,default,GetTotalVideoCountReq" json:"req"`
 }
 
-func NewVideoServiceDeleteVideoArgs() *VideoServiceDeleteVideoArgs {
-	return &VideoServiceDeleteVideoArgs{}
+func NewVideoServiceGetTotalVideoCountArgs() *VideoServiceGetTotalVideoCountArgs {
+	return &VideoServiceGetTotalVideoCountArgs{}
 }
 
-func (p *VideoServiceDeleteVideoArgs) InitDefault() {
+func (p *VideoServiceGetTotalVideoCountArgs) InitDefault() {
 }
 
-var VideoServiceDeleteVideoArgs_Req_DEFAULT *DeleteVideoReq
+var VideoServiceGetTotalVideoCountArgs_Req_DEFAULT *GetTotalVideoCountReq
 
-func (p *VideoServiceDeleteVideoArgs) GetReq() (v *DeleteVideoReq) {
+func (p *VideoServiceGetTotalVideoCountArgs) GetReq() (v *GetTotalVideoCountReq) {
 	if !p.IsSetReq() {
-		return VideoServiceDeleteVideoArgs_Req_DEFAULT
+		return VideoServiceGetTotalVideoCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceDeleteVideoArgs) SetReq(val *DeleteVideoReq) {
+func (p *VideoServiceGetTotalVideoCountArgs) SetReq(val *GetTotalVideoCountReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceDeleteVideoArgs) IsSetReq() bool {
+func (p *VideoServiceGetTotalVideoCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceDeleteVideoArgs) String() string {
+func (p *VideoServiceGetTotalVideoCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDeleteVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetTotalVideoCountArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceDeleteVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetTotalVideoCountArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceDeleteVideoResult struct {
-	Success *DeleteVideoResp `thrift:"success,0,optional" frugal:"0,optional,DeleteVideoResp" json:"success,omitempty"`
+type VideoServiceGetTotalVideoCountResult struct {
+	Success *GetTotalVideoCountResp `thrift:"success,0,optional" frugal:"0,optional,GetTotalVideoCountResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceDeleteVideoResult() *VideoServiceDeleteVideoResult {
-	return &VideoServiceDeleteVideoResult{}
+func NewVideoServiceGetTotalVideoCountResult() *VideoServiceGetTotalVideoCountResult {
+	return &VideoServiceGetTotalVideoCountResult{}
 }
 
-func (p *VideoServiceDeleteVideoResult) InitDefault() {
+func (p *VideoServiceGetTotalVideoCountResult) InitDefault() {
 }
 
-var VideoServiceDeleteVideoResult_Success_DEFAULT *DeleteVideoResp
+var VideoServiceGetTotalVideoCountResult_Success_DEFAULT *GetTotalVideoCountResp
 
-func (p *VideoServiceDeleteVideoResult) GetSuccess() (v *DeleteVideoResp) {
+func (p *VideoServiceGetTotalVideoCountResult) GetSuccess() (v *GetTotalVideoCountResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceDeleteVideoResult_Success_DEFAULT
+		return VideoServiceGetTotalVideoCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceDeleteVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteVideoResp)
+func (p *VideoServiceGetTotalVideoCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTotalVideoCountResp)
 }
 
-func (p *VideoServiceDeleteVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceGetTotalVideoCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceDeleteVideoResult) String() string {
+func (p *VideoServiceGetTotalVideoCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDeleteVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetTotalVideoCountResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceDeleteVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceGetTotalVideoCountResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceUpdateVideoInfoArgs struct {
-	Req *UpdateVideoInfoReq `thrift:"req,1" frugal:"1,default,UpdateVideoInfoReq" json:"req"`
+type VideoServiceReportWatchProgressArgs struct {
+	Req *ReportWatchProgressReq `thrift:"req,1" frugal:"1,default,ReportWatchProgressReq" json:"req"`
 }
 
-func NewVideoServiceUpdateVideoInfoArgs() *VideoServiceUpdateVideoInfoArgs {
-	return &VideoServiceUpdateVideoInfoArgs{}
+func NewVideoServiceReportWatchProgressArgs() *VideoServiceReportWatchProgressArgs {
+	return &VideoServiceReportWatchProgressArgs{}
 }
 
-func (p *VideoServiceUpdateVideoInfoArgs) InitDefault() {
+func (p *VideoServiceReportWatchProgressArgs) InitDefault() {
 }
 
-var VideoServiceUpdateVideoInfoArgs_Req_DEFAULT *UpdateVideoInfoReq
+var VideoServiceReportWatchProgressArgs_Req_DEFAULT *ReportWatchProgressReq
 
-func (p *VideoServiceUpdateVideoInfoArgs) GetReq() (v *UpdateVideoInfoReq) {
+func (p *VideoServiceReportWatchProgressArgs) GetReq() (v *ReportWatchProgressReq) {
 	if !p.IsSetReq() {
-		return VideoServiceUpdateVideoInfoArgs_Req_DEFAULT
+		return VideoServiceReportWatchProgressArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceUpdateVideoInfoArgs) SetReq(val *UpdateVideoInfoReq) {
+func (p *VideoServiceReportWatchProgressArgs) SetReq(val *ReportWatchProgressReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceUpdateVideoInfoArgs) IsSetReq() bool {
+func (p *VideoServiceReportWatchProgressArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceUpdateVideoInfoArgs) String() string {
+func (p *VideoServiceReportWatchProgressArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUpdateVideoInfoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceReportWatchProgressArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceUpdateVideoInfoArgs = map[int16]string{
+var fieldIDToName_VideoServiceReportWatchProgressArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceUpdateVideoInfoResult struct {
-	Success *UpdateVideoInfoResp `thrift:"success,0,optional" frugal:"0,optional,UpdateVideoInfoResp" json:"success,omitempty"`
+type VideoServiceReportWatchProgressResult struct {
+	Success *ReportWatchProgressResp `thrift:"success,0,optional" frugal:"0,optional,ReportWatchProgressResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceUpdateVideoInfoResult() *VideoServiceUpdateVideoInfoResult {
-	return &VideoServiceUpdateVideoInfoResult{}
+func NewVideoServiceReportWatchProgressResult() *VideoServiceReportWatchProgressResult {
+	return &VideoServiceReportWatchProgressResult{}
 }
 
-func (p *VideoServiceUpdateVideoInfoResult) InitDefault() {
+func (p *VideoServiceReportWatchProgressResult) InitDefault() {
 }
 
-var VideoServiceUpdateVideoInfoResult_Success_DEFAULT *UpdateVideoInfoResp
+var VideoServiceReportWatchProgressResult_Success_DEFAULT *ReportWatchProgressResp
 
-func (p *VideoServiceUpdateVideoInfoResult) GetSuccess() (v *UpdateVideoInfoResp) {
+func (p *VideoServiceReportWatchProgressResult) GetSuccess() (v *ReportWatchProgressResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceUpdateVideoInfoResult_Success_DEFAULT
+		return VideoServiceReportWatchProgressResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceUpdateVideoInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateVideoInfoResp)
+func (p *VideoServiceReportWatchProgressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReportWatchProgressResp)
 }
 
-func (p *VideoServiceUpdateVideoInfoResult) IsSetSuccess() bool {
+func (p *VideoServiceReportWatchProgressResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceUpdateVideoInfoResult) String() string {
+func (p *VideoServiceReportWatchProgressResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUpdateVideoInfoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceReportWatchProgressResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceUpdateVideoInfoResult = map[int16]string{
+var fieldIDToName_VideoServiceReportWatchProgressResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetVideoStatsArgs struct {
-	Req *VideoStatsReq `thrift:"req,1" frugal:"1,default,VideoStatsReq" json:"req"`
+type VideoServiceGetWatchHistoryArgs struct {
+	Req *WatchHistoryReq `thrift:"req,1" frugal:"1,default,WatchHistoryReq" json:"req"`
 }
 
-func NewVideoServiceGetVideoStatsArgs() *VideoServiceGetVideoStatsArgs {
-	return &VideoServiceGetVideoStatsArgs{}
+func NewVideoServiceGetWatchHistoryArgs() *VideoServiceGetWatchHistoryArgs {
+	return &VideoServiceGetWatchHistoryArgs{}
 }
 
-func (p *VideoServiceGetVideoStatsArgs) InitDefault() {
+func (p *VideoServiceGetWatchHistoryArgs) InitDefault() {
 }
 
-var VideoServiceGetVideoStatsArgs_Req_DEFAULT *VideoStatsReq
+var VideoServiceGetWatchHistoryArgs_Req_DEFAULT *WatchHistoryReq
 
-func (p *VideoServiceGetVideoStatsArgs) GetReq() (v *VideoStatsReq) {
+func (p *VideoServiceGetWatchHistoryArgs) GetReq() (v *WatchHistoryReq) {
 	if !p.IsSetReq() {
-		return VideoServiceGetVideoStatsArgs_Req_DEFAULT
+		return VideoServiceGetWatchHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetVideoStatsArgs) SetReq(val *VideoStatsReq) {
+func (p *VideoServiceGetWatchHistoryArgs) SetReq(val *WatchHistoryReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetVideoStatsArgs) IsSetReq() bool {
+func (p *VideoServiceGetWatchHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetVideoStatsArgs) String() string {
+func (p *VideoServiceGetWatchHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoStatsArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetWatchHistoryArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetVideoStatsArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetWatchHistoryArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetVideoStatsResult struct {
-	Success *VideoStatsResp `thrift:"success,0,optional" frugal:"0,optional,VideoStatsResp" json:"success,omitempty"`
+type VideoServiceGetWatchHistoryResult struct {
+	Success *WatchHistoryResp `thrift:"success,0,optional" frugal:"0,optional,WatchHistoryResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetVideoStatsResult() *VideoServiceGetVideoStatsResult {
-	return &VideoServiceGetVideoStatsResult{}
+func NewVideoServiceGetWatchHistoryResult() *VideoServiceGetWatchHistoryResult {
+	return &VideoServiceGetWatchHistoryResult{}
 }
 
-func (p *VideoServiceGetVideoStatsResult) InitDefault() {
+func (p *VideoServiceGetWatchHistoryResult) InitDefault() {
 }
 
-var VideoServiceGetVideoStatsResult_Success_DEFAULT *VideoStatsResp
+var VideoServiceGetWatchHistoryResult_Success_DEFAULT *WatchHistoryResp
 
-func (p *VideoServiceGetVideoStatsResult) GetSuccess() (v *VideoStatsResp) {
+func (p *VideoServiceGetWatchHistoryResult) GetSuccess() (v *WatchHistoryResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetVideoStatsResult_Success_DEFAULT
+		return VideoServiceGetWatchHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetVideoStatsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoStatsResp)
+func (p *VideoServiceGetWatchHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*WatchHistoryResp)
 }
 
-func (p *VideoServiceGetVideoStatsResult) IsSetSuccess() bool {
+func (p *VideoServiceGetWatchHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetVideoStatsResult) String() string {
+func (p *VideoServiceGetWatchHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoStatsResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetWatchHistoryResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetVideoStatsResult = map[int16]string{
+var fieldIDToName_VideoServiceGetWatchHistoryResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetHotVideosArgs struct {
-	Req *HotVideoReq `thrift:"req,1" frugal:"1,default,HotVideoReq" json:"req"`
+type VideoServiceGetWatchProgressArgs struct {
+	Req *WatchProgressReq `thrift:"req,1" frugal:"1,default,WatchProgressReq" json:"req"`
 }
 
-func NewVideoServiceGetHotVideosArgs() *VideoServiceGetHotVideosArgs {
-	return &VideoServiceGetHotVideosArgs{}
+func NewVideoServiceGetWatchProgressArgs() *VideoServiceGetWatchProgressArgs {
+	return &VideoServiceGetWatchProgressArgs{}
 }
 
-func (p *VideoServiceGetHotVideosArgs) InitDefault() {
+func (p *VideoServiceGetWatchProgressArgs) InitDefault() {
 }
 
-var VideoServiceGetHotVideosArgs_Req_DEFAULT *HotVideoReq
+var VideoServiceGetWatchProgressArgs_Req_DEFAULT *WatchProgressReq
 
-func (p *VideoServiceGetHotVideosArgs) GetReq() (v *HotVideoReq) {
+func (p *VideoServiceGetWatchProgressArgs) GetReq() (v *WatchProgressReq) {
 	if !p.IsSetReq() {
-		return VideoServiceGetHotVideosArgs_Req_DEFAULT
+		return VideoServiceGetWatchProgressArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetHotVideosArgs) SetReq(val *HotVideoReq) {
+func (p *VideoServiceGetWatchProgressArgs) SetReq(val *WatchProgressReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetHotVideosArgs) IsSetReq() bool {
+func (p *VideoServiceGetWatchProgressArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetHotVideosArgs) String() string {
+func (p *VideoServiceGetWatchProgressArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetHotVideosArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetWatchProgressArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetHotVideosArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetWatchProgressArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetHotVideosResult struct {
-	Success *HotVideoResp `thrift:"success,0,optional" frugal:"0,optional,HotVideoResp" json:"success,omitempty"`
+type VideoServiceGetWatchProgressResult struct {
+	Success *WatchProgressResp `thrift:"success,0,optional" frugal:"0,optional,WatchProgressResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetHotVideosResult() *VideoServiceGetHotVideosResult {
-	return &VideoServiceGetHotVideosResult{}
+func NewVideoServiceGetWatchProgressResult() *VideoServiceGetWatchProgressResult {
+	return &VideoServiceGetWatchProgressResult{}
 }
 
-func (p *VideoServiceGetHotVideosResult) InitDefault() {
+func (p *VideoServiceGetWatchProgressResult) InitDefault() {
 }
 
-var VideoServiceGetHotVideosResult_Success_DEFAULT *HotVideoResp
+var VideoServiceGetWatchProgressResult_Success_DEFAULT *WatchProgressResp
 
-func (p *VideoServiceGetHotVideosResult) GetSuccess() (v *HotVideoResp) {
+func (p *VideoServiceGetWatchProgressResult) GetSuccess() (v *WatchProgressResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetHotVideosResult_Success_DEFAULT
+		return VideoServiceGetWatchProgressResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetHotVideosResult) SetSuccess(x interface{}) {
-	p.Success = x.(*HotVideoResp)
+func (p *VideoServiceGetWatchProgressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*WatchProgressResp)
 }
 
-func (p *VideoServiceGetHotVideosResult) IsSetSuccess() bool {
+func (p *VideoServiceGetWatchProgressResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetHotVideosResult) String() string {
+func (p *VideoServiceGetWatchProgressResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetHotVideosResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetWatchProgressResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetHotVideosResult = map[int16]string{
+var fieldIDToName_VideoServiceGetWatchProgressResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceUploadVideoArgs struct {
-	Req *UploadVideoReq `thrift:"req,1" frugal:"1,default,UploadVideoReq" json:"req"`
+type VideoServiceDeleteWatchHistoryArgs struct {
+	Req *DeleteWatchHistoryReq `thrift:"req,1" frugal:"1,default,DeleteWatchHistoryReq" json:"req"`
 }
 
-func NewVideoServiceUploadVideoArgs() *VideoServiceUploadVideoArgs {
-	return &VideoServiceUploadVideoArgs{}
+func NewVideoServiceDeleteWatchHistoryArgs() *VideoServiceDeleteWatchHistoryArgs {
+	return &VideoServiceDeleteWatchHistoryArgs{}
 }
 
-func (p *VideoServiceUploadVideoArgs) InitDefault() {
+func (p *VideoServiceDeleteWatchHistoryArgs) InitDefault() {
 }
 
-var VideoServiceUploadVideoArgs_Req_DEFAULT *UploadVideoReq
+var VideoServiceDeleteWatchHistoryArgs_Req_DEFAULT *DeleteWatchHistoryReq
 
-func (p *VideoServiceUploadVideoArgs) GetReq() (v *UploadVideoReq) {
+func (p *VideoServiceDeleteWatchHistoryArgs) GetReq() (v *DeleteWatchHistoryReq) {
 	if !p.IsSetReq() {
-		return VideoServiceUploadVideoArgs_Req_DEFAULT
+		return VideoServiceDeleteWatchHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceUploadVideoArgs) SetReq(val *UploadVideoReq) {
+func (p *VideoServiceDeleteWatchHistoryArgs) SetReq(val *DeleteWatchHistoryReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceUploadVideoArgs) IsSetReq() bool {
+func (p *VideoServiceDeleteWatchHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceUploadVideoArgs) String() string {
+func (p *VideoServiceDeleteWatchHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUploadVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDeleteWatchHistoryArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceUploadVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceDeleteWatchHistoryArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceUploadVideoResult struct {
-	Success *UploadVideoResp `thrift:"success,0,optional" frugal:"0,optional,UploadVideoResp" json:"success,omitempty"`
+type VideoServiceDeleteWatchHistoryResult struct {
+	Success *DeleteWatchHistoryResp `thrift:"success,0,optional" frugal:"0,optional,DeleteWatchHistoryResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceUploadVideoResult() *VideoServiceUploadVideoResult {
-	return &VideoServiceUploadVideoResult{}
+func NewVideoServiceDeleteWatchHistoryResult() *VideoServiceDeleteWatchHistoryResult {
+	return &VideoServiceDeleteWatchHistoryResult{}
 }
 
-func (p *VideoServiceUploadVideoResult) InitDefault() {
+func (p *VideoServiceDeleteWatchHistoryResult) InitDefault() {
 }
 
-var VideoServiceUploadVideoResult_Success_DEFAULT *UploadVideoResp
+var VideoServiceDeleteWatchHistoryResult_Success_DEFAULT *DeleteWatchHistoryResp
 
-func (p *VideoServiceUploadVideoResult) GetSuccess() (v *UploadVideoResp) {
+func (p *VideoServiceDeleteWatchHistoryResult) GetSuccess() (v *DeleteWatchHistoryResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceUploadVideoResult_Success_DEFAULT
+		return VideoServiceDeleteWatchHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceUploadVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UploadVideoResp)
+func (p *VideoServiceDeleteWatchHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteWatchHistoryResp)
 }
 
-func (p *VideoServiceUploadVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceDeleteWatchHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceUploadVideoResult) String() string {
+func (p *VideoServiceDeleteWatchHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUploadVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDeleteWatchHistoryResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceUploadVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceDeleteWatchHistoryResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetUserVideoCountArgs struct {
-	Req *GetUserVideoCountReq `thrift:"req,1" frugal:"1,default,GetUserVideoCountReq" json:"req"`
+type VideoServiceClearWatchHistoryArgs struct {
+	Req *ClearWatchHistoryReq `thrift:"req,1" frugal:"1,default,ClearWatchHistoryReq" json:"req"`
 }
 
-func NewVideoServiceGetUserVideoCountArgs() *VideoServiceGetUserVideoCountArgs {
-	return &VideoServiceGetUserVideoCountArgs{}
+func NewVideoServiceClearWatchHistoryArgs() *VideoServiceClearWatchHistoryArgs {
+	return &VideoServiceClearWatchHistoryArgs{}
 }
 
-func (p *VideoServiceGetUserVideoCountArgs) InitDefault() {
+func (p *VideoServiceClearWatchHistoryArgs) InitDefault() {
 }
 
-var VideoServiceGetUserVideoCountArgs_Req_DEFAULT *GetUserVideoCountReq
+var VideoServiceClearWatchHistoryArgs_Req_DEFAULT *ClearWatchHistoryReq
 
-func (p *VideoServiceGetUserVideoCountArgs) GetReq() (v *GetUserVideoCountReq) {
+func (p *VideoServiceClearWatchHistoryArgs) GetReq() (v *ClearWatchHistoryReq) {
 	if !p.IsSetReq() {
-		return VideoServiceGetUserVideoCountArgs_Req_DEFAULT
+		return VideoServiceClearWatchHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetUserVideoCountArgs) SetReq(val *GetUserVideoCountReq) {
+func (p *VideoServiceClearWatchHistoryArgs) SetReq(val *ClearWatchHistoryReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetUserVideoCountArgs) IsSetReq() bool {
+func (p *VideoServiceClearWatchHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetUserVideoCountArgs) String() string {
+func (p *VideoServiceClearWatchHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetUserVideoCountArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceClearWatchHistoryArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetUserVideoCountArgs = map[int16]string{
+var fieldIDToName_VideoServiceClearWatchHistoryArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetUserVideoCountResult struct {
-	Success *GetUserVideoCountResp `thrift:"success,0,optional" frugal:"0,optional,GetUserVideoCountResp" json:"success,omitempty"`
+type VideoServiceClearWatchHistoryResult struct {
+	Success *ClearWatchHistoryResp `thrift:"success,0,optional" frugal:"0,optional,ClearWatchHistoryResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetUserVideoCountResult() *VideoServiceGetUserVideoCountResult {
-	return &VideoServiceGetUserVideoCountResult{}
+func NewVideoServiceClearWatchHistoryResult() *VideoServiceClearWatchHistoryResult {
+	return &VideoServiceClearWatchHistoryResult{}
 }
 
-func (p *VideoServiceGetUserVideoCountResult) InitDefault() {
+func (p *VideoServiceClearWatchHistoryResult) InitDefault() {
 }
 
-var VideoServiceGetUserVideoCountResult_Success_DEFAULT *GetUserVideoCountResp
+var VideoServiceClearWatchHistoryResult_Success_DEFAULT *ClearWatchHistoryResp
 
-func (p *VideoServiceGetUserVideoCountResult) GetSuccess() (v *GetUserVideoCountResp) {
+func (p *VideoServiceClearWatchHistoryResult) GetSuccess() (v *ClearWatchHistoryResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetUserVideoCountResult_Success_DEFAULT
+		return VideoServiceClearWatchHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetUserVideoCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUserVideoCountResp)
+func (p *VideoServiceClearWatchHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ClearWatchHistoryResp)
 }
 
-func (p *VideoServiceGetUserVideoCountResult) IsSetSuccess() bool {
+func (p *VideoServiceClearWatchHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetUserVideoCountResult) String() string {
+func (p *VideoServiceClearWatchHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetUserVideoCountResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceClearWatchHistoryResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetUserVideoCountResult = map[int16]string{
+var fieldIDToName_VideoServiceClearWatchHistoryResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetTotalVideoCountArgs struct {
-	Req *GetTotalVideoCountReq `thrift:"req,1" frugal:"1,default,GetTotalVideoCountReq" json:"req"`
+type VideoServiceSetWatchHistoryPausedArgs struct {
+	Req *SetWatchHistoryPausedReq `thrift:"req,1" frugal:"1,default,SetWatchHistoryPausedReq" json:"req"`
 }
 
-func NewVideoServiceGetTotalVideoCountArgs() *VideoServiceGetTotalVideoCountArgs {
-	return &VideoServiceGetTotalVideoCountArgs{}
+func NewVideoServiceSetWatchHistoryPausedArgs() *VideoServiceSetWatchHistoryPausedArgs {
+	return &VideoServiceSetWatchHistoryPausedArgs{}
 }
 
-func (p *VideoServiceGetTotalVideoCountArgs) InitDefault() {
+func (p *VideoServiceSetWatchHistoryPausedArgs) InitDefault() {
 }
 
-var VideoServiceGetTotalVideoCountArgs_Req_DEFAULT *GetTotalVideoCountReq
+var VideoServiceSetWatchHistoryPausedArgs_Req_DEFAULT *SetWatchHistoryPausedReq
 
-func (p *VideoServiceGetTotalVideoCountArgs) GetReq() (v *GetTotalVideoCountReq) {
+func (p *VideoServiceSetWatchHistoryPausedArgs) GetReq() (v *SetWatchHistoryPausedReq) {
 	if !p.IsSetReq() {
-		return VideoServiceGetTotalVideoCountArgs_Req_DEFAULT
+		return VideoServiceSetWatchHistoryPausedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetTotalVideoCountArgs) SetReq(val *GetTotalVideoCountReq) {
+func (p *VideoServiceSetWatchHistoryPausedArgs) SetReq(val *SetWatchHistoryPausedReq) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetTotalVideoCountArgs) IsSetReq() bool {
+func (p *VideoServiceSetWatchHistoryPausedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetTotalVideoCountArgs) String() string {
+func (p *VideoServiceSetWatchHistoryPausedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetTotalVideoCountArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSetWatchHistoryPausedArgs(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetTotalVideoCountArgs = map[int16]string{
+var fieldIDToName_VideoServiceSetWatchHistoryPausedArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetTotalVideoCountResult struct {
-	Success *GetTotalVideoCountResp `thrift:"success,0,optional" frugal:"0,optional,GetTotalVideoCountResp" json:"success,omitempty"`
+type VideoServiceSetWatchHistoryPausedResult struct {
+	Success *SetWatchHistoryPausedResp `thrift:"success,0,optional" frugal:"0,optional,SetWatchHistoryPausedResp" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetTotalVideoCountResult() *VideoServiceGetTotalVideoCountResult {
-	return &VideoServiceGetTotalVideoCountResult{}
+func NewVideoServiceSetWatchHistoryPausedResult() *VideoServiceSetWatchHistoryPausedResult {
+	return &VideoServiceSetWatchHistoryPausedResult{}
 }
 
-func (p *VideoServiceGetTotalVideoCountResult) InitDefault() {
+func (p *VideoServiceSetWatchHistoryPausedResult) InitDefault() {
 }
 
-var VideoServiceGetTotalVideoCountResult_Success_DEFAULT *GetTotalVideoCountResp
+var VideoServiceSetWatchHistoryPausedResult_Success_DEFAULT *SetWatchHistoryPausedResp
 
-func (p *VideoServiceGetTotalVideoCountResult) GetSuccess() (v *GetTotalVideoCountResp) {
+func (p *VideoServiceSetWatchHistoryPausedResult) GetSuccess() (v *SetWatchHistoryPausedResp) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetTotalVideoCountResult_Success_DEFAULT
+		return VideoServiceSetWatchHistoryPausedResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetTotalVideoCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetTotalVideoCountResp)
+func (p *VideoServiceSetWatchHistoryPausedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetWatchHistoryPausedResp)
 }
 
-func (p *VideoServiceGetTotalVideoCountResult) IsSetSuccess() bool {
+func (p *VideoServiceSetWatchHistoryPausedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetTotalVideoCountResult) String() string {
+func (p *VideoServiceSetWatchHistoryPausedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetTotalVideoCountResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSetWatchHistoryPausedResult(%+v)", *p)
 }
 
-var fieldIDToName_VideoServiceGetTotalVideoCountResult = map[int16]string{
+var fieldIDToName_VideoServiceSetWatchHistoryPausedResult = map[int16]string{
 	0: "success",
 }
@@ -24,6 +24,12 @@ type Client interface {
 	UploadVideo(ctx context.Context, req *video.UploadVideoReq, callOptions ...callopt.Option) (r *video.UploadVideoResp, err error)
 	GetUserVideoCount(ctx context.Context, req *video.GetUserVideoCountReq, callOptions ...callopt.Option) (r *video.GetUserVideoCountResp, err error)
 	GetTotalVideoCount(ctx context.Context, req *video.GetTotalVideoCountReq, callOptions ...callopt.Option) (r *video.GetTotalVideoCountResp, err error)
+	ReportWatchProgress(ctx context.Context, req *video.ReportWatchProgressReq, callOptions ...callopt.Option) (r *video.ReportWatchProgressResp, err error)
+	GetWatchHistory(ctx context.Context, req *video.WatchHistoryReq, callOptions ...callopt.Option) (r *video.WatchHistoryResp, err error)
+	GetWatchProgress(ctx context.Context, req *video.WatchProgressReq, callOptions ...callopt.Option) (r *video.WatchProgressResp, err error)
+	DeleteWatchHistory(ctx context.Context, req *video.DeleteWatchHistoryReq, callOptions ...callopt.Option) (r *video.DeleteWatchHistoryResp, err error)
+	ClearWatchHistory(ctx context.Context, req *video.ClearWatchHistoryReq, callOptions ...callopt.Option) (r *video.ClearWatchHistoryResp, err error)
+	SetWatchHistoryPaused(ctx context.Context, req *video.SetWatchHistoryPausedReq, callOptions ...callopt.Option) (r *video.SetWatchHistoryPausedResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -119,3 +125,33 @@ func (p *kVideoServiceClient) GetTotalVideoCount(ctx context.Context, req *video
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetTotalVideoCount(ctx, req)
 }
+
+func (p *kVideoServiceClient) ReportWatchProgress(ctx context.Context, req *video.ReportWatchProgressReq, callOptions ...callopt.Option) (r *video.ReportWatchProgressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReportWatchProgress(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetWatchHistory(ctx context.Context, req *video.WatchHistoryReq, callOptions ...callopt.Option) (r *video.WatchHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetWatchHistory(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetWatchProgress(ctx context.Context, req *video.WatchProgressReq, callOptions ...callopt.Option) (r *video.WatchProgressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetWatchProgress(ctx, req)
+}
+
+func (p *kVideoServiceClient) DeleteWatchHistory(ctx context.Context, req *video.DeleteWatchHistoryReq, callOptions ...callopt.Option) (r *video.DeleteWatchHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteWatchHistory(ctx, req)
+}
+
+func (p *kVideoServiceClient) ClearWatchHistory(ctx context.Context, req *video.ClearWatchHistoryReq, callOptions ...callopt.Option) (r *video.ClearWatchHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClearWatchHistory(ctx, req)
+}
+
+func (p *kVideoServiceClient) SetWatchHistoryPaused(ctx context.Context, req *video.SetWatchHistoryPausedReq, callOptions ...callopt.Option) (r *video.SetWatchHistoryPausedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetWatchHistoryPaused(ctx, req)
+}
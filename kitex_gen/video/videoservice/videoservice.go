@@ -104,6 +104,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ReportWatchProgress": kitex.NewMethodInfo(
+		reportWatchProgressHandler,
+		newVideoServiceReportWatchProgressArgs,
+		newVideoServiceReportWatchProgressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetWatchHistory": kitex.NewMethodInfo(
+		getWatchHistoryHandler,
+		newVideoServiceGetWatchHistoryArgs,
+		newVideoServiceGetWatchHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetWatchProgress": kitex.NewMethodInfo(
+		getWatchProgressHandler,
+		newVideoServiceGetWatchProgressArgs,
+		newVideoServiceGetWatchProgressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteWatchHistory": kitex.NewMethodInfo(
+		deleteWatchHistoryHandler,
+		newVideoServiceDeleteWatchHistoryArgs,
+		newVideoServiceDeleteWatchHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ClearWatchHistory": kitex.NewMethodInfo(
+		clearWatchHistoryHandler,
+		newVideoServiceClearWatchHistoryArgs,
+		newVideoServiceClearWatchHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetWatchHistoryPaused": kitex.NewMethodInfo(
+		setWatchHistoryPausedHandler,
+		newVideoServiceSetWatchHistoryPausedArgs,
+		newVideoServiceSetWatchHistoryPausedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -404,6 +446,114 @@ func newVideoServiceGetTotalVideoCountResult() interface{} {
 	return video.NewVideoServiceGetTotalVideoCountResult()
 }
 
+func reportWatchProgressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceReportWatchProgressArgs)
+	realResult := result.(*video.VideoServiceReportWatchProgressResult)
+	success, err := handler.(video.VideoService).ReportWatchProgress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceReportWatchProgressArgs() interface{} {
+	return video.NewVideoServiceReportWatchProgressArgs()
+}
+
+func newVideoServiceReportWatchProgressResult() interface{} {
+	return video.NewVideoServiceReportWatchProgressResult()
+}
+
+func getWatchHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetWatchHistoryArgs)
+	realResult := result.(*video.VideoServiceGetWatchHistoryResult)
+	success, err := handler.(video.VideoService).GetWatchHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetWatchHistoryArgs() interface{} {
+	return video.NewVideoServiceGetWatchHistoryArgs()
+}
+
+func newVideoServiceGetWatchHistoryResult() interface{} {
+	return video.NewVideoServiceGetWatchHistoryResult()
+}
+
+func getWatchProgressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetWatchProgressArgs)
+	realResult := result.(*video.VideoServiceGetWatchProgressResult)
+	success, err := handler.(video.VideoService).GetWatchProgress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetWatchProgressArgs() interface{} {
+	return video.NewVideoServiceGetWatchProgressArgs()
+}
+
+func newVideoServiceGetWatchProgressResult() interface{} {
+	return video.NewVideoServiceGetWatchProgressResult()
+}
+
+func deleteWatchHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceDeleteWatchHistoryArgs)
+	realResult := result.(*video.VideoServiceDeleteWatchHistoryResult)
+	success, err := handler.(video.VideoService).DeleteWatchHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceDeleteWatchHistoryArgs() interface{} {
+	return video.NewVideoServiceDeleteWatchHistoryArgs()
+}
+
+func newVideoServiceDeleteWatchHistoryResult() interface{} {
+	return video.NewVideoServiceDeleteWatchHistoryResult()
+}
+
+func clearWatchHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceClearWatchHistoryArgs)
+	realResult := result.(*video.VideoServiceClearWatchHistoryResult)
+	success, err := handler.(video.VideoService).ClearWatchHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceClearWatchHistoryArgs() interface{} {
+	return video.NewVideoServiceClearWatchHistoryArgs()
+}
+
+func newVideoServiceClearWatchHistoryResult() interface{} {
+	return video.NewVideoServiceClearWatchHistoryResult()
+}
+
+func setWatchHistoryPausedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceSetWatchHistoryPausedArgs)
+	realResult := result.(*video.VideoServiceSetWatchHistoryPausedResult)
+	success, err := handler.(video.VideoService).SetWatchHistoryPaused(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceSetWatchHistoryPausedArgs() interface{} {
+	return video.NewVideoServiceSetWatchHistoryPausedArgs()
+}
+
+func newVideoServiceSetWatchHistoryPausedResult() interface{} {
+	return video.NewVideoServiceSetWatchHistoryPausedResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -543,3 +693,63 @@ func (p *kClient) GetTotalVideoCount(ctx context.Context, req *video.GetTotalVid
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ReportWatchProgress(ctx context.Context, req *video.ReportWatchProgressReq) (r *video.ReportWatchProgressResp, err error) {
+	var _args video.VideoServiceReportWatchProgressArgs
+	_args.Req = req
+	var _result video.VideoServiceReportWatchProgressResult
+	if err = p.c.Call(ctx, "ReportWatchProgress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetWatchHistory(ctx context.Context, req *video.WatchHistoryReq) (r *video.WatchHistoryResp, err error) {
+	var _args video.VideoServiceGetWatchHistoryArgs
+	_args.Req = req
+	var _result video.VideoServiceGetWatchHistoryResult
+	if err = p.c.Call(ctx, "GetWatchHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetWatchProgress(ctx context.Context, req *video.WatchProgressReq) (r *video.WatchProgressResp, err error) {
+	var _args video.VideoServiceGetWatchProgressArgs
+	_args.Req = req
+	var _result video.VideoServiceGetWatchProgressResult
+	if err = p.c.Call(ctx, "GetWatchProgress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteWatchHistory(ctx context.Context, req *video.DeleteWatchHistoryReq) (r *video.DeleteWatchHistoryResp, err error) {
+	var _args video.VideoServiceDeleteWatchHistoryArgs
+	_args.Req = req
+	var _result video.VideoServiceDeleteWatchHistoryResult
+	if err = p.c.Call(ctx, "DeleteWatchHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ClearWatchHistory(ctx context.Context, req *video.ClearWatchHistoryReq) (r *video.ClearWatchHistoryResp, err error) {
+	var _args video.VideoServiceClearWatchHistoryArgs
+	_args.Req = req
+	var _result video.VideoServiceClearWatchHistoryResult
+	if err = p.c.Call(ctx, "ClearWatchHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetWatchHistoryPaused(ctx context.Context, req *video.SetWatchHistoryPausedReq) (r *video.SetWatchHistoryPausedResp, err error) {
+	var _args video.VideoServiceSetWatchHistoryPausedArgs
+	_args.Req = req
+	var _result video.VideoServiceSetWatchHistoryPausedResult
+	if err = p.c.Call(ctx, "SetWatchHistoryPaused", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"shortvideo/pkg/config"
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,6 +17,8 @@ type Cache interface {
 	Get(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+	GetSet(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error)
 
 	//批量操作
 	MSet(ctx context.Context, values map[string]interface{}) error
@@ -26,6 +29,7 @@ type Cache interface {
 	HSet(ctx context.Context, key, field string, value interface{}) error
 	HGet(ctx context.Context, key, field string) (string, error)
 	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	HMGet(ctx context.Context, key string, fields []string) (map[string]string, error)
 	HDel(ctx context.Context, key string, fields []string) error
 
 	//列表操作
@@ -39,12 +43,18 @@ type Cache interface {
 	SRem(ctx context.Context, key string, members ...interface{}) error
 	SMembers(ctx context.Context, key string) ([]string, error)
 	SIsMember(ctx context.Context, key string, member interface{}) (bool, error)
+	SMIsMember(ctx context.Context, key string, members ...interface{}) ([]bool, error)
 
 	//有序集合操作
 	ZAdd(ctx context.Context, key string, score float64, member interface{}) error
 	ZRem(ctx context.Context, key string, members ...interface{}) error
 	ZRange(ctx context.Context, key string, start, stop int64) ([]string, error)
 	ZScore(ctx context.Context, key string, member string) (float64, error)
+	ZRevRangeByScore(ctx context.Context, key string, max, min string, count int64) ([]string, error)
+	ZCard(ctx context.Context, key string) (int64, error)
+	ZRemRangeByRank(ctx context.Context, key string, start, stop int64) error
+	ZCount(ctx context.Context, key string, min, max string) (int64, error)
+	ZAddWindow(ctx context.Context, key string, member string, now time.Time, window time.Duration) (int64, error)
 
 	//计数器操作
 	Incr(ctx context.Context, key string) (int64, error)
@@ -106,6 +116,25 @@ func (c *RedisCache) Get(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
 }
 
+// 原子地写入新值并返回旧值，键不存在时返回空字符串
+func (c *RedisCache) GetSet(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error) {
+	var old *redis.StringCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		old = pipe.GetSet(ctx, key, value)
+		pipe.Expire(ctx, key, expiration)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return "", err
+	}
+
+	result, err := old.Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return result, err
+}
+
 // 删除键
 func (c *RedisCache) Delete(ctx context.Context, key string) error {
 	return c.client.Del(ctx, key).Err()
@@ -120,6 +149,11 @@ func (c *RedisCache) Exists(ctx context.Context, key string) (bool, error) {
 	return result > 0, nil
 }
 
+// 设置过期时间
+func (c *RedisCache) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return c.client.Expire(ctx, key, expiration).Err()
+}
+
 // 批量设置
 func (c *RedisCache) MSet(ctx context.Context, values map[string]interface{}) error {
 	return c.client.MSet(ctx, values).Err()
@@ -161,6 +195,22 @@ func (c *RedisCache) HGetAll(ctx context.Context, key string) (map[string]string
 	return c.client.HGetAll(ctx, key).Result()
 }
 
+// 批量获取哈希字段，不存在的字段不出现在结果中
+func (c *RedisCache) HMGet(ctx context.Context, key string, fields []string) (map[string]string, error) {
+	result, err := c.client.HMGet(ctx, key, fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for i, field := range fields {
+		if result[i] != nil {
+			values[field] = result[i].(string)
+		}
+	}
+	return values, nil
+}
+
 // 删除哈希字段
 func (c *RedisCache) HDel(ctx context.Context, key string, fields []string) error {
 	return c.client.HDel(ctx, key, fields...).Err()
@@ -206,6 +256,11 @@ func (c *RedisCache) SIsMember(ctx context.Context, key string, member interface
 	return c.client.SIsMember(ctx, key, member).Result()
 }
 
+// 批量检查是否为集合成员，结果与members顺序一致
+func (c *RedisCache) SMIsMember(ctx context.Context, key string, members ...interface{}) ([]bool, error) {
+	return c.client.SMIsMember(ctx, key, members...).Result()
+}
+
 // 添加有序集合成员
 func (c *RedisCache) ZAdd(ctx context.Context, key string, score float64, member interface{}) error {
 	return c.client.ZAdd(ctx, key, &redis.Z{Score: score, Member: member}).Err()
@@ -226,6 +281,46 @@ func (c *RedisCache) ZScore(ctx context.Context, key string, member string) (flo
 	return c.client.ZScore(ctx, key, member).Result()
 }
 
+// 按分数从高到低获取有序集合成员，max/min支持"(", "+inf", "-inf"等写法
+func (c *RedisCache) ZRevRangeByScore(ctx context.Context, key string, max, min string, count int64) ([]string, error) {
+	return c.client.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+		Max:   max,
+		Min:   min,
+		Count: count,
+	}).Result()
+}
+
+// 获取有序集合成员数量
+func (c *RedisCache) ZCard(ctx context.Context, key string) (int64, error) {
+	return c.client.ZCard(ctx, key).Result()
+}
+
+// 按排名删除有序集合成员
+func (c *RedisCache) ZRemRangeByRank(ctx context.Context, key string, start, stop int64) error {
+	return c.client.ZRemRangeByRank(ctx, key, start, stop).Err()
+}
+
+// 统计分数在[min, max]内的有序集合成员数量
+func (c *RedisCache) ZCount(ctx context.Context, key string, min, max string) (int64, error) {
+	return c.client.ZCount(ctx, key, min, max).Result()
+}
+
+// 滑动窗口计数：以当前时间为分数写入成员，清理窗口外的成员并返回窗口内的数量
+func (c *RedisCache) ZAddWindow(ctx context.Context, key string, member string, now time.Time, window time.Duration) (int64, error) {
+	var card *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+		pipe.ZAdd(ctx, key, &redis.Z{Score: float64(now.UnixNano()), Member: member})
+		card = pipe.ZCard(ctx, key)
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return card.Val(), nil
+}
+
 // 递增计数器
 func (c *RedisCache) Incr(ctx context.Context, key string) (int64, error) {
 	return c.client.Incr(ctx, key).Result()
@@ -275,3 +370,107 @@ func GenerateUserVideosKey(userID int64) string {
 func GenerateFeedKey(userID int64) string {
 	return fmt.Sprintf("feed:%d", userID)
 }
+
+// 生成视频热门评论排序缓存键
+func GenerateHotCommentsKey(videoID int64) string {
+	return fmt.Sprintf("comments:hot:%d", videoID)
+}
+
+func GenerateHotCommentsSnapshotKey(videoID, version int64) string {
+	return fmt.Sprintf("comments:hot:%d:%d", videoID, version)
+}
+
+// 生成用户点赞视频集合缓存键
+func GenerateUserLikedKey(userID int64) string {
+	return fmt.Sprintf("user:liked:%d", userID)
+}
+
+// 生成用户收藏视频集合缓存键
+func GenerateUserStarredKey(userID int64) string {
+	return fmt.Sprintf("user:starred:%d", userID)
+}
+
+// 生成用户所在可见名单集合缓存键
+func GenerateAudienceMembershipKey(userID int64) string {
+	return fmt.Sprintf("user:audience:%d", userID)
+}
+
+// 生成用户观看历史有序集合缓存键
+func GenerateWatchHistoryKey(userID int64) string {
+	return fmt.Sprintf("user:history:%d", userID)
+}
+
+// 生成用户观看进度哈希缓存键
+func GenerateWatchProgressKey(userID int64) string {
+	return fmt.Sprintf("user:history:progress:%d", userID)
+}
+
+// 生成用户暂停记录观看历史标记缓存键
+func GenerateWatchHistoryPausedKey(userID int64) string {
+	return fmt.Sprintf("user:history:paused:%d", userID)
+}
+
+// 生成已吊销令牌缓存键
+func GenerateRevokedTokenKey(tokenID string) string {
+	return fmt.Sprintf("token:revoked:%s", tokenID)
+}
+
+// 生成已吊销会话缓存键，会话吊销后该会话签发的所有令牌失效
+func GenerateRevokedSessionKey(sessionID string) string {
+	return fmt.Sprintf("session:revoked:%s", sessionID)
+}
+
+// 生成会话当前有效的刷新令牌ID缓存键
+func GenerateSessionRefreshKey(sessionID string) string {
+	return fmt.Sprintf("session:refresh:%s", sessionID)
+}
+
+// 生成会话最近活跃标记缓存键，用于限制活跃时间的写入频率
+func GenerateSessionSeenKey(sessionID string) string {
+	return fmt.Sprintf("session:seen:%s", sessionID)
+}
+
+// 生成登录失败计数缓存键，kind为用户名、IP或设备指纹
+func GenerateLoginFailureKey(kind, value string) string {
+	return fmt.Sprintf("guard:login:fail:%s:%s", kind, value)
+}
+
+// 生成登录注册锁定缓存键
+func GenerateLoginLockKey(kind, value string) string {
+	return fmt.Sprintf("guard:lock:%s:%s", kind, value)
+}
+
+// 生成注册次数计数缓存键
+func GenerateRegisterAttemptKey(kind, value string) string {
+	return fmt.Sprintf("guard:register:%s:%s", kind, value)
+}
+
+// 生成两步验证登录挑战缓存键
+func GenerateTwoFactorChallengeKey(token string) string {
+	return fmt.Sprintf("2fa:challenge:%s", token)
+}
+
+// 生成两步验证登录挑战错误次数缓存键
+func GenerateTwoFactorAttemptKey(token string) string {
+	return fmt.Sprintf("2fa:attempts:%s", token)
+}
+
+// 生成第三方授权状态缓存键
+func GenerateOAuthStateKey(state string) string {
+	return fmt.Sprintf("oauth:state:%s", state)
+}
+
+// 生成用户令牌吊销时间缓存键，早于该时间签发的令牌全部失效
+func GenerateUserTokensRevokedKey(userID int64) string {
+	return fmt.Sprintf("user:tokens:revoked_before:%d", userID)
+}
+
+// 生成用户角色变更时间缓存键，早于该时间签发的访问令牌携带的角色已过期，需要刷新令牌
+func GenerateUserRolesChangedKey(userID int64) string {
+	return fmt.Sprintf("user:roles:changed_at:%d", userID)
+}
+
+// 生成用户设置缓存键
+func GenerateUserSettingsKey(userID int64) string {
+	return fmt.Sprintf("user:settings:%d", userID)
+}
@@ -0,0 +1,49 @@
+package captcha
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"shortvideo/pkg/config"
+)
+
+// 本地桩实现的名称
+const ProviderLocal = "local"
+
+var (
+	ErrUnknownProvider = errors.New("未知的人机验证服务")
+)
+
+// Verifier 人机验证，token为客户端完成验证后拿到的凭证，remoteIP用于服务端二次校验
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// 根据配置创建人机验证，目前只有本地桩实现，接入第三方服务时在此扩展
+func NewVerifier() (Verifier, error) {
+	captchaConfig := config.Get().Captcha
+	switch strings.ToLower(captchaConfig.Provider) {
+	case "", ProviderLocal:
+		return NewLocalVerifier(captchaConfig.LocalToken), nil
+	default:
+		return nil, ErrUnknownProvider
+	}
+}
+
+// LocalVerifier 本地桩实现，token与配置的固定值一致即视为通过，用于开发和测试环境
+type LocalVerifier struct {
+	token string
+}
+
+func NewLocalVerifier(token string) *LocalVerifier {
+	return &LocalVerifier{token: token}
+}
+
+func (v *LocalVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	if v.token == "" || token == "" {
+		return false, nil
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(v.token)) == 1, nil
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	"sync"
 	"time"
 
@@ -26,6 +28,18 @@ type Config struct {
 	Minio         MinioConfig         `mapstructure:"minio"`
 	Log           LogConfig           `mapstructure:"log"`
 	JWT           JWTConfig           `mapstructure:"jwt"`
+	Pagination    PaginationConfig    `mapstructure:"pagination"`
+	TextFilter    TextFilterConfig    `mapstructure:"text_filter"`
+	Share         ShareConfig         `mapstructure:"share"`
+	Social        SocialConfig        `mapstructure:"social"`
+	LoginGuard    LoginGuardConfig    `mapstructure:"login_guard"`
+	Captcha       CaptchaConfig       `mapstructure:"captcha"`
+	TwoFactor     TwoFactorConfig     `mapstructure:"two_factor"`
+	OAuth         OAuthConfig         `mapstructure:"oauth"`
+	Account       AccountConfig       `mapstructure:"account"`
+	Username      UsernameConfig      `mapstructure:"username"`
+	RBAC          RBACConfig          `mapstructure:"rbac"`
+	Experience    ExperienceConfig    `mapstructure:"experience"`
 	Prometheus    PrometheusConfig    `mapstructure:"prometheus"`
 	Tracing       TracingConfig       `mapstructure:"tracing"`
 	WebSocket     WebSocketConfig     `mapstructure:"websocket"`
@@ -155,10 +169,138 @@ type LogConfig struct {
 	FilePath string `mapstructure:"file_path"`
 }
 
-// JWT配置
+// JWT配置，访问令牌短期有效，过期后用刷新令牌换取新的令牌对
 type JWTConfig struct {
-	Secret      string `mapstructure:"secret"`
-	ExpireHours int    `mapstructure:"expire_hours"`
+	Secret              string `mapstructure:"secret"`
+	AccessExpireMinutes int    `mapstructure:"access_expire_minutes"`
+	RefreshExpireHours  int    `mapstructure:"refresh_expire_hours"`
+}
+
+// 分页配置，游标签名密钥不提供默认值，未配置时服务拒绝启动
+type PaginationConfig struct {
+	CursorSecret        string `mapstructure:"cursor_secret"`
+	CursorExpireMinutes int    `mapstructure:"cursor_expire_minutes"`
+	TotalCacheSeconds   int    `mapstructure:"total_cache_seconds"`
+}
+
+// 敏感词过滤配置
+type TextFilterConfig struct {
+	Enable        bool              `mapstructure:"enable"`
+	WordFile      string            `mapstructure:"word_file"`
+	ReloadSeconds int               `mapstructure:"reload_seconds"`
+	DefaultAction string            `mapstructure:"default_action"`
+	Actions       map[string]string `mapstructure:"actions"`
+}
+
+// 分享链接配置，LandingURL 中的 {video_id} 和 {code} 会被替换
+type ShareConfig struct {
+	BaseURL    string `mapstructure:"base_url"`
+	LandingURL string `mapstructure:"landing_url"`
+}
+
+// 社交配置，CounterRepairHours 为根据关注表修复关注数和粉丝数的间隔，0表示不修复
+type SocialConfig struct {
+	CounterRepairHours int `mapstructure:"counter_repair_hours"`
+}
+
+// 登录注册防护配置，按用户名、IP和设备指纹在滑动窗口内统计失败次数，
+// 失败次数达到CaptchaAfter后要求人机验证，达到对应上限后临时锁定；每次失败后的延迟按次数翻倍。
+// MaxTwoFactorFailures为同一用户在窗口内两步验证码错误的上限，登录和敏感操作的二次验证共用
+type LoginGuardConfig struct {
+	Enable                bool `mapstructure:"enable"`
+	WindowMinutes         int  `mapstructure:"window_minutes"`
+	CaptchaAfter          int  `mapstructure:"captcha_after"`
+	MaxUsernameFailures   int  `mapstructure:"max_username_failures"`
+	MaxIPFailures         int  `mapstructure:"max_ip_failures"`
+	MaxDeviceFailures     int  `mapstructure:"max_device_failures"`
+	MaxTwoFactorFailures  int  `mapstructure:"max_two_factor_failures"`
+	LockoutMinutes        int  `mapstructure:"lockout_minutes"`
+	DelayBaseMillis       int  `mapstructure:"delay_base_millis"`
+	DelayMaxMillis        int  `mapstructure:"delay_max_millis"`
+	RegisterWindowMinutes int  `mapstructure:"register_window_minutes"`
+	RegisterCaptchaAfter  int  `mapstructure:"register_captcha_after"`
+	MaxRegisterPerIP      int  `mapstructure:"max_register_per_ip"`
+	MaxRegisterPerDevice  int  `mapstructure:"max_register_per_device"`
+}
+
+// 人机验证配置，Provider为local时使用本地桩实现，客户端提交LocalToken即视为通过
+type CaptchaConfig struct {
+	Provider   string `mapstructure:"provider"`
+	LocalToken string `mapstructure:"local_token"`
+}
+
+// 两步验证配置，ChallengeMinutes为密码验证通过后提交验证码的时限，Skew为允许的时钟偏差（时间步数）
+type TwoFactorConfig struct {
+	Issuer            string `mapstructure:"issuer"`
+	ChallengeMinutes  int    `mapstructure:"challenge_minutes"`
+	ChallengeAttempts int    `mapstructure:"challenge_attempts"`
+	RecoveryCodes     int    `mapstructure:"recovery_codes"`
+	Skew              int    `mapstructure:"skew"`
+}
+
+// 第三方登录配置，RedirectURL中的{provider}会被替换为提供方名称，StateMinutes为完成授权的时限
+type OAuthConfig struct {
+	RedirectURL  string                `mapstructure:"redirect_url"`
+	StateMinutes int                   `mapstructure:"state_minutes"`
+	Providers    []OAuthProviderConfig `mapstructure:"providers"`
+	Mock         OAuthMockConfig       `mapstructure:"mock"`
+}
+
+// OIDC提供方配置，Scopes为空时使用openid profile email
+type OAuthProviderConfig struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	AuthURL      string   `mapstructure:"auth_url"`
+	TokenURL     string   `mapstructure:"token_url"`
+	JWKSURL      string   `mapstructure:"jwks_url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// 本地模拟OIDC提供方，授权页由网关提供，用于离线联调；只能在dev环境开启，
+// 签名密钥只从环境变量OAUTH_MOCK_SECRET读取
+type OAuthMockConfig struct {
+	Enable   bool   `mapstructure:"enable"`
+	Issuer   string `mapstructure:"issuer"`
+	ClientID string `mapstructure:"client_id"`
+	Secret   string `mapstructure:"secret"`
+}
+
+// 账号注销和数据导出配置，DeletionCoolingDays为申请注销到执行注销的冷静期，
+// ExportExpireHours为导出文件下载链接的有效期，ExportBucket为存放导出文件的私有桶，
+// JobIntervalMinutes为处理到期注销和导出任务的间隔
+type AccountConfig struct {
+	DeletionCoolingDays int    `mapstructure:"deletion_cooling_days"`
+	ExportExpireHours   int    `mapstructure:"export_expire_hours"`
+	ExportBucket        string `mapstructure:"export_bucket"`
+	JobIntervalMinutes  int    `mapstructure:"job_interval_minutes"`
+}
+
+// 用户名修改配置，ChangeCooldownDays为两次修改之间的最短间隔，RedirectDays为旧用户名保留并跳转到新用户名的天数，
+// 保留期内其他用户不能使用；Reserved为不能注册和修改为的保留用户名，不区分大小写
+type UsernameConfig struct {
+	ChangeCooldownDays int      `mapstructure:"change_cooldown_days"`
+	RedirectDays       int      `mapstructure:"redirect_days"`
+	Reserved           []string `mapstructure:"reserved"`
+}
+
+// 角色权限配置，AdminUsernames中的用户在用户服务启动时被授予管理员角色，用于初始化第一个管理员
+type RBACConfig struct {
+	AdminUsernames []string `mapstructure:"admin_usernames"`
+}
+
+// 经验值和等级配置，LevelThresholds为升到2级、3级……所需的累计经验值，需按升序排列；
+// Sources为各类行为每次获得的经验值和每天最多获得的经验值；开启隐藏低等级弹幕后，低于LowLevel级的用户弹幕会被隐藏
+type ExperienceConfig struct {
+	LevelThresholds []int64                           `mapstructure:"level_thresholds"`
+	Sources         map[string]ExperienceSourceConfig `mapstructure:"sources"`
+	LowLevel        int32                             `mapstructure:"low_level"`
+}
+
+type ExperienceSourceConfig struct {
+	Points   int64 `mapstructure:"points"`
+	DailyCap int64 `mapstructure:"daily_cap"`
 }
 
 // Prometheus配置
@@ -204,6 +346,23 @@ func LoadConfig() (*Config, error) {
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, err
 	}
+	//游标签名密钥可以通过环境变量PAGINATION_CURSOR_SECRET设置，不使用公开的默认值
+	if config.Pagination.CursorSecret == "" {
+		config.Pagination.CursorSecret = os.Getenv("PAGINATION_CURSOR_SECRET")
+	}
+	if config.Pagination.CursorSecret == "" {
+		return nil, errors.New("需要通过pagination.cursor_secret或环境变量PAGINATION_CURSOR_SECRET设置分页游标签名密钥")
+	}
+	//模拟OIDC提供方可以为任意身份签发授权码，不允许在dev以外的环境开启，密钥也不能写在配置文件中
+	config.OAuth.Mock.Secret = os.Getenv("OAUTH_MOCK_SECRET")
+	if config.OAuth.Mock.Enable {
+		if config.App.Env != "dev" {
+			return nil, fmt.Errorf("模拟OIDC提供方只能在dev环境开启，当前环境为%s", config.App.Env)
+		}
+		if config.OAuth.Mock.Secret == "" {
+			return nil, errors.New("开启模拟OIDC提供方时需要通过环境变量OAUTH_MOCK_SECRET设置密钥")
+		}
+	}
 	return &config, nil
 }
 
@@ -298,7 +457,72 @@ func setDefaults() {
 	viper.SetDefault("log.file_path", "./logs/app.log")
 
 	viper.SetDefault("jwt.secret", "misonomika")
-	viper.SetDefault("jwt.expire_hours", 168)
+	viper.SetDefault("jwt.access_expire_minutes", 15)
+	viper.SetDefault("jwt.refresh_expire_hours", 720)
+
+	viper.SetDefault("pagination.cursor_expire_minutes", 1440)
+	viper.SetDefault("pagination.total_cache_seconds", 60)
+
+	viper.SetDefault("text_filter.enable", true)
+	viper.SetDefault("text_filter.word_file", "./configs/sensitive_words.txt")
+	viper.SetDefault("text_filter.reload_seconds", 30)
+	viper.SetDefault("text_filter.default_action", "mask")
+
+	viper.SetDefault("share.base_url", "http://127.0.0.1:8080/s/")
+	viper.SetDefault("share.landing_url", "http://127.0.0.1:8080/api/video/detail?video_id={video_id}&share_code={code}")
+
+	viper.SetDefault("social.counter_repair_hours", 24)
+
+	viper.SetDefault("login_guard.enable", true)
+	viper.SetDefault("login_guard.window_minutes", 15)
+	viper.SetDefault("login_guard.captcha_after", 3)
+	viper.SetDefault("login_guard.max_username_failures", 5)
+	viper.SetDefault("login_guard.max_ip_failures", 20)
+	viper.SetDefault("login_guard.max_device_failures", 10)
+	viper.SetDefault("login_guard.max_two_factor_failures", 5)
+	viper.SetDefault("login_guard.lockout_minutes", 15)
+	viper.SetDefault("login_guard.delay_base_millis", 200)
+	viper.SetDefault("login_guard.delay_max_millis", 2000)
+	viper.SetDefault("login_guard.register_window_minutes", 60)
+	viper.SetDefault("login_guard.register_captcha_after", 3)
+	viper.SetDefault("login_guard.max_register_per_ip", 10)
+	viper.SetDefault("login_guard.max_register_per_device", 5)
+
+	viper.SetDefault("captcha.provider", "local")
+	viper.SetDefault("captcha.local_token", "local-captcha-pass")
+
+	viper.SetDefault("two_factor.issuer", "shortvideo")
+	viper.SetDefault("two_factor.challenge_minutes", 5)
+	viper.SetDefault("two_factor.challenge_attempts", 5)
+	viper.SetDefault("two_factor.recovery_codes", 10)
+	viper.SetDefault("two_factor.skew", 1)
+
+	viper.SetDefault("oauth.redirect_url", "http://127.0.0.1:8080/api/oauth/{provider}/callback")
+	viper.SetDefault("oauth.state_minutes", 10)
+	viper.SetDefault("oauth.mock.enable", false)
+	viper.SetDefault("oauth.mock.issuer", "http://127.0.0.1:8080/api/oauth/mock")
+	viper.SetDefault("oauth.mock.client_id", "shortvideo")
+
+	viper.SetDefault("account.deletion_cooling_days", 15)
+	viper.SetDefault("account.export_expire_hours", 72)
+	viper.SetDefault("account.export_bucket", "shortvideo-exports")
+	viper.SetDefault("account.job_interval_minutes", 10)
+
+	viper.SetDefault("username.change_cooldown_days", 30)
+	viper.SetDefault("username.redirect_days", 14)
+	viper.SetDefault("username.reserved", []string{"admin", "administrator", "root", "system", "support", "official", "moderator", "security", "help", "api", "shortvideo"})
+
+	viper.SetDefault("rbac.admin_usernames", []string{})
+
+	viper.SetDefault("experience.level_thresholds", []int64{100, 300, 800, 1500, 3000, 6000})
+	viper.SetDefault("experience.low_level", 3)
+	viper.SetDefault("experience.sources", map[string]interface{}{
+		"login":         map[string]interface{}{"points": 5, "daily_cap": 5},
+		"watch":         map[string]interface{}{"points": 1, "daily_cap": 10},
+		"comment":       map[string]interface{}{"points": 2, "daily_cap": 20},
+		"publish":       map[string]interface{}{"points": 10, "daily_cap": 30},
+		"like_received": map[string]interface{}{"points": 1, "daily_cap": 50},
+	})
 
 	viper.SetDefault("prometheus.enable", true)
 	viper.SetDefault("prometheus.port", 9090)
@@ -62,16 +62,54 @@ func InitPostgres(postgresConfig config.PostgresConfig) (*gorm.DB, error) {
 	sqlDB.SetConnMaxLifetime(postgresConfig.ConnMaxLifetime)
 	log.Println("Database connected successfully")
 
+	//唯一索引建立前清理一次重复的点赞记录，保证用户对视频只有一个回应
+	if err := dedupeLikes(db); err != nil {
+		log.Printf("清理重复点赞记录失败: %v", err)
+		return nil, err
+	}
+
+	//唯一索引建立前清理一次重复的关注记录，保证唯一索引可以建立
+	if err := dedupeFollows(db); err != nil {
+		log.Printf("清理重复关注记录失败: %v", err)
+		return nil, err
+	}
+
 	//自动迁移数据库表
 	log.Println("开始自动迁移数据库表...")
 	err = db.AutoMigrate(
 		&user_model.User{},
+		&user_model.UserSession{},
+		&user_model.SecurityAuditLog{},
+		&user_model.UserTwoFactor{},
+		&user_model.UserRecoveryCode{},
+		&user_model.UserIdentity{},
+		&user_model.AccountDeletion{},
+		&user_model.DataExport{},
+		&user_model.UserRole{},
+		&user_model.ExperienceLog{},
+		&user_model.UserSettings{},
+		&user_model.UsernameHistory{},
 		&video_model.Video{},
+		&video_model.WatchHistory{},
+		&video_model.WatchHistorySetting{},
 		&social_model.Follow{},
+		&social_model.FollowRequest{},
+		&social_model.AudienceList{},
+		&social_model.AudienceListMember{},
+		&social_model.Block{},
 		&interaction_model.Comment{},
+		&interaction_model.CommentLike{},
+		&interaction_model.CommentSetting{},
+		&interaction_model.CommentKeyword{},
+		&interaction_model.StarFolder{},
+		&interaction_model.StarFolderItem{},
+		&interaction_model.StarFolderFollow{},
 		&interaction_model.Like{},
 		&interaction_model.Star{},
 		&interaction_model.Share{},
+		&interaction_model.ShareLink{},
+		&interaction_model.ShareEvent{},
+		&interaction_model.ShareAttribution{},
 		&interaction_model.VideoInteractionStats{},
 		&message_model.Message{},
 		&message_model.SystemNotification{},
@@ -86,6 +124,7 @@ func InitPostgres(postgresConfig config.PostgresConfig) (*gorm.DB, error) {
 		&recommend_model.UserAction{},
 		&recommend_model.VideoTag{},
 		&recommend_model.UserPreference{},
+		&recommend_model.UserFeedback{},
 	)
 	if err != nil {
 		log.Printf("数据库迁移失败: %v", err)
@@ -93,9 +132,67 @@ func InitPostgres(postgresConfig config.PostgresConfig) (*gorm.DB, error) {
 	}
 	log.Println("数据库迁移完成")
 
+	//楼中楼上线前的回复没有所属顶层评论，补齐后才能出现在评论列表中
+	if err := backfillCommentRoots(db); err != nil {
+		log.Printf("补齐评论所属顶层评论失败: %v", err)
+		return nil, err
+	}
+
 	return db, nil
 }
 
+// 删除同一用户对同一视频的重复点赞，只保留最早的一条，已有点赞迁移为like回应；
+// 唯一索引已存在说明已经清理过，不再执行
+func dedupeLikes(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&interaction_model.Like{}) ||
+		db.Migrator().HasIndex(&interaction_model.Like{}, "idx_like_user_video") {
+		return nil
+	}
+	return db.Exec(`DELETE FROM likes a USING likes b
+		WHERE a.user_id = b.user_id AND a.video_id = b.video_id AND a.id > b.id`).Error
+}
+
+// 删除同一用户对同一目标的重复关注，只保留最早的一条，计数由社交服务的修复任务重新计算；
+// 唯一索引已存在说明已经清理过，不再执行
+func dedupeFollows(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&social_model.Follow{}) ||
+		db.Migrator().HasIndex(&social_model.Follow{}, "idx_follow_user_target") {
+		return nil
+	}
+	return db.Exec(`DELETE FROM follows a USING follows b
+		WHERE a.user_id = b.user_id AND a.target_user_id = b.target_user_id AND a.id > b.id`).Error
+}
+
+// 沿回复链为root_id为0的旧回复找到顶层评论，并重新统计顶层评论的已发布回复数；
+// 没有需要补齐的回复时不执行，父评论已被删除的回复保持不变
+func backfillCommentRoots(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`WITH RECURSIVE chain(start_id, node_id, parent_id, root_id) AS (
+				SELECT id, id, reply_to_id, root_id FROM comments WHERE root_id = 0 AND reply_to_id > 0
+				UNION ALL
+				SELECT chain.start_id, c.id, c.reply_to_id, c.root_id
+				FROM chain JOIN comments c ON c.id = chain.parent_id
+				WHERE chain.root_id = 0 AND chain.parent_id > 0
+			)
+			UPDATE comments SET root_id = resolved.root_id
+			FROM (
+				SELECT start_id, CASE WHEN root_id > 0 THEN root_id ELSE node_id END AS root_id
+				FROM chain
+				WHERE node_id <> start_id AND (root_id > 0 OR parent_id = 0)
+			) AS resolved
+			WHERE comments.id = resolved.start_id`)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		log.Printf("已补齐%d条回复的顶层评论", result.RowsAffected)
+
+		return tx.Exec(`UPDATE comments SET reply_count = (
+				SELECT COUNT(*) FROM comments r WHERE r.root_id = comments.id AND r.status = ?
+			)
+			WHERE root_id = 0 AND reply_to_id = 0`, interaction_model.CommentStatusPublished).Error
+	})
+}
+
 func GetDB() *gorm.DB {
 	if db == nil {
 		panic("database not initialized")
@@ -49,6 +49,8 @@ type SearchQuery struct {
 	From  int                      `json:"from,omitempty"`
 	Size  int                      `json:"size,omitempty"`
 	Sort  []map[string]interface{} `json:"sort,omitempty"`
+	//按上一页最后一条的排序值继续查询，代替From做深分页
+	SearchAfter []interface{} `json:"search_after,omitempty"`
 }
 
 type SearchResult struct {
@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -10,35 +12,95 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// 签发时间精确到毫秒，吊销检查可以区分同一秒内吊销前后签发的令牌
+func init() {
+	jwt.TimePrecision = time.Millisecond
+}
+
+// 令牌类型
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// 令牌声明，ID(jti)唯一标识一个令牌，SessionID标识一次登录，同一会话中轮换出的令牌属于同一家族
+// Roles为签发时用户拥有的角色，角色变更后在下次刷新令牌时更新
 type Claims struct {
-	UserID int64 `json:"user_id"`
+	UserID    int64    `json:"user_id"`
+	SessionID string   `json:"sid"`
+	TokenType string   `json:"typ"`
+	Roles     []string `json:"roles,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// 一次签发的访问令牌和刷新令牌
+type TokenPair struct {
+	AccessToken   string
+	RefreshToken  string
+	AccessClaims  *Claims
+	RefreshClaims *Claims
+}
+
 type JWTManager struct {
-	secretKey   string
-	expireHours int
+	secretKey     string
+	accessExpire  time.Duration
+	refreshExpire time.Duration
 }
 
 func NewJWTManager() *JWTManager {
 	jwtConfig := config.Get().JWT
-	return NewJWTManagerWithConfig(jwtConfig.Secret, jwtConfig.ExpireHours)
+	return NewJWTManagerWithConfig(jwtConfig.Secret, jwtConfig.AccessExpireMinutes, jwtConfig.RefreshExpireHours)
 }
 
-func NewJWTManagerWithConfig(secretKey string, expireHours int) *JWTManager {
+func NewJWTManagerWithConfig(secretKey string, accessExpireMinutes, refreshExpireHours int) *JWTManager {
 	return &JWTManager{
-		secretKey:   secretKey,
-		expireHours: expireHours,
+		secretKey:     secretKey,
+		accessExpire:  time.Minute * time.Duration(accessExpireMinutes),
+		refreshExpire: time.Hour * time.Duration(refreshExpireHours),
+	}
+}
+
+// 刷新令牌有效期，会话相关的缓存按此设置过期时间
+func (j *JWTManager) RefreshExpire() time.Duration {
+	return j.refreshExpire
+}
+
+// 生成新的会话ID
+func NewSessionID() string {
+	return randomID()
+}
+
+// 为会话签发一对访问令牌和刷新令牌
+func (j *JWTManager) GenerateTokenPair(userID int64, sessionID string, roles []string) (*TokenPair, error) {
+	accessToken, accessClaims, err := j.generate(userID, sessionID, TokenTypeAccess, roles, j.accessExpire)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshClaims, err := j.generate(userID, sessionID, TokenTypeRefresh, nil, j.refreshExpire)
+	if err != nil {
+		return nil, err
 	}
+
+	return &TokenPair{
+		AccessToken:   accessToken,
+		RefreshToken:  refreshToken,
+		AccessClaims:  accessClaims,
+		RefreshClaims: refreshClaims,
+	}, nil
 }
 
-func (j *JWTManager) GenerateToken(userID int64) (string, error) {
-	expireTime := time.Now().Add(time.Hour * time.Duration(j.expireHours))
+func (j *JWTManager) generate(userID int64, sessionID, tokenType string, roles []string, expire time.Duration) (string, *Claims, error) {
+	now := time.Now()
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenType: tokenType,
+		Roles:     roles,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expireTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        randomID(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expire)),
+			IssuedAt:  jwt.NewNumericDate(now),
 			Subject:   fmt.Sprintf("%d", userID),
 		},
 	}
@@ -46,10 +108,10 @@ func (j *JWTManager) GenerateToken(userID int64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString([]byte(j.secretKey))
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	return tokenString, nil
+	return tokenString, claims, nil
 }
 
 func (j *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
@@ -73,11 +135,41 @@ func (j *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
-func (j *JWTManager) GetUserIDFromToken(tokenString string) (int64, error) {
+// 校验访问令牌，刷新令牌不能用于访问接口
+func (j *JWTManager) ValidateAccessToken(tokenString string) (*Claims, error) {
+	return j.validateType(tokenString, TokenTypeAccess)
+}
+
+// 校验刷新令牌
+func (j *JWTManager) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	return j.validateType(tokenString, TokenTypeRefresh)
+}
+
+func (j *JWTManager) validateType(tokenString, tokenType string) (*Claims, error) {
 	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != tokenType || claims.ID == "" || claims.SessionID == "" {
+		return nil, errors.New("令牌类型不正确")
+	}
+	return claims, nil
+}
+
+func (j *JWTManager) GetUserIDFromToken(tokenString string) (int64, error) {
+	claims, err := j.ValidateAccessToken(tokenString)
 	if err != nil {
 		return 0, err
 	}
 
 	return claims.UserID, nil
 }
+
+func randomID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		//crypto/rand不可用时退化为时间戳，仍能保证同一进程内不重复
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,154 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"shortvideo/pkg/config"
+)
+
+// 用户事件类型
+const (
+	EventTypeUserDeleted  = "user_deleted"
+	EventTypeUserActivity = "user_activity"
+)
+
+// 可获得经验值的用户行为
+const (
+	ActivityLogin        = "login"
+	ActivityWatch        = "watch"
+	ActivityComment      = "comment"
+	ActivityPublish      = "publish"
+	ActivityLikeReceived = "like_received"
+)
+
+// 处理失败时的重试间隔，每次失败后翻倍，最长不超过userEventMaxRetryDelay
+const (
+	userEventRetryDelay    = 5 * time.Second
+	userEventMaxRetryDelay = 5 * time.Minute
+)
+
+// UserDeletedEvent 账号注销完成后发送到用户主题，各服务收到后匿名化或删除该用户的数据
+type UserDeletedEvent struct {
+	Type      string    `json:"type"`
+	UserID    int64     `json:"user_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+func (p *Producer) SendUserDeletedEvent(ctx context.Context, userID int64) error {
+	data, err := json.Marshal(&UserDeletedEvent{
+		Type:      EventTypeUserDeleted,
+		UserID:    userID,
+		DeletedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return p.SendUserEvent(ctx, fmt.Sprintf("%d", userID), data)
+}
+
+// 消费用户主题中的注销事件，每个服务使用独立的消费组，handle需保证重复执行结果一致
+func ConsumeUserDeleted(ctx context.Context, groupID string, handle func(ctx context.Context, userID int64) error) {
+	consumeUserEvents(ctx, groupID, EventTypeUserDeleted, func(ctx context.Context, data []byte) error {
+		var event UserDeletedEvent
+		if err := json.Unmarshal(data, &event); err != nil || event.UserID <= 0 {
+			return nil
+		}
+		if err := handle(ctx, event.UserID); err != nil {
+			return err
+		}
+		log.Printf("消费组 %s 已清理注销用户 %d 的数据", groupID, event.UserID)
+		return nil
+	})
+}
+
+// UserActivityEvent 用户产生可获得经验值的行为时发送到用户主题，由用户服务发放经验值，
+// RefID为行为关联的对象，同一对象同一天只计算一次
+type UserActivityEvent struct {
+	Type       string    `json:"type"`
+	UserID     int64     `json:"user_id"`
+	Source     string    `json:"source"`
+	RefID      string    `json:"ref_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (p *Producer) SendUserActivityEvent(ctx context.Context, userID int64, source, refID string) error {
+	data, err := json.Marshal(&UserActivityEvent{
+		Type:       EventTypeUserActivity,
+		UserID:     userID,
+		Source:     source,
+		RefID:      refID,
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return p.SendUserEvent(ctx, fmt.Sprintf("%d", userID), data)
+}
+
+// 消费用户主题中的行为事件，handle需保证重复执行结果一致
+func ConsumeUserActivity(ctx context.Context, groupID string, handle func(ctx context.Context, event *UserActivityEvent) error) {
+	consumeUserEvents(ctx, groupID, EventTypeUserActivity, func(ctx context.Context, data []byte) error {
+		var event UserActivityEvent
+		if err := json.Unmarshal(data, &event); err != nil || event.UserID <= 0 || event.Source == "" {
+			return nil
+		}
+		return handle(ctx, &event)
+	})
+}
+
+// 消费用户主题中指定类型的事件。处理成功后才提交位移，失败时退避重试直到成功，
+// 不会跳过事件；服务在重试期间重启时，未提交的事件会重新投递
+func consumeUserEvents(ctx context.Context, groupID, eventType string, handle func(ctx context.Context, data []byte) error) {
+	consumer := NewConsumer(config.Get().Kafka.Topics.User, groupID)
+	defer consumer.Close()
+
+	for {
+		msg, err := consumer.Fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("消费用户事件失败: %v", err)
+			time.Sleep(userEventRetryDelay)
+			continue
+		}
+
+		var header struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(msg.Value, &header); err == nil && header.Type == eventType {
+			delay := userEventRetryDelay
+			for attempt := 1; ; attempt++ {
+				err = handle(ctx, msg.Value)
+				if err == nil {
+					break
+				}
+				log.Printf("消费组 %s 处理%s事件失败(第%d次)，%v后重试: %v", groupID, eventType, attempt, delay, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(delay):
+				}
+				if delay *= 2; delay > userEventMaxRetryDelay {
+					delay = userEventMaxRetryDelay
+				}
+			}
+		}
+
+		for {
+			err := consumer.Commit(ctx, msg)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("消费组 %s 提交用户事件位移失败: %v", groupID, err)
+			time.Sleep(userEventRetryDelay)
+		}
+	}
+}
@@ -27,6 +27,7 @@ type Message struct {
 	Partition int
 	Offset    int64
 	Time      time.Time
+	raw       kafka.Message
 }
 
 var (
@@ -145,6 +146,32 @@ func (c *Consumer) Receive(ctx context.Context) (*Message, error) {
 	}, nil
 }
 
+// 读取消息但不提交位移，处理完成后需调用Commit，未提交的消息在消费者重启后会重新投递
+func (c *Consumer) Fetch(ctx context.Context) (*Message, error) {
+	msg, err := c.reader.FetchMessage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("读取消息失败: %w", err)
+	}
+
+	return &Message{
+		Topic:     msg.Topic,
+		Key:       string(msg.Key),
+		Value:     msg.Value,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Time:      msg.Time,
+		raw:       msg,
+	}, nil
+}
+
+// 提交Fetch读取的消息的位移
+func (c *Consumer) Commit(ctx context.Context, msg *Message) error {
+	if err := c.reader.CommitMessages(ctx, msg.raw); err != nil {
+		return fmt.Errorf("提交位移失败: %w", err)
+	}
+	return nil
+}
+
 func (p *Producer) Close() error {
 	return p.writer.Close()
 }
@@ -0,0 +1,169 @@
+package pagination
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"shortvideo/pkg/cache"
+	"shortvideo/pkg/config"
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidCursor = errors.New("无效的分页游标")
+	ErrCursorExpired = errors.New("分页游标已过期，请从第一页重新获取")
+)
+
+// Cursor 游标内容，记录上一页最后一条记录的排序键与ID，以及游标所属的列表和过期时间；
+// 按缓存快照排序的列表在Version中记录快照版本
+type Cursor struct {
+	SortKey   int64  `json:"k"`
+	ID        int64  `json:"i"`
+	Version   int64  `json:"v,omitempty"`
+	List      string `json:"l"`
+	Owner     int64  `json:"o"`
+	ExpiresAt int64  `json:"e"`
+}
+
+// Scope 游标所属的列表，List为列表名，Owner为列表所属的用户或对象ID，
+// 游标只能在签发它的列表中使用
+type Scope struct {
+	List  string
+	Owner int64
+}
+
+var (
+	cursorSecret []byte
+	cursorTTL    time.Duration
+	totalTTL     time.Duration
+	secretOnce   sync.Once
+)
+
+func loadConfig() {
+	secretOnce.Do(func() {
+		paginationConfig := config.Get().Pagination
+		cursorSecret = []byte(paginationConfig.CursorSecret)
+		cursorTTL = time.Duration(paginationConfig.CursorExpireMinutes) * time.Minute
+		totalTTL = time.Duration(paginationConfig.TotalCacheSeconds) * time.Second
+	})
+}
+
+// 编码游标，格式为 base64(payload).base64(签名)；游标绑定到scope并带有过期时间
+func Encode(cursor *Cursor, scope Scope) string {
+	if cursor == nil {
+		return ""
+	}
+	loadConfig()
+
+	signed := *cursor
+	signed.List = scope.List
+	signed.Owner = scope.Owner
+	signed.ExpiresAt = time.Now().Add(cursorTTL).Unix()
+
+	payload, _ := json.Marshal(&signed)
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(sign(encodedPayload))
+}
+
+// 解码并校验游标，空字符串表示从第一页开始；签名不符或不属于scope的游标返回ErrInvalidCursor
+func Decode(token string, scope Scope) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	loadConfig()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, sign(parts[0])) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.List != scope.List || cursor.Owner != scope.Owner {
+		return nil, ErrInvalidCursor
+	}
+	if time.Now().Unix() > cursor.ExpiresAt {
+		return nil, ErrCursorExpired
+	}
+	return &cursor, nil
+}
+
+func sign(payload string) []byte {
+	mac := hmac.New(sha256.New, cursorSecret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// 规范化每页数量
+func NormalizePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return pageSize
+}
+
+// 截取一页数据，DAO需多查询一条用于判断是否还有下一页
+func Paginate[T any](items []T, pageSize int, scope Scope, keyFn func(T) Cursor) ([]T, string) {
+	if len(items) <= pageSize {
+		return items, ""
+	}
+	items = items[:pageSize]
+	last := keyFn(items[len(items)-1])
+	return items, Encode(&last, scope)
+}
+
+// 获取总数，结果在缓存中保留一段时间，cache为空时直接查询
+func CachedTotal(ctx context.Context, c cache.Cache, key string, countFn func() (int64, error)) (int64, error) {
+	loadConfig()
+
+	if c != nil {
+		if cached, err := c.Get(ctx, key); err == nil {
+			if total, err := strconv.ParseInt(cached, 10, 64); err == nil {
+				return total, nil
+			}
+		}
+	}
+
+	total, err := countFn()
+	if err != nil {
+		return 0, err
+	}
+
+	if c != nil && totalTTL > 0 {
+		_ = c.Set(ctx, key, total, totalTTL)
+	}
+	return total, nil
+}
+
+// 生成总数缓存键
+func TotalKey(scope string, id int64) string {
+	return fmt.Sprintf("total:%s:%d", scope, id)
+}
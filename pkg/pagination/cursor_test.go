@@ -0,0 +1,200 @@
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 测试不读取配置文件，直接设置签名密钥和有效期
+func setupTestConfig(t *testing.T, ttl time.Duration) {
+	t.Helper()
+	secretOnce.Do(func() {})
+	cursorSecret = []byte("test-secret")
+	cursorTTL = ttl
+	totalTTL = 0
+}
+
+// 修改游标内容后保留原签名
+func tamper(t *testing.T, token string, modify func(*Cursor)) string {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	modify(&cursor)
+	payload, _ = json.Marshal(&cursor)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + parts[1]
+}
+
+func TestEncodeDecode(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	scope := Scope{List: "following", Owner: 42}
+
+	token := Encode(&Cursor{SortKey: 1700000000000000, ID: 7, Version: 3}, scope)
+	cursor, err := Decode(token, scope)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if cursor.SortKey != 1700000000000000 || cursor.ID != 7 || cursor.Version != 3 {
+		t.Errorf("Decode() = %+v", cursor)
+	}
+	if cursor.List != scope.List || cursor.Owner != scope.Owner {
+		t.Errorf("cursor scope = (%s, %d), want (%s, %d)", cursor.List, cursor.Owner, scope.List, scope.Owner)
+	}
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	cursor, err := Decode("", Scope{List: "following"})
+	if cursor != nil || err != nil {
+		t.Errorf("Decode(\"\") = (%v, %v), want (nil, nil)", cursor, err)
+	}
+	if token := Encode(nil, Scope{List: "following"}); token != "" {
+		t.Errorf("Encode(nil) = %q, want empty", token)
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	scope := Scope{List: "following", Owner: 42}
+	token := Encode(&Cursor{SortKey: 100, ID: 7}, scope)
+
+	tests := []struct {
+		name    string
+		token   string
+		scope   Scope
+		wantErr error
+	}{
+		{"缺少签名", strings.Split(token, ".")[0], scope, ErrInvalidCursor},
+		{"签名不是Base64", strings.Split(token, ".")[0] + ".!!!", scope, ErrInvalidCursor},
+		{"篡改ID", tamper(t, token, func(c *Cursor) { c.ID = 8 }), scope, ErrInvalidCursor},
+		{"篡改过期时间", tamper(t, token, func(c *Cursor) { c.ExpiresAt += 3600 }), scope, ErrInvalidCursor},
+		{"篡改所属列表", tamper(t, token, func(c *Cursor) { c.List = "followers" }), Scope{List: "followers", Owner: 42}, ErrInvalidCursor},
+		{"用于其他列表", token, Scope{List: "followers", Owner: 42}, ErrInvalidCursor},
+		{"用于其他用户", token, Scope{List: "following", Owner: 43}, ErrInvalidCursor},
+		{"多余的分段", token + ".x", scope, ErrInvalidCursor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := Decode(tt.token, tt.scope)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Decode() = (%v, %v), want error %v", cursor, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeOtherSecret(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	scope := Scope{List: "following", Owner: 42}
+	token := Encode(&Cursor{SortKey: 100, ID: 7}, scope)
+
+	cursorSecret = []byte("rotated-secret")
+	if _, err := Decode(token, scope); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Decode() error = %v, want %v", err, ErrInvalidCursor)
+	}
+}
+
+func TestDecodeExpired(t *testing.T) {
+	setupTestConfig(t, -time.Minute)
+	scope := Scope{List: "following", Owner: 42}
+	token := Encode(&Cursor{SortKey: 100, ID: 7}, scope)
+
+	cursorTTL = time.Hour
+	if _, err := Decode(token, scope); !errors.Is(err, ErrCursorExpired) {
+		t.Errorf("Decode() error = %v, want %v", err, ErrCursorExpired)
+	}
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		in   int
+		want int
+	}{
+		{-1, DefaultPageSize},
+		{0, DefaultPageSize},
+		{1, 1},
+		{MaxPageSize, MaxPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	}
+	for _, tt := range tests {
+		if got := NormalizePageSize(tt.in); got != tt.want {
+			t.Errorf("NormalizePageSize(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	scope := Scope{List: "numbers"}
+	keyFn := func(n int64) Cursor { return Cursor{SortKey: n * 10, ID: n} }
+
+	tests := []struct {
+		name      string
+		items     []int64
+		pageSize  int
+		wantItems int
+		wantLast  int64
+	}{
+		{"空列表", nil, 2, 0, 0},
+		{"不足一页", []int64{1}, 2, 1, 0},
+		{"正好一页", []int64{1, 2}, 2, 2, 0},
+		{"多查询的一条表示还有下一页", []int64{1, 2, 3}, 2, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, next := Paginate(tt.items, tt.pageSize, scope, keyFn)
+			if len(items) != tt.wantItems {
+				t.Errorf("len(items) = %d, want %d", len(items), tt.wantItems)
+			}
+			if tt.wantLast == 0 {
+				if next != "" {
+					t.Errorf("next = %q, want empty", next)
+				}
+				return
+			}
+			cursor, err := Decode(next, scope)
+			if err != nil {
+				t.Fatalf("Decode(next) error = %v", err)
+			}
+			if cursor.ID != tt.wantLast || cursor.SortKey != tt.wantLast*10 {
+				t.Errorf("next cursor = %+v, want ID %d", cursor, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestTotalKey(t *testing.T) {
+	if got := TotalKey("followers", 42); got != "total:followers:42" {
+		t.Errorf("TotalKey() = %q", got)
+	}
+}
+
+func TestCachedTotalWithoutCache(t *testing.T) {
+	setupTestConfig(t, time.Hour)
+	calls := 0
+	countFn := func() (int64, error) {
+		calls++
+		return 5, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		total, err := CachedTotal(context.Background(), nil, TotalKey("followers", 42), countFn)
+		if err != nil || total != 5 {
+			t.Fatalf("CachedTotal() = (%d, %v), want (5, nil)", total, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("countFn calls = %d, want 2", calls)
+	}
+}
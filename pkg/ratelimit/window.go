@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"shortvideo/pkg/cache"
+)
+
+// SlidingWindow 基于Redis有序集合的滑动窗口计数器，每次记录一个事件，统计最近window内的事件数
+type SlidingWindow struct {
+	cache  cache.Cache
+	window time.Duration
+}
+
+func NewSlidingWindow(c cache.Cache, window time.Duration) *SlidingWindow {
+	return &SlidingWindow{cache: c, window: window}
+}
+
+// 窗口长度
+func (w *SlidingWindow) Window() time.Duration {
+	return w.window
+}
+
+// 记录一次事件，返回包含本次在内的窗口内事件数
+func (w *SlidingWindow) Hit(ctx context.Context, key string) (int64, error) {
+	now := time.Now()
+	return w.cache.ZAddWindow(ctx, key, eventID(now), now, w.window)
+}
+
+// 窗口内的事件数，不记录新事件
+func (w *SlidingWindow) Count(ctx context.Context, key string) (int64, error) {
+	min := strconv.FormatInt(time.Now().Add(-w.window).UnixNano(), 10)
+	return w.cache.ZCount(ctx, key, "("+min, "+inf")
+}
+
+// 清空计数
+func (w *SlidingWindow) Reset(ctx context.Context, key string) error {
+	return w.cache.Delete(ctx, key)
+}
+
+// 同一纳秒内可能有多个事件，成员名加随机后缀避免互相覆盖
+func eventID(now time.Time) string {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return strconv.FormatInt(now.UnixNano(), 10)
+	}
+	return fmt.Sprintf("%d-%s", now.UnixNano(), hex.EncodeToString(buf))
+}
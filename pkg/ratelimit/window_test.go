@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"shortvideo/pkg/cache"
+)
+
+// 内存中的有序集合，只实现滑动窗口用到的操作，语义与RedisCache一致
+type fakeCache struct {
+	cache.Cache
+	sets map[string]map[string]int64
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{sets: make(map[string]map[string]int64)}
+}
+
+func (f *fakeCache) ZAddWindow(ctx context.Context, key string, member string, now time.Time, window time.Duration) (int64, error) {
+	set := f.sets[key]
+	if set == nil {
+		set = make(map[string]int64)
+		f.sets[key] = set
+	}
+	min := now.Add(-window).UnixNano()
+	for m, score := range set {
+		if score <= min {
+			delete(set, m)
+		}
+	}
+	set[member] = now.UnixNano()
+	return int64(len(set)), nil
+}
+
+// 只支持"(min"到"+inf"的区间
+func (f *fakeCache) ZCount(ctx context.Context, key string, min, max string) (int64, error) {
+	lower, err := strconv.ParseInt(strings.TrimPrefix(min, "("), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for _, score := range f.sets[key] {
+		if score > lower {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (f *fakeCache) Delete(ctx context.Context, key string) error {
+	delete(f.sets, key)
+	return nil
+}
+
+func TestSlidingWindowHit(t *testing.T) {
+	ctx := context.Background()
+	window := NewSlidingWindow(newFakeCache(), time.Minute)
+
+	tests := []struct {
+		key  string
+		want int64
+	}{
+		{"login:alice", 1},
+		{"login:alice", 2},
+		{"login:bob", 1},
+		{"login:alice", 3},
+	}
+	for _, tt := range tests {
+		got, err := window.Hit(ctx, tt.key)
+		if err != nil {
+			t.Fatalf("Hit(%s) error = %v", tt.key, err)
+		}
+		if got != tt.want {
+			t.Errorf("Hit(%s) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestSlidingWindowCount(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		scores []time.Time
+		want   int64
+	}{
+		{"没有事件", nil, 0},
+		{"窗口内", []time.Time{now.Add(-time.Second), now.Add(-30 * time.Second)}, 2},
+		{"窗口外的不计入", []time.Time{now.Add(-2 * time.Minute), now.Add(-time.Second)}, 1},
+		{"全部过期", []time.Time{now.Add(-time.Hour)}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeCache()
+			fake.sets["key"] = make(map[string]int64)
+			for _, at := range tt.scores {
+				fake.sets["key"][eventID(at)] = at.UnixNano()
+			}
+
+			window := NewSlidingWindow(fake, time.Minute)
+			got, err := window.Count(ctx, "key")
+			if err != nil {
+				t.Fatalf("Count() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Count() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlidingWindowReset(t *testing.T) {
+	ctx := context.Background()
+	window := NewSlidingWindow(newFakeCache(), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		window.Hit(ctx, "key")
+	}
+	if err := window.Reset(ctx, "key"); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if got, _ := window.Count(ctx, "key"); got != 0 {
+		t.Errorf("Count() after Reset = %d, want 0", got)
+	}
+	if got := window.Window(); got != time.Minute {
+		t.Errorf("Window() = %v, want %v", got, time.Minute)
+	}
+}
+
+func TestEventID(t *testing.T) {
+	now := time.Now()
+	first, second := eventID(now), eventID(now)
+	if first == second {
+		t.Errorf("eventID() returned duplicate %s for the same instant", first)
+	}
+	pattern := regexp.MustCompile(`^` + strconv.FormatInt(now.UnixNano(), 10) + `-[0-9a-f]{8}$`)
+	if !pattern.MatchString(first) {
+		t.Errorf("eventID() = %s, want <unixnano>-<8 hex>", first)
+	}
+}
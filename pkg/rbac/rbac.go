@@ -0,0 +1,127 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// 内置角色，所有用户都拥有user角色，其余角色需要单独授予
+const (
+	RoleUser      = "user"
+	RoleCreator   = "creator"
+	RoleVerified  = "verified"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// 权限，命名为 资源.操作[.范围]，any表示可以操作他人的内容
+const (
+	PermVideoDeleteAny   = "video.delete.any"
+	PermCommentDeleteAny = "comment.delete.any"
+	PermCommentReview    = "comment.review"
+	PermDanmuManageAny   = "danmu.manage.any"
+	PermLiveStopAny      = "live.stop.any"
+	PermMessageReview    = "message.review"
+	PermUserBan          = "user.ban"
+	PermUsernameHistory  = "username.history"
+	PermRoleAssign       = "role.assign"
+)
+
+var ErrPermissionDenied = errors.New("没有操作权限")
+
+// 各角色拥有的权限；creator和verified目前只作为身份标识，不附带额外权限
+var rolePermissions = map[string][]string{
+	RoleUser:     {},
+	RoleCreator:  {},
+	RoleVerified: {},
+	RoleModerator: {
+		PermVideoDeleteAny,
+		PermCommentDeleteAny,
+		PermCommentReview,
+		PermDanmuManageAny,
+		PermLiveStopAny,
+		PermMessageReview,
+		PermUserBan,
+		PermUsernameHistory,
+	},
+	RoleAdmin: {
+		PermVideoDeleteAny,
+		PermCommentDeleteAny,
+		PermCommentReview,
+		PermDanmuManageAny,
+		PermLiveStopAny,
+		PermMessageReview,
+		PermUserBan,
+		PermUsernameHistory,
+		PermRoleAssign,
+	},
+}
+
+// 是否为内置角色
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// 角色集合是否拥有指定权限
+func Has(roles []string, permission string) bool {
+	for _, role := range roles {
+		for _, p := range rolePermissions[role] {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 角色集合拥有的全部权限，去重后按角色顺序返回
+func Permissions(roles []string) []string {
+	seen := make(map[string]bool)
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, p := range rolePermissions[role] {
+			if !seen[p] {
+				seen[p] = true
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	return permissions
+}
+
+// 角色通过Kitex元信息传递给下游服务，只传递一跳，下游服务再调用其他服务时不会继续携带
+const rolesMetaKey = "USER_ROLES"
+
+// 把当前用户的角色写入上下文，网关鉴权通过后调用，之后发出的RPC请求都会携带角色
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	if len(roles) == 0 {
+		return ctx
+	}
+	return metainfo.WithValue(ctx, rolesMetaKey, strings.Join(roles, ","))
+}
+
+// 从上下文中读取当前用户的角色，没有角色信息时返回nil
+func RolesFromContext(ctx context.Context) []string {
+	value, ok := metainfo.GetValue(ctx, rolesMetaKey)
+	if !ok || value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// 当前用户是否拥有指定权限，供Kitex服务在处理请求时判断
+func Allowed(ctx context.Context, permission string) bool {
+	return Has(RolesFromContext(ctx), permission)
+}
+
+// 当前用户没有指定权限时返回ErrPermissionDenied
+func Require(ctx context.Context, permission string) error {
+	if !Allowed(ctx, permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHas(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []string
+		permission string
+		want       bool
+	}{
+		{"没有角色", nil, PermVideoDeleteAny, false},
+		{"普通用户", []string{RoleUser}, PermCommentDeleteAny, false},
+		{"创作者没有管理权限", []string{RoleUser, RoleCreator}, PermVideoDeleteAny, false},
+		{"认证用户没有管理权限", []string{RoleVerified}, PermUserBan, false},
+		{"审核员删除他人视频", []string{RoleUser, RoleModerator}, PermVideoDeleteAny, true},
+		{"审核员审核评论", []string{RoleModerator}, PermCommentReview, true},
+		{"审核员审核私信", []string{RoleModerator}, PermMessageReview, true},
+		{"审核员封禁用户", []string{RoleModerator}, PermUserBan, true},
+		{"审核员不能分配角色", []string{RoleModerator}, PermRoleAssign, false},
+		{"管理员分配角色", []string{RoleAdmin}, PermRoleAssign, true},
+		{"管理员查看用户名历史", []string{RoleAdmin}, PermUsernameHistory, true},
+		{"未知角色", []string{"superuser"}, PermRoleAssign, false},
+		{"未知权限", []string{RoleAdmin}, "video.delete.all", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Has(tt.roles, tt.permission); got != tt.want {
+				t.Errorf("Has(%v, %s) = %v, want %v", tt.roles, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+// 管理员拥有审核员的全部权限
+func TestAdminIncludesModerator(t *testing.T) {
+	for _, p := range rolePermissions[RoleModerator] {
+		if !Has([]string{RoleAdmin}, p) {
+			t.Errorf("admin missing moderator permission %s", p)
+		}
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []string
+		want  []string
+	}{
+		{"没有角色", nil, []string{}},
+		{"普通用户", []string{RoleUser, RoleCreator}, []string{}},
+		{"审核员", []string{RoleModerator}, rolePermissions[RoleModerator]},
+		{"重复权限去重", []string{RoleModerator, RoleAdmin}, append(append([]string{}, rolePermissions[RoleModerator]...), PermRoleAssign)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Permissions(tt.roles); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permissions(%v) = %v, want %v", tt.roles, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidRole(t *testing.T) {
+	tests := []struct {
+		role string
+		want bool
+	}{
+		{RoleUser, true},
+		{RoleCreator, true},
+		{RoleVerified, true},
+		{RoleModerator, true},
+		{RoleAdmin, true},
+		{"", false},
+		{"Admin", false},
+		{"superuser", false},
+	}
+	for _, tt := range tests {
+		if got := ValidRole(tt.role); got != tt.want {
+			t.Errorf("ValidRole(%q) = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []string
+		permission string
+		wantErr    error
+	}{
+		{"上下文没有角色", nil, PermUserBan, ErrPermissionDenied},
+		{"普通用户", []string{RoleUser}, PermUserBan, ErrPermissionDenied},
+		{"审核员", []string{RoleUser, RoleModerator}, PermUserBan, nil},
+		{"审核员不能分配角色", []string{RoleUser, RoleModerator}, PermRoleAssign, ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithRoles(context.Background(), tt.roles)
+			if got := RolesFromContext(ctx); !reflect.DeepEqual(got, tt.roles) {
+				t.Errorf("RolesFromContext() = %v, want %v", got, tt.roles)
+			}
+			if err := Require(ctx, tt.permission); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Require() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
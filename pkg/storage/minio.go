@@ -36,6 +36,9 @@ type Storage interface {
 	//创建桶
 	CreateBucket(ctx context.Context, bucket string) error
 
+	//创建私有桶，对象只能通过预签名URL访问
+	CreatePrivateBucket(ctx context.Context, bucket string) error
+
 	//关闭连接
 	Close() error
 }
@@ -232,6 +235,22 @@ func (s *MinioStorage) CreateBucket(ctx context.Context, bucket string) error {
 	return nil
 }
 
+func (s *MinioStorage) CreatePrivateBucket(ctx context.Context, bucket string) error {
+	exists, err := s.client.BucketExists(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	if err := s.client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+		return err
+	}
+	log.Printf("MinIO私有桶创建成功: %s", bucket)
+	return nil
+}
+
 func (s *MinioStorage) Close() error {
 	return nil
 }
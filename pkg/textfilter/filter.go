@@ -0,0 +1,303 @@
+package textfilter
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"shortvideo/pkg/config"
+	"shortvideo/pkg/logger"
+)
+
+// Action 命中敏感词后的处理方式
+type Action string
+
+const (
+	ActionPass   Action = "pass"
+	ActionMask   Action = "mask"
+	ActionReview Action = "review"
+	ActionReject Action = "reject"
+)
+
+// 默认分类，词库中未写分类的词条归入该分类
+const DefaultCategory = "default"
+
+var (
+	ErrWordFileNotFound = errors.New("敏感词库文件不存在")
+)
+
+// 处理方式的严重程度，多个分类同时命中时取最严重的
+var actionLevel = map[Action]int{
+	ActionPass:   0,
+	ActionMask:   1,
+	ActionReview: 2,
+	ActionReject: 3,
+}
+
+// Word 词条
+type Word struct {
+	Text     string
+	Category string
+}
+
+// Match 一次命中，Start/End 为原文中的字符下标（包含两端）
+type Match struct {
+	Word     string
+	Category string
+	Start    int
+	End      int
+}
+
+// Result 过滤结果，Text 为按分类规则打码后的文本
+type Result struct {
+	Action  Action
+	Text    string
+	Matches []Match
+}
+
+// Filter 敏感词过滤器，创建后只读，可并发使用
+type Filter struct {
+	words         []Word
+	matcher       *matcher
+	actions       map[string]Action
+	defaultAction Action
+}
+
+// 创建过滤器，actions 为分类到处理方式的映射，未配置的分类使用 defaultAction
+func New(words []Word, actions map[string]Action, defaultAction Action) *Filter {
+	if _, ok := actionLevel[defaultAction]; !ok {
+		defaultAction = ActionMask
+	}
+
+	normalized := make([][]rune, len(words))
+	for i, w := range words {
+		normalized[i] = normalizeWord(w.Text)
+	}
+
+	return &Filter{
+		words:         words,
+		matcher:       newMatcher(normalized),
+		actions:       actions,
+		defaultAction: defaultAction,
+	}
+}
+
+func (f *Filter) actionOf(category string) Action {
+	if action, ok := f.actions[category]; ok {
+		return action
+	}
+	return f.defaultAction
+}
+
+// 检查文本，未命中时返回 ActionPass 和原文
+func (f *Filter) Check(text string) *Result {
+	result := &Result{Action: ActionPass, Text: text}
+	if f == nil || text == "" {
+		return result
+	}
+
+	original := []rune(text)
+	folded, positions := normalize(original)
+	hits := f.matcher.find(folded)
+	if len(hits) == 0 {
+		return result
+	}
+
+	masked := false
+	for _, h := range hits {
+		word := f.words[h.word]
+		length := len(normalizeWord(word.Text))
+		match := Match{
+			Word:     word.Text,
+			Category: word.Category,
+			Start:    positions[h.end-length+1],
+			End:      positions[h.end],
+		}
+		result.Matches = append(result.Matches, match)
+
+		action := f.actionOf(word.Category)
+		if actionLevel[action] > actionLevel[result.Action] {
+			result.Action = action
+		}
+		if action == ActionMask {
+			//只替换有效字符，保留用于规避的符号
+			for i := match.Start; i <= match.End; i++ {
+				if isMeaningful(foldRune(original[i])) {
+					original[i] = '*'
+				}
+			}
+			masked = true
+		}
+	}
+
+	if masked {
+		result.Text = string(original)
+	}
+	return result
+}
+
+// MaskAll 返回所有命中词都打码后的文本，送审内容在审核通过前按此保存
+func (r *Result) MaskAll() string {
+	if len(r.Matches) == 0 {
+		return r.Text
+	}
+	masked := []rune(r.Text)
+	for _, match := range r.Matches {
+		for i := match.Start; i <= match.End && i < len(masked); i++ {
+			if isMeaningful(foldRune(masked[i])) {
+				masked[i] = '*'
+			}
+		}
+	}
+	return string(masked)
+}
+
+// 解析词库，每行一个词条，格式为"分类:词"，未写分类时归入默认分类，#开头为注释
+func ParseWords(r io.Reader) ([]Word, error) {
+	var words []Word
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		word := Word{Text: line, Category: DefaultCategory}
+		if idx := strings.Index(line, ":"); idx > 0 {
+			word.Category = strings.ToLower(strings.TrimSpace(line[:idx]))
+			word.Text = strings.TrimSpace(line[idx+1:])
+		}
+		if len(normalizeWord(word.Text)) == 0 {
+			continue
+		}
+		words = append(words, word)
+	}
+	return words, scanner.Err()
+}
+
+var (
+	current   atomic.Pointer[Filter]
+	initOnce  sync.Once
+	wordFile  string
+	wordMtime time.Time
+	reloadMu  sync.Mutex
+)
+
+// 使用全局过滤器检查文本，首次调用时按配置加载词库并启动热更新
+func Check(text string) *Result {
+	initOnce.Do(initDefault)
+	return current.Load().Check(text)
+}
+
+// 替换全局过滤器
+func SetDefault(f *Filter) {
+	initOnce.Do(func() {})
+	current.Store(f)
+}
+
+func initDefault() {
+	filterConfig := config.Get().TextFilter
+	if !filterConfig.Enable {
+		logger.Info("敏感词过滤未启用")
+		return
+	}
+
+	wordFile = resolveWordFile(filterConfig.WordFile)
+	if err := Reload(); err != nil {
+		logger.Error("加载敏感词库失败",
+			logger.ErrorField(err),
+			logger.StringField("word_file", filterConfig.WordFile))
+	}
+
+	if filterConfig.ReloadSeconds > 0 {
+		go watch(time.Duration(filterConfig.ReloadSeconds) * time.Second)
+	}
+}
+
+// 重新加载词库，文件读取失败时保留当前词库
+func Reload() error {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	if wordFile == "" {
+		return ErrWordFileNotFound
+	}
+
+	info, err := os.Stat(wordFile)
+	if err != nil {
+		return ErrWordFileNotFound
+	}
+
+	file, err := os.Open(wordFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	words, err := ParseWords(file)
+	if err != nil {
+		return err
+	}
+
+	filterConfig := config.Get().TextFilter
+	actions := make(map[string]Action, len(filterConfig.Actions))
+	for category, action := range filterConfig.Actions {
+		actions[strings.ToLower(category)] = Action(action)
+	}
+
+	current.Store(New(words, actions, Action(filterConfig.DefaultAction)))
+	wordMtime = info.ModTime()
+
+	logger.Info("敏感词库加载成功",
+		logger.StringField("word_file", wordFile),
+		logger.IntField("word_count", len(words)))
+	return nil
+}
+
+// 定期检查词库文件，修改后自动重新加载
+func watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		info, err := os.Stat(wordFile)
+		if err != nil {
+			continue
+		}
+
+		reloadMu.Lock()
+		changed := !info.ModTime().Equal(wordMtime)
+		reloadMu.Unlock()
+
+		if changed {
+			if err := Reload(); err != nil {
+				logger.Error("热更新敏感词库失败", logger.ErrorField(err))
+			}
+		}
+	}
+}
+
+// 查找词库文件，相对路径依次在当前目录和上级configs目录中查找
+func resolveWordFile(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+
+	name := filepath.Base(path)
+	for _, dir := range []string{"./configs", "../configs", "../../configs"} {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+	return path
+}
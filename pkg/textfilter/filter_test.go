@@ -0,0 +1,154 @@
+package textfilter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestFilter() *Filter {
+	words := []Word{
+		{Text: "赌博", Category: "gambling"},
+		{Text: "spam", Category: "ad"},
+		{Text: "违禁", Category: DefaultCategory},
+	}
+	actions := map[string]Action{
+		"gambling": ActionReject,
+		"ad":       ActionMask,
+	}
+	return New(words, actions, ActionReview)
+}
+
+func TestFoldRune(t *testing.T) {
+	tests := []struct {
+		name string
+		in   rune
+		want rune
+	}{
+		{"全角字母", 'Ａ', 'a'},
+		{"全角数字", '１', '1'},
+		{"全角空格", '　', ' '},
+		{"大写字母", 'B', 'b'},
+		{"繁体字", '賭', '赌'},
+		{"简体字不变", '博', '博'},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := foldRune(tt.in); got != tt.want {
+				t.Errorf("foldRune(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	folded, positions := normalize([]rune("Ｓ-p a！m"))
+	if string(folded) != "spam" {
+		t.Errorf("folded = %q, want %q", string(folded), "spam")
+	}
+	if want := []int{0, 2, 4, 6}; !reflect.DeepEqual(positions, want) {
+		t.Errorf("positions = %v, want %v", positions, want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	filter := newTestFilter()
+
+	tests := []struct {
+		name       string
+		text       string
+		wantAction Action
+		wantText   string
+		wantWords  []string
+	}{
+		{"未命中", "今天天气不错", ActionPass, "今天天气不错", nil},
+		{"直接命中", "来玩赌博吧", ActionReject, "来玩赌博吧", []string{"赌博"}},
+		{"繁体规避", "來玩賭博吧", ActionReject, "來玩賭博吧", []string{"赌博"}},
+		{"插入符号规避", "赌*博", ActionReject, "赌*博", []string{"赌博"}},
+		{"插入空格规避", "赌 博", ActionReject, "赌 博", []string{"赌博"}},
+		{"全角大写打码", "ＳＰＡＭ here", ActionMask, "**** here", []string{"spam"}},
+		{"打码保留符号", "s-p-a-m", ActionMask, "*-*-*-*", []string{"spam"}},
+		{"未配置分类使用默认处理", "违禁品", ActionReview, "违禁品", []string{"违禁"}},
+		{"多个分类取最严重的", "spam 赌博", ActionReject, "**** 赌博", []string{"spam", "赌博"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filter.Check(tt.text)
+			if result.Action != tt.wantAction {
+				t.Errorf("Action = %q, want %q", result.Action, tt.wantAction)
+			}
+			if result.Text != tt.wantText {
+				t.Errorf("Text = %q, want %q", result.Text, tt.wantText)
+			}
+			var words []string
+			for _, match := range result.Matches {
+				words = append(words, match.Word)
+			}
+			if !reflect.DeepEqual(words, tt.wantWords) {
+				t.Errorf("Matches = %v, want %v", words, tt.wantWords)
+			}
+		})
+	}
+}
+
+func TestCheckMatchPosition(t *testing.T) {
+	result := newTestFilter().Check("xx赌.博")
+	if len(result.Matches) != 1 {
+		t.Fatalf("len(Matches) = %d, want 1", len(result.Matches))
+	}
+	if match := result.Matches[0]; match.Start != 2 || match.End != 4 {
+		t.Errorf("match = [%d, %d], want [2, 4]", match.Start, match.End)
+	}
+}
+
+func TestCheckNilFilter(t *testing.T) {
+	var filter *Filter
+	result := filter.Check("赌博")
+	if result.Action != ActionPass || result.Text != "赌博" {
+		t.Errorf("nil filter result = %+v, want pass", result)
+	}
+}
+
+func TestMaskAll(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"未命中", "你好", "你好"},
+		{"拒绝类也打码", "来玩赌.博", "来玩*.*"},
+		{"多处命中", "spam违禁", "******"},
+	}
+	filter := newTestFilter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filter.Check(tt.text).MaskAll(); got != tt.want {
+				t.Errorf("MaskAll() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWords(t *testing.T) {
+	input := strings.Join([]string{
+		"# 注释",
+		"",
+		"赌博",
+		"AD: 加微信 ",
+		"politics:违禁",
+		"ad:!!!",
+	}, "\n")
+
+	words, err := ParseWords(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseWords() error = %v", err)
+	}
+	want := []Word{
+		{Text: "赌博", Category: DefaultCategory},
+		{Text: "加微信", Category: "ad"},
+		{Text: "违禁", Category: "politics"},
+	}
+	if !reflect.DeepEqual(words, want) {
+		t.Errorf("ParseWords() = %v, want %v", words, want)
+	}
+}
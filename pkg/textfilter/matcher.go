@@ -0,0 +1,86 @@
+package textfilter
+
+// Aho-Corasick 自动机，按字符匹配所有词条
+type matcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	children map[rune]int32
+	fail     int32
+	//以该节点结尾的词条下标，构建时已合并失败链上的输出
+	outputs []int32
+}
+
+// 一次命中，end 为归一化序列中最后一个字符的下标
+type hit struct {
+	word int32
+	end  int
+}
+
+func newMatcher(words [][]rune) *matcher {
+	m := &matcher{nodes: []acNode{{children: map[rune]int32{}}}}
+
+	for i, word := range words {
+		if len(word) == 0 {
+			continue
+		}
+		cur := int32(0)
+		for _, r := range word {
+			next, ok := m.nodes[cur].children[r]
+			if !ok {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{children: map[rune]int32{}})
+				m.nodes[cur].children[r] = next
+			}
+			cur = next
+		}
+		m.nodes[cur].outputs = append(m.nodes[cur].outputs, int32(i))
+	}
+
+	//按层遍历构建失败指针
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].children {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].children {
+			fail := m.nodes[cur].fail
+			for fail > 0 {
+				if _, ok := m.nodes[fail].children[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].children[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			m.nodes[child].outputs = append(m.nodes[child].outputs, m.nodes[m.nodes[child].fail].outputs...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// 在归一化序列中查找所有命中
+func (m *matcher) find(text []rune) []hit {
+	var hits []hit
+	cur := int32(0)
+	for i, r := range text {
+		for cur > 0 {
+			if _, ok := m.nodes[cur].children[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if next, ok := m.nodes[cur].children[r]; ok {
+			cur = next
+		}
+		for _, word := range m.nodes[cur].outputs {
+			hits = append(hits, hit{word: word, end: i})
+		}
+	}
+	return hits
+}
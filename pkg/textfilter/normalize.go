@@ -0,0 +1,95 @@
+package textfilter
+
+import (
+	"unicode"
+)
+
+// 常用繁体字到简体字的映射，每项为"繁简"两个字
+var traditionalPairs = []string{
+	"與与", "為为", "們们", "個个", "來来", "對对", "時时", "會会", "說说", "過过",
+	"還还", "這这", "麼么", "裡里", "後后", "開开", "關关", "學学", "國国", "經经",
+	"發发", "現现", "長长", "門门", "問问", "間间", "聽听", "見见", "話话", "頭头",
+	"東东", "車车", "馬马", "鳥鸟", "魚鱼", "龍龙", "號号", "網网", "錢钱", "錯错",
+	"電电", "氣气", "機机", "樣样", "飛飞", "風风", "體体", "點点", "黨党", "實实",
+	"賣卖", "買买", "寫写", "變变", "讓让", "論论", "讀读", "識识", "貨货", "費费",
+	"資资", "賬账", "賭赌", "質质", "購购", "轉转", "輸输", "農农", "運运", "達达",
+	"違违", "連连", "進进", "遠远", "選选", "邊边", "醫医", "鐵铁", "銀银", "鎖锁",
+	"陰阴", "險险", "難难", "雞鸡", "雲云", "靈灵", "顏颜", "願愿", "類类", "顯显",
+	"飯饭", "館馆", "髮发", "鬥斗", "麗丽", "傳传", "傷伤", "債债", "價价", "兒儿",
+	"兩两", "劃划", "劇剧", "則则", "剛刚", "創创", "務务", "勞劳", "勢势", "區区",
+	"單单", "參参", "雙双", "嗎吗", "嚴严", "團团", "園园", "圖图", "圍围", "場场",
+	"壞坏", "壓压", "夢梦", "奪夺", "婦妇", "媽妈", "孫孙", "審审", "寶宝", "將将",
+	"專专", "導导", "層层", "屬属", "幣币", "幫帮", "廣广", "廠厂", "彈弹", "從从",
+	"態态", "戰战", "擊击", "據据", "換换", "數数", "斷断", "條条", "極极", "槍枪",
+	"權权", "歐欧", "歲岁", "歷历", "殺杀", "沒没", "淚泪", "測测", "溫温", "滅灭",
+	"滿满", "漢汉", "灣湾", "熱热", "爭争", "爺爷", "獎奖", "獨独", "產产", "畫画",
+	"當当", "瘋疯", "療疗", "盡尽", "監监", "盤盘", "眾众", "確确", "碼码", "禮礼",
+	"種种", "稱称", "穩稳", "窮穷", "筆笔", "節节", "範范", "簡简", "紀纪", "約约",
+	"紅红", "級级", "紙纸", "線线", "組组", "細细", "終终", "結结", "給给", "絕绝",
+	"統统", "綠绿", "維维", "緊紧", "編编", "練练", "縣县", "總总", "績绩", "繼继",
+	"續续", "罰罚", "罵骂", "羅罗", "聖圣", "聯联", "職职", "聲声", "臉脸", "興兴",
+	"舉举", "藝艺", "藥药", "處处", "蟲虫", "術术", "補补", "製制", "規规", "視视",
+	"親亲", "覺觉", "觀观", "計计", "記记", "設设", "許许", "評评", "詞词", "試试",
+	"該该", "認认", "誘诱", "語语", "誤误", "請请", "課课", "調调", "談谈", "講讲",
+	"謝谢", "證证", "議议", "負负", "財财", "貧贫", "販贩", "責责", "貴贵",
+	"貸贷", "賀贺", "賊贼", "賞赏", "賠赔", "賴赖", "贏赢", "趨趋", "跡迹", "軍军",
+	"軟软", "載载", "輕轻", "輪轮", "辦办", "鄰邻", "醜丑", "釋释", "針针", "銷销",
+	"鋼钢", "錄录", "鍵键", "鏡镜", "閃闪", "閉闭", "闆板", "陣阵", "陸陆", "隨随",
+	"隱隐", "雜杂", "雖虽", "離离", "頁页", "頂顶", "項项", "順顺", "預预", "領领",
+	"頻频", "題题", "額额", "飄飘", "飲饮", "養养", "騙骗", "驗验", "驚惊", "髒脏",
+	"鬧闹", "黃黄", "齡龄", "幹干", "槓杠", "妳你", "衝冲", "裏里",
+}
+
+var traditionalToSimplified = buildTraditionalMap()
+
+func buildTraditionalMap() map[rune]rune {
+	m := make(map[rune]rune, len(traditionalPairs))
+	for _, pair := range traditionalPairs {
+		runes := []rune(pair)
+		if len(runes) == 2 && runes[0] != runes[1] {
+			m[runes[0]] = runes[1]
+		}
+	}
+	return m
+}
+
+// 归一化单个字符：全角转半角、大写转小写、繁体转简体
+func foldRune(r rune) rune {
+	switch {
+	case r == '　':
+		r = ' '
+	case r >= '！' && r <= '～':
+		r -= 0xFEE0
+	}
+
+	if s, ok := traditionalToSimplified[r]; ok {
+		return s
+	}
+	return unicode.ToLower(r)
+}
+
+// 是否为参与匹配的有效字符，其余符号、空白和表情在匹配时跳过，用于识别插入符号的规避写法
+func isMeaningful(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// 归一化文本，返回有效字符序列及每个字符在原文中的位置
+func normalize(text []rune) ([]rune, []int) {
+	folded := make([]rune, 0, len(text))
+	positions := make([]int, 0, len(text))
+	for i, r := range text {
+		r = foldRune(r)
+		if !isMeaningful(r) {
+			continue
+		}
+		folded = append(folded, r)
+		positions = append(positions, i)
+	}
+	return folded, positions
+}
+
+// 归一化词条
+func normalizeWord(word string) []rune {
+	folded, _ := normalize([]rune(word))
+	return folded
+}
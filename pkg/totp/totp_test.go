@@ -0,0 +1,125 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录B的SHA1密钥"12345678901234567890"的Base32编码
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 附录B的测试向量，取8位验证码的后6位
+func TestCodeAtRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			got, err := CodeAt(rfcSecret, Step(time.Unix(tt.unix, 0)))
+			if err != nil {
+				t.Fatalf("CodeAt() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CodeAt() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeAtSecretFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		wantErr bool
+	}{
+		{"小写和空白", " " + strings.ToLower(rfcSecret) + " ", false},
+		{"空密钥", "", true},
+		{"非Base32字符", "not-base32!", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := CodeAt(tt.secret, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CodeAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				want, _ := CodeAt(rfcSecret, 1)
+				if code != want {
+					t.Errorf("CodeAt() = %s, want %s", code, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	codeAt := func(step int64) string {
+		code, _ := CodeAt(rfcSecret, step)
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int
+		wantStep int64
+		wantOK   bool
+	}{
+		{"当前时间步", codeAt(current), 1, current, true},
+		{"上一个时间步在偏差内", codeAt(current - 1), 1, current - 1, true},
+		{"下一个时间步在偏差内", codeAt(current + 1), 1, current + 1, true},
+		{"超出偏差", codeAt(current - 2), 1, 0, false},
+		{"不允许偏差", codeAt(current - 1), 0, 0, false},
+		{"带空白", " " + codeAt(current) + " ", 0, current, true},
+		{"位数不对", "12345", 1, 0, false},
+		{"错误验证码", "000000", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, now, tt.skew)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate() = (%d, %v), want (%d, %v)", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	key, err := encoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret is not Base32: %v", err)
+	}
+	if len(key) != secretSize {
+		t.Errorf("len(key) = %d, want %d", len(key), secretSize)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("ShortVideo", "alice", rfcSecret)
+	for _, part := range []string{
+		"otpauth://totp/ShortVideo:alice?",
+		"secret=" + rfcSecret,
+		"issuer=ShortVideo",
+		"digits=6",
+		"period=30",
+	} {
+		if !strings.Contains(uri, part) {
+			t.Errorf("URI() = %s, missing %s", uri, part)
+		}
+	}
+}